@@ -19,6 +19,7 @@ import (
 	admin_handler "github.com/zitadel/zitadel/internal/admin/repository/eventsourcing/handler"
 	admin_view "github.com/zitadel/zitadel/internal/admin/repository/eventsourcing/view"
 	internal_authz "github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/dpop"
 	"github.com/zitadel/zitadel/internal/api/oidc"
 	"github.com/zitadel/zitadel/internal/api/ui/login"
 	auth_es "github.com/zitadel/zitadel/internal/auth/repository/eventsourcing"
@@ -162,7 +163,9 @@ func projections(
 	)
 	logging.OnError(err).Fatal("unable to start queries")
 
-	authZRepo, err := authz.Start(queries, es, client, keys.OIDC, config.ExternalSecure)
+	dpopVerifier, err := dpop.StartVerifier(ctx, cacheConnectors)
+	logging.OnError(err).Fatal("unable to start dpop replay cache")
+	authZRepo, err := authz.Start(queries, es, client, keys.OIDC, config.ExternalSecure, dpopVerifier)
 	logging.OnError(err).Fatal("unable to start authz repo")
 
 	webAuthNConfig := &webauthn.Config{
@@ -220,6 +223,7 @@ func projections(
 		queries,
 		es,
 		config.Login.DefaultOTPEmailURLV2,
+		config.Login.DefaultBackChannelAuthURLV2,
 		config.SystemDefaults.Notifications.FileSystemPath,
 		keys.User,
 		keys.SMTP,
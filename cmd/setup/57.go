@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 57.sql
+	addDPoPBoundAccessTokens string
+)
+
+type Apps7OIDCConfigsDPoPBoundAccessTokens struct {
+	dbClient *database.DB
+}
+
+func (mig *Apps7OIDCConfigsDPoPBoundAccessTokens) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, addDPoPBoundAccessTokens)
+	return err
+}
+
+func (mig *Apps7OIDCConfigsDPoPBoundAccessTokens) String() string {
+	return "57_apps7_oidc_configs_add_dpop_bound_access_tokens"
+}
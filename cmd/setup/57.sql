@@ -0,0 +1 @@
+ALTER TABLE IF EXISTS projections.apps7_oidc_configs ADD COLUMN IF NOT EXISTS dpop_bound_access_tokens BOOLEAN DEFAULT FALSE;
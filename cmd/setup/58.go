@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 58.sql
+	addRequirePushedAuthRequests string
+)
+
+type Apps7OIDCConfigsRequirePushedAuthRequests struct {
+	dbClient *database.DB
+}
+
+func (mig *Apps7OIDCConfigsRequirePushedAuthRequests) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, addRequirePushedAuthRequests)
+	return err
+}
+
+func (mig *Apps7OIDCConfigsRequirePushedAuthRequests) String() string {
+	return "58_apps7_oidc_configs_add_require_pushed_auth_requests"
+}
@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 59.sql
+	addCIBANotificationURI string
+)
+
+type Apps7OIDCConfigsCIBANotificationURI struct {
+	dbClient *database.DB
+}
+
+func (mig *Apps7OIDCConfigsCIBANotificationURI) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, addCIBANotificationURI)
+	return err
+}
+
+func (mig *Apps7OIDCConfigsCIBANotificationURI) String() string {
+	return "59_apps7_oidc_configs_add_ciba_notification_uri"
+}
@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 60.sql
+	scimProvisioningHandlerCurrentState string
+)
+
+type SCIMProvisioningHandlerStart struct {
+	dbClient *database.DB
+}
+
+func (mig *SCIMProvisioningHandlerStart) Execute(ctx context.Context, e eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, scimProvisioningHandlerCurrentState, e.Sequence(), e.CreatedAt(), e.Position())
+	return err
+}
+
+func (mig *SCIMProvisioningHandlerStart) String() string {
+	return "60_scim_provisioning_handler_start"
+}
@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 61.sql
+	addTargetTLS string
+)
+
+type Targets2AddTLS struct {
+	dbClient *database.DB
+}
+
+func (mig *Targets2AddTLS) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, addTargetTLS)
+	return err
+}
+
+func (mig *Targets2AddTLS) String() string {
+	return "61_targets2_add_tls"
+}
@@ -0,0 +1,3 @@
+ALTER TABLE IF EXISTS projections.targets2 ADD COLUMN IF NOT EXISTS client_certificate BYTEA;
+ALTER TABLE IF EXISTS projections.targets2 ADD COLUMN IF NOT EXISTS client_key JSONB;
+ALTER TABLE IF EXISTS projections.targets2 ADD COLUMN IF NOT EXISTS root_ca BYTEA;
@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 62.sql
+	addRecoveryCodeCheckedAt string
+)
+
+type Sessions8AddRecoveryCodeCheckedAt struct {
+	dbClient *database.DB
+}
+
+func (mig *Sessions8AddRecoveryCodeCheckedAt) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, addRecoveryCodeCheckedAt)
+	return err
+}
+
+func (mig *Sessions8AddRecoveryCodeCheckedAt) String() string {
+	return "62_sessions8_add_recovery_code_checked_at"
+}
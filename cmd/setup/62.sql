@@ -0,0 +1 @@
+ALTER TABLE IF EXISTS projections.sessions8 ADD COLUMN IF NOT EXISTS recovery_code_checked_at TIMESTAMPTZ;
@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 63.sql
+	addConsentRequired string
+)
+
+type Apps7OIDCConfigsConsentRequired struct {
+	dbClient *database.DB
+}
+
+func (mig *Apps7OIDCConfigsConsentRequired) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, addConsentRequired)
+	return err
+}
+
+func (mig *Apps7OIDCConfigsConsentRequired) String() string {
+	return "63_apps7_oidc_configs_add_consent_required"
+}
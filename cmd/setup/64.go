@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 64.sql
+	addPasswordHistory string
+)
+
+type PasswordAgePolicies2AddHistory struct {
+	dbClient *database.DB
+}
+
+func (mig *PasswordAgePolicies2AddHistory) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, addPasswordHistory)
+	return err
+}
+
+func (mig *PasswordAgePolicies2AddHistory) String() string {
+	return "64_password_age_policies2_add_history"
+}
@@ -0,0 +1,2 @@
+ALTER TABLE IF EXISTS projections.password_age_policies2 ADD COLUMN IF NOT EXISTS history_count BIGINT DEFAULT 0;
+ALTER TABLE IF EXISTS projections.password_age_policies2 ADD COLUMN IF NOT EXISTS min_age_days BIGINT DEFAULT 0;
@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 65.sql
+	addLDAPGroupSync string
+)
+
+type IDPTemplate6LDAP2AddGroupSync struct {
+	dbClient *database.DB
+}
+
+func (mig *IDPTemplate6LDAP2AddGroupSync) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, addLDAPGroupSync)
+	return err
+}
+
+func (mig *IDPTemplate6LDAP2AddGroupSync) String() string {
+	return "65_idp_templates6_ldap2_add_group_sync"
+}
@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 66.sql
+	addTLSClientAuth string
+)
+
+type Apps7OIDCConfigsTLSClientAuth struct {
+	dbClient *database.DB
+}
+
+func (mig *Apps7OIDCConfigsTLSClientAuth) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, addTLSClientAuth)
+	return err
+}
+
+func (mig *Apps7OIDCConfigsTLSClientAuth) String() string {
+	return "66_apps7_oidc_configs_add_tls_client_auth"
+}
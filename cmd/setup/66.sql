@@ -0,0 +1,3 @@
+ALTER TABLE IF EXISTS projections.apps7_oidc_configs ADD COLUMN IF NOT EXISTS tls_client_auth_subject_dn TEXT;
+ALTER TABLE IF EXISTS projections.apps7_oidc_configs ADD COLUMN IF NOT EXISTS tls_client_certificates TEXT[];
+ALTER TABLE IF EXISTS projections.apps7_oidc_configs ADD COLUMN IF NOT EXISTS tls_client_certificate_bound_access_tokens BOOLEAN DEFAULT FALSE;
@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 67.sql
+	addOrgParent string
+)
+
+type Orgs1AddParent struct {
+	dbClient *database.DB
+}
+
+func (mig *Orgs1AddParent) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, addOrgParent)
+	return err
+}
+
+func (mig *Orgs1AddParent) String() string {
+	return "67_orgs1_add_parent"
+}
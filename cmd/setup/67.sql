@@ -0,0 +1,3 @@
+ALTER TABLE IF EXISTS projections.orgs1 ADD COLUMN IF NOT EXISTS parent_org_id TEXT;
+ALTER TABLE IF EXISTS projections.orgs1 ADD COLUMN IF NOT EXISTS delegate_administration BOOLEAN DEFAULT FALSE;
+CREATE INDEX IF NOT EXISTS orgs1_parent_idx ON projections.orgs1 (parent_org_id);
@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 68.sql
+	addUserGrantValidity string
+)
+
+type UserGrants5AddValidity struct {
+	dbClient *database.DB
+}
+
+func (mig *UserGrants5AddValidity) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, addUserGrantValidity)
+	return err
+}
+
+func (mig *UserGrants5AddValidity) String() string {
+	return "68_user_grants5_add_validity"
+}
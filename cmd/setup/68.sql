@@ -0,0 +1,4 @@
+ALTER TABLE IF EXISTS projections.user_grants5 ADD COLUMN IF NOT EXISTS valid_from TIMESTAMPTZ;
+ALTER TABLE IF EXISTS projections.user_grants5 ADD COLUMN IF NOT EXISTS valid_until TIMESTAMPTZ;
+ALTER TABLE IF EXISTS projections.user_grants5 ADD COLUMN IF NOT EXISTS expiry_reminded BOOLEAN DEFAULT FALSE;
+CREATE INDEX IF NOT EXISTS user_grants5_valid_until_idx ON projections.user_grants5 (valid_until);
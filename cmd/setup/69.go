@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 69.sql
+	addRefreshTokenRotation string
+)
+
+type Apps7OIDCConfigsRefreshTokenRotation struct {
+	dbClient *database.DB
+}
+
+func (mig *Apps7OIDCConfigsRefreshTokenRotation) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, addRefreshTokenRotation)
+	return err
+}
+
+func (mig *Apps7OIDCConfigsRefreshTokenRotation) String() string {
+	return "69_apps7_oidc_configs_add_refresh_token_rotation"
+}
@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 70.sql
+	addEncryptAuthorizationResponse string
+)
+
+type Apps7OIDCConfigsEncryptAuthorizationResponse struct {
+	dbClient *database.DB
+}
+
+func (mig *Apps7OIDCConfigsEncryptAuthorizationResponse) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, addEncryptAuthorizationResponse)
+	return err
+}
+
+func (mig *Apps7OIDCConfigsEncryptAuthorizationResponse) String() string {
+	return "70_apps7_oidc_configs_add_encrypt_authorization_response"
+}
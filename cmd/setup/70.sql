@@ -0,0 +1 @@
+ALTER TABLE IF EXISTS projections.apps7_oidc_configs ADD COLUMN IF NOT EXISTS encrypt_authorization_response BOOLEAN DEFAULT FALSE;
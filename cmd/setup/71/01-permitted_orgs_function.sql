@@ -0,0 +1,90 @@
+-- permitted_orgs additionally returns the organizations administrated thru the organization hierarchy:
+-- members of an organization keep their roles on the child organizations delegating their administration to it,
+-- as long as every organization in between delegates its administration (see domain.OrgHierarchyMaxDepth for the depth).
+CREATE OR REPLACE FUNCTION eventstore.permitted_orgs(
+    req_instance_id TEXT
+    , auth_user_id TEXT
+    , system_user_perms JSONB
+    , perm TEXT
+    , filter_org TEXT
+
+    , instance_permitted OUT BOOLEAN
+    , org_ids OUT TEXT[]
+)
+	LANGUAGE 'plpgsql' STABLE
+AS $$
+BEGIN
+    -- if system user
+    IF system_user_perms IS NOT NULL THEN
+        SELECT p.instance_permitted, p.org_ids INTO instance_permitted, org_ids
+        FROM eventstore.check_system_user_perms(system_user_perms, req_instance_id, perm) p;
+        RETURN;
+    END IF;
+
+    -- if human/machine user
+    DECLARE
+    	matched_roles TEXT[] := eventstore.find_roles(req_instance_id, perm);
+	BEGIN
+        -- First try if the permission was granted thru an instance-level role
+        SELECT true INTO instance_permitted
+            FROM eventstore.instance_members im
+            WHERE im.role = ANY(matched_roles)
+            AND im.instance_id = req_instance_id
+            AND im.user_id = auth_user_id
+            LIMIT 1;
+
+        org_ids := ARRAY[]::TEXT[];
+        IF instance_permitted THEN
+            RETURN;
+        END IF;
+        instance_permitted := FALSE;
+
+        -- Return the filtered organization if permission was granted thru org-level roles
+        -- on the organization itself or one of its delegating ancestors
+        IF filter_org IS NOT NULL THEN
+            WITH RECURSIVE ancestors (id, parent_org_id, delegate_administration, depth) AS (
+                SELECT o.id, o.parent_org_id, o.delegate_administration, 0
+                FROM projections.orgs1 o
+                WHERE o.instance_id = req_instance_id
+                AND o.id = filter_org
+                UNION ALL
+                SELECT p.id, p.parent_org_id, p.delegate_administration, a.depth + 1
+                FROM ancestors a
+                JOIN projections.orgs1 p
+                    ON p.instance_id = req_instance_id
+                    AND p.id = a.parent_org_id
+                WHERE a.depth < 10
+                AND a.delegate_administration
+            )
+            SELECT ARRAY[filter_org] INTO org_ids
+            FROM eventstore.org_members om
+            WHERE om.role = ANY(matched_roles)
+            AND om.instance_id = req_instance_id
+            AND om.user_id = auth_user_id
+            AND (om.org_id = filter_org OR om.org_id IN (SELECT id FROM ancestors))
+            LIMIT 1;
+            RETURN;
+        END IF;
+
+        -- Return the organizations where permission were granted thru org-level roles
+        -- and their descendants delegating their administration
+        WITH RECURSIVE descendants (id, depth) AS (
+            SELECT DISTINCT om.org_id, 0
+            FROM eventstore.org_members om
+            WHERE om.role = ANY(matched_roles)
+            AND om.instance_id = req_instance_id
+            AND om.user_id = auth_user_id
+            UNION
+            SELECT c.id, d.depth + 1
+            FROM descendants d
+            JOIN projections.orgs1 c
+                ON c.instance_id = req_instance_id
+                AND c.parent_org_id = d.id
+                AND c.delegate_administration
+            WHERE d.depth < 10
+        )
+        SELECT array_agg(DISTINCT d.id) INTO org_ids
+        FROM descendants d;
+    END;
+END;
+$$;
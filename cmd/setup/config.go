@@ -102,57 +102,72 @@ func MustNewConfig(v *viper.Viper) *Config {
 }
 
 type Steps struct {
-	s1ProjectionTable                       *ProjectionTable
-	s2AssetsTable                           *AssetTable
-	FirstInstance                           *FirstInstance
-	s5LastFailed                            *LastFailed
-	s6OwnerRemoveColumns                    *OwnerRemoveColumns
-	s7LogstoreTables                        *LogstoreTables
-	s8AuthTokens                            *AuthTokenIndexes
-	CorrectCreationDate                     *CorrectCreationDate
-	s12AddOTPColumns                        *AddOTPColumns
-	s13FixQuotaProjection                   *FixQuotaConstraints
-	s14NewEventsTable                       *NewEventsTable
-	s15CurrentStates                        *CurrentProjectionState
-	s16UniqueConstraintsLower               *UniqueConstraintToLower
-	s17AddOffsetToUniqueConstraints         *AddOffsetToCurrentStates
-	s18AddLowerFieldsToLoginNames           *AddLowerFieldsToLoginNames
-	s19AddCurrentStatesIndex                *AddCurrentSequencesIndex
-	s20AddByUserSessionIndex                *AddByUserIndexToSession
-	s21AddBlockFieldToLimits                *AddBlockFieldToLimits
-	s22ActiveInstancesIndex                 *ActiveInstanceEvents
-	s23CorrectGlobalUniqueConstraints       *CorrectGlobalUniqueConstraints
-	s24AddActorToAuthTokens                 *AddActorToAuthTokens
-	s25User11AddLowerFieldsToVerifiedEmail  *User11AddLowerFieldsToVerifiedEmail
-	s26AuthUsers3                           *AuthUsers3
-	s27IDPTemplate6SAMLNameIDFormat         *IDPTemplate6SAMLNameIDFormat
-	s28AddFieldTable                        *AddFieldTable
-	s29FillFieldsForProjectGrant            *FillFieldsForProjectGrant
-	s30FillFieldsForOrgDomainVerified       *FillFieldsForOrgDomainVerified
-	s31AddAggregateIndexToFields            *AddAggregateIndexToFields
-	s32AddAuthSessionID                     *AddAuthSessionID
-	s33SMSConfigs3TwilioAddVerifyServiceSid *SMSConfigs3TwilioAddVerifyServiceSid
-	s34AddCacheSchema                       *AddCacheSchema
-	s35AddPositionToIndexEsWm               *AddPositionToIndexEsWm
-	s36FillV2Milestones                     *FillV3Milestones
-	s37Apps7OIDConfigsBackChannelLogoutURI  *Apps7OIDConfigsBackChannelLogoutURI
-	s38BackChannelLogoutNotificationStart   *BackChannelLogoutNotificationStart
-	s40InitPushFunc                         *InitPushFunc
-	s42Apps7OIDCConfigsLoginVersion         *Apps7OIDCConfigsLoginVersion
-	s43CreateFieldsDomainIndex              *CreateFieldsDomainIndex
-	s44ReplaceCurrentSequencesIndex         *ReplaceCurrentSequencesIndex
-	s45CorrectProjectOwners                 *CorrectProjectOwners
-	s46InitPermissionFunctions              *InitPermissionFunctions
-	s47FillMembershipFields                 *FillMembershipFields
-	s48Apps7SAMLConfigsLoginVersion         *Apps7SAMLConfigsLoginVersion
-	s49InitPermittedOrgsFunction            *InitPermittedOrgsFunction
-	s50IDPTemplate6UsePKCE                  *IDPTemplate6UsePKCE
-	s51IDPTemplate6RootCA                   *IDPTemplate6RootCA
-	s52IDPTemplate6LDAP2                    *IDPTemplate6LDAP2
-	s53InitPermittedOrgsFunction            *InitPermittedOrgsFunction53
-	s54InstancePositionIndex                *InstancePositionIndex
-	s55ExecutionHandlerStart                *ExecutionHandlerStart
-	s56IDPTemplate6SAMLFederatedLogout      *IDPTemplate6SAMLFederatedLogout
+	s1ProjectionTable                               *ProjectionTable
+	s2AssetsTable                                   *AssetTable
+	FirstInstance                                   *FirstInstance
+	s5LastFailed                                    *LastFailed
+	s6OwnerRemoveColumns                            *OwnerRemoveColumns
+	s7LogstoreTables                                *LogstoreTables
+	s8AuthTokens                                    *AuthTokenIndexes
+	CorrectCreationDate                             *CorrectCreationDate
+	s12AddOTPColumns                                *AddOTPColumns
+	s13FixQuotaProjection                           *FixQuotaConstraints
+	s14NewEventsTable                               *NewEventsTable
+	s15CurrentStates                                *CurrentProjectionState
+	s16UniqueConstraintsLower                       *UniqueConstraintToLower
+	s17AddOffsetToUniqueConstraints                 *AddOffsetToCurrentStates
+	s18AddLowerFieldsToLoginNames                   *AddLowerFieldsToLoginNames
+	s19AddCurrentStatesIndex                        *AddCurrentSequencesIndex
+	s20AddByUserSessionIndex                        *AddByUserIndexToSession
+	s21AddBlockFieldToLimits                        *AddBlockFieldToLimits
+	s22ActiveInstancesIndex                         *ActiveInstanceEvents
+	s23CorrectGlobalUniqueConstraints               *CorrectGlobalUniqueConstraints
+	s24AddActorToAuthTokens                         *AddActorToAuthTokens
+	s25User11AddLowerFieldsToVerifiedEmail          *User11AddLowerFieldsToVerifiedEmail
+	s26AuthUsers3                                   *AuthUsers3
+	s27IDPTemplate6SAMLNameIDFormat                 *IDPTemplate6SAMLNameIDFormat
+	s28AddFieldTable                                *AddFieldTable
+	s29FillFieldsForProjectGrant                    *FillFieldsForProjectGrant
+	s30FillFieldsForOrgDomainVerified               *FillFieldsForOrgDomainVerified
+	s31AddAggregateIndexToFields                    *AddAggregateIndexToFields
+	s32AddAuthSessionID                             *AddAuthSessionID
+	s33SMSConfigs3TwilioAddVerifyServiceSid         *SMSConfigs3TwilioAddVerifyServiceSid
+	s34AddCacheSchema                               *AddCacheSchema
+	s35AddPositionToIndexEsWm                       *AddPositionToIndexEsWm
+	s36FillV2Milestones                             *FillV3Milestones
+	s37Apps7OIDConfigsBackChannelLogoutURI          *Apps7OIDConfigsBackChannelLogoutURI
+	s38BackChannelLogoutNotificationStart           *BackChannelLogoutNotificationStart
+	s40InitPushFunc                                 *InitPushFunc
+	s42Apps7OIDCConfigsLoginVersion                 *Apps7OIDCConfigsLoginVersion
+	s43CreateFieldsDomainIndex                      *CreateFieldsDomainIndex
+	s44ReplaceCurrentSequencesIndex                 *ReplaceCurrentSequencesIndex
+	s45CorrectProjectOwners                         *CorrectProjectOwners
+	s46InitPermissionFunctions                      *InitPermissionFunctions
+	s47FillMembershipFields                         *FillMembershipFields
+	s48Apps7SAMLConfigsLoginVersion                 *Apps7SAMLConfigsLoginVersion
+	s49InitPermittedOrgsFunction                    *InitPermittedOrgsFunction
+	s50IDPTemplate6UsePKCE                          *IDPTemplate6UsePKCE
+	s51IDPTemplate6RootCA                           *IDPTemplate6RootCA
+	s52IDPTemplate6LDAP2                            *IDPTemplate6LDAP2
+	s53InitPermittedOrgsFunction                    *InitPermittedOrgsFunction53
+	s54InstancePositionIndex                        *InstancePositionIndex
+	s55ExecutionHandlerStart                        *ExecutionHandlerStart
+	s56IDPTemplate6SAMLFederatedLogout              *IDPTemplate6SAMLFederatedLogout
+	s57Apps7OIDCConfigsDPoP                         *Apps7OIDCConfigsDPoPBoundAccessTokens
+	s58Apps7OIDCConfigsPAR                          *Apps7OIDCConfigsRequirePushedAuthRequests
+	s59Apps7OIDCConfigsCIBA                         *Apps7OIDCConfigsCIBANotificationURI
+	s60SCIMProvisioningHandlerStart                 *SCIMProvisioningHandlerStart
+	s61Targets2AddTLS                               *Targets2AddTLS
+	s62Sessions8AddRecoveryCodeCheckedAt            *Sessions8AddRecoveryCodeCheckedAt
+	s63Apps7OIDCConfigsConsentRequired              *Apps7OIDCConfigsConsentRequired
+	s64PasswordAgePolicies2AddHistory               *PasswordAgePolicies2AddHistory
+	s65IDPTemplate6LDAP2AddGroupSync                *IDPTemplate6LDAP2AddGroupSync
+	s66Apps7OIDCConfigsTLSClientAuth                *Apps7OIDCConfigsTLSClientAuth
+	s67Orgs1AddParent                               *Orgs1AddParent
+	s68UserGrants5AddValidity                       *UserGrants5AddValidity
+	s69Apps7OIDCConfigsRefreshTokenRotation         *Apps7OIDCConfigsRefreshTokenRotation
+	s70Apps7OIDCConfigsEncryptAuthorizationResponse *Apps7OIDCConfigsEncryptAuthorizationResponse
+	s71InitPermittedOrgsFunction                    *InitPermittedOrgsFunction71
 }
 
 func MustNewSteps(v *viper.Viper) *Steps {
@@ -543,6 +543,14 @@ func TestPermittedOrgs(t *testing.T) {
 	createRolePermission(t, tx, "ORG_OWNER", []string{"org.write", "org.read"})
 	createMember(t, tx, instance.AggregateType, "instance_user")
 	createMember(t, tx, org.AggregateType, "org_user")
+	// parent_org is administrated by parent_user,
+	// the administration is delegated down to grand_child_org, but not to the children of other_child_org
+	createTestField(t, tx, "parent_org", org.AggregateType, "parent_org", "org_member_role", "parent_user", "org_role", "ORG_OWNER")
+	createOrg(t, tx, "parent_org", "", false)
+	createOrg(t, tx, "child_org", "parent_org", true)
+	createOrg(t, tx, "grand_child_org", "child_org", true)
+	createOrg(t, tx, "other_child_org", "parent_org", false)
+	createOrg(t, tx, "other_grand_child_org", "other_child_org", true)
 
 	const query = "SELECT instance_permitted, org_ids FROM eventstore.permitted_orgs($1,$2,$3,$4,$5);"
 	type args struct {
@@ -634,6 +642,49 @@ func TestPermittedOrgs(t *testing.T) {
 			},
 			want: result{},
 		},
+		{
+			name: "org member, delegated orgs",
+			args: args{
+				reqInstanceID: instanceID,
+				authUserID:    "parent_user",
+				perm:          "org.read",
+			},
+			want: result{
+				OrgIDs: pgtype.FlatArray[string]{"parent_org", "child_org", "grand_child_org"},
+			},
+		},
+		{
+			name: "org member, filter delegated org",
+			args: args{
+				reqInstanceID: instanceID,
+				authUserID:    "parent_user",
+				perm:          "org.read",
+				filterOrg:     gu.Ptr("grand_child_org"),
+			},
+			want: result{
+				OrgIDs: pgtype.FlatArray[string]{"grand_child_org"},
+			},
+		},
+		{
+			name: "org member, filter not delegated org",
+			args: args{
+				reqInstanceID: instanceID,
+				authUserID:    "parent_user",
+				perm:          "org.read",
+				filterOrg:     gu.Ptr("other_child_org"),
+			},
+			want: result{},
+		},
+		{
+			name: "org member, filter org with not delegating parent",
+			args: args{
+				reqInstanceID: instanceID,
+				authUserID:    "parent_user",
+				perm:          "org.read",
+				filterOrg:     gu.Ptr("other_grand_child_org"),
+			},
+			want: result{},
+		},
 		{
 			name: "no permission",
 			args: args{
@@ -859,6 +910,14 @@ func createMember(t *testing.T, tx pgx.Tx, aggregateType eventstore.AggregateTyp
 	require.NoError(t, err)
 }
 
+func createOrg(t *testing.T, tx pgx.Tx, id, parentOrgID string, delegateAdministration bool) {
+	const query = `INSERT INTO projections.orgs1(
+		id, creation_date, change_date, resource_owner, instance_id, org_state, sequence, name, parent_org_id, delegate_administration)
+		VALUES ($1, now(), now(), $1, $2, 1, 1, $1, NULLIF($3, ''), $4);`
+	_, err := tx.Exec(CTX, query, id, instanceID, parentOrgID, delegateAdministration)
+	require.NoError(t, err)
+}
+
 func createTestField(t *testing.T, tx pgx.Tx, resourceOwner string, aggregateType eventstore.AggregateType, aggregateID, objectType, objectID, fieldName string, value any) {
 	const query = `INSERT INTO eventstore.fields(
 		instance_id, resource_owner, aggregate_type, aggregate_id, object_type, object_id, field_name, value, value_must_be_unique, should_index, object_revision)
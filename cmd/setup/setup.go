@@ -25,6 +25,7 @@ import (
 	admin_handler "github.com/zitadel/zitadel/internal/admin/repository/eventsourcing/handler"
 	admin_view "github.com/zitadel/zitadel/internal/admin/repository/eventsourcing/view"
 	internal_authz "github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/dpop"
 	auth_handler "github.com/zitadel/zitadel/internal/auth/repository/eventsourcing/handler"
 	auth_view "github.com/zitadel/zitadel/internal/auth/repository/eventsourcing/view"
 	"github.com/zitadel/zitadel/internal/authz"
@@ -215,6 +216,21 @@ func Setup(ctx context.Context, config *Config, steps *Steps, masterKey string)
 	steps.s54InstancePositionIndex = &InstancePositionIndex{dbClient: dbClient}
 	steps.s55ExecutionHandlerStart = &ExecutionHandlerStart{dbClient: dbClient}
 	steps.s56IDPTemplate6SAMLFederatedLogout = &IDPTemplate6SAMLFederatedLogout{dbClient: dbClient}
+	steps.s57Apps7OIDCConfigsDPoP = &Apps7OIDCConfigsDPoPBoundAccessTokens{dbClient: dbClient}
+	steps.s58Apps7OIDCConfigsPAR = &Apps7OIDCConfigsRequirePushedAuthRequests{dbClient: dbClient}
+	steps.s59Apps7OIDCConfigsCIBA = &Apps7OIDCConfigsCIBANotificationURI{dbClient: dbClient}
+	steps.s60SCIMProvisioningHandlerStart = &SCIMProvisioningHandlerStart{dbClient: dbClient}
+	steps.s61Targets2AddTLS = &Targets2AddTLS{dbClient: dbClient}
+	steps.s62Sessions8AddRecoveryCodeCheckedAt = &Sessions8AddRecoveryCodeCheckedAt{dbClient: dbClient}
+	steps.s63Apps7OIDCConfigsConsentRequired = &Apps7OIDCConfigsConsentRequired{dbClient: dbClient}
+	steps.s64PasswordAgePolicies2AddHistory = &PasswordAgePolicies2AddHistory{dbClient: dbClient}
+	steps.s65IDPTemplate6LDAP2AddGroupSync = &IDPTemplate6LDAP2AddGroupSync{dbClient: dbClient}
+	steps.s66Apps7OIDCConfigsTLSClientAuth = &Apps7OIDCConfigsTLSClientAuth{dbClient: dbClient}
+	steps.s67Orgs1AddParent = &Orgs1AddParent{dbClient: dbClient}
+	steps.s68UserGrants5AddValidity = &UserGrants5AddValidity{dbClient: dbClient}
+	steps.s69Apps7OIDCConfigsRefreshTokenRotation = &Apps7OIDCConfigsRefreshTokenRotation{dbClient: dbClient}
+	steps.s70Apps7OIDCConfigsEncryptAuthorizationResponse = &Apps7OIDCConfigsEncryptAuthorizationResponse{dbClient: dbClient}
+	steps.s71InitPermittedOrgsFunction = &InitPermittedOrgsFunction71{dbClient: dbClient}
 
 	err = projection.Create(ctx, dbClient, eventstoreClient, config.Projections, nil, nil, nil)
 	logging.OnError(err).Fatal("unable to start projections")
@@ -260,6 +276,7 @@ func Setup(ctx context.Context, config *Config, steps *Steps, masterKey string)
 		steps.s54InstancePositionIndex,
 		steps.s55ExecutionHandlerStart,
 		steps.s56IDPTemplate6SAMLFederatedLogout,
+		steps.s60SCIMProvisioningHandlerStart,
 	} {
 		setupErr = executeMigration(ctx, eventstoreClient, step, "migration failed")
 		if setupErr != nil {
@@ -316,6 +333,20 @@ func Setup(ctx context.Context, config *Config, steps *Steps, masterKey string)
 		steps.s42Apps7OIDCConfigsLoginVersion,
 		steps.s43CreateFieldsDomainIndex,
 		steps.s48Apps7SAMLConfigsLoginVersion,
+		steps.s57Apps7OIDCConfigsDPoP,
+		steps.s58Apps7OIDCConfigsPAR,
+		steps.s59Apps7OIDCConfigsCIBA,
+		steps.s61Targets2AddTLS,
+		steps.s62Sessions8AddRecoveryCodeCheckedAt,
+		steps.s63Apps7OIDCConfigsConsentRequired,
+		steps.s64PasswordAgePolicies2AddHistory,
+		steps.s65IDPTemplate6LDAP2AddGroupSync,
+		steps.s66Apps7OIDCConfigsTLSClientAuth,
+		steps.s67Orgs1AddParent,
+		steps.s68UserGrants5AddValidity,
+		steps.s69Apps7OIDCConfigsRefreshTokenRotation,
+		steps.s70Apps7OIDCConfigsEncryptAuthorizationResponse,
+		steps.s71InitPermittedOrgsFunction,
 	} {
 		setupErr = executeMigration(ctx, eventstoreClient, step, "migration failed")
 		if setupErr != nil {
@@ -483,7 +514,9 @@ func startCommandsQueries(
 		queries,
 	)
 
-	authZRepo, err := authz.Start(queries, eventstoreClient, dbClient, keys.OIDC, config.ExternalSecure)
+	dpopVerifier, err := dpop.StartVerifier(ctx, cacheConnectors)
+	logging.OnError(err).Fatal("unable to start dpop replay cache")
+	authZRepo, err := authz.Start(queries, eventstoreClient, dbClient, keys.OIDC, config.ExternalSecure, dpopVerifier)
 	logging.OnError(err).Fatal("unable to start authz repo")
 	permissionCheck := func(ctx context.Context, permission, orgID, resourceID string) (err error) {
 		return internal_authz.CheckPermission(ctx, authZRepo, config.SystemAuthZ.RolePermissionMappings, config.InternalAuthZ.RolePermissionMappings, permission, orgID, resourceID)
@@ -541,6 +574,7 @@ func startCommandsQueries(
 		queries,
 		eventstoreClient,
 		config.Login.DefaultOTPEmailURLV2,
+		config.Login.DefaultBackChannelAuthURLV2,
 		config.SystemDefaults.Notifications.FileSystemPath,
 		keys.User,
 		keys.SMTP,
@@ -29,11 +29,13 @@ import (
 	"github.com/zitadel/zitadel/cmd/encryption"
 	"github.com/zitadel/zitadel/cmd/key"
 	cmd_tls "github.com/zitadel/zitadel/cmd/tls"
+	"github.com/zitadel/zitadel/internal/accessreviewexpiry"
 	"github.com/zitadel/zitadel/internal/actions"
 	admin_es "github.com/zitadel/zitadel/internal/admin/repository/eventsourcing"
 	"github.com/zitadel/zitadel/internal/api"
 	"github.com/zitadel/zitadel/internal/api/assets"
 	internal_authz "github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/dpop"
 	action_v2_beta "github.com/zitadel/zitadel/internal/api/grpc/action/v2beta"
 	"github.com/zitadel/zitadel/internal/api/grpc/admin"
 	"github.com/zitadel/zitadel/internal/api/grpc/auth"
@@ -86,9 +88,11 @@ import (
 	old_es "github.com/zitadel/zitadel/internal/eventstore/repository/sql"
 	new_es "github.com/zitadel/zitadel/internal/eventstore/v3"
 	"github.com/zitadel/zitadel/internal/execution"
+	"github.com/zitadel/zitadel/internal/grantexpiry"
 	"github.com/zitadel/zitadel/internal/i18n"
 	"github.com/zitadel/zitadel/internal/id"
 	"github.com/zitadel/zitadel/internal/integration/sink"
+	"github.com/zitadel/zitadel/internal/ldapsync"
 	"github.com/zitadel/zitadel/internal/logstore"
 	"github.com/zitadel/zitadel/internal/logstore/emitters/access"
 	emit_execution "github.com/zitadel/zitadel/internal/logstore/emitters/execution"
@@ -96,6 +100,7 @@ import (
 	"github.com/zitadel/zitadel/internal/logstore/record"
 	"github.com/zitadel/zitadel/internal/net"
 	"github.com/zitadel/zitadel/internal/notification"
+	"github.com/zitadel/zitadel/internal/provisioning"
 	"github.com/zitadel/zitadel/internal/query"
 	"github.com/zitadel/zitadel/internal/queue"
 	"github.com/zitadel/zitadel/internal/static"
@@ -208,7 +213,11 @@ func startZitadel(ctx context.Context, config *Config, masterKey string, server
 		return fmt.Errorf("cannot start queries: %w", err)
 	}
 
-	authZRepo, err := authz.Start(queries, eventstoreClient, dbClient, keys.OIDC, config.ExternalSecure)
+	dpopVerifier, err := dpop.StartVerifier(ctx, cacheConnectors)
+	if err != nil {
+		return fmt.Errorf("unable to start dpop replay cache: %w", err)
+	}
+	authZRepo, err := authz.Start(queries, eventstoreClient, dbClient, keys.OIDC, config.ExternalSecure, dpopVerifier)
 	if err != nil {
 		return fmt.Errorf("error starting authz repo: %w", err)
 	}
@@ -296,6 +305,7 @@ func startZitadel(ctx context.Context, config *Config, masterKey string, server
 		queries,
 		eventstoreClient,
 		config.Login.DefaultOTPEmailURLV2,
+		config.Login.DefaultBackChannelAuthURLV2,
 		config.SystemDefaults.Notifications.FileSystemPath,
 		keys.User,
 		keys.SMTP,
@@ -316,6 +326,40 @@ func startZitadel(ctx context.Context, config *Config, masterKey string, server
 	)
 	execution.Start(ctx)
 
+	provisioning.Register(
+		ctx,
+		config.Projections.Customizations["scim_provisioning_handler"],
+		config.SCIMProvisioning,
+		queries,
+		eventstoreClient,
+		q,
+	)
+	provisioning.Start(ctx)
+
+	ldapsync.Register(
+		config.LDAPGroupSync,
+		commands,
+		queries,
+		eventstoreClient,
+		q,
+	)
+
+	grantexpiry.Register(
+		config.UserGrantExpiry,
+		commands,
+		queries,
+		eventstoreClient,
+		q,
+	)
+
+	accessreviewexpiry.Register(
+		config.AccessReviewExpiry,
+		commands,
+		queries,
+		eventstoreClient,
+		q,
+	)
+
 	if err = q.Start(ctx); err != nil {
 		return err
 	}
@@ -336,6 +380,7 @@ func startZitadel(ctx context.Context, config *Config, masterKey string, server
 		config,
 		storage,
 		authZRepo,
+		dpopVerifier,
 		keys,
 		permissionCheck,
 		cacheConnectors,
@@ -381,6 +426,7 @@ func startAPIs(
 	config *Config,
 	store static.Storage,
 	authZRepo authz_repo.Repository,
+	dpopVerifier *dpop.Verifier,
 	keys *encryption.EncryptionKeys,
 	permissionCheck domain.PermissionCheck,
 	cacheConnectors connector.Connectors,
@@ -395,6 +441,7 @@ func startAPIs(
 	oidcPrefixes := []string{"/.well-known/openid-configuration", "/oidc/v1", "/oauth/v2"}
 	// always set the origin in the context if available in the http headers, no matter for what protocol
 	router.Use(middleware.WithOrigin(config.ExternalSecure, config.HTTP1HostHeader, config.HTTP2HostHeader, config.InstanceHostHeaders, config.PublicHostHeaders))
+	router.Use(middleware.WithClientCertificate(config.TLS.ClientCertificateHeader))
 	systemTokenVerifier, err := internal_authz.StartSystemTokenVerifierFromConfig(http_util.BuildHTTP(config.ExternalDomain, config.ExternalPort, config.ExternalSecure), config.SystemAPIUsers)
 	if err != nil {
 		return nil, err
@@ -557,6 +604,7 @@ func startAPIs(
 		config.Log.Slog(),
 		config.SystemDefaults.SecretHasher,
 		federatedLogoutsCache,
+		dpopVerifier,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("unable to start oidc provider: %w", err)
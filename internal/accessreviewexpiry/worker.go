@@ -0,0 +1,98 @@
+package accessreviewexpiry
+
+import (
+	"context"
+	"time"
+
+	"github.com/riverqueue/river"
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/queue/instanceworker"
+	"github.com/zitadel/zitadel/internal/repository/accessreview"
+)
+
+const (
+	QueueName = "access_review_expiry"
+
+	ExpiryUserID = "ACCESS-REVIEW-EXPIRY"
+)
+
+// Request asks the worker to close the access reviews which reached their deadline, in all instances.
+// The items which were not reviewed until the deadline are revoked.
+type Request struct{}
+
+func (r *Request) Kind() string {
+	return "access_review_expiry_request"
+}
+
+type Commands interface {
+	ExpireAccessReview(ctx context.Context, id, resourceOwner string) (*domain.ObjectDetails, error)
+}
+
+type Queries interface {
+	SearchAccessReviews(ctx context.Context, queries *query.AccessReviewSearchQueries) (*query.AccessReviews, error)
+}
+
+type Worker struct {
+	*instanceworker.Worker[*Request]
+
+	commands Commands
+	queries  Queries
+}
+
+type WorkerConfig struct {
+	// Interval in which the deadlines of the access reviews are checked.
+	// If set to 0, access reviews are not closed and unreviewed items are not revoked.
+	Interval            time.Duration
+	TransactionDuration time.Duration
+}
+
+func NewWorker(
+	config WorkerConfig,
+	commands Commands,
+	queries Queries,
+	es instanceworker.EventStore,
+) *Worker {
+	w := &Worker{
+		commands: commands,
+		queries:  queries,
+	}
+	w.Worker = instanceworker.NewWorker[*Request](QueueName, ExpiryUserID, config.TransactionDuration, es, instancesQuery, w.expireInstance)
+	return w
+}
+
+var _ river.Worker[*Request] = (*Worker)(nil)
+
+func instancesQuery() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsInstanceIDs).
+		OrderDesc().
+		AddQuery().
+		AggregateTypes(accessreview.AggregateType).
+		EventTypes(accessreview.StartedType).
+		Builder()
+}
+
+// expireInstance closes the access reviews of the instance which reached their deadline.
+func (w *Worker) expireInstance(ctx context.Context) error {
+	stateQuery, err := query.NewAccessReviewStateSearchQuery(domain.AccessReviewStateActive)
+	if err != nil {
+		return err
+	}
+	deadlineQuery, err := query.NewAccessReviewDeadlineSearchQuery(time.Now(), query.TimestampLessOrEquals)
+	if err != nil {
+		return err
+	}
+	reviews, err := w.queries.SearchAccessReviews(ctx, &query.AccessReviewSearchQueries{Queries: []query.SearchQuery{stateQuery, deadlineQuery}})
+	if err != nil {
+		return err
+	}
+	for _, review := range reviews.AccessReviews {
+		_, err = w.commands.ExpireAccessReview(ctx, review.ID, review.ResourceOwner)
+		logging.WithFields("instance", authz.GetInstance(ctx).InstanceID(), "review", review.ID).OnError(err).Warn("unable to expire access review")
+	}
+	return nil
+}
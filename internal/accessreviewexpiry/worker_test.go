@@ -0,0 +1,77 @@
+package accessreviewexpiry
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/queue/instanceworker"
+)
+
+type fakeQueries struct {
+	reviews map[string][]*query.AccessReview
+}
+
+func (f *fakeQueries) SearchAccessReviews(ctx context.Context, _ *query.AccessReviewSearchQueries) (*query.AccessReviews, error) {
+	return &query.AccessReviews{AccessReviews: f.reviews[authz.GetInstance(ctx).InstanceID()]}, nil
+}
+
+type fakeCommands struct {
+	failing string
+	expired []string
+}
+
+func (f *fakeCommands) ExpireAccessReview(ctx context.Context, id, resourceOwner string) (*domain.ObjectDetails, error) {
+	if id == f.failing {
+		return nil, errors.New("failed")
+	}
+	f.expired = append(f.expired, authz.GetInstance(ctx).InstanceID()+"/"+resourceOwner+"/"+id+"/"+authz.GetCtxData(ctx).UserID)
+	return &domain.ObjectDetails{}, nil
+}
+
+func TestWorker_expireInstance(t *testing.T) {
+	reviews := map[string][]*query.AccessReview{
+		"instance1": {
+			{ObjectDetails: domain.ObjectDetails{ID: "review1", ResourceOwner: "org1"}},
+			{ObjectDetails: domain.ObjectDetails{ID: "review2", ResourceOwner: "org2"}},
+		},
+		"instance2": {
+			{ObjectDetails: domain.ObjectDetails{ID: "review3", ResourceOwner: "org3"}},
+		},
+	}
+	tests := []struct {
+		name        string
+		failing     string
+		wantExpired []string
+	}{
+		{
+			name: "expired",
+			wantExpired: []string{
+				"instance1/org1/review1/" + ExpiryUserID,
+				"instance1/org2/review2/" + ExpiryUserID,
+			},
+		},
+		{
+			name:    "failure does not stop others",
+			failing: "review1",
+			wantExpired: []string{
+				"instance1/org2/review2/" + ExpiryUserID,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			commands := &fakeCommands{failing: tt.failing}
+			w := NewWorker(WorkerConfig{}, commands, &fakeQueries{reviews: reviews}, nil)
+			err := w.expireInstance(instanceworker.WithInstance(context.Background(), "instance1", ExpiryUserID))
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantExpired, commands.expired)
+		})
+	}
+}
@@ -46,6 +46,17 @@ func Test_extractBearerToken(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "dpop auth header set",
+			args: args{
+				ctx:   context.Background(),
+				token: "DPoP AUTH",
+				verifier: AccessTokenVerifierFunc(func(context.Context, string) (string, string, string, string, string, error) {
+					return "", "", "", "", "", nil
+				}),
+			},
+			wantErr: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -22,6 +22,7 @@ const (
 	dataKey               key = 2
 	allPermissionsKey     key = 3
 	instanceKey           key = 4
+	dpopRequestKey        key = 5
 )
 
 type CtxData struct {
@@ -189,6 +190,9 @@ func checkOrigin(ctx context.Context, origins []string) error {
 }
 
 func extractBearerToken(token string) (part string, err error) {
+	if dpopToken, ok := strings.CutPrefix(token, DPoPPrefix); ok && dpopToken != "" {
+		return dpopToken, nil
+	}
 	parts := strings.Split(token, BearerPrefix)
 	if len(parts) != 2 {
 		return "", zerrors.ThrowUnauthenticated(nil, "AUTH-toLo1", "invalid auth header")
@@ -0,0 +1,39 @@
+package authz
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/dpop"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	DPoPPrefix = dpop.TokenType + " "
+)
+
+type dpopRequest struct {
+	proof   string
+	request dpop.Request
+}
+
+// WithDPoPRequest stores the DPoP proof of the request and the request (method and url) it must be bound to,
+// so the binding of DPoP bound access tokens can be verified by [CheckDPoPBinding].
+func WithDPoPRequest(ctx context.Context, proof string, request dpop.Request) context.Context {
+	return context.WithValue(ctx, dpopRequestKey, &dpopRequest{proof: proof, request: request})
+}
+
+// CheckDPoPBinding ensures the request contains a valid DPoP proof of the key with the thumbprint jkt,
+// the access token is bound to.
+func CheckDPoPBinding(ctx context.Context, verifier *dpop.Verifier, accessToken, jkt string) error {
+	r, ok := ctx.Value(dpopRequestKey).(*dpopRequest)
+	if !ok || r.proof == "" {
+		return zerrors.ThrowUnauthenticated(nil, "AUTH-Oo4ah", "Errors.OIDCSession.DPoPProofInvalid")
+	}
+	request := r.request
+	request.AccessToken = accessToken
+	request.InstanceID = GetInstance(ctx).InstanceID()
+	if err := verifier.CheckBinding(ctx, r.proof, jkt, request); err != nil {
+		return zerrors.ThrowUnauthenticated(err, "AUTH-eiW0u", "Errors.OIDCSession.DPoPProofInvalid")
+	}
+	return nil
+}
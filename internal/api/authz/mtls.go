@@ -0,0 +1,17 @@
+package authz
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/mtls"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// CheckCertificateBinding ensures the request was sent over a mutual-TLS connection
+// with the client certificate (thumbprint x5t) the access token is bound to (RFC 8705).
+func CheckCertificateBinding(ctx context.Context, x5t string) error {
+	if err := mtls.CheckBinding(ctx, x5t); err != nil {
+		return zerrors.ThrowUnauthenticated(err, "AUTH-Iej5a", "Errors.OIDCSession.ClientCertificateInvalid")
+	}
+	return nil
+}
@@ -0,0 +1,207 @@
+// Package dpop verifies DPoP proofs as specified in RFC 9449,
+// OAuth 2.0 Demonstrating Proof of Possession (DPoP).
+package dpop
+
+import (
+	"context"
+	"crypto"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+)
+
+const (
+	// HeaderName is the name of the http header (and gRPC metadata) containing the proof.
+	HeaderName = "DPoP"
+	// TokenType is the token_type of DPoP bound access tokens and the scheme of the authorization header.
+	TokenType = "DPoP"
+
+	proofType = "dpop+jwt"
+
+	// MaxAge is the maximum age of a proof, based on its iat claim.
+	MaxAge = 5 * time.Minute
+	// ClockSkew is the tolerated deviation of the iat claim into the future.
+	ClockSkew = time.Minute
+)
+
+var (
+	ErrInvalidProof = errors.New("invalid DPoP proof")
+
+	// SupportedAlgorithms are the asymmetric signature algorithms allowed for proofs.
+	SupportedAlgorithms = []jose.SignatureAlgorithm{
+		jose.RS256, jose.RS384, jose.RS512,
+		jose.PS256, jose.PS384, jose.PS512,
+		jose.ES256, jose.ES384, jose.ES512,
+		jose.EdDSA,
+	}
+)
+
+// Request describes the request the proof was sent with.
+type Request struct {
+	// Method is the http method the proof must be bound to (htm claim).
+	Method string
+	// URL is the http uri the proof must be bound to (htu claim), query and fragment are ignored.
+	URL string
+	// AccessToken must be set when the proof is presented together with an access token (ath claim).
+	AccessToken string
+	// InstanceID is the instance the proof was sent to, which scopes the detection of replayed proofs (jti claim).
+	InstanceID string
+}
+
+// Proof contains the verified information of a DPoP proof.
+type Proof struct {
+	// JKT is the base64url encoded SHA-256 thumbprint of the public key of the proof,
+	// which is used as the confirmation (cnf.jkt) of bound tokens.
+	JKT      string
+	ID       string
+	IssuedAt time.Time
+}
+
+type claims struct {
+	ID              string `json:"jti"`
+	HTTPMethod      string `json:"htm"`
+	HTTPURI         string `json:"htu"`
+	IssuedAt        int64  `json:"iat"`
+	AccessTokenHash string `json:"ath,omitempty"`
+}
+
+// Verify parses the proof, checks its signature against the embedded public key
+// and validates the claims against the request.
+// Every proof is only accepted once, a replayed proof (same jti of the same key) is rejected.
+func (v *Verifier) Verify(ctx context.Context, proof string, request Request) (*Proof, error) {
+	now := time.Now()
+	verified, err := verify(proof, request, now)
+	if err != nil {
+		return nil, err
+	}
+	used := &UsedProof{
+		InstanceID: request.InstanceID,
+		JKT:        verified.JKT,
+		ID:         verified.ID,
+		Expiration: verified.IssuedAt.Add(MaxAge),
+	}
+	if !v.use(ctx, used, now) {
+		return nil, fmt.Errorf("%w: jti was already used", ErrInvalidProof)
+	}
+	return verified, nil
+}
+
+func verify(proof string, request Request, now time.Time) (*Proof, error) {
+	if proof == "" {
+		return nil, fmt.Errorf("%w: missing proof", ErrInvalidProof)
+	}
+	jws, err := jose.ParseSignedCompact(proof, SupportedAlgorithms)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidProof, err)
+	}
+	if len(jws.Signatures) != 1 {
+		return nil, fmt.Errorf("%w: exactly one signature expected", ErrInvalidProof)
+	}
+	header := jws.Signatures[0].Protected
+	if typ, _ := header.ExtraHeaders[jose.HeaderType].(string); typ != proofType {
+		return nil, fmt.Errorf("%w: typ must be %s", ErrInvalidProof, proofType)
+	}
+	key := header.JSONWebKey
+	if key == nil || !key.Valid() || !key.IsPublic() {
+		return nil, fmt.Errorf("%w: jwk must be a valid public key", ErrInvalidProof)
+	}
+	payload, err := jws.Verify(key)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidProof, err)
+	}
+	c := new(claims)
+	if err = json.Unmarshal(payload, c); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidProof, err)
+	}
+	if err = c.validate(request, now); err != nil {
+		return nil, err
+	}
+	jkt, err := Thumbprint(key)
+	if err != nil {
+		return nil, err
+	}
+	return &Proof{
+		JKT:      jkt,
+		ID:       c.ID,
+		IssuedAt: time.Unix(c.IssuedAt, 0),
+	}, nil
+}
+
+func (c *claims) validate(request Request, now time.Time) error {
+	if c.ID == "" {
+		return fmt.Errorf("%w: jti is missing", ErrInvalidProof)
+	}
+	if c.HTTPMethod != request.Method {
+		return fmt.Errorf("%w: htm does not match", ErrInvalidProof)
+	}
+	if !matchURI(c.HTTPURI, request.URL) {
+		return fmt.Errorf("%w: htu does not match", ErrInvalidProof)
+	}
+	issuedAt := time.Unix(c.IssuedAt, 0)
+	if c.IssuedAt == 0 || issuedAt.Before(now.Add(-MaxAge)) || issuedAt.After(now.Add(ClockSkew)) {
+		return fmt.Errorf("%w: iat is outside the accepted window", ErrInvalidProof)
+	}
+	if request.AccessToken == "" {
+		return nil
+	}
+	if subtle.ConstantTimeCompare([]byte(c.AccessTokenHash), []byte(AccessTokenHash(request.AccessToken))) != 1 {
+		return fmt.Errorf("%w: ath does not match", ErrInvalidProof)
+	}
+	return nil
+}
+
+// matchURI compares the htu claim with the uri of the request without query and fragment,
+// scheme and host are compared case-insensitive.
+func matchURI(htu, uri string) bool {
+	claimed, err := url.Parse(htu)
+	if err != nil {
+		return false
+	}
+	requested, err := url.Parse(uri)
+	if err != nil {
+		return false
+	}
+	return strings.EqualFold(claimed.Scheme, requested.Scheme) &&
+		strings.EqualFold(claimed.Host, requested.Host) &&
+		claimed.Path == requested.Path
+}
+
+// Thumbprint returns the base64url encoded SHA-256 JWK thumbprint (RFC 7638) of the key.
+func Thumbprint(key *jose.JSONWebKey) (string, error) {
+	thumbprint, err := key.Thumbprint(crypto.SHA256)
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrInvalidProof, err)
+	}
+	return base64.RawURLEncoding.EncodeToString(thumbprint), nil
+}
+
+// AccessTokenHash returns the base64url encoded SHA-256 hash of the access token (ath claim).
+func AccessTokenHash(accessToken string) string {
+	hash := sha256.Sum256([]byte(accessToken))
+	return base64.RawURLEncoding.EncodeToString(hash[:])
+}
+
+// Confirmation returns the cnf claim of tokens bound to the key with the thumbprint jkt.
+func Confirmation(jkt string) map[string]any {
+	return map[string]any{"jkt": jkt}
+}
+
+// CheckBinding verifies the proof and ensures it was created with the key the access token is bound to.
+func (v *Verifier) CheckBinding(ctx context.Context, proof, jkt string, request Request) error {
+	verified, err := v.Verify(ctx, proof, request)
+	if err != nil {
+		return err
+	}
+	if subtle.ConstantTimeCompare([]byte(verified.JKT), []byte(jkt)) != 1 {
+		return fmt.Errorf("%w: key does not match the token binding", ErrInvalidProof)
+	}
+	return nil
+}
@@ -0,0 +1,271 @@
+package dpop
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/cache"
+	"github.com/zitadel/zitadel/internal/cache/connector/gomap"
+)
+
+func newTestReplays() cache.Cache[ReplayIndex, string, *UsedProof] {
+	return gomap.NewCache[ReplayIndex, string, *UsedProof](context.Background(), []ReplayIndex{ReplayIndexProof}, cache.Config{MaxAge: MaxAge + ClockSkew})
+}
+
+func Test_verify(t *testing.T) {
+	now := time.Now()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	jkt, err := Thumbprint(&jose.JSONWebKey{Key: key.Public()})
+	require.NoError(t, err)
+
+	validClaims := func() map[string]any {
+		return map[string]any{
+			"jti": "id",
+			"htm": "POST",
+			"htu": "https://issuer.com/oauth/v2/token",
+			"iat": now.Unix(),
+		}
+	}
+	request := Request{
+		Method: "POST",
+		URL:    "https://issuer.com/oauth/v2/token",
+	}
+
+	tests := []struct {
+		name    string
+		proof   string
+		request Request
+		want    *Proof
+		wantErr bool
+	}{
+		{
+			name:    "missing proof",
+			request: request,
+			wantErr: true,
+		},
+		{
+			name:    "invalid typ",
+			proof:   signProof(t, key, "JWT", true, validClaims()),
+			request: request,
+			wantErr: true,
+		},
+		{
+			name:    "missing jwk",
+			proof:   signProof(t, key, proofType, false, validClaims()),
+			request: request,
+			wantErr: true,
+		},
+		{
+			name: "missing jti",
+			proof: signProof(t, key, proofType, true, func() map[string]any {
+				c := validClaims()
+				delete(c, "jti")
+				return c
+			}()),
+			request: request,
+			wantErr: true,
+		},
+		{
+			name:  "htm mismatch",
+			proof: signProof(t, key, proofType, true, validClaims()),
+			request: Request{
+				Method: "GET",
+				URL:    request.URL,
+			},
+			wantErr: true,
+		},
+		{
+			name:  "htu mismatch",
+			proof: signProof(t, key, proofType, true, validClaims()),
+			request: Request{
+				Method: request.Method,
+				URL:    "https://issuer.com/oidc/v1/userinfo",
+			},
+			wantErr: true,
+		},
+		{
+			name: "iat expired",
+			proof: signProof(t, key, proofType, true, func() map[string]any {
+				c := validClaims()
+				c["iat"] = now.Add(-MaxAge - time.Minute).Unix()
+				return c
+			}()),
+			request: request,
+			wantErr: true,
+		},
+		{
+			name: "iat in future",
+			proof: signProof(t, key, proofType, true, func() map[string]any {
+				c := validClaims()
+				c["iat"] = now.Add(ClockSkew + time.Minute).Unix()
+				return c
+			}()),
+			request: request,
+			wantErr: true,
+		},
+		{
+			name:  "ath missing",
+			proof: signProof(t, key, proofType, true, validClaims()),
+			request: Request{
+				Method:      request.Method,
+				URL:         request.URL,
+				AccessToken: "token",
+			},
+			wantErr: true,
+		},
+		{
+			name: "ath mismatch",
+			proof: signProof(t, key, proofType, true, func() map[string]any {
+				c := validClaims()
+				c["ath"] = AccessTokenHash("other")
+				return c
+			}()),
+			request: Request{
+				Method:      request.Method,
+				URL:         request.URL,
+				AccessToken: "token",
+			},
+			wantErr: true,
+		},
+		{
+			name:    "valid",
+			proof:   signProof(t, key, proofType, true, validClaims()),
+			request: request,
+			want: &Proof{
+				JKT:      jkt,
+				ID:       "id",
+				IssuedAt: time.Unix(now.Unix(), 0),
+			},
+		},
+		{
+			name:  "valid, ignore query",
+			proof: signProof(t, key, proofType, true, validClaims()),
+			request: Request{
+				Method: request.Method,
+				URL:    "https://ISSUER.com/oauth/v2/token?foo=bar",
+			},
+			want: &Proof{
+				JKT:      jkt,
+				ID:       "id",
+				IssuedAt: time.Unix(now.Unix(), 0),
+			},
+		},
+		{
+			name: "valid with access token",
+			proof: signProof(t, key, proofType, true, func() map[string]any {
+				c := validClaims()
+				c["ath"] = AccessTokenHash("token")
+				return c
+			}()),
+			request: Request{
+				Method:      request.Method,
+				URL:         request.URL,
+				AccessToken: "token",
+			},
+			want: &Proof{
+				JKT:      jkt,
+				ID:       "id",
+				IssuedAt: time.Unix(now.Unix(), 0),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := verify(tt.proof, tt.request, now)
+			if tt.wantErr {
+				require.ErrorIs(t, err, ErrInvalidProof)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCheckBinding(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	jkt, err := Thumbprint(&jose.JSONWebKey{Key: key.Public()})
+	require.NoError(t, err)
+	newProof := func(jti string) string {
+		return signProof(t, key, proofType, true, map[string]any{
+			"jti": jti,
+			"htm": "GET",
+			"htu": "https://issuer.com/oidc/v1/userinfo",
+			"iat": time.Now().Unix(),
+			"ath": AccessTokenHash("token"),
+		})
+	}
+	request := Request{
+		Method:      "GET",
+		URL:         "https://issuer.com/oidc/v1/userinfo",
+		AccessToken: "token",
+		InstanceID:  "instanceID",
+	}
+
+	ctx := context.Background()
+	replays := newTestReplays()
+	verifier := NewVerifier(replays)
+
+	proof := newProof("id")
+	assert.NoError(t, verifier.CheckBinding(ctx, proof, jkt, request))
+	assert.ErrorIs(t, verifier.CheckBinding(ctx, proof, jkt, request), ErrInvalidProof, "replayed proof")
+	assert.ErrorIs(t, NewVerifier(replays).CheckBinding(ctx, proof, jkt, request), ErrInvalidProof, "replayed proof to other verifier of the shared cache")
+	assert.ErrorIs(t, verifier.CheckBinding(ctx, newProof("id2"), "other", request), ErrInvalidProof)
+}
+
+func TestVerifier_use(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	proof := func(instanceID, jkt string, expiration time.Time) *UsedProof {
+		return &UsedProof{InstanceID: instanceID, JKT: jkt, ID: "id", Expiration: expiration}
+	}
+	v := NewVerifier(newTestReplays())
+
+	assert.True(t, v.use(ctx, proof("instanceID", "jkt", now.Add(MaxAge)), now))
+	assert.False(t, v.use(ctx, proof("instanceID", "jkt", now.Add(MaxAge)), now.Add(time.Minute)), "replay within max age")
+	assert.True(t, v.use(ctx, proof("instanceID2", "jkt", now.Add(MaxAge)), now), "other instance")
+	assert.True(t, v.use(ctx, proof("instanceID", "jkt2", now.Add(MaxAge)), now), "other key")
+
+	later := now.Add(MaxAge)
+	assert.True(t, v.use(ctx, proof("instanceID", "jkt", later.Add(MaxAge)), later), "expired proof")
+	assert.False(t, v.use(ctx, proof("instanceID", "jkt", later.Add(MaxAge)), later), "replay of renewed proof")
+}
+
+func TestForwardedRequest(t *testing.T) {
+	method, path, ok := ForwardedRequest(ForwardRequest("GET", "/v2/users/123"))
+	assert.True(t, ok)
+	assert.Equal(t, "GET", method)
+	assert.Equal(t, "/v2/users/123", path)
+
+	_, _, ok = ForwardedRequest("R0VUIC92Mi91c2Vycy8xMjM.c2lnbmF0dXJl")
+	assert.False(t, ok, "not authenticated")
+	_, _, ok = ForwardedRequest("GET /v2/users/123")
+	assert.False(t, ok, "malformed")
+}
+
+func signProof(t *testing.T, key *ecdsa.PrivateKey, typ string, embedKey bool, claims map[string]any) string {
+	options := (&jose.SignerOptions{}).WithType(jose.ContentType(typ))
+	if embedKey {
+		options.EmbedJWK = true
+	}
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.ES256, Key: key}, options)
+	require.NoError(t, err)
+	payload, err := json.Marshal(claims)
+	require.NoError(t, err)
+	jws, err := signer.Sign(payload)
+	require.NoError(t, err)
+	proof, err := jws.CompactSerialize()
+	require.NoError(t, err)
+	return proof
+}
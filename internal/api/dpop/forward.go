@@ -0,0 +1,60 @@
+package dpop
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"strings"
+)
+
+// ForwardedRequestHeader is used by the gateway to pass the http method and path of a REST request
+// to the gRPC server, where they are required to verify the binding of a proof, see [ForwardRequest].
+const ForwardedRequestHeader = "x-zitadel-dpop-request"
+
+// forwardingKey authenticates requests forwarded within the same process.
+var forwardingKey = newForwardingKey()
+
+// ForwardRequest returns the value to pass the http method and path of a REST request
+// from the gateway to the gRPC server of the same process.
+// The value is authenticated with a key only known to the process, so it cannot be set by clients.
+func ForwardRequest(method, path string) string {
+	encoded := base64.RawURLEncoding.EncodeToString([]byte(method + " " + path))
+	return encoded + "." + base64.RawURLEncoding.EncodeToString(forwardingMAC(encoded))
+}
+
+// ForwardedRequest returns the http method and path of a value created by [ForwardRequest].
+// If the value was not created by the gateway of this process, ok is false.
+func ForwardedRequest(value string) (method, path string, ok bool) {
+	encoded, mac, found := strings.Cut(value, ".")
+	if !found {
+		return "", "", false
+	}
+	decodedMAC, err := base64.RawURLEncoding.DecodeString(mac)
+	if err != nil || !hmac.Equal(decodedMAC, forwardingMAC(encoded)) {
+		return "", "", false
+	}
+	request, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return "", "", false
+	}
+	method, path, ok = strings.Cut(string(request), " ")
+	if !ok || method == "" || path == "" {
+		return "", "", false
+	}
+	return method, path, true
+}
+
+func forwardingMAC(value string) []byte {
+	mac := hmac.New(sha256.New, forwardingKey)
+	mac.Write([]byte(value))
+	return mac.Sum(nil)
+}
+
+func newForwardingKey() []byte {
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		panic(err)
+	}
+	return key
+}
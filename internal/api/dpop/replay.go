@@ -0,0 +1,66 @@
+package dpop
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/cache"
+	"github.com/zitadel/zitadel/internal/cache/connector"
+)
+
+type ReplayIndex int
+
+const (
+	ReplayIndexUnspecified ReplayIndex = iota
+	ReplayIndexProof
+)
+
+// UsedProof is stored in the replay cache for every verified proof until it expires.
+type UsedProof struct {
+	InstanceID string
+	JKT        string
+	ID         string
+	Expiration time.Time
+}
+
+// Keys implements cache.Entry
+func (p *UsedProof) Keys(i ReplayIndex) []string {
+	if i == ReplayIndexProof {
+		return []string{replayKey(p.InstanceID, p.JKT, p.ID)}
+	}
+	return nil
+}
+
+func replayKey(instanceID, jkt, jti string) string {
+	return instanceID + "-" + jkt + "-" + jti
+}
+
+// Verifier verifies DPoP proofs and detects replayed proofs (same jti of the same key) within an instance.
+// The used proofs are kept in the cache until they expire,
+// a cache shared by all processes (e.g. postgres or redis) detects replays across them.
+type Verifier struct {
+	replays cache.Cache[ReplayIndex, string, *UsedProof]
+}
+
+func NewVerifier(replays cache.Cache[ReplayIndex, string, *UsedProof]) *Verifier {
+	return &Verifier{replays: replays}
+}
+
+// StartVerifier starts the replay cache configured as DPoPProofs.
+func StartVerifier(background context.Context, connectors connector.Connectors) (*Verifier, error) {
+	replays, err := connector.StartCache[ReplayIndex, string, *UsedProof](background, []ReplayIndex{ReplayIndexProof}, cache.PurposeDPoPProof, connectors.Config.DPoPProofs, connectors)
+	if err != nil {
+		return nil, err
+	}
+	return NewVerifier(replays), nil
+}
+
+// use records the proof until its expiration and returns false if it was already used before.
+func (v *Verifier) use(ctx context.Context, proof *UsedProof, now time.Time) bool {
+	used, ok := v.replays.Get(ctx, ReplayIndexProof, replayKey(proof.InstanceID, proof.JKT, proof.ID))
+	if ok && now.Before(used.Expiration) {
+		return false
+	}
+	v.replays.Set(ctx, proof)
+	return true
+}
@@ -94,6 +94,15 @@ func targetToPb(t *query.Target) *action.Target {
 		target.TargetType = &action.Target_RestCall{RestCall: &action.RESTCall{InterruptOnError: t.InterruptOnError}}
 	case domain.TargetTypeAsync:
 		target.TargetType = &action.Target_RestAsync{RestAsync: &action.RESTAsync{}}
+	case domain.TargetTypeGRPC:
+		grpcCall := &action.GRPCCall{InterruptOnError: t.InterruptOnError}
+		if len(t.ClientCertificate) > 0 || len(t.RootCA) > 0 {
+			grpcCall.Tls = &action.GRPCTLS{
+				ClientCertificate: t.ClientCertificate,
+				RootCa:            t.RootCA,
+			}
+		}
+		target.TargetType = &action.Target_GrpcCall{GrpcCall: grpcCall}
 	default:
 		target.TargetType = nil
 	}
@@ -67,6 +67,7 @@ func createTargetToCommand(req *action.CreateTargetRequest) *command.AddTarget {
 	var (
 		targetType       domain.TargetType
 		interruptOnError bool
+		tls              *command.TargetTLS
 	)
 	switch t := req.GetTargetType().(type) {
 	case *action.CreateTargetRequest_RestWebhook:
@@ -77,6 +78,10 @@ func createTargetToCommand(req *action.CreateTargetRequest) *command.AddTarget {
 		interruptOnError = t.RestCall.InterruptOnError
 	case *action.CreateTargetRequest_RestAsync:
 		targetType = domain.TargetTypeAsync
+	case *action.CreateTargetRequest_GrpcCall:
+		targetType = domain.TargetTypeGRPC
+		interruptOnError = t.GrpcCall.InterruptOnError
+		tls = targetTLSToCommand(t.GrpcCall.GetTls())
 	}
 	return &command.AddTarget{
 		Name:             req.GetName(),
@@ -84,6 +89,7 @@ func createTargetToCommand(req *action.CreateTargetRequest) *command.AddTarget {
 		Endpoint:         req.GetEndpoint(),
 		Timeout:          req.GetTimeout().AsDuration(),
 		InterruptOnError: interruptOnError,
+		TLS:              tls,
 	}
 }
 
@@ -116,6 +122,14 @@ func updateTargetToCommand(req *action.UpdateTargetRequest) *command.ChangeTarge
 		case *action.UpdateTargetRequest_RestAsync:
 			target.TargetType = gu.Ptr(domain.TargetTypeAsync)
 			target.InterruptOnError = gu.Ptr(false)
+		case *action.UpdateTargetRequest_GrpcCall:
+			target.TargetType = gu.Ptr(domain.TargetTypeGRPC)
+			target.InterruptOnError = gu.Ptr(t.GrpcCall.InterruptOnError)
+			// the TLS configuration is replaced as a whole, an empty configuration removes it
+			target.TLS = targetTLSToCommand(t.GrpcCall.GetTls())
+			if target.TLS == nil {
+				target.TLS = new(command.TargetTLS)
+			}
 		}
 	}
 	if req.Timeout != nil {
@@ -123,3 +137,14 @@ func updateTargetToCommand(req *action.UpdateTargetRequest) *command.ChangeTarge
 	}
 	return target
 }
+
+func targetTLSToCommand(tls *action.GRPCTLS) *command.TargetTLS {
+	if tls == nil {
+		return nil
+	}
+	return &command.TargetTLS{
+		ClientCertificate: tls.GetClientCertificate(),
+		ClientKey:         tls.GetClientKey(),
+		RootCA:            tls.GetRootCa(),
+	}
+}
@@ -88,6 +88,36 @@ func Test_createTargetToCommand(t *testing.T) {
 				InterruptOnError: true,
 			},
 		},
+		{
+			name: "all fields (grpc)",
+			args: args{&action.CreateTargetRequest{
+				Name:     "target 1",
+				Endpoint: "https://example.com",
+				TargetType: &action.CreateTargetRequest_GrpcCall{
+					GrpcCall: &action.GRPCCall{
+						InterruptOnError: true,
+						Tls: &action.GRPCTLS{
+							ClientCertificate: []byte("certificate"),
+							ClientKey:         []byte("key"),
+							RootCa:            []byte("root"),
+						},
+					},
+				},
+				Timeout: durationpb.New(10 * time.Second),
+			}},
+			want: &command.AddTarget{
+				Name:             "target 1",
+				TargetType:       domain.TargetTypeGRPC,
+				Endpoint:         "https://example.com",
+				Timeout:          10 * time.Second,
+				InterruptOnError: true,
+				TLS: &command.TargetTLS{
+					ClientCertificate: []byte("certificate"),
+					ClientKey:         []byte("key"),
+					RootCA:            []byte("root"),
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -219,6 +249,19 @@ func Test_updateTargetToCommand(t *testing.T) {
 				InterruptOnError: gu.Ptr(true),
 			},
 		},
+		{
+			name: "grpc without tls",
+			args: args{&action.UpdateTargetRequest{
+				TargetType: &action.UpdateTargetRequest_GrpcCall{
+					GrpcCall: &action.GRPCCall{},
+				},
+			}},
+			want: &command.ChangeTarget{
+				TargetType:       gu.Ptr(domain.TargetTypeGRPC),
+				InterruptOnError: gu.Ptr(false),
+				TLS:              &command.TargetTLS{},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
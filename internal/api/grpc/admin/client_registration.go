@@ -0,0 +1,72 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	admin_pb "github.com/zitadel/zitadel/pkg/grpc/admin"
+)
+
+func (s *Server) GetClientRegistrationConfig(ctx context.Context, _ *admin_pb.GetClientRegistrationConfigRequest) (*admin_pb.GetClientRegistrationConfigResponse, error) {
+	config, err := s.query.ClientRegistrationConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.GetClientRegistrationConfigResponse{
+		Details:                  object.ToViewDetailsPb(config.Sequence, config.CreationDate, config.ChangeDate, config.ResourceOwner),
+		ProjectId:                config.ProjectID,
+		SoftwareStatementIssuers: softwareStatementIssuersToPb(config.SoftwareStatementIssuers),
+	}, nil
+}
+
+func (s *Server) SetClientRegistrationConfig(ctx context.Context, req *admin_pb.SetClientRegistrationConfigRequest) (*admin_pb.SetClientRegistrationConfigResponse, error) {
+	details, err := s.command.SetClientRegistrationConfig(ctx, setClientRegistrationConfigToCommand(req))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.SetClientRegistrationConfigResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) RemoveClientRegistrationConfig(ctx context.Context, _ *admin_pb.RemoveClientRegistrationConfigRequest) (*admin_pb.RemoveClientRegistrationConfigResponse, error) {
+	details, err := s.command.RemoveClientRegistrationConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.RemoveClientRegistrationConfigResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) ListClientRegistrationInitialAccessTokens(ctx context.Context, _ *admin_pb.ListClientRegistrationInitialAccessTokensRequest) (*admin_pb.ListClientRegistrationInitialAccessTokensResponse, error) {
+	tokens, err := s.query.ClientRegistrationInitialAccessTokens(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.ListClientRegistrationInitialAccessTokensResponse{
+		Result: initialAccessTokensToPb(tokens),
+	}, nil
+}
+
+func (s *Server) AddClientRegistrationInitialAccessToken(ctx context.Context, req *admin_pb.AddClientRegistrationInitialAccessTokenRequest) (*admin_pb.AddClientRegistrationInitialAccessTokenResponse, error) {
+	token, err := s.command.AddClientRegistrationInitialAccessToken(ctx, req.GetDescription(), req.GetExpirationDate().AsTime())
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.AddClientRegistrationInitialAccessTokenResponse{
+		TokenId: token.ID,
+		Token:   token.Token,
+		Details: object.DomainToAddDetailsPb(token.Details),
+	}, nil
+}
+
+func (s *Server) RemoveClientRegistrationInitialAccessToken(ctx context.Context, req *admin_pb.RemoveClientRegistrationInitialAccessTokenRequest) (*admin_pb.RemoveClientRegistrationInitialAccessTokenResponse, error) {
+	details, err := s.command.RemoveClientRegistrationInitialAccessToken(ctx, req.GetTokenId())
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.RemoveClientRegistrationInitialAccessTokenResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
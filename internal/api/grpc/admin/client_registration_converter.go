@@ -0,0 +1,48 @@
+package admin
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/repository/clientregistration"
+	admin_pb "github.com/zitadel/zitadel/pkg/grpc/admin"
+)
+
+func setClientRegistrationConfigToCommand(req *admin_pb.SetClientRegistrationConfigRequest) *command.ClientRegistrationConfig {
+	issuers := make([]*clientregistration.SoftwareStatementIssuer, len(req.GetSoftwareStatementIssuers()))
+	for i, issuer := range req.GetSoftwareStatementIssuers() {
+		issuers[i] = &clientregistration.SoftwareStatementIssuer{
+			Issuer: issuer.GetIssuer(),
+			JWKS:   issuer.GetJwks(),
+		}
+	}
+	return &command.ClientRegistrationConfig{
+		ProjectID:                req.GetProjectId(),
+		SoftwareStatementIssuers: issuers,
+	}
+}
+
+func softwareStatementIssuersToPb(issuers []*clientregistration.SoftwareStatementIssuer) []*admin_pb.SoftwareStatementIssuer {
+	result := make([]*admin_pb.SoftwareStatementIssuer, len(issuers))
+	for i, issuer := range issuers {
+		result[i] = &admin_pb.SoftwareStatementIssuer{
+			Issuer: issuer.Issuer,
+			Jwks:   issuer.JWKS,
+		}
+	}
+	return result
+}
+
+func initialAccessTokensToPb(tokens []*query.ClientRegistrationInitialAccessToken) []*admin_pb.ClientRegistrationInitialAccessToken {
+	result := make([]*admin_pb.ClientRegistrationInitialAccessToken, len(tokens))
+	for i, token := range tokens {
+		result[i] = &admin_pb.ClientRegistrationInitialAccessToken{
+			Id:             token.ID,
+			Description:    token.Description,
+			CreationDate:   timestamppb.New(token.CreationDate),
+			ExpirationDate: timestamppb.New(token.ExpirationDate),
+		}
+	}
+	return result
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/query"
 	admin_pb "github.com/zitadel/zitadel/pkg/grpc/admin"
 )
 
@@ -27,6 +28,20 @@ func (s *Server) ListEvents(ctx context.Context, in *admin_pb.ListEventsRequest)
 	return admin_pb.EventsToPb(ctx, events)
 }
 
+func (s *Server) StreamEvents(in *admin_pb.StreamEventsRequest, stream admin_pb.AdminService_StreamEventsServer) error {
+	streamQuery, err := streamEventsRequestToQuery(in)
+	if err != nil {
+		return err
+	}
+	return s.query.StreamEvents(stream.Context(), streamQuery, func(event *query.Event, cursor query.EventStreamCursor) error {
+		resp, err := admin_pb.StreamEventToPb(event, cursor.String())
+		if err != nil {
+			return err
+		}
+		return stream.Send(resp)
+	})
+}
+
 func (s *Server) ListEventTypes(ctx context.Context, in *admin_pb.ListEventTypesRequest) (*admin_pb.ListEventTypesResponse, error) {
 	eventTypes := s.query.SearchEventTypes(ctx)
 	return admin_pb.EventTypesToPb(eventTypes), nil
@@ -109,6 +124,31 @@ func eventRequestToFilter(ctx context.Context, req *admin_pb.ListEventsRequest)
 	return builder, nil
 }
 
+func streamEventsRequestToQuery(req *admin_pb.StreamEventsRequest) (*query.EventStreamQuery, error) {
+	cursor, err := query.ParseEventStreamCursor(req.Cursor)
+	if err != nil {
+		return nil, err
+	}
+	eventTypes := make([]eventstore.EventType, len(req.EventTypes))
+	for i, eventType := range req.EventTypes {
+		eventTypes[i] = eventstore.EventType(eventType)
+	}
+	aggregateTypes := make([]eventstore.AggregateType, len(req.AggregateTypes))
+	for i, aggregateType := range req.AggregateTypes {
+		aggregateTypes[i] = eventstore.AggregateType(aggregateType)
+	}
+	if len(aggregateTypes) == 0 {
+		aggregateTypes = aggregateTypesFromEventTypes(eventTypes)
+	}
+	slices.Sort(aggregateTypes)
+	return &query.EventStreamQuery{
+		AggregateTypes: slices.Compact(aggregateTypes),
+		EventTypes:     eventTypes,
+		ResourceOwner:  req.ResourceOwner,
+		From:           cursor,
+	}, nil
+}
+
 func aggregateTypesFromEventTypes(eventTypes []eventstore.EventType) []eventstore.AggregateType {
 	aggregateTypes := make([]eventstore.AggregateType, 0, len(eventTypes))
 
@@ -430,6 +430,7 @@ func addLDAPProviderToCommand(req *admin_pb.AddLDAPProviderRequest) command.LDAP
 		RootCA:            req.RootCa,
 		LDAPAttributes:    idp_grpc.LDAPAttributesToCommand(req.Attributes),
 		IDPOptions:        idp_grpc.OptionsToCommand(req.ProviderOptions),
+		GroupSync:         idp_grpc.LDAPGroupSyncToCommand(req.GroupSync),
 	}
 }
 
@@ -448,6 +449,7 @@ func updateLDAPProviderToCommand(req *admin_pb.UpdateLDAPProviderRequest) comman
 		LDAPAttributes:    idp_grpc.LDAPAttributesToCommand(req.Attributes),
 		IDPOptions:        idp_grpc.OptionsToCommand(req.ProviderOptions),
 		RootCA:            req.RootCa,
+		GroupSync:         idp_grpc.LDAPGroupSyncToCommand(req.GroupSync),
 	}
 }
 
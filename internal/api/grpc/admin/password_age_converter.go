@@ -9,5 +9,7 @@ func UpdatePasswordAgePolicyToDomain(policy *admin_pb.UpdatePasswordAgePolicyReq
 	return &domain.PasswordAgePolicy{
 		MaxAgeDays:     uint64(policy.MaxAgeDays),
 		ExpireWarnDays: uint64(policy.ExpireWarnDays),
+		HistoryCount:   uint64(policy.HistoryCount),
+		MinAgeDays:     uint64(policy.MinAgeDays),
 	}
 }
@@ -0,0 +1,57 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/api/grpc/user"
+	"github.com/zitadel/zitadel/internal/query"
+	auth_pb "github.com/zitadel/zitadel/pkg/grpc/auth"
+)
+
+func (s *Server) ListMyAccessReviewItems(ctx context.Context, req *auth_pb.ListMyAccessReviewItemsRequest) (*auth_pb.ListMyAccessReviewItemsResponse, error) {
+	queries, err := ListMyAccessReviewItemsRequestToQuery(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	items, err := s.query.SearchAccessReviewItems(ctx, queries)
+	if err != nil {
+		return nil, err
+	}
+	return &auth_pb.ListMyAccessReviewItemsResponse{
+		Result:  user.AccessReviewItemsToPb(items.Items),
+		Details: object.ToListDetails(items.Count, items.Sequence, items.LastRun),
+	}, nil
+}
+
+func (s *Server) DecideMyAccessReviewItem(ctx context.Context, req *auth_pb.DecideMyAccessReviewItemRequest) (*auth_pb.DecideMyAccessReviewItemResponse, error) {
+	details, err := s.command.DecideAccessReviewItem(ctx, req.ReviewId, "", req.ItemId, user.AccessReviewDecisionToDomain(req.Decision), req.Comment)
+	if err != nil {
+		return nil, err
+	}
+	return &auth_pb.DecideMyAccessReviewItemResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+// ListMyAccessReviewItemsRequestToQuery restricts the search to the items assigned to the authenticated user.
+func ListMyAccessReviewItemsRequestToQuery(ctx context.Context, req *auth_pb.ListMyAccessReviewItemsRequest) (*query.AccessReviewItemSearchQueries, error) {
+	offset, limit, asc := object.ListQueryToModel(req.Query)
+	queries, err := user.AccessReviewItemQueriesToModel(req.Queries)
+	if err != nil {
+		return nil, err
+	}
+	reviewerQuery, err := query.NewAccessReviewItemReviewerIDSearchQuery(authz.GetCtxData(ctx).UserID)
+	if err != nil {
+		return nil, err
+	}
+	return &query.AccessReviewItemSearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset: offset,
+			Limit:  limit,
+			Asc:    asc,
+		},
+		Queries: append(queries, reviewerQuery),
+	}, nil
+}
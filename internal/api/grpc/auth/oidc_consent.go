@@ -0,0 +1,77 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/query"
+	auth_pb "github.com/zitadel/zitadel/pkg/grpc/auth"
+)
+
+func (s *Server) ListMyOIDCConsents(ctx context.Context, req *auth_pb.ListMyOIDCConsentsRequest) (*auth_pb.ListMyOIDCConsentsResponse, error) {
+	queries, err := ListMyOIDCConsentsRequestToQuery(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	res, err := s.query.SearchOIDCConsents(ctx, queries)
+	if err != nil {
+		return nil, err
+	}
+	return &auth_pb.ListMyOIDCConsentsResponse{
+		Result:  OIDCConsentsToPb(res.OIDCConsents),
+		Details: object.ToListDetails(res.Count, res.Sequence, res.LastRun),
+	}, nil
+}
+
+func (s *Server) RevokeMyOIDCConsent(ctx context.Context, req *auth_pb.RevokeMyOIDCConsentRequest) (*auth_pb.RevokeMyOIDCConsentResponse, error) {
+	details, err := s.command.RevokeOIDCConsent(ctx, authz.GetCtxData(ctx).UserID, req.Id, s.oidcSessionIDs)
+	if err != nil {
+		return nil, err
+	}
+	return &auth_pb.RevokeMyOIDCConsentResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) oidcSessionIDs(ctx context.Context, userID, clientID string) ([]string, error) {
+	return s.query.OIDCSessionIDsByUserAndClientID(ctx, true, userID, clientID)
+}
+
+func ListMyOIDCConsentsRequestToQuery(ctx context.Context, req *auth_pb.ListMyOIDCConsentsRequest) (*query.OIDCConsentSearchQueries, error) {
+	offset, limit, asc := object.ListQueryToModel(req.Query)
+	userIDQuery, err := query.NewOIDCConsentUserIDSearchQuery(authz.GetCtxData(ctx).UserID)
+	if err != nil {
+		return nil, err
+	}
+	return &query.OIDCConsentSearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset: offset,
+			Limit:  limit,
+			Asc:    asc,
+		},
+		Queries: []query.SearchQuery{
+			userIDQuery,
+		},
+	}, nil
+}
+
+func OIDCConsentsToPb(consents []*query.OIDCConsent) []*auth_pb.OIDCConsent {
+	result := make([]*auth_pb.OIDCConsent, len(consents))
+	for i, consent := range consents {
+		result[i] = OIDCConsentToPb(consent)
+	}
+	return result
+}
+
+func OIDCConsentToPb(consent *query.OIDCConsent) *auth_pb.OIDCConsent {
+	return &auth_pb.OIDCConsent{
+		Id:        consent.ID,
+		Details:   object.ToViewDetailsPb(consent.Sequence, consent.CreationDate, consent.EventDate, consent.ResourceOwner),
+		ClientId:  consent.ClientID,
+		AppId:     consent.AppID,
+		AppName:   consent.AppName,
+		ProjectId: consent.ProjectID,
+		Scopes:    consent.Scope,
+	}
+}
@@ -2,11 +2,15 @@ package grpc
 
 import (
 	"context"
+	net_http "net/http"
 
 	"github.com/grpc-ecosystem/go-grpc-middleware/util/metautils"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc/metadata"
 
+	"github.com/zitadel/zitadel/internal/api/dpop"
 	"github.com/zitadel/zitadel/internal/api/http"
+	"github.com/zitadel/zitadel/internal/api/mtls"
 )
 
 func GetHeader(ctx context.Context, headername string) string {
@@ -20,3 +24,38 @@ func GetGatewayHeader(ctx context.Context, headername string) string {
 func GetAuthorizationHeader(ctx context.Context) string {
 	return GetHeader(ctx, http.Authorization)
 }
+
+// DPoPRequestMetadata passes the http method and path of a REST request through the gateway to the gRPC server,
+// where they are required to verify the binding of a DPoP proof.
+func DPoPRequestMetadata(_ context.Context, r *net_http.Request) metadata.MD {
+	return metadata.Pairs(dpop.ForwardedRequestHeader, dpop.ForwardRequest(r.Method, r.URL.Path))
+}
+
+// GetDPoPRequestHeaders returns the http method and path of the REST request set by [DPoPRequestMetadata].
+// As the value is appended to the ones possibly sent by the client itself, the last one is used.
+// Values not set by the gateway of this process are ignored and empty strings are returned.
+func GetDPoPRequestHeaders(ctx context.Context) (method, path string) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	method, path, ok := dpop.ForwardedRequest(lastValue(md.Get(dpop.ForwardedRequestHeader)))
+	if !ok {
+		return "", ""
+	}
+	return method, path
+}
+
+// ClientCertificateMetadata passes the client certificate of a REST request through the gateway to the gRPC server,
+// where it is required to verify the binding of certificate-bound access tokens.
+func ClientCertificateMetadata(ctx context.Context, _ *net_http.Request) metadata.MD {
+	header, value := mtls.ForwardCertificate(mtls.Certificates(ctx))
+	if header == "" {
+		return nil
+	}
+	return metadata.Pairs(header, value)
+}
+
+func lastValue(values []string) string {
+	if len(values) == 0 {
+		return ""
+	}
+	return values[len(values)-1]
+}
@@ -5,6 +5,8 @@ import (
 	"testing"
 
 	"google.golang.org/grpc/metadata"
+
+	"github.com/zitadel/zitadel/internal/api/dpop"
 )
 
 func TestGetHeader(t *testing.T) {
@@ -90,3 +92,48 @@ func TestGetAuthorizationHeader(t *testing.T) {
 		})
 	}
 }
+
+func TestGetDPoPRequestHeaders(t *testing.T) {
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		wantMethod string
+		wantPath   string
+	}{
+		{
+			name: "no metadata",
+			ctx:  context.Background(),
+		},
+		{
+			name: "set by client",
+			ctx: metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+				dpop.ForwardedRequestHeader, "GET /v2/users/123",
+			)),
+		},
+		{
+			name: "set by gateway",
+			ctx: metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+				dpop.ForwardedRequestHeader, dpop.ForwardRequest("GET", "/v2/users/123"),
+			)),
+			wantMethod: "GET",
+			wantPath:   "/v2/users/123",
+		},
+		{
+			name: "set by client and gateway",
+			ctx: metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+				dpop.ForwardedRequestHeader, "POST /v2/users/456",
+				dpop.ForwardedRequestHeader, dpop.ForwardRequest("GET", "/v2/users/123"),
+			)),
+			wantMethod: "GET",
+			wantPath:   "/v2/users/123",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			method, path := GetDPoPRequestHeaders(tt.ctx)
+			if method != tt.wantMethod || path != tt.wantPath {
+				t.Errorf("GetDPoPRequestHeaders() = %v %v, want %v %v", method, path, tt.wantMethod, tt.wantPath)
+			}
+		})
+	}
+}
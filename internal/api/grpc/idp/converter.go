@@ -313,6 +313,26 @@ func LDAPAttributesToCommand(attributes *idp_pb.LDAPAttributes) idp.LDAPAttribut
 	}
 }
 
+func LDAPGroupSyncToCommand(groupSync *idp_pb.LDAPGroupSync) *idp.LDAPGroupSync {
+	if groupSync == nil {
+		return nil
+	}
+	mappings := make([]*idp.LDAPGroupMapping, len(groupSync.GroupMappings))
+	for i, mapping := range groupSync.GroupMappings {
+		mappings[i] = &idp.LDAPGroupMapping{
+			Group:     mapping.GetGroup(),
+			ProjectID: mapping.GetProjectId(),
+			RoleKeys:  mapping.GetRoleKeys(),
+		}
+	}
+	return &idp.LDAPGroupSync{
+		GroupBaseDN:          groupSync.GroupBaseDn,
+		GroupObjectClasses:   groupSync.GroupObjectClasses,
+		GroupMemberAttribute: groupSync.GroupMemberAttribute,
+		GroupMappings:        mappings,
+	}
+}
+
 func AzureADTenantToCommand(tenant *idp_pb.AzureADTenant) string {
 	if tenant == nil {
 		return string(azuread.CommonTenant)
@@ -624,6 +644,7 @@ func ldapConfigToPb(providerConfig *idp_pb.ProviderConfig, template *query.LDAPI
 			Timeout:           timeout,
 			RootCa:            template.RootCA,
 			Attributes:        ldapAttributesToPb(template.LDAPAttributes),
+			GroupSync:         ldapGroupSyncToPb(template.GroupSync),
 		},
 	}
 }
@@ -646,6 +667,26 @@ func ldapAttributesToPb(attributes idp.LDAPAttributes) *idp_pb.LDAPAttributes {
 	}
 }
 
+func ldapGroupSyncToPb(groupSync *idp.LDAPGroupSync) *idp_pb.LDAPGroupSync {
+	if groupSync == nil {
+		return nil
+	}
+	mappings := make([]*idp_pb.LDAPGroupMapping, len(groupSync.GroupMappings))
+	for i, mapping := range groupSync.GroupMappings {
+		mappings[i] = &idp_pb.LDAPGroupMapping{
+			Group:     mapping.Group,
+			ProjectId: mapping.ProjectID,
+			RoleKeys:  mapping.RoleKeys,
+		}
+	}
+	return &idp_pb.LDAPGroupSync{
+		GroupBaseDn:          groupSync.GroupBaseDN,
+		GroupObjectClasses:   groupSync.GroupObjectClasses,
+		GroupMemberAttribute: groupSync.GroupMemberAttribute,
+		GroupMappings:        mappings,
+	}
+}
+
 func appleConfigToPb(providerConfig *idp_pb.ProviderConfig, template *query.AppleIDPTemplate) {
 	providerConfig.Config = &idp_pb.ProviderConfig_Apple{
 		Apple: &idp_pb.AppleConfig{
@@ -291,10 +291,31 @@ func ldapConfigToPb(idpConfig *idp_pb.IDPConfig, template *query.LDAPIDPTemplate
 			Timeout:           timeout,
 			RootCa:            template.RootCA,
 			Attributes:        ldapAttributesToPb(template.LDAPAttributes),
+			GroupSync:         ldapGroupSyncToPb(template.GroupSync),
 		},
 	}
 }
 
+func ldapGroupSyncToPb(groupSync *idp_rp.LDAPGroupSync) *idp_pb.LDAPGroupSync {
+	if groupSync == nil {
+		return nil
+	}
+	mappings := make([]*idp_pb.LDAPGroupMapping, len(groupSync.GroupMappings))
+	for i, mapping := range groupSync.GroupMappings {
+		mappings[i] = &idp_pb.LDAPGroupMapping{
+			Group:     mapping.Group,
+			ProjectId: mapping.ProjectID,
+			RoleKeys:  mapping.RoleKeys,
+		}
+	}
+	return &idp_pb.LDAPGroupSync{
+		GroupBaseDn:          groupSync.GroupBaseDN,
+		GroupObjectClasses:   groupSync.GroupObjectClasses,
+		GroupMemberAttribute: groupSync.GroupMemberAttribute,
+		GroupMappings:        mappings,
+	}
+}
+
 func ldapAttributesToPb(attributes idp_rp.LDAPAttributes) *idp_pb.LDAPAttributes {
 	return &idp_pb.LDAPAttributes{
 		IdAttribute:                attributes.IDAttribute,
@@ -0,0 +1,71 @@
+package management
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	object_grpc "github.com/zitadel/zitadel/internal/api/grpc/object"
+	user_grpc "github.com/zitadel/zitadel/internal/api/grpc/user"
+	mgmt_pb "github.com/zitadel/zitadel/pkg/grpc/management"
+)
+
+func (s *Server) ListAccessReviews(ctx context.Context, req *mgmt_pb.ListAccessReviewsRequest) (*mgmt_pb.ListAccessReviewsResponse, error) {
+	queries, err := listAccessReviewsRequestToModel(req, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	reviews, err := s.query.SearchAccessReviews(ctx, queries)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ListAccessReviewsResponse{
+		Result:  user_grpc.AccessReviewsToPb(reviews.AccessReviews),
+		Details: object_grpc.ToListDetails(reviews.Count, reviews.Sequence, reviews.LastRun),
+	}, nil
+}
+
+func (s *Server) GetAccessReviewByID(ctx context.Context, req *mgmt_pb.GetAccessReviewByIDRequest) (*mgmt_pb.GetAccessReviewByIDResponse, error) {
+	review, err := s.query.AccessReviewByID(ctx, req.Id, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.GetAccessReviewByIDResponse{
+		Review: user_grpc.AccessReviewToPb(review),
+	}, nil
+}
+
+func (s *Server) StartAccessReview(ctx context.Context, req *mgmt_pb.StartAccessReviewRequest) (*mgmt_pb.StartAccessReviewResponse, error) {
+	details, err := s.command.StartAccessReview(ctx, authz.GetCtxData(ctx).OrgID, StartAccessReviewRequestToCommand(req))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.StartAccessReviewResponse{
+		Id:      details.ID,
+		Details: object_grpc.DomainToAddDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) ListAccessReviewItems(ctx context.Context, req *mgmt_pb.ListAccessReviewItemsRequest) (*mgmt_pb.ListAccessReviewItemsResponse, error) {
+	queries, err := listAccessReviewItemsRequestToModel(req, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	items, err := s.query.SearchAccessReviewItems(ctx, queries)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ListAccessReviewItemsResponse{
+		Result:  user_grpc.AccessReviewItemsToPb(items.Items),
+		Details: object_grpc.ToListDetails(items.Count, items.Sequence, items.LastRun),
+	}, nil
+}
+
+func (s *Server) ReassignAccessReviewItem(ctx context.Context, req *mgmt_pb.ReassignAccessReviewItemRequest) (*mgmt_pb.ReassignAccessReviewItemResponse, error) {
+	details, err := s.command.ReassignAccessReviewItem(ctx, req.Id, authz.GetCtxData(ctx).OrgID, req.ItemId, req.ReviewerId)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ReassignAccessReviewItemResponse{
+		Details: object_grpc.DomainToChangeDetailsPb(details),
+	}, nil
+}
@@ -0,0 +1,62 @@
+package management
+
+import (
+	object_grpc "github.com/zitadel/zitadel/internal/api/grpc/object"
+	user_grpc "github.com/zitadel/zitadel/internal/api/grpc/user"
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/query"
+	mgmt_pb "github.com/zitadel/zitadel/pkg/grpc/management"
+)
+
+func StartAccessReviewRequestToCommand(req *mgmt_pb.StartAccessReviewRequest) *command.StartAccessReview {
+	return &command.StartAccessReview{
+		Name:       req.Name,
+		ProjectID:  req.ProjectId,
+		Deadline:   req.GetDeadline().AsTime(),
+		ReviewerID: req.ReviewerId,
+	}
+}
+
+func listAccessReviewsRequestToModel(req *mgmt_pb.ListAccessReviewsRequest, resourceOwner string) (*query.AccessReviewSearchQueries, error) {
+	offset, limit, asc := object_grpc.ListQueryToModel(req.Query)
+	queries, err := user_grpc.AccessReviewQueriesToModel(req.Queries)
+	if err != nil {
+		return nil, err
+	}
+	resourceOwnerQuery, err := query.NewAccessReviewResourceOwnerSearchQuery(resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	return &query.AccessReviewSearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset: offset,
+			Limit:  limit,
+			Asc:    asc,
+		},
+		Queries: append(queries, resourceOwnerQuery),
+	}, nil
+}
+
+func listAccessReviewItemsRequestToModel(req *mgmt_pb.ListAccessReviewItemsRequest, resourceOwner string) (*query.AccessReviewItemSearchQueries, error) {
+	offset, limit, asc := object_grpc.ListQueryToModel(req.Query)
+	queries, err := user_grpc.AccessReviewItemQueriesToModel(req.Queries)
+	if err != nil {
+		return nil, err
+	}
+	reviewIDQuery, err := query.NewAccessReviewItemReviewIDSearchQuery(req.Id)
+	if err != nil {
+		return nil, err
+	}
+	resourceOwnerQuery, err := query.NewAccessReviewItemResourceOwnerSearchQuery(resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	return &query.AccessReviewItemSearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset: offset,
+			Limit:  limit,
+			Asc:    asc,
+		},
+		Queries: append(queries, reviewIDQuery, resourceOwnerQuery),
+	}, nil
+}
@@ -423,6 +423,7 @@ func addLDAPProviderToCommand(req *mgmt_pb.AddLDAPProviderRequest) command.LDAPP
 		RootCA:            req.RootCa,
 		LDAPAttributes:    idp_grpc.LDAPAttributesToCommand(req.Attributes),
 		IDPOptions:        idp_grpc.OptionsToCommand(req.ProviderOptions),
+		GroupSync:         idp_grpc.LDAPGroupSyncToCommand(req.GroupSync),
 	}
 }
 
@@ -441,6 +442,7 @@ func updateLDAPProviderToCommand(req *mgmt_pb.UpdateLDAPProviderRequest) command
 		LDAPAttributes:    idp_grpc.LDAPAttributesToCommand(req.Attributes),
 		IDPOptions:        idp_grpc.OptionsToCommand(req.ProviderOptions),
 		RootCA:            req.RootCa,
+		GroupSync:         idp_grpc.LDAPGroupSyncToCommand(req.GroupSync),
 	}
 }
 
@@ -140,6 +140,26 @@ func (s *Server) RemoveOrg(ctx context.Context, req *mgmt_pb.RemoveOrgRequest) (
 	return &mgmt_pb.RemoveOrgResponse{Details: object.DomainToChangeDetailsPb(details)}, nil
 }
 
+func (s *Server) SetOrgParent(ctx context.Context, req *mgmt_pb.SetOrgParentRequest) (*mgmt_pb.SetOrgParentResponse, error) {
+	details, err := s.command.SetOrgParent(ctx, authz.GetCtxData(ctx).OrgID, req.ParentOrgId, req.DelegateAdministration)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.SetOrgParentResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) RemoveOrgParent(ctx context.Context, req *mgmt_pb.RemoveOrgParentRequest) (*mgmt_pb.RemoveOrgParentResponse, error) {
+	details, err := s.command.RemoveOrgParent(ctx, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.RemoveOrgParentResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
 func (s *Server) GetDomainPolicy(ctx context.Context, req *mgmt_pb.GetDomainPolicyRequest) (*mgmt_pb.GetDomainPolicyResponse, error) {
 	policy, err := s.query.DomainPolicyByOrg(ctx, true, authz.GetCtxData(ctx).OrgID, false)
 	if err != nil {
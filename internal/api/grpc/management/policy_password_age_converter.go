@@ -9,6 +9,8 @@ func AddPasswordAgePolicyToDomain(policy *mgmt_pb.AddCustomPasswordAgePolicyRequ
 	return &domain.PasswordAgePolicy{
 		MaxAgeDays:     uint64(policy.MaxAgeDays),
 		ExpireWarnDays: uint64(policy.ExpireWarnDays),
+		HistoryCount:   uint64(policy.HistoryCount),
+		MinAgeDays:     uint64(policy.MinAgeDays),
 	}
 }
 
@@ -16,5 +18,7 @@ func UpdatePasswordAgePolicyToDomain(policy *mgmt_pb.UpdateCustomPasswordAgePoli
 	return &domain.PasswordAgePolicy{
 		MaxAgeDays:     uint64(policy.MaxAgeDays),
 		ExpireWarnDays: uint64(policy.ExpireWarnDays),
+		HistoryCount:   uint64(policy.HistoryCount),
+		MinAgeDays:     uint64(policy.MinAgeDays),
 	}
 }
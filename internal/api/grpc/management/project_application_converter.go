@@ -45,25 +45,34 @@ func AddOIDCAppRequestToDomain(req *mgmt_pb.AddOIDCAppRequest) (*domain.OIDCApp,
 		ObjectRoot: models.ObjectRoot{
 			AggregateID: req.ProjectId,
 		},
-		AppName:                  req.Name,
-		OIDCVersion:              app_grpc.OIDCVersionToDomain(req.Version),
-		RedirectUris:             req.RedirectUris,
-		ResponseTypes:            app_grpc.OIDCResponseTypesToDomain(req.ResponseTypes),
-		GrantTypes:               app_grpc.OIDCGrantTypesToDomain(req.GrantTypes),
-		ApplicationType:          app_grpc.OIDCApplicationTypeToDomain(req.AppType),
-		AuthMethodType:           app_grpc.OIDCAuthMethodTypeToDomain(req.AuthMethodType),
-		PostLogoutRedirectUris:   req.PostLogoutRedirectUris,
-		DevMode:                  req.DevMode,
-		AccessTokenType:          app_grpc.OIDCTokenTypeToDomain(req.AccessTokenType),
-		AccessTokenRoleAssertion: req.AccessTokenRoleAssertion,
-		IDTokenRoleAssertion:     req.IdTokenRoleAssertion,
-		IDTokenUserinfoAssertion: req.IdTokenUserinfoAssertion,
-		ClockSkew:                req.ClockSkew.AsDuration(),
-		AdditionalOrigins:        req.AdditionalOrigins,
-		SkipNativeAppSuccessPage: req.SkipNativeAppSuccessPage,
-		BackChannelLogoutURI:     req.GetBackChannelLogoutUri(),
-		LoginVersion:             loginVersion,
-		LoginBaseURI:             loginBaseURI,
+		AppName:                               req.Name,
+		OIDCVersion:                           app_grpc.OIDCVersionToDomain(req.Version),
+		RedirectUris:                          req.RedirectUris,
+		ResponseTypes:                         app_grpc.OIDCResponseTypesToDomain(req.ResponseTypes),
+		GrantTypes:                            app_grpc.OIDCGrantTypesToDomain(req.GrantTypes),
+		ApplicationType:                       app_grpc.OIDCApplicationTypeToDomain(req.AppType),
+		AuthMethodType:                        app_grpc.OIDCAuthMethodTypeToDomain(req.AuthMethodType),
+		PostLogoutRedirectUris:                req.PostLogoutRedirectUris,
+		DevMode:                               req.DevMode,
+		AccessTokenType:                       app_grpc.OIDCTokenTypeToDomain(req.AccessTokenType),
+		AccessTokenRoleAssertion:              req.AccessTokenRoleAssertion,
+		IDTokenRoleAssertion:                  req.IdTokenRoleAssertion,
+		IDTokenUserinfoAssertion:              req.IdTokenUserinfoAssertion,
+		ClockSkew:                             req.ClockSkew.AsDuration(),
+		AdditionalOrigins:                     req.AdditionalOrigins,
+		SkipNativeAppSuccessPage:              req.SkipNativeAppSuccessPage,
+		BackChannelLogoutURI:                  req.GetBackChannelLogoutUri(),
+		LoginVersion:                          loginVersion,
+		LoginBaseURI:                          loginBaseURI,
+		DPoPBoundAccessTokens:                 req.GetDpopBoundAccessTokens(),
+		RequirePushedAuthRequests:             req.GetRequirePushedAuthRequests(),
+		CIBANotificationURI:                   req.GetCibaNotificationUri(),
+		ConsentRequired:                       req.GetConsentRequired(),
+		TLSClientAuthSubjectDN:                req.GetTlsClientAuthSubjectDn(),
+		TLSClientCertificates:                 req.GetTlsClientCertificates(),
+		TLSClientCertificateBoundAccessTokens: req.GetTlsClientCertificateBoundAccessTokens(),
+		RefreshTokenRotation:                  app_grpc.OIDCRefreshTokenRotationToDomain(req.GetRefreshTokenRotation()),
+		EncryptAuthorizationResponse:          req.GetEncryptAuthorizationResponse(),
 	}, nil
 }
 
@@ -110,24 +119,33 @@ func UpdateOIDCAppConfigRequestToDomain(app *mgmt_pb.UpdateOIDCAppConfigRequest)
 		ObjectRoot: models.ObjectRoot{
 			AggregateID: app.ProjectId,
 		},
-		AppID:                    app.AppId,
-		RedirectUris:             app.RedirectUris,
-		ResponseTypes:            app_grpc.OIDCResponseTypesToDomain(app.ResponseTypes),
-		GrantTypes:               app_grpc.OIDCGrantTypesToDomain(app.GrantTypes),
-		ApplicationType:          app_grpc.OIDCApplicationTypeToDomain(app.AppType),
-		AuthMethodType:           app_grpc.OIDCAuthMethodTypeToDomain(app.AuthMethodType),
-		PostLogoutRedirectUris:   app.PostLogoutRedirectUris,
-		DevMode:                  app.DevMode,
-		AccessTokenType:          app_grpc.OIDCTokenTypeToDomain(app.AccessTokenType),
-		AccessTokenRoleAssertion: app.AccessTokenRoleAssertion,
-		IDTokenRoleAssertion:     app.IdTokenRoleAssertion,
-		IDTokenUserinfoAssertion: app.IdTokenUserinfoAssertion,
-		ClockSkew:                app.ClockSkew.AsDuration(),
-		AdditionalOrigins:        app.AdditionalOrigins,
-		SkipNativeAppSuccessPage: app.SkipNativeAppSuccessPage,
-		BackChannelLogoutURI:     app.BackChannelLogoutUri,
-		LoginVersion:             loginVersion,
-		LoginBaseURI:             loginBaseURI,
+		AppID:                                 app.AppId,
+		RedirectUris:                          app.RedirectUris,
+		ResponseTypes:                         app_grpc.OIDCResponseTypesToDomain(app.ResponseTypes),
+		GrantTypes:                            app_grpc.OIDCGrantTypesToDomain(app.GrantTypes),
+		ApplicationType:                       app_grpc.OIDCApplicationTypeToDomain(app.AppType),
+		AuthMethodType:                        app_grpc.OIDCAuthMethodTypeToDomain(app.AuthMethodType),
+		PostLogoutRedirectUris:                app.PostLogoutRedirectUris,
+		DevMode:                               app.DevMode,
+		AccessTokenType:                       app_grpc.OIDCTokenTypeToDomain(app.AccessTokenType),
+		AccessTokenRoleAssertion:              app.AccessTokenRoleAssertion,
+		IDTokenRoleAssertion:                  app.IdTokenRoleAssertion,
+		IDTokenUserinfoAssertion:              app.IdTokenUserinfoAssertion,
+		ClockSkew:                             app.ClockSkew.AsDuration(),
+		AdditionalOrigins:                     app.AdditionalOrigins,
+		SkipNativeAppSuccessPage:              app.SkipNativeAppSuccessPage,
+		BackChannelLogoutURI:                  app.BackChannelLogoutUri,
+		LoginVersion:                          loginVersion,
+		LoginBaseURI:                          loginBaseURI,
+		DPoPBoundAccessTokens:                 app.DpopBoundAccessTokens,
+		RequirePushedAuthRequests:             app.RequirePushedAuthRequests,
+		CIBANotificationURI:                   app.CibaNotificationUri,
+		ConsentRequired:                       app.ConsentRequired,
+		TLSClientAuthSubjectDN:                app.TlsClientAuthSubjectDn,
+		TLSClientCertificates:                 app.TlsClientCertificates,
+		TLSClientCertificateBoundAccessTokens: app.TlsClientCertificateBoundAccessTokens,
+		RefreshTokenRotation:                  app_grpc.OIDCRefreshTokenRotationToDomain(app.RefreshTokenRotation),
+		EncryptAuthorizationResponse:          app.EncryptAuthorizationResponse,
 	}, nil
 }
 
@@ -0,0 +1,86 @@
+package management
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	object_grpc "github.com/zitadel/zitadel/internal/api/grpc/object"
+	project_grpc "github.com/zitadel/zitadel/internal/api/grpc/project"
+	mgmt_pb "github.com/zitadel/zitadel/pkg/grpc/management"
+)
+
+func (s *Server) ListProjectSCIMConnectors(ctx context.Context, req *mgmt_pb.ListProjectSCIMConnectorsRequest) (*mgmt_pb.ListProjectSCIMConnectorsResponse, error) {
+	queries, err := listProjectSCIMConnectorsRequestToModel(req, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	connectors, err := s.query.SearchSCIMConnectors(ctx, queries)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ListProjectSCIMConnectorsResponse{
+		Result:  project_grpc.SCIMConnectorsToPb(connectors.SCIMConnectors),
+		Details: object_grpc.ToListDetails(connectors.Count, connectors.Sequence, connectors.LastRun),
+	}, nil
+}
+
+func (s *Server) GetProjectSCIMConnectorByID(ctx context.Context, req *mgmt_pb.GetProjectSCIMConnectorByIDRequest) (*mgmt_pb.GetProjectSCIMConnectorByIDResponse, error) {
+	connector, err := s.query.GetSCIMConnectorByID(ctx, req.ProjectId, req.ConnectorId, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.GetProjectSCIMConnectorByIDResponse{
+		Connector: project_grpc.SCIMConnectorToPb(connector),
+	}, nil
+}
+
+func (s *Server) AddProjectSCIMConnector(ctx context.Context, req *mgmt_pb.AddProjectSCIMConnectorRequest) (*mgmt_pb.AddProjectSCIMConnectorResponse, error) {
+	details, err := s.command.AddSCIMConnector(ctx, AddProjectSCIMConnectorRequestToCommand(req), authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.AddProjectSCIMConnectorResponse{
+		Id:      details.ID,
+		Details: object_grpc.DomainToAddDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) UpdateProjectSCIMConnector(ctx context.Context, req *mgmt_pb.UpdateProjectSCIMConnectorRequest) (*mgmt_pb.UpdateProjectSCIMConnectorResponse, error) {
+	details, err := s.command.ChangeSCIMConnector(ctx, UpdateProjectSCIMConnectorRequestToCommand(req), authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.UpdateProjectSCIMConnectorResponse{
+		Details: object_grpc.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) DeactivateProjectSCIMConnector(ctx context.Context, req *mgmt_pb.DeactivateProjectSCIMConnectorRequest) (*mgmt_pb.DeactivateProjectSCIMConnectorResponse, error) {
+	details, err := s.command.DeactivateSCIMConnector(ctx, req.ProjectId, req.ConnectorId, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.DeactivateProjectSCIMConnectorResponse{
+		Details: object_grpc.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) ReactivateProjectSCIMConnector(ctx context.Context, req *mgmt_pb.ReactivateProjectSCIMConnectorRequest) (*mgmt_pb.ReactivateProjectSCIMConnectorResponse, error) {
+	details, err := s.command.ReactivateSCIMConnector(ctx, req.ProjectId, req.ConnectorId, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ReactivateProjectSCIMConnectorResponse{
+		Details: object_grpc.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) RemoveProjectSCIMConnector(ctx context.Context, req *mgmt_pb.RemoveProjectSCIMConnectorRequest) (*mgmt_pb.RemoveProjectSCIMConnectorResponse, error) {
+	details, err := s.command.RemoveSCIMConnector(ctx, req.ProjectId, req.ConnectorId, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.RemoveProjectSCIMConnectorResponse{
+		Details: object_grpc.DomainToChangeDetailsPb(details),
+	}, nil
+}
@@ -0,0 +1,55 @@
+package management
+
+import (
+	object_grpc "github.com/zitadel/zitadel/internal/api/grpc/object"
+	project_grpc "github.com/zitadel/zitadel/internal/api/grpc/project"
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
+	"github.com/zitadel/zitadel/internal/query"
+	mgmt_pb "github.com/zitadel/zitadel/pkg/grpc/management"
+)
+
+func AddProjectSCIMConnectorRequestToCommand(req *mgmt_pb.AddProjectSCIMConnectorRequest) *command.AddSCIMConnector {
+	return &command.AddSCIMConnector{
+		ProjectID: req.ProjectId,
+		Name:      req.Name,
+		Endpoint:  req.Endpoint,
+		Token:     req.Token,
+	}
+}
+
+func UpdateProjectSCIMConnectorRequestToCommand(req *mgmt_pb.UpdateProjectSCIMConnectorRequest) *command.ChangeSCIMConnector {
+	return &command.ChangeSCIMConnector{
+		ObjectRoot: models.ObjectRoot{
+			AggregateID: req.ConnectorId,
+		},
+		ProjectID: req.ProjectId,
+		Name:      req.Name,
+		Endpoint:  req.Endpoint,
+		Token:     req.Token,
+	}
+}
+
+func listProjectSCIMConnectorsRequestToModel(req *mgmt_pb.ListProjectSCIMConnectorsRequest, resourceOwner string) (*query.SCIMConnectorSearchQueries, error) {
+	offset, limit, asc := object_grpc.ListQueryToModel(req.Query)
+	queries, err := project_grpc.SCIMConnectorQueriesToModel(req.Queries)
+	if err != nil {
+		return nil, err
+	}
+	projectIDQuery, err := query.NewSCIMConnectorProjectIDSearchQuery(req.ProjectId)
+	if err != nil {
+		return nil, err
+	}
+	resourceOwnerQuery, err := query.NewSCIMConnectorResourceOwnerSearchQuery(resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	return &query.SCIMConnectorSearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset: offset,
+			Limit:  limit,
+			Asc:    asc,
+		},
+		Queries: append(queries, projectIDQuery, resourceOwnerQuery),
+	}, nil
+}
@@ -76,6 +76,16 @@ func (s *Server) UpdateUserGrant(ctx context.Context, req *mgmt_pb.UpdateUserGra
 	}, nil
 }
 
+func (s *Server) UpdateUserGrantValidity(ctx context.Context, req *mgmt_pb.UpdateUserGrantValidityRequest) (*mgmt_pb.UpdateUserGrantValidityResponse, error) {
+	objectDetails, err := s.command.ChangeUserGrantValidity(ctx, req.GrantId, authz.GetCtxData(ctx).OrgID, validityToDomain(req.ValidFrom), validityToDomain(req.ValidUntil))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.UpdateUserGrantValidityResponse{
+		Details: obj_grpc.DomainToChangeDetailsPb(objectDetails),
+	}, nil
+}
+
 func (s *Server) DeactivateUserGrant(ctx context.Context, req *mgmt_pb.DeactivateUserGrantRequest) (*mgmt_pb.DeactivateUserGrantResponse, error) {
 	objectDetails, err := s.command.DeactivateUserGrant(ctx, req.GrantId, authz.GetCtxData(ctx).OrgID)
 	if err != nil {
@@ -2,6 +2,9 @@ package management
 
 import (
 	"context"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/api/grpc/object"
@@ -55,6 +58,8 @@ func AddUserGrantRequestToDomain(req *mgmt_pb.AddUserGrantRequest) *domain.UserG
 		ProjectID:      req.ProjectId,
 		ProjectGrantID: req.ProjectGrantId,
 		RoleKeys:       req.RoleKeys,
+		ValidFrom:      validityToDomain(req.ValidFrom),
+		ValidUntil:     validityToDomain(req.ValidUntil),
 	}
 }
 
@@ -68,3 +73,10 @@ func UpdateUserGrantRequestToDomain(req *mgmt_pb.UpdateUserGrantRequest) *domain
 	}
 
 }
+
+func validityToDomain(t *timestamppb.Timestamp) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+	return t.AsTime()
+}
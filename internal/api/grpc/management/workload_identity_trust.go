@@ -0,0 +1,66 @@
+package management
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	object_grpc "github.com/zitadel/zitadel/internal/api/grpc/object"
+	user_grpc "github.com/zitadel/zitadel/internal/api/grpc/user"
+	mgmt_pb "github.com/zitadel/zitadel/pkg/grpc/management"
+)
+
+func (s *Server) ListWorkloadIdentityTrusts(ctx context.Context, req *mgmt_pb.ListWorkloadIdentityTrustsRequest) (*mgmt_pb.ListWorkloadIdentityTrustsResponse, error) {
+	queries, err := listWorkloadIdentityTrustsRequestToModel(req, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	trusts, err := s.query.SearchWorkloadIdentityTrusts(ctx, queries)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ListWorkloadIdentityTrustsResponse{
+		Result:  user_grpc.WorkloadIdentityTrustsToPb(trusts.WorkloadIdentityTrusts),
+		Details: object_grpc.ToListDetails(trusts.Count, trusts.Sequence, trusts.LastRun),
+	}, nil
+}
+
+func (s *Server) GetWorkloadIdentityTrustByID(ctx context.Context, req *mgmt_pb.GetWorkloadIdentityTrustByIDRequest) (*mgmt_pb.GetWorkloadIdentityTrustByIDResponse, error) {
+	trust, err := s.query.GetWorkloadIdentityTrustByID(ctx, req.Id, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.GetWorkloadIdentityTrustByIDResponse{
+		Trust: user_grpc.WorkloadIdentityTrustToPb(trust),
+	}, nil
+}
+
+func (s *Server) AddWorkloadIdentityTrust(ctx context.Context, req *mgmt_pb.AddWorkloadIdentityTrustRequest) (*mgmt_pb.AddWorkloadIdentityTrustResponse, error) {
+	details, err := s.command.AddWorkloadIdentityTrust(ctx, AddWorkloadIdentityTrustRequestToCommand(req), authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.AddWorkloadIdentityTrustResponse{
+		Id:      details.ID,
+		Details: object_grpc.DomainToAddDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) UpdateWorkloadIdentityTrust(ctx context.Context, req *mgmt_pb.UpdateWorkloadIdentityTrustRequest) (*mgmt_pb.UpdateWorkloadIdentityTrustResponse, error) {
+	details, err := s.command.ChangeWorkloadIdentityTrust(ctx, UpdateWorkloadIdentityTrustRequestToCommand(req), authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.UpdateWorkloadIdentityTrustResponse{
+		Details: object_grpc.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) RemoveWorkloadIdentityTrust(ctx context.Context, req *mgmt_pb.RemoveWorkloadIdentityTrustRequest) (*mgmt_pb.RemoveWorkloadIdentityTrustResponse, error) {
+	details, err := s.command.RemoveWorkloadIdentityTrust(ctx, req.Id, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.RemoveWorkloadIdentityTrustResponse{
+		Details: object_grpc.DomainToChangeDetailsPb(details),
+	}, nil
+}
@@ -0,0 +1,58 @@
+package management
+
+import (
+	object_grpc "github.com/zitadel/zitadel/internal/api/grpc/object"
+	user_grpc "github.com/zitadel/zitadel/internal/api/grpc/user"
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
+	"github.com/zitadel/zitadel/internal/query"
+	mgmt_pb "github.com/zitadel/zitadel/pkg/grpc/management"
+)
+
+func AddWorkloadIdentityTrustRequestToCommand(req *mgmt_pb.AddWorkloadIdentityTrustRequest) *command.AddWorkloadIdentityTrust {
+	return &command.AddWorkloadIdentityTrust{
+		Name:         req.Name,
+		Issuer:       req.Issuer,
+		JWKSEndpoint: req.GetJwksEndpoint(),
+		JWKS:         req.GetJwks(),
+		Audience:     req.Audience,
+		Rules:        user_grpc.WorkloadIdentityRulesToCommand(req.Rules),
+	}
+}
+
+func UpdateWorkloadIdentityTrustRequestToCommand(req *mgmt_pb.UpdateWorkloadIdentityTrustRequest) *command.ChangeWorkloadIdentityTrust {
+	change := &command.ChangeWorkloadIdentityTrust{
+		ObjectRoot: models.ObjectRoot{
+			AggregateID: req.Id,
+		},
+		Name:         req.Name,
+		Issuer:       req.Issuer,
+		JWKSEndpoint: req.GetJwksEndpoint(),
+		JWKS:         req.GetJwks(),
+		Rules:        user_grpc.WorkloadIdentityRulesToCommand(req.Rules),
+	}
+	if len(req.Audience) > 0 {
+		change.Audience = req.Audience
+	}
+	return change
+}
+
+func listWorkloadIdentityTrustsRequestToModel(req *mgmt_pb.ListWorkloadIdentityTrustsRequest, resourceOwner string) (*query.WorkloadIdentityTrustSearchQueries, error) {
+	offset, limit, asc := object_grpc.ListQueryToModel(req.Query)
+	queries, err := user_grpc.WorkloadIdentityTrustQueriesToModel(req.Queries)
+	if err != nil {
+		return nil, err
+	}
+	resourceOwnerQuery, err := query.NewWorkloadIdentityTrustResourceOwnerSearchQuery(resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	return &query.WorkloadIdentityTrustSearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset: offset,
+			Limit:  limit,
+			Asc:    asc,
+		},
+		Queries: append(queries, resourceOwnerQuery),
+	}, nil
+}
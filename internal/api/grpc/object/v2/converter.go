@@ -107,6 +107,10 @@ func AuthMethodToPb(mfa *query.AuthMethod) *user_pb.AuthFactor {
 		factor.Type = &user_pb.AuthFactor_OtpEmail{
 			OtpEmail: &user_pb.AuthFactorOTPEmail{},
 		}
+	case domain.UserAuthMethodTypeRecoveryCodes:
+		factor.Type = &user_pb.AuthFactor_RecoveryCodes{
+			RecoveryCodes: &user_pb.AuthFactorRecoveryCodes{},
+		}
 	case domain.UserAuthMethodTypeUnspecified:
 	case domain.UserAuthMethodTypePasswordless:
 	case domain.UserAuthMethodTypePassword:
@@ -135,6 +139,8 @@ func AuthFactorToPb(authFactor user_pb.AuthFactors) domain.UserAuthMethodType {
 		return domain.UserAuthMethodTypeOTPEmail
 	case user_pb.AuthFactors_U2F:
 		return domain.UserAuthMethodTypeU2F
+	case user_pb.AuthFactors_RECOVERY_CODES:
+		return domain.UserAuthMethodTypeRecoveryCodes
 	default:
 		return domain.UserAuthMethodTypeUnspecified
 	}
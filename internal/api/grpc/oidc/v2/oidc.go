@@ -12,6 +12,7 @@ import (
 	"github.com/zitadel/zitadel/internal/api/grpc/object/v2"
 	http_utils "github.com/zitadel/zitadel/internal/api/http"
 	"github.com/zitadel/zitadel/internal/api/oidc"
+	"github.com/zitadel/zitadel/internal/command"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/query"
 	"github.com/zitadel/zitadel/internal/zerrors"
@@ -77,6 +78,19 @@ func (s *Server) AuthorizeOrDenyDeviceAuthorization(ctx context.Context, req *oi
 	return &oidc_pb.AuthorizeOrDenyDeviceAuthorizationResponse{}, nil
 }
 
+func (s *Server) AuthorizeOrDenyBackChannelAuthentication(ctx context.Context, req *oidc_pb.AuthorizeOrDenyBackChannelAuthenticationRequest) (_ *oidc_pb.AuthorizeOrDenyBackChannelAuthenticationResponse, err error) {
+	switch req.GetDecision().(type) {
+	case *oidc_pb.AuthorizeOrDenyBackChannelAuthenticationRequest_Session:
+		_, err = s.command.ApproveBackChannelAuthWithSession(ctx, req.GetAuthRequestId(), req.GetSession().GetSessionId(), req.GetSession().GetSessionToken())
+	case *oidc_pb.AuthorizeOrDenyBackChannelAuthenticationRequest_Deny:
+		_, err = s.command.CancelBackChannelAuth(ctx, req.GetAuthRequestId(), domain.DeviceAuthCanceledDenied)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &oidc_pb.AuthorizeOrDenyBackChannelAuthenticationResponse{}, nil
+}
+
 func authRequestToPb(a *query.AuthRequest) *oidc_pb.AuthRequest {
 	pba := &oidc_pb.AuthRequest{
 		Id:           a.ID,
@@ -136,13 +150,55 @@ func (s *Server) checkPermission(ctx context.Context, clientID string, userID st
 	return nil
 }
 
+// checkConsent returns the consent check of the session link.
+// If the client requires the consent and the user grants it, the consent is recorded together with the link,
+// otherwise the request fails if the client requires the consent and some scopes weren't granted yet.
+func (s *Server) checkConsent(grant bool) command.OIDCConsentCheck {
+	return func(ctx context.Context, clientID, userID string, scope []string) (*command.OIDCConsent, error) {
+		client, err := s.query.ActiveOIDCClientByID(ctx, clientID, false)
+		if err != nil {
+			return nil, err
+		}
+		if !client.ConsentRequired {
+			return nil, nil
+		}
+		if grant {
+			return &command.OIDCConsent{
+				UserID:    userID,
+				ClientID:  clientID,
+				AppID:     client.AppID,
+				ProjectID: client.ProjectID,
+				Scope:     scope,
+			}, nil
+		}
+		var granted []string
+		consent, err := s.query.OIDCConsentByUserAndClientID(ctx, true, userID, clientID)
+		if err != nil && !zerrors.IsNotFound(err) {
+			return nil, err
+		}
+		if consent != nil {
+			granted = consent.Scope
+		}
+		if len(domain.MissingConsentScopes(granted, scope)) > 0 {
+			return nil, zerrors.ThrowPreconditionFailed(nil, "OIDC-Ohx3i", "Errors.OIDCConsent.Required")
+		}
+		return nil, nil
+	}
+}
+
 func (s *Server) failAuthRequest(ctx context.Context, authRequestID string, ae *oidc_pb.AuthorizationError) (*oidc_pb.CreateCallbackResponse, error) {
 	details, aar, err := s.command.FailAuthRequest(ctx, authRequestID, errorReasonToDomain(ae.GetError()))
 	if err != nil {
 		return nil, err
 	}
 	authReq := &oidc.AuthRequestV2{CurrentAuthRequest: aar}
-	callback, err := oidc.CreateErrorCallbackURL(authReq, errorReasonToOIDC(ae.GetError()), ae.GetErrorDescription(), ae.GetErrorUri(), s.op.Provider())
+	issuer := authReq.Issuer
+	if issuer == "" {
+		issuer = http_utils.DomainContext(ctx).Origin()
+	}
+	// the issuer is required for the JWT response modes
+	ctx = op.ContextWithIssuer(ctx, issuer)
+	callback, err := s.op.CreateErrorCallbackURL(ctx, authReq, errorReasonToOIDC(ae.GetError()), ae.GetErrorDescription(), ae.GetErrorUri())
 	if err != nil {
 		return nil, err
 	}
@@ -153,7 +209,7 @@ func (s *Server) failAuthRequest(ctx context.Context, authRequestID string, ae *
 }
 
 func (s *Server) linkSessionToAuthRequest(ctx context.Context, authRequestID string, session *oidc_pb.Session) (*oidc_pb.CreateCallbackResponse, error) {
-	details, aar, err := s.command.LinkSessionToAuthRequest(ctx, authRequestID, session.GetSessionId(), session.GetSessionToken(), true, s.checkPermission)
+	details, aar, err := s.command.LinkSessionToAuthRequest(ctx, authRequestID, session.GetSessionId(), session.GetSessionToken(), true, s.checkPermission, s.checkConsent(session.GetGrantConsent()))
 	if err != nil {
 		return nil, err
 	}
@@ -165,7 +221,7 @@ func (s *Server) linkSessionToAuthRequest(ctx context.Context, authRequestID str
 	ctx = op.ContextWithIssuer(ctx, issuer)
 	var callback string
 	if aar.ResponseType == domain.OIDCResponseTypeCode {
-		callback, err = oidc.CreateCodeCallbackURL(ctx, authReq, s.op.Provider())
+		callback, err = s.op.CreateCodeCallbackURL(ctx, authReq)
 	} else {
 		callback, err = s.op.CreateTokenCallbackURL(ctx, authReq)
 	}
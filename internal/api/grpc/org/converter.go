@@ -54,6 +54,9 @@ func OrgViewToPb(org *query.Org) *org_pb.Org {
 			org.ChangeDate,
 			org.ResourceOwner,
 		),
+
+		ParentOrgId:            org.ParentOrgID,
+		DelegateAdministration: org.DelegateAdministration,
 	}
 }
 
@@ -72,6 +75,9 @@ func OrgToPb(org *query.Org) *org_pb.Org {
 		PrimaryDomain: org.Domain,
 		Details:       object.ToViewDetailsPb(org.Sequence, org.CreationDate, org.ChangeDate, org.ResourceOwner),
 		State:         OrgStateToPb(org.State),
+
+		ParentOrgId:            org.ParentOrgID,
+		DelegateAdministration: org.DelegateAdministration,
 	}
 }
 
@@ -44,27 +44,36 @@ func AppConfigToPb(app *query.App) app_pb.AppConfig {
 func AppOIDCConfigToPb(app *query.OIDCApp) *app_pb.App_OidcConfig {
 	return &app_pb.App_OidcConfig{
 		OidcConfig: &app_pb.OIDCConfig{
-			RedirectUris:             app.RedirectURIs,
-			ResponseTypes:            OIDCResponseTypesFromModel(app.ResponseTypes),
-			GrantTypes:               OIDCGrantTypesFromModel(app.GrantTypes),
-			AppType:                  OIDCApplicationTypeToPb(app.AppType),
-			ClientId:                 app.ClientID,
-			AuthMethodType:           OIDCAuthMethodTypeToPb(app.AuthMethodType),
-			PostLogoutRedirectUris:   app.PostLogoutRedirectURIs,
-			Version:                  OIDCVersionToPb(domain.OIDCVersion(app.Version)),
-			NoneCompliant:            len(app.ComplianceProblems) != 0,
-			ComplianceProblems:       ComplianceProblemsToLocalizedMessages(app.ComplianceProblems),
-			DevMode:                  app.IsDevMode,
-			AccessTokenType:          oidcTokenTypeToPb(app.AccessTokenType),
-			AccessTokenRoleAssertion: app.AssertAccessTokenRole,
-			IdTokenRoleAssertion:     app.AssertIDTokenRole,
-			IdTokenUserinfoAssertion: app.AssertIDTokenUserinfo,
-			ClockSkew:                durationpb.New(app.ClockSkew),
-			AdditionalOrigins:        app.AdditionalOrigins,
-			AllowedOrigins:           app.AllowedOrigins,
-			SkipNativeAppSuccessPage: app.SkipNativeAppSuccessPage,
-			BackChannelLogoutUri:     app.BackChannelLogoutURI,
-			LoginVersion:             loginVersionToPb(app.LoginVersion, app.LoginBaseURI),
+			RedirectUris:                          app.RedirectURIs,
+			ResponseTypes:                         OIDCResponseTypesFromModel(app.ResponseTypes),
+			GrantTypes:                            OIDCGrantTypesFromModel(app.GrantTypes),
+			AppType:                               OIDCApplicationTypeToPb(app.AppType),
+			ClientId:                              app.ClientID,
+			AuthMethodType:                        OIDCAuthMethodTypeToPb(app.AuthMethodType),
+			PostLogoutRedirectUris:                app.PostLogoutRedirectURIs,
+			Version:                               OIDCVersionToPb(domain.OIDCVersion(app.Version)),
+			NoneCompliant:                         len(app.ComplianceProblems) != 0,
+			ComplianceProblems:                    ComplianceProblemsToLocalizedMessages(app.ComplianceProblems),
+			DevMode:                               app.IsDevMode,
+			AccessTokenType:                       oidcTokenTypeToPb(app.AccessTokenType),
+			AccessTokenRoleAssertion:              app.AssertAccessTokenRole,
+			IdTokenRoleAssertion:                  app.AssertIDTokenRole,
+			IdTokenUserinfoAssertion:              app.AssertIDTokenUserinfo,
+			ClockSkew:                             durationpb.New(app.ClockSkew),
+			AdditionalOrigins:                     app.AdditionalOrigins,
+			AllowedOrigins:                        app.AllowedOrigins,
+			SkipNativeAppSuccessPage:              app.SkipNativeAppSuccessPage,
+			BackChannelLogoutUri:                  app.BackChannelLogoutURI,
+			LoginVersion:                          loginVersionToPb(app.LoginVersion, app.LoginBaseURI),
+			DpopBoundAccessTokens:                 app.DPoPBoundAccessTokens,
+			RequirePushedAuthRequests:             app.RequirePushedAuthRequests,
+			CibaNotificationUri:                   app.CIBANotificationURI,
+			ConsentRequired:                       app.ConsentRequired,
+			TlsClientAuthSubjectDn:                app.TLSClientAuthSubjectDN,
+			TlsClientCertificates:                 app.TLSClientCertificates,
+			TlsClientCertificateBoundAccessTokens: app.TLSClientCertificateBoundAccessTokens,
+			RefreshTokenRotation:                  oidcRefreshTokenRotationToPb(app.RefreshTokenRotation),
+			EncryptAuthorizationResponse:          app.EncryptAuthorizationResponse,
 		},
 	}
 }
@@ -158,6 +167,8 @@ func OIDCGrantTypesFromModel(grantTypes []domain.OIDCGrantType) []app_pb.OIDCGra
 			oidcGrantTypes[i] = app_pb.OIDCGrantType_OIDC_GRANT_TYPE_DEVICE_CODE
 		case domain.OIDCGrantTypeTokenExchange:
 			oidcGrantTypes[i] = app_pb.OIDCGrantType_OIDC_GRANT_TYPE_TOKEN_EXCHANGE
+		case domain.OIDCGrantTypeCIBA:
+			oidcGrantTypes[i] = app_pb.OIDCGrantType_OIDC_GRANT_TYPE_CIBA
 		}
 	}
 	return oidcGrantTypes
@@ -180,6 +191,8 @@ func OIDCGrantTypesToDomain(grantTypes []app_pb.OIDCGrantType) []domain.OIDCGran
 			oidcGrantTypes[i] = domain.OIDCGrantTypeDeviceCode
 		case app_pb.OIDCGrantType_OIDC_GRANT_TYPE_TOKEN_EXCHANGE:
 			oidcGrantTypes[i] = domain.OIDCGrantTypeTokenExchange
+		case app_pb.OIDCGrantType_OIDC_GRANT_TYPE_CIBA:
+			oidcGrantTypes[i] = domain.OIDCGrantTypeCIBA
 		}
 	}
 	return oidcGrantTypes
@@ -220,6 +233,10 @@ func OIDCAuthMethodTypeToPb(authType domain.OIDCAuthMethodType) app_pb.OIDCAuthM
 		return app_pb.OIDCAuthMethodType_OIDC_AUTH_METHOD_TYPE_NONE
 	case domain.OIDCAuthMethodTypePrivateKeyJWT:
 		return app_pb.OIDCAuthMethodType_OIDC_AUTH_METHOD_TYPE_PRIVATE_KEY_JWT
+	case domain.OIDCAuthMethodTypeTLSClientAuth:
+		return app_pb.OIDCAuthMethodType_OIDC_AUTH_METHOD_TYPE_TLS_CLIENT_AUTH
+	case domain.OIDCAuthMethodTypeSelfSignedTLSClientAuth:
+		return app_pb.OIDCAuthMethodType_OIDC_AUTH_METHOD_TYPE_SELF_SIGNED_TLS_CLIENT_AUTH
 	default:
 		return app_pb.OIDCAuthMethodType_OIDC_AUTH_METHOD_TYPE_BASIC
 	}
@@ -235,6 +252,10 @@ func OIDCAuthMethodTypeToDomain(authType app_pb.OIDCAuthMethodType) domain.OIDCA
 		return domain.OIDCAuthMethodTypeNone
 	case app_pb.OIDCAuthMethodType_OIDC_AUTH_METHOD_TYPE_PRIVATE_KEY_JWT:
 		return domain.OIDCAuthMethodTypePrivateKeyJWT
+	case app_pb.OIDCAuthMethodType_OIDC_AUTH_METHOD_TYPE_TLS_CLIENT_AUTH:
+		return domain.OIDCAuthMethodTypeTLSClientAuth
+	case app_pb.OIDCAuthMethodType_OIDC_AUTH_METHOD_TYPE_SELF_SIGNED_TLS_CLIENT_AUTH:
+		return domain.OIDCAuthMethodTypeSelfSignedTLSClientAuth
 	default:
 		return domain.OIDCAuthMethodTypeBasic
 	}
@@ -278,6 +299,32 @@ func OIDCTokenTypeToDomain(tokenType app_pb.OIDCTokenType) domain.OIDCTokenType
 	}
 }
 
+func oidcRefreshTokenRotationToPb(rotation domain.OIDCRefreshTokenRotation) app_pb.OIDCRefreshTokenRotation {
+	switch rotation {
+	case domain.OIDCRefreshTokenRotationStrict:
+		return app_pb.OIDCRefreshTokenRotation_OIDC_REFRESH_TOKEN_ROTATION_STRICT
+	case domain.OIDCRefreshTokenRotationStrictNotify:
+		return app_pb.OIDCRefreshTokenRotation_OIDC_REFRESH_TOKEN_ROTATION_STRICT_NOTIFY
+	case domain.OIDCRefreshTokenRotationDefault:
+		return app_pb.OIDCRefreshTokenRotation_OIDC_REFRESH_TOKEN_ROTATION_DEFAULT
+	default:
+		return app_pb.OIDCRefreshTokenRotation_OIDC_REFRESH_TOKEN_ROTATION_DEFAULT
+	}
+}
+
+func OIDCRefreshTokenRotationToDomain(rotation app_pb.OIDCRefreshTokenRotation) domain.OIDCRefreshTokenRotation {
+	switch rotation {
+	case app_pb.OIDCRefreshTokenRotation_OIDC_REFRESH_TOKEN_ROTATION_STRICT:
+		return domain.OIDCRefreshTokenRotationStrict
+	case app_pb.OIDCRefreshTokenRotation_OIDC_REFRESH_TOKEN_ROTATION_STRICT_NOTIFY:
+		return domain.OIDCRefreshTokenRotationStrictNotify
+	case app_pb.OIDCRefreshTokenRotation_OIDC_REFRESH_TOKEN_ROTATION_DEFAULT:
+		return domain.OIDCRefreshTokenRotationDefault
+	default:
+		return domain.OIDCRefreshTokenRotationDefault
+	}
+}
+
 func ComplianceProblemsToLocalizedMessages(problems []string) []*message_pb.LocalizedMessage {
 	converted := make([]*message_pb.LocalizedMessage, len(problems))
 	for i, p := range problems {
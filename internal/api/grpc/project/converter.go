@@ -155,3 +155,58 @@ func RoleViewToPb(role *query.ProjectRole) *proj_pb.Role {
 		),
 	}
 }
+
+func SCIMConnectorQueriesToModel(queries []*proj_pb.SCIMConnectorQuery) (_ []query.SearchQuery, err error) {
+	q := make([]query.SearchQuery, len(queries))
+	for i, query := range queries {
+		q[i], err = SCIMConnectorQueryToModel(query)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return q, nil
+}
+
+func SCIMConnectorQueryToModel(apiQuery *proj_pb.SCIMConnectorQuery) (query.SearchQuery, error) {
+	switch q := apiQuery.Query.(type) {
+	case *proj_pb.SCIMConnectorQuery_NameQuery:
+		return query.NewSCIMConnectorNameSearchQuery(object.TextMethodToQuery(q.NameQuery.Method), q.NameQuery.Name)
+	default:
+		return nil, zerrors.ThrowInvalidArgument(nil, "PROJECT-Ahph5", "List.Query.Invalid")
+	}
+}
+
+func SCIMConnectorsToPb(connectors []*query.SCIMConnector) []*proj_pb.SCIMConnector {
+	c := make([]*proj_pb.SCIMConnector, len(connectors))
+	for i, connector := range connectors {
+		c[i] = SCIMConnectorToPb(connector)
+	}
+	return c
+}
+
+func SCIMConnectorToPb(connector *query.SCIMConnector) *proj_pb.SCIMConnector {
+	return &proj_pb.SCIMConnector{
+		Id:        connector.ID,
+		ProjectId: connector.ProjectID,
+		Name:      connector.Name,
+		Endpoint:  connector.Endpoint,
+		State:     scimConnectorStateToPb(connector.State),
+		Details: object.ToViewDetailsPb(
+			connector.Sequence,
+			connector.CreationDate,
+			connector.EventDate,
+			connector.ResourceOwner,
+		),
+	}
+}
+
+func scimConnectorStateToPb(state domain.SCIMConnectorState) proj_pb.SCIMConnectorState {
+	switch state {
+	case domain.SCIMConnectorStateActive:
+		return proj_pb.SCIMConnectorState_SCIM_CONNECTOR_STATE_ACTIVE
+	case domain.SCIMConnectorStateInactive:
+		return proj_pb.SCIMConnectorState_SCIM_CONNECTOR_STATE_INACTIVE
+	default:
+		return proj_pb.SCIMConnectorState_SCIM_CONNECTOR_STATE_UNSPECIFIED
+	}
+}
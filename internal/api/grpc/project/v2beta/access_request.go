@@ -0,0 +1,235 @@
+package project
+
+import (
+	"context"
+
+	"github.com/muhlemmer/gu"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	filter "github.com/zitadel/zitadel/internal/api/grpc/filter/v2beta"
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
+	project_pb "github.com/zitadel/zitadel/pkg/grpc/project/v2beta"
+)
+
+func (s *Server) CreateAccessRequest(ctx context.Context, req *project_pb.CreateAccessRequestRequest) (*project_pb.CreateAccessRequestResponse, error) {
+	details, err := s.command.AddAccessRequest(ctx, &command.AddAccessRequest{
+		ProjectID:      req.ProjectId,
+		ProjectGrantID: req.GetProjectGrantId(),
+		RoleKeys:       req.RoleKeys,
+		Reason:         req.Reason,
+	})
+	if err != nil {
+		return nil, err
+	}
+	var creationDate *timestamppb.Timestamp
+	if !details.EventDate.IsZero() {
+		creationDate = timestamppb.New(details.EventDate)
+	}
+	return &project_pb.CreateAccessRequestResponse{
+		Id:           details.ID,
+		CreationDate: creationDate,
+	}, nil
+}
+
+func (s *Server) ApproveAccessRequest(ctx context.Context, req *project_pb.ApproveAccessRequestRequest) (*project_pb.ApproveAccessRequestResponse, error) {
+	details, err := s.command.ApproveAccessRequest(ctx, req.Id, "", req.Comment)
+	if err != nil {
+		return nil, err
+	}
+	var changeDate *timestamppb.Timestamp
+	if !details.EventDate.IsZero() {
+		changeDate = timestamppb.New(details.EventDate)
+	}
+	return &project_pb.ApproveAccessRequestResponse{
+		ChangeDate: changeDate,
+	}, nil
+}
+
+func (s *Server) DenyAccessRequest(ctx context.Context, req *project_pb.DenyAccessRequestRequest) (*project_pb.DenyAccessRequestResponse, error) {
+	details, err := s.command.DenyAccessRequest(ctx, req.Id, "", req.Comment)
+	if err != nil {
+		return nil, err
+	}
+	var changeDate *timestamppb.Timestamp
+	if !details.EventDate.IsZero() {
+		changeDate = timestamppb.New(details.EventDate)
+	}
+	return &project_pb.DenyAccessRequestResponse{
+		ChangeDate: changeDate,
+	}, nil
+}
+
+func (s *Server) WithdrawAccessRequest(ctx context.Context, req *project_pb.WithdrawAccessRequestRequest) (*project_pb.WithdrawAccessRequestResponse, error) {
+	details, err := s.command.WithdrawAccessRequest(ctx, req.Id, "")
+	if err != nil {
+		return nil, err
+	}
+	var changeDate *timestamppb.Timestamp
+	if !details.EventDate.IsZero() {
+		changeDate = timestamppb.New(details.EventDate)
+	}
+	return &project_pb.WithdrawAccessRequestResponse{
+		ChangeDate: changeDate,
+	}, nil
+}
+
+func (s *Server) GetAccessRequest(ctx context.Context, req *project_pb.GetAccessRequestRequest) (*project_pb.GetAccessRequestResponse, error) {
+	request, err := s.query.GetAccessRequestByID(ctx, req.Id, s.checkPermission)
+	if err != nil {
+		return nil, err
+	}
+	return &project_pb.GetAccessRequestResponse{
+		AccessRequest: accessRequestToPb(request),
+	}, nil
+}
+
+func (s *Server) ListAccessRequests(ctx context.Context, req *project_pb.ListAccessRequestsRequest) (*project_pb.ListAccessRequestsResponse, error) {
+	queries, err := s.listAccessRequestsRequestToModel(req)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.query.SearchAccessRequests(ctx, queries, s.checkPermission)
+	if err != nil {
+		return nil, err
+	}
+	return &project_pb.ListAccessRequestsResponse{
+		AccessRequests: accessRequestsToPb(resp.AccessRequests),
+		Pagination:     filter.QueryToPaginationPb(queries.SearchRequest, resp.SearchResponse),
+	}, nil
+}
+
+func (s *Server) listAccessRequestsRequestToModel(req *project_pb.ListAccessRequestsRequest) (*query.AccessRequestSearchQueries, error) {
+	offset, limit, asc, err := filter.PaginationPbToQuery(s.systemDefaults, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	queries, err := accessRequestFiltersToModel(req.Filters)
+	if err != nil {
+		return nil, err
+	}
+	return &query.AccessRequestSearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset:        offset,
+			Limit:         limit,
+			Asc:           asc,
+			SortingColumn: accessRequestFieldNameToSortingColumn(req.SortingColumn),
+		},
+		Queries: queries,
+	}, nil
+}
+
+func accessRequestFieldNameToSortingColumn(field *project_pb.AccessRequestFieldName) query.Column {
+	if field == nil {
+		return query.AccessRequestColumnCreationDate
+	}
+	switch *field {
+	case project_pb.AccessRequestFieldName_ACCESS_REQUEST_FIELD_NAME_CREATION_DATE:
+		return query.AccessRequestColumnCreationDate
+	case project_pb.AccessRequestFieldName_ACCESS_REQUEST_FIELD_NAME_CHANGE_DATE:
+		return query.AccessRequestColumnChangeDate
+	case project_pb.AccessRequestFieldName_ACCESS_REQUEST_FIELD_NAME_STATE:
+		return query.AccessRequestColumnState
+	case project_pb.AccessRequestFieldName_ACCESS_REQUEST_FIELD_NAME_UNSPECIFIED:
+		return query.AccessRequestColumnCreationDate
+	default:
+		return query.AccessRequestColumnCreationDate
+	}
+}
+
+func accessRequestFiltersToModel(queries []*project_pb.AccessRequestSearchFilter) (_ []query.SearchQuery, err error) {
+	q := make([]query.SearchQuery, len(queries))
+	for i, qry := range queries {
+		q[i], err = accessRequestFilterToModel(qry)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return q, nil
+}
+
+func accessRequestFilterToModel(filter *project_pb.AccessRequestSearchFilter) (query.SearchQuery, error) {
+	switch q := filter.Filter.(type) {
+	case *project_pb.AccessRequestSearchFilter_ProjectIdFilter:
+		return query.NewAccessRequestProjectIDSearchQuery(q.ProjectIdFilter.ProjectId)
+	case *project_pb.AccessRequestSearchFilter_ProjectGrantIdFilter:
+		return query.NewAccessRequestProjectGrantIDSearchQuery(q.ProjectGrantIdFilter.ProjectGrantId)
+	case *project_pb.AccessRequestSearchFilter_UserIdFilter:
+		return query.NewAccessRequestUserIDSearchQuery(q.UserIdFilter.UserId)
+	case *project_pb.AccessRequestSearchFilter_StateFilter:
+		return query.NewAccessRequestStateSearchQuery(accessRequestStateToDomain(q.StateFilter.State))
+	case *project_pb.AccessRequestSearchFilter_OrganizationIdFilter:
+		return query.NewAccessRequestResourceOwnerSearchQuery(q.OrganizationIdFilter.OrganizationId)
+	default:
+		return nil, zerrors.ThrowInvalidArgument(nil, "PROJECT-aeX7o", "List.Query.Invalid")
+	}
+}
+
+func accessRequestsToPb(requests []*query.AccessRequest) []*project_pb.AccessRequest {
+	r := make([]*project_pb.AccessRequest, len(requests))
+	for i, request := range requests {
+		r[i] = accessRequestToPb(request)
+	}
+	return r
+}
+
+func accessRequestToPb(request *query.AccessRequest) *project_pb.AccessRequest {
+	return &project_pb.AccessRequest{
+		Id:              request.ID,
+		CreationDate:    timestamppb.New(request.CreationDate),
+		ChangeDate:      timestamppb.New(request.EventDate),
+		OrganizationId:  request.ResourceOwner,
+		State:           accessRequestStateToPb(request.State),
+		UserId:          request.UserID,
+		ProjectId:       request.ProjectID,
+		ProjectGrantId:  optionalString(request.ProjectGrantID),
+		RoleKeys:        request.RoleKeys,
+		Reason:          request.Reason,
+		DeciderId:       optionalString(request.DeciderID),
+		DecisionComment: request.DecisionComment,
+		UserGrantId:     optionalString(request.UserGrantID),
+	}
+}
+
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return gu.Ptr(s)
+}
+
+func accessRequestStateToPb(state domain.AccessRequestState) project_pb.AccessRequestState {
+	switch state {
+	case domain.AccessRequestStatePending:
+		return project_pb.AccessRequestState_ACCESS_REQUEST_STATE_PENDING
+	case domain.AccessRequestStateApproved:
+		return project_pb.AccessRequestState_ACCESS_REQUEST_STATE_APPROVED
+	case domain.AccessRequestStateDenied:
+		return project_pb.AccessRequestState_ACCESS_REQUEST_STATE_DENIED
+	case domain.AccessRequestStateWithdrawn:
+		return project_pb.AccessRequestState_ACCESS_REQUEST_STATE_WITHDRAWN
+	case domain.AccessRequestStateUnspecified:
+		return project_pb.AccessRequestState_ACCESS_REQUEST_STATE_UNSPECIFIED
+	default:
+		return project_pb.AccessRequestState_ACCESS_REQUEST_STATE_UNSPECIFIED
+	}
+}
+
+func accessRequestStateToDomain(state project_pb.AccessRequestState) domain.AccessRequestState {
+	switch state {
+	case project_pb.AccessRequestState_ACCESS_REQUEST_STATE_PENDING:
+		return domain.AccessRequestStatePending
+	case project_pb.AccessRequestState_ACCESS_REQUEST_STATE_APPROVED:
+		return domain.AccessRequestStateApproved
+	case project_pb.AccessRequestState_ACCESS_REQUEST_STATE_DENIED:
+		return domain.AccessRequestStateDenied
+	case project_pb.AccessRequestState_ACCESS_REQUEST_STATE_WITHDRAWN:
+		return domain.AccessRequestStateWithdrawn
+	case project_pb.AccessRequestState_ACCESS_REQUEST_STATE_UNSPECIFIED:
+		return domain.AccessRequestStateUnspecified
+	default:
+		return domain.AccessRequestStateUnspecified
+	}
+}
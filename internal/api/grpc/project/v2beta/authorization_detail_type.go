@@ -0,0 +1,79 @@
+package project
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
+	project_pb "github.com/zitadel/zitadel/pkg/grpc/project/v2beta"
+)
+
+func (s *Server) SetProjectAuthorizationDetailType(ctx context.Context, req *project_pb.SetProjectAuthorizationDetailTypeRequest) (*project_pb.SetProjectAuthorizationDetailTypeResponse, error) {
+	schema, err := req.GetSchema().MarshalJSON()
+	if err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "PROJECT-Adt5q", "Errors.Project.AuthorizationDetailType.SchemaInvalid")
+	}
+	details, err := s.command.SetProjectAuthorizationDetailType(ctx, &command.SetProjectAuthorizationDetailType{
+		ProjectID: req.GetProjectId(),
+		Type:      req.GetType(),
+		Schema:    schema,
+	})
+	if err != nil {
+		return nil, err
+	}
+	var changeDate *timestamppb.Timestamp
+	if !details.EventDate.IsZero() {
+		changeDate = timestamppb.New(details.EventDate)
+	}
+	return &project_pb.SetProjectAuthorizationDetailTypeResponse{
+		ChangeDate: changeDate,
+	}, nil
+}
+
+func (s *Server) RemoveProjectAuthorizationDetailType(ctx context.Context, req *project_pb.RemoveProjectAuthorizationDetailTypeRequest) (*project_pb.RemoveProjectAuthorizationDetailTypeResponse, error) {
+	details, err := s.command.RemoveProjectAuthorizationDetailType(ctx, req.GetProjectId(), "", req.GetType())
+	if err != nil {
+		return nil, err
+	}
+	var deletionDate *timestamppb.Timestamp
+	if !details.EventDate.IsZero() {
+		deletionDate = timestamppb.New(details.EventDate)
+	}
+	return &project_pb.RemoveProjectAuthorizationDetailTypeResponse{
+		RemovalDate: deletionDate,
+	}, nil
+}
+
+func (s *Server) ListProjectAuthorizationDetailTypes(ctx context.Context, req *project_pb.ListProjectAuthorizationDetailTypesRequest) (*project_pb.ListProjectAuthorizationDetailTypesResponse, error) {
+	types, err := s.query.ProjectAuthorizationDetailTypes(ctx, req.GetProjectId())
+	if err != nil {
+		return nil, err
+	}
+	result, err := authorizationDetailTypesToPb(types)
+	if err != nil {
+		return nil, err
+	}
+	return &project_pb.ListProjectAuthorizationDetailTypesResponse{
+		AuthorizationDetailTypes: result,
+	}, nil
+}
+
+func authorizationDetailTypesToPb(types []*query.ProjectAuthorizationDetailType) ([]*project_pb.ProjectAuthorizationDetailType, error) {
+	result := make([]*project_pb.ProjectAuthorizationDetailType, len(types))
+	for i, detailType := range types {
+		schema := new(structpb.Struct)
+		if err := schema.UnmarshalJSON(detailType.Schema); err != nil {
+			return nil, zerrors.ThrowInternal(err, "PROJECT-Adt6q", "Errors.Internal")
+		}
+		result[i] = &project_pb.ProjectAuthorizationDetailType{
+			ProjectId: detailType.ProjectID,
+			Type:      detailType.Type,
+			Schema:    schema,
+		}
+	}
+	return result, nil
+}
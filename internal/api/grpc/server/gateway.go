@@ -20,6 +20,7 @@ import (
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
 
+	grpc_api "github.com/zitadel/zitadel/internal/api/grpc"
 	client_middleware "github.com/zitadel/zitadel/internal/api/grpc/client/middleware"
 	http_utils "github.com/zitadel/zitadel/internal/api/http"
 	http_mw "github.com/zitadel/zitadel/internal/api/http/middleware"
@@ -34,6 +35,7 @@ const (
 var (
 	customHeaders = []string{
 		"x-zitadel-",
+		"dpop",
 	}
 	jsonMarshaler = &runtime.JSONPb{
 		UnmarshalOptions: protojson.UnmarshalOptions{
@@ -71,6 +73,8 @@ var (
 			runtime.WithMarshalerOption(mimeWildcard, jsonMarshaler),
 			runtime.WithMarshalerOption(runtime.MIMEWildcard, jsonMarshaler),
 			runtime.WithIncomingHeaderMatcher(headerMatcher(hostHeaders)),
+			runtime.WithMetadata(grpc_api.DPoPRequestMetadata),
+			runtime.WithMetadata(grpc_api.ClientCertificateMetadata),
 			runtime.WithOutgoingHeaderMatcher(runtime.DefaultHeaderMatcher),
 			runtime.WithForwardResponseOption(responseForwarder),
 			runtime.WithRoutingErrorHandler(httpErrorHandler),
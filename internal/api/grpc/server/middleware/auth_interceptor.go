@@ -2,12 +2,14 @@ package middleware
 
 import (
 	"context"
+	net_http "net/http"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/dpop"
 	grpc_util "github.com/zitadel/zitadel/internal/api/grpc"
 	"github.com/zitadel/zitadel/internal/api/http"
 	"github.com/zitadel/zitadel/internal/telemetry/tracing"
@@ -33,6 +35,7 @@ func authorize(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
 		return nil, status.Error(codes.Unauthenticated, "auth header missing")
 	}
 
+	authCtx = authz.WithDPoPRequest(authCtx, grpc_util.GetHeader(authCtx, dpop.HeaderName), dpopRequest(authCtx, info.FullMethod))
 	orgID, orgDomain := orgIDAndDomainFromRequest(authCtx, req)
 	ctxSetter, err := authz.CheckUserAuthorization(authCtx, req, authToken, orgID, orgDomain, verifier, systemUserPermissions.RolePermissionMappings, authConfig.RolePermissionMappings, authOpt, info.FullMethod)
 	if err != nil {
@@ -42,6 +45,20 @@ func authorize(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
 	return handler(ctxSetter(ctx), req)
 }
 
+// dpopRequest returns the http method and url a DPoP proof must be bound to.
+// For calls through the gateway these are the method and path of the REST request,
+// for gRPC calls the proof must be bound to the POST request of the full method.
+func dpopRequest(ctx context.Context, fullMethod string) dpop.Request {
+	method, path := grpc_util.GetDPoPRequestHeaders(ctx)
+	if method == "" || path == "" {
+		method, path = net_http.MethodPost, fullMethod
+	}
+	return dpop.Request{
+		Method: method,
+		URL:    http.DomainContext(ctx).Origin() + path,
+	}
+}
+
 func orgIDAndDomainFromRequest(ctx context.Context, req interface{}) (id, domain string) {
 	orgID := grpc_util.GetHeader(ctx, http.ZitadelOrgID)
 	oz, ok := req.(OrganizationFromRequest)
@@ -56,6 +56,15 @@ func (e *mockExecutionTarget) GetExecutionID() string {
 func (e *mockExecutionTarget) GetSigningKey() string {
 	return e.SigningKey
 }
+func (e *mockExecutionTarget) GetClientCertificate() []byte {
+	return nil
+}
+func (e *mockExecutionTarget) GetClientKey() []byte {
+	return nil
+}
+func (e *mockExecutionTarget) GetRootCA() []byte {
+	return nil
+}
 
 func newMockContentRequest(content string) proto.Message {
 	return &structpb.Struct{
@@ -0,0 +1,77 @@
+package middleware
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc"
+
+	"github.com/zitadel/zitadel/internal/api/grpc/gerrors"
+	"github.com/zitadel/zitadel/internal/i18n"
+)
+
+// ServerStreamInterceptor applies the unary interceptor to the streaming calls of the services with the servicePrefix (e.g. `zitadel.`).
+// Streams of other services, such as the server reflection or health watches, are passed to the handler unchanged.
+// The interceptor is called with the first request message once it was received,
+// so instance, authorization and validation are handled the same way as for unary calls.
+// On client streams the following messages are passed to the handler without interception.
+// Sent messages and returned errors are localized like the responses of unary calls.
+func ServerStreamInterceptor(unary grpc.UnaryServerInterceptor, servicePrefix string) grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if !strings.HasPrefix(info.FullMethod, "/"+servicePrefix) {
+			return handler(srv, stream)
+		}
+		wrapped := &interceptedServerStream{
+			ServerStream: stream,
+			interceptor:  unary,
+			info:         &grpc.UnaryServerInfo{Server: srv, FullMethod: info.FullMethod},
+		}
+		err := handler(srv, wrapped)
+		if err != nil && wrapped.ctx != nil {
+			if translator, translatorErr := getTranslator(wrapped.ctx); translatorErr == nil {
+				err = translateError(wrapped.ctx, err, translator)
+			}
+		}
+		return gerrors.ZITADELToGRPCError(err)
+	}
+}
+
+type interceptedServerStream struct {
+	grpc.ServerStream
+	interceptor grpc.UnaryServerInterceptor
+	info        *grpc.UnaryServerInfo
+	ctx         context.Context
+	translator  *i18n.Translator
+}
+
+// Context returns the context enriched by the unary interceptor
+// after the request message was received.
+func (s *interceptedServerStream) Context() context.Context {
+	if s.ctx != nil {
+		return s.ctx
+	}
+	return s.ServerStream.Context()
+}
+
+// RecvMsg receives the request message and passes the first one through the unary interceptor.
+func (s *interceptedServerStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil || s.ctx != nil {
+		return err
+	}
+	_, err := s.interceptor(s.ServerStream.Context(), m, s.info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		s.ctx = ctx
+		return req, nil
+	})
+	return err
+}
+
+// SendMsg localizes the fields of the message before it is sent.
+func (s *interceptedServerStream) SendMsg(m interface{}) error {
+	if loc, ok := m.(localizers); ok && s.ctx != nil {
+		if s.translator == nil {
+			s.translator, _ = getTranslator(s.ctx)
+		}
+		translateFields(s.ctx, loc, s.translator)
+	}
+	return s.ServerStream.SendMsg(m)
+}
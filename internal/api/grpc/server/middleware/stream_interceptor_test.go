@@ -0,0 +1,96 @@
+package middleware
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type ctxKey struct{}
+
+type mockServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *mockServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *mockServerStream) RecvMsg(interface{}) error {
+	return nil
+}
+
+func TestServerStreamInterceptor(t *testing.T) {
+	addValue := func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		return handler(context.WithValue(ctx, ctxKey{}, info.FullMethod), req)
+	}
+	deny := func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		return nil, zerrors.ThrowPermissionDenied(nil, "TEST-Ub5ai", "denied")
+	}
+	tests := []struct {
+		name           string
+		interceptor    grpc.UnaryServerInterceptor
+		fullMethod     string
+		isClientStream bool
+		wantValue      interface{}
+		wantCode       codes.Code
+	}{
+		{
+			name:        "context passed",
+			interceptor: addValue,
+			fullMethod:  "/zitadel.test.v1.TestService/Stream",
+			wantValue:   "/zitadel.test.v1.TestService/Stream",
+			wantCode:    codes.OK,
+		},
+		{
+			name:        "interceptor error",
+			interceptor: deny,
+			fullMethod:  "/zitadel.test.v1.TestService/Stream",
+			wantCode:    codes.PermissionDenied,
+		},
+		{
+			name:        "other service not intercepted",
+			interceptor: deny,
+			fullMethod:  "/grpc.reflection.v1.ServerReflection/ServerReflectionInfo",
+			wantCode:    codes.OK,
+		},
+		{
+			name:           "client stream",
+			interceptor:    addValue,
+			fullMethod:     "/zitadel.test.v1.TestService/Stream",
+			isClientStream: true,
+			wantValue:      "/zitadel.test.v1.TestService/Stream",
+			wantCode:       codes.OK,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotValue interface{}
+			handler := func(srv interface{}, stream grpc.ServerStream) error {
+				if err := stream.RecvMsg(&mockReq{}); err != nil {
+					return err
+				}
+				gotValue = stream.Context().Value(ctxKey{})
+				if tt.isClientStream {
+					return stream.RecvMsg(&mockReq{})
+				}
+				return nil
+			}
+			err := ServerStreamInterceptor(tt.interceptor, "zitadel.")(
+				nil,
+				&mockServerStream{ctx: context.Background()},
+				&grpc.StreamServerInfo{FullMethod: tt.fullMethod, IsServerStream: true, IsClientStream: tt.isClientStream},
+				handler,
+			)
+			assert.Equal(t, tt.wantCode, status.Code(err))
+			assert.Equal(t, tt.wantValue, gotValue)
+		})
+	}
+}
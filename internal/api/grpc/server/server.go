@@ -18,6 +18,10 @@ import (
 	system_pb "github.com/zitadel/zitadel/pkg/grpc/system"
 )
 
+// zitadelServicePrefix is the prefix of the services of ZITADEL,
+// only their streams are intercepted, see [middleware.ServerStreamInterceptor].
+const zitadelServicePrefix = "zitadel."
+
 type Server interface {
 	RegisterServer(*grpc.Server)
 	RegisterGateway() RegisterGatewayFunc
@@ -63,6 +67,21 @@ func CreateServer(
 				middleware.ActivityInterceptor(),
 			),
 		),
+		grpc.StreamInterceptor(
+			middleware.ServerStreamInterceptor(
+				grpc_middleware.ChainUnaryServer(
+					middleware.CallDurationHandler(),
+					middleware.InstanceInterceptor(queries, externalDomain, system_pb.SystemService_ServiceDesc.ServiceName, healthpb.Health_ServiceDesc.ServiceName),
+					middleware.ErrorHandler(),
+					middleware.LimitsInterceptor(system_pb.SystemService_ServiceDesc.ServiceName),
+					middleware.AuthorizationInterceptor(verifier, systemAuthz, authConfig),
+					middleware.TranslationHandler(),
+					middleware.ValidationHandler(),
+					middleware.ServiceHandler(),
+				),
+				zitadelServicePrefix,
+			),
+		),
 		grpc.StatsHandler(middleware.DefaultTracingServer()),
 	}
 	if tlsConfig != nil {
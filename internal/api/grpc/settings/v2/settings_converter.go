@@ -75,6 +75,8 @@ func secondFactorTypeToPb(secondFactorType domain.SecondFactorType) settings.Sec
 		return settings.SecondFactorType_SECOND_FACTOR_TYPE_OTP_EMAIL
 	case domain.SecondFactorTypeOTPSMS:
 		return settings.SecondFactorType_SECOND_FACTOR_TYPE_OTP_SMS
+	case domain.SecondFactorTypeRecoveryCodes:
+		return settings.SecondFactorType_SECOND_FACTOR_TYPE_RECOVERY_CODES
 	case domain.SecondFactorTypeUnspecified:
 		return settings.SecondFactorType_SECOND_FACTOR_TYPE_UNSPECIFIED
 	default:
@@ -109,6 +111,8 @@ func passwordExpirySettingsToPb(current *query.PasswordAgePolicy) *settings.Pass
 		MaxAgeDays:        current.MaxAgeDays,
 		ExpireWarnDays:    current.ExpireWarnDays,
 		ResourceOwnerType: isDefaultToResourceOwnerTypePb(current.IsDefault),
+		HistoryCount:      current.HistoryCount,
+		MinAgeDays:        current.MinAgeDays,
 	}
 }
 
@@ -46,6 +46,7 @@ func Test_loginSettingsToPb(t *testing.T) {
 			domain.SecondFactorTypeU2F,
 			domain.SecondFactorTypeOTPEmail,
 			domain.SecondFactorTypeOTPSMS,
+			domain.SecondFactorTypeRecoveryCodes,
 		},
 		MultiFactors: []domain.MultiFactorType{
 			domain.MultiFactorTypeU2FWithPIN,
@@ -76,6 +77,7 @@ func Test_loginSettingsToPb(t *testing.T) {
 			settings.SecondFactorType_SECOND_FACTOR_TYPE_U2F,
 			settings.SecondFactorType_SECOND_FACTOR_TYPE_OTP_EMAIL,
 			settings.SecondFactorType_SECOND_FACTOR_TYPE_OTP_SMS,
+			settings.SecondFactorType_SECOND_FACTOR_TYPE_RECOVERY_CODES,
 		},
 		MultiFactors: []settings.MultiFactorType{
 			settings.MultiFactorType_MULTI_FACTOR_TYPE_U2F_WITH_VERIFICATION,
@@ -243,12 +245,16 @@ func Test_passwordExpirySettingsToPb(t *testing.T) {
 	arg := &query.PasswordAgePolicy{
 		ExpireWarnDays: 80,
 		MaxAgeDays:     90,
+		HistoryCount:   5,
+		MinAgeDays:     1,
 		IsDefault:      true,
 	}
 	want := &settings.PasswordExpirySettings{
 		ExpireWarnDays:    80,
 		MaxAgeDays:        90,
 		ResourceOwnerType: settings.ResourceOwnerType_RESOURCE_OWNER_TYPE_INSTANCE,
+		HistoryCount:      5,
+		MinAgeDays:        1,
 	}
 
 	got := passwordExpirySettingsToPb(arg)
@@ -0,0 +1,44 @@
+package system
+
+import (
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	system_pb "github.com/zitadel/zitadel/pkg/grpc/system"
+)
+
+func (s *Server) ExportInstance(req *system_pb.ExportInstanceRequest, stream system_pb.SystemService_ExportInstanceServer) error {
+	return s.command.ExportInstance(stream.Context(), req.GetTransferKey(), func(line []byte) error {
+		return stream.Send(&system_pb.ExportInstanceResponse{Line: string(line)})
+	})
+}
+
+func (s *Server) ImportInstance(stream system_pb.SystemService_ImportInstanceServer) error {
+	first, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	pending := first.GetLine()
+	next := func() ([]byte, error) {
+		if pending != "" {
+			line := pending
+			pending = ""
+			return []byte(line), nil
+		}
+		for {
+			req, err := stream.Recv()
+			if err != nil {
+				return nil, err
+			}
+			if line := req.GetLine(); line != "" {
+				return []byte(line), nil
+			}
+		}
+	}
+	instanceID, details, err := s.command.ImportInstance(stream.Context(), first.GetTransferKey(), next)
+	if err != nil {
+		return err
+	}
+	return stream.SendAndClose(&system_pb.ImportInstanceResponse{
+		InstanceId: instanceID,
+		Details:    object.DomainToAddDetailsPb(details),
+	})
+}
@@ -0,0 +1,177 @@
+package user
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
+	user_pb "github.com/zitadel/zitadel/pkg/grpc/user"
+)
+
+func AccessReviewQueriesToModel(queries []*user_pb.AccessReviewQuery) (_ []query.SearchQuery, err error) {
+	q := make([]query.SearchQuery, len(queries))
+	for i, query := range queries {
+		q[i], err = AccessReviewQueryToModel(query)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return q, nil
+}
+
+func AccessReviewQueryToModel(apiQuery *user_pb.AccessReviewQuery) (query.SearchQuery, error) {
+	switch q := apiQuery.Query.(type) {
+	case *user_pb.AccessReviewQuery_StateQuery:
+		return query.NewAccessReviewStateSearchQuery(AccessReviewStateToDomain(q.StateQuery.State))
+	case *user_pb.AccessReviewQuery_ProjectIdQuery:
+		return query.NewAccessReviewProjectIDSearchQuery(q.ProjectIdQuery.ProjectId)
+	default:
+		return nil, zerrors.ThrowInvalidArgument(nil, "USER-Ahb4o", "List.Query.Invalid")
+	}
+}
+
+func AccessReviewItemQueriesToModel(queries []*user_pb.AccessReviewItemQuery) (_ []query.SearchQuery, err error) {
+	q := make([]query.SearchQuery, len(queries))
+	for i, query := range queries {
+		q[i], err = AccessReviewItemQueryToModel(query)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return q, nil
+}
+
+func AccessReviewItemQueryToModel(apiQuery *user_pb.AccessReviewItemQuery) (query.SearchQuery, error) {
+	switch q := apiQuery.Query.(type) {
+	case *user_pb.AccessReviewItemQuery_ReviewIdQuery:
+		return query.NewAccessReviewItemReviewIDSearchQuery(q.ReviewIdQuery.ReviewId)
+	case *user_pb.AccessReviewItemQuery_ReviewerIdQuery:
+		return query.NewAccessReviewItemReviewerIDSearchQuery(q.ReviewerIdQuery.ReviewerId)
+	case *user_pb.AccessReviewItemQuery_UserIdQuery:
+		return query.NewAccessReviewItemUserIDSearchQuery(q.UserIdQuery.UserId)
+	case *user_pb.AccessReviewItemQuery_DecisionQuery:
+		return query.NewAccessReviewItemDecisionSearchQuery(AccessReviewDecisionToDomain(q.DecisionQuery.Decision))
+	default:
+		return nil, zerrors.ThrowInvalidArgument(nil, "USER-Eing5", "List.Query.Invalid")
+	}
+}
+
+func AccessReviewsToPb(reviews []*query.AccessReview) []*user_pb.AccessReview {
+	r := make([]*user_pb.AccessReview, len(reviews))
+	for i, review := range reviews {
+		r[i] = AccessReviewToPb(review)
+	}
+	return r
+}
+
+func AccessReviewToPb(review *query.AccessReview) *user_pb.AccessReview {
+	return &user_pb.AccessReview{
+		Id:        review.ID,
+		Details:   object.ToViewDetailsPb(review.Sequence, review.CreationDate, review.EventDate, review.ResourceOwner),
+		State:     AccessReviewStateToPb(review.State),
+		Name:      review.Name,
+		ProjectId: review.ProjectID,
+		Deadline:  timestamppb.New(review.Deadline),
+	}
+}
+
+func AccessReviewItemsToPb(items []*query.AccessReviewItem) []*user_pb.AccessReviewItem {
+	i := make([]*user_pb.AccessReviewItem, len(items))
+	for j, item := range items {
+		i[j] = AccessReviewItemToPb(item)
+	}
+	return i
+}
+
+func AccessReviewItemToPb(item *query.AccessReviewItem) *user_pb.AccessReviewItem {
+	return &user_pb.AccessReviewItem{
+		Id:             item.ID,
+		Details:        object.ChangeToDetailsPb(0, item.ChangeDate, item.ResourceOwner),
+		ReviewId:       item.ReviewID,
+		ReviewName:     item.ReviewName,
+		Deadline:       timestamppb.New(item.Deadline),
+		Type:           AccessReviewItemTypeToPb(item.Type),
+		UserId:         item.UserID,
+		ProjectId:      item.ProjectID,
+		ProjectGrantId: item.ProjectGrantID,
+		UserGrantId:    item.UserGrantID,
+		Roles:          item.Roles,
+		ReviewerId:     item.ReviewerID,
+		Decision:       AccessReviewDecisionToPb(item.Decision),
+		DeciderId:      item.DeciderID,
+		Comment:        item.Comment,
+	}
+}
+
+func AccessReviewStateToPb(state domain.AccessReviewState) user_pb.AccessReviewState {
+	switch state {
+	case domain.AccessReviewStateActive:
+		return user_pb.AccessReviewState_ACCESS_REVIEW_STATE_ACTIVE
+	case domain.AccessReviewStateClosed:
+		return user_pb.AccessReviewState_ACCESS_REVIEW_STATE_CLOSED
+	case domain.AccessReviewStateUnspecified:
+		return user_pb.AccessReviewState_ACCESS_REVIEW_STATE_UNSPECIFIED
+	default:
+		return user_pb.AccessReviewState_ACCESS_REVIEW_STATE_UNSPECIFIED
+	}
+}
+
+func AccessReviewStateToDomain(state user_pb.AccessReviewState) domain.AccessReviewState {
+	switch state {
+	case user_pb.AccessReviewState_ACCESS_REVIEW_STATE_ACTIVE:
+		return domain.AccessReviewStateActive
+	case user_pb.AccessReviewState_ACCESS_REVIEW_STATE_CLOSED:
+		return domain.AccessReviewStateClosed
+	case user_pb.AccessReviewState_ACCESS_REVIEW_STATE_UNSPECIFIED:
+		return domain.AccessReviewStateUnspecified
+	default:
+		return domain.AccessReviewStateUnspecified
+	}
+}
+
+func AccessReviewItemTypeToPb(itemType domain.AccessReviewItemType) user_pb.AccessReviewItemType {
+	switch itemType {
+	case domain.AccessReviewItemTypeUserGrant:
+		return user_pb.AccessReviewItemType_ACCESS_REVIEW_ITEM_TYPE_USER_GRANT
+	case domain.AccessReviewItemTypeOrgMember:
+		return user_pb.AccessReviewItemType_ACCESS_REVIEW_ITEM_TYPE_ORG_MEMBER
+	case domain.AccessReviewItemTypeProjectMember:
+		return user_pb.AccessReviewItemType_ACCESS_REVIEW_ITEM_TYPE_PROJECT_MEMBER
+	case domain.AccessReviewItemTypeUnspecified:
+		return user_pb.AccessReviewItemType_ACCESS_REVIEW_ITEM_TYPE_UNSPECIFIED
+	default:
+		return user_pb.AccessReviewItemType_ACCESS_REVIEW_ITEM_TYPE_UNSPECIFIED
+	}
+}
+
+func AccessReviewDecisionToPb(decision domain.AccessReviewDecision) user_pb.AccessReviewDecision {
+	switch decision {
+	case domain.AccessReviewDecisionKeep:
+		return user_pb.AccessReviewDecision_ACCESS_REVIEW_DECISION_KEEP
+	case domain.AccessReviewDecisionRevoke:
+		return user_pb.AccessReviewDecision_ACCESS_REVIEW_DECISION_REVOKE
+	case domain.AccessReviewDecisionExpired:
+		return user_pb.AccessReviewDecision_ACCESS_REVIEW_DECISION_EXPIRED
+	case domain.AccessReviewDecisionPending:
+		return user_pb.AccessReviewDecision_ACCESS_REVIEW_DECISION_PENDING
+	default:
+		return user_pb.AccessReviewDecision_ACCESS_REVIEW_DECISION_PENDING
+	}
+}
+
+func AccessReviewDecisionToDomain(decision user_pb.AccessReviewDecision) domain.AccessReviewDecision {
+	switch decision {
+	case user_pb.AccessReviewDecision_ACCESS_REVIEW_DECISION_KEEP:
+		return domain.AccessReviewDecisionKeep
+	case user_pb.AccessReviewDecision_ACCESS_REVIEW_DECISION_REVOKE:
+		return domain.AccessReviewDecisionRevoke
+	case user_pb.AccessReviewDecision_ACCESS_REVIEW_DECISION_EXPIRED:
+		return domain.AccessReviewDecisionExpired
+	case user_pb.AccessReviewDecision_ACCESS_REVIEW_DECISION_PENDING:
+		return domain.AccessReviewDecisionPending
+	default:
+		return domain.AccessReviewDecisionPending
+	}
+}
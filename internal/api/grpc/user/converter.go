@@ -223,6 +223,10 @@ func AuthMethodToPb(mfa *query.AuthMethod) *user_pb.AuthFactor {
 		factor.Type = &user_pb.AuthFactor_OtpEmail{
 			OtpEmail: &user_pb.AuthFactorOTPEmail{},
 		}
+	case domain.UserAuthMethodTypeRecoveryCodes:
+		factor.Type = &user_pb.AuthFactor_RecoveryCodes{
+			RecoveryCodes: &user_pb.AuthFactorRecoveryCodes{},
+		}
 	}
 	return factor
 }
@@ -16,8 +16,10 @@ import (
 	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/integration"
 	"github.com/zitadel/zitadel/pkg/grpc/feature/v2"
+	"github.com/zitadel/zitadel/pkg/grpc/management"
 	"github.com/zitadel/zitadel/pkg/grpc/object/v2"
 	"github.com/zitadel/zitadel/pkg/grpc/session/v2"
 	"github.com/zitadel/zitadel/pkg/grpc/user/v2"
@@ -1274,6 +1276,79 @@ func TestServer_SystemUsers_ListUsers(t *testing.T) {
 	}
 }
 
+func TestServer_ListUsers_DelegatedAdministration(t *testing.T) {
+	defer func() {
+		_, err := Instance.Client.FeatureV2.ResetInstanceFeatures(IamCTX, &feature.ResetInstanceFeaturesRequest{})
+		require.NoError(t, err)
+	}()
+
+	parent := Instance.CreateOrganization(IamCTX, fmt.Sprintf("ListUsersParentOrg-%s", gofakeit.AppName()), gofakeit.Email())
+	delegating := Instance.CreateOrganization(IamCTX, fmt.Sprintf("ListUsersDelegatingOrg-%s", gofakeit.AppName()), gofakeit.Email())
+	notDelegating := Instance.CreateOrganization(IamCTX, fmt.Sprintf("ListUsersNotDelegatingOrg-%s", gofakeit.AppName()), gofakeit.Email())
+	_, err := Instance.Client.Mgmt.SetOrgParent(integration.SetOrgID(IamCTX, delegating.GetOrganizationId()), &management.SetOrgParentRequest{
+		ParentOrgId:            parent.GetOrganizationId(),
+		DelegateAdministration: true,
+	})
+	require.NoError(t, err)
+	_, err = Instance.Client.Mgmt.SetOrgParent(integration.SetOrgID(IamCTX, notDelegating.GetOrganizationId()), &management.SetOrgParentRequest{
+		ParentOrgId: parent.GetOrganizationId(),
+	})
+	require.NoError(t, err)
+	delegatingUser := createUser(IamCTX, delegating.GetOrganizationId(), false)
+	notDelegatingUser := createUser(IamCTX, notDelegating.GetOrganizationId(), false)
+
+	// the manager is only member of the parent organization
+	_, pat, err := Instance.CreateMachineUserPATWithMembership(integration.SetOrgID(IamCTX, parent.GetOrganizationId()), domain.RoleOrgOwner)
+	require.NoError(t, err)
+	managerCTX := integration.WithAuthorizationToken(CTX, pat)
+
+	tests := []struct {
+		name    string
+		orgID   string
+		userID  string
+		wantIDs []string
+	}{
+		{
+			name:    "delegating child organization",
+			orgID:   delegating.GetOrganizationId(),
+			userID:  delegatingUser.UserID,
+			wantIDs: []string{delegatingUser.UserID},
+		},
+		{
+			name:   "not delegating child organization",
+			orgID:  notDelegating.GetOrganizationId(),
+			userID: notDelegatingUser.UserID,
+		},
+	}
+
+	// both the membership lookup (permission_check_v2 not set) and the permitted organizations (permission_check_v2 set)
+	// must grant the members of the parent organization access to the users of delegating child organizations
+	for _, f := range permissionCheckV2Settings {
+		f := f
+		for _, tt := range tests {
+			t.Run(f.TestNamePrependString+tt.name, func(t *testing.T) {
+				setPermissionCheckV2Flag(t, f.SetFlag)
+
+				retryDuration, tick := integration.WaitForAndTickWithMaxDuration(managerCTX, 1*time.Minute)
+				require.EventuallyWithT(t, func(ttt *assert.CollectT) {
+					got, err := Client.ListUsers(managerCTX, &user.ListUsersRequest{
+						Queries: []*user.SearchQuery{
+							OrganizationIdQuery(tt.orgID),
+							InUserIDsQuery([]string{tt.userID}),
+						},
+					})
+					require.NoError(ttt, err)
+					gotIDs := make([]string, len(got.GetResult()))
+					for i, u := range got.GetResult() {
+						gotIDs[i] = u.GetUserId()
+					}
+					assert.ElementsMatch(ttt, tt.wantIDs, gotIDs)
+				}, retryDuration, tick, "timeout waiting for expected user result")
+			})
+		}
+	}
+}
+
 func InUserIDsQuery(ids []string) *user.SearchQuery {
 	return &user.SearchQuery{
 		Query: &user.SearchQuery_InUserIdsQuery{
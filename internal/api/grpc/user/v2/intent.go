@@ -83,6 +83,11 @@ func (s *Server) startLDAPIntent(ctx context.Context, idpID string, ldapCredenti
 	if err != nil {
 		return nil, err
 	}
+	if ldapUser, ok := externalUser.(*ldap.User); ok && userID != "" && session.Provider.IsGroupSearch() {
+		if err := s.command.SyncLDAPUserGrants(ctx, intentWriteModel.IDPID, userID, ldapUser.GetGroups()); err != nil {
+			return nil, err
+		}
+	}
 	return &user.StartIdentityProviderIntentResponse{
 		Details: object.DomainToDetailsPb(details),
 		NextStep: &user.StartIdentityProviderIntentResponse_IdpIntent{
@@ -0,0 +1,81 @@
+package user
+
+import (
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/repository/workloadidentity"
+	"github.com/zitadel/zitadel/internal/zerrors"
+	user_pb "github.com/zitadel/zitadel/pkg/grpc/user"
+)
+
+func WorkloadIdentityTrustQueriesToModel(queries []*user_pb.WorkloadIdentityTrustQuery) (_ []query.SearchQuery, err error) {
+	q := make([]query.SearchQuery, len(queries))
+	for i, query := range queries {
+		q[i], err = WorkloadIdentityTrustQueryToModel(query)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return q, nil
+}
+
+func WorkloadIdentityTrustQueryToModel(apiQuery *user_pb.WorkloadIdentityTrustQuery) (query.SearchQuery, error) {
+	switch q := apiQuery.Query.(type) {
+	case *user_pb.WorkloadIdentityTrustQuery_NameQuery:
+		return query.NewWorkloadIdentityTrustNameSearchQuery(object.TextMethodToQuery(q.NameQuery.Method), q.NameQuery.Name)
+	case *user_pb.WorkloadIdentityTrustQuery_IssuerQuery:
+		return query.NewWorkloadIdentityTrustIssuerSearchQuery(object.TextMethodToQuery(q.IssuerQuery.Method), q.IssuerQuery.Issuer)
+	default:
+		return nil, zerrors.ThrowInvalidArgument(nil, "USER-eiK3a", "List.Query.Invalid")
+	}
+}
+
+func WorkloadIdentityTrustsToPb(trusts []*query.WorkloadIdentityTrust) []*user_pb.WorkloadIdentityTrust {
+	t := make([]*user_pb.WorkloadIdentityTrust, len(trusts))
+	for i, trust := range trusts {
+		t[i] = WorkloadIdentityTrustToPb(trust)
+	}
+	return t
+}
+
+func WorkloadIdentityTrustToPb(trust *query.WorkloadIdentityTrust) *user_pb.WorkloadIdentityTrust {
+	pb := &user_pb.WorkloadIdentityTrust{
+		Id:       trust.ID,
+		Details:  object.ToViewDetailsPb(trust.Sequence, trust.CreationDate, trust.EventDate, trust.ResourceOwner),
+		Name:     trust.Name,
+		Issuer:   trust.Issuer,
+		Audience: trust.Audience,
+		Rules:    WorkloadIdentityRulesToPb(trust.Rules),
+	}
+	if len(trust.JWKS) > 0 {
+		pb.Keys = &user_pb.WorkloadIdentityTrust_Jwks{Jwks: trust.JWKS}
+	} else {
+		pb.Keys = &user_pb.WorkloadIdentityTrust_JwksEndpoint{JwksEndpoint: trust.JWKSEndpoint}
+	}
+	return pb
+}
+
+func WorkloadIdentityRulesToPb(rules []*workloadidentity.Rule) []*user_pb.WorkloadIdentityRule {
+	r := make([]*user_pb.WorkloadIdentityRule, len(rules))
+	for i, rule := range rules {
+		r[i] = &user_pb.WorkloadIdentityRule{
+			Conditions: rule.Conditions,
+			UserId:     rule.UserID,
+		}
+	}
+	return r
+}
+
+func WorkloadIdentityRulesToCommand(rules []*user_pb.WorkloadIdentityRule) []*workloadidentity.Rule {
+	if len(rules) == 0 {
+		return nil
+	}
+	r := make([]*workloadidentity.Rule, len(rules))
+	for i, rule := range rules {
+		r[i] = &workloadidentity.Rule{
+			Conditions: rule.GetConditions(),
+			UserID:     rule.GetUserId(),
+		}
+	}
+	return r
+}
@@ -0,0 +1,28 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/api/mtls"
+)
+
+// WithClientCertificate stores the certificate chain presented by the client in the context,
+// where it is used for the mutual-TLS client authentication and certificate-bound access tokens.
+// If trustedHeader is set, the certificate is read from the header set by a reverse proxy terminating TLS
+// instead of the connection.
+func WithClientCertificate(trustedHeader string) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			chain, err := mtls.CertificatesFromRequest(r, trustedHeader)
+			if err != nil {
+				logging.WithError(err).Debug("unable to read client certificate")
+				next.ServeHTTP(w, r)
+				return
+			}
+			next.ServeHTTP(w, r.WithContext(mtls.WithCertificates(r.Context(), chain)))
+		})
+	}
+}
@@ -0,0 +1,302 @@
+// Package mtls implements the mutual-TLS client authentication and certificate-bound access tokens
+// as specified in RFC 8705, OAuth 2.0 Mutual-TLS Client Authentication and Certificate-Bound Access Tokens.
+package mtls
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+)
+
+const (
+	// ConfirmationMethod is the member of the cnf claim containing the thumbprint of the certificate a token is bound to.
+	ConfirmationMethod = "x5t#S256"
+
+	// forwardedCertificateHeader is used by the gateway to pass the client certificate of a REST request
+	// to the gRPC server, see [ForwardCertificate].
+	forwardedCertificateHeader = "x-zitadel-forwarded-client-certificate"
+
+	pemTypeCertificate = "CERTIFICATE"
+)
+
+var (
+	ErrNoCertificate      = errors.New("no client certificate presented")
+	ErrInvalidCertificate = errors.New("invalid client certificate")
+
+	// forwardingKey authenticates certificates forwarded within the same process.
+	forwardingKey = newForwardingKey()
+)
+
+type certificatesKey struct{}
+
+// WithCertificates stores the certificate chain presented by the client, the leaf certificate first.
+func WithCertificates(ctx context.Context, chain []*x509.Certificate) context.Context {
+	if len(chain) == 0 {
+		return ctx
+	}
+	return context.WithValue(ctx, certificatesKey{}, chain)
+}
+
+// Certificates returns the certificate chain presented by the client, the leaf certificate first.
+func Certificates(ctx context.Context) []*x509.Certificate {
+	chain, _ := ctx.Value(certificatesKey{}).([]*x509.Certificate)
+	return chain
+}
+
+// Certificate returns the leaf certificate presented by the client or nil.
+func Certificate(ctx context.Context) *x509.Certificate {
+	chain := Certificates(ctx)
+	if len(chain) == 0 {
+		return nil
+	}
+	return chain[0]
+}
+
+// CertificatesFromRequest returns the certificate chain of the client.
+// The certificate forwarded by the gateway takes precedence, followed by the trusted header (if configured)
+// and finally the certificates presented on the TLS connection.
+func CertificatesFromRequest(r *http.Request, trustedHeader string) ([]*x509.Certificate, error) {
+	// as the gateway appends its value to the ones possibly sent by the client itself, the last one is used
+	if forwarded := r.Header.Values(forwardedCertificateHeader); len(forwarded) > 0 {
+		return parseForwardedCertificate(forwarded[len(forwarded)-1])
+	}
+	if trustedHeader != "" {
+		value := r.Header.Get(trustedHeader)
+		if value == "" {
+			return nil, nil
+		}
+		return parseHeaderCertificates(value)
+	}
+	if r.TLS == nil {
+		return nil, nil
+	}
+	return r.TLS.PeerCertificates, nil
+}
+
+// parseHeaderCertificates parses the URL encoded PEM certificate(s) passed by a reverse proxy
+// (e.g. nginx's $ssl_client_escaped_cert) or a single base64 encoded DER certificate.
+func parseHeaderCertificates(value string) ([]*x509.Certificate, error) {
+	unescaped, err := url.QueryUnescape(value)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidCertificate, err)
+	}
+	if strings.Contains(unescaped, "-----BEGIN") {
+		return ParseCertificates(unescaped)
+	}
+	der, err := base64.StdEncoding.DecodeString(value)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidCertificate, err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidCertificate, err)
+	}
+	return []*x509.Certificate{cert}, nil
+}
+
+// ParseCertificates parses all PEM encoded certificates of data.
+// At least one certificate is required.
+func ParseCertificates(data string) ([]*x509.Certificate, error) {
+	var certs []*x509.Certificate
+	rest := []byte(data)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != pemTypeCertificate {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidCertificate, err)
+		}
+		certs = append(certs, cert)
+	}
+	if len(certs) == 0 {
+		return nil, fmt.Errorf("%w: no PEM encoded certificate found", ErrInvalidCertificate)
+	}
+	return certs, nil
+}
+
+// LoadRootCAs reads the PEM encoded certificate authorities from path.
+// If the path is empty, nil is returned, which results in the system roots being used for verification.
+func LoadRootCAs(path string) (*x509.CertPool, error) {
+	if path == "" {
+		return nil, nil
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(data) {
+		return nil, fmt.Errorf("no certificate found in %s", path)
+	}
+	return pool, nil
+}
+
+// Thumbprint returns the base64url encoded SHA-256 thumbprint of the DER encoded certificate (x5t#S256).
+func Thumbprint(cert *x509.Certificate) string {
+	hash := sha256.Sum256(cert.Raw)
+	return base64.RawURLEncoding.EncodeToString(hash[:])
+}
+
+// Confirmation returns the cnf claim of tokens bound to the certificate with the thumbprint x5t.
+func Confirmation(x5t string) map[string]any {
+	return map[string]any{ConfirmationMethod: x5t}
+}
+
+// CheckBinding ensures the client presented the certificate the token is bound to.
+func CheckBinding(ctx context.Context, x5t string) error {
+	cert := Certificate(ctx)
+	if cert == nil {
+		return ErrNoCertificate
+	}
+	if subtle.ConstantTimeCompare([]byte(Thumbprint(cert)), []byte(x5t)) != 1 {
+		return fmt.Errorf("%w: certificate does not match the token binding", ErrInvalidCertificate)
+	}
+	return nil
+}
+
+// VerifyPKI verifies the chain of the client certificate against the roots (system roots if nil)
+// and ensures the subject distinguished name of the leaf certificate matches the registered subjectDN
+// (tls_client_auth).
+func VerifyPKI(chain []*x509.Certificate, roots *x509.CertPool, subjectDN string, now time.Time) error {
+	if len(chain) == 0 {
+		return ErrNoCertificate
+	}
+	intermediates := x509.NewCertPool()
+	for _, cert := range chain[1:] {
+		intermediates.AddCert(cert)
+	}
+	_, err := chain[0].Verify(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates,
+		CurrentTime:   now,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	})
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidCertificate, err)
+	}
+	if !SubjectDNMatches(chain[0], subjectDN) {
+		return fmt.Errorf("%w: subject does not match", ErrInvalidCertificate)
+	}
+	return nil
+}
+
+// VerifySelfSigned ensures the client certificate is one of the registered PEM encoded certificates
+// (self_signed_tls_client_auth).
+func VerifySelfSigned(cert *x509.Certificate, registered []string) error {
+	if cert == nil {
+		return ErrNoCertificate
+	}
+	x5t := Thumbprint(cert)
+	for _, data := range registered {
+		certs, err := ParseCertificates(data)
+		if err != nil {
+			continue
+		}
+		if subtle.ConstantTimeCompare([]byte(Thumbprint(certs[0])), []byte(x5t)) == 1 {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: certificate is not registered", ErrInvalidCertificate)
+}
+
+// SubjectDNMatches compares the subject of the certificate with the distinguished name in its
+// RFC 4514 string representation. Attribute types are compared case-insensitive and whitespace
+// around the separators is ignored.
+func SubjectDNMatches(cert *x509.Certificate, dn string) bool {
+	if dn == "" {
+		return false
+	}
+	return normalizeDN(cert.Subject.String()) == normalizeDN(dn)
+}
+
+func normalizeDN(dn string) string {
+	rdns := splitUnescaped(dn, ',')
+	for i, rdn := range rdns {
+		attributeType, value, _ := strings.Cut(rdn, "=")
+		rdns[i] = strings.ToUpper(strings.TrimSpace(attributeType)) + "=" + strings.TrimSpace(value)
+	}
+	return strings.Join(rdns, ",")
+}
+
+func splitUnescaped(s string, sep byte) []string {
+	var (
+		parts   []string
+		start   int
+		escaped bool
+	)
+	for i := 0; i < len(s); i++ {
+		switch {
+		case escaped:
+			escaped = false
+		case s[i] == '\\':
+			escaped = true
+		case s[i] == sep:
+			parts = append(parts, s[start:i])
+			start = i + 1
+		}
+	}
+	return append(parts, s[start:])
+}
+
+// ForwardCertificate returns the header and value to pass the leaf certificate of the chain
+// from the gateway to the gRPC server of the same process.
+// The value is authenticated with a key only known to the process, so it cannot be set by clients.
+func ForwardCertificate(chain []*x509.Certificate) (header, value string) {
+	if len(chain) == 0 {
+		return "", ""
+	}
+	encoded := base64.RawURLEncoding.EncodeToString(chain[0].Raw)
+	return forwardedCertificateHeader, encoded + "." + base64.RawURLEncoding.EncodeToString(forwardingMAC(encoded))
+}
+
+func parseForwardedCertificate(value string) ([]*x509.Certificate, error) {
+	encoded, mac, ok := strings.Cut(value, ".")
+	if !ok {
+		return nil, fmt.Errorf("%w: malformed forwarded certificate", ErrInvalidCertificate)
+	}
+	decodedMAC, err := base64.RawURLEncoding.DecodeString(mac)
+	if err != nil || !hmac.Equal(decodedMAC, forwardingMAC(encoded)) {
+		return nil, fmt.Errorf("%w: forwarded certificate not authenticated", ErrInvalidCertificate)
+	}
+	der, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidCertificate, err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidCertificate, err)
+	}
+	return []*x509.Certificate{cert}, nil
+}
+
+func forwardingMAC(value string) []byte {
+	mac := hmac.New(sha256.New, forwardingKey)
+	mac.Write([]byte(value))
+	return mac.Sum(nil)
+}
+
+func newForwardingKey() []byte {
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		panic(err)
+	}
+	return key
+}
@@ -0,0 +1,276 @@
+package mtls
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyPKI(t *testing.T) {
+	now := time.Now()
+	ca, caKey := newCertificate(t, pkix.Name{CommonName: "ca"}, nil, nil, true)
+	client, _ := newCertificate(t, pkix.Name{CommonName: "client", Organization: []string{"zitadel"}}, ca, caKey, false)
+	selfSigned, _ := newCertificate(t, pkix.Name{CommonName: "client", Organization: []string{"zitadel"}}, nil, nil, false)
+	roots := x509.NewCertPool()
+	roots.AddCert(ca)
+
+	tests := []struct {
+		name      string
+		chain     []*x509.Certificate
+		subjectDN string
+		now       time.Time
+		wantErr   error
+	}{
+		{
+			name:      "no certificate",
+			subjectDN: "CN=client,O=zitadel",
+			now:       now,
+			wantErr:   ErrNoCertificate,
+		},
+		{
+			name:      "untrusted issuer",
+			chain:     []*x509.Certificate{selfSigned},
+			subjectDN: "CN=client,O=zitadel",
+			now:       now,
+			wantErr:   ErrInvalidCertificate,
+		},
+		{
+			name:      "expired",
+			chain:     []*x509.Certificate{client},
+			subjectDN: "CN=client,O=zitadel",
+			now:       now.Add(48 * time.Hour),
+			wantErr:   ErrInvalidCertificate,
+		},
+		{
+			name:      "subject mismatch",
+			chain:     []*x509.Certificate{client},
+			subjectDN: "CN=other,O=zitadel",
+			now:       now,
+			wantErr:   ErrInvalidCertificate,
+		},
+		{
+			name:      "ok",
+			chain:     []*x509.Certificate{client},
+			subjectDN: "cn=client, o=zitadel",
+			now:       now,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := VerifyPKI(tt.chain, roots, tt.subjectDN, tt.now)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestVerifySelfSigned(t *testing.T) {
+	registered, _ := newCertificate(t, pkix.Name{CommonName: "registered"}, nil, nil, false)
+	other, _ := newCertificate(t, pkix.Name{CommonName: "registered"}, nil, nil, false)
+
+	tests := []struct {
+		name       string
+		cert       *x509.Certificate
+		registered []string
+		wantErr    error
+	}{
+		{
+			name:       "no certificate",
+			registered: []string{encodePEM(registered)},
+			wantErr:    ErrNoCertificate,
+		},
+		{
+			name:       "not registered",
+			cert:       other,
+			registered: []string{encodePEM(registered)},
+			wantErr:    ErrInvalidCertificate,
+		},
+		{
+			name:       "invalid registered certificates ignored",
+			cert:       registered,
+			registered: []string{"invalid", encodePEM(registered)},
+		},
+		{
+			name:       "ok",
+			cert:       registered,
+			registered: []string{encodePEM(other), encodePEM(registered)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := VerifySelfSigned(tt.cert, tt.registered)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestCheckBinding(t *testing.T) {
+	cert, _ := newCertificate(t, pkix.Name{CommonName: "client"}, nil, nil, false)
+	other, _ := newCertificate(t, pkix.Name{CommonName: "client"}, nil, nil, false)
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		wantErr error
+	}{
+		{
+			name:    "no certificate",
+			ctx:     context.Background(),
+			wantErr: ErrNoCertificate,
+		},
+		{
+			name:    "other certificate",
+			ctx:     WithCertificates(context.Background(), []*x509.Certificate{other}),
+			wantErr: ErrInvalidCertificate,
+		},
+		{
+			name: "ok",
+			ctx:  WithCertificates(context.Background(), []*x509.Certificate{cert}),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckBinding(tt.ctx, Thumbprint(cert))
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestCertificatesFromRequest(t *testing.T) {
+	cert, _ := newCertificate(t, pkix.Name{CommonName: "client"}, nil, nil, false)
+	connection, _ := newCertificate(t, pkix.Name{CommonName: "connection"}, nil, nil, false)
+	forwardedHeader, forwardedValue := ForwardCertificate([]*x509.Certificate{cert})
+
+	tests := []struct {
+		name          string
+		header        http.Header
+		tls           *tls.ConnectionState
+		trustedHeader string
+		want          *x509.Certificate
+		wantErr       error
+	}{
+		{
+			name: "no certificate",
+		},
+		{
+			name: "tls connection",
+			tls:  &tls.ConnectionState{PeerCertificates: []*x509.Certificate{connection}},
+			want: connection,
+		},
+		{
+			name:   "untrusted header ignored",
+			header: http.Header{"X-Client-Cert": []string{url.QueryEscape(encodePEM(cert))}},
+			tls:    &tls.ConnectionState{PeerCertificates: []*x509.Certificate{connection}},
+			want:   connection,
+		},
+		{
+			name:          "trusted header, escaped pem",
+			header:        http.Header{"X-Client-Cert": []string{url.QueryEscape(encodePEM(cert))}},
+			tls:           &tls.ConnectionState{PeerCertificates: []*x509.Certificate{connection}},
+			trustedHeader: "X-Client-Cert",
+			want:          cert,
+		},
+		{
+			name:          "trusted header, base64 der",
+			header:        http.Header{"X-Client-Cert": []string{base64.StdEncoding.EncodeToString(cert.Raw)}},
+			trustedHeader: "X-Client-Cert",
+			want:          cert,
+		},
+		{
+			name:          "trusted header, invalid",
+			header:        http.Header{"X-Client-Cert": []string{"invalid"}},
+			trustedHeader: "X-Client-Cert",
+			wantErr:       ErrInvalidCertificate,
+		},
+		{
+			name:   "forwarded",
+			header: http.Header{http.CanonicalHeaderKey(forwardedHeader): []string{forwardedValue}},
+			tls:    &tls.ConnectionState{PeerCertificates: []*x509.Certificate{connection}},
+			want:   cert,
+		},
+		{
+			name:    "forwarded, tampered",
+			header:  http.Header{http.CanonicalHeaderKey(forwardedHeader): []string{base64.RawURLEncoding.EncodeToString(cert.Raw) + ".mac"}},
+			wantErr: ErrInvalidCertificate,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPost, "/oauth/v2/token", nil)
+			if tt.header != nil {
+				r.Header = tt.header
+			}
+			r.TLS = tt.tls
+			got, err := CertificatesFromRequest(r, tt.trustedHeader)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			if tt.want == nil {
+				assert.Empty(t, got)
+				return
+			}
+			require.NotEmpty(t, got)
+			assert.Equal(t, Thumbprint(tt.want), Thumbprint(got[0]))
+		})
+	}
+}
+
+func newCertificate(t *testing.T, subject pkix.Name, issuer *x509.Certificate, issuerKey *ecdsa.PrivateKey, isCA bool) (*x509.Certificate, *ecdsa.PrivateKey) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	serial, err := rand.Int(rand.Reader, big.NewInt(1<<62))
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               subject,
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(24 * time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  isCA,
+	}
+	if isCA {
+		template.KeyUsage |= x509.KeyUsageCertSign
+	}
+	if issuer == nil {
+		issuer, issuerKey = template, key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, issuer, key.Public(), issuerKey)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return cert, key
+}
+
+func encodePEM(cert *x509.Certificate) string {
+	return string(pem.EncodeToMemory(&pem.Block{Type: pemTypeCertificate, Bytes: cert.Raw}))
+}
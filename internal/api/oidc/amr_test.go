@@ -66,6 +66,13 @@ func TestAMR(t *testing.T) {
 			},
 			[]string{OTP},
 		},
+		{
+			"recovery code checked",
+			args{
+				[]domain.UserAuthMethodType{domain.UserAuthMethodTypeRecoveryCodes},
+			},
+			[]string{OTP},
+		},
 		{
 			"multiple (t)otp checked",
 			args{
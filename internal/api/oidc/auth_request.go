@@ -75,25 +75,37 @@ func (o *OPStorage) CreateAuthRequest(ctx context.Context, req *oidc.AuthRequest
 	}
 }
 
-func (o *OPStorage) createAuthRequestScopeAndAudience(ctx context.Context, clientID string, reqScope []string) (scope, audience []string, err error) {
+func (o *OPStorage) createAuthRequestScopeAndAudience(ctx context.Context, clientID string, reqScope []string) (scope, audience []string, authorizationDetails domain.AuthorizationDetails, err error) {
 	project, err := o.query.ProjectByClientID(ctx, clientID)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 	scope, err = o.assertProjectRoleScopesByProject(ctx, project, reqScope)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
+	}
+	authorizationDetails, err = requestedAuthorizationDetails(ctx, o.query, project.ID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	// if resources are indicated, the audience is restricted to exactly these resources (RFC 8707)
+	if resources := resourcesFromContext(ctx); len(resources) > 0 {
+		audience, err = resourceAudience(ctx, o.query, resources)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		return scope, audience, authorizationDetails, nil
 	}
 	audience, err = o.audienceFromProjectID(ctx, project.ID)
 	audience = domain.AddAudScopeToAudience(ctx, audience, scope)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
-	return scope, audience, nil
+	return scope, audience, authorizationDetails, nil
 }
 
 func (o *OPStorage) createAuthRequestLoginClient(ctx context.Context, req *oidc.AuthRequest, hintUserID, loginClient string) (op.AuthRequest, error) {
-	scope, audience, err := o.createAuthRequestScopeAndAudience(ctx, req.ClientID, req.Scopes)
+	scope, audience, authorizationDetails, err := o.createAuthRequestScopeAndAudience(ctx, req.ClientID, req.Scopes)
 	if err != nil {
 		return nil, err
 	}
@@ -113,6 +125,8 @@ func (o *OPStorage) createAuthRequestLoginClient(ctx context.Context, req *oidc.
 		UILocales:        UILocalesToBusiness(req.UILocales),
 		MaxAge:           MaxAgeToBusiness(req.MaxAge),
 		Issuer:           o.contextToIssuer(ctx),
+
+		AuthorizationDetails: authorizationDetails,
 	}
 	if req.LoginHint != "" {
 		authRequest.LoginHint = &req.LoginHint
@@ -133,12 +147,13 @@ func (o *OPStorage) createAuthRequest(ctx context.Context, req *oidc.AuthRequest
 	if !ok {
 		return nil, zerrors.ThrowPreconditionFailed(nil, "OIDC-sd436", "no user agent id")
 	}
-	scope, audience, err := o.createAuthRequestScopeAndAudience(ctx, req.ClientID, req.Scopes)
+	scope, audience, authorizationDetails, err := o.createAuthRequestScopeAndAudience(ctx, req.ClientID, req.Scopes)
 	if err != nil {
 		return nil, err
 	}
 	req.Scopes = scope
 	authRequest := CreateAuthRequestToBusiness(ctx, req, userAgentID, userID, audience)
+	authRequest.AuthorizationDetails = authorizationDetails
 	resp, err := o.repo.CreateAuthRequest(ctx, authRequest)
 	if err != nil {
 		return nil, err
@@ -566,7 +581,7 @@ func setContextUserSystem(ctx context.Context) context.Context {
 	return authz.SetCtxData(ctx, data)
 }
 
-func CreateErrorCallbackURL(authReq op.AuthRequest, reason, description, uri string, authorizer op.Authorizer) (string, error) {
+func (s *Server) CreateErrorCallbackURL(ctx context.Context, authReq op.AuthRequest, reason, description, uri string) (string, error) {
 	e := struct {
 		Error       string `schema:"error"`
 		Description string `schema:"error_description,omitempty"`
@@ -578,14 +593,15 @@ func CreateErrorCallbackURL(authReq op.AuthRequest, reason, description, uri str
 		URI:         uri,
 		State:       authReq.GetState(),
 	}
-	callback, err := op.AuthResponseURL(authReq.GetRedirectURI(), authReq.GetResponseType(), authReq.GetResponseMode(), e, authorizer.Encoder())
+	callback, err := s.authResponseURL(ctx, authReq, authReq.GetClientID(), e, s.Provider().Encoder())
 	if err != nil {
 		return "", err
 	}
 	return callback, nil
 }
 
-func CreateCodeCallbackURL(ctx context.Context, authReq op.AuthRequest, authorizer op.Authorizer) (string, error) {
+func (s *Server) CreateCodeCallbackURL(ctx context.Context, authReq op.AuthRequest) (string, error) {
+	authorizer := s.Provider()
 	code, err := op.CreateAuthRequestCode(ctx, authReq, authorizer.Storage(), authorizer.Crypto())
 	if err != nil {
 		return "", err
@@ -597,7 +613,7 @@ func CreateCodeCallbackURL(ctx context.Context, authReq op.AuthRequest, authoriz
 		code:  code,
 		state: authReq.GetState(),
 	}
-	return op.AuthResponseURL(authReq.GetRedirectURI(), authReq.GetResponseType(), authReq.GetResponseMode(), &codeResponse, authorizer.Encoder())
+	return s.authResponseURL(ctx, authReq, authReq.GetClientID(), &codeResponse, authorizer.Encoder())
 }
 
 func (s *Server) CreateTokenCallbackURL(ctx context.Context, req op.AuthRequest) (string, error) {
@@ -615,8 +631,11 @@ func (s *Server) CreateTokenCallbackURL(ctx context.Context, req op.AuthRequest)
 		setContextUserSystem(ctx),
 		req.GetID(),
 		implicitFlowComplianceChecker(),
+		nil, // the access token of the implicit flow has the audience of the auth request
 		slices.Contains(client.GrantTypes(), oidc.GrantTypeRefreshToken),
 		client.client.BackChannelLogoutURI,
+		"", // tokens of the implicit flow cannot be bound to a DPoP key
+		"", // nor to a client certificate
 	)
 	if err != nil {
 		return "", err
@@ -625,7 +644,7 @@ func (s *Server) CreateTokenCallbackURL(ctx context.Context, req op.AuthRequest)
 	if err != nil {
 		return "", err
 	}
-	callback, err := op.AuthResponseURL(req.GetRedirectURI(), req.GetResponseType(), req.GetResponseMode(), resp, provider.Encoder())
+	callback, err := s.authResponseURL(ctx, req, req.GetClientID(), resp, provider.Encoder())
 	if err != nil {
 		return "", err
 	}
@@ -656,6 +675,9 @@ func (s *Server) authorizeCallbackHandler(w http.ResponseWriter, r *http.Request
 		if err != nil {
 			return nil, err
 		}
+		if authReq.ConsentDenied {
+			return authReq, oidc.ErrAccessDenied().WithDescription("The user denied the consent.")
+		}
 		if !authReq.Done() {
 			return authReq, oidc.ErrInteractionRequired().WithDescription("Unfortunately, the user may be not logged in and/or additional interaction is required.")
 		}
@@ -667,7 +689,7 @@ func (s *Server) authorizeCallbackHandler(w http.ResponseWriter, r *http.Request
 			op.AuthRequestError(w, r, nil, err, authorizer)
 			return
 		}
-		op.AuthRequestError(w, r, authReq, err, authorizer)
+		s.authRequestError(w, r, authReq, err, authorizer)
 	}
 }
 
@@ -678,16 +700,41 @@ func (s *Server) authResponse(authReq *AuthRequest, authorizer op.Authorizer, w
 
 	client, err := authorizer.Storage().GetClientByClientID(ctx, authReq.GetClientID())
 	if err != nil {
-		op.AuthRequestError(w, r, authReq, err, authorizer)
+		s.authRequestError(w, r, authReq, err, authorizer)
 		return err
 	}
 	if authReq.GetResponseType() == oidc.ResponseTypeCode {
-		op.AuthResponseCode(w, r, authReq, authorizer)
-		return nil
+		return s.authResponseCode(authReq, authorizer, w, r)
 	}
 	return s.authResponseToken(authReq, authorizer, client, w, r)
 }
 
+// authResponseCode returns the code like [op.AuthResponseCode],
+// but also supports the JWT response modes.
+func (s *Server) authResponseCode(authReq *AuthRequest, authorizer op.Authorizer, w http.ResponseWriter, r *http.Request) (err error) {
+	ctx, span := tracing.NewSpan(r.Context())
+	r = r.WithContext(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	code, err := op.CreateAuthRequestCode(ctx, authReq, authorizer.Storage(), authorizer.Crypto())
+	if err != nil {
+		s.authRequestError(w, r, authReq, err, authorizer)
+		return err
+	}
+	codeResponse := struct {
+		Code  string `schema:"code"`
+		State string `schema:"state,omitempty"`
+	}{
+		Code:  code,
+		State: authReq.GetState(),
+	}
+	if err = s.writeAuthResponse(w, r, authReq, &codeResponse, authorizer.Encoder()); err != nil {
+		s.authRequestError(w, r, authReq, err, authorizer)
+		return err
+	}
+	return nil
+}
+
 func (s *Server) authResponseToken(authReq *AuthRequest, authorizer op.Authorizer, opClient op.Client, w http.ResponseWriter, r *http.Request) (err error) {
 	ctx, span := tracing.NewSpan(r.Context())
 	r = r.WithContext(ctx)
@@ -706,6 +753,7 @@ func (s *Server) authResponseToken(authReq *AuthRequest, authorizer op.Authorize
 		client.client.BackChannelLogoutURI,
 		scope,
 		authReq.Audience,
+		nil,
 		authReq.AuthMethods(),
 		authReq.AuthTime,
 		authReq.GetNonce(),
@@ -716,30 +764,22 @@ func (s *Server) authResponseToken(authReq *AuthRequest, authorizer op.Authorize
 		slices.Contains(scope, oidc.ScopeOfflineAccess),
 		authReq.SessionID,
 		authReq.oidc().ResponseType,
+		"", // tokens of the implicit flow cannot be bound to a DPoP key
+		"", // nor to a client certificate
+		authReq.AuthorizationDetails,
 	)
 	if err != nil {
-		op.AuthRequestError(w, r, authReq, err, authorizer)
+		s.authRequestError(w, r, authReq, err, authorizer)
 		return err
 	}
 	resp, err := s.accessTokenResponseFromSession(ctx, client, session, authReq.GetState(), client.client.ProjectID, client.client.ProjectRoleAssertion, client.client.AccessTokenRoleAssertion, client.client.IDTokenRoleAssertion, client.client.IDTokenUserinfoAssertion)
 	if err != nil {
-		op.AuthRequestError(w, r, authReq, err, authorizer)
+		s.authRequestError(w, r, authReq, err, authorizer)
 		return err
 	}
-
-	if authReq.GetResponseMode() == oidc.ResponseModeFormPost {
-		if err = op.AuthResponseFormPost(w, authReq.GetRedirectURI(), resp, authorizer.Encoder()); err != nil {
-			op.AuthRequestError(w, r, authReq, err, authorizer)
-			return err
-		}
-		return nil
-	}
-
-	callback, err := op.AuthResponseURL(authReq.GetRedirectURI(), authReq.GetResponseType(), authReq.GetResponseMode(), resp, authorizer.Encoder())
-	if err != nil {
-		op.AuthRequestError(w, r, authReq, err, authorizer)
+	if err = s.writeAuthResponse(w, r, authReq, resp, authorizer.Encoder()); err != nil {
+		s.authRequestError(w, r, authReq, err, authorizer)
 		return err
 	}
-	http.Redirect(w, r, callback, http.StatusFound)
 	return nil
 }
@@ -237,8 +237,16 @@ func ResponseTypeToOIDC(responseType domain.OIDCResponseType) oidc.ResponseType
 // ResponseModeToBusiness returns the OIDCResponseMode enum value from the domain package.
 // An empty or invalid value defaults to unspecified.
 func ResponseModeToBusiness(responseMode oidc.ResponseMode) domain.OIDCResponseMode {
-	if responseMode == "" {
+	switch responseMode {
+	case "":
 		return domain.OIDCResponseModeUnspecified
+	// the JWT response modes contain a dot, which the names of the enum cannot
+	case responseModeQueryJWT:
+		return domain.OIDCResponseModeQueryJWT
+	case responseModeFragmentJWT:
+		return domain.OIDCResponseModeFragmentJWT
+	case responseModeFormPostJWT:
+		return domain.OIDCResponseModeFormPostJWT
 	}
 	out, err := domain.OIDCResponseModeString(string(responseMode))
 	logging.OnError(err).Debugln("invalid oidc response_mode, using default")
@@ -252,6 +260,9 @@ func ResponseModeToOIDC(responseMode domain.OIDCResponseMode) oidc.ResponseMode
 	if responseMode == domain.OIDCResponseModeUnspecified || !responseMode.IsAOIDCResponseMode() {
 		return ""
 	}
+	if responseMode.IsJWT() {
+		return oidc.ResponseMode(strings.Replace(responseMode.String(), "_jwt", ".jwt", 1))
+	}
 	return oidc.ResponseMode(responseMode.String())
 }
 
@@ -287,7 +298,8 @@ func AMRFromMFAType(mfaType domain.MFAType) string {
 	switch mfaType {
 	case domain.MFATypeTOTP,
 		domain.MFATypeOTPSMS,
-		domain.MFATypeOTPEmail:
+		domain.MFATypeOTPEmail,
+		domain.MFATypeRecoveryCodes:
 		return OTP
 	case domain.MFATypeU2F,
 		domain.MFATypeU2FUserVerification:
@@ -43,6 +43,26 @@ func TestResponseModeToBusiness(t *testing.T) {
 			args: args{oidc.ResponseModeFormPost},
 			want: domain.OIDCResponseModeFormPost,
 		},
+		{
+			name: "query.jwt",
+			args: args{"query.jwt"},
+			want: domain.OIDCResponseModeQueryJWT,
+		},
+		{
+			name: "fragment.jwt",
+			args: args{"fragment.jwt"},
+			want: domain.OIDCResponseModeFragmentJWT,
+		},
+		{
+			name: "form_post.jwt",
+			args: args{"form_post.jwt"},
+			want: domain.OIDCResponseModeFormPostJWT,
+		},
+		{
+			name: "jwt",
+			args: args{"jwt"},
+			want: domain.OIDCResponseModeJWT,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -86,6 +106,26 @@ func TestResponseModeToOIDC(t *testing.T) {
 			args: args{domain.OIDCResponseModeFormPost},
 			want: oidc.ResponseModeFormPost,
 		},
+		{
+			name: "query.jwt",
+			args: args{domain.OIDCResponseModeQueryJWT},
+			want: "query.jwt",
+		},
+		{
+			name: "fragment.jwt",
+			args: args{domain.OIDCResponseModeFragmentJWT},
+			want: "fragment.jwt",
+		},
+		{
+			name: "form_post.jwt",
+			args: args{domain.OIDCResponseModeFormPostJWT},
+			want: "form_post.jwt",
+		},
+		{
+			name: "jwt",
+			args: args{domain.OIDCResponseModeJWT},
+			want: "jwt",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -0,0 +1,131 @@
+package oidc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+
+	"github.com/santhosh-tekuri/jsonschema/v5"
+	"github.com/zitadel/oidc/v3/pkg/oidc"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	domain_schema "github.com/zitadel/zitadel/internal/domain/schema"
+	"github.com/zitadel/zitadel/internal/query"
+)
+
+const (
+	// formAuthorizationDetails is the parameter of authorization requests,
+	// which contains the fine-grained authorizations requested by the client as JSON array (RFC 9396).
+	formAuthorizationDetails = "authorization_details"
+	// claimAuthorizationDetails is the claim of access tokens and the field of token and introspection responses,
+	// which contains the granted authorization details.
+	claimAuthorizationDetails = "authorization_details"
+
+	errorTypeInvalidAuthorizationDetails = "invalid_authorization_details"
+)
+
+type authorizationDetailsKey struct{}
+
+// contextWithAuthorizationDetails passes the authorization_details parameter of the authorization request to the storage,
+// since the auth request of the oidc library does not contain it.
+func contextWithAuthorizationDetails(ctx context.Context, authorizationDetails string) context.Context {
+	if authorizationDetails == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, authorizationDetailsKey{}, authorizationDetails)
+}
+
+func authorizationDetailsFromContext(ctx context.Context) string {
+	authorizationDetails, _ := ctx.Value(authorizationDetailsKey{}).(string)
+	return authorizationDetails
+}
+
+// requestedAuthorizationDetails parses the authorization_details of the authorization request
+// and validates every authorization detail against the schema of its type configured on the project.
+func requestedAuthorizationDetails(ctx context.Context, q *query.Queries, projectID string) (domain.AuthorizationDetails, error) {
+	raw := authorizationDetailsFromContext(ctx)
+	if raw == "" {
+		return nil, nil
+	}
+	details, err := parseAuthorizationDetails(raw)
+	if err != nil {
+		return nil, err
+	}
+	types, err := q.ProjectAuthorizationDetailTypes(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	if err = validateAuthorizationDetails(details, types); err != nil {
+		return nil, err
+	}
+	return details, nil
+}
+
+func parseAuthorizationDetails(raw string) (domain.AuthorizationDetails, error) {
+	var details domain.AuthorizationDetails
+	if err := json.Unmarshal([]byte(raw), &details); err != nil {
+		return nil, errInvalidAuthorizationDetails(err, "authorization_details must be a JSON array of objects")
+	}
+	if len(details) == 0 {
+		return nil, errInvalidAuthorizationDetails(nil, "authorization_details must not be empty")
+	}
+	for _, detail := range details {
+		if detail.Type() == "" {
+			return nil, errInvalidAuthorizationDetails(nil, "type of authorization detail is missing")
+		}
+	}
+	return details, nil
+}
+
+func validateAuthorizationDetails(details domain.AuthorizationDetails, types []*query.ProjectAuthorizationDetailType) error {
+	schemas := make(map[string]*jsonschema.Schema, len(types))
+	for _, detail := range details {
+		schema, ok := schemas[detail.Type()]
+		if !ok {
+			detailType := findAuthorizationDetailType(types, detail.Type())
+			if detailType == nil {
+				return errInvalidAuthorizationDetails(nil, "authorization details type %s is not supported", detail.Type())
+			}
+			var err error
+			schema, err = domain_schema.NewAuthorizationDetailSchema(bytes.NewReader(detailType.Schema))
+			if err != nil {
+				return err
+			}
+			schemas[detail.Type()] = schema
+		}
+		if err := schema.Validate(map[string]any(detail)); err != nil {
+			return errInvalidAuthorizationDetails(err, "authorization detail of type %s is invalid", detail.Type())
+		}
+	}
+	return nil
+}
+
+func findAuthorizationDetailType(types []*query.ProjectAuthorizationDetailType, detailType string) *query.ProjectAuthorizationDetailType {
+	for _, t := range types {
+		if t.Type == detailType {
+			return t
+		}
+	}
+	return nil
+}
+
+// accessTokenResponseWithAuthorizationDetails adds the granted authorization details to the token response,
+// so the client knows what the access token is authorized for.
+func accessTokenResponseWithAuthorizationDetails(resp *oidc.AccessTokenResponse, authorizationDetails domain.AuthorizationDetails) any {
+	if len(authorizationDetails) == 0 {
+		return resp
+	}
+	return &struct {
+		*oidc.AccessTokenResponse
+		AuthorizationDetails domain.AuthorizationDetails `json:"authorization_details"`
+	}{
+		AccessTokenResponse:  resp,
+		AuthorizationDetails: authorizationDetails,
+	}
+}
+
+func errInvalidAuthorizationDetails(parent error, description string, args ...any) *oidc.Error {
+	return (&oidc.Error{
+		ErrorType: errorTypeInvalidAuthorizationDetails,
+	}).WithParent(parent).WithDescription(description, args...)
+}
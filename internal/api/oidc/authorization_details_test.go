@@ -0,0 +1,152 @@
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zitadel/oidc/v3/pkg/oidc"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+)
+
+func Test_parseAuthorizationDetails(t *testing.T) {
+	tests := []struct {
+		name    string
+		raw     string
+		want    domain.AuthorizationDetails
+		wantErr error
+	}{
+		{
+			name:    "no JSON array",
+			raw:     `{"type":"payment_initiation"}`,
+			wantErr: errInvalidAuthorizationDetails(nil, "authorization_details must be a JSON array of objects"),
+		},
+		{
+			name:    "empty",
+			raw:     `[]`,
+			wantErr: errInvalidAuthorizationDetails(nil, "authorization_details must not be empty"),
+		},
+		{
+			name:    "type missing",
+			raw:     `[{"actions":["initiate"]}]`,
+			wantErr: errInvalidAuthorizationDetails(nil, "type of authorization detail is missing"),
+		},
+		{
+			name: "ok",
+			raw:  `[{"type":"payment_initiation","instructedAmount":{"currency":"EUR","amount":"100"},"creditorName":"X"}]`,
+			want: domain.AuthorizationDetails{
+				{
+					"type": "payment_initiation",
+					"instructedAmount": map[string]any{
+						"currency": "EUR",
+						"amount":   "100",
+					},
+					"creditorName": "X",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseAuthorizationDetails(tt.raw)
+			require.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_validateAuthorizationDetails(t *testing.T) {
+	types := []*query.ProjectAuthorizationDetailType{
+		{
+			ProjectID: "projectID",
+			Type:      "payment_initiation",
+			Schema: json.RawMessage(`{
+				"type": "object",
+				"required": ["instructedAmount", "creditorName"],
+				"properties": {
+					"instructedAmount": {
+						"type": "object",
+						"required": ["currency", "amount"],
+						"properties": {
+							"currency": {"type": "string", "minLength": 3, "maxLength": 3},
+							"amount": {"type": "string"}
+						}
+					},
+					"creditorName": {"type": "string"}
+				}
+			}`),
+		},
+	}
+	tests := []struct {
+		name    string
+		details domain.AuthorizationDetails
+		wantErr error
+	}{
+		{
+			name: "type not supported",
+			details: domain.AuthorizationDetails{
+				{"type": "account_information"},
+			},
+			wantErr: errInvalidAuthorizationDetails(nil, "authorization details type %s is not supported", "account_information"),
+		},
+		{
+			name: "schema not fulfilled",
+			details: domain.AuthorizationDetails{
+				{
+					"type":             "payment_initiation",
+					"instructedAmount": map[string]any{"currency": "EURO", "amount": "100"},
+					"creditorName":     "X",
+				},
+			},
+			wantErr: errInvalidAuthorizationDetails(nil, "authorization detail of type %s is invalid", "payment_initiation"),
+		},
+		{
+			name: "ok",
+			details: domain.AuthorizationDetails{
+				{
+					"type":             "payment_initiation",
+					"instructedAmount": map[string]any{"currency": "EUR", "amount": "100"},
+					"creditorName":     "X",
+				},
+				{
+					"type":             "payment_initiation",
+					"instructedAmount": map[string]any{"currency": "CHF", "amount": "50"},
+					"creditorName":     "Y",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateAuthorizationDetails(tt.details, types)
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func Test_authorizationDetailsFromContext(t *testing.T) {
+	ctx := contextWithAuthorizationDetails(context.Background(), "")
+	assert.Empty(t, authorizationDetailsFromContext(ctx))
+
+	ctx = contextWithAuthorizationDetails(context.Background(), `[{"type":"payment_initiation"}]`)
+	assert.Equal(t, `[{"type":"payment_initiation"}]`, authorizationDetailsFromContext(ctx))
+}
+
+func Test_accessTokenResponseWithAuthorizationDetails(t *testing.T) {
+	resp := &oidc.AccessTokenResponse{
+		AccessToken: "accessToken",
+		TokenType:   oidc.BearerToken,
+		ExpiresIn:   3600,
+	}
+	got, err := json.Marshal(accessTokenResponseWithAuthorizationDetails(resp, nil))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"access_token":"accessToken","token_type":"Bearer","expires_in":3600}`, string(got))
+
+	got, err = json.Marshal(accessTokenResponseWithAuthorizationDetails(resp, domain.AuthorizationDetails{{"type": "payment_initiation"}}))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"access_token":"accessToken","token_type":"Bearer","expires_in":3600,"authorization_details":[{"type":"payment_initiation"}]}`, string(got))
+}
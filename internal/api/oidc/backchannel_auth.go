@@ -0,0 +1,339 @@
+package oidc
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	httphelper "github.com/zitadel/oidc/v3/pkg/http"
+	"github.com/zitadel/oidc/v3/pkg/oidc"
+	"github.com/zitadel/oidc/v3/pkg/op"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	grantTypeCIBA oidc.GrantType = "urn:openid:params:grant-type:ciba"
+
+	BackChannelAuthDefaultLifetime     = 5 * time.Minute
+	BackChannelAuthDefaultPollInterval = 5 * time.Second
+
+	backChannelTokenDeliveryModePoll = "poll"
+	backChannelTokenDeliveryModePing = "ping"
+
+	errorTypeUnknownUserID         = "unknown_user_id"
+	errorTypeInvalidBindingMessage = "invalid_binding_message"
+
+	bindingMessageMaxLength          = 100
+	clientNotificationTokenMaxLength = 1024
+)
+
+type BackChannelAuthConfig struct {
+	Lifetime     time.Duration
+	PollInterval time.Duration
+}
+
+// lifetime returns the configured lifetime or the default.
+// Safe to call when c is nil.
+func (c *BackChannelAuthConfig) lifetime() time.Duration {
+	if c == nil || c.Lifetime == 0 {
+		return BackChannelAuthDefaultLifetime
+	}
+	return c.Lifetime
+}
+
+// pollInterval returns the configured poll interval or the default.
+// Safe to call when c is nil.
+func (c *BackChannelAuthConfig) pollInterval() time.Duration {
+	if c == nil || c.PollInterval == 0 {
+		return BackChannelAuthDefaultPollInterval
+	}
+	return c.PollInterval
+}
+
+type backChannelAuthResponse struct {
+	AuthReqID string `json:"auth_req_id"`
+	ExpiresIn uint64 `json:"expires_in"`
+	Interval  uint64 `json:"interval,omitempty"`
+}
+
+// backChannelAuthHandler serves the backchannel authentication endpoint
+// and the token requests of the CIBA grant (OpenID Connect Client-Initiated Backchannel Authentication).
+// The oidc library neither supports the endpoint nor the grant type,
+// therefore both are served by a middleware in front of the library's router.
+func (s *Server) backChannelAuthHandler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case s.backChannelAuthEndpoint != nil && r.URL.Path == s.backChannelAuthEndpoint.Relative():
+			ctx := op.ContextWithIssuer(r.Context(), s.IssuerFromRequest(r))
+			r = r.WithContext(ctx)
+			if r.Method != http.MethodPost {
+				op.WriteError(w, r, oidc.ErrInvalidRequest().WithDescription("backchannel authentication requests must be sent with POST"), s.getLogger(ctx))
+				return
+			}
+			resp, err := s.backChannelAuthenticate(ctx, r)
+			if err != nil {
+				op.WriteError(w, r, err, s.getLogger(ctx))
+				return
+			}
+			httphelper.MarshalJSON(w, resp)
+		case r.Method == http.MethodPost && r.URL.Path == s.Endpoints().Token.Relative() && isCIBATokenRequest(r):
+			ctx := op.ContextWithIssuer(r.Context(), s.IssuerFromRequest(r))
+			r = r.WithContext(ctx)
+			resp, err := s.backChannelAuthToken(ctx, r)
+			if err != nil {
+				op.WriteError(w, r, err, s.getLogger(ctx))
+				return
+			}
+			httphelper.MarshalJSON(w, resp)
+		default:
+			next.ServeHTTP(w, r)
+		}
+	})
+}
+
+// isCIBATokenRequest parses the form of the request,
+// which the oidc library is able to parse again for all other grant types.
+func isCIBATokenRequest(r *http.Request) bool {
+	if err := r.ParseForm(); err != nil {
+		return false
+	}
+	return oidc.GrantType(r.PostForm.Get("grant_type")) == grantTypeCIBA
+}
+
+// backChannelAuthenticate authenticates the client, resolves the user from the hint
+// and creates a pending request, which the user is asked to approve.
+func (s *Server) backChannelAuthenticate(ctx context.Context, r *http.Request) (_ *backChannelAuthResponse, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() {
+		err = oidcError(err)
+		span.EndWithError(err)
+	}()
+
+	client, err := s.verifyBackChannelAuthClient(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	form := r.PostForm
+	if clientID := form.Get("client_id"); clientID != "" && clientID != client.GetID() {
+		return nil, oidc.ErrInvalidRequest().WithDescription("client_id does not match the authenticated client")
+	}
+	if form.Has("request") {
+		return nil, oidc.ErrRequestNotSupported()
+	}
+	scopes := strings.Fields(form.Get("scope"))
+	if !slices.Contains(scopes, oidc.ScopeOpenID) {
+		return nil, oidc.ErrInvalidScope().WithDescription("the openid scope is required")
+	}
+	user, err := s.backChannelAuthUser(ctx, form.Get("login_hint"), form.Get("id_token_hint"), form.Get("login_hint_token"))
+	if err != nil {
+		return nil, err
+	}
+	bindingMessage := form.Get("binding_message")
+	if utf8.RuneCountInString(bindingMessage) > bindingMessageMaxLength {
+		return nil, &oidc.Error{ErrorType: errorTypeInvalidBindingMessage, Description: "binding_message is too long"}
+	}
+	notificationURI := client.client.CIBANotificationURI
+	notificationToken := form.Get("client_notification_token")
+	if notificationURI != "" && notificationToken == "" {
+		return nil, oidc.ErrInvalidRequest().WithDescription("client_notification_token is required")
+	}
+	if len(notificationToken) > clientNotificationTokenMaxLength {
+		return nil, oidc.ErrInvalidRequest().WithDescription("client_notification_token is too long")
+	}
+	lifetime, err := backChannelAuthLifetime(form.Get("requested_expiry"), s.backChannelAuthConfig.lifetime())
+	if err != nil {
+		return nil, err
+	}
+
+	storage, ok := s.Provider().Storage().(*OPStorage)
+	if !ok {
+		return nil, zerrors.ThrowInternal(nil, "OIDC-Ahx4u", "Errors.Internal")
+	}
+	scopes, audience, _, err := storage.createAuthRequestScopeAndAudience(ctx, client.GetID(), scopes)
+	if err != nil {
+		return nil, err
+	}
+	// the auth_req_id is as unguessable as a device code
+	authReqID, err := op.NewDeviceCode(op.RecommendedDeviceCodeBytes)
+	if err != nil {
+		return nil, err
+	}
+	_, err = s.command.AddBackChannelAuth(ctx, &command.BackChannelAuthRequest{
+		ID:                authReqID,
+		ClientID:          client.GetID(),
+		Scopes:            scopes,
+		Audience:          audience,
+		UserID:            user.ID,
+		UserOrgID:         user.ResourceOwner,
+		BindingMessage:    bindingMessage,
+		Expires:           time.Now().Add(lifetime),
+		NeedRefreshToken:  slices.Contains(scopes, oidc.ScopeOfflineAccess),
+		NotificationURI:   notificationURI,
+		NotificationToken: notificationToken,
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp := &backChannelAuthResponse{
+		AuthReqID: authReqID,
+		ExpiresIn: uint64(lifetime / time.Second),
+	}
+	if notificationURI == "" {
+		resp.Interval = uint64(s.backChannelAuthConfig.pollInterval() / time.Second)
+	}
+	return resp, nil
+}
+
+// backChannelAuthToken exchanges an approved auth_req_id for tokens.
+// Pending, denied and expired requests are reported with the errors of the poll mode.
+func (s *Server) backChannelAuthToken(ctx context.Context, r *http.Request) (_ *oidc.AccessTokenResponse, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() {
+		span.EndWithError(err)
+		err = oidcError(err)
+	}()
+
+	client, err := s.verifyBackChannelAuthClient(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	authReqID := r.PostForm.Get("auth_req_id")
+	if authReqID == "" {
+		return nil, oidc.ErrInvalidRequest().WithDescription("auth_req_id missing")
+	}
+	dpopJKT, err := dpopJKTFromTokenRequest(ctx, s.dpopVerifier, &op.Request[struct{}]{
+		Method: r.Method,
+		URL:    r.URL,
+		Header: r.Header,
+		Form:   r.PostForm,
+	}, client.client.DPoPBoundAccessTokens)
+	if err != nil {
+		return nil, err
+	}
+	certificateThumbprint, err := certificateThumbprintFromTokenRequest(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	session, err := s.command.CreateOIDCSessionFromBackChannelAuth(ctx, authReqID, client.GetID(), client.client.BackChannelLogoutURI, dpopJKT, certificateThumbprint)
+	if err != nil {
+		return nil, backChannelAuthError(ctx, err)
+	}
+	return s.accessTokenResponseFromSession(ctx, client, session, "", client.client.ProjectID, client.client.ProjectRoleAssertion, client.client.AccessTokenRoleAssertion, client.client.IDTokenRoleAssertion, client.client.IDTokenUserinfoAssertion)
+}
+
+// verifyBackChannelAuthClient authenticates the client, which must be confidential
+// and allowed to use the CIBA grant.
+func (s *Server) verifyBackChannelAuthClient(ctx context.Context, r *http.Request) (*Client, error) {
+	if err := r.ParseForm(); err != nil {
+		return nil, oidc.ErrInvalidRequest().WithDescription("error parsing form").WithParent(err)
+	}
+	credentials, err := clientCredentialsFromRequest(r)
+	if err != nil {
+		return nil, err
+	}
+	opClient, err := s.VerifyClient(ctx, &op.Request[op.ClientCredentials]{
+		Method: r.Method,
+		URL:    r.URL,
+		Header: r.Header,
+		Form:   r.PostForm,
+		Data:   credentials,
+	})
+	if err != nil {
+		return nil, err
+	}
+	client, ok := opClient.(*Client)
+	if !ok {
+		return nil, zerrors.ThrowInternal(nil, "OIDC-ooC4i", "Error.Internal")
+	}
+	if client.client.AuthMethodType == domain.OIDCAuthMethodTypeNone {
+		return nil, oidc.ErrUnauthorizedClient().WithDescription("backchannel authentication requires a confidential client")
+	}
+	if !op.ValidateGrantType(client, grantTypeCIBA) {
+		return nil, oidc.ErrUnauthorizedClient().WithDescription("grant_type %q not allowed", grantTypeCIBA)
+	}
+	return client, nil
+}
+
+// backChannelAuthUser resolves the active human user identified by exactly one of the hints.
+func (s *Server) backChannelAuthUser(ctx context.Context, loginHint, idTokenHint, loginHintToken string) (_ *query.User, err error) {
+	if countNonEmpty(loginHint, idTokenHint, loginHintToken) != 1 {
+		return nil, oidc.ErrInvalidRequest().WithDescription("exactly one of login_hint, id_token_hint or login_hint_token is required")
+	}
+	if loginHintToken != "" {
+		return nil, oidc.ErrInvalidRequest().WithDescription("login_hint_token is not supported")
+	}
+	var user *query.User
+	if loginHint != "" {
+		user, err = s.query.GetUserByLoginName(ctx, true, loginHint)
+	} else {
+		var userID string
+		userID, err = op.ValidateAuthReqIDTokenHint(ctx, idTokenHint, s.Provider().IDTokenHintVerifier(ctx))
+		if err != nil {
+			return nil, oidc.ErrInvalidRequest().WithDescription("id_token_hint is invalid").WithParent(err)
+		}
+		user, err = s.query.GetUserByID(ctx, true, userID)
+	}
+	if zerrors.IsNotFound(err) {
+		return nil, (&oidc.Error{ErrorType: errorTypeUnknownUserID, Description: "the user could not be identified"}).WithParent(err)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if user.Type != domain.UserTypeHuman || user.State != domain.UserStateActive {
+		return nil, oidc.ErrAccessDenied().WithDescription("the user is not able to authenticate")
+	}
+	return user, nil
+}
+
+// backChannelAuthLifetime returns the requested_expiry, if it is shorter than the maximal lifetime.
+func backChannelAuthLifetime(requestedExpiry string, maxLifetime time.Duration) (time.Duration, error) {
+	if requestedExpiry == "" {
+		return maxLifetime, nil
+	}
+	seconds, err := strconv.ParseUint(requestedExpiry, 10, 32)
+	if err != nil || seconds == 0 {
+		return 0, oidc.ErrInvalidRequest().WithDescription("requested_expiry must be a positive integer")
+	}
+	return min(time.Duration(seconds)*time.Second, maxLifetime), nil
+}
+
+func countNonEmpty(values ...string) (n int) {
+	for _, value := range values {
+		if value != "" {
+			n++
+		}
+	}
+	return n
+}
+
+// backChannelAuthError maps the state of a backchannel authentication request,
+// which was not (yet) approved, to the corresponding error of the poll mode.
+func backChannelAuthError(ctx context.Context, err error) error {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return oidc.ErrSlowDown().WithParent(err).WithReturnParentToClient(authz.GetFeatures(ctx).DebugOIDCParentError)
+	}
+
+	var target command.DeviceAuthStateError
+	if errors.As(err, &target) {
+		switch domain.DeviceAuthState(target) {
+		case domain.DeviceAuthStateInitiated:
+			return oidc.ErrAuthorizationPending().WithDescription("the authentication request is still pending")
+		case domain.DeviceAuthStateExpired:
+			return oidc.ErrExpiredDeviceCode().WithDescription("the auth_req_id has expired")
+		case domain.DeviceAuthStateDenied:
+			return oidc.ErrAccessDenied().WithDescription("the user denied the authentication request")
+		}
+	}
+	return oidc.ErrInvalidGrant().WithParent(err).WithReturnParentToClient(authz.GetFeatures(ctx).DebugOIDCParentError)
+}
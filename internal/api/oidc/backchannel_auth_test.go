@@ -0,0 +1,130 @@
+package oidc
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zitadel/oidc/v3/pkg/oidc"
+
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func Test_backChannelAuthLifetime(t *testing.T) {
+	tests := []struct {
+		name            string
+		requestedExpiry string
+		want            time.Duration
+		wantErr         error
+	}{
+		{
+			name: "not requested",
+			want: 5 * time.Minute,
+		},
+		{
+			name:            "shorter",
+			requestedExpiry: "60",
+			want:            time.Minute,
+		},
+		{
+			name:            "longer than maximum",
+			requestedExpiry: "3600",
+			want:            5 * time.Minute,
+		},
+		{
+			name:            "zero",
+			requestedExpiry: "0",
+			wantErr:         oidc.ErrInvalidRequest().WithDescription("requested_expiry must be a positive integer"),
+		},
+		{
+			name:            "invalid",
+			requestedExpiry: "-1",
+			wantErr:         oidc.ErrInvalidRequest().WithDescription("requested_expiry must be a positive integer"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := backChannelAuthLifetime(tt.requestedExpiry, 5*time.Minute)
+			require.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_backChannelAuthError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want *oidc.Error
+	}{
+		{
+			name: "pending",
+			err:  command.DeviceAuthStateError(domain.DeviceAuthStateInitiated),
+			want: oidc.ErrAuthorizationPending().WithDescription("the authentication request is still pending"),
+		},
+		{
+			name: "expired",
+			err:  command.DeviceAuthStateError(domain.DeviceAuthStateExpired),
+			want: oidc.ErrExpiredDeviceCode().WithDescription("the auth_req_id has expired"),
+		},
+		{
+			name: "denied",
+			err:  command.DeviceAuthStateError(domain.DeviceAuthStateDenied),
+			want: oidc.ErrAccessDenied().WithDescription("the user denied the authentication request"),
+		},
+		{
+			name: "done",
+			err:  command.DeviceAuthStateError(domain.DeviceAuthStateDone),
+			want: oidc.ErrInvalidGrant(),
+		},
+		{
+			name: "not found",
+			err:  zerrors.ThrowNotFound(nil, "COMMAND-eiK4u", "Errors.BackChannelAuth.NotFound"),
+			want: oidc.ErrInvalidGrant(),
+		},
+		{
+			name: "deadline exceeded",
+			err:  context.DeadlineExceeded,
+			want: oidc.ErrSlowDown(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.ErrorIs(t, backChannelAuthError(context.Background(), tt.err), tt.want)
+		})
+	}
+}
+
+func Test_isCIBATokenRequest(t *testing.T) {
+	tests := []struct {
+		name string
+		form url.Values
+		want bool
+	}{
+		{
+			name: "ciba",
+			form: url.Values{"grant_type": {string(grantTypeCIBA)}, "auth_req_id": {"id"}},
+			want: true,
+		},
+		{
+			name: "device code",
+			form: url.Values{"grant_type": {string(oidc.GrantTypeDeviceCode)}},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPost, "/oauth/v2/token", strings.NewReader(tt.form.Encode()))
+			r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			assert.Equal(t, tt.want, isCIBATokenRequest(r))
+		})
+	}
+}
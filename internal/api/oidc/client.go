@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"slices"
 	"strings"
@@ -19,6 +20,7 @@ import (
 	"github.com/zitadel/zitadel/internal/actions/object"
 	"github.com/zitadel/zitadel/internal/api/authz"
 	api_http "github.com/zitadel/zitadel/internal/api/http"
+	"github.com/zitadel/zitadel/internal/api/mtls"
 	"github.com/zitadel/zitadel/internal/command"
 	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/domain"
@@ -814,11 +816,17 @@ func (o *OPStorage) assertRoles(ctx context.Context, userID, applicationID strin
 	if err != nil {
 		return nil, nil, err
 	}
+	// grants outside their validity don't grant any roles, even before they are expired
+	validQuery, err := query.NewUserGrantValidAtQuery(time.Now())
+	if err != nil {
+		return nil, nil, err
+	}
 	grants, err := o.query.UserGrants(ctx, &query.UserGrantsQueries{
 		Queries: []query.SearchQuery{
 			projectQuery,
 			userIDQuery,
 			activeQuery,
+			validQuery,
 		},
 	}, true)
 	if err != nil {
@@ -1003,6 +1011,8 @@ func (s *Server) VerifyClient(ctx context.Context, r *op.Request[op.ClientCreden
 		err = s.verifyClientSecret(ctx, client, r.Data.ClientSecret)
 	case domain.OIDCAuthMethodTypePrivateKeyJWT:
 		err = s.verifyClientAssertion(ctx, client, r.Data.ClientAssertion)
+	case domain.OIDCAuthMethodTypeTLSClientAuth, domain.OIDCAuthMethodTypeSelfSignedTLSClientAuth:
+		err = s.verifyClientCertificate(ctx, client)
 	case domain.OIDCAuthMethodTypeNone:
 	}
 	if err != nil {
@@ -1026,6 +1036,28 @@ func (s *Server) verifyClientAssertion(ctx context.Context, client *query.OIDCCl
 	return nil
 }
 
+// verifyClientCertificate authenticates the client by the certificate presented on the mutual-TLS connection (RFC 8705).
+// With tls_client_auth the certificate must be issued by a trusted CA for the registered subject,
+// with self_signed_tls_client_auth it must be one of the registered certificates.
+func (s *Server) verifyClientCertificate(ctx context.Context, client *query.OIDCClient) (err error) {
+	_, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	switch client.AuthMethodType {
+	case domain.OIDCAuthMethodTypeTLSClientAuth:
+		err = mtls.VerifyPKI(mtls.Certificates(ctx), s.tlsClientAuthRoots, client.TLSClientAuthSubjectDN, time.Now())
+	case domain.OIDCAuthMethodTypeSelfSignedTLSClientAuth:
+		err = mtls.VerifySelfSigned(mtls.Certificate(ctx), client.TLSClientCertificates)
+	}
+	if errors.Is(err, mtls.ErrNoCertificate) {
+		return oidc.ErrInvalidClient().WithParent(err).WithDescription("no client certificate presented")
+	}
+	if err != nil {
+		return oidc.ErrInvalidClient().WithParent(err).WithReturnParentToClient(authz.GetFeatures(ctx).DebugOIDCParentError).WithDescription("invalid client certificate")
+	}
+	return nil
+}
+
 func (s *Server) verifyClientSecret(ctx context.Context, client *query.OIDCClient, secret string) (err error) {
 	_, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
@@ -157,6 +157,13 @@ func accessTokenTypeToOIDC(tokenType domain.OIDCTokenType) op.AccessTokenType {
 	}
 }
 
+const (
+	// authMethodTLSClientAuth and authMethodSelfSignedTLSClientAuth are the mutual-TLS client authentication methods of RFC 8705,
+	// which are not defined by the oidc library.
+	authMethodTLSClientAuth           oidc.AuthMethod = "tls_client_auth"
+	authMethodSelfSignedTLSClientAuth oidc.AuthMethod = "self_signed_tls_client_auth"
+)
+
 func authMethodToOIDC(authType domain.OIDCAuthMethodType) oidc.AuthMethod {
 	switch authType {
 	case domain.OIDCAuthMethodTypeBasic:
@@ -167,6 +174,10 @@ func authMethodToOIDC(authType domain.OIDCAuthMethodType) oidc.AuthMethod {
 		return oidc.AuthMethodNone
 	case domain.OIDCAuthMethodTypePrivateKeyJWT:
 		return oidc.AuthMethodPrivateKeyJWT
+	case domain.OIDCAuthMethodTypeTLSClientAuth:
+		return authMethodTLSClientAuth
+	case domain.OIDCAuthMethodTypeSelfSignedTLSClientAuth:
+		return authMethodSelfSignedTLSClientAuth
 	default:
 		return oidc.AuthMethodBasic
 	}
@@ -213,6 +224,8 @@ func grantTypeToOIDC(grantType domain.OIDCGrantType) oidc.GrantType {
 		return oidc.GrantTypeDeviceCode
 	case domain.OIDCGrantTypeTokenExchange:
 		return oidc.GrantTypeTokenExchange
+	case domain.OIDCGrantTypeCIBA:
+		return grantTypeCIBA
 	default:
 		return oidc.GrantTypeCode
 	}
@@ -0,0 +1,472 @@
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/go-jose/go-jose/v4"
+	httphelper "github.com/zitadel/oidc/v3/pkg/http"
+	"github.com/zitadel/oidc/v3/pkg/oidc"
+	"github.com/zitadel/oidc/v3/pkg/op"
+
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	errorTypeInvalidClientMetadata       = "invalid_client_metadata"
+	errorTypeInvalidSoftwareStatement    = "invalid_software_statement"
+	errorTypeUnapprovedSoftwareStatement = "unapproved_software_statement"
+	errorTypeInvalidToken                = "invalid_token"
+
+	applicationTypeWeb    = "web"
+	applicationTypeNative = "native"
+
+	// clientRegistrationMaxBodySize limits the size of the client metadata, which is small for all sane clients.
+	clientRegistrationMaxBodySize = 64 * 1024
+)
+
+// clientRegistrationRequest is the client metadata of RFC 7591 and OpenID Connect Dynamic Client Registration,
+// limited to the metadata which can be stored on an OIDC application.
+type clientRegistrationRequest struct {
+	ClientID                string              `json:"client_id,omitempty"`
+	ClientName              string              `json:"client_name,omitempty"`
+	RedirectURIs            []string            `json:"redirect_uris,omitempty"`
+	PostLogoutRedirectURIs  []string            `json:"post_logout_redirect_uris,omitempty"`
+	ResponseTypes           []oidc.ResponseType `json:"response_types,omitempty"`
+	GrantTypes              []oidc.GrantType    `json:"grant_types,omitempty"`
+	ApplicationType         string              `json:"application_type,omitempty"`
+	TokenEndpointAuthMethod oidc.AuthMethod     `json:"token_endpoint_auth_method,omitempty"`
+	SoftwareStatement       string              `json:"software_statement,omitempty"`
+}
+
+type clientRegistrationResponse struct {
+	ClientID                string              `json:"client_id"`
+	ClientSecret            string              `json:"client_secret,omitempty"`
+	ClientIDIssuedAt        int64               `json:"client_id_issued_at,omitempty"`
+	ClientSecretExpiresAt   *int64              `json:"client_secret_expires_at,omitempty"`
+	RegistrationAccessToken string              `json:"registration_access_token,omitempty"`
+	RegistrationClientURI   string              `json:"registration_client_uri"`
+	ClientName              string              `json:"client_name,omitempty"`
+	RedirectURIs            []string            `json:"redirect_uris,omitempty"`
+	PostLogoutRedirectURIs  []string            `json:"post_logout_redirect_uris,omitempty"`
+	ResponseTypes           []oidc.ResponseType `json:"response_types"`
+	GrantTypes              []oidc.GrantType    `json:"grant_types"`
+	ApplicationType         string              `json:"application_type"`
+	TokenEndpointAuthMethod oidc.AuthMethod     `json:"token_endpoint_auth_method"`
+}
+
+// clientRegistrationHandler serves the dynamic client registration endpoint (RFC 7591)
+// and the client configuration endpoints of the registered clients (RFC 7592).
+// The oidc library does not support dynamic client registration,
+// therefore the endpoints are served by a middleware in front of the library's router.
+func (s *Server) clientRegistrationHandler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if s.clientRegistrationEndpoint == nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+		registrationPath := s.clientRegistrationEndpoint.Relative()
+		clientID, isClientPath := strings.CutPrefix(r.URL.Path, registrationPath+"/")
+		if r.URL.Path != registrationPath && (!isClientPath || clientID == "" || strings.Contains(clientID, "/")) {
+			next.ServeHTTP(w, r)
+			return
+		}
+		ctx := op.ContextWithIssuer(r.Context(), s.IssuerFromRequest(r))
+		r = r.WithContext(ctx)
+		var (
+			resp   *clientRegistrationResponse
+			status = http.StatusOK
+			err    error
+		)
+		switch {
+		case !isClientPath && r.Method == http.MethodPost:
+			resp, err = s.registerClient(ctx, r)
+			status = http.StatusCreated
+		case isClientPath && r.Method == http.MethodGet:
+			resp, err = s.readRegisteredClient(ctx, r, clientID)
+		case isClientPath && r.Method == http.MethodPut:
+			resp, err = s.updateRegisteredClient(ctx, r, clientID)
+		case isClientPath && r.Method == http.MethodDelete:
+			err = s.deleteRegisteredClient(ctx, r, clientID)
+			status = http.StatusNoContent
+		default:
+			err = op.NewStatusError(oidc.ErrInvalidRequest().WithDescription("method not allowed"), http.StatusMethodNotAllowed)
+		}
+		if err != nil {
+			op.WriteError(w, r, err, s.getLogger(ctx))
+			return
+		}
+		if resp == nil {
+			w.WriteHeader(status)
+			return
+		}
+		httphelper.MarshalJSONWithStatus(w, resp, status)
+	})
+}
+
+// registerClient creates an OIDC application in the project configured for the dynamic client registration.
+// The request must either be authorized by an initial access token or contain a software statement of a trusted issuer.
+// The claims of the software statement take precedence over the plain client metadata.
+func (s *Server) registerClient(ctx context.Context, r *http.Request) (_ *clientRegistrationResponse, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() {
+		err = clientRegistrationError(err)
+		span.EndWithError(err)
+	}()
+
+	config, err := s.query.ClientRegistrationConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	req, err := decodeClientRegistrationRequest(r)
+	if err != nil {
+		return nil, err
+	}
+	token, hasToken := bearerToken(r)
+	if hasToken {
+		if err = s.command.VerifyClientRegistrationInitialAccessToken(ctx, token); err != nil {
+			return nil, err
+		}
+	}
+	if req.SoftwareStatement != "" {
+		if err = verifySoftwareStatement(ctx, req, config); err != nil {
+			return nil, err
+		}
+	} else if !hasToken {
+		return nil, errInvalidToken("an initial access token or a software statement is required")
+	}
+	metadata, err := clientRegistrationRequestToMetadata(req)
+	if err != nil {
+		return nil, err
+	}
+	client, err := s.command.RegisterOIDCClient(ctx, metadata)
+	if err != nil {
+		return nil, err
+	}
+	resp := s.oidcAppToClientRegistrationResponse(ctx, client.App, client.RegistrationAccessToken)
+	if client.App.ClientSecretString != "" {
+		resp.ClientSecret = client.App.ClientSecretString
+		// secrets of registered clients don't expire
+		resp.ClientSecretExpiresAt = new(int64)
+	}
+	return resp, nil
+}
+
+func (s *Server) readRegisteredClient(ctx context.Context, r *http.Request, clientID string) (_ *clientRegistrationResponse, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() {
+		err = clientRegistrationError(err)
+		span.EndWithError(err)
+	}()
+
+	app, err := s.verifyRegisteredClient(ctx, r, clientID)
+	if err != nil {
+		return nil, err
+	}
+	return s.queryAppToClientRegistrationResponse(ctx, app), nil
+}
+
+// updateRegisteredClient replaces the metadata of the registered client (RFC 7592 section 2.2).
+// Omitted metadata is reset to its default, as the request represents the complete client.
+func (s *Server) updateRegisteredClient(ctx context.Context, r *http.Request, clientID string) (_ *clientRegistrationResponse, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() {
+		err = clientRegistrationError(err)
+		span.EndWithError(err)
+	}()
+
+	app, err := s.verifyRegisteredClient(ctx, r, clientID)
+	if err != nil {
+		return nil, err
+	}
+	req, err := decodeClientRegistrationRequest(r)
+	if err != nil {
+		return nil, err
+	}
+	if req.ClientID != clientID {
+		return nil, oidc.ErrInvalidRequest().WithDescription("client_id does not match the registered client")
+	}
+	if req.SoftwareStatement != "" {
+		return nil, &oidc.Error{ErrorType: errorTypeInvalidSoftwareStatement, Description: "software statements can only be used on registration"}
+	}
+	metadata, err := clientRegistrationRequestToMetadata(req)
+	if err != nil {
+		return nil, err
+	}
+	changed, err := s.command.ChangeRegisteredOIDCClient(ctx, app.ProjectID, app.ID, metadata)
+	if err != nil {
+		return nil, err
+	}
+	resp := s.oidcAppToClientRegistrationResponse(ctx, changed, "")
+	resp.ClientIDIssuedAt = app.CreationDate.Unix()
+	return resp, nil
+}
+
+func (s *Server) deleteRegisteredClient(ctx context.Context, r *http.Request, clientID string) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() {
+		err = clientRegistrationError(err)
+		span.EndWithError(err)
+	}()
+
+	app, err := s.verifyRegisteredClient(ctx, r, clientID)
+	if err != nil {
+		return err
+	}
+	_, err = s.command.RemoveApplication(ctx, app.ProjectID, app.ID, app.ResourceOwner)
+	return err
+}
+
+// verifyRegisteredClient returns the application of the client
+// if the request is authorized by the registration access token issued on its registration.
+// Unknown clients are treated as invalid token, so the existence of clients is not disclosed.
+func (s *Server) verifyRegisteredClient(ctx context.Context, r *http.Request, clientID string) (*query.App, error) {
+	token, ok := bearerToken(r)
+	if !ok {
+		return nil, errInvalidToken("registration access token missing")
+	}
+	app, err := s.query.AppByOIDCClientID(ctx, clientID)
+	if err != nil {
+		if zerrors.IsNotFound(err) {
+			return nil, errInvalidToken("invalid registration access token")
+		}
+		return nil, err
+	}
+	if err = s.command.VerifyOIDCClientRegistrationAccessToken(ctx, app.ProjectID, app.ID, token); err != nil {
+		return nil, err
+	}
+	return app, nil
+}
+
+// verifySoftwareStatement verifies the signature of the software statement
+// with the keys of its issuer and overrides the client metadata with the claims of the statement.
+func verifySoftwareStatement(ctx context.Context, req *clientRegistrationRequest, config *query.ClientRegistrationConfig) error {
+	claims := new(oidc.TokenClaims)
+	payload, err := oidc.ParseToken(req.SoftwareStatement, claims)
+	if err != nil {
+		return &oidc.Error{ErrorType: errorTypeInvalidSoftwareStatement, Description: "software statement is malformed", Parent: err}
+	}
+	issuer := config.SoftwareStatementIssuer(claims.Issuer)
+	if issuer == nil {
+		return &oidc.Error{ErrorType: errorTypeUnapprovedSoftwareStatement, Description: "issuer of the software statement is not trusted"}
+	}
+	keySet := new(jose.JSONWebKeySet)
+	if err = json.Unmarshal(issuer.JWKS, keySet); err != nil {
+		return err
+	}
+	if err = oidc.CheckSignature(ctx, req.SoftwareStatement, payload, claims, workloadIdentitySigningAlgorithms, staticKeySet(keySet.Keys)); err != nil {
+		return &oidc.Error{ErrorType: errorTypeInvalidSoftwareStatement, Description: "invalid signature of the software statement", Parent: err}
+	}
+	if !claims.GetExpiration().IsZero() {
+		if err = oidc.CheckExpiration(claims, 0); err != nil {
+			return &oidc.Error{ErrorType: errorTypeInvalidSoftwareStatement, Description: "software statement expired", Parent: err}
+		}
+	}
+	statement := new(clientRegistrationRequest)
+	if err = json.Unmarshal(payload, statement); err != nil {
+		return &oidc.Error{ErrorType: errorTypeInvalidSoftwareStatement, Description: "invalid claims of the software statement", Parent: err}
+	}
+	overrideClientMetadata(req, statement)
+	return nil
+}
+
+// overrideClientMetadata sets all metadata asserted by the software statement on the request.
+func overrideClientMetadata(req, statement *clientRegistrationRequest) {
+	if statement.ClientName != "" {
+		req.ClientName = statement.ClientName
+	}
+	if len(statement.RedirectURIs) > 0 {
+		req.RedirectURIs = statement.RedirectURIs
+	}
+	if len(statement.PostLogoutRedirectURIs) > 0 {
+		req.PostLogoutRedirectURIs = statement.PostLogoutRedirectURIs
+	}
+	if len(statement.ResponseTypes) > 0 {
+		req.ResponseTypes = statement.ResponseTypes
+	}
+	if len(statement.GrantTypes) > 0 {
+		req.GrantTypes = statement.GrantTypes
+	}
+	if statement.ApplicationType != "" {
+		req.ApplicationType = statement.ApplicationType
+	}
+	if statement.TokenEndpointAuthMethod != "" {
+		req.TokenEndpointAuthMethod = statement.TokenEndpointAuthMethod
+	}
+}
+
+func decodeClientRegistrationRequest(r *http.Request) (*clientRegistrationRequest, error) {
+	req := new(clientRegistrationRequest)
+	if err := json.NewDecoder(http.MaxBytesReader(nil, r.Body, clientRegistrationMaxBodySize)).Decode(req); err != nil {
+		return nil, oidc.ErrInvalidRequest().WithDescription("error decoding client metadata").WithParent(err)
+	}
+	return req, nil
+}
+
+// clientRegistrationRequestToMetadata applies the defaults of RFC 7591 section 2
+// and maps the client metadata to the types of OIDC applications.
+func clientRegistrationRequestToMetadata(req *clientRegistrationRequest) (*command.OIDCClientMetadata, error) {
+	metadata := &command.OIDCClientMetadata{
+		ClientName:             req.ClientName,
+		RedirectURIs:           req.RedirectURIs,
+		PostLogoutRedirectURIs: req.PostLogoutRedirectURIs,
+		ResponseTypes:          []domain.OIDCResponseType{domain.OIDCResponseTypeCode},
+		GrantTypes:             []domain.OIDCGrantType{domain.OIDCGrantTypeAuthorizationCode},
+		ApplicationType:        domain.OIDCApplicationTypeWeb,
+		AuthMethodType:         domain.OIDCAuthMethodTypeBasic,
+	}
+	if len(req.ResponseTypes) > 0 {
+		metadata.ResponseTypes = make([]domain.OIDCResponseType, len(req.ResponseTypes))
+		for i, responseType := range req.ResponseTypes {
+			t, ok := responseTypeFromOIDC(responseType)
+			if !ok {
+				return nil, errInvalidClientMetadata("unsupported response type %q", responseType)
+			}
+			metadata.ResponseTypes[i] = t
+		}
+	}
+	if len(req.GrantTypes) > 0 {
+		metadata.GrantTypes = make([]domain.OIDCGrantType, len(req.GrantTypes))
+		for i, grantType := range req.GrantTypes {
+			t, ok := grantTypeFromOIDC(grantType)
+			if !ok {
+				return nil, errInvalidClientMetadata("unsupported grant type %q", grantType)
+			}
+			metadata.GrantTypes[i] = t
+		}
+	}
+	switch req.ApplicationType {
+	case "", applicationTypeWeb:
+	case applicationTypeNative:
+		metadata.ApplicationType = domain.OIDCApplicationTypeNative
+	default:
+		return nil, errInvalidClientMetadata("unsupported application type %q", req.ApplicationType)
+	}
+	switch req.TokenEndpointAuthMethod {
+	case "", oidc.AuthMethodBasic:
+	case oidc.AuthMethodPost:
+		metadata.AuthMethodType = domain.OIDCAuthMethodTypePost
+	case oidc.AuthMethodNone:
+		metadata.AuthMethodType = domain.OIDCAuthMethodTypeNone
+	default:
+		return nil, errInvalidClientMetadata("unsupported token endpoint auth method %q", req.TokenEndpointAuthMethod)
+	}
+	return metadata, nil
+}
+
+func responseTypeFromOIDC(responseType oidc.ResponseType) (domain.OIDCResponseType, bool) {
+	switch responseType {
+	case oidc.ResponseTypeCode:
+		return domain.OIDCResponseTypeCode, true
+	case oidc.ResponseTypeIDToken:
+		return domain.OIDCResponseTypeIDTokenToken, true
+	case oidc.ResponseTypeIDTokenOnly:
+		return domain.OIDCResponseTypeIDToken, true
+	default:
+		return domain.OIDCResponseTypeUnspecified, false
+	}
+}
+
+func grantTypeFromOIDC(grantType oidc.GrantType) (domain.OIDCGrantType, bool) {
+	switch grantType {
+	case oidc.GrantTypeCode:
+		return domain.OIDCGrantTypeAuthorizationCode, true
+	case oidc.GrantTypeImplicit:
+		return domain.OIDCGrantTypeImplicit, true
+	case oidc.GrantTypeRefreshToken:
+		return domain.OIDCGrantTypeRefreshToken, true
+	case oidc.GrantTypeDeviceCode:
+		return domain.OIDCGrantTypeDeviceCode, true
+	case oidc.GrantTypeTokenExchange:
+		return domain.OIDCGrantTypeTokenExchange, true
+	default:
+		return 0, false
+	}
+}
+
+func applicationTypeToRegistration(appType domain.OIDCApplicationType) string {
+	if appType == domain.OIDCApplicationTypeNative {
+		return applicationTypeNative
+	}
+	return applicationTypeWeb
+}
+
+func (s *Server) registrationClientURI(ctx context.Context, clientID string) string {
+	return s.clientRegistrationEndpoint.Absolute(op.IssuerFromContext(ctx)) + "/" + clientID
+}
+
+func (s *Server) oidcAppToClientRegistrationResponse(ctx context.Context, app *domain.OIDCApp, registrationAccessToken string) *clientRegistrationResponse {
+	return &clientRegistrationResponse{
+		ClientID:                app.ClientID,
+		ClientIDIssuedAt:        app.ChangeDate.Unix(),
+		RegistrationAccessToken: registrationAccessToken,
+		RegistrationClientURI:   s.registrationClientURI(ctx, app.ClientID),
+		ClientName:              app.AppName,
+		RedirectURIs:            app.RedirectUris,
+		PostLogoutRedirectURIs:  app.PostLogoutRedirectUris,
+		ResponseTypes:           responseTypesToOIDC(app.ResponseTypes),
+		GrantTypes:              grantTypesToOIDC(app.GrantTypes),
+		ApplicationType:         applicationTypeToRegistration(app.ApplicationType),
+		TokenEndpointAuthMethod: authMethodToOIDC(app.AuthMethodType),
+	}
+}
+
+func (s *Server) queryAppToClientRegistrationResponse(ctx context.Context, app *query.App) *clientRegistrationResponse {
+	return &clientRegistrationResponse{
+		ClientID:                app.OIDCConfig.ClientID,
+		ClientIDIssuedAt:        app.CreationDate.Unix(),
+		RegistrationClientURI:   s.registrationClientURI(ctx, app.OIDCConfig.ClientID),
+		ClientName:              app.Name,
+		RedirectURIs:            app.OIDCConfig.RedirectURIs,
+		PostLogoutRedirectURIs:  app.OIDCConfig.PostLogoutRedirectURIs,
+		ResponseTypes:           responseTypesToOIDC(app.OIDCConfig.ResponseTypes),
+		GrantTypes:              grantTypesToOIDC(app.OIDCConfig.GrantTypes),
+		ApplicationType:         applicationTypeToRegistration(app.OIDCConfig.AppType),
+		TokenEndpointAuthMethod: authMethodToOIDC(app.OIDCConfig.AuthMethodType),
+	}
+}
+
+// bearerToken returns the token of the bearer authorization header.
+func bearerToken(r *http.Request) (string, bool) {
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), oidc.PrefixBearer)
+	if !ok || token == "" {
+		return "", false
+	}
+	return token, true
+}
+
+func errInvalidToken(description string) error {
+	return op.NewStatusError(&oidc.Error{ErrorType: errorTypeInvalidToken, Description: description}, http.StatusUnauthorized)
+}
+
+func errInvalidClientMetadata(format string, args ...any) error {
+	return (&oidc.Error{ErrorType: errorTypeInvalidClientMetadata}).WithDescription(format, args...)
+}
+
+// clientRegistrationError maps invalid arguments of the commands to invalid client metadata
+// and failed token verifications to invalid token errors as defined by RFC 7591 and RFC 7592.
+// All other errors are handled by [oidcError].
+func clientRegistrationError(err error) error {
+	if err == nil {
+		return nil
+	}
+	var zErr *zerrors.ZitadelError
+	if !errors.As(err, &zErr) {
+		return oidcError(err)
+	}
+	switch {
+	case zerrors.IsErrorInvalidArgument(err):
+		return (&oidc.Error{ErrorType: errorTypeInvalidClientMetadata, Description: zErr.GetMessage()}).WithParent(err)
+	case zerrors.IsUnauthenticated(err):
+		return op.NewStatusError((&oidc.Error{ErrorType: errorTypeInvalidToken, Description: zErr.GetMessage()}).WithParent(err), http.StatusUnauthorized)
+	default:
+		return oidcError(err)
+	}
+}
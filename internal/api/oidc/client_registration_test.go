@@ -0,0 +1,234 @@
+package oidc
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zitadel/oidc/v3/pkg/oidc"
+	"github.com/zitadel/oidc/v3/pkg/op"
+
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/repository/clientregistration"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func Test_clientRegistrationRequestToMetadata(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     *clientRegistrationRequest
+		want    *command.OIDCClientMetadata
+		wantErr error
+	}{
+		{
+			name: "defaults",
+			req: &clientRegistrationRequest{
+				ClientName:   "client",
+				RedirectURIs: []string{"https://example.com/callback"},
+			},
+			want: &command.OIDCClientMetadata{
+				ClientName:      "client",
+				RedirectURIs:    []string{"https://example.com/callback"},
+				ResponseTypes:   []domain.OIDCResponseType{domain.OIDCResponseTypeCode},
+				GrantTypes:      []domain.OIDCGrantType{domain.OIDCGrantTypeAuthorizationCode},
+				ApplicationType: domain.OIDCApplicationTypeWeb,
+				AuthMethodType:  domain.OIDCAuthMethodTypeBasic,
+			},
+		},
+		{
+			name: "native public client",
+			req: &clientRegistrationRequest{
+				ClientName:              "client",
+				RedirectURIs:            []string{"http://127.0.0.1/callback"},
+				PostLogoutRedirectURIs:  []string{"http://127.0.0.1/logout"},
+				ResponseTypes:           []oidc.ResponseType{oidc.ResponseTypeCode, oidc.ResponseTypeIDTokenOnly},
+				GrantTypes:              []oidc.GrantType{oidc.GrantTypeCode, oidc.GrantTypeRefreshToken},
+				ApplicationType:         applicationTypeNative,
+				TokenEndpointAuthMethod: oidc.AuthMethodNone,
+			},
+			want: &command.OIDCClientMetadata{
+				ClientName:             "client",
+				RedirectURIs:           []string{"http://127.0.0.1/callback"},
+				PostLogoutRedirectURIs: []string{"http://127.0.0.1/logout"},
+				ResponseTypes:          []domain.OIDCResponseType{domain.OIDCResponseTypeCode, domain.OIDCResponseTypeIDToken},
+				GrantTypes:             []domain.OIDCGrantType{domain.OIDCGrantTypeAuthorizationCode, domain.OIDCGrantTypeRefreshToken},
+				ApplicationType:        domain.OIDCApplicationTypeNative,
+				AuthMethodType:         domain.OIDCAuthMethodTypeNone,
+			},
+		},
+		{
+			name:    "unsupported response type",
+			req:     &clientRegistrationRequest{ResponseTypes: []oidc.ResponseType{"token"}},
+			wantErr: &oidc.Error{ErrorType: errorTypeInvalidClientMetadata},
+		},
+		{
+			name:    "unsupported grant type",
+			req:     &clientRegistrationRequest{GrantTypes: []oidc.GrantType{oidc.GrantTypeClientCredentials}},
+			wantErr: &oidc.Error{ErrorType: errorTypeInvalidClientMetadata},
+		},
+		{
+			name:    "unsupported application type",
+			req:     &clientRegistrationRequest{ApplicationType: "user_agent"},
+			wantErr: &oidc.Error{ErrorType: errorTypeInvalidClientMetadata},
+		},
+		{
+			name:    "unsupported auth method",
+			req:     &clientRegistrationRequest{TokenEndpointAuthMethod: oidc.AuthMethodPrivateKeyJWT},
+			wantErr: &oidc.Error{ErrorType: errorTypeInvalidClientMetadata},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := clientRegistrationRequestToMetadata(tt.req)
+			require.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_verifySoftwareStatement(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	jwks := func(key *ecdsa.PrivateKey) []byte {
+		keySet, err := json.Marshal(jose.JSONWebKeySet{Keys: []jose.JSONWebKey{{Key: &key.PublicKey, KeyID: "key1", Algorithm: string(jose.ES256), Use: oidc.KeyUseSignature}}})
+		require.NoError(t, err)
+		return keySet
+	}
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.ES256, Key: &jose.JSONWebKey{Key: key, KeyID: "key1"}}, nil)
+	require.NoError(t, err)
+	sign := func(claims map[string]any) string {
+		payload, err := json.Marshal(claims)
+		require.NoError(t, err)
+		jws, err := signer.Sign(payload)
+		require.NoError(t, err)
+		token, err := jws.CompactSerialize()
+		require.NoError(t, err)
+		return token
+	}
+	validClaims := func() map[string]any {
+		return map[string]any{
+			"iss":           "https://software.example.com",
+			"client_name":   "statement client",
+			"redirect_uris": []string{"https://statement.example.com/callback"},
+			"exp":           time.Now().Add(time.Hour).Unix(),
+		}
+	}
+	config := func(jwks []byte) *query.ClientRegistrationConfig {
+		return &query.ClientRegistrationConfig{
+			ProjectID: "project1",
+			SoftwareStatementIssuers: []*clientregistration.SoftwareStatementIssuer{
+				{Issuer: "https://software.example.com", JWKS: jwks},
+			},
+		}
+	}
+	tests := []struct {
+		name      string
+		statement string
+		config    *query.ClientRegistrationConfig
+		want      *clientRegistrationRequest
+		wantErr   error
+	}{
+		{
+			name:      "malformed",
+			statement: "statement",
+			config:    config(jwks(key)),
+			wantErr:   &oidc.Error{ErrorType: errorTypeInvalidSoftwareStatement},
+		},
+		{
+			name: "untrusted issuer",
+			statement: sign(map[string]any{
+				"iss": "https://other.example.com",
+			}),
+			config:  config(jwks(key)),
+			wantErr: &oidc.Error{ErrorType: errorTypeUnapprovedSoftwareStatement},
+		},
+		{
+			name:      "wrong key",
+			statement: sign(validClaims()),
+			config:    config(jwks(otherKey)),
+			wantErr:   &oidc.Error{ErrorType: errorTypeInvalidSoftwareStatement},
+		},
+		{
+			name: "expired",
+			statement: sign(map[string]any{
+				"iss": "https://software.example.com",
+				"exp": time.Now().Add(-time.Minute).Unix(),
+			}),
+			config:  config(jwks(key)),
+			wantErr: &oidc.Error{ErrorType: errorTypeInvalidSoftwareStatement},
+		},
+		{
+			name:      "claims override metadata, ok",
+			statement: sign(validClaims()),
+			config:    config(jwks(key)),
+			want: &clientRegistrationRequest{
+				ClientName:              "statement client",
+				RedirectURIs:            []string{"https://statement.example.com/callback"},
+				TokenEndpointAuthMethod: oidc.AuthMethodNone,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := &clientRegistrationRequest{
+				ClientName:              "client",
+				RedirectURIs:            []string{"https://example.com/callback"},
+				TokenEndpointAuthMethod: oidc.AuthMethodNone,
+				SoftwareStatement:       tt.statement,
+			}
+			err := verifySoftwareStatement(context.Background(), req, tt.config)
+			require.ErrorIs(t, err, tt.wantErr)
+			if tt.wantErr != nil {
+				return
+			}
+			tt.want.SoftwareStatement = tt.statement
+			assert.Equal(t, tt.want, req)
+		})
+	}
+}
+
+func Test_clientRegistrationError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want error
+	}{
+		{
+			name: "invalid argument, invalid client metadata",
+			err:  zerrors.ThrowInvalidArgument(nil, "TEST-Ooy3e", "Errors.Project.App.Invalid"),
+			want: &oidc.Error{ErrorType: errorTypeInvalidClientMetadata, Description: "Errors.Project.App.Invalid"},
+		},
+		{
+			name: "unauthenticated, invalid token",
+			err:  zerrors.ThrowUnauthenticated(nil, "TEST-aiF4o", "Errors.ClientRegistration.InitialAccessTokenInvalid"),
+			want: op.NewStatusError(&oidc.Error{ErrorType: errorTypeInvalidToken}, http.StatusUnauthorized),
+		},
+		{
+			name: "not found",
+			err:  zerrors.ThrowNotFound(nil, "TEST-Eev9u", "Errors.ClientRegistration.NotConfigured"),
+			want: op.NewStatusError(oidc.ErrInvalidRequest(), http.StatusNotFound),
+		},
+		{
+			name: "oidc error, unchanged",
+			err:  &oidc.Error{ErrorType: errorTypeUnapprovedSoftwareStatement},
+			want: &oidc.Error{ErrorType: errorTypeUnapprovedSoftwareStatement},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.ErrorIs(t, clientRegistrationError(tt.err), tt.want)
+		})
+	}
+}
@@ -76,7 +76,7 @@ func (o *OPStorage) StoreDeviceAuthorization(ctx context.Context, clientID, devi
 		logger.OnError(err).Error(logMsg)
 		span.EndWithError(err)
 	}()
-	scope, audience, err := o.createAuthRequestScopeAndAudience(ctx, clientID, scope)
+	scope, audience, _, err := o.createAuthRequestScopeAndAudience(ctx, clientID, scope)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,85 @@
+package oidc
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/zitadel/oidc/v3/pkg/oidc"
+	"github.com/zitadel/oidc/v3/pkg/op"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/dpop"
+	http_utils "github.com/zitadel/zitadel/internal/api/http"
+)
+
+const errorTypeInvalidDPoPProof = "invalid_dpop_proof"
+
+func errInvalidDPoPProof(err error) *oidc.Error {
+	return (&oidc.Error{
+		ErrorType:   errorTypeInvalidDPoPProof,
+		Description: "DPoP proof is invalid",
+	}).WithParent(err)
+}
+
+// dpopJKTFromTokenRequest verifies the DPoP proof sent to the token endpoint
+// and returns the thumbprint of its key, the issued tokens will be bound to.
+// If required (the client only accepts DPoP bound access tokens), the proof is mandatory.
+// Otherwise, the tokens are only bound if the client sent a proof.
+func dpopJKTFromTokenRequest[T any](ctx context.Context, verifier *dpop.Verifier, r *op.Request[T], required bool) (string, error) {
+	proof := r.Header.Get(dpop.HeaderName)
+	if proof == "" {
+		if required {
+			return "", errInvalidDPoPProof(dpop.ErrInvalidProof).WithDescription("DPoP proof is required")
+		}
+		return "", nil
+	}
+	verified, err := verifier.Verify(ctx, proof, dpop.Request{
+		Method:     r.Method,
+		URL:        requestURL(ctx, r.URL.Path),
+		InstanceID: authz.GetInstance(ctx).InstanceID(),
+	})
+	if err != nil {
+		return "", errInvalidDPoPProof(err)
+	}
+	return verified.JKT, nil
+}
+
+// checkDPoPBinding ensures the request to a protected resource of the OP (e.g. userinfo)
+// contains a DPoP proof of the key the access token is bound to.
+func checkDPoPBinding(ctx context.Context, verifier *dpop.Verifier, header http.Header, method, path, accessToken, jkt string) error {
+	if jkt == "" {
+		return nil
+	}
+	err := verifier.CheckBinding(ctx, header.Get(dpop.HeaderName), jkt, dpop.Request{
+		Method:      method,
+		URL:         requestURL(ctx, path),
+		AccessToken: accessToken,
+		InstanceID:  authz.GetInstance(ctx).InstanceID(),
+	})
+	if err != nil {
+		return errInvalidDPoPProof(err)
+	}
+	return nil
+}
+
+func requestURL(ctx context.Context, path string) string {
+	return http_utils.DomainContext(ctx).Origin() + path
+}
+
+// dpopAuthorizationScheme allows DPoP bound access tokens to be sent with the DPoP authorization scheme
+// by rewriting the header to the Bearer scheme, which is the only one understood by the oidc library.
+// The binding of the token is verified by the handlers themselves.
+func dpopAuthorizationScheme(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth := r.Header.Get("Authorization")
+		scheme, token, ok := strings.Cut(auth, " ")
+		if !ok || !strings.EqualFold(scheme, dpop.TokenType) {
+			next.ServeHTTP(w, r)
+			return
+		}
+		r = r.Clone(r.Context())
+		r.Header.Set("Authorization", oidc.PrefixBearer+token)
+		next.ServeHTTP(w, r)
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"maps"
 	"slices"
 	"time"
 
@@ -11,6 +12,7 @@ import (
 	"github.com/zitadel/oidc/v3/pkg/op"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/dpop"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/query"
 	"github.com/zitadel/zitadel/internal/telemetry/tracing"
@@ -132,6 +134,22 @@ func (s *Server) Introspect(ctx context.Context, r *op.Request[op.IntrospectionR
 		Actor:                           actorDomainToClaims(token.actor),
 	}
 	introspectionResp.SetUserInfo(userInfo)
+	if token.dpopJKT != "" {
+		introspectionResp.TokenType = dpop.TokenType
+	}
+	cnf := confirmation(token.dpopJKT, token.certificateThumbprint)
+	if cnf != nil || len(token.authorizationDetails) > 0 {
+		introspectionResp.Claims = maps.Clone(introspectionResp.Claims)
+		if introspectionResp.Claims == nil {
+			introspectionResp.Claims = make(map[string]any, 2)
+		}
+	}
+	if cnf != nil {
+		introspectionResp.Claims["cnf"] = cnf
+	}
+	if len(token.authorizationDetails) > 0 {
+		introspectionResp.Claims[claimAuthorizationDetails] = token.authorizationDetails
+	}
 	return op.NewResponse(introspectionResp), nil
 }
 
@@ -0,0 +1,250 @@
+package oidc
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/zitadel/oidc/v3/pkg/crypto"
+	httphelper "github.com/zitadel/oidc/v3/pkg/http"
+	"github.com/zitadel/oidc/v3/pkg/oidc"
+	"github.com/zitadel/oidc/v3/pkg/op"
+
+	zcrypto "github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+)
+
+// The response modes of the JWT Secured Authorization Response Mode for OAuth 2.0 (JARM),
+// which are not defined by the oidc library.
+const (
+	responseModeQueryJWT    oidc.ResponseMode = "query.jwt"
+	responseModeFragmentJWT oidc.ResponseMode = "fragment.jwt"
+	responseModeFormPostJWT oidc.ResponseMode = "form_post.jwt"
+	responseModeJWT         oidc.ResponseMode = "jwt"
+
+	// jwtResponseLifetime is the lifetime of the authorization response JWT.
+	// The response is consumed by the client right away, so JARM recommends a short lifetime of at most 10 minutes.
+	jwtResponseLifetime = 10 * time.Minute
+
+	// jwtResponseKeyAlgorithm and jwtResponseContentEncryption are used to encrypt the response JWT to the client's key.
+	jwtResponseKeyAlgorithm      = jose.RSA_OAEP_256
+	jwtResponseContentEncryption = jose.A256GCM
+)
+
+// jwtResponse is the authorization response of the JWT response modes,
+// which carries all parameters of the plain response as claims of the JWT.
+type jwtResponse struct {
+	Response string `schema:"response"`
+}
+
+func isJWTResponseMode(responseMode oidc.ResponseMode) bool {
+	switch responseMode {
+	case responseModeQueryJWT, responseModeFragmentJWT, responseModeFormPostJWT, responseModeJWT:
+		return true
+	default:
+		return false
+	}
+}
+
+// jwtResponseDeliveryMode returns the response mode used to deliver the response JWT to the client.
+// The plain jwt response mode uses the default of the response type: query for the code flow and fragment for the implicit flow.
+func jwtResponseDeliveryMode(responseMode oidc.ResponseMode, responseType oidc.ResponseType) oidc.ResponseMode {
+	switch responseMode {
+	case responseModeQueryJWT:
+		return oidc.ResponseModeQuery
+	case responseModeFragmentJWT:
+		return oidc.ResponseModeFragment
+	case responseModeFormPostJWT:
+		return oidc.ResponseModeFormPost
+	}
+	if responseType == oidc.ResponseTypeCode {
+		return oidc.ResponseModeQuery
+	}
+	return oidc.ResponseModeFragment
+}
+
+// createJWTResponse puts the parameters of the authorization response (successful or error) as claims into a JWT,
+// signed by the current key of the instance.
+// If the client requires it, the JWT is additionally encrypted to the public key of the client.
+func (s *Server) createJWTResponse(ctx context.Context, clientID string, response any, encoder httphelper.Encoder) (_ *jwtResponse, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	params := make(map[string][]string)
+	if err = encoder.Encode(response, params); err != nil {
+		return nil, oidc.ErrServerError().WithParent(err)
+	}
+	claims := make(map[string]any, len(params)+3)
+	for key, values := range params {
+		if len(values) > 0 {
+			claims[key] = values[0]
+		}
+	}
+	claims["iss"] = op.IssuerFromContext(ctx)
+	claims["aud"] = clientID
+	claims["exp"] = time.Now().Add(jwtResponseLifetime).Unix()
+
+	signer, _, err := s.getSignerOnce()(ctx)
+	if err != nil {
+		return nil, oidc.ErrServerError().WithParent(err)
+	}
+	token, err := crypto.Sign(claims, signer)
+	if err != nil {
+		return nil, oidc.ErrServerError().WithParent(err)
+	}
+
+	client, err := s.query.ActiveOIDCClientByID(ctx, clientID, false)
+	if err != nil {
+		return nil, oidc.ErrServerError().WithParent(err)
+	}
+	if client.EncryptAuthorizationResponse {
+		keys, err := s.clientEncryptionKeys(ctx, client)
+		if err != nil {
+			return nil, oidc.ErrServerError().WithParent(err)
+		}
+		token, err = encryptJWTResponse(token, keys)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &jwtResponse{Response: token}, nil
+}
+
+// clientEncryptionKeys returns the keys of the client, which are not expired yet.
+func (s *Server) clientEncryptionKeys(ctx context.Context, client *query.OIDCClient) ([]*query.AuthNKeyData, error) {
+	appIDQuery, err := query.NewAuthNKeyObjectIDQuery(client.AppID)
+	if err != nil {
+		return nil, err
+	}
+	projectIDQuery, err := query.NewAuthNKeyAggregateIDQuery(client.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+	expirationQuery, err := query.NewTimestampQuery(query.AuthNKeyColumnExpiration, time.Now(), query.TimestampGreater)
+	if err != nil {
+		return nil, err
+	}
+	keys, err := s.query.SearchAuthNKeysData(ctx, &query.AuthNKeySearchQueries{
+		Queries: []query.SearchQuery{appIDQuery, projectIDQuery, expirationQuery},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return keys.AuthNKeysData, nil
+}
+
+// encryptJWTResponse encrypts the signed response JWT to the public key of the client (nested JWT).
+// Of multiple keys, the latest one is used, which is the one created last.
+func encryptJWTResponse(token string, keys []*query.AuthNKeyData) (string, error) {
+	if len(keys) == 0 {
+		return "", oidc.ErrServerError().WithDescription("no client key to encrypt the authorization response")
+	}
+	key := slices.MaxFunc(keys, func(a, b *query.AuthNKeyData) int {
+		return a.CreationDate.Compare(b.CreationDate)
+	})
+	publicKey, err := zcrypto.BytesToPublicKey(key.PublicKey)
+	if err != nil || publicKey == nil {
+		return "", oidc.ErrServerError().WithParent(err).WithDescription("invalid client key to encrypt the authorization response")
+	}
+	encrypter, err := jose.NewEncrypter(
+		jwtResponseContentEncryption,
+		jose.Recipient{
+			Algorithm: jwtResponseKeyAlgorithm,
+			Key:       publicKey,
+			KeyID:     key.ID,
+		},
+		(&jose.EncrypterOptions{}).WithContentType("JWT").WithType("JWT"),
+	)
+	if err != nil {
+		return "", oidc.ErrServerError().WithParent(err)
+	}
+	jwe, err := encrypter.Encrypt([]byte(token))
+	if err != nil {
+		return "", oidc.ErrServerError().WithParent(err)
+	}
+	return jwe.CompactSerialize()
+}
+
+// authResponseRequest is the part of the auth request, which defines how the authorization response is returned.
+// It is implemented by the stored auth requests as well as by the [oidc.AuthRequest] of the authorization endpoint.
+type authResponseRequest interface {
+	op.ErrAuthRequest
+	GetResponseMode() oidc.ResponseMode
+}
+
+// authResponseForMode returns the response and the response mode to deliver it with.
+// For the JWT response modes, the response is replaced by the response JWT.
+func (s *Server) authResponseForMode(ctx context.Context, authReq authResponseRequest, clientID string, response any, encoder httphelper.Encoder) (any, oidc.ResponseMode, error) {
+	responseMode := authReq.GetResponseMode()
+	if !isJWTResponseMode(responseMode) {
+		return response, responseMode, nil
+	}
+	jwt, err := s.createJWTResponse(ctx, clientID, response, encoder)
+	if err != nil {
+		return nil, "", err
+	}
+	return jwt, jwtResponseDeliveryMode(responseMode, authReq.GetResponseType()), nil
+}
+
+// authResponseURL encodes the authorization response into the redirect URI like [op.AuthResponseURL].
+// For the JWT response modes, the response is returned as JWT in the response parameter.
+func (s *Server) authResponseURL(ctx context.Context, authReq authResponseRequest, clientID string, response any, encoder httphelper.Encoder) (string, error) {
+	response, responseMode, err := s.authResponseForMode(ctx, authReq, clientID, response, encoder)
+	if err != nil {
+		return "", err
+	}
+	return op.AuthResponseURL(authReq.GetRedirectURI(), authReq.GetResponseType(), responseMode, response, encoder)
+}
+
+// writeAuthResponse returns the authorization response to the client,
+// either as redirect or as auto-submitted form for the form_post and form_post.jwt response modes.
+func (s *Server) writeAuthResponse(w http.ResponseWriter, r *http.Request, authReq op.AuthRequest, response any, encoder httphelper.Encoder) error {
+	response, responseMode, err := s.authResponseForMode(r.Context(), authReq, authReq.GetClientID(), response, encoder)
+	if err != nil {
+		return err
+	}
+	if responseMode == oidc.ResponseModeFormPost {
+		return op.AuthResponseFormPost(w, authReq.GetRedirectURI(), response, encoder)
+	}
+	callback, err := op.AuthResponseURL(authReq.GetRedirectURI(), authReq.GetResponseType(), responseMode, response, encoder)
+	if err != nil {
+		return err
+	}
+	http.Redirect(w, r, callback, http.StatusFound)
+	return nil
+}
+
+// authRequestError returns the error to the client like [op.AuthRequestError].
+// For the JWT response modes, the error is returned as JWT as well.
+func (s *Server) authRequestError(w http.ResponseWriter, r *http.Request, authReq op.AuthRequest, err error, authorizer op.Authorizer) {
+	e := oidc.DefaultToServerError(err, err.Error())
+	if !isJWTResponseMode(authReq.GetResponseMode()) || authReq.GetRedirectURI() == "" || e.IsRedirectDisabled() {
+		op.AuthRequestError(w, r, authReq, err, authorizer)
+		return
+	}
+	e.State = authReq.GetState()
+	authorizer.Logger().Log(r.Context(), e.LogLevel(), "auth request", "oidc_error", e)
+	if err = s.writeAuthResponse(w, r, authReq, e, authorizer.Encoder()); err != nil {
+		authorizer.Logger().ErrorContext(r.Context(), "auth response JWT", "error", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+	}
+}
+
+// tryErrorRedirect redirects the error to the client like [op.TryErrorRedirect].
+// For the JWT response modes, the error is returned as JWT as well.
+func (s *Server) tryErrorRedirect(ctx context.Context, authReq *oidc.AuthRequest, parent error) (*op.Redirect, error) {
+	e := oidc.DefaultToServerError(parent, parent.Error())
+	if !isJWTResponseMode(authReq.ResponseMode) || authReq.RedirectURI == "" || e.IsRedirectDisabled() {
+		return op.TryErrorRedirect(ctx, authReq, parent, s.Provider().Encoder(), s.getLogger(ctx))
+	}
+	e.State = authReq.State
+	s.getLogger(ctx).Log(ctx, e.LogLevel(), "auth request", "oidc_error", e)
+	callback, err := s.authResponseURL(ctx, authReq, authReq.ClientID, e, s.Provider().Encoder())
+	if err != nil {
+		return nil, op.AsStatusError(err, http.StatusBadRequest)
+	}
+	return op.NewRedirect(callback), nil
+}
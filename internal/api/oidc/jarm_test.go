@@ -0,0 +1,129 @@
+package oidc
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zitadel/oidc/v3/pkg/oidc"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/query"
+)
+
+func Test_jwtResponseDeliveryMode(t *testing.T) {
+	tests := []struct {
+		name         string
+		responseMode oidc.ResponseMode
+		responseType oidc.ResponseType
+		want         oidc.ResponseMode
+	}{
+		{
+			name:         "query.jwt",
+			responseMode: responseModeQueryJWT,
+			responseType: oidc.ResponseTypeIDToken,
+			want:         oidc.ResponseModeQuery,
+		},
+		{
+			name:         "fragment.jwt",
+			responseMode: responseModeFragmentJWT,
+			responseType: oidc.ResponseTypeCode,
+			want:         oidc.ResponseModeFragment,
+		},
+		{
+			name:         "form_post.jwt",
+			responseMode: responseModeFormPostJWT,
+			responseType: oidc.ResponseTypeCode,
+			want:         oidc.ResponseModeFormPost,
+		},
+		{
+			name:         "jwt, code",
+			responseMode: responseModeJWT,
+			responseType: oidc.ResponseTypeCode,
+			want:         oidc.ResponseModeQuery,
+		},
+		{
+			name:         "jwt, implicit",
+			responseMode: responseModeJWT,
+			responseType: oidc.ResponseTypeIDToken,
+			want:         oidc.ResponseModeFragment,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.True(t, isJWTResponseMode(tt.responseMode))
+			assert.Equal(t, tt.want, jwtResponseDeliveryMode(tt.responseMode, tt.responseType))
+		})
+	}
+}
+
+func Test_encryptJWTResponse(t *testing.T) {
+	oldKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	oldPublicKey, err := crypto.PublicKeyToBytes(&oldKey.PublicKey)
+	require.NoError(t, err)
+	newKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	newPublicKey, err := crypto.PublicKeyToBytes(&newKey.PublicKey)
+	require.NoError(t, err)
+
+	now := time.Now()
+	tests := []struct {
+		name      string
+		keys      []*query.AuthNKeyData
+		wantKey   *rsa.PrivateKey
+		wantKeyID string
+		wantErr   bool
+	}{
+		{
+			name:    "no keys",
+			wantErr: true,
+		},
+		{
+			name: "invalid key",
+			keys: []*query.AuthNKeyData{
+				{ID: "key1", CreationDate: now, PublicKey: []byte("invalid")},
+			},
+			wantErr: true,
+		},
+		{
+			name: "latest key",
+			keys: []*query.AuthNKeyData{
+				{ID: "key1", CreationDate: now.Add(-time.Hour), PublicKey: oldPublicKey},
+				{ID: "key2", CreationDate: now, PublicKey: newPublicKey},
+			},
+			wantKey:   newKey,
+			wantKeyID: "key2",
+		},
+		{
+			name: "latest key by creation date, not by id",
+			keys: []*query.AuthNKeyData{
+				{ID: "9", CreationDate: now.Add(-time.Hour), PublicKey: oldPublicKey},
+				{ID: "10", CreationDate: now, PublicKey: newPublicKey},
+			},
+			wantKey:   newKey,
+			wantKeyID: "10",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := encryptJWTResponse("signed.response.jwt", tt.keys)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			jwe, err := jose.ParseEncrypted(got, []jose.KeyAlgorithm{jwtResponseKeyAlgorithm}, []jose.ContentEncryption{jwtResponseContentEncryption})
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantKeyID, jwe.Header.KeyID)
+			assert.Equal(t, "JWT", jwe.Header.ExtraHeaders[jose.HeaderContentType])
+			plaintext, err := jwe.Decrypt(tt.wantKey)
+			require.NoError(t, err)
+			assert.Equal(t, "signed.response.jwt", string(plaintext))
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package oidc
+
+import (
+	"context"
+	"maps"
+
+	"github.com/zitadel/oidc/v3/pkg/oidc"
+
+	"github.com/zitadel/zitadel/internal/api/dpop"
+	"github.com/zitadel/zitadel/internal/api/mtls"
+)
+
+// certificateThumbprintFromTokenRequest returns the thumbprint of the client certificate presented
+// on the mutual-TLS connection to the token endpoint, the issued tokens will be bound to (RFC 8705).
+// Tokens are only bound for clients requesting certificate-bound access tokens,
+// for which a certificate is mandatory.
+func certificateThumbprintFromTokenRequest(ctx context.Context, client *Client) (string, error) {
+	if !client.client.TLSClientCertificateBoundAccessTokens {
+		return "", nil
+	}
+	cert := mtls.Certificate(ctx)
+	if cert == nil {
+		return "", oidc.ErrInvalidRequest().WithParent(mtls.ErrNoCertificate).WithDescription("client certificate is required")
+	}
+	return mtls.Thumbprint(cert), nil
+}
+
+// confirmation returns the cnf claim of a token bound to a DPoP key (jkt) and / or a client certificate (x5t#S256).
+// It returns nil if the token is not bound.
+func confirmation(dpopJKT, certificateThumbprint string) map[string]any {
+	if dpopJKT == "" && certificateThumbprint == "" {
+		return nil
+	}
+	cnf := make(map[string]any, 2)
+	if dpopJKT != "" {
+		maps.Copy(cnf, dpop.Confirmation(dpopJKT))
+	}
+	if certificateThumbprint != "" {
+		maps.Copy(cnf, mtls.Confirmation(certificateThumbprint))
+	}
+	return cnf
+}
+
+// checkCertificateBinding ensures the request to a protected resource of the OP (e.g. userinfo)
+// was sent over a mutual-TLS connection with the certificate the access token is bound to.
+func checkCertificateBinding(ctx context.Context, x5t string) error {
+	if x5t == "" {
+		return nil
+	}
+	if err := mtls.CheckBinding(ctx, x5t); err != nil {
+		return oidc.ErrAccessDenied().WithParent(err).WithDescription("access token is bound to a different client certificate")
+	}
+	return nil
+}
@@ -7,12 +7,15 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/hashicorp/golang-lru/v2/expirable"
 	"github.com/zitadel/oidc/v3/pkg/oidc"
 	"github.com/zitadel/oidc/v3/pkg/op"
 
 	"github.com/zitadel/zitadel/internal/api/assets"
+	"github.com/zitadel/zitadel/internal/api/dpop"
 	http_utils "github.com/zitadel/zitadel/internal/api/http"
 	"github.com/zitadel/zitadel/internal/api/http/middleware"
+	"github.com/zitadel/zitadel/internal/api/mtls"
 	"github.com/zitadel/zitadel/internal/api/ui/login"
 	"github.com/zitadel/zitadel/internal/auth/repository"
 	"github.com/zitadel/zitadel/internal/cache"
@@ -44,17 +47,23 @@ type Config struct {
 	DefaultLogoutURLV2                string
 	PublicKeyCacheMaxAge              time.Duration
 	DefaultBackChannelLogoutLifetime  time.Duration
+	PushedAuthRequestLifetime         time.Duration
+	TLSClientAuthRootCAsPath          string
+	BackChannelAuth                   *BackChannelAuthConfig
 }
 
 type EndpointConfig struct {
-	Auth          *Endpoint
-	Token         *Endpoint
-	Introspection *Endpoint
-	Userinfo      *Endpoint
-	Revocation    *Endpoint
-	EndSession    *Endpoint
-	Keys          *Endpoint
-	DeviceAuth    *Endpoint
+	Auth               *Endpoint
+	Token              *Endpoint
+	Introspection      *Endpoint
+	Userinfo           *Endpoint
+	Revocation         *Endpoint
+	EndSession         *Endpoint
+	Keys               *Endpoint
+	DeviceAuth         *Endpoint
+	PushedAuth         *Endpoint
+	BackChannelAuth    *Endpoint
+	ClientRegistration *Endpoint
 }
 
 type Endpoint struct {
@@ -119,6 +128,7 @@ func NewServer(
 	fallbackLogger *slog.Logger,
 	hashConfig crypto.HashConfig,
 	federatedLogoutCache cache.Cache[federatedlogout.Index, string, *federatedlogout.FederatedLogout],
+	dpopVerifier *dpop.Verifier,
 ) (*Server, error) {
 	opConfig, err := createOPConfig(config, defaultLogoutRedirectURI, cryptoKey)
 	if err != nil {
@@ -146,6 +156,10 @@ func NewServer(
 	if err != nil {
 		return nil, zerrors.ThrowInternal(err, "OIDC-Aij4e", "cannot create secret hasher")
 	}
+	tlsClientAuthRoots, err := mtls.LoadRootCAs(config.TLSClientAuthRootCAsPath)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "OIDC-Ui7ie", "cannot load tls client auth root certificates")
+	}
 	server := &Server{
 		LegacyServer: op.NewLegacyServer(&Provider{
 			Provider:          provider,
@@ -168,6 +182,14 @@ func NewServer(
 		encAlg:                     encryptionAlg,
 		opCrypto:                   op.NewAESCrypto(opConfig.CryptoKey),
 		assetAPIPrefix:             assets.AssetAPI(),
+		pushedAuthRequestEndpoint:  pushedAuthRequestEndpoint(config.CustomEndpoints),
+		pushedAuthRequestLifetime:  config.PushedAuthRequestLifetime,
+		backChannelAuthEndpoint:    backChannelAuthEndpoint(config.CustomEndpoints),
+		backChannelAuthConfig:      config.BackChannelAuth,
+		workloadIdentityKeySets:    expirable.NewLRU[string, oidc.KeySet](workloadIdentityKeySetsMaxEntries, nil, workloadIdentityKeySetsTTL),
+		clientRegistrationEndpoint: clientRegistrationEndpoint(config.CustomEndpoints),
+		tlsClientAuthRoots:         tlsClientAuthRoots,
+		dpopVerifier:               dpopVerifier,
 	}
 	metricTypes := []metrics.MetricType{metrics.MetricTypeRequestCount, metrics.MetricTypeStatusCode, metrics.MetricTypeTotalCount}
 	server.Handler = op.RegisterLegacyServer(server,
@@ -182,6 +204,10 @@ func NewServer(
 			http_utils.CopyHeadersToContext,
 			accessHandler.HandleWithPublicAuthPathPrefixes(publicAuthPathPrefixes(config.CustomEndpoints)),
 			middleware.ActivityHandler,
+			dpopAuthorizationScheme,
+			server.pushedAuthRequestHandler,
+			server.backChannelAuthHandler,
+			server.clientRegistrationHandler,
 		))
 
 	return server, nil
@@ -0,0 +1,226 @@
+package oidc
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	httphelper "github.com/zitadel/oidc/v3/pkg/http"
+	"github.com/zitadel/oidc/v3/pkg/oidc"
+	"github.com/zitadel/oidc/v3/pkg/op"
+	"github.com/zitadel/schema"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	requestURIPrefix           = "urn:ietf:params:oauth:request_uri:"
+	formRequestURI             = "request_uri"
+	errorTypeInvalidRequestURI = "invalid_request_uri"
+)
+
+var pushedAuthRequestDecoder = newPushedAuthRequestDecoder()
+
+func newPushedAuthRequestDecoder() *schema.Decoder {
+	decoder := schema.NewDecoder()
+	decoder.IgnoreUnknownKeys(true)
+	return decoder
+}
+
+// clientAuthenticationParams are removed from the pushed parameters,
+// so no client credentials end up in the eventstore.
+var clientAuthenticationParams = []string{
+	"client_secret",
+	"client_assertion",
+	"client_assertion_type",
+}
+
+// discoveryConfiguration extends the discovery document of the oidc library
+// with the metadata of the pushed authorization request, backchannel authentication and mutual-TLS extensions.
+type discoveryConfiguration struct {
+	*oidc.DiscoveryConfiguration
+	PushedAuthorizationRequestEndpoint        string   `json:"pushed_authorization_request_endpoint,omitempty"`
+	BackChannelAuthenticationEndpoint         string   `json:"backchannel_authentication_endpoint,omitempty"`
+	BackChannelTokenDeliveryModesSupported    []string `json:"backchannel_token_delivery_modes_supported,omitempty"`
+	TLSClientCertificateBoundAccessTokens     bool     `json:"tls_client_certificate_bound_access_tokens,omitempty"`
+	AuthorizationSigningAlgValuesSupported    []string `json:"authorization_signing_alg_values_supported,omitempty"`
+	AuthorizationEncryptionAlgValuesSupported []string `json:"authorization_encryption_alg_values_supported,omitempty"`
+	AuthorizationEncryptionEncValuesSupported []string `json:"authorization_encryption_enc_values_supported,omitempty"`
+}
+
+type pushedAuthRequestResponse struct {
+	RequestURI string `json:"request_uri"`
+	ExpiresIn  uint64 `json:"expires_in"`
+}
+
+// pushedAuthRequestHandler serves the pushed authorization request endpoint (RFC 9126).
+// The oidc library does neither support PAR nor allow adding routes after its middleware is registered,
+// therefore the endpoint is served by a middleware in front of the library's router.
+func (s *Server) pushedAuthRequestHandler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if s.pushedAuthRequestEndpoint == nil || r.URL.Path != s.pushedAuthRequestEndpoint.Relative() {
+			next.ServeHTTP(w, r)
+			return
+		}
+		ctx := op.ContextWithIssuer(r.Context(), s.IssuerFromRequest(r))
+		r = r.WithContext(ctx)
+		if r.Method != http.MethodPost {
+			op.WriteError(w, r, oidc.ErrInvalidRequest().WithDescription("pushed authorization requests must be sent with POST"), s.getLogger(ctx))
+			return
+		}
+		resp, err := s.pushAuthRequest(ctx, r)
+		if err != nil {
+			op.WriteError(w, r, err, s.getLogger(ctx))
+			return
+		}
+		httphelper.MarshalJSONWithStatus(w, resp, http.StatusCreated)
+	})
+}
+
+// pushAuthRequest authenticates the client, validates the pushed authorization request
+// and stores its parameters, so they can be referenced by the returned request_uri.
+func (s *Server) pushAuthRequest(ctx context.Context, r *http.Request) (_ *pushedAuthRequestResponse, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() {
+		err = oidcError(err)
+		span.EndWithError(err)
+	}()
+
+	if err = r.ParseForm(); err != nil {
+		return nil, oidc.ErrInvalidRequest().WithDescription("error parsing form").WithParent(err)
+	}
+	credentials, err := clientCredentialsFromRequest(r)
+	if err != nil {
+		return nil, err
+	}
+	client, err := s.VerifyClient(ctx, &op.Request[op.ClientCredentials]{
+		Method: r.Method,
+		URL:    r.URL,
+		Header: r.Header,
+		Form:   r.PostForm,
+		Data:   credentials,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if r.PostForm.Has(formRequestURI) {
+		return nil, oidc.ErrInvalidRequest().WithDescription("request_uri must not be pushed")
+	}
+	if clientID := r.PostForm.Get("client_id"); clientID != "" && clientID != client.GetID() {
+		return nil, oidc.ErrInvalidRequest().WithDescription("client_id does not match the authenticated client")
+	}
+
+	params := make(url.Values, len(r.PostForm))
+	for key, values := range r.PostForm {
+		params[key] = values
+	}
+	for _, key := range clientAuthenticationParams {
+		params.Del(key)
+	}
+	params.Set("client_id", client.GetID())
+
+	authReq, err := decodePushedAuthRequest(params)
+	if err != nil {
+		return nil, err
+	}
+	if authReq.RequestParam != "" {
+		if !s.Provider().RequestObjectSupported() {
+			return nil, oidc.ErrRequestNotSupported()
+		}
+		if err = op.ParseRequestObject(ctx, authReq, s.Provider().Storage(), op.IssuerFromContext(ctx)); err != nil {
+			return nil, err
+		}
+	}
+	if _, err = op.ValidateAuthRequestClient(ctx, authReq, client, s.Provider().IDTokenHintVerifier(ctx)); err != nil {
+		return nil, err
+	}
+
+	id, err := s.command.AddPushedAuthRequest(ctx, client.GetID(), params, time.Now().Add(s.pushedAuthRequestLifetime))
+	if err != nil {
+		return nil, err
+	}
+	return &pushedAuthRequestResponse{
+		RequestURI: requestURIPrefix + id,
+		ExpiresIn:  uint64(s.pushedAuthRequestLifetime / time.Second),
+	}, nil
+}
+
+// resolvePushedAuthRequest replaces the parameters of the authorization request
+// with the ones pushed by the client and referenced by the request_uri.
+// The request_uri can only be used once.
+func (s *Server) resolvePushedAuthRequest(ctx context.Context, r *op.Request[oidc.AuthRequest], requestURI string) error {
+	id, ok := strings.CutPrefix(requestURI, requestURIPrefix)
+	if !ok || id == "" {
+		return errInvalidRequestURI(nil)
+	}
+	if r.Data.ClientID == "" {
+		return oidc.ErrInvalidRequest().WithParent(op.ErrAuthReqMissingClientID).WithDescription("%s", op.ErrAuthReqMissingClientID.Error())
+	}
+	params, err := s.command.UsePushedAuthRequest(ctx, id, r.Data.ClientID)
+	if err != nil {
+		if zerrors.IsPreconditionFailed(err) {
+			return errInvalidRequestURI(err)
+		}
+		return err
+	}
+	authReq, err := decodePushedAuthRequest(params)
+	if err != nil {
+		return err
+	}
+	r.Data = authReq
+	r.Form = params
+	return nil
+}
+
+func decodePushedAuthRequest(params url.Values) (*oidc.AuthRequest, error) {
+	authReq := new(oidc.AuthRequest)
+	if err := pushedAuthRequestDecoder.Decode(authReq, params); err != nil {
+		return nil, oidc.ErrInvalidRequest().WithDescription("error decoding authorization request").WithParent(err)
+	}
+	return authReq, nil
+}
+
+// clientCredentialsFromRequest reads the client credentials from the form or the basic auth header,
+// which takes precedence, the same way the oidc library does for its client authenticated endpoints.
+func clientCredentialsFromRequest(r *http.Request) (*op.ClientCredentials, error) {
+	credentials := &op.ClientCredentials{
+		ClientID:            r.PostForm.Get("client_id"),
+		ClientSecret:        r.PostForm.Get("client_secret"),
+		ClientAssertion:     r.PostForm.Get("client_assertion"),
+		ClientAssertionType: r.PostForm.Get("client_assertion_type"),
+	}
+	if clientID, clientSecret, ok := r.BasicAuth(); ok {
+		var err error
+		if credentials.ClientID, err = url.QueryUnescape(clientID); err != nil {
+			return nil, oidc.ErrInvalidClient().WithDescription("invalid basic auth header").WithParent(err)
+		}
+		if credentials.ClientSecret, err = url.QueryUnescape(clientSecret); err != nil {
+			return nil, oidc.ErrInvalidClient().WithDescription("invalid basic auth header").WithParent(err)
+		}
+	}
+	if credentials.ClientID == "" && credentials.ClientAssertion == "" {
+		return nil, oidc.ErrInvalidRequest().WithDescription("client_id or client_assertion must be provided")
+	}
+	if credentials.ClientAssertion != "" && credentials.ClientAssertionType != oidc.ClientAssertionTypeJWTAssertion {
+		return nil, oidc.ErrInvalidRequest().WithDescription("invalid client_assertion_type %s", credentials.ClientAssertionType)
+	}
+	return credentials, nil
+}
+
+// pushedAuthRequestRequired returns true for confidential clients,
+// which must push their authorization requests.
+func pushedAuthRequestRequired(client op.Client) bool {
+	c, ok := client.(*Client)
+	return ok && c.client.RequirePushedAuthRequests && c.client.AuthMethodType != domain.OIDCAuthMethodTypeNone
+}
+
+func errInvalidRequestURI(err error) *oidc.Error {
+	return (&oidc.Error{
+		ErrorType:   errorTypeInvalidRequestURI,
+		Description: "request_uri is invalid or expired",
+	}).WithParent(err)
+}
@@ -0,0 +1,143 @@
+package oidc
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zitadel/oidc/v3/pkg/oidc"
+	"github.com/zitadel/oidc/v3/pkg/op"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+)
+
+func Test_clientCredentialsFromRequest(t *testing.T) {
+	tests := []struct {
+		name      string
+		form      url.Values
+		basicAuth []string
+		want      *op.ClientCredentials
+		wantErr   error
+	}{
+		{
+			name:    "missing client",
+			form:    url.Values{"scope": {"openid"}},
+			wantErr: oidc.ErrInvalidRequest().WithDescription("client_id or client_assertion must be provided"),
+		},
+		{
+			name: "invalid assertion type",
+			form: url.Values{
+				"client_assertion":      {"assertion"},
+				"client_assertion_type": {"type"},
+			},
+			wantErr: oidc.ErrInvalidRequest().WithDescription("invalid client_assertion_type %s", "type"),
+		},
+		{
+			name: "form",
+			form: url.Values{
+				"client_id":     {"clientID"},
+				"client_secret": {"secret"},
+			},
+			want: &op.ClientCredentials{
+				ClientID:     "clientID",
+				ClientSecret: "secret",
+			},
+		},
+		{
+			name: "basic auth precedence",
+			form: url.Values{
+				"client_id": {"clientID"},
+			},
+			basicAuth: []string{"basic%3AclientID", "basic%20secret"},
+			want: &op.ClientCredentials{
+				ClientID:     "basic:clientID",
+				ClientSecret: "basic secret",
+			},
+		},
+		{
+			name: "assertion",
+			form: url.Values{
+				"client_assertion":      {"assertion"},
+				"client_assertion_type": {oidc.ClientAssertionTypeJWTAssertion},
+			},
+			want: &op.ClientCredentials{
+				ClientAssertion:     "assertion",
+				ClientAssertionType: oidc.ClientAssertionTypeJWTAssertion,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPost, "/oauth/v2/par", strings.NewReader(tt.form.Encode()))
+			r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			if tt.basicAuth != nil {
+				r.SetBasicAuth(tt.basicAuth[0], tt.basicAuth[1])
+			}
+			require.NoError(t, r.ParseForm())
+			got, err := clientCredentialsFromRequest(r)
+			require.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_decodePushedAuthRequest(t *testing.T) {
+	got, err := decodePushedAuthRequest(url.Values{
+		"client_id":     {"clientID"},
+		"redirect_uri":  {"https://example.com/callback"},
+		"response_type": {"code"},
+		"scope":         {"openid profile"},
+		"state":         {"state"},
+		"unknown":       {"ignored"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, &oidc.AuthRequest{
+		ClientID:     "clientID",
+		RedirectURI:  "https://example.com/callback",
+		ResponseType: oidc.ResponseTypeCode,
+		Scopes:       oidc.SpaceDelimitedArray{"openid", "profile"},
+		State:        "state",
+	}, got)
+}
+
+func Test_pushedAuthRequestRequired(t *testing.T) {
+	tests := []struct {
+		name   string
+		client op.Client
+		want   bool
+	}{
+		{
+			name: "not required",
+			client: &Client{client: &query.OIDCClient{
+				AuthMethodType: domain.OIDCAuthMethodTypeBasic,
+			}},
+			want: false,
+		},
+		{
+			name: "public client",
+			client: &Client{client: &query.OIDCClient{
+				AuthMethodType:            domain.OIDCAuthMethodTypeNone,
+				RequirePushedAuthRequests: true,
+			}},
+			want: false,
+		},
+		{
+			name: "confidential client",
+			client: &Client{client: &query.OIDCClient{
+				AuthMethodType:            domain.OIDCAuthMethodTypePrivateKeyJWT,
+				RequirePushedAuthRequests: true,
+			}},
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, pushedAuthRequestRequired(tt.client))
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package oidc
+
+import (
+	"context"
+	"slices"
+
+	"github.com/zitadel/oidc/v3/pkg/oidc"
+
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// formResource is the parameter of authorization and token requests,
+// which indicates the resources the access token is requested for (RFC 8707).
+const formResource = "resource"
+
+type resourcesKey struct{}
+
+// contextWithResources passes the resource indicators of the authorization request to the storage,
+// since the auth request of the oidc library does not contain them.
+func contextWithResources(ctx context.Context, resources []string) context.Context {
+	if len(resources) == 0 {
+		return ctx
+	}
+	return context.WithValue(ctx, resourcesKey{}, resources)
+}
+
+func resourcesFromContext(ctx context.Context) []string {
+	resources, _ := ctx.Value(resourcesKey{}).([]string)
+	return resources
+}
+
+// resourceAudience validates the requested resources and returns them as audience of the access token.
+// Resource servers are identified by the client_id of their API application,
+// so every resource must be the client_id of an active API application of the instance.
+func resourceAudience(ctx context.Context, q *query.Queries, resources []string) ([]string, error) {
+	if len(resources) == 0 {
+		return nil, nil
+	}
+	audience := make([]string, 0, len(resources))
+	for _, resource := range resources {
+		if slices.Contains(audience, resource) {
+			continue
+		}
+		app, err := q.AppByClientID(ctx, resource)
+		if err != nil {
+			if zerrors.IsNotFound(err) {
+				return nil, errInvalidResource(resource, err)
+			}
+			return nil, err
+		}
+		if app.APIConfig == nil {
+			return nil, errInvalidResource(resource, nil)
+		}
+		audience = append(audience, resource)
+	}
+	return audience, nil
+}
+
+// validateResourceAudience ensures that the resources requested at the token endpoint
+// are part of the granted audience and returns them as audience of the access token.
+// If no resources are requested, the granted audience is returned.
+func validateResourceAudience(grantedAudience, requestedAudience []string) ([]string, error) {
+	if len(requestedAudience) == 0 {
+		return grantedAudience, nil
+	}
+	for _, aud := range requestedAudience {
+		if !slices.Contains(grantedAudience, aud) {
+			return nil, errInvalidResource(aud, nil)
+		}
+	}
+	return requestedAudience, nil
+}
+
+func errInvalidResource(resource string, parent error) *oidc.Error {
+	return oidc.ErrInvalidTarget().WithParent(parent).WithDescription("resource %s is invalid or was not granted", resource)
+}
@@ -0,0 +1,53 @@
+package oidc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zitadel/oidc/v3/pkg/oidc"
+)
+
+func Test_validateResourceAudience(t *testing.T) {
+	tests := []struct {
+		name      string
+		granted   []string
+		requested []string
+		want      []string
+		wantErr   error
+	}{
+		{
+			name:    "no resource requested",
+			granted: []string{"projectID", "clientID"},
+			want:    []string{"projectID", "clientID"},
+		},
+		{
+			name:      "resource not granted",
+			granted:   []string{"projectID", "clientID"},
+			requested: []string{"apiClientID"},
+			wantErr:   oidc.ErrInvalidTarget().WithDescription("resource %s is invalid or was not granted", "apiClientID"),
+		},
+		{
+			name:      "downscoped",
+			granted:   []string{"apiClientID1", "apiClientID2"},
+			requested: []string{"apiClientID2"},
+			want:      []string{"apiClientID2"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := validateResourceAudience(tt.granted, tt.requested)
+			require.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_resourcesFromContext(t *testing.T) {
+	ctx := contextWithResources(context.Background(), nil)
+	assert.Nil(t, resourcesFromContext(ctx))
+
+	ctx = contextWithResources(context.Background(), []string{"apiClientID1", "apiClientID2"})
+	assert.Equal(t, []string{"apiClientID1", "apiClientID2"}, resourcesFromContext(ctx))
+}
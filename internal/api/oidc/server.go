@@ -2,21 +2,25 @@ package oidc
 
 import (
 	"context"
+	"crypto/x509"
 	"log/slog"
 	"net/http"
 	"time"
 
+	"github.com/hashicorp/golang-lru/v2/expirable"
 	"github.com/zitadel/logging"
 	"github.com/zitadel/oidc/v3/pkg/oidc"
 	"github.com/zitadel/oidc/v3/pkg/op"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/dpop"
 	"github.com/zitadel/zitadel/internal/auth/repository"
 	"github.com/zitadel/zitadel/internal/command"
 	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/i18n"
 	"github.com/zitadel/zitadel/internal/query"
 	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
 type Server struct {
@@ -43,6 +47,22 @@ type Server struct {
 	opCrypto            op.Crypto
 
 	assetAPIPrefix func(ctx context.Context) string
+
+	pushedAuthRequestEndpoint *op.Endpoint
+	pushedAuthRequestLifetime time.Duration
+
+	backChannelAuthEndpoint *op.Endpoint
+	backChannelAuthConfig   *BackChannelAuthConfig
+
+	clientRegistrationEndpoint *op.Endpoint
+
+	// tlsClientAuthRoots verify the client certificates of the tls_client_auth method, system roots are used if nil.
+	tlsClientAuthRoots *x509.CertPool
+
+	// workloadIdentityKeySets caches the remote key sets of the workload identity trusts by JWKS endpoint.
+	workloadIdentityKeySets *expirable.LRU[string, oidc.KeySet]
+
+	dpopVerifier *dpop.Verifier
 }
 
 func endpoints(endpointConfig *EndpointConfig) op.Endpoints {
@@ -88,6 +108,27 @@ func endpoints(endpointConfig *EndpointConfig) op.Endpoints {
 	return endpoints
 }
 
+func pushedAuthRequestEndpoint(endpointConfig *EndpointConfig) *op.Endpoint {
+	if endpointConfig == nil || endpointConfig.PushedAuth == nil {
+		return op.NewEndpoint("/oauth/v2/par")
+	}
+	return op.NewEndpointWithURL(endpointConfig.PushedAuth.Path, endpointConfig.PushedAuth.URL)
+}
+
+func backChannelAuthEndpoint(endpointConfig *EndpointConfig) *op.Endpoint {
+	if endpointConfig == nil || endpointConfig.BackChannelAuth == nil {
+		return op.NewEndpoint("/oauth/v2/bc-authorize")
+	}
+	return op.NewEndpointWithURL(endpointConfig.BackChannelAuth.Path, endpointConfig.BackChannelAuth.URL)
+}
+
+func clientRegistrationEndpoint(endpointConfig *EndpointConfig) *op.Endpoint {
+	if endpointConfig == nil || endpointConfig.ClientRegistration == nil {
+		return op.NewEndpoint("/oauth/v2/register")
+	}
+	return op.NewEndpointWithURL(endpointConfig.ClientRegistration.Path, endpointConfig.ClientRegistration.URL)
+}
+
 func (s *Server) getLogger(ctx context.Context) *slog.Logger {
 	if logger, ok := logging.FromContext(ctx); ok {
 		return logger
@@ -127,21 +168,66 @@ func (s *Server) Discovery(ctx context.Context, r *op.Request[struct{}]) (_ *op.
 	if len(allowedLanguages) == 0 {
 		allowedLanguages = i18n.SupportedLanguages()
 	}
-	return op.NewResponse(s.createDiscoveryConfig(ctx, allowedLanguages)), nil
+	issuer := op.IssuerFromContext(ctx)
+	discoveryConfig := s.createDiscoveryConfig(ctx, allowedLanguages)
+	// the registration endpoint is only advertised if the dynamic client registration is enabled for the instance
+	_, err = s.query.ClientRegistrationConfig(ctx)
+	if err == nil {
+		discoveryConfig.RegistrationEndpoint = s.clientRegistrationEndpoint.Absolute(issuer)
+	} else if !zerrors.IsNotFound(err) {
+		return nil, op.NewStatusError(oidc.ErrServerError().WithParent(err).WithReturnParentToClient(authz.GetFeatures(ctx).DebugOIDCParentError).WithDescription("internal server error"), http.StatusInternalServerError)
+	}
+	return op.NewResponse(&discoveryConfiguration{
+		DiscoveryConfiguration:                    discoveryConfig,
+		PushedAuthorizationRequestEndpoint:        s.pushedAuthRequestEndpoint.Absolute(issuer),
+		BackChannelAuthenticationEndpoint:         s.backChannelAuthEndpoint.Absolute(issuer),
+		BackChannelTokenDeliveryModesSupported:    []string{backChannelTokenDeliveryModePoll, backChannelTokenDeliveryModePing},
+		TLSClientCertificateBoundAccessTokens:     true,
+		AuthorizationSigningAlgValuesSupported:    discoveryConfig.IDTokenSigningAlgValuesSupported,
+		AuthorizationEncryptionAlgValuesSupported: []string{string(jwtResponseKeyAlgorithm)},
+		AuthorizationEncryptionEncValuesSupported: []string{string(jwtResponseContentEncryption)},
+	}), nil
 }
 
 func (s *Server) VerifyAuthRequest(ctx context.Context, r *op.Request[oidc.AuthRequest]) (_ *op.ClientRequest[oidc.AuthRequest], err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
-	return s.LegacyServer.VerifyAuthRequest(ctx, r)
+	requestURI := r.Form.Get(formRequestURI)
+	if requestURI != "" {
+		if err = s.resolvePushedAuthRequest(ctx, r, requestURI); err != nil {
+			return nil, err
+		}
+	}
+	clientRequest, err := s.LegacyServer.VerifyAuthRequest(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	if requestURI == "" && pushedAuthRequestRequired(clientRequest.Client) {
+		return nil, oidc.ErrInvalidRequest().WithDescription("pushed authorization request required")
+	}
+	return clientRequest, nil
 }
 
 func (s *Server) Authorize(ctx context.Context, r *op.ClientRequest[oidc.AuthRequest]) (_ *op.Redirect, err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
-	return s.LegacyServer.Authorize(ctx, r)
+	ctx = contextWithResources(ctx, r.Form[formResource])
+	ctx = contextWithAuthorizationDetails(ctx, r.Form.Get(formAuthorizationDetails))
+	if !isJWTResponseMode(r.Data.ResponseMode) {
+		return s.LegacyServer.Authorize(ctx, r)
+	}
+	// same as the legacy server, but errors are redirected as JWT
+	userID, err := op.ValidateAuthReqIDTokenHint(ctx, r.Data.IDTokenHint, s.Provider().IDTokenHintVerifier(ctx))
+	if err != nil {
+		return nil, err
+	}
+	authReq, err := s.Provider().Storage().CreateAuthRequest(ctx, r.Data, userID)
+	if err != nil {
+		return s.tryErrorRedirect(ctx, r.Data, oidc.DefaultToServerError(err, "unable to save auth request"))
+	}
+	return op.NewRedirect(r.Client.LoginURL(authReq.GetID())), nil
 }
 
 func (s *Server) DeviceAuthorization(ctx context.Context, r *op.ClientRequest[oidc.DeviceAuthorizationRequest]) (_ *op.Response, err error) {
@@ -185,12 +271,16 @@ func (s *Server) createDiscoveryConfig(ctx context.Context, supportedUILocales o
 			string(oidc.ResponseModeQuery),
 			string(oidc.ResponseModeFragment),
 			string(oidc.ResponseModeFormPost),
+			string(responseModeQueryJWT),
+			string(responseModeFragmentJWT),
+			string(responseModeFormPostJWT),
+			string(responseModeJWT),
 		},
-		GrantTypesSupported:                                op.GrantTypes(s.Provider()),
+		GrantTypesSupported:                                append(op.GrantTypes(s.Provider()), grantTypeCIBA),
 		SubjectTypesSupported:                              op.SubjectTypes(s.Provider()),
 		IDTokenSigningAlgValuesSupported:                   supportedSigningAlgs(ctx),
 		RequestObjectSigningAlgValuesSupported:             op.RequestObjectSigAlgorithms(s.Provider()),
-		TokenEndpointAuthMethodsSupported:                  op.AuthMethodsTokenEndpoint(s.Provider()),
+		TokenEndpointAuthMethodsSupported:                  append(op.AuthMethodsTokenEndpoint(s.Provider()), authMethodTLSClientAuth, authMethodSelfSignedTLSClientAuth),
 		TokenEndpointAuthSigningAlgValuesSupported:         op.TokenSigAlgorithms(s.Provider()),
 		IntrospectionEndpointAuthSigningAlgValuesSupported: op.IntrospectionSigAlgorithms(s.Provider()),
 		IntrospectionEndpointAuthMethodsSupported:          op.AuthMethodsIntrospectionEndpoint(s.Provider()),
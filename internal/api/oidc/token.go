@@ -3,6 +3,7 @@ package oidc
 import (
 	"context"
 	"encoding/base64"
+	"maps"
 	"slices"
 	"sync"
 	"time"
@@ -13,6 +14,7 @@ import (
 	"github.com/zitadel/oidc/v3/pkg/op"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/dpop"
 	"github.com/zitadel/zitadel/internal/command"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/telemetry/tracing"
@@ -35,8 +37,12 @@ func (s *Server) accessTokenResponseFromSession(ctx context.Context, client op.C
 	getUserInfo := s.getUserInfo(session.UserID, projectID, projectRoleAssertion, userInfoAssertion, session.Scope)
 	getSigner := s.getSignerOnce()
 
+	tokenType := oidc.BearerToken
+	if session.DPoPJKT != "" {
+		tokenType = dpop.TokenType
+	}
 	resp := &oidc.AccessTokenResponse{
-		TokenType:    oidc.BearerToken,
+		TokenType:    tokenType,
 		RefreshToken: session.RefreshToken,
 		ExpiresIn:    timeToOIDCExpiresIn(session.Expiration),
 		State:        state,
@@ -202,6 +208,20 @@ func (s *Server) createJWT(ctx context.Context, client op.Client, session *comma
 	)
 	claims.Actor = actorDomainToClaims(session.Actor)
 	claims.Claims = userInfo.Claims
+	cnf := confirmation(session.DPoPJKT, session.CertificateThumbprint)
+	if cnf != nil || len(session.AuthorizationDetails) > 0 {
+		// copy the claims, so the cnf and authorization_details claims do not end up in the userinfo (e.g. of the id_token)
+		claims.Claims = maps.Clone(userInfo.Claims)
+		if claims.Claims == nil {
+			claims.Claims = make(map[string]any, 2)
+		}
+	}
+	if cnf != nil {
+		claims.Claims["cnf"] = cnf
+	}
+	if len(session.AuthorizationDetails) > 0 {
+		claims.Claims[claimAuthorizationDetails] = session.AuthorizationDetails
+	}
 
 	return crypto.Sign(claims, signer)
 }
@@ -22,6 +22,10 @@ func (s *Server) ClientCredentialsExchange(ctx context.Context, r *op.ClientRequ
 	if !ok {
 		return nil, zerrors.ThrowInternal(nil, "OIDC-ga0EP", "Error.Internal")
 	}
+	dpopJKT, err := dpopJKTFromTokenRequest(ctx, s.dpopVerifier, r.Request, false)
+	if err != nil {
+		return nil, err
+	}
 	scope, err := op.ValidateAuthReqScopes(client, r.Data.Scope)
 	if err != nil {
 		return nil, err
@@ -30,6 +34,14 @@ func (s *Server) ClientCredentialsExchange(ctx context.Context, r *op.ClientRequ
 	if err != nil {
 		return nil, err
 	}
+	// if resources are indicated, the audience is restricted to exactly these resources (RFC 8707)
+	audience, err := resourceAudience(ctx, s.query, r.Form[formResource])
+	if err != nil {
+		return nil, err
+	}
+	if len(audience) == 0 {
+		audience = domain.AddAudScopeToAudience(ctx, nil, r.Data.Scope)
+	}
 
 	session, err := s.command.CreateOIDCSession(ctx,
 		client.userID,
@@ -37,7 +49,8 @@ func (s *Server) ClientCredentialsExchange(ctx context.Context, r *op.ClientRequ
 		client.clientID,
 		"", // backChannelLogoutURI not needed for service user session
 		scope,
-		domain.AddAudScopeToAudience(ctx, nil, r.Data.Scope),
+		audience,
+		nil,
 		[]domain.UserAuthMethodType{domain.UserAuthMethodTypePassword},
 		time.Now(),
 		"",
@@ -48,6 +61,9 @@ func (s *Server) ClientCredentialsExchange(ctx context.Context, r *op.ClientRequ
 		false,
 		"",
 		domain.OIDCResponseTypeUnspecified,
+		dpopJKT,
+		"",
+		nil,
 	)
 	if err != nil {
 		return nil, err
@@ -2,6 +2,7 @@ package oidc
 
 import (
 	"context"
+	"errors"
 	"slices"
 	"strings"
 
@@ -27,6 +28,20 @@ func (s *Server) CodeExchange(ctx context.Context, r *op.ClientRequest[oidc.Acce
 		return nil, zerrors.ThrowInternal(nil, "OIDC-Ae2ph", "Error.Internal")
 	}
 
+	dpopJKT, err := dpopJKTFromTokenRequest(ctx, s.dpopVerifier, r.Request, client.client.DPoPBoundAccessTokens)
+	if err != nil {
+		return nil, err
+	}
+	certificateThumbprint, err := certificateThumbprintFromTokenRequest(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	audience, err := resourceAudience(ctx, s.query, r.Form[formResource])
+	if err != nil {
+		return nil, err
+	}
+
 	plainCode, err := s.decryptCode(ctx, r.Data.Code)
 	if err != nil {
 		return nil, zerrors.ThrowInvalidArgument(err, "OIDC-ahLi2", "Errors.User.Code.Invalid")
@@ -40,20 +55,28 @@ func (s *Server) CodeExchange(ctx context.Context, r *op.ClientRequest[oidc.Acce
 			setContextUserSystem(ctx),
 			plainCode,
 			codeExchangeComplianceChecker(client, r.Data),
+			audience,
 			slices.Contains(client.GrantTypes(), oidc.GrantTypeRefreshToken),
 			client.client.BackChannelLogoutURI,
+			dpopJKT,
+			certificateThumbprint,
 		)
 	} else {
-		session, err = s.codeExchangeV1(ctx, client, r.Data, r.Data.Code)
+		session, err = s.codeExchangeV1(ctx, client, r.Data, r.Data.Code, audience, dpopJKT, certificateThumbprint)
+	}
+	if errors.Is(err, zerrors.ThrowPreconditionFailed(nil, "OIDCS-Ruo9e", "Errors.OIDCSession.ResourceInvalid")) {
+		return nil, oidc.ErrInvalidTarget().WithParent(err).WithDescription("resource was not granted")
 	}
 	if err != nil {
 		return nil, err
 	}
-	return response(s.accessTokenResponseFromSession(ctx, client, session, "", client.client.ProjectID, client.client.ProjectRoleAssertion, client.client.AccessTokenRoleAssertion, client.client.IDTokenRoleAssertion, client.client.IDTokenUserinfoAssertion))
+	resp, err := s.accessTokenResponseFromSession(ctx, client, session, "", client.client.ProjectID, client.client.ProjectRoleAssertion, client.client.AccessTokenRoleAssertion, client.client.IDTokenRoleAssertion, client.client.IDTokenUserinfoAssertion)
+	return response(accessTokenResponseWithAuthorizationDetails(resp, session.AuthorizationDetails), err)
 }
 
 // codeExchangeV1 creates a v2 token from a v1 auth request.
-func (s *Server) codeExchangeV1(ctx context.Context, client *Client, req *oidc.AccessTokenRequest, code string) (session *command.OIDCSession, err error) {
+// If an audience is requested, only the access token is restricted to it.
+func (s *Server) codeExchangeV1(ctx context.Context, client *Client, req *oidc.AccessTokenRequest, code string, audience []string, dpopJKT, certificateThumbprint string) (session *command.OIDCSession, err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
@@ -70,6 +93,9 @@ func (s *Server) codeExchangeV1(ctx context.Context, client *Client, req *oidc.A
 	if req.RedirectURI != authReq.GetRedirectURI() {
 		return nil, oidc.ErrInvalidGrant().WithDescription("redirect_uri does not correspond")
 	}
+	if _, err = validateResourceAudience(authReq.Audience, audience); err != nil {
+		return nil, err
+	}
 
 	scope := authReq.GetScopes()
 	session, err = s.command.CreateOIDCSession(ctx,
@@ -79,6 +105,7 @@ func (s *Server) codeExchangeV1(ctx context.Context, client *Client, req *oidc.A
 		client.client.BackChannelLogoutURI,
 		scope,
 		authReq.Audience,
+		audience,
 		authReq.AuthMethods(),
 		authReq.AuthTime,
 		authReq.GetNonce(),
@@ -89,6 +116,9 @@ func (s *Server) codeExchangeV1(ctx context.Context, client *Client, req *oidc.A
 		slices.Contains(scope, oidc.ScopeOfflineAccess),
 		authReq.SessionID,
 		authReq.oidc().ResponseType,
+		dpopJKT,
+		certificateThumbprint,
+		authReq.AuthorizationDetails,
 	)
 	if err != nil {
 		return nil, err
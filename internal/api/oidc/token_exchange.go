@@ -59,7 +59,9 @@ func (s *Server) tokenExchange(ctx context.Context, r *op.ClientRequest[oidc.Tok
 	}
 
 	actorToken := subjectToken // see [createExchangeTokens] comment.
-	if subjectToken.tokenType == UserIDTokenType || subjectToken.tokenType == oidc.JWTTokenType || r.Data.ActorToken != "" {
+	// tokens of workload identities are exchanged for the mapped machine user, which is not an impersonation
+	isWorkloadIdentity := subjectToken.workloadIdentityTrustID != ""
+	if subjectToken.tokenType == UserIDTokenType || (subjectToken.tokenType == oidc.JWTTokenType && !isWorkloadIdentity) || r.Data.ActorToken != "" {
 		if !authz.GetInstance(ctx).EnableImpersonation() {
 			return nil, zerrors.ThrowPermissionDenied(nil, "OIDC-Fae5w", "Errors.TokenExchange.Impersonation.PolicyDisabled")
 		}
@@ -134,6 +136,13 @@ func (s *Server) verifyExchangeToken(ctx context.Context, client *Client, token
 		return idTokenClaimsToExchangeToken(claims, resourceOwner), nil
 
 	case oidc.JWTTokenType:
+		workloadToken, err := s.verifyWorkloadIdentityToken(ctx, token)
+		if err != nil {
+			return nil, err
+		}
+		if workloadToken != nil {
+			return workloadToken, nil
+		}
 		var (
 			resourceOwner     string
 			preferredLanguage *language.Tag
@@ -291,6 +300,7 @@ func (s *Server) createExchangeAccessToken(
 		client.client.BackChannelLogoutURI,
 		scope,
 		audience,
+		nil,
 		authMethods,
 		authTime,
 		"",
@@ -301,6 +311,9 @@ func (s *Server) createExchangeAccessToken(
 		slices.Contains(scope, oidc.ScopeOfflineAccess),
 		"",
 		domain.OIDCResponseTypeUnspecified,
+		"",
+		"",
+		nil,
 	)
 	if err != nil {
 		return "", "", "", 0, err
@@ -338,6 +351,7 @@ func (s *Server) createExchangeJWT(
 		client.client.BackChannelLogoutURI,
 		scope,
 		audience,
+		nil,
 		authMethods,
 		authTime,
 		"",
@@ -348,6 +362,9 @@ func (s *Server) createExchangeJWT(
 		slices.Contains(scope, oidc.ScopeOfflineAccess),
 		"",
 		domain.OIDCResponseTypeUnspecified,
+		"",
+		"",
+		nil,
 	)
 	if err != nil {
 		return "", "", 0, err
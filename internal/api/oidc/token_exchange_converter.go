@@ -21,6 +21,9 @@ type exchangeToken struct {
 	audience          []string
 	scopes            []string
 	preferredLanguage *language.Tag
+	// workloadIdentityTrustID is set if the token was issued by an external issuer,
+	// trusted by a workload identity trust.
+	workloadIdentityTrustID string
 }
 
 func (et *exchangeToken) nestedActor() *domain.TokenActor {
@@ -0,0 +1,260 @@
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/zitadel/logging"
+	"github.com/zitadel/oidc/v3/pkg/client/rp"
+	"github.com/zitadel/oidc/v3/pkg/oidc"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/repository/workloadidentity"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	// workloadIdentityClockSkew is tolerated on the time claims of external tokens.
+	workloadIdentityClockSkew = 10 * time.Second
+	// workloadIdentityMaxAge limits the age of external tokens, which are usually short-lived.
+	workloadIdentityMaxAge = 24 * time.Hour
+	// workloadIdentityKeySetsMaxEntries limits the number of cached remote key sets.
+	workloadIdentityKeySetsMaxEntries = 1000
+	// workloadIdentityKeySetsTTL removes cached remote key sets,
+	// so endpoints of removed or changed trusts are not kept forever.
+	workloadIdentityKeySetsTTL = time.Hour
+	// workloadIdentityJWKSTimeout limits the time to fetch the keys of a remote key set.
+	workloadIdentityJWKSTimeout = 10 * time.Second
+)
+
+var workloadIdentityHTTPClient = &http.Client{Timeout: workloadIdentityJWKSTimeout}
+
+var errWorkloadIdentityNotYetValid = errors.New("token is not yet valid")
+
+var workloadIdentitySigningAlgorithms = []string{
+	string(jose.RS256), string(jose.RS384), string(jose.RS512),
+	string(jose.PS256), string(jose.PS384), string(jose.PS512),
+	string(jose.ES256), string(jose.ES384), string(jose.ES512),
+	string(jose.EdDSA),
+}
+
+// verifyWorkloadIdentityToken verifies a JWT of an external issuer (e.g. a Kubernetes service account token or a CI OIDC token)
+// against the workload identity trusts of the instance and returns the machine user mapped by their rules.
+// Nil is returned without error if no trust is configured for the issuer of the token,
+// so the token can be verified as JWT profile assertion instead.
+func (s *Server) verifyWorkloadIdentityToken(ctx context.Context, token string) (_ *exchangeToken, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	unverified := new(oidc.TokenClaims)
+	if _, err = oidc.ParseToken(token, unverified); err != nil || unverified.Issuer == "" {
+		return nil, nil
+	}
+	trusts, err := s.query.WorkloadIdentityTrustsByIssuer(ctx, unverified.Issuer)
+	if err != nil {
+		return nil, err
+	}
+	if len(trusts) == 0 {
+		return nil, nil
+	}
+	claims, match, err := verifyWorkloadIdentityTrusts(ctx, token, trusts, s.workloadIdentityKeySet)
+	if err != nil {
+		return nil, err
+	}
+	user, err := s.query.GetUserByID(ctx, false, match.userID)
+	if err != nil {
+		return nil, zerrors.ThrowPermissionDenied(err, "OIDC-gaeC8", "Errors.TokenExchange.Token.Invalid")
+	}
+	if user.Type != domain.UserTypeMachine || user.State != domain.UserStateActive || user.ResourceOwner != match.resourceOwner {
+		return nil, zerrors.ThrowPermissionDenied(nil, "OIDC-Eeph4", "Errors.TokenExchange.WorkloadIdentity.UserInvalid")
+	}
+	return workloadIdentityToExchangeToken(claims, match), nil
+}
+
+// workloadIdentityMatch is the machine user an external token is mapped to.
+type workloadIdentityMatch struct {
+	trustID       string
+	userID        string
+	resourceOwner string
+}
+
+// verifyWorkloadIdentityTrusts verifies the token against all trusts of its issuer,
+// as the issuer might be trusted by multiple organizations with different keys or audiences.
+// The token must be mapped to exactly one machine user by the rules of the trusts it is valid for.
+func verifyWorkloadIdentityTrusts(
+	ctx context.Context,
+	token string,
+	trusts []*query.WorkloadIdentityTrust,
+	keySet func(*query.WorkloadIdentityTrust) (oidc.KeySet, error),
+) (*oidc.TokenClaims, *workloadIdentityMatch, error) {
+	var (
+		verified *oidc.TokenClaims
+		match    *workloadIdentityMatch
+	)
+	for _, trust := range trusts {
+		keys, err := keySet(trust)
+		if err != nil {
+			logging.WithFields("trust", trust.ID).WithError(err).Warn("unable to get keys of workload identity trust")
+			continue
+		}
+		claims, rawClaims, err := verifyWorkloadIdentityTrust(ctx, token, trust, keys)
+		if err != nil {
+			logging.WithFields("trust", trust.ID).WithError(err).Debug("token not valid for workload identity trust")
+			continue
+		}
+		for _, rule := range trust.Rules {
+			if !workloadIdentityRuleMatches(rule, rawClaims) {
+				continue
+			}
+			if match != nil && (match.userID != rule.UserID || match.resourceOwner != trust.ResourceOwner) {
+				return nil, nil, zerrors.ThrowPermissionDenied(nil, "OIDC-ooT6i", "Errors.TokenExchange.WorkloadIdentity.Ambiguous")
+			}
+			verified = claims
+			match = &workloadIdentityMatch{
+				trustID:       trust.ID,
+				userID:        rule.UserID,
+				resourceOwner: trust.ResourceOwner,
+			}
+		}
+	}
+	if match == nil {
+		return nil, nil, zerrors.ThrowPermissionDenied(nil, "OIDC-Ci4ee", "Errors.TokenExchange.WorkloadIdentity.NoMatch")
+	}
+	return verified, match, nil
+}
+
+// verifyWorkloadIdentityTrust checks the signature, the audience and the time claims of the token
+// and returns its standard and all raw claims for the evaluation of the rules.
+func verifyWorkloadIdentityTrust(ctx context.Context, token string, trust *query.WorkloadIdentityTrust, keySet oidc.KeySet) (*oidc.TokenClaims, map[string]any, error) {
+	claims := new(oidc.TokenClaims)
+	payload, err := oidc.ParseToken(token, claims)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err = oidc.CheckIssuer(claims, trust.Issuer); err != nil {
+		return nil, nil, err
+	}
+	if err = oidc.CheckSignature(ctx, token, payload, claims, workloadIdentitySigningAlgorithms, keySet); err != nil {
+		return nil, nil, err
+	}
+	if !slices.ContainsFunc(claims.Audience, func(aud string) bool { return slices.Contains(trust.Audience, aud) }) {
+		return nil, nil, oidc.ErrAudience
+	}
+	// unlike for ID tokens, the expiration is required, so a leaked token can't be exchanged forever
+	if claims.GetExpiration().IsZero() {
+		return nil, nil, oidc.ErrExpired
+	}
+	if err = oidc.CheckExpiration(claims, -workloadIdentityClockSkew); err != nil {
+		return nil, nil, err
+	}
+	if nbf := claims.NotBefore.AsTime(); !nbf.IsZero() && time.Now().Add(workloadIdentityClockSkew).Before(nbf) {
+		return nil, nil, errWorkloadIdentityNotYetValid
+	}
+	if !claims.GetIssuedAt().IsZero() {
+		if err = oidc.CheckIssuedAt(claims, workloadIdentityMaxAge, workloadIdentityClockSkew); err != nil {
+			return nil, nil, err
+		}
+	}
+	rawClaims := make(map[string]any)
+	if err = json.Unmarshal(payload, &rawClaims); err != nil {
+		return nil, nil, err
+	}
+	return claims, rawClaims, nil
+}
+
+// workloadIdentityRuleMatches returns true if all conditions of the rule match the claims.
+// A condition value ending with * matches all claim values with the preceding prefix.
+// Conditions on array claims match if any of the values matches.
+func workloadIdentityRuleMatches(rule *workloadidentity.Rule, claims map[string]any) bool {
+	if len(rule.Conditions) == 0 {
+		return false
+	}
+	for claim, expected := range rule.Conditions {
+		value, ok := claims[claim]
+		if !ok {
+			return false
+		}
+		values, isArray := value.([]any)
+		if !isArray {
+			values = []any{value}
+		}
+		if !slices.ContainsFunc(values, func(v any) bool { return workloadIdentityValueMatches(expected, v) }) {
+			return false
+		}
+	}
+	return true
+}
+
+func workloadIdentityValueMatches(expected string, value any) bool {
+	var actual string
+	switch v := value.(type) {
+	case string:
+		actual = v
+	case bool, float64:
+		actual = fmt.Sprint(v)
+	default:
+		return false
+	}
+	if prefix, ok := strings.CutSuffix(expected, "*"); ok {
+		return strings.HasPrefix(actual, prefix)
+	}
+	return actual == expected
+}
+
+// workloadIdentityKeySet returns the key set of the trust.
+// Remote key sets are cached per endpoint for [workloadIdentityKeySetsTTL],
+// so the keys are only fetched again on an unknown key ID or after the key set expired.
+func (s *Server) workloadIdentityKeySet(trust *query.WorkloadIdentityTrust) (oidc.KeySet, error) {
+	if len(trust.JWKS) > 0 {
+		keySet := new(jose.JSONWebKeySet)
+		if err := json.Unmarshal(trust.JWKS, keySet); err != nil {
+			return nil, err
+		}
+		return staticKeySet(keySet.Keys), nil
+	}
+	if keySet, ok := s.workloadIdentityKeySets.Get(trust.JWKSEndpoint); ok {
+		return keySet, nil
+	}
+	keySet := rp.NewRemoteKeySet(workloadIdentityHTTPClient, trust.JWKSEndpoint)
+	s.workloadIdentityKeySets.Add(trust.JWKSEndpoint, keySet)
+	return keySet, nil
+}
+
+// staticKeySet implements the [oidc.KeySet] interface for configured keys,
+// such as the keys of a workload identity trust or a software statement issuer.
+type staticKeySet []jose.JSONWebKey
+
+func (k staticKeySet) VerifySignature(_ context.Context, jws *jose.JSONWebSignature) ([]byte, error) {
+	keyID, alg := oidc.GetKeyIDAndAlg(jws)
+	key, err := oidc.FindMatchingKey(keyID, oidc.KeyUseSignature, alg, k...)
+	if err != nil {
+		return nil, err
+	}
+	return jws.Verify(&key)
+}
+
+func workloadIdentityToExchangeToken(claims *oidc.TokenClaims, match *workloadIdentityMatch) *exchangeToken {
+	return &exchangeToken{
+		tokenType:     oidc.JWTTokenType,
+		userID:        match.userID,
+		issuer:        claims.Issuer,
+		resourceOwner: match.resourceOwner,
+		authTime:      claims.GetIssuedAt(),
+		// the external workload is set as actor, so the issued tokens can be traced back to it
+		actor: &domain.TokenActor{
+			UserID: claims.Subject,
+			Issuer: claims.Issuer,
+		},
+		workloadIdentityTrustID: match.trustID,
+		// audience omitted as we don't trust audiences not signed by us
+	}
+}
@@ -0,0 +1,216 @@
+package oidc
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/hashicorp/golang-lru/v2/expirable"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zitadel/oidc/v3/pkg/oidc"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/repository/workloadidentity"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func Test_workloadIdentityRuleMatches(t *testing.T) {
+	claims := map[string]any{
+		"sub":    "system:serviceaccount:ci:deployer",
+		"groups": []any{"system:serviceaccounts", "system:serviceaccounts:ci"},
+		"admin":  true,
+		"nested": map[string]any{"namespace": "ci"},
+	}
+	tests := []struct {
+		name       string
+		conditions map[string]string
+		want       bool
+	}{
+		{
+			name: "no conditions",
+			want: false,
+		},
+		{
+			name:       "exact match",
+			conditions: map[string]string{"sub": "system:serviceaccount:ci:deployer"},
+			want:       true,
+		},
+		{
+			name:       "prefix match",
+			conditions: map[string]string{"sub": "system:serviceaccount:ci:*"},
+			want:       true,
+		},
+		{
+			name:       "prefix mismatch",
+			conditions: map[string]string{"sub": "system:serviceaccount:prod:*"},
+			want:       false,
+		},
+		{
+			name:       "array match",
+			conditions: map[string]string{"groups": "system:serviceaccounts:ci"},
+			want:       true,
+		},
+		{
+			name:       "bool match",
+			conditions: map[string]string{"admin": "true"},
+			want:       true,
+		},
+		{
+			name:       "object never matches",
+			conditions: map[string]string{"nested": "*ci*"},
+			want:       false,
+		},
+		{
+			name:       "all conditions must match",
+			conditions: map[string]string{"sub": "system:serviceaccount:ci:*", "aud": "zitadel"},
+			want:       false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := workloadIdentityRuleMatches(&workloadidentity.Rule{Conditions: tt.conditions, UserID: "machine1"}, claims)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_verifyWorkloadIdentityTrusts(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.ES256, Key: &jose.JSONWebKey{Key: key, KeyID: "key1"}}, nil)
+	require.NoError(t, err)
+	sign := func(claims map[string]any) string {
+		payload, err := json.Marshal(claims)
+		require.NoError(t, err)
+		jws, err := signer.Sign(payload)
+		require.NoError(t, err)
+		token, err := jws.CompactSerialize()
+		require.NoError(t, err)
+		return token
+	}
+	keySets := map[string]oidc.KeySet{
+		"trust1": staticKeySet{{Key: &key.PublicKey, KeyID: "key1", Use: oidc.KeyUseSignature}},
+		"trust2": staticKeySet{{Key: &key.PublicKey, KeyID: "key1", Use: oidc.KeyUseSignature}},
+		"other":  staticKeySet{{Key: &otherKey.PublicKey, KeyID: "key1", Use: oidc.KeyUseSignature}},
+	}
+	keySet := func(trust *query.WorkloadIdentityTrust) (oidc.KeySet, error) {
+		return keySets[trust.ID], nil
+	}
+	trust := func(id, resourceOwner, userID string) *query.WorkloadIdentityTrust {
+		return &query.WorkloadIdentityTrust{
+			ObjectDetails: domain.ObjectDetails{ID: id, ResourceOwner: resourceOwner},
+			Issuer:        "https://kubernetes.default.svc",
+			Audience:      []string{"zitadel"},
+			Rules: []*workloadidentity.Rule{
+				{Conditions: map[string]string{"sub": "system:serviceaccount:ci:*"}, UserID: userID},
+			},
+		}
+	}
+	validClaims := func() map[string]any {
+		return map[string]any{
+			"iss": "https://kubernetes.default.svc",
+			"sub": "system:serviceaccount:ci:deployer",
+			"aud": []string{"zitadel"},
+			"exp": time.Now().Add(time.Hour).Unix(),
+			"iat": time.Now().Unix(),
+		}
+	}
+	withClaim := func(key string, value any) string {
+		claims := validClaims()
+		claims[key] = value
+		return sign(claims)
+	}
+	tests := []struct {
+		name      string
+		token     string
+		trusts    []*query.WorkloadIdentityTrust
+		wantMatch *workloadIdentityMatch
+		wantErr   error
+	}{
+		{
+			name:    "wrong key, no match",
+			token:   sign(validClaims()),
+			trusts:  []*query.WorkloadIdentityTrust{trust("other", "org1", "machine1")},
+			wantErr: zerrors.ThrowPermissionDenied(nil, "OIDC-Ci4ee", "Errors.TokenExchange.WorkloadIdentity.NoMatch"),
+		},
+		{
+			name:    "wrong audience, no match",
+			token:   withClaim("aud", []string{"kubernetes"}),
+			trusts:  []*query.WorkloadIdentityTrust{trust("trust1", "org1", "machine1")},
+			wantErr: zerrors.ThrowPermissionDenied(nil, "OIDC-Ci4ee", "Errors.TokenExchange.WorkloadIdentity.NoMatch"),
+		},
+		{
+			name:    "expired, no match",
+			token:   withClaim("exp", time.Now().Add(-time.Minute).Unix()),
+			trusts:  []*query.WorkloadIdentityTrust{trust("trust1", "org1", "machine1")},
+			wantErr: zerrors.ThrowPermissionDenied(nil, "OIDC-Ci4ee", "Errors.TokenExchange.WorkloadIdentity.NoMatch"),
+		},
+		{
+			name:    "without expiration, no match",
+			token:   withClaim("exp", nil),
+			trusts:  []*query.WorkloadIdentityTrust{trust("trust1", "org1", "machine1")},
+			wantErr: zerrors.ThrowPermissionDenied(nil, "OIDC-Ci4ee", "Errors.TokenExchange.WorkloadIdentity.NoMatch"),
+		},
+		{
+			name:    "rule not matching, no match",
+			token:   withClaim("sub", "system:serviceaccount:prod:deployer"),
+			trusts:  []*query.WorkloadIdentityTrust{trust("trust1", "org1", "machine1")},
+			wantErr: zerrors.ThrowPermissionDenied(nil, "OIDC-Ci4ee", "Errors.TokenExchange.WorkloadIdentity.NoMatch"),
+		},
+		{
+			name:    "multiple users, ambiguous",
+			token:   sign(validClaims()),
+			trusts:  []*query.WorkloadIdentityTrust{trust("trust1", "org1", "machine1"), trust("trust2", "org2", "machine2")},
+			wantErr: zerrors.ThrowPermissionDenied(nil, "OIDC-ooT6i", "Errors.TokenExchange.WorkloadIdentity.Ambiguous"),
+		},
+		{
+			name:      "only valid trust matches, ok",
+			token:     sign(validClaims()),
+			trusts:    []*query.WorkloadIdentityTrust{trust("other", "org2", "machine2"), trust("trust1", "org1", "machine1")},
+			wantMatch: &workloadIdentityMatch{trustID: "trust1", userID: "machine1", resourceOwner: "org1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claims, match, err := verifyWorkloadIdentityTrusts(context.Background(), tt.token, tt.trusts, keySet)
+			require.ErrorIs(t, err, tt.wantErr)
+			if tt.wantErr != nil {
+				return
+			}
+			assert.Equal(t, tt.wantMatch, match)
+			assert.Equal(t, "system:serviceaccount:ci:deployer", claims.Subject)
+		})
+	}
+}
+
+func TestServer_workloadIdentityKeySet(t *testing.T) {
+	s := &Server{
+		workloadIdentityKeySets: expirable.NewLRU[string, oidc.KeySet](1, nil, time.Hour),
+	}
+	trust1 := &query.WorkloadIdentityTrust{JWKSEndpoint: "https://issuer1.example.com/keys"}
+	trust2 := &query.WorkloadIdentityTrust{JWKSEndpoint: "https://issuer2.example.com/keys"}
+
+	keySet1, err := s.workloadIdentityKeySet(trust1)
+	require.NoError(t, err)
+	cached, err := s.workloadIdentityKeySet(trust1)
+	require.NoError(t, err)
+	assert.Same(t, keySet1, cached, "key set of the same endpoint must be cached")
+
+	keySet2, err := s.workloadIdentityKeySet(trust2)
+	require.NoError(t, err)
+	assert.NotSame(t, keySet1, keySet2)
+
+	// the cache is bounded, so the key set of the first endpoint was evicted
+	evicted, err := s.workloadIdentityKeySet(trust1)
+	require.NoError(t, err)
+	assert.NotSame(t, keySet1, evicted)
+}
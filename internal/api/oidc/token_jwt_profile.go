@@ -21,6 +21,10 @@ func (s *Server) JWTProfile(ctx context.Context, r *op.Request[oidc.JWTProfileGr
 		err = oidcError(err)
 	}()
 
+	dpopJKT, err := dpopJKTFromTokenRequest(ctx, s.dpopVerifier, r, false)
+	if err != nil {
+		return nil, err
+	}
 	user, err := s.verifyJWTProfile(ctx, r.Data)
 	if err != nil {
 		return nil, err
@@ -48,6 +52,7 @@ func (s *Server) JWTProfile(ctx context.Context, r *op.Request[oidc.JWTProfileGr
 		"", // backChannelLogoutURI not needed for service user session
 		scope,
 		domain.AddAudScopeToAudience(ctx, nil, r.Data.Scope),
+		nil,
 		[]domain.UserAuthMethodType{domain.UserAuthMethodTypePrivateKey},
 		time.Now(),
 		"",
@@ -58,6 +63,9 @@ func (s *Server) JWTProfile(ctx context.Context, r *op.Request[oidc.JWTProfileGr
 		false,
 		"",
 		domain.OIDCResponseTypeUnspecified,
+		dpopJKT,
+		"",
+		nil,
 	)
 	if err != nil {
 		return nil, err
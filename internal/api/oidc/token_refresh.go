@@ -26,12 +26,35 @@ func (s *Server) RefreshToken(ctx context.Context, r *op.ClientRequest[oidc.Refr
 		return nil, zerrors.ThrowInternal(nil, "OIDC-ga0EP", "Error.Internal")
 	}
 
-	session, err := s.command.ExchangeOIDCSessionRefreshAndAccessToken(ctx, r.Data.RefreshToken, r.Data.Scopes, refreshTokenComplianceChecker())
+	dpopJKT, err := dpopJKTFromTokenRequest(ctx, s.dpopVerifier, r.Request, client.client.DPoPBoundAccessTokens)
+	if err != nil {
+		return nil, err
+	}
+	certificateThumbprint, err := certificateThumbprintFromTokenRequest(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	audience, err := resourceAudience(ctx, s.query, r.Form[formResource])
+	if err != nil {
+		return nil, err
+	}
+
+	session, err := s.command.ExchangeOIDCSessionRefreshAndAccessToken(ctx, r.Data.RefreshToken, r.Data.Scopes, audience, dpopJKT, certificateThumbprint, client.client.RefreshTokenRotation, refreshTokenComplianceChecker())
 	if err == nil {
-		return response(s.accessTokenResponseFromSession(ctx, client, session, "", client.client.ProjectID, client.client.ProjectRoleAssertion, client.client.AccessTokenRoleAssertion, client.client.IDTokenRoleAssertion, client.client.IDTokenUserinfoAssertion))
+		resp, err := s.accessTokenResponseFromSession(ctx, client, session, "", client.client.ProjectID, client.client.ProjectRoleAssertion, client.client.AccessTokenRoleAssertion, client.client.IDTokenRoleAssertion, client.client.IDTokenUserinfoAssertion)
+		return response(accessTokenResponseWithAuthorizationDetails(resp, session.AuthorizationDetails), err)
 	} else if errors.Is(err, zerrors.ThrowPreconditionFailed(nil, "OIDCS-JOI23", "Errors.OIDCSession.RefreshTokenInvalid")) {
 		// We try again for v1 tokens when we encountered specific parsing error
-		return s.refreshTokenV1(ctx, client, r)
+		return s.refreshTokenV1(ctx, client, r, audience, dpopJKT, certificateThumbprint)
+	} else if errors.Is(err, zerrors.ThrowPreconditionFailed(nil, "OIDCS-Dp0pK", "Errors.OIDCSession.DPoPProofInvalid")) {
+		return nil, errInvalidDPoPProof(err)
+	} else if errors.Is(err, zerrors.ThrowPreconditionFailed(nil, "OIDCS-Ce9tb", "Errors.OIDCSession.ClientCertificateInvalid")) {
+		return nil, oidc.ErrInvalidGrant().WithParent(err).WithDescription("client certificate does not match the refresh token")
+	} else if errors.Is(err, zerrors.ThrowPreconditionFailed(nil, "OIDCS-Reu5e", "Errors.OIDCSession.RefreshTokenReused")) {
+		return nil, oidc.ErrInvalidGrant().WithParent(err).WithDescription("refresh token was already used, all tokens of the session have been revoked")
+	} else if errors.Is(err, zerrors.ThrowPreconditionFailed(nil, "OIDCS-Ruo9e", "Errors.OIDCSession.ResourceInvalid")) {
+		return nil, oidc.ErrInvalidTarget().WithParent(err).WithDescription("resource was not granted")
 	}
 	return nil, err
 }
@@ -40,8 +63,11 @@ func (s *Server) RefreshToken(ctx context.Context, r *op.ClientRequest[oidc.Refr
 // When valid a v2 OIDC session is created and v2 tokens are returned.
 // This "upgrades" existing v1 sessions to v2 session without requiring users to re-login.
 //
+// If an audience is requested, only the access token is restricted to it,
+// the new session keeps the audience of the refresh token.
+//
 // This function can be removed when we retire the v1 token repo.
-func (s *Server) refreshTokenV1(ctx context.Context, client *Client, r *op.ClientRequest[oidc.RefreshTokenRequest]) (_ *op.Response, err error) {
+func (s *Server) refreshTokenV1(ctx context.Context, client *Client, r *op.ClientRequest[oidc.RefreshTokenRequest], audience []string, dpopJKT, certificateThumbprint string) (_ *op.Response, err error) {
 	refreshToken, err := s.repo.RefreshTokenByToken(ctx, r.Data.RefreshToken)
 	if err != nil {
 		return nil, err
@@ -50,6 +76,9 @@ func (s *Server) refreshTokenV1(ctx context.Context, client *Client, r *op.Clien
 	if err != nil {
 		return nil, err
 	}
+	if _, err = validateResourceAudience(refreshToken.Audience, audience); err != nil {
+		return nil, err
+	}
 	session, err := s.command.CreateOIDCSession(ctx,
 		refreshToken.UserID,
 		refreshToken.ResourceOwner,
@@ -57,6 +86,7 @@ func (s *Server) refreshTokenV1(ctx context.Context, client *Client, r *op.Clien
 		"", // backChannelLogoutURI is not in refresh token view
 		scope,
 		refreshToken.Audience,
+		audience,
 		AMRToAuthMethodTypes(refreshToken.AuthMethodsReferences),
 		refreshToken.AuthTime,
 		"",
@@ -70,6 +100,9 @@ func (s *Server) refreshTokenV1(ctx context.Context, client *Client, r *op.Clien
 		true,
 		"",
 		domain.OIDCResponseTypeUnspecified,
+		dpopJKT,
+		certificateThumbprint,
+		nil,
 	)
 	if err != nil {
 		return nil, err
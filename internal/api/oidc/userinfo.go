@@ -46,6 +46,12 @@ func (s *Server) UserInfo(ctx context.Context, r *op.Request[oidc.UserInfoReques
 	if err != nil {
 		return nil, op.NewStatusError(oidc.ErrAccessDenied().WithDescription("access token invalid").WithParent(err).WithReturnParentToClient(authz.GetFeatures(ctx).DebugOIDCParentError), http.StatusUnauthorized)
 	}
+	if err = checkDPoPBinding(ctx, s.dpopVerifier, r.Header, r.Method, r.URL.Path, r.Data.AccessToken, token.dpopJKT); err != nil {
+		return nil, op.NewStatusError(err, http.StatusUnauthorized)
+	}
+	if err = checkCertificateBinding(ctx, token.certificateThumbprint); err != nil {
+		return nil, op.NewStatusError(err, http.StatusUnauthorized)
+	}
 
 	var (
 		projectID string
@@ -8,6 +8,7 @@ import (
 	"github.com/zitadel/saml/pkg/provider"
 	"github.com/zitadel/saml/pkg/provider/models"
 	"github.com/zitadel/saml/pkg/provider/xml"
+	"github.com/zitadel/saml/pkg/provider/xml/samlp"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/command"
@@ -46,6 +47,7 @@ func (p *Provider) CreateResponse(ctx context.Context, authReq models.AuthReques
 		authReq.GetID(),
 		samlComplianceChecker(),
 		samlResponse.Id,
+		logoutSubject(samlResponse),
 		p.Expiration(),
 	); err != nil {
 		return "", "", err
@@ -54,6 +56,22 @@ func (p *Provider) CreateResponse(ctx context.Context, authReq models.AuthReques
 	return createResponse(samlResponse, authReq.GetBindingType(), authReq.GetAccessConsumerServiceURL(), resp.RelayState, resp.SigAlg, resp.Signature)
 }
 
+// logoutSubject returns the NameID and SessionIndex of the issued assertion,
+// which are needed to send a LogoutRequest to the service provider.
+func logoutSubject(samlResponse *samlp.ResponseType) *command.SAMLLogoutSubject {
+	if samlResponse.Assertion.Subject == nil || samlResponse.Assertion.Subject.NameID == nil {
+		return nil
+	}
+	subject := &command.SAMLLogoutSubject{
+		NameID:       samlResponse.Assertion.Subject.NameID.Text,
+		NameIDFormat: samlResponse.Assertion.Subject.NameID.Format,
+	}
+	if len(samlResponse.Assertion.AuthnStatement) > 0 {
+		subject.SessionIndex = samlResponse.Assertion.AuthnStatement[0].SessionIndex
+	}
+	return subject
+}
+
 func createResponse(samlResponse interface{}, binding, acs, relayState, sigAlg, sig string) (string, string, error) {
 	respData, err := xml.Marshal(samlResponse)
 	if err != nil {
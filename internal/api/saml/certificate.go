@@ -74,7 +74,7 @@ func (p *Storage) getCertificateAndKey(ctx context.Context, usage crypto.KeyUsag
 	}
 
 	if len(certs.Certificates) > 0 {
-		return p.certificateToCertificateAndKey(selectCertificate(certs.Certificates))
+		return CertificateToCertificateAndKey(SelectCertificate(certs.Certificates), p.encAlg)
 	}
 
 	var position decimal.Decimal
@@ -171,8 +171,10 @@ func (p *Storage) getMaxKeyPosition(ctx context.Context) (decimal.Decimal, error
 	)
 }
 
-func (p *Storage) certificateToCertificateAndKey(certificate query.Certificate) (_ *key.CertificateAndKey, err error) {
-	keyData, err := crypto.Decrypt(certificate.Key(), p.encAlg)
+// CertificateToCertificateAndKey decrypts the private key of the certificate
+// and returns both in the format used for signing.
+func CertificateToCertificateAndKey(certificate query.Certificate, encAlg crypto.EncryptionAlgorithm) (_ *key.CertificateAndKey, err error) {
+	keyData, err := crypto.Decrypt(certificate.Key(), encAlg)
 	if err != nil {
 		return nil, err
 	}
@@ -192,7 +194,8 @@ func (p *Storage) certificateToCertificateAndKey(certificate query.Certificate)
 	}, nil
 }
 
-func selectCertificate(certs []query.Certificate) query.Certificate {
+// SelectCertificate returns the most recent of the active certificates.
+func SelectCertificate(certs []query.Certificate) query.Certificate {
 	return certs[len(certs)-1]
 }
 
@@ -0,0 +1,261 @@
+package saml
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"html/template"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/zitadel/logging"
+	"github.com/zitadel/saml/pkg/provider"
+	"github.com/zitadel/saml/pkg/provider/key"
+	"github.com/zitadel/saml/pkg/provider/serviceprovider"
+	"github.com/zitadel/saml/pkg/provider/signature"
+	"github.com/zitadel/saml/pkg/provider/xml"
+	"github.com/zitadel/saml/pkg/provider/xml/md"
+	"github.com/zitadel/saml/pkg/provider/xml/saml"
+	"github.com/zitadel/saml/pkg/provider/xml/samlp"
+
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	queryRequest    = "SAMLRequest"
+	queryEncoding   = "SAMLEncoding"
+	queryRelayState = "RelayState"
+	querySigAlg     = "SigAlg"
+	querySignature  = "Signature"
+)
+
+var logoutResponseTemplate = template.Must(template.New("logout").Parse(`<!DOCTYPE html>
+<html lang="en">
+<body onload="document.getElementById('samlpost').submit()">
+<noscript>
+<p><strong>Note:</strong> Since your browser does not support JavaScript, you must press the Continue button once to proceed.</p>
+</noscript>
+<form action="{{ .URL }}" method="post" id="samlpost">
+<input type="hidden" name="RelayState" value="{{ .RelayState }}"/>
+<input type="hidden" name="SAMLResponse" value="{{ .SAMLResponse }}"/>
+<noscript><input type="submit" value="Continue"/></noscript>
+</form>
+</body>
+</html>`))
+
+type logoutResponseForm struct {
+	URL          string
+	RelayState   string
+	SAMLResponse string
+}
+
+// logoutHandler handles LogoutRequests of service providers (SP-initiated single logout)
+// on the single logout endpoint.
+// It terminates the SAML sessions of the subject and the underlying sessions,
+// which will notify all other participating service providers (and OIDC clients) using back-channel logout.
+type logoutHandler struct {
+	storage            *Storage
+	command            *command.Commands
+	logoutEndpoint     string
+	metadataEndpoint   provider.Endpoint
+	signatureAlgorithm string
+}
+
+func newLogoutHandler(storage *Storage, command *command.Commands, config *provider.Config) *logoutHandler {
+	logoutEndpoint := provider.NewEndpoint(provider.DefaultSingleLogOutEndpoint)
+	metadataEndpoint := provider.NewEndpoint(provider.DefaultMetadataEndpoint)
+	var signatureAlgorithm string
+	if config.MetadataConfig != nil && config.MetadataConfig.Path != "" {
+		metadataEndpoint = provider.NewEndpoint(config.MetadataConfig.Path)
+	}
+	if config.IDPConfig != nil {
+		signatureAlgorithm = config.IDPConfig.SignatureAlgorithm
+		if config.IDPConfig.Endpoints != nil && config.IDPConfig.Endpoints.SingleLogOut != nil && config.IDPConfig.Endpoints.SingleLogOut.Relative() != "" {
+			logoutEndpoint = *config.IDPConfig.Endpoints.SingleLogOut
+		}
+	}
+	return &logoutHandler{
+		storage:            storage,
+		command:            command,
+		logoutEndpoint:     logoutEndpoint.Relative(),
+		metadataEndpoint:   metadataEndpoint,
+		signatureAlgorithm: signatureAlgorithm,
+	}
+}
+
+// Handler intercepts the requests to the single logout endpoint,
+// since the endpoint of the library does not terminate any session.
+func (l *logoutHandler) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.TrimSuffix(r.URL.Path, "/") != l.logoutEndpoint {
+			next.ServeHTTP(w, r)
+			return
+		}
+		l.handleLogout(w, r)
+	})
+}
+
+func (l *logoutHandler) handleLogout(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, fmt.Errorf("failed to parse form: %w", err).Error(), http.StatusBadRequest)
+		return
+	}
+	logoutRequest, sp, err := l.parseAndVerifyRequest(ctx, r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	response := &samlp.LogoutResponseType{
+		Id:           provider.NewID(),
+		InResponseTo: logoutRequest.Id,
+		Version:      "2.0",
+		IssueInstant: time.Now().UTC().Format(TimeFormat),
+		Destination:  logoutResponseURL(sp.Metadata.SPSSODescriptor.SingleLogoutService),
+		Issuer:       &saml.NameIDType{Text: l.metadataEndpoint.Absolute(ContextToIssuer(ctx))},
+		Status: samlp.StatusType{
+			StatusCode: samlp.StatusCodeType{Value: provider.StatusCodeSuccess},
+		},
+	}
+	if err = l.command.SAMLLogout(setContextUserSystem(ctx), sp.GetEntityID(), logoutRequest.NameID.Text, logoutRequest.SessionIndex); err != nil {
+		logging.WithError(err).WithField("entityID", sp.GetEntityID()).Error("saml logout failed")
+		response.Status.StatusCode.Value = provider.StatusCodeResponder
+		response.Status.StatusMessage = "failed to terminate session"
+	}
+	if err = l.sendResponse(ctx, w, response, r.Form.Get(queryRelayState)); err != nil {
+		http.Error(w, fmt.Errorf("failed to send response: %w", err).Error(), http.StatusInternalServerError)
+	}
+}
+
+// parseAndVerifyRequest decodes the LogoutRequest of either the HTTP-Redirect or HTTP-POST binding
+// and verifies its signature with the certificate of the service provider.
+func (l *logoutHandler) parseAndVerifyRequest(ctx context.Context, r *http.Request) (*samlp.LogoutRequestType, *serviceprovider.ServiceProvider, error) {
+	encoding := r.Form.Get(queryEncoding)
+	redirectBinding := r.URL.Query().Has(queryRequest)
+	if redirectBinding && encoding == "" {
+		encoding = xml.EncodingDeflate
+	}
+	request := r.Form.Get(queryRequest)
+	logoutRequest, err := xml.DecodeLogoutRequest(encoding, request)
+	if err != nil {
+		return nil, nil, zerrors.ThrowInvalidArgument(err, "SAML-Jw2ga", "Errors.SAMLSession.InvalidLogoutRequest")
+	}
+	if logoutRequest.Issuer == nil || logoutRequest.NameID == nil {
+		return nil, nil, zerrors.ThrowInvalidArgument(nil, "SAML-Pq7sV", "Errors.SAMLSession.InvalidLogoutRequest")
+	}
+	sp, err := l.storage.GetEntityByID(ctx, logoutRequest.Issuer.Text)
+	if err != nil {
+		return nil, nil, err
+	}
+	if redirectBinding {
+		err = sp.ValidateRedirectSignature(request, r.Form.Get(queryRelayState), r.Form.Get(querySigAlg), r.Form.Get(querySignature))
+	} else {
+		var data []byte
+		data, err = xml.InflateAndDecode(encoding, true, request)
+		if err == nil {
+			err = sp.ValidatePostSignature(string(data))
+		}
+	}
+	if err != nil {
+		return nil, nil, zerrors.ThrowInvalidArgument(err, "SAML-0dG3s", "Errors.SAMLSession.InvalidLogoutRequest")
+	}
+	return logoutRequest, sp, nil
+}
+
+func (l *logoutHandler) sendResponse(ctx context.Context, w http.ResponseWriter, response *samlp.LogoutResponseType, relayState string) error {
+	certAndKey, err := l.storage.GetCertificateAndKey(ctx, crypto.KeyUsageSAMLResponseSinging)
+	if err != nil {
+		return err
+	}
+	signer, err := signature.GetSigner(certAndKey.Certificate, certAndKey.Key, l.signatureAlgorithm)
+	if err != nil {
+		return err
+	}
+	response.Signature, err = signature.Create(signer, response)
+	if err != nil {
+		return err
+	}
+	data, err := xml.Marshal(response)
+	if err != nil {
+		return err
+	}
+	if response.Destination == "" {
+		return xml.Write(w, data)
+	}
+	return logoutResponseTemplate.Execute(w, &logoutResponseForm{
+		URL:          response.Destination,
+		RelayState:   relayState,
+		SAMLResponse: base64.StdEncoding.EncodeToString(data),
+	})
+}
+
+// logoutResponseURL returns the url of the HTTP-POST single logout service of the service provider,
+// preferring a dedicated ResponseLocation
+func logoutResponseURL(services []md.EndpointType) string {
+	for _, service := range services {
+		if service.Binding != provider.PostBinding {
+			continue
+		}
+		if service.ResponseLocation != "" {
+			return service.ResponseLocation
+		}
+		return service.Location
+	}
+	return ""
+}
+
+// LogoutRequestURL returns the url of the HTTP-POST single logout service
+// from the metadata of the service provider.
+// If the service provider does not provide one, an empty string is returned.
+func LogoutRequestURL(metadata []byte) (string, error) {
+	entityDescriptor, err := xml.ParseMetadataXmlIntoStruct(metadata)
+	if err != nil {
+		return "", err
+	}
+	if entityDescriptor.SPSSODescriptor == nil {
+		return "", nil
+	}
+	for _, service := range entityDescriptor.SPSSODescriptor.SingleLogoutService {
+		if service.Binding == provider.PostBinding {
+			return service.Location, nil
+		}
+	}
+	return "", nil
+}
+
+// CreateLogoutRequest creates a signed and base64 encoded LogoutRequest for the HTTP-POST binding,
+// which is sent to the service providers on IdP-initiated single logout.
+func CreateLogoutRequest(certAndKey *key.CertificateAndKey, signatureAlgorithm, issuer, destination, nameID, nameIDFormat, sessionIndex string, lifetime time.Duration) (string, error) {
+	now := time.Now().UTC()
+	request := &samlp.LogoutRequestType{
+		Id:           provider.NewID(),
+		Version:      "2.0",
+		IssueInstant: now.Format(TimeFormat),
+		NotOnOrAfter: now.Add(lifetime).Format(TimeFormat),
+		Destination:  destination,
+		Issuer:       &saml.NameIDType{Text: issuer},
+		NameID: &saml.NameIDType{
+			Format: nameIDFormat,
+			Text:   nameID,
+		},
+	}
+	if sessionIndex != "" {
+		request.SessionIndex = []string{sessionIndex}
+	}
+	signer, err := signature.GetSigner(certAndKey.Certificate, certAndKey.Key, signatureAlgorithm)
+	if err != nil {
+		return "", err
+	}
+	request.Signature, err = signature.Create(signer, request)
+	if err != nil {
+		return "", err
+	}
+	data, err := xml.Marshal(request)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(data), nil
+}
@@ -22,6 +22,7 @@ import (
 
 const (
 	HandlerPrefix = "/saml/v2"
+	TimeFormat    = "2006-01-02T15:04:05.999Z"
 )
 
 type Config struct {
@@ -76,8 +77,9 @@ func NewProvider(
 			accessHandler.HandleWithPublicAuthPathPrefixes(publicAuthPathPrefixes(conf.ProviderConfig)),
 			http_utils.CopyHeadersToContext,
 			middleware.ActivityHandler,
+			newLogoutHandler(provStorage, command, conf.ProviderConfig).Handler,
 		),
-		provider.WithCustomTimeFormat("2006-01-02T15:04:05.999Z"),
+		provider.WithCustomTimeFormat(TimeFormat),
 	}
 	if !externalSecure {
 		options = append(options, provider.WithAllowInsecure())
@@ -138,11 +140,12 @@ func publicAuthPathPrefixes(config *provider.Config) []string {
 	metadataEndpoint := HandlerPrefix + provider.DefaultMetadataEndpoint
 	certificateEndpoint := HandlerPrefix + provider.DefaultCertificateEndpoint
 	ssoEndpoint := HandlerPrefix + provider.DefaultSingleSignOnEndpoint
+	sloEndpoint := HandlerPrefix + provider.NewEndpoint(provider.DefaultSingleLogOutEndpoint).Relative()
 	if config.MetadataConfig != nil && config.MetadataConfig.Path != "" {
 		metadataEndpoint = HandlerPrefix + config.MetadataConfig.Path
 	}
 	if config.IDPConfig == nil || config.IDPConfig.Endpoints == nil {
-		return []string{metadataEndpoint, certificateEndpoint, ssoEndpoint}
+		return []string{metadataEndpoint, certificateEndpoint, ssoEndpoint, sloEndpoint}
 	}
 	if config.IDPConfig.Endpoints.Certificate != nil && config.IDPConfig.Endpoints.Certificate.Relative() != "" {
 		certificateEndpoint = HandlerPrefix + config.IDPConfig.Endpoints.Certificate.Relative()
@@ -150,5 +153,8 @@ func publicAuthPathPrefixes(config *provider.Config) []string {
 	if config.IDPConfig.Endpoints.SingleSignOn != nil && config.IDPConfig.Endpoints.SingleSignOn.Relative() != "" {
 		ssoEndpoint = HandlerPrefix + config.IDPConfig.Endpoints.SingleSignOn.Relative()
 	}
-	return []string{metadataEndpoint, certificateEndpoint, ssoEndpoint}
+	if config.IDPConfig.Endpoints.SingleLogOut != nil && config.IDPConfig.Endpoints.SingleLogOut.Relative() != "" {
+		sloEndpoint = HandlerPrefix + config.IDPConfig.Endpoints.SingleLogOut.Relative()
+	}
+	return []string{metadataEndpoint, certificateEndpoint, ssoEndpoint, sloEndpoint}
 }
@@ -0,0 +1,152 @@
+//go:build integration
+
+package integration_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/api/scim/resources"
+	"github.com/zitadel/zitadel/internal/api/scim/schemas"
+	"github.com/zitadel/zitadel/internal/integration"
+	"github.com/zitadel/zitadel/internal/integration/scim"
+	"github.com/zitadel/zitadel/pkg/grpc/management"
+)
+
+const groupsProjectIDMetadataKey = "urn:zitadel:scim:groupsProjectId"
+
+func TestCreateGroup(t *testing.T) {
+	setGroupsProject(t)
+	member1 := Instance.CreateHumanUser(CTX).GetUserId()
+	member2 := Instance.CreateHumanUser(CTX).GetUserId()
+
+	tests := []struct {
+		name          string
+		ctx           context.Context
+		body          []byte
+		wantMembers   []string
+		wantErr       bool
+		scimErrorType string
+		errorStatus   int
+	}{
+		{
+			name:        "without members",
+			body:        groupJson(t, "group-"+gofakeit.UUID()),
+			wantMembers: []string{},
+		},
+		{
+			name:        "with members",
+			body:        groupJson(t, "group-"+gofakeit.UUID(), member1, member2),
+			wantMembers: []string{member1, member2},
+		},
+		{
+			name:          "missing display name",
+			body:          groupJson(t, "", member1),
+			wantErr:       true,
+			scimErrorType: "invalidValue",
+		},
+		{
+			name:        "not authenticated",
+			ctx:         context.Background(),
+			body:        groupJson(t, "group-"+gofakeit.UUID()),
+			wantErr:     true,
+			errorStatus: http.StatusUnauthorized,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := tt.ctx
+			if ctx == nil {
+				ctx = CTX
+			}
+
+			createdGroup, err := Instance.Client.SCIM.Groups.Create(ctx, Instance.DefaultOrg.Id, tt.body)
+			if tt.wantErr {
+				statusCode := tt.errorStatus
+				if statusCode == 0 {
+					statusCode = http.StatusBadRequest
+				}
+				scimErr := scim.RequireScimError(t, statusCode, err)
+				assert.Equal(t, tt.scimErrorType, scimErr.Error.ScimType)
+				return
+			}
+			require.NoError(t, err)
+			assert.NotEmpty(t, createdGroup.ID)
+			assert.ElementsMatch(t, tt.wantMembers, groupMemberIDs(createdGroup))
+
+			retryDuration, tick := integration.WaitForAndTickWithMaxDuration(CTX, time.Minute)
+			require.EventuallyWithT(t, func(ttt *assert.CollectT) {
+				// ensure the group is really stored and not just returned to the caller
+				fetchedGroup, err := Instance.Client.SCIM.Groups.Get(CTX, Instance.DefaultOrg.Id, createdGroup.ID)
+				require.NoError(ttt, err)
+				assert.Equal(ttt, createdGroup.DisplayName, fetchedGroup.DisplayName)
+				assert.ElementsMatch(ttt, tt.wantMembers, groupMemberIDs(fetchedGroup))
+			}, retryDuration, tick)
+		})
+	}
+}
+
+func TestCreateGroup_noProjectConfigured(t *testing.T) {
+	_, err := Instance.Client.SCIM.Groups.Create(CTX, Instance.DefaultOrg.Id, groupJson(t, "group-"+gofakeit.UUID()))
+	scimErr := scim.RequireScimError(t, http.StatusBadRequest, err)
+	assert.Equal(t, "SCIM-GRP1", scimErr.Error.ZitadelDetail.ID)
+}
+
+func TestCreateGroup_duplicate(t *testing.T) {
+	setGroupsProject(t)
+	body := groupJson(t, "group-"+gofakeit.UUID())
+
+	_, err := Instance.Client.SCIM.Groups.Create(CTX, Instance.DefaultOrg.Id, body)
+	require.NoError(t, err)
+
+	_, err = Instance.Client.SCIM.Groups.Create(CTX, Instance.DefaultOrg.Id, body)
+	scimErr := scim.RequireScimError(t, http.StatusConflict, err)
+	assert.Equal(t, "uniqueness", scimErr.Error.ScimType)
+}
+
+// setGroupsProject creates a project and configures it as project of the scim groups
+// for the scim service user until the test is finished.
+func setGroupsProject(t *testing.T) string {
+	projectID := Instance.CreateProject(CTX, t, "", gofakeit.AppName(), false, false).GetId()
+	userID := Instance.Users.Get(integration.UserTypeOrgOwner).ID
+	setAndEnsureMetadata(t, userID, groupsProjectIDMetadataKey, projectID)
+	t.Cleanup(func() {
+		_, err := Instance.Client.Mgmt.RemoveUserMetadata(CTX, &management.RemoveUserMetadataRequest{
+			Id:  userID,
+			Key: groupsProjectIDMetadataKey,
+		})
+		require.NoError(t, err)
+	})
+	return projectID
+}
+
+func groupJson(t require.TestingT, displayName string, memberIDs ...string) []byte {
+	members := make([]*resources.ScimGroupMember, len(memberIDs))
+	for i, id := range memberIDs {
+		members[i] = &resources.ScimGroupMember{Value: id}
+	}
+	body, err := json.Marshal(&resources.ScimGroup{
+		Resource: &schemas.Resource{
+			Schemas: []schemas.ScimSchemaType{schemas.IdGroup},
+		},
+		DisplayName: displayName,
+		Members:     members,
+	})
+	require.NoError(t, err)
+	return body
+}
+
+func groupMemberIDs(group *resources.ScimGroup) []string {
+	ids := make([]string, len(group.Members))
+	for i, member := range group.Members {
+		ids[i] = member.Value
+	}
+	return ids
+}
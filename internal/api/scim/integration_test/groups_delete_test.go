@@ -0,0 +1,54 @@
+//go:build integration
+
+package integration_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/integration"
+	"github.com/zitadel/zitadel/internal/integration/scim"
+)
+
+func TestDeleteGroup_errors(t *testing.T) {
+	setGroupsProject(t)
+
+	t.Run("unknown group id", func(t *testing.T) {
+		err := Instance.Client.SCIM.Groups.Delete(CTX, Instance.DefaultOrg.Id, "unknown")
+		scim.RequireScimError(t, http.StatusNotFound, err)
+	})
+
+	t.Run("another org", func(t *testing.T) {
+		err := Instance.Client.SCIM.Groups.Delete(CTX, SecondaryOrganization.OrganizationId, "unknown")
+		scim.RequireScimError(t, http.StatusNotFound, err)
+	})
+}
+
+func TestDeleteGroup_ensureReallyDeleted(t *testing.T) {
+	setGroupsProject(t)
+	member := Instance.CreateHumanUser(CTX).GetUserId()
+
+	createdGroup, err := Instance.Client.SCIM.Groups.Create(CTX, Instance.DefaultOrg.Id, groupJson(t, "group-"+gofakeit.UUID(), member))
+	require.NoError(t, err)
+
+	// delete group via scim, the group must be projected before it can be deleted
+	retryDuration, tick := integration.WaitForAndTickWithMaxDuration(CTX, time.Minute)
+	require.EventuallyWithT(t, func(ttt *assert.CollectT) {
+		require.NoError(ttt, Instance.Client.SCIM.Groups.Delete(CTX, Instance.DefaultOrg.Id, createdGroup.ID))
+	}, retryDuration, tick)
+
+	// ensure it is really deleted => try to get and delete again => should 404
+	retryDuration, tick = integration.WaitForAndTickWithMaxDuration(CTX, time.Minute)
+	require.EventuallyWithT(t, func(ttt *assert.CollectT) {
+		_, err := Instance.Client.SCIM.Groups.Get(CTX, Instance.DefaultOrg.Id, createdGroup.ID)
+		scim.RequireScimError(ttt, http.StatusNotFound, err)
+	}, retryDuration, tick)
+
+	err = Instance.Client.SCIM.Groups.Delete(CTX, Instance.DefaultOrg.Id, createdGroup.ID)
+	scim.RequireScimError(t, http.StatusNotFound, err)
+}
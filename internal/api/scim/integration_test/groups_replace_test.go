@@ -0,0 +1,101 @@
+//go:build integration
+
+package integration_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/api/scim/resources"
+	"github.com/zitadel/zitadel/internal/integration"
+	"github.com/zitadel/zitadel/internal/integration/scim"
+)
+
+func TestReplaceGroup(t *testing.T) {
+	setGroupsProject(t)
+	member1 := Instance.CreateHumanUser(CTX).GetUserId()
+	member2 := Instance.CreateHumanUser(CTX).GetUserId()
+	member3 := Instance.CreateHumanUser(CTX).GetUserId()
+
+	tests := []struct {
+		name           string
+		createMembers  []string
+		replaceMembers []string
+		displayName    string
+	}{
+		{
+			name:           "add members",
+			replaceMembers: []string{member1, member2},
+		},
+		{
+			name:           "remove members",
+			createMembers:  []string{member1, member2},
+			replaceMembers: []string{member2},
+		},
+		{
+			name:           "replace members",
+			createMembers:  []string{member1, member2},
+			replaceMembers: []string{member2, member3},
+		},
+		{
+			name:           "change display name",
+			createMembers:  []string{member1},
+			replaceMembers: []string{member1},
+			displayName:    "group-renamed",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			groupID := "group-" + gofakeit.UUID()
+			createdGroup, err := Instance.Client.SCIM.Groups.Create(CTX, Instance.DefaultOrg.Id, groupJson(t, groupID, tt.createMembers...))
+			require.NoError(t, err)
+
+			displayName := tt.displayName
+			if displayName == "" {
+				displayName = createdGroup.DisplayName
+			}
+
+			var replacedGroup *resources.ScimGroup
+			retryDuration, tick := integration.WaitForAndTickWithMaxDuration(CTX, time.Minute)
+			require.EventuallyWithT(t, func(ttt *assert.CollectT) {
+				// the group must be projected before it can be replaced
+				replacedGroup, err = Instance.Client.SCIM.Groups.Replace(CTX, Instance.DefaultOrg.Id, createdGroup.ID, groupJson(t, displayName, tt.replaceMembers...))
+				require.NoError(ttt, err)
+			}, retryDuration, tick)
+			assert.Equal(t, groupID, replacedGroup.ID)
+
+			retryDuration, tick = integration.WaitForAndTickWithMaxDuration(CTX, time.Minute)
+			require.EventuallyWithT(t, func(ttt *assert.CollectT) {
+				fetchedGroup, err := Instance.Client.SCIM.Groups.Get(CTX, Instance.DefaultOrg.Id, createdGroup.ID)
+				require.NoError(ttt, err)
+				assert.Equal(ttt, displayName, fetchedGroup.DisplayName)
+				assert.ElementsMatch(ttt, tt.replaceMembers, groupMemberIDs(fetchedGroup))
+			}, retryDuration, tick)
+		})
+	}
+}
+
+func TestReplaceGroup_errors(t *testing.T) {
+	setGroupsProject(t)
+	createdGroup, err := Instance.Client.SCIM.Groups.Create(CTX, Instance.DefaultOrg.Id, groupJson(t, "group-"+gofakeit.UUID()))
+	require.NoError(t, err)
+
+	t.Run("unknown group id", func(t *testing.T) {
+		_, err := Instance.Client.SCIM.Groups.Replace(CTX, Instance.DefaultOrg.Id, "unknown", groupJson(t, "unknown"))
+		scim.RequireScimError(t, http.StatusNotFound, err)
+	})
+
+	t.Run("missing display name", func(t *testing.T) {
+		retryDuration, tick := integration.WaitForAndTickWithMaxDuration(CTX, time.Minute)
+		require.EventuallyWithT(t, func(ttt *assert.CollectT) {
+			_, err := Instance.Client.SCIM.Groups.Replace(CTX, Instance.DefaultOrg.Id, createdGroup.ID, groupJson(t, ""))
+			scimErr := scim.RequireScimError(ttt, http.StatusBadRequest, err)
+			assert.Equal(ttt, "invalidValue", scimErr.Error.ScimType)
+		}, retryDuration, tick)
+	})
+}
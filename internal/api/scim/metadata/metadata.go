@@ -15,6 +15,7 @@ const (
 
 	KeyPrefix                 = "urn:zitadel:scim:"
 	KeyProvisioningDomain Key = KeyPrefix + "provisioningDomain"
+	KeyGroupsProjectID    Key = KeyPrefix + "groupsProjectId"
 
 	KeyExternalId               Key = KeyPrefix + "externalId"
 	keyScopedExternalIdTemplate     = KeyPrefix + externalIdProvisioningDomainPlaceholder + ":externalId"
@@ -29,22 +29,37 @@ func initScimContext(ctx context.Context, q *query.Queries) (context.Context, er
 	ctx = smetadata.SetScimContextData(ctx, data)
 
 	userID := authz.GetCtxData(ctx).UserID
-	metadata, err := q.GetUserMetadataByKey(ctx, false, userID, string(smetadata.KeyProvisioningDomain), false)
+	provisioningDomain, err := queryScimUserMetadata(ctx, q, userID, smetadata.KeyProvisioningDomain)
+	if err != nil {
+		return ctx, err
+	}
+
+	data.ProvisioningDomain = provisioningDomain
+	if data.ProvisioningDomain != "" {
+		data.ExternalIDScopedMetadataKey = smetadata.ScopeExternalIdKey(data.ProvisioningDomain)
+	}
+
+	// the project which backs the scim groups (project roles)
+	data.GroupsProjectID, err = queryScimUserMetadata(ctx, q, userID, smetadata.KeyGroupsProjectID)
+	if err != nil {
+		return ctx, err
+	}
+	return smetadata.SetScimContextData(ctx, data), nil
+}
+
+func queryScimUserMetadata(ctx context.Context, q *query.Queries, userID string, key smetadata.Key) (string, error) {
+	metadata, err := q.GetUserMetadataByKey(ctx, false, userID, string(key), false)
 	if err != nil {
 		if zerrors.IsNotFound(err) {
-			return ctx, nil
+			return "", nil
 		}
 
-		return ctx, err
+		return "", err
 	}
 
 	if metadata == nil {
-		return ctx, nil
+		return "", nil
 	}
 
-	data.ProvisioningDomain = string(metadata.Value)
-	if data.ProvisioningDomain != "" {
-		data.ExternalIDScopedMetadataKey = smetadata.ScopeExternalIdKey(data.ProvisioningDomain)
-	}
-	return smetadata.SetScimContextData(ctx, data), nil
+	return string(metadata.Value), nil
 }
@@ -0,0 +1,257 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/scim/metadata"
+	"github.com/zitadel/zitadel/internal/api/scim/resources/filter"
+	"github.com/zitadel/zitadel/internal/api/scim/resources/patch"
+	scim_schemas "github.com/zitadel/zitadel/internal/api/scim/schemas"
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// GroupsHandler maps scim groups to the roles of a project
+// and the members of a group to the user grants of this project.
+// The project is configured per scim service user (metadata.KeyGroupsProjectID),
+// the role key is used as the id of a group.
+type GroupsHandler struct {
+	command         *command.Commands
+	query           *query.Queries
+	filterEvaluator *filter.Evaluator
+	schema          *scim_schemas.ResourceSchema
+}
+
+type ScimGroup struct {
+	*scim_schemas.Resource `scim:"ignoreInSchema"`
+	ID                     string             `json:"id" scim:"ignoreInSchema"`
+	DisplayName            string             `json:"displayName,omitempty" scim:"required"`
+	Members                []*ScimGroupMember `json:"members,omitempty"`
+}
+
+type ScimGroupMember struct {
+	Value   string `json:"value" scim:"required"`
+	Display string `json:"display,omitempty"`
+	Ref     string `json:"$ref,omitempty"`
+	Type    string `json:"type,omitempty"`
+}
+
+func NewGroupsHandler(
+	command *command.Commands,
+	query *query.Queries,
+) ResourceHandler[*ScimGroup] {
+	return &GroupsHandler{
+		command,
+		query,
+		filter.NewEvaluator(scim_schemas.IdGroup),
+		scim_schemas.BuildSchema(scim_schemas.SchemaBuilderArgs{
+			ID:           scim_schemas.IdGroup,
+			Name:         scim_schemas.GroupResourceType,
+			EndpointName: scim_schemas.GroupsResourceType,
+			Description:  "Group",
+			Resource:     new(ScimGroup),
+		}),
+	}
+}
+
+func (g *ScimGroup) GetResource() *scim_schemas.Resource {
+	return g.Resource
+}
+
+func (g *ScimGroup) GetSchemas() []scim_schemas.ScimSchemaType {
+	if g.Resource == nil {
+		return nil
+	}
+
+	return g.Resource.Schemas
+}
+
+func (h *GroupsHandler) Schema() *scim_schemas.ResourceSchema {
+	return h.schema
+}
+
+func (h *GroupsHandler) NewResource() *ScimGroup {
+	return new(ScimGroup)
+}
+
+func (h *GroupsHandler) Create(ctx context.Context, group *ScimGroup) (*ScimGroup, error) {
+	projectID, err := groupsProjectID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if group.DisplayName == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "SCIM-GRP2", "The displayName of a group is mandatory")
+	}
+
+	orgID := authz.GetCtxData(ctx).OrgID
+	details, err := h.command.AddProjectRole(ctx, &command.AddProjectRole{
+		ObjectRoot: newProjectObjectRoot(projectID, orgID),
+		// the display name is used as key, as scim clients (e.g. entra id) identify groups by their name
+		Key:         group.DisplayName,
+		DisplayName: group.DisplayName,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	group.ID = group.DisplayName
+	if err = h.addMembers(ctx, projectID, group.ID, group.Members); err != nil {
+		return nil, err
+	}
+
+	details.ID = group.ID
+	group.Resource = buildResource(ctx, h, details)
+	return group, nil
+}
+
+func (h *GroupsHandler) Replace(ctx context.Context, id string, group *ScimGroup) (*ScimGroup, error) {
+	existingGroup, err := h.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	group.ID = id
+	if err = h.applyChanges(ctx, existingGroup, group); err != nil {
+		return nil, err
+	}
+
+	return h.Get(ctx, id)
+}
+
+func (h *GroupsHandler) Update(ctx context.Context, id string, operations patch.OperationCollection) error {
+	existingGroup, err := h.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	group, err := h.applyPatches(existingGroup, operations)
+	if err != nil {
+		return err
+	}
+
+	// ensure the identity of the group is not modified
+	group.ID = id
+	return h.applyChanges(ctx, existingGroup, group)
+}
+
+func (h *GroupsHandler) Delete(ctx context.Context, id string) error {
+	projectID, err := groupsProjectID(ctx)
+	if err != nil {
+		return err
+	}
+
+	orgID := authz.GetCtxData(ctx).OrgID
+	grants, err := h.queryGroupUserGrants(ctx, projectID, id)
+	if err != nil {
+		return err
+	}
+
+	projectGrants, err := h.query.SearchProjectGrantsByProjectIDAndRoleKey(ctx, projectID, id)
+	if err != nil {
+		return err
+	}
+
+	_, err = h.command.RemoveProjectRole(ctx, projectID, id, orgID, projectGrantsToIDs(projectGrants.ProjectGrants), userGrantsToIDs(grants)...)
+	return err
+}
+
+func (h *GroupsHandler) Get(ctx context.Context, id string) (*ScimGroup, error) {
+	projectID, err := groupsProjectID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	keyQuery, err := query.NewProjectRoleKeySearchQuery(query.TextEquals, id)
+	if err != nil {
+		return nil, err
+	}
+
+	q, err := h.buildProjectRoleQuery(ctx, projectID, query.SearchRequest{Limit: 1}, keyQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	roles, err := h.query.SearchProjectRoles(ctx, false, q, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(roles.ProjectRoles) == 0 {
+		return nil, zerrors.ThrowNotFound(nil, "SCIM-GRP3", "Errors.Project.Role.NotExisting")
+	}
+
+	groups, err := h.mapToScimGroups(ctx, projectID, roles.ProjectRoles)
+	if err != nil {
+		return nil, err
+	}
+	return groups[0], nil
+}
+
+func (h *GroupsHandler) List(ctx context.Context, request *ListRequest) (*ListResponse[*ScimGroup], error) {
+	projectID, err := groupsProjectID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	q, err := h.buildListQuery(ctx, projectID, request)
+	if err != nil {
+		return nil, err
+	}
+
+	roles, err := h.query.SearchProjectRoles(ctx, false, q, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if request.Count == 0 {
+		return NewListResponse(roles.SearchResponse.Count, q.SearchRequest, make([]*ScimGroup, 0)), nil
+	}
+
+	groups, err := h.mapToScimGroups(ctx, projectID, roles.ProjectRoles)
+	if err != nil {
+		return nil, err
+	}
+	return NewListResponse(roles.SearchResponse.Count, q.SearchRequest, groups), nil
+}
+
+// applyChanges updates the display name of the project role
+// and adds / removes the role to / from the user grants of the changed members.
+func (h *GroupsHandler) applyChanges(ctx context.Context, existingGroup, group *ScimGroup) error {
+	projectID, err := groupsProjectID(ctx)
+	if err != nil {
+		return err
+	}
+
+	if group.DisplayName == "" {
+		return zerrors.ThrowInvalidArgument(nil, "SCIM-GRP4", "The displayName of a group is mandatory")
+	}
+
+	if group.DisplayName != existingGroup.DisplayName {
+		_, err = h.command.ChangeProjectRole(ctx, &command.ChangeProjectRole{
+			ObjectRoot:  newProjectObjectRoot(projectID, authz.GetCtxData(ctx).OrgID),
+			Key:         existingGroup.ID,
+			DisplayName: group.DisplayName,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	addedMembers, removedMembers := diffGroupMembers(existingGroup.Members, group.Members)
+	if err = h.addMembers(ctx, projectID, existingGroup.ID, addedMembers); err != nil {
+		return err
+	}
+	return h.removeMembers(ctx, projectID, existingGroup.ID, removedMembers)
+}
+
+func groupsProjectID(ctx context.Context) (string, error) {
+	projectID := metadata.GetScimContextData(ctx).GroupsProjectID
+	if projectID == "" {
+		return "", zerrors.ThrowPreconditionFailedf(nil, "SCIM-GRP1", "No project is configured for scim groups, set the metadata %s of the scim service user", metadata.KeyGroupsProjectID)
+	}
+
+	return projectID, nil
+}
@@ -0,0 +1,257 @@
+package resources
+
+import (
+	"context"
+	"slices"
+	"strconv"
+
+	"github.com/muhlemmer/gu"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/scim/metadata"
+	"github.com/zitadel/zitadel/internal/api/scim/schemas"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func (h *GroupsHandler) mapToScimGroups(ctx context.Context, projectID string, roles []*query.ProjectRole) ([]*ScimGroup, error) {
+	keys := make([]string, len(roles))
+	for i, role := range roles {
+		keys[i] = role.Key
+	}
+
+	grants, err := h.queryGroupUserGrants(ctx, projectID, keys...)
+	if err != nil {
+		return nil, err
+	}
+
+	groups := make([]*ScimGroup, len(roles))
+	for i, role := range roles {
+		groups[i] = &ScimGroup{
+			Resource:    h.buildResourceForProjectRole(ctx, role),
+			ID:          role.Key,
+			DisplayName: role.DisplayName,
+			Members:     mapToScimGroupMembers(ctx, role.Key, grants),
+		}
+	}
+	return groups, nil
+}
+
+func mapToScimGroupMembers(ctx context.Context, roleKey string, grants []*query.UserGrant) []*ScimGroupMember {
+	members := make([]*ScimGroupMember, 0)
+	for _, grant := range grants {
+		if !slices.Contains(grant.Roles, roleKey) {
+			continue
+		}
+
+		members = append(members, &ScimGroupMember{
+			Value:   grant.UserID,
+			Display: grant.DisplayName,
+			Ref:     schemas.BuildLocationForResource(ctx, schemas.UsersResourceType, grant.UserID),
+			Type:    string(schemas.UserResourceType),
+		})
+	}
+	return members
+}
+
+func (h *GroupsHandler) buildResourceForProjectRole(ctx context.Context, role *query.ProjectRole) *schemas.Resource {
+	return &schemas.Resource{
+		ID:      role.Key,
+		Schemas: []schemas.ScimSchemaType{schemas.IdGroup},
+		Meta: &schemas.ResourceMeta{
+			ResourceType: schemas.GroupResourceType,
+			Created:      gu.Ptr(role.CreationDate.UTC()),
+			LastModified: gu.Ptr(role.ChangeDate.UTC()),
+			Version:      strconv.FormatUint(role.Sequence, 10),
+			Location:     schemas.BuildLocationForResource(ctx, h.schema.PluralName, role.Key),
+		},
+	}
+}
+
+// queryGroupUserGrants returns all user grants of the organization on the groups project,
+// which contain at least one of the role keys.
+func (h *GroupsHandler) queryGroupUserGrants(ctx context.Context, projectID string, roleKeys ...string) ([]*query.UserGrant, error) {
+	if len(roleKeys) == 0 {
+		return nil, nil
+	}
+
+	projectQuery, err := query.NewUserGrantProjectIDSearchQuery(projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	orgQuery, err := query.NewUserGrantResourceOwnerSearchQuery(authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+
+	roleQueries := make([]query.SearchQuery, len(roleKeys))
+	for i, key := range roleKeys {
+		roleQueries[i], err = query.NewUserGrantRoleQuery(key)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	rolesQuery, err := query.NewOrQuery(roleQueries...)
+	if err != nil {
+		return nil, err
+	}
+
+	grants, err := h.query.UserGrants(ctx, &query.UserGrantsQueries{
+		Queries: []query.SearchQuery{projectQuery, orgQuery, rolesQuery},
+	}, true)
+	if err != nil {
+		return nil, err
+	}
+	return grants.UserGrants, nil
+}
+
+// queryMemberUserGrant returns the user grant of the user on the groups project
+// or nil if the user is not granted at all.
+func (h *GroupsHandler) queryMemberUserGrant(ctx context.Context, projectID, userID string) (*query.UserGrant, error) {
+	userQuery, err := query.NewUserGrantUserIDSearchQuery(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	projectQuery, err := query.NewUserGrantProjectIDSearchQuery(projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	orgQuery, err := query.NewUserGrantResourceOwnerSearchQuery(authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+
+	grant, err := h.query.UserGrant(ctx, true, userQuery, projectQuery, orgQuery)
+	if zerrors.IsNotFound(err) {
+		return nil, nil
+	}
+	return grant, err
+}
+
+// addMembers adds the role to the user grants of the members,
+// if a member is not yet granted on the project, a new user grant is created.
+func (h *GroupsHandler) addMembers(ctx context.Context, projectID, roleKey string, members []*ScimGroupMember) error {
+	orgID := authz.GetCtxData(ctx).OrgID
+	for _, member := range members {
+		userID, err := metadata.ResolveScimBulkIDIfNeeded(ctx, member.Value)
+		if err != nil {
+			return err
+		}
+
+		grant, err := h.queryMemberUserGrant(ctx, projectID, userID)
+		if err != nil {
+			return err
+		}
+
+		if grant == nil {
+			_, err = h.command.AddUserGrant(ctx, &domain.UserGrant{
+				UserID:    userID,
+				ProjectID: projectID,
+				RoleKeys:  []string{roleKey},
+			}, orgID)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		if slices.Contains(grant.Roles, roleKey) {
+			continue
+		}
+
+		_, err = h.command.ChangeUserGrant(ctx, &domain.UserGrant{
+			ObjectRoot: models.ObjectRoot{AggregateID: grant.ID},
+			RoleKeys:   append(slices.Clone(grant.Roles), roleKey),
+		}, orgID)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// removeMembers removes the role from the user grants of the members,
+// user grants without any remaining role are removed.
+func (h *GroupsHandler) removeMembers(ctx context.Context, projectID, roleKey string, members []*ScimGroupMember) error {
+	orgID := authz.GetCtxData(ctx).OrgID
+	for _, member := range members {
+		userID, err := metadata.ResolveScimBulkIDIfNeeded(ctx, member.Value)
+		if err != nil {
+			return err
+		}
+
+		grant, err := h.queryMemberUserGrant(ctx, projectID, userID)
+		if err != nil {
+			return err
+		}
+
+		if grant == nil || !slices.Contains(grant.Roles, roleKey) {
+			continue
+		}
+
+		roleKeys := slices.DeleteFunc(slices.Clone(grant.Roles), func(key string) bool {
+			return key == roleKey
+		})
+		if len(roleKeys) == 0 {
+			_, err = h.command.RemoveUserGrant(ctx, grant.ID, orgID)
+		} else {
+			_, err = h.command.ChangeUserGrant(ctx, &domain.UserGrant{
+				ObjectRoot: models.ObjectRoot{AggregateID: grant.ID},
+				RoleKeys:   roleKeys,
+			}, orgID)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// diffGroupMembers returns the members which are only present in the updated members (added)
+// and the members which are only present in the existing members (removed).
+func diffGroupMembers(existingMembers, members []*ScimGroupMember) (added, removed []*ScimGroupMember) {
+	existingIDs := make(map[string]bool, len(existingMembers))
+	for _, member := range existingMembers {
+		existingIDs[member.Value] = true
+	}
+
+	ids := make(map[string]bool, len(members))
+	for _, member := range members {
+		if member == nil || ids[member.Value] {
+			continue
+		}
+
+		ids[member.Value] = true
+		if !existingIDs[member.Value] {
+			added = append(added, member)
+		}
+	}
+
+	for _, member := range existingMembers {
+		if !ids[member.Value] {
+			removed = append(removed, member)
+		}
+	}
+	return added, removed
+}
+
+func newProjectObjectRoot(projectID, orgID string) models.ObjectRoot {
+	return models.ObjectRoot{
+		AggregateID:   projectID,
+		ResourceOwner: orgID,
+	}
+}
+
+func projectGrantsToIDs(grants []*query.ProjectGrant) []string {
+	ids := make([]string, len(grants))
+	for i, grant := range grants {
+		ids[i] = grant.GrantID
+	}
+	return ids
+}
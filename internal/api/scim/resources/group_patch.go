@@ -0,0 +1,46 @@
+package resources
+
+import (
+	"github.com/zitadel/zitadel/internal/api/scim/resources/filter"
+	"github.com/zitadel/zitadel/internal/api/scim/resources/patch"
+)
+
+// groupPatcher applies the patches to a copy of the group,
+// the changes are detected afterward by comparing it to the existing group.
+type groupPatcher struct {
+	handler *GroupsHandler
+}
+
+func (h *GroupsHandler) applyPatches(existingGroup *ScimGroup, operations patch.OperationCollection) (*ScimGroup, error) {
+	group := &ScimGroup{
+		Resource:    existingGroup.Resource,
+		ID:          existingGroup.ID,
+		DisplayName: existingGroup.DisplayName,
+		Members:     make([]*ScimGroupMember, len(existingGroup.Members)),
+	}
+	for i, member := range existingGroup.Members {
+		m := *member
+		group.Members[i] = &m
+	}
+
+	if err := operations.Apply(&groupPatcher{handler: h}, group); err != nil {
+		return nil, err
+	}
+	return group, nil
+}
+
+func (p *groupPatcher) FilterEvaluator() *filter.Evaluator {
+	return p.handler.filterEvaluator
+}
+
+func (p *groupPatcher) Added([]string) error {
+	return nil
+}
+
+func (p *groupPatcher) Replaced([]string) error {
+	return nil
+}
+
+func (p *groupPatcher) Removed([]string) error {
+	return nil
+}
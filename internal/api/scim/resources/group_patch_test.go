@@ -0,0 +1,211 @@
+package resources
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/api/scim/resources/filter"
+	"github.com/zitadel/zitadel/internal/api/scim/resources/patch"
+	"github.com/zitadel/zitadel/internal/test"
+)
+
+func TestGroupsHandler_applyPatches(t *testing.T) {
+	tests := []struct {
+		name        string
+		op          *patch.Operation
+		want        *ScimGroup
+		wantAdded   []string
+		wantRemoved []string
+		wantErr     bool
+	}{
+		{
+			name: "replace display name",
+			op: &patch.Operation{
+				Operation: patch.OperationTypeReplace,
+				Path:      test.Must(filter.ParsePath("displayName")),
+				Value:     json.RawMessage(`"Administrators"`),
+			},
+			want: &ScimGroup{
+				ID:          "admins",
+				DisplayName: "Administrators",
+				Members: []*ScimGroupMember{
+					{Value: "user1"},
+					{Value: "user2"},
+				},
+			},
+		},
+		{
+			name: "add members",
+			op: &patch.Operation{
+				Operation: patch.OperationTypeAdd,
+				Path:      test.Must(filter.ParsePath("members")),
+				Value:     json.RawMessage(`[{ "value": "user3" }, { "value": "user4" }]`),
+			},
+			want: &ScimGroup{
+				ID:          "admins",
+				DisplayName: "admins",
+				Members: []*ScimGroupMember{
+					{Value: "user1"},
+					{Value: "user2"},
+					{Value: "user3"},
+					{Value: "user4"},
+				},
+			},
+			wantAdded: []string{"user3", "user4"},
+		},
+		{
+			name: "add members without path",
+			op: &patch.Operation{
+				Operation: patch.OperationTypeAdd,
+				Value:     json.RawMessage(`{ "members": [{ "value": "user3" }] }`),
+			},
+			want: &ScimGroup{
+				ID:          "admins",
+				DisplayName: "admins",
+				Members: []*ScimGroupMember{
+					{Value: "user1"},
+					{Value: "user2"},
+					{Value: "user3"},
+				},
+			},
+			wantAdded: []string{"user3"},
+		},
+		{
+			name: "remove member by filter",
+			op: &patch.Operation{
+				Operation: patch.OperationTypeRemove,
+				Path:      test.Must(filter.ParsePath(`members[value eq "user1"]`)),
+			},
+			want: &ScimGroup{
+				ID:          "admins",
+				DisplayName: "admins",
+				Members: []*ScimGroupMember{
+					{Value: "user2"},
+				},
+			},
+			wantRemoved: []string{"user1"},
+		},
+		{
+			name: "remove all members",
+			op: &patch.Operation{
+				Operation: patch.OperationTypeRemove,
+				Path:      test.Must(filter.ParsePath("members")),
+			},
+			want: &ScimGroup{
+				ID:          "admins",
+				DisplayName: "admins",
+			},
+			wantRemoved: []string{"user1", "user2"},
+		},
+		{
+			name: "replace members",
+			op: &patch.Operation{
+				Operation: patch.OperationTypeReplace,
+				Path:      test.Must(filter.ParsePath("members")),
+				Value:     json.RawMessage(`[{ "value": "user2" }, { "value": "user3" }]`),
+			},
+			want: &ScimGroup{
+				ID:          "admins",
+				DisplayName: "admins",
+				Members: []*ScimGroupMember{
+					{Value: "user2"},
+					{Value: "user3"},
+				},
+			},
+			wantAdded:   []string{"user3"},
+			wantRemoved: []string{"user1"},
+		},
+		{
+			name: "unknown path",
+			op: &patch.Operation{
+				Operation: patch.OperationTypeAdd,
+				Path:      test.Must(filter.ParsePath("fooBar")),
+				Value:     json.RawMessage(`"foo"`),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			existingGroup := &ScimGroup{
+				ID:          "admins",
+				DisplayName: "admins",
+				Members: []*ScimGroupMember{
+					{Value: "user1"},
+					{Value: "user2"},
+				},
+			}
+
+			handler := NewGroupsHandler(nil, nil).(*GroupsHandler)
+			got, err := handler.applyPatches(existingGroup, patch.OperationCollection{tt.op})
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+
+			// the existing group must not be modified
+			assert.Len(t, existingGroup.Members, 2)
+			assert.Equal(t, "admins", existingGroup.DisplayName)
+
+			added, removed := diffGroupMembers(existingGroup.Members, got.Members)
+			assert.Equal(t, tt.wantAdded, groupMemberValues(added))
+			assert.Equal(t, tt.wantRemoved, groupMemberValues(removed))
+		})
+	}
+}
+
+func Test_diffGroupMembers(t *testing.T) {
+	tests := []struct {
+		name            string
+		existingMembers []*ScimGroupMember
+		members         []*ScimGroupMember
+		wantAdded       []string
+		wantRemoved     []string
+	}{
+		{
+			name: "no members",
+		},
+		{
+			name:            "unchanged",
+			existingMembers: []*ScimGroupMember{{Value: "user1"}},
+			members:         []*ScimGroupMember{{Value: "user1", Display: "User 1"}},
+		},
+		{
+			name:      "duplicate members",
+			members:   []*ScimGroupMember{{Value: "user1"}, {Value: "user1"}},
+			wantAdded: []string{"user1"},
+		},
+		{
+			name:            "added and removed",
+			existingMembers: []*ScimGroupMember{{Value: "user1"}, {Value: "user2"}},
+			members:         []*ScimGroupMember{{Value: "user2"}, {Value: "user3"}},
+			wantAdded:       []string{"user3"},
+			wantRemoved:     []string{"user1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			added, removed := diffGroupMembers(tt.existingMembers, tt.members)
+			assert.Equal(t, tt.wantAdded, groupMemberValues(added))
+			assert.Equal(t, tt.wantRemoved, groupMemberValues(removed))
+		})
+	}
+}
+
+func groupMemberValues(members []*ScimGroupMember) []string {
+	if len(members) == 0 {
+		return nil
+	}
+
+	values := make([]string, len(members))
+	for i, member := range members {
+		values[i] = member.Value
+	}
+	return values
+}
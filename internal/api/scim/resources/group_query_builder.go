@@ -0,0 +1,70 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/scim/resources/filter"
+	"github.com/zitadel/zitadel/internal/query"
+)
+
+// groupFieldPathColumnMapping maps lowercase json field names of the scim group to the matching column in the projection
+// filtering by members is not supported
+// to ensure database performance.
+var groupFieldPathColumnMapping = filter.FieldPathMapping{
+	"meta.created": {
+		Column:    query.ProjectRoleColumnCreationDate,
+		FieldType: filter.FieldTypeTimestamp,
+	},
+	"meta.lastmodified": {
+		Column:    query.ProjectRoleColumnChangeDate,
+		FieldType: filter.FieldTypeTimestamp,
+	},
+	"id": {
+		Column:    query.ProjectRoleColumnKey,
+		FieldType: filter.FieldTypeString,
+	},
+	"displayname": {
+		Column:          query.ProjectRoleColumnDisplayName,
+		FieldType:       filter.FieldTypeString,
+		CaseInsensitive: true,
+	},
+}
+
+func (h *GroupsHandler) buildListQuery(ctx context.Context, projectID string, request *ListRequest) (*query.ProjectRoleSearchQueries, error) {
+	searchRequest, err := request.toSearchRequest(query.ProjectRoleColumnKey, groupFieldPathColumnMapping)
+	if err != nil {
+		return nil, err
+	}
+
+	if request.Filter == nil {
+		return h.buildProjectRoleQuery(ctx, projectID, searchRequest)
+	}
+
+	filterQuery, err := request.Filter.BuildQuery(ctx, h.schema.ID, groupFieldPathColumnMapping)
+	if err != nil {
+		return nil, err
+	}
+
+	return h.buildProjectRoleQuery(ctx, projectID, searchRequest, filterQuery)
+}
+
+func (h *GroupsHandler) buildProjectRoleQuery(ctx context.Context, projectID string, searchRequest query.SearchRequest, queries ...query.SearchQuery) (*query.ProjectRoleSearchQueries, error) {
+	// the groups are always limited to the configured project
+	projectQuery, err := query.NewProjectRoleProjectIDSearchQuery(projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	// the scim service is always limited to one organization
+	// the organization is the resource owner
+	orgIDQuery, err := query.NewProjectRoleResourceOwnerSearchQuery(authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &query.ProjectRoleSearchQueries{
+		SearchRequest: searchRequest,
+		Queries:       append([]query.SearchQuery{projectQuery, orgIDQuery}, queries...),
+	}, nil
+}
@@ -0,0 +1,55 @@
+package login
+
+import (
+	"net/http"
+
+	http_mw "github.com/zitadel/zitadel/internal/api/http/middleware"
+	"github.com/zitadel/zitadel/internal/domain"
+)
+
+const (
+	tmplConsent = "consent"
+)
+
+type consentData struct {
+	userData
+	AppName string
+	Scopes  []string
+}
+
+type consentFormData struct {
+	Deny bool `schema:"deny"`
+}
+
+func (l *Login) handleConsent(w http.ResponseWriter, r *http.Request) {
+	data := new(consentFormData)
+	authReq, err := l.ensureAuthRequestAndParseData(r, data)
+	if err != nil {
+		l.renderError(w, r, authReq, err)
+		return
+	}
+	userAgentID, _ := http_mw.UserAgentIDFromCtx(r.Context())
+	if data.Deny {
+		err = l.authRepo.DenyConsent(r.Context(), authReq.ID, userAgentID)
+	} else {
+		err = l.authRepo.GrantConsent(setContext(r.Context(), authReq.UserOrgID), authReq.ID, userAgentID)
+	}
+	if err != nil {
+		l.renderError(w, r, authReq, err)
+		return
+	}
+	l.renderNextStep(w, r, authReq)
+}
+
+func (l *Login) renderConsent(w http.ResponseWriter, r *http.Request, authReq *domain.AuthRequest, step *domain.ConsentStep, err error) {
+	translator := l.getTranslator(r.Context(), authReq)
+	data := &consentData{
+		userData: l.getUserData(r, authReq, translator, "Consent.Title", "Consent.Description", err),
+		AppName:  authReq.ApplicationID,
+		Scopes:   step.Scopes,
+	}
+	if app, appErr := l.query.AppByOIDCClientID(r.Context(), authReq.ApplicationID); appErr == nil {
+		data.AppName = app.Name
+	}
+	l.renderer.RenderTemplate(w, r, translator, l.renderer.Templates[tmplConsent], data, nil)
+}
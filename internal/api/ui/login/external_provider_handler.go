@@ -498,6 +498,13 @@ func (l *Login) handleExternalUserAuthenticated(
 			return
 		}
 	}
+	if ldapUser, ok := user.(*ldap.User); ok && provider.LDAPIDPTemplate != nil && provider.LDAPIDPTemplate.GroupSync != nil {
+		err = l.command.SyncLDAPUserGrants(setContext(r.Context(), authReq.UserOrgID), provider.ID, authReq.UserID, ldapUser.GetGroups())
+		if err != nil && !userLinked {
+			l.renderError(w, r, authReq, err)
+			return
+		}
+	}
 	callback(w, r, authReq)
 }
 
@@ -989,6 +996,9 @@ func (l *Login) ldapProvider(ctx context.Context, identityProvider *query.IDPTem
 	if identityProvider.LDAPIDPTemplate.LDAPAttributes.ProfileAttribute != "" {
 		opts = append(opts, ldap.WithProfileAttribute(identityProvider.LDAPIDPTemplate.LDAPAttributes.ProfileAttribute))
 	}
+	if groupSync := identityProvider.LDAPIDPTemplate.GroupSync; groupSync != nil && len(groupSync.GroupMappings) > 0 {
+		opts = append(opts, ldap.WithGroupSearch(groupSync.GroupBaseDN, groupSync.GroupObjectClasses, groupSync.GroupMemberAttribute))
+	}
 	return ldap.New(
 		identityProvider.Name,
 		identityProvider.Servers,
@@ -52,7 +52,8 @@ type Config struct {
 	AssetCache         middleware.CacheConfig
 
 	// LoginV2
-	DefaultOTPEmailURLV2 string
+	DefaultOTPEmailURLV2        string
+	DefaultBackChannelAuthURLV2 string
 }
 
 const (
@@ -8,7 +8,8 @@ import (
 )
 
 const (
-	tmplMFAVerify = "mfaverify"
+	tmplMFAVerify             = "mfaverify"
+	tmplMFAVerifyRecoveryCode = "mfaverifyrecoverycode"
 )
 
 type mfaVerifyFormData struct {
@@ -49,6 +50,22 @@ func (l *Login) handleMFAVerify(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 	}
+	if data.MFAType == domain.MFATypeRecoveryCodes {
+		userAgentID, _ := http_mw.UserAgentIDFromCtx(r.Context())
+		err = l.authRepo.VerifyMFARecoveryCode(setContext(r.Context(), authReq.UserOrgID), authReq.UserID, authReq.UserOrgID, data.Code, authReq.ID, userAgentID, domain.BrowserInfoFromRequest(r))
+
+		metadata, actionErr := l.runPostInternalAuthenticationActions(authReq, r, authMethodRecoveryCode, err)
+		if err == nil && actionErr == nil && len(metadata) > 0 {
+			_, err = l.command.BulkSetUserMetadata(r.Context(), authReq.UserID, authReq.UserOrgID, metadata...)
+		} else if actionErr != nil && err == nil {
+			err = actionErr
+		}
+
+		if err != nil {
+			l.renderMFAVerifySelected(w, r, authReq, step, domain.MFATypeRecoveryCodes, err)
+			return
+		}
+	}
 	l.renderNextStep(w, r, authReq)
 }
 
@@ -86,6 +103,13 @@ func (l *Login) renderMFAVerifySelected(w http.ResponseWriter, r *http.Request,
 	case domain.MFATypeOTPEmail:
 		l.handleOTPVerification(w, r, authReq, verificationStep.MFAProviders, domain.MFATypeOTPEmail, nil)
 		return
+	case domain.MFATypeRecoveryCodes:
+		data.MFAProviders = removeSelectedProviderFromList(verificationStep.MFAProviders, domain.MFATypeRecoveryCodes)
+		data.SelectedMFAProvider = domain.MFATypeRecoveryCodes
+		data.Title = translator.LocalizeWithoutArgs("VerifyMFARecoveryCode.Title")
+		data.Description = translator.LocalizeWithoutArgs("VerifyMFARecoveryCode.Description")
+		l.renderer.RenderTemplate(w, r, translator, l.renderer.Templates[tmplMFAVerifyRecoveryCode], data, nil)
+		return
 	default:
 		l.renderError(w, r, authReq, err)
 		return
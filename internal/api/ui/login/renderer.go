@@ -56,6 +56,7 @@ func CreateRenderer(pathPrefix string, staticStorage static.Storage, cookieName
 		tmplPasswordlessRegistrationDone: "passwordless_registration_done.html",
 		tmplPasswordlessPrompt:           "passwordless_prompt.html",
 		tmplMFAVerify:                    "mfa_verify_totp.html",
+		tmplMFAVerifyRecoveryCode:        "mfa_verify_recovery_code.html",
 		tmplMFAPrompt:                    "mfa_prompt.html",
 		tmplMFAInitVerify:                "mfa_init_otp.html",
 		tmplMFASMSInit:                   "mfa_init_otp_sms.html",
@@ -85,6 +86,7 @@ func CreateRenderer(pathPrefix string, staticStorage static.Storage, cookieName
 		tmplLDAPLogin:                    "ldap_login.html",
 		tmplDeviceAuthUserCode:           "device_usercode.html",
 		tmplDeviceAuthAction:             "device_action.html",
+		tmplConsent:                      "consent.html",
 	}
 	funcs := map[string]interface{}{
 		"resourceUrl": func(file string) string {
@@ -156,6 +158,9 @@ func CreateRenderer(pathPrefix string, staticStorage static.Storage, cookieName
 		"passwordlessPromptUrl": func() string {
 			return path.Join(r.pathPrefix, EndpointPasswordlessPrompt)
 		},
+		"consentUrl": func() string {
+			return path.Join(r.pathPrefix, EndpointConsent)
+		},
 		"passwordResetUrl": func(id string) string {
 			return path.Join(r.pathPrefix, fmt.Sprintf("%s?%s=%s", EndpointPasswordReset, QueryAuthRequestID, id))
 		},
@@ -336,6 +341,8 @@ func (l *Login) chooseNextStep(w http.ResponseWriter, r *http.Request, authReq *
 		l.renderInternalError(w, r, authReq, zerrors.ThrowPreconditionFailed(nil, "APP-m92d", "Errors.User.ProjectRequired"))
 	case *domain.VerifyInviteStep:
 		l.renderInviteUser(w, r, authReq, "", "", "", "", nil)
+	case *domain.ConsentStep:
+		l.renderConsent(w, r, authReq, step, err)
 	default:
 		l.renderInternalError(w, r, authReq, zerrors.ThrowInternal(nil, "APP-ds3QF", "step no possible"))
 	}
@@ -58,6 +58,7 @@ type AuthRequestRepo struct {
 	UserGrantProvider         userGrantProvider
 	ProjectProvider           projectProvider
 	ApplicationProvider       applicationProvider
+	ConsentProvider           consentProvider
 	CustomTextProvider        customTextProvider
 	PasswordReset             passwordReset
 	PasswordChecker           passwordChecker
@@ -132,6 +133,10 @@ type applicationProvider interface {
 	AppByOIDCClientID(context.Context, string) (*query.App, error)
 }
 
+type consentProvider interface {
+	OIDCConsentByUserAndClientID(ctx context.Context, shouldTriggerBulk bool, userID, clientID string) (*query.OIDCConsent, error)
+}
+
 type customTextProvider interface {
 	CustomTextListByTemplate(ctx context.Context, aggregateID string, text string, withOwnerRemoved bool) (texts *query.CustomTexts, err error)
 }
@@ -449,6 +454,16 @@ func (repo *AuthRequestRepo) VerifyMFAOTPEmail(ctx context.Context, userID, reso
 	return repo.Command.HumanCheckOTPEmail(ctx, userID, code, resourceOwner, request.WithCurrentInfo(info))
 }
 
+func (repo *AuthRequestRepo) VerifyMFARecoveryCode(ctx context.Context, userID, resourceOwner, code, authRequestID, userAgentID string, info *domain.BrowserInfo) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+	request, err := repo.getAuthRequestEnsureUser(ctx, authRequestID, userAgentID, userID)
+	if err != nil {
+		return err
+	}
+	return repo.Command.HumanCheckRecoveryCode(ctx, userID, code, resourceOwner, request.WithCurrentInfo(info))
+}
+
 func (repo *AuthRequestRepo) BeginMFAU2FLogin(ctx context.Context, userID, resourceOwner, authRequestID, userAgentID string) (login *domain.WebAuthNLogin, err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
@@ -572,6 +587,43 @@ func (repo *AuthRequestRepo) RequestLocalAuth(ctx context.Context, authReqID, us
 	return repo.AuthRequests.UpdateAuthRequest(ctx, request)
 }
 
+func (repo *AuthRequestRepo) GrantConsent(ctx context.Context, authReqID, userAgentID string) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+	request, err := repo.getAuthRequest(ctx, authReqID, userAgentID)
+	if err != nil {
+		return err
+	}
+	oidcRequest, ok := request.Request.(*domain.AuthRequestOIDC)
+	if !ok {
+		return zerrors.ThrowPreconditionFailed(nil, "EVENT-aeX6o", "Errors.AuthRequest.RequestTypeNotSupported")
+	}
+	if request.UserID == "" {
+		return zerrors.ThrowPreconditionFailed(nil, "EVENT-Sae3o", "Errors.User.NotFound")
+	}
+	app, err := repo.ApplicationProvider.AppByOIDCClientID(ctx, request.ApplicationID)
+	if err != nil {
+		return err
+	}
+	_, err = repo.Command.GrantOIDCConsent(ctx, &command.OIDCConsent{
+		UserID:    request.UserID,
+		ClientID:  request.ApplicationID,
+		AppID:     app.ID,
+		ProjectID: app.ProjectID,
+		Scope:     oidcRequest.Scopes,
+	})
+	return err
+}
+
+func (repo *AuthRequestRepo) DenyConsent(ctx context.Context, authReqID, userAgentID string) error {
+	request, err := repo.getAuthRequest(ctx, authReqID, userAgentID)
+	if err != nil {
+		return err
+	}
+	request.ConsentDenied = true
+	return repo.AuthRequests.UpdateAuthRequest(ctx, request)
+}
+
 func (repo *AuthRequestRepo) AutoRegisterExternalUser(ctx context.Context, registerUser *domain.Human, externalIDP *domain.UserIDPLink, orgMemberRoles []string, authReqID, userAgentID, resourceOwner string, metadatas []*domain.Metadata, info *domain.BrowserInfo) (err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
@@ -1129,7 +1181,6 @@ func (repo *AuthRequestRepo) nextSteps(ctx context.Context, request *domain.Auth
 	if request.LinkingUsers != nil && len(request.LinkingUsers) != 0 {
 		return append(steps, &domain.LinkUsersStep{}), nil
 	}
-	//PLANNED: consent step
 
 	missing, err := projectRequired(ctx, request, repo.ProjectProvider)
 	if err != nil {
@@ -1147,6 +1198,17 @@ func (repo *AuthRequestRepo) nextSteps(ctx context.Context, request *domain.Auth
 		return append(steps, &domain.GrantRequiredStep{}), nil
 	}
 
+	if request.ConsentDenied {
+		return append(steps, &domain.RedirectToCallbackStep{}), nil
+	}
+	missingScopes, err := repo.consentRequired(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	if len(missingScopes) > 0 {
+		return append(steps, &domain.ConsentStep{Scopes: missingScopes}), nil
+	}
+
 	ok, err = repo.hasSucceededPage(ctx, request, repo.ApplicationProvider)
 	if err != nil {
 		return nil, err
@@ -1157,6 +1219,34 @@ func (repo *AuthRequestRepo) nextSteps(ctx context.Context, request *domain.Auth
 	return append(steps, &domain.RedirectToCallbackStep{}), nil
 }
 
+// consentRequired returns the requested scopes the user did not yet consent to,
+// if the application requires the consent of the user.
+func (repo *AuthRequestRepo) consentRequired(ctx context.Context, request *domain.AuthRequest) (_ []string, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	oidcRequest, ok := request.Request.(*domain.AuthRequestOIDC)
+	if !ok {
+		return nil, nil
+	}
+	app, err := repo.ApplicationProvider.AppByOIDCClientID(ctx, request.ApplicationID)
+	if err != nil {
+		return nil, err
+	}
+	if app.OIDCConfig == nil || !app.OIDCConfig.ConsentRequired {
+		return nil, nil
+	}
+	var granted []string
+	consent, err := repo.ConsentProvider.OIDCConsentByUserAndClientID(ctx, true, request.UserID, request.ApplicationID)
+	if err != nil && !zerrors.IsNotFound(err) {
+		return nil, err
+	}
+	if consent != nil {
+		granted = consent.Scope
+	}
+	return domain.MissingConsentScopes(granted, oidcRequest.Scopes), nil
+}
+
 func checkForAllowedIDPs(allowedIDPs []*domain.IDPProvider, idps []*query.IDPUserLink) (_ []string) {
 	allowedLinkedIDPs := make([]string, 0, len(idps))
 	// only use allowed linked idps
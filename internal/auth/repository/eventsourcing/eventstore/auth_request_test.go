@@ -305,6 +305,17 @@ func (m *mockApp) AppByOIDCClientID(ctx context.Context, id string) (*query.App,
 	return nil, zerrors.ThrowNotFound(nil, "ERROR", "error")
 }
 
+type mockConsent struct {
+	scope []string
+}
+
+func (m *mockConsent) OIDCConsentByUserAndClientID(ctx context.Context, shouldTriggerBulk bool, userID, clientID string) (*query.OIDCConsent, error) {
+	if m.scope != nil {
+		return &query.OIDCConsent{UserID: userID, ClientID: clientID, Scope: m.scope}, nil
+	}
+	return nil, zerrors.ThrowNotFound(nil, "ERROR", "error")
+}
+
 type mockIDPUserLinks struct {
 	idps []*query.IDPUserLink
 }
@@ -354,6 +365,7 @@ func TestAuthRequestRepo_nextSteps(t *testing.T) {
 		userGrantProvider         userGrantProvider
 		projectProvider           projectProvider
 		applicationProvider       applicationProvider
+		consentProvider           consentProvider
 		loginPolicyProvider       loginPolicyViewProvider
 		lockoutPolicyProvider     lockoutPolicyViewProvider
 		idpUserLinksProvider      idpUserLinksProvider
@@ -1991,6 +2003,159 @@ func TestAuthRequestRepo_nextSteps(t *testing.T) {
 			[]domain.NextStep{&domain.RedirectToCallbackStep{}},
 			nil,
 		},
+		{
+			"consent required and not given, consent step",
+			fields{
+				userSessionViewProvider: &mockViewUserSession{
+					PasswordVerification:     testNow.Add(-5 * time.Minute),
+					SecondFactorVerification: testNow.Add(-5 * time.Minute),
+				},
+				userViewProvider: &mockViewUser{
+					PasswordSet:     true,
+					IsEmailVerified: true,
+					MFAMaxSetUp:     int32(domain.MFALevelSecondFactor),
+				},
+				userEventProvider:   &mockEventUser{},
+				orgViewProvider:     &mockViewOrg{State: domain.OrgStateActive},
+				userGrantProvider:   &mockUserGrants{},
+				projectProvider:     &mockProject{},
+				applicationProvider: &mockApp{app: &query.App{OIDCConfig: &query.OIDCApp{AppType: domain.OIDCApplicationTypeWeb, ConsentRequired: true}}},
+				consentProvider:     &mockConsent{},
+				lockoutPolicyProvider: &mockLockoutPolicy{
+					policy: &query.LockoutPolicy{
+						ShowFailures: true,
+					},
+				},
+				idpUserLinksProvider: &mockIDPUserLinks{},
+			},
+			args{&domain.AuthRequest{
+				UserID:  "UserID",
+				Request: &domain.AuthRequestOIDC{Scopes: []string{"openid", "profile"}},
+				LoginPolicy: &domain.LoginPolicy{
+					AllowUsernamePassword:     true,
+					SecondFactors:             []domain.SecondFactorType{domain.SecondFactorTypeTOTP},
+					PasswordCheckLifetime:     10 * 24 * time.Hour,
+					SecondFactorCheckLifetime: 18 * time.Hour,
+				},
+			}, false},
+			[]domain.NextStep{&domain.ConsentStep{Scopes: []string{"openid", "profile"}}},
+			nil,
+		},
+		{
+			"consent required and additional scope requested, consent step",
+			fields{
+				userSessionViewProvider: &mockViewUserSession{
+					PasswordVerification:     testNow.Add(-5 * time.Minute),
+					SecondFactorVerification: testNow.Add(-5 * time.Minute),
+				},
+				userViewProvider: &mockViewUser{
+					PasswordSet:     true,
+					IsEmailVerified: true,
+					MFAMaxSetUp:     int32(domain.MFALevelSecondFactor),
+				},
+				userEventProvider:   &mockEventUser{},
+				orgViewProvider:     &mockViewOrg{State: domain.OrgStateActive},
+				userGrantProvider:   &mockUserGrants{},
+				projectProvider:     &mockProject{},
+				applicationProvider: &mockApp{app: &query.App{OIDCConfig: &query.OIDCApp{AppType: domain.OIDCApplicationTypeWeb, ConsentRequired: true}}},
+				consentProvider:     &mockConsent{scope: []string{"openid"}},
+				lockoutPolicyProvider: &mockLockoutPolicy{
+					policy: &query.LockoutPolicy{
+						ShowFailures: true,
+					},
+				},
+				idpUserLinksProvider: &mockIDPUserLinks{},
+			},
+			args{&domain.AuthRequest{
+				UserID:  "UserID",
+				Request: &domain.AuthRequestOIDC{Scopes: []string{"openid", "email"}},
+				LoginPolicy: &domain.LoginPolicy{
+					AllowUsernamePassword:     true,
+					SecondFactors:             []domain.SecondFactorType{domain.SecondFactorTypeTOTP},
+					PasswordCheckLifetime:     10 * 24 * time.Hour,
+					SecondFactorCheckLifetime: 18 * time.Hour,
+				},
+			}, false},
+			[]domain.NextStep{&domain.ConsentStep{Scopes: []string{"email"}}},
+			nil,
+		},
+		{
+			"consent required and given, redirect to callback step",
+			fields{
+				userSessionViewProvider: &mockViewUserSession{
+					PasswordVerification:     testNow.Add(-5 * time.Minute),
+					SecondFactorVerification: testNow.Add(-5 * time.Minute),
+				},
+				userViewProvider: &mockViewUser{
+					PasswordSet:     true,
+					IsEmailVerified: true,
+					MFAMaxSetUp:     int32(domain.MFALevelSecondFactor),
+				},
+				userEventProvider:   &mockEventUser{},
+				orgViewProvider:     &mockViewOrg{State: domain.OrgStateActive},
+				userGrantProvider:   &mockUserGrants{},
+				projectProvider:     &mockProject{},
+				applicationProvider: &mockApp{app: &query.App{OIDCConfig: &query.OIDCApp{AppType: domain.OIDCApplicationTypeWeb, ConsentRequired: true}}},
+				consentProvider:     &mockConsent{scope: []string{"openid", "email"}},
+				lockoutPolicyProvider: &mockLockoutPolicy{
+					policy: &query.LockoutPolicy{
+						ShowFailures: true,
+					},
+				},
+				idpUserLinksProvider: &mockIDPUserLinks{},
+			},
+			args{&domain.AuthRequest{
+				UserID:  "UserID",
+				Request: &domain.AuthRequestOIDC{Scopes: []string{"openid", "email"}},
+				LoginPolicy: &domain.LoginPolicy{
+					AllowUsernamePassword:     true,
+					SecondFactors:             []domain.SecondFactorType{domain.SecondFactorTypeTOTP},
+					PasswordCheckLifetime:     10 * 24 * time.Hour,
+					SecondFactorCheckLifetime: 18 * time.Hour,
+				},
+			}, false},
+			[]domain.NextStep{&domain.RedirectToCallbackStep{}},
+			nil,
+		},
+		{
+			"consent denied, redirect to callback step",
+			fields{
+				userSessionViewProvider: &mockViewUserSession{
+					PasswordVerification:     testNow.Add(-5 * time.Minute),
+					SecondFactorVerification: testNow.Add(-5 * time.Minute),
+				},
+				userViewProvider: &mockViewUser{
+					PasswordSet:     true,
+					IsEmailVerified: true,
+					MFAMaxSetUp:     int32(domain.MFALevelSecondFactor),
+				},
+				userEventProvider:   &mockEventUser{},
+				orgViewProvider:     &mockViewOrg{State: domain.OrgStateActive},
+				userGrantProvider:   &mockUserGrants{},
+				projectProvider:     &mockProject{},
+				applicationProvider: &mockApp{app: &query.App{OIDCConfig: &query.OIDCApp{AppType: domain.OIDCApplicationTypeWeb, ConsentRequired: true}}},
+				consentProvider:     &mockConsent{},
+				lockoutPolicyProvider: &mockLockoutPolicy{
+					policy: &query.LockoutPolicy{
+						ShowFailures: true,
+					},
+				},
+				idpUserLinksProvider: &mockIDPUserLinks{},
+			},
+			args{&domain.AuthRequest{
+				UserID:  "UserID",
+				Request: &domain.AuthRequestOIDC{Scopes: []string{"openid"}},
+				LoginPolicy: &domain.LoginPolicy{
+					AllowUsernamePassword:     true,
+					SecondFactors:             []domain.SecondFactorType{domain.SecondFactorTypeTOTP},
+					PasswordCheckLifetime:     10 * 24 * time.Hour,
+					SecondFactorCheckLifetime: 18 * time.Hour,
+				},
+				ConsentDenied: true,
+			}, false},
+			[]domain.NextStep{&domain.RedirectToCallbackStep{}},
+			nil,
+		},
 		{
 			"prompt none, checkLoggedIn true, authenticated and native, login succeeded step",
 			fields{
@@ -2356,6 +2521,7 @@ func TestAuthRequestRepo_nextSteps(t *testing.T) {
 				UserGrantProvider:         tt.fields.userGrantProvider,
 				ProjectProvider:           tt.fields.projectProvider,
 				ApplicationProvider:       tt.fields.applicationProvider,
+				ConsentProvider:           tt.fields.consentProvider,
 				LoginPolicyViewProvider:   tt.fields.loginPolicyProvider,
 				LockoutPolicyViewProvider: tt.fields.lockoutPolicyProvider,
 				IDPUserLinksProvider:      tt.fields.idpUserLinksProvider,
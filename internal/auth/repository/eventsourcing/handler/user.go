@@ -61,6 +61,10 @@ func (u *User) Reducers() []handler.AggregateReducer {
 					Event:  user_repo.HumanOTPEmailRemovedType,
 					Reduce: u.ProcessUser,
 				},
+				{
+					Event:  user_repo.HumanRecoveryCodesRemovedType,
+					Reduce: u.ProcessUser,
+				},
 				{
 					Event:  user_repo.HumanAddedType,
 					Reduce: u.ProcessUser,
@@ -195,6 +199,7 @@ func (u *User) ProcessUser(event eventstore.Event) (_ *handler.Statement, err er
 		user_repo.HumanMFAOTPVerifiedType,
 		user_repo.HumanOTPSMSRemovedType,
 		user_repo.HumanOTPEmailRemovedType,
+		user_repo.HumanRecoveryCodesRemovedType,
 		user_repo.HumanU2FTokenVerifiedType:
 		return handler.NewUpdateStatement(event,
 			[]handler.Column{
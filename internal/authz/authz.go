@@ -1,6 +1,7 @@
 package authz
 
 import (
+	"github.com/zitadel/zitadel/internal/api/dpop"
 	"github.com/zitadel/zitadel/internal/authz/repository"
 	"github.com/zitadel/zitadel/internal/authz/repository/eventsourcing"
 	"github.com/zitadel/zitadel/internal/crypto"
@@ -9,6 +10,6 @@ import (
 	"github.com/zitadel/zitadel/internal/query"
 )
 
-func Start(queries *query.Queries, es *eventstore.Eventstore, dbClient *database.DB, keyEncryptionAlgorithm crypto.EncryptionAlgorithm, externalSecure bool) (repository.Repository, error) {
-	return eventsourcing.Start(queries, es, dbClient, keyEncryptionAlgorithm, externalSecure)
+func Start(queries *query.Queries, es *eventstore.Eventstore, dbClient *database.DB, keyEncryptionAlgorithm crypto.EncryptionAlgorithm, externalSecure bool, dpopVerifier *dpop.Verifier) (repository.Repository, error) {
+	return eventsourcing.Start(queries, es, dbClient, keyEncryptionAlgorithm, externalSecure, dpopVerifier)
 }
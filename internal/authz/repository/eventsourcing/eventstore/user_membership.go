@@ -39,8 +39,21 @@ func (repo *UserMembershipRepo) searchUserMemberships(ctx context.Context, orgID
 	if err != nil {
 		return nil, err
 	}
+	ownerQueries := []query.SearchQuery{orgIDsQuery, grantedIDQuery}
+	// the organization members of ancestors delegating their administration keep their roles on the organization
+	ancestorIDs, err := repo.Queries.OrgAncestorIDs(ctx, orgID, true)
+	if err != nil {
+		return nil, err
+	}
+	if len(ancestorIDs) > 0 {
+		ancestorsQuery, err := query.NewMembershipOrgIDsQuery(ancestorIDs...)
+		if err != nil {
+			return nil, err
+		}
+		ownerQueries = append(ownerQueries, ancestorsQuery)
+	}
 	memberships, err := repo.Queries.Memberships(ctx, &query.MembershipSearchQuery{
-		Queries: []query.SearchQuery{userIDQuery, query.Or(orgIDsQuery, grantedIDQuery)},
+		Queries: []query.SearchQuery{userIDQuery, query.Or(ownerQueries...)},
 	}, shouldTriggerBulk)
 	if err != nil {
 		return nil, err
@@ -19,6 +19,7 @@ const (
 	PurposeOrganization
 	PurposeIdPFormCallback
 	PurposeFederatedLogout
+	PurposeDPoPProof
 )
 
 // Cache stores objects with a value of type `V`.
@@ -24,6 +24,7 @@ type CachesConfig struct {
 	Organization     *cache.Config
 	IdPFormCallbacks *cache.Config
 	FederatedLogouts *cache.Config
+	DPoPProofs       *cache.Config
 }
 
 type Connectors struct {
@@ -0,0 +1,308 @@
+package command
+
+import (
+	"context"
+	"slices"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/accessrequest"
+	"github.com/zitadel/zitadel/internal/repository/usergrant"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// AddAccessRequest is the request of the authenticated user for roles of a project
+// or of a project granted to the organization of the user.
+type AddAccessRequest struct {
+	ProjectID      string
+	ProjectGrantID string
+	RoleKeys       []string
+	Reason         string
+}
+
+func (r *AddAccessRequest) IsValid() bool {
+	return r.ProjectID != "" && len(r.RoleKeys) > 0
+}
+
+// AddAccessRequest creates a pending request of the authenticated user.
+// The request is owned by the organization the user grant will be created on after the approval.
+func (c *Commands) AddAccessRequest(ctx context.Context, request *AddAccessRequest) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if !request.IsValid() {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-ieZ3o", "Errors.AccessRequest.Invalid")
+	}
+	userID := authz.GetCtxData(ctx).UserID
+	if userID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ceit4", "Errors.IDMissing")
+	}
+	resourceOwner, err := c.accessRequestResourceOwner(ctx, request.ProjectID, request.ProjectGrantID)
+	if err != nil {
+		return nil, err
+	}
+	err = c.checkUserGrantPreCondition(ctx, &domain.UserGrant{
+		UserID:         userID,
+		ProjectID:      request.ProjectID,
+		ProjectGrantID: request.ProjectGrantID,
+		RoleKeys:       request.RoleKeys,
+	}, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	id, err := c.idGenerator.Next()
+	if err != nil {
+		return nil, err
+	}
+	writeModel := NewAccessRequestWriteModel(id, resourceOwner)
+	pushedEvents, err := c.eventstore.Push(ctx, accessrequest.NewAddedEvent(
+		ctx,
+		AccessRequestAggregateFromWriteModel(&writeModel.WriteModel),
+		userID,
+		request.ProjectID,
+		request.ProjectGrantID,
+		request.RoleKeys,
+		request.Reason,
+	))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(writeModel, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// accessRequestResourceOwner returns the organization owning the project
+// or the organization the project is granted to.
+func (c *Commands) accessRequestResourceOwner(ctx context.Context, projectID, projectGrantID string) (string, error) {
+	if projectGrantID == "" {
+		return c.checkProjectExists(ctx, projectID, "")
+	}
+	projectGrant, err := c.projectGrantWriteModelByID(ctx, projectGrantID, projectID, "")
+	if err != nil {
+		return "", err
+	}
+	return projectGrant.GrantedOrgID, nil
+}
+
+// ApproveAccessRequest grants the requested roles to the requesting user.
+// If the user already has a grant on the project (grant), the requested roles are added to it,
+// otherwise a new user grant is created.
+func (c *Commands) ApproveAccessRequest(ctx context.Context, id, resourceOwner, comment string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	existingRequest, err := c.pendingAccessRequestForDecision(ctx, id, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	userGrantEvent, userGrantID, err := c.accessRequestUserGrant(ctx, existingRequest)
+	if err != nil {
+		return nil, err
+	}
+	events := make([]eventstore.Command, 0, 2)
+	if userGrantEvent != nil {
+		events = append(events, userGrantEvent)
+	}
+	events = append(events, accessrequest.NewApprovedEvent(
+		ctx,
+		AccessRequestAggregateFromWriteModel(&existingRequest.WriteModel),
+		existingRequest.UserID,
+		existingRequest.ProjectID,
+		existingRequest.ProjectGrantID,
+		userGrantID,
+		comment,
+	))
+	pushedEvents, err := c.eventstore.Push(ctx, events...)
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existingRequest, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingRequest.WriteModel), nil
+}
+
+// accessRequestUserGrant returns the event granting the requested roles and the id of the user grant.
+// No event is returned if the user already has all requested roles.
+func (c *Commands) accessRequestUserGrant(ctx context.Context, request *AccessRequestWriteModel) (eventstore.Command, string, error) {
+	userGrants, err := c.userGrantsOfUser(ctx, request.UserID)
+	if err != nil {
+		return nil, "", err
+	}
+	for _, userGrantID := range sortedKeys(userGrants.Grants) {
+		grant := userGrants.Grants[userGrantID]
+		if grant.ProjectID != request.ProjectID || grant.ProjectGrantID != request.ProjectGrantID || grant.ResourceOwner != request.ResourceOwner {
+			continue
+		}
+		existingUserGrant, err := c.userGrantWriteModelByID(ctx, userGrantID, request.ResourceOwner)
+		if err != nil {
+			return nil, "", err
+		}
+		if existingUserGrant.State == domain.UserGrantStateUnspecified || existingUserGrant.State == domain.UserGrantStateRemoved {
+			continue
+		}
+		roleKeys := slices.Clone(existingUserGrant.RoleKeys)
+		for _, roleKey := range request.RoleKeys {
+			if !slices.Contains(roleKeys, roleKey) {
+				roleKeys = append(roleKeys, roleKey)
+			}
+		}
+		if len(roleKeys) == len(existingUserGrant.RoleKeys) {
+			return nil, userGrantID, nil
+		}
+		err = c.checkUserGrantPreCondition(ctx, &domain.UserGrant{
+			UserID:         request.UserID,
+			ProjectID:      request.ProjectID,
+			ProjectGrantID: request.ProjectGrantID,
+			RoleKeys:       roleKeys,
+		}, request.ResourceOwner)
+		if err != nil {
+			return nil, "", err
+		}
+		return usergrant.NewUserGrantChangedEvent(
+			ctx,
+			UserGrantAggregateFromWriteModel(&existingUserGrant.WriteModel),
+			request.UserID,
+			roleKeys,
+		), userGrantID, nil
+	}
+	userGrant := &domain.UserGrant{
+		UserID:         request.UserID,
+		ProjectID:      request.ProjectID,
+		ProjectGrantID: request.ProjectGrantID,
+		RoleKeys:       request.RoleKeys,
+	}
+	event, _, err := c.addUserGrant(ctx, userGrant, request.ResourceOwner)
+	if err != nil {
+		return nil, "", err
+	}
+	return event, userGrant.AggregateID, nil
+}
+
+// DenyAccessRequest closes the request without granting the requested roles.
+func (c *Commands) DenyAccessRequest(ctx context.Context, id, resourceOwner, comment string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	existingRequest, err := c.pendingAccessRequestForDecision(ctx, id, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, accessrequest.NewDeniedEvent(
+		ctx,
+		AccessRequestAggregateFromWriteModel(&existingRequest.WriteModel),
+		existingRequest.UserID,
+		existingRequest.ProjectID,
+		existingRequest.ProjectGrantID,
+		comment,
+	))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existingRequest, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingRequest.WriteModel), nil
+}
+
+// pendingAccessRequestForDecision returns the pending request,
+// if the authenticated user is allowed to approve or deny it.
+// Users are never allowed to decide on their own requests.
+func (c *Commands) pendingAccessRequestForDecision(ctx context.Context, id, resourceOwner string) (*AccessRequestWriteModel, error) {
+	existingRequest, err := c.pendingAccessRequestWriteModelByID(ctx, id, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if existingRequest.UserID == authz.GetCtxData(ctx).UserID {
+		return nil, zerrors.ThrowPermissionDenied(nil, "COMMAND-Aih7y", "Errors.AccessRequest.SelfApproval")
+	}
+	err = c.checkPermissionApproveAccessRequest(ctx, existingRequest.ResourceOwner, existingRequest.ProjectID, existingRequest.ProjectGrantID)
+	if err != nil {
+		return nil, err
+	}
+	return existingRequest, nil
+}
+
+// WithdrawAccessRequest closes the request on behalf of the requesting user.
+func (c *Commands) WithdrawAccessRequest(ctx context.Context, id, resourceOwner string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	existingRequest, err := c.pendingAccessRequestWriteModelByID(ctx, id, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if existingRequest.UserID != authz.GetCtxData(ctx).UserID {
+		return nil, zerrors.ThrowPermissionDenied(nil, "COMMAND-Ohf6u", "Errors.AccessRequest.NotRequester")
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, accessrequest.NewWithdrawnEvent(
+		ctx,
+		AccessRequestAggregateFromWriteModel(&existingRequest.WriteModel),
+		existingRequest.UserID,
+		existingRequest.ProjectID,
+		existingRequest.ProjectGrantID,
+	))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existingRequest, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingRequest.WriteModel), nil
+}
+
+// AccessRequestApproversNotified marks the approvers of the request as notified.
+func (c *Commands) AccessRequestApproversNotified(ctx context.Context, orgID, id string) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	existingRequest, err := c.accessRequestWriteModelByID(ctx, id, orgID)
+	if err != nil {
+		return err
+	}
+	if !existingRequest.State.Exists() {
+		return zerrors.ThrowNotFound(nil, "COMMAND-Eeth1", "Errors.AccessRequest.NotFound")
+	}
+	if existingRequest.ApproversNotified {
+		return nil
+	}
+	_, err = c.eventstore.Push(ctx, accessrequest.NewApproversNotifiedEvent(
+		ctx,
+		AccessRequestAggregateFromWriteModel(&existingRequest.WriteModel),
+	))
+	return err
+}
+
+func (c *Commands) pendingAccessRequestWriteModelByID(ctx context.Context, id, resourceOwner string) (*AccessRequestWriteModel, error) {
+	existingRequest, err := c.accessRequestWriteModelByID(ctx, id, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !existingRequest.State.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Reo6a", "Errors.AccessRequest.NotFound")
+	}
+	if existingRequest.State != domain.AccessRequestStatePending {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Dai9o", "Errors.AccessRequest.NotPending")
+	}
+	return existingRequest, nil
+}
+
+func (c *Commands) accessRequestWriteModelByID(ctx context.Context, id, resourceOwner string) (writeModel *AccessRequestWriteModel, err error) {
+	if id == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Gee7a", "Errors.AccessRequest.IDMissing")
+	}
+	writeModel = NewAccessRequestWriteModel(id, resourceOwner)
+	err = c.eventstore.FilterToQueryReducer(ctx, writeModel)
+	if err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
@@ -0,0 +1,75 @@
+package command
+
+import (
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/accessrequest"
+)
+
+type AccessRequestWriteModel struct {
+	eventstore.WriteModel
+
+	UserID            string
+	ProjectID         string
+	ProjectGrantID    string
+	RoleKeys          []string
+	Reason            string
+	State             domain.AccessRequestState
+	ApproversNotified bool
+}
+
+func NewAccessRequestWriteModel(id, resourceOwner string) *AccessRequestWriteModel {
+	return &AccessRequestWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   id,
+			ResourceOwner: resourceOwner,
+		},
+	}
+}
+
+func (wm *AccessRequestWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *accessrequest.AddedEvent:
+			wm.UserID = e.UserID
+			wm.ProjectID = e.ProjectID
+			wm.ProjectGrantID = e.ProjectGrantID
+			wm.RoleKeys = e.RoleKeys
+			wm.Reason = e.Reason
+			wm.State = domain.AccessRequestStatePending
+		case *accessrequest.ApprovedEvent:
+			wm.State = domain.AccessRequestStateApproved
+		case *accessrequest.DeniedEvent:
+			wm.State = domain.AccessRequestStateDenied
+		case *accessrequest.WithdrawnEvent:
+			wm.State = domain.AccessRequestStateWithdrawn
+		case *accessrequest.ApproversNotifiedEvent:
+			wm.ApproversNotified = true
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *AccessRequestWriteModel) Query() *eventstore.SearchQueryBuilder {
+	query := eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(accessrequest.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			accessrequest.AddedType,
+			accessrequest.ApprovedType,
+			accessrequest.DeniedType,
+			accessrequest.WithdrawnType,
+			accessrequest.ApproversNotifiedType,
+		).
+		Builder()
+
+	if wm.ResourceOwner != "" {
+		query.ResourceOwner(wm.ResourceOwner)
+	}
+	return query
+}
+
+func AccessRequestAggregateFromWriteModel(wm *eventstore.WriteModel) *eventstore.Aggregate {
+	return eventstore.AggregateFromWriteModel(wm, accessrequest.AggregateType, accessrequest.AggregateVersion)
+}
@@ -0,0 +1,839 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/id"
+	id_mock "github.com/zitadel/zitadel/internal/id/mock"
+	"github.com/zitadel/zitadel/internal/repository/accessrequest"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/repository/usergrant"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func accessRequestAddedEvent(ctx context.Context, roleKeys ...string) *accessrequest.AddedEvent {
+	return accessrequest.NewAddedEvent(ctx,
+		&accessrequest.NewAggregate("request1", "org1").Aggregate,
+		"user1",
+		"project1",
+		"",
+		roleKeys,
+		"reason",
+	)
+}
+
+func accessRequestPreConditionEvents() []eventstore.Event {
+	return []eventstore.Event{
+		eventFromEventPusher(
+			user.NewHumanAddedEvent(context.Background(),
+				&user.NewAggregate("user1", "org1").Aggregate,
+				"username1",
+				"firstname1",
+				"lastname1",
+				"nickname1",
+				"displayname1",
+				language.German,
+				domain.GenderMale,
+				"email1",
+				true,
+			),
+		),
+		eventFromEventPusher(
+			project.NewProjectAddedEvent(context.Background(),
+				&project.NewAggregate("project1", "org1").Aggregate,
+				"projectname1", true, true, true,
+				domain.PrivateLabelingSettingUnspecified,
+			),
+		),
+		eventFromEventPusher(
+			project.NewRoleAddedEvent(context.Background(),
+				&project.NewAggregate("project1", "org1").Aggregate,
+				"rolekey1",
+				"rolekey",
+				"",
+			),
+		),
+		eventFromEventPusher(
+			project.NewRoleAddedEvent(context.Background(),
+				&project.NewAggregate("project1", "org1").Aggregate,
+				"rolekey2",
+				"rolekey",
+				"",
+			),
+		),
+	}
+}
+
+func TestCommandSide_AddAccessRequest(t *testing.T) {
+	type fields struct {
+		eventstore  *eventstore.Eventstore
+		idGenerator id.Generator
+	}
+	type args struct {
+		ctx     context.Context
+		request *AddAccessRequest
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "no roles, invalid argument error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+				),
+			},
+			args: args{
+				ctx: authz.NewMockContext("instance1", "org1", "user1"),
+				request: &AddAccessRequest{
+					ProjectID: "project1",
+				},
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "project not existing, precondition error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx: authz.NewMockContext("instance1", "org1", "user1"),
+				request: &AddAccessRequest{
+					ProjectID: "project1",
+					RoleKeys:  []string{"rolekey1"},
+				},
+			},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "role not existing, precondition error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							project.NewProjectAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"projectname1", true, true, true,
+								domain.PrivateLabelingSettingUnspecified,
+							),
+						),
+					),
+					expectFilter(accessRequestPreConditionEvents()...),
+				),
+			},
+			args: args{
+				ctx: authz.NewMockContext("instance1", "org1", "user1"),
+				request: &AddAccessRequest{
+					ProjectID: "project1",
+					RoleKeys:  []string{"unknown"},
+				},
+			},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "request for project, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							project.NewProjectAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"projectname1", true, true, true,
+								domain.PrivateLabelingSettingUnspecified,
+							),
+						),
+					),
+					expectFilter(accessRequestPreConditionEvents()...),
+					expectPush(
+						accessRequestAddedEvent(authz.NewMockContext("instance1", "org1", "user1"), "rolekey1"),
+					),
+				),
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "request1"),
+			},
+			args: args{
+				ctx: authz.NewMockContext("instance1", "org1", "user1"),
+				request: &AddAccessRequest{
+					ProjectID: "project1",
+					RoleKeys:  []string{"rolekey1"},
+					Reason:    "reason",
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ID:            "request1",
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore:  tt.fields.eventstore,
+				idGenerator: tt.fields.idGenerator,
+			}
+			got, err := r.AddAccessRequest(tt.args.ctx, tt.args.request)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommandSide_ApproveAccessRequest(t *testing.T) {
+	type fields struct {
+		eventstore      *eventstore.Eventstore
+		idGenerator     id.Generator
+		checkPermission domain.PermissionCheck
+	}
+	type args struct {
+		ctx context.Context
+		id  string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "id missing, invalid argument error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args: args{
+				ctx: authz.NewMockContext("instance1", "org1", "approver1"),
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "request not existing, not found error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args: args{
+				ctx: authz.NewMockContext("instance1", "org1", "approver1"),
+				id:  "request1",
+			},
+			res: res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			name: "request withdrawn, precondition error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(accessRequestAddedEvent(context.Background(), "rolekey1")),
+						eventFromEventPusher(
+							accessrequest.NewWithdrawnEvent(context.Background(),
+								&accessrequest.NewAggregate("request1", "org1").Aggregate,
+								"user1", "project1", "",
+							),
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args: args{
+				ctx: authz.NewMockContext("instance1", "org1", "approver1"),
+				id:  "request1",
+			},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "own request, permission denied error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(accessRequestAddedEvent(context.Background(), "rolekey1")),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args: args{
+				ctx: authz.NewMockContext("instance1", "org1", "user1"),
+				id:  "request1",
+			},
+			res: res{
+				err: zerrors.IsPermissionDenied,
+			},
+		},
+		{
+			name: "no permission, permission denied error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(accessRequestAddedEvent(context.Background(), "rolekey1")),
+					),
+				),
+				checkPermission: newMockPermissionCheckNotAllowed(),
+			},
+			args: args{
+				ctx: authz.NewMockContext("instance1", "org1", "approver1"),
+				id:  "request1",
+			},
+			res: res{
+				err: zerrors.IsPermissionDenied,
+			},
+		},
+		{
+			name: "approve without existing grant, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(accessRequestAddedEvent(context.Background(), "rolekey1")),
+					),
+					expectFilter(),
+					expectFilter(accessRequestPreConditionEvents()...),
+					expectPush(
+						usergrant.NewUserGrantAddedEvent(authz.NewMockContext("instance1", "org1", "approver1"),
+							&usergrant.NewAggregate("usergrant1", "org1").Aggregate,
+							"user1",
+							"project1",
+							"",
+							[]string{"rolekey1"},
+						),
+						accessrequest.NewApprovedEvent(authz.NewMockContext("instance1", "org1", "approver1"),
+							&accessrequest.NewAggregate("request1", "org1").Aggregate,
+							"user1", "project1", "", "usergrant1", "comment",
+						),
+					),
+				),
+				idGenerator:     id_mock.NewIDGeneratorExpectIDs(t, "usergrant1"),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args: args{
+				ctx: authz.NewMockContext("instance1", "org1", "approver1"),
+				id:  "request1",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ID:            "request1",
+					ResourceOwner: "org1",
+				},
+			},
+		},
+		{
+			name: "approve with cascade removed grant, new grant added",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(accessRequestAddedEvent(context.Background(), "rolekey1")),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							usergrant.NewUserGrantAddedEvent(context.Background(),
+								&usergrant.NewAggregate("usergrant1", "org1").Aggregate,
+								"user1",
+								"project1",
+								"",
+								[]string{"rolekey1"},
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							usergrant.NewUserGrantCascadeRemovedEvent(context.Background(),
+								&usergrant.NewAggregate("usergrant1", "org1").Aggregate,
+								"user1",
+								"project1",
+								"",
+							),
+						),
+					),
+					expectFilter(accessRequestPreConditionEvents()...),
+					expectPush(
+						usergrant.NewUserGrantAddedEvent(authz.NewMockContext("instance1", "org1", "approver1"),
+							&usergrant.NewAggregate("usergrant2", "org1").Aggregate,
+							"user1",
+							"project1",
+							"",
+							[]string{"rolekey1"},
+						),
+						accessrequest.NewApprovedEvent(authz.NewMockContext("instance1", "org1", "approver1"),
+							&accessrequest.NewAggregate("request1", "org1").Aggregate,
+							"user1", "project1", "", "usergrant2", "comment",
+						),
+					),
+				),
+				idGenerator:     id_mock.NewIDGeneratorExpectIDs(t, "usergrant2"),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args: args{
+				ctx: authz.NewMockContext("instance1", "org1", "approver1"),
+				id:  "request1",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ID:            "request1",
+					ResourceOwner: "org1",
+				},
+			},
+		},
+		{
+			name: "approve with existing grant, roles added",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(accessRequestAddedEvent(context.Background(), "rolekey2")),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							usergrant.NewUserGrantAddedEvent(context.Background(),
+								&usergrant.NewAggregate("usergrant1", "org1").Aggregate,
+								"user1",
+								"project1",
+								"",
+								[]string{"rolekey1"},
+							),
+						),
+					),
+					expectFilter(),
+					expectFilter(
+						eventFromEventPusher(
+							usergrant.NewUserGrantAddedEvent(context.Background(),
+								&usergrant.NewAggregate("usergrant1", "org1").Aggregate,
+								"user1",
+								"project1",
+								"",
+								[]string{"rolekey1"},
+							),
+						),
+					),
+					expectFilter(accessRequestPreConditionEvents()...),
+					expectPush(
+						usergrant.NewUserGrantChangedEvent(authz.NewMockContext("instance1", "org1", "approver1"),
+							&usergrant.NewAggregate("usergrant1", "org1").Aggregate,
+							"user1",
+							[]string{"rolekey1", "rolekey2"},
+						),
+						accessrequest.NewApprovedEvent(authz.NewMockContext("instance1", "org1", "approver1"),
+							&accessrequest.NewAggregate("request1", "org1").Aggregate,
+							"user1", "project1", "", "usergrant1", "comment",
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args: args{
+				ctx: authz.NewMockContext("instance1", "org1", "approver1"),
+				id:  "request1",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ID:            "request1",
+					ResourceOwner: "org1",
+				},
+			},
+		},
+		{
+			name: "approve with existing grant containing roles, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(accessRequestAddedEvent(context.Background(), "rolekey1")),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							usergrant.NewUserGrantAddedEvent(context.Background(),
+								&usergrant.NewAggregate("usergrant1", "org1").Aggregate,
+								"user1",
+								"project1",
+								"",
+								[]string{"rolekey1"},
+							),
+						),
+					),
+					expectFilter(),
+					expectFilter(
+						eventFromEventPusher(
+							usergrant.NewUserGrantAddedEvent(context.Background(),
+								&usergrant.NewAggregate("usergrant1", "org1").Aggregate,
+								"user1",
+								"project1",
+								"",
+								[]string{"rolekey1"},
+							),
+						),
+					),
+					expectPush(
+						accessrequest.NewApprovedEvent(authz.NewMockContext("instance1", "org1", "approver1"),
+							&accessrequest.NewAggregate("request1", "org1").Aggregate,
+							"user1", "project1", "", "usergrant1", "comment",
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args: args{
+				ctx: authz.NewMockContext("instance1", "org1", "approver1"),
+				id:  "request1",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ID:            "request1",
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore:      tt.fields.eventstore,
+				idGenerator:     tt.fields.idGenerator,
+				checkPermission: tt.fields.checkPermission,
+			}
+			got, err := r.ApproveAccessRequest(tt.args.ctx, tt.args.id, "org1", "comment")
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommandSide_DenyAccessRequest(t *testing.T) {
+	type fields struct {
+		eventstore      *eventstore.Eventstore
+		checkPermission domain.PermissionCheck
+	}
+	type args struct {
+		ctx context.Context
+		id  string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "request already denied, precondition error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(accessRequestAddedEvent(context.Background(), "rolekey1")),
+						eventFromEventPusher(
+							accessrequest.NewDeniedEvent(context.Background(),
+								&accessrequest.NewAggregate("request1", "org1").Aggregate,
+								"user1", "project1", "", "",
+							),
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args: args{
+				ctx: authz.NewMockContext("instance1", "org1", "approver1"),
+				id:  "request1",
+			},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "no permission, permission denied error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(accessRequestAddedEvent(context.Background(), "rolekey1")),
+					),
+				),
+				checkPermission: newMockPermissionCheckNotAllowed(),
+			},
+			args: args{
+				ctx: authz.NewMockContext("instance1", "org1", "approver1"),
+				id:  "request1",
+			},
+			res: res{
+				err: zerrors.IsPermissionDenied,
+			},
+		},
+		{
+			name: "deny, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(accessRequestAddedEvent(context.Background(), "rolekey1")),
+					),
+					expectPush(
+						accessrequest.NewDeniedEvent(authz.NewMockContext("instance1", "org1", "approver1"),
+							&accessrequest.NewAggregate("request1", "org1").Aggregate,
+							"user1", "project1", "", "comment",
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args: args{
+				ctx: authz.NewMockContext("instance1", "org1", "approver1"),
+				id:  "request1",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ID:            "request1",
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore:      tt.fields.eventstore,
+				checkPermission: tt.fields.checkPermission,
+			}
+			got, err := r.DenyAccessRequest(tt.args.ctx, tt.args.id, "org1", "comment")
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommandSide_WithdrawAccessRequest(t *testing.T) {
+	type fields struct {
+		eventstore *eventstore.Eventstore
+	}
+	type args struct {
+		ctx context.Context
+		id  string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "other user, permission denied error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(accessRequestAddedEvent(context.Background(), "rolekey1")),
+					),
+				),
+			},
+			args: args{
+				ctx: authz.NewMockContext("instance1", "org1", "user2"),
+				id:  "request1",
+			},
+			res: res{
+				err: zerrors.IsPermissionDenied,
+			},
+		},
+		{
+			name: "withdraw, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(accessRequestAddedEvent(context.Background(), "rolekey1")),
+					),
+					expectPush(
+						accessrequest.NewWithdrawnEvent(authz.NewMockContext("instance1", "org1", "user1"),
+							&accessrequest.NewAggregate("request1", "org1").Aggregate,
+							"user1", "project1", "",
+						),
+					),
+				),
+			},
+			args: args{
+				ctx: authz.NewMockContext("instance1", "org1", "user1"),
+				id:  "request1",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ID:            "request1",
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore,
+			}
+			got, err := r.WithdrawAccessRequest(tt.args.ctx, tt.args.id, "org1")
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommandSide_AccessRequestApproversNotified(t *testing.T) {
+	type fields struct {
+		eventstore *eventstore.Eventstore
+	}
+	type args struct {
+		ctx context.Context
+		id  string
+	}
+	type res struct {
+		err func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "request not existing, not found error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				id:  "request1",
+			},
+			res: res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			name: "already notified, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(accessRequestAddedEvent(context.Background(), "rolekey1")),
+						eventFromEventPusher(
+							accessrequest.NewApproversNotifiedEvent(context.Background(),
+								&accessrequest.NewAggregate("request1", "org1").Aggregate,
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				id:  "request1",
+			},
+		},
+		{
+			name: "notified, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(accessRequestAddedEvent(context.Background(), "rolekey1")),
+					),
+					expectPush(
+						accessrequest.NewApproversNotifiedEvent(context.Background(),
+							&accessrequest.NewAggregate("request1", "org1").Aggregate,
+						),
+					),
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				id:  "request1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore,
+			}
+			err := r.AccessRequestApproversNotified(tt.args.ctx, "org1", tt.args.id)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,294 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/accessreview"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type StartAccessReview struct {
+	Name string
+	// ProjectID optionally restricts the review to the user grants and members of the project.
+	ProjectID string
+	Deadline  time.Time
+	// ReviewerID is the user the items are assigned to.
+	ReviewerID string
+}
+
+func (r *StartAccessReview) IsValid() bool {
+	return r.Name != "" && r.ReviewerID != "" && !r.Deadline.IsZero()
+}
+
+// StartAccessReview starts a campaign reviewing the active user grants and memberships of the organization.
+// The items are snapshotted at the start, changes afterwards are not part of the campaign.
+// Items of the reviewer themselves are assigned to the user starting the campaign.
+func (c *Commands) StartAccessReview(ctx context.Context, resourceOwner string, review *StartAccessReview) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if resourceOwner == "" || !review.IsValid() {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ier4a", "Errors.AccessReview.Invalid")
+	}
+	if !review.Deadline.After(time.Now()) {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ahb2u", "Errors.AccessReview.InvalidDeadline")
+	}
+	if err = c.checkPermission(ctx, domain.PermissionAccessReviewWrite, resourceOwner, resourceOwner); err != nil {
+		return nil, err
+	}
+	if review.ProjectID != "" {
+		if _, err = c.checkProjectExists(ctx, review.ProjectID, resourceOwner); err != nil {
+			return nil, err
+		}
+	}
+	if err = c.checkUserExists(ctx, review.ReviewerID, ""); err != nil {
+		return nil, err
+	}
+	scope := newAccessReviewScopeWriteModel(resourceOwner, review.ProjectID)
+	if err = c.eventstore.FilterToQueryReducer(ctx, scope); err != nil {
+		return nil, err
+	}
+	items := scope.items()
+	if len(items) == 0 {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-ooR5e", "Errors.AccessReview.NoItems")
+	}
+	id, err := c.idGenerator.Next()
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range items {
+		if item.ID, err = c.idGenerator.Next(); err != nil {
+			return nil, err
+		}
+		item.ReviewerID = review.ReviewerID
+		if item.UserID == review.ReviewerID {
+			item.ReviewerID = authz.GetCtxData(ctx).UserID
+		}
+	}
+	writeModel := NewAccessReviewWriteModel(id, resourceOwner)
+	pushedEvents, err := c.eventstore.Push(ctx, accessreview.NewStartedEvent(
+		ctx,
+		AccessReviewAggregateFromWriteModel(&writeModel.WriteModel),
+		review.Name,
+		review.ProjectID,
+		review.Deadline,
+		items,
+	))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(writeModel, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// DecideAccessReviewItem keeps or revokes the access of an item on behalf of the assigned reviewer.
+// Revoking removes the user grant or membership.
+// The campaign is closed as soon as the last item is decided.
+func (c *Commands) DecideAccessReviewItem(ctx context.Context, id, resourceOwner, itemID string, decision domain.AccessReviewDecision, comment string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if decision != domain.AccessReviewDecisionKeep && decision != domain.AccessReviewDecisionRevoke {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Yei9o", "Errors.AccessReview.InvalidDecision")
+	}
+	existingReview, item, err := c.pendingAccessReviewItem(ctx, id, resourceOwner, itemID)
+	if err != nil {
+		return nil, err
+	}
+	userID := authz.GetCtxData(ctx).UserID
+	if item.UserID == userID {
+		return nil, zerrors.ThrowPermissionDenied(nil, "COMMAND-Aeg4i", "Errors.AccessReview.SelfReview")
+	}
+	if item.ReviewerID != userID {
+		return nil, zerrors.ThrowPermissionDenied(nil, "COMMAND-Iu7ie", "Errors.AccessReview.NotReviewer")
+	}
+	events, err := c.decideAccessReviewItem(ctx, existingReview, item, decision, comment)
+	if err != nil {
+		return nil, err
+	}
+	if len(existingReview.pendingItems()) == 1 {
+		events = append(events, accessreview.NewClosedEvent(ctx, AccessReviewAggregateFromWriteModel(&existingReview.WriteModel)))
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, events...)
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existingReview, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingReview.WriteModel), nil
+}
+
+// ReassignAccessReviewItem assigns a pending item to another reviewer.
+func (c *Commands) ReassignAccessReviewItem(ctx context.Context, id, resourceOwner, itemID, reviewerID string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if reviewerID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Mie4o", "Errors.AccessReview.Invalid")
+	}
+	existingReview, item, err := c.pendingAccessReviewItem(ctx, id, resourceOwner, itemID)
+	if err != nil {
+		return nil, err
+	}
+	if err = c.checkPermission(ctx, domain.PermissionAccessReviewWrite, existingReview.ResourceOwner, existingReview.ResourceOwner); err != nil {
+		return nil, err
+	}
+	if item.UserID == reviewerID {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ooph4", "Errors.AccessReview.SelfReview")
+	}
+	if item.ReviewerID == reviewerID {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ahd8i", "Errors.AccessReview.NotChanged")
+	}
+	if err = c.checkUserExists(ctx, reviewerID, ""); err != nil {
+		return nil, err
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, accessreview.NewItemReassignedEvent(
+		ctx,
+		AccessReviewAggregateFromWriteModel(&existingReview.WriteModel),
+		item.ID,
+		reviewerID,
+	))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existingReview, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingReview.WriteModel), nil
+}
+
+// ExpireAccessReview revokes the access of all items, which were not reviewed until the deadline,
+// and closes the campaign.
+func (c *Commands) ExpireAccessReview(ctx context.Context, id, resourceOwner string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	existingReview, err := c.activeAccessReviewWriteModelByID(ctx, id, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if time.Now().Before(existingReview.Deadline) {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ue3ah", "Errors.AccessReview.DeadlineNotReached")
+	}
+	pending := existingReview.pendingItems()
+	events := make([]eventstore.Command, 0, 2*len(pending)+1)
+	for _, item := range pending {
+		itemEvents, err := c.decideAccessReviewItem(ctx, existingReview, item, domain.AccessReviewDecisionExpired, "")
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, itemEvents...)
+	}
+	events = append(events, accessreview.NewClosedEvent(ctx, AccessReviewAggregateFromWriteModel(&existingReview.WriteModel)))
+	pushedEvents, err := c.eventstore.Push(ctx, events...)
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existingReview, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingReview.WriteModel), nil
+}
+
+// decideAccessReviewItem returns the decision event,
+// preceded by the event removing the access if it is revoked and still exists.
+func (c *Commands) decideAccessReviewItem(ctx context.Context, review *AccessReviewWriteModel, item *AccessReviewItemWriteModel, decision domain.AccessReviewDecision, comment string) ([]eventstore.Command, error) {
+	events := make([]eventstore.Command, 0, 2)
+	if decision.IsRevoked() {
+		revocation, err := c.accessReviewRevocation(ctx, review.ResourceOwner, item)
+		if err != nil {
+			return nil, err
+		}
+		if revocation != nil {
+			events = append(events, revocation)
+		}
+	}
+	return append(events, accessreview.NewItemDecidedEvent(
+		ctx,
+		AccessReviewAggregateFromWriteModel(&review.WriteModel),
+		item.ID,
+		decision,
+		comment,
+	)), nil
+}
+
+// accessReviewRevocation returns the event removing the user grant or membership of the item.
+// The decision of the reviewer authorizes the removal, so the events are pushed as cascading.
+// No event is returned if the access was already removed.
+func (c *Commands) accessReviewRevocation(ctx context.Context, resourceOwner string, item *AccessReviewItemWriteModel) (eventstore.Command, error) {
+	switch item.Type {
+	case domain.AccessReviewItemTypeUserGrant:
+		event, _, err := c.removeUserGrant(ctx, item.UserGrantID, resourceOwner, true)
+		if zerrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return event, err
+	case domain.AccessReviewItemTypeOrgMember:
+		member, err := c.orgMemberWriteModelByID(ctx, resourceOwner, item.UserID)
+		if zerrors.IsNotFound(err) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		return c.removeOrgMember(ctx, OrgAggregateFromWriteModel(&member.MemberWriteModel.WriteModel), item.UserID, true), nil
+	case domain.AccessReviewItemTypeProjectMember:
+		member, err := c.projectMemberWriteModelByID(ctx, item.ProjectID, item.UserID, resourceOwner)
+		if zerrors.IsNotFound(err) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		return c.removeProjectMember(ctx, ProjectAggregateFromWriteModel(&member.MemberWriteModel.WriteModel), item.UserID, true), nil
+	case domain.AccessReviewItemTypeUnspecified:
+		fallthrough
+	default:
+		return nil, zerrors.ThrowInternal(nil, "COMMAND-Oow0u", "Errors.AccessReview.Invalid")
+	}
+}
+
+func (c *Commands) pendingAccessReviewItem(ctx context.Context, id, resourceOwner, itemID string) (*AccessReviewWriteModel, *AccessReviewItemWriteModel, error) {
+	existingReview, err := c.activeAccessReviewWriteModelByID(ctx, id, resourceOwner)
+	if err != nil {
+		return nil, nil, err
+	}
+	item := existingReview.item(itemID)
+	if item == nil {
+		return nil, nil, zerrors.ThrowNotFound(nil, "COMMAND-Chu3e", "Errors.AccessReview.ItemNotFound")
+	}
+	if item.Decision != domain.AccessReviewDecisionPending {
+		return nil, nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ieth5", "Errors.AccessReview.ItemAlreadyDecided")
+	}
+	return existingReview, item, nil
+}
+
+func (c *Commands) activeAccessReviewWriteModelByID(ctx context.Context, id, resourceOwner string) (writeModel *AccessReviewWriteModel, err error) {
+	if id == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Thoo7", "Errors.AccessReview.IDMissing")
+	}
+	writeModel = NewAccessReviewWriteModel(id, resourceOwner)
+	err = c.eventstore.FilterToQueryReducer(ctx, writeModel)
+	if err != nil {
+		return nil, err
+	}
+	if !writeModel.State.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Eexu8", "Errors.AccessReview.NotFound")
+	}
+	if writeModel.State != domain.AccessReviewStateActive {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-oo4Ae", "Errors.AccessReview.NotActive")
+	}
+	return writeModel, nil
+}
@@ -0,0 +1,292 @@
+package command
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/accessreview"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/repository/usergrant"
+)
+
+type AccessReviewWriteModel struct {
+	eventstore.WriteModel
+
+	Name      string
+	ProjectID string
+	Deadline  time.Time
+	State     domain.AccessReviewState
+	Items     []*AccessReviewItemWriteModel
+}
+
+type AccessReviewItemWriteModel struct {
+	accessreview.Item
+	Decision domain.AccessReviewDecision
+}
+
+func NewAccessReviewWriteModel(id, resourceOwner string) *AccessReviewWriteModel {
+	return &AccessReviewWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   id,
+			ResourceOwner: resourceOwner,
+		},
+	}
+}
+
+func (wm *AccessReviewWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *accessreview.StartedEvent:
+			wm.Name = e.Name
+			wm.ProjectID = e.ProjectID
+			wm.Deadline = e.Deadline
+			wm.State = domain.AccessReviewStateActive
+			wm.Items = make([]*AccessReviewItemWriteModel, len(e.Items))
+			for i, item := range e.Items {
+				wm.Items[i] = &AccessReviewItemWriteModel{Item: *item}
+			}
+		case *accessreview.ItemDecidedEvent:
+			if item := wm.item(e.ItemID); item != nil {
+				item.Decision = e.Decision
+			}
+		case *accessreview.ItemReassignedEvent:
+			if item := wm.item(e.ItemID); item != nil {
+				item.ReviewerID = e.ReviewerID
+			}
+		case *accessreview.ClosedEvent:
+			wm.State = domain.AccessReviewStateClosed
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *AccessReviewWriteModel) Query() *eventstore.SearchQueryBuilder {
+	query := eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(accessreview.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			accessreview.StartedType,
+			accessreview.ItemDecidedType,
+			accessreview.ItemReassignedType,
+			accessreview.ClosedType,
+		).
+		Builder()
+
+	if wm.ResourceOwner != "" {
+		query.ResourceOwner(wm.ResourceOwner)
+	}
+	return query
+}
+
+func (wm *AccessReviewWriteModel) item(id string) *AccessReviewItemWriteModel {
+	for _, item := range wm.Items {
+		if item.ID == id {
+			return item
+		}
+	}
+	return nil
+}
+
+// pendingItems returns the items, which are not decided yet.
+func (wm *AccessReviewWriteModel) pendingItems() []*AccessReviewItemWriteModel {
+	pending := make([]*AccessReviewItemWriteModel, 0, len(wm.Items))
+	for _, item := range wm.Items {
+		if item.Decision == domain.AccessReviewDecisionPending {
+			pending = append(pending, item)
+		}
+	}
+	return pending
+}
+
+func AccessReviewAggregateFromWriteModel(wm *eventstore.WriteModel) *eventstore.Aggregate {
+	return eventstore.AggregateFromWriteModel(wm, accessreview.AggregateType, accessreview.AggregateVersion)
+}
+
+// accessReviewScopeWriteModel collects the active user grants and the memberships of an organization,
+// which are reviewed in a campaign.
+// If a project is set, only the user grants and members of the project are collected.
+type accessReviewScopeWriteModel struct {
+	eventstore.WriteModel
+
+	ProjectID string
+
+	UserGrants     map[string]*accessReviewScopeUserGrant
+	OrgMembers     map[string][]string
+	ProjectMembers map[string]map[string][]string
+}
+
+type accessReviewScopeUserGrant struct {
+	UserID         string
+	ProjectID      string
+	ProjectGrantID string
+	RoleKeys       []string
+	Active         bool
+}
+
+func newAccessReviewScopeWriteModel(orgID, projectID string) *accessReviewScopeWriteModel {
+	return &accessReviewScopeWriteModel{
+		WriteModel: eventstore.WriteModel{
+			ResourceOwner: orgID,
+		},
+		ProjectID:      projectID,
+		UserGrants:     make(map[string]*accessReviewScopeUserGrant),
+		OrgMembers:     make(map[string][]string),
+		ProjectMembers: make(map[string]map[string][]string),
+	}
+}
+
+func (wm *accessReviewScopeWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *usergrant.UserGrantAddedEvent:
+			wm.UserGrants[e.Aggregate().ID] = &accessReviewScopeUserGrant{
+				UserID:         e.UserID,
+				ProjectID:      e.ProjectID,
+				ProjectGrantID: e.ProjectGrantID,
+				RoleKeys:       e.RoleKeys,
+				Active:         true,
+			}
+		case *usergrant.UserGrantChangedEvent:
+			if grant, ok := wm.UserGrants[e.Aggregate().ID]; ok {
+				grant.RoleKeys = e.RoleKeys
+			}
+		case *usergrant.UserGrantCascadeChangedEvent:
+			if grant, ok := wm.UserGrants[e.Aggregate().ID]; ok {
+				grant.RoleKeys = e.RoleKeys
+			}
+		case *usergrant.UserGrantDeactivatedEvent:
+			if grant, ok := wm.UserGrants[e.Aggregate().ID]; ok {
+				grant.Active = false
+			}
+		case *usergrant.UserGrantExpiredEvent:
+			if grant, ok := wm.UserGrants[e.Aggregate().ID]; ok {
+				grant.Active = false
+			}
+		case *usergrant.UserGrantReactivatedEvent:
+			if grant, ok := wm.UserGrants[e.Aggregate().ID]; ok {
+				grant.Active = true
+			}
+		case *usergrant.UserGrantRemovedEvent:
+			delete(wm.UserGrants, e.Aggregate().ID)
+		case *usergrant.UserGrantCascadeRemovedEvent:
+			delete(wm.UserGrants, e.Aggregate().ID)
+		case *org.MemberAddedEvent:
+			wm.OrgMembers[e.UserID] = e.Roles
+		case *org.MemberChangedEvent:
+			wm.OrgMembers[e.UserID] = e.Roles
+		case *org.MemberRemovedEvent:
+			delete(wm.OrgMembers, e.UserID)
+		case *org.MemberCascadeRemovedEvent:
+			delete(wm.OrgMembers, e.UserID)
+		case *project.MemberAddedEvent:
+			wm.setProjectMember(e.Aggregate().ID, e.UserID, e.Roles)
+		case *project.MemberChangedEvent:
+			wm.setProjectMember(e.Aggregate().ID, e.UserID, e.Roles)
+		case *project.MemberRemovedEvent:
+			delete(wm.ProjectMembers[e.Aggregate().ID], e.UserID)
+		case *project.MemberCascadeRemovedEvent:
+			delete(wm.ProjectMembers[e.Aggregate().ID], e.UserID)
+		case *project.ProjectRemovedEvent:
+			delete(wm.ProjectMembers, e.Aggregate().ID)
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *accessReviewScopeWriteModel) setProjectMember(projectID, userID string, roles []string) {
+	if wm.ProjectMembers[projectID] == nil {
+		wm.ProjectMembers[projectID] = make(map[string][]string)
+	}
+	wm.ProjectMembers[projectID][userID] = roles
+}
+
+func (wm *accessReviewScopeWriteModel) Query() *eventstore.SearchQueryBuilder {
+	query := eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(usergrant.AggregateType).
+		EventTypes(
+			usergrant.UserGrantAddedType,
+			usergrant.UserGrantChangedType,
+			usergrant.UserGrantCascadeChangedType,
+			usergrant.UserGrantDeactivatedType,
+			usergrant.UserGrantExpiredType,
+			usergrant.UserGrantReactivatedType,
+			usergrant.UserGrantRemovedType,
+			usergrant.UserGrantCascadeRemovedType,
+		).
+		Or().
+		AggregateTypes(project.AggregateType).
+		EventTypes(
+			project.MemberAddedEventType,
+			project.MemberChangedEventType,
+			project.MemberRemovedEventType,
+			project.MemberCascadeRemovedEventType,
+			project.ProjectRemovedType,
+		)
+	if wm.ProjectID != "" {
+		return query.AggregateIDs(wm.ProjectID).Builder()
+	}
+	return query.
+		Or().
+		AggregateTypes(org.AggregateType).
+		AggregateIDs(wm.ResourceOwner).
+		EventTypes(
+			org.MemberAddedEventType,
+			org.MemberChangedEventType,
+			org.MemberRemovedEventType,
+			org.MemberCascadeRemovedEventType,
+		).
+		Builder()
+}
+
+// items returns the snapshot of the collected user grants and memberships.
+// The items are sorted to get the same snapshot for the same state.
+func (wm *accessReviewScopeWriteModel) items() []*accessreview.Item {
+	items := make([]*accessreview.Item, 0, len(wm.UserGrants)+len(wm.OrgMembers))
+	for id, grant := range wm.UserGrants {
+		if !grant.Active || (wm.ProjectID != "" && grant.ProjectID != wm.ProjectID) {
+			continue
+		}
+		items = append(items, &accessreview.Item{
+			Type:           domain.AccessReviewItemTypeUserGrant,
+			UserID:         grant.UserID,
+			ProjectID:      grant.ProjectID,
+			ProjectGrantID: grant.ProjectGrantID,
+			UserGrantID:    id,
+			Roles:          grant.RoleKeys,
+		})
+	}
+	for userID, roles := range wm.OrgMembers {
+		items = append(items, &accessreview.Item{
+			Type:   domain.AccessReviewItemTypeOrgMember,
+			UserID: userID,
+			Roles:  roles,
+		})
+	}
+	for projectID, members := range wm.ProjectMembers {
+		for userID, roles := range members {
+			items = append(items, &accessreview.Item{
+				Type:      domain.AccessReviewItemTypeProjectMember,
+				UserID:    userID,
+				ProjectID: projectID,
+				Roles:     roles,
+			})
+		}
+	}
+	slices.SortFunc(items, func(a, b *accessreview.Item) int {
+		return cmp.Or(
+			cmp.Compare(a.Type, b.Type),
+			strings.Compare(a.ProjectID, b.ProjectID),
+			strings.Compare(a.UserID, b.UserID),
+			strings.Compare(a.UserGrantID, b.UserGrantID),
+		)
+	})
+	return items
+}
@@ -0,0 +1,696 @@
+package command
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/id"
+	id_mock "github.com/zitadel/zitadel/internal/id/mock"
+	"github.com/zitadel/zitadel/internal/repository/accessreview"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/repository/usergrant"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func accessReviewItems() []*accessreview.Item {
+	return []*accessreview.Item{
+		{
+			ID:          "item1",
+			Type:        domain.AccessReviewItemTypeUserGrant,
+			UserID:      "user1",
+			ProjectID:   "project1",
+			UserGrantID: "grant1",
+			Roles:       []string{"rolekey1"},
+			ReviewerID:  "reviewer1",
+		},
+		{
+			ID:         "item2",
+			Type:       domain.AccessReviewItemTypeOrgMember,
+			UserID:     "reviewer1",
+			Roles:      []string{"ORG_OWNER"},
+			ReviewerID: "creator1",
+		},
+	}
+}
+
+func accessReviewStartedEvent(ctx context.Context, deadline time.Time) *accessreview.StartedEvent {
+	return accessreview.NewStartedEvent(ctx,
+		&accessreview.NewAggregate("review1", "org1").Aggregate,
+		"Q1",
+		"",
+		deadline,
+		accessReviewItems(),
+	)
+}
+
+func accessReviewUserGrantAddedEvent() *usergrant.UserGrantAddedEvent {
+	return usergrant.NewUserGrantAddedEvent(context.Background(),
+		&usergrant.NewAggregate("grant1", "org1").Aggregate,
+		"user1",
+		"project1",
+		"",
+		[]string{"rolekey1"},
+	)
+}
+
+func TestCommandSide_StartAccessReview(t *testing.T) {
+	deadline := time.Now().Add(time.Hour)
+	type fields struct {
+		eventstore      *eventstore.Eventstore
+		idGenerator     id.Generator
+		checkPermission domain.PermissionCheck
+	}
+	type args struct {
+		ctx    context.Context
+		review *StartAccessReview
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "no reviewer, invalid argument error",
+			fields: fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args: args{
+				ctx: authz.NewMockContext("instance1", "org1", "creator1"),
+				review: &StartAccessReview{
+					Name:     "Q1",
+					Deadline: deadline,
+				},
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "deadline in the past, invalid argument error",
+			fields: fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args: args{
+				ctx: authz.NewMockContext("instance1", "org1", "creator1"),
+				review: &StartAccessReview{
+					Name:       "Q1",
+					Deadline:   time.Now().Add(-time.Hour),
+					ReviewerID: "reviewer1",
+				},
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "missing permission, permission denied error",
+			fields: fields{
+				eventstore:      eventstoreExpect(t),
+				checkPermission: newMockPermissionCheckNotAllowed(),
+			},
+			args: args{
+				ctx: authz.NewMockContext("instance1", "org1", "creator1"),
+				review: &StartAccessReview{
+					Name:       "Q1",
+					Deadline:   deadline,
+					ReviewerID: "reviewer1",
+				},
+			},
+			res: res{
+				err: zerrors.IsPermissionDenied,
+			},
+		},
+		{
+			name: "nothing to review, precondition error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanAddedEvent(context.Background(),
+								&user.NewAggregate("reviewer1", "org1").Aggregate,
+								"username1",
+								"firstname1",
+								"lastname1",
+								"nickname1",
+								"displayname1",
+								language.German,
+								domain.GenderMale,
+								"email1",
+								true,
+							),
+						),
+					),
+					expectFilter(),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args: args{
+				ctx: authz.NewMockContext("instance1", "org1", "creator1"),
+				review: &StartAccessReview{
+					Name:       "Q1",
+					Deadline:   deadline,
+					ReviewerID: "reviewer1",
+				},
+			},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "start review, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanAddedEvent(context.Background(),
+								&user.NewAggregate("reviewer1", "org1").Aggregate,
+								"username1",
+								"firstname1",
+								"lastname1",
+								"nickname1",
+								"displayname1",
+								language.German,
+								domain.GenderMale,
+								"email1",
+								true,
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							org.NewMemberAddedEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								"reviewer1",
+								"ORG_OWNER",
+							),
+						),
+						eventFromEventPusher(accessReviewUserGrantAddedEvent()),
+					),
+					expectPush(
+						accessReviewStartedEvent(authz.NewMockContext("instance1", "org1", "creator1"), deadline),
+					),
+				),
+				idGenerator:     id_mock.NewIDGeneratorExpectIDs(t, "review1", "item1", "item2"),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args: args{
+				ctx: authz.NewMockContext("instance1", "org1", "creator1"),
+				review: &StartAccessReview{
+					Name:       "Q1",
+					Deadline:   deadline,
+					ReviewerID: "reviewer1",
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ID:            "review1",
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore:      tt.fields.eventstore,
+				idGenerator:     tt.fields.idGenerator,
+				checkPermission: tt.fields.checkPermission,
+			}
+			got, err := r.StartAccessReview(tt.args.ctx, "org1", tt.args.review)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommandSide_DecideAccessReviewItem(t *testing.T) {
+	deadline := time.Now().Add(time.Hour)
+	type fields struct {
+		eventstore *eventstore.Eventstore
+	}
+	type args struct {
+		ctx      context.Context
+		itemID   string
+		decision domain.AccessReviewDecision
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "invalid decision, invalid argument error",
+			fields: fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args: args{
+				ctx:      authz.NewMockContext("instance1", "org1", "reviewer1"),
+				itemID:   "item1",
+				decision: domain.AccessReviewDecisionExpired,
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "review not found, not found error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx:      authz.NewMockContext("instance1", "org1", "reviewer1"),
+				itemID:   "item1",
+				decision: domain.AccessReviewDecisionKeep,
+			},
+			res: res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			name: "own item, permission denied error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(accessReviewStartedEvent(context.Background(), deadline)),
+					),
+				),
+			},
+			args: args{
+				ctx:      authz.NewMockContext("instance1", "org1", "reviewer1"),
+				itemID:   "item2",
+				decision: domain.AccessReviewDecisionKeep,
+			},
+			res: res{
+				err: zerrors.IsPermissionDenied,
+			},
+		},
+		{
+			name: "not assigned reviewer, permission denied error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(accessReviewStartedEvent(context.Background(), deadline)),
+					),
+				),
+			},
+			args: args{
+				ctx:      authz.NewMockContext("instance1", "org1", "creator1"),
+				itemID:   "item1",
+				decision: domain.AccessReviewDecisionKeep,
+			},
+			res: res{
+				err: zerrors.IsPermissionDenied,
+			},
+		},
+		{
+			name: "already decided, precondition error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(accessReviewStartedEvent(context.Background(), deadline)),
+						eventFromEventPusher(
+							accessreview.NewItemDecidedEvent(context.Background(),
+								&accessreview.NewAggregate("review1", "org1").Aggregate,
+								"item1",
+								domain.AccessReviewDecisionKeep,
+								"",
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:      authz.NewMockContext("instance1", "org1", "reviewer1"),
+				itemID:   "item1",
+				decision: domain.AccessReviewDecisionRevoke,
+			},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "keep, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(accessReviewStartedEvent(context.Background(), deadline)),
+					),
+					expectPush(
+						accessreview.NewItemDecidedEvent(authz.NewMockContext("instance1", "org1", "reviewer1"),
+							&accessreview.NewAggregate("review1", "org1").Aggregate,
+							"item1",
+							domain.AccessReviewDecisionKeep,
+							"still needed",
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:      authz.NewMockContext("instance1", "org1", "reviewer1"),
+				itemID:   "item1",
+				decision: domain.AccessReviewDecisionKeep,
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+		{
+			name: "revoke last item, grant removed and review closed",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(accessReviewStartedEvent(context.Background(), deadline)),
+						eventFromEventPusher(
+							accessreview.NewItemDecidedEvent(context.Background(),
+								&accessreview.NewAggregate("review1", "org1").Aggregate,
+								"item2",
+								domain.AccessReviewDecisionKeep,
+								"",
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(accessReviewUserGrantAddedEvent()),
+					),
+					expectPush(
+						usergrant.NewUserGrantCascadeRemovedEvent(authz.NewMockContext("instance1", "org1", "reviewer1"),
+							&usergrant.NewAggregate("grant1", "org1").Aggregate,
+							"user1",
+							"project1",
+							"",
+						),
+						accessreview.NewItemDecidedEvent(authz.NewMockContext("instance1", "org1", "reviewer1"),
+							&accessreview.NewAggregate("review1", "org1").Aggregate,
+							"item1",
+							domain.AccessReviewDecisionRevoke,
+							"still needed",
+						),
+						accessreview.NewClosedEvent(authz.NewMockContext("instance1", "org1", "reviewer1"),
+							&accessreview.NewAggregate("review1", "org1").Aggregate,
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:      authz.NewMockContext("instance1", "org1", "reviewer1"),
+				itemID:   "item1",
+				decision: domain.AccessReviewDecisionRevoke,
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore,
+			}
+			got, err := r.DecideAccessReviewItem(tt.args.ctx, "review1", "org1", tt.args.itemID, tt.args.decision, "still needed")
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommandSide_ReassignAccessReviewItem(t *testing.T) {
+	deadline := time.Now().Add(time.Hour)
+	type fields struct {
+		eventstore      *eventstore.Eventstore
+		checkPermission domain.PermissionCheck
+	}
+	type args struct {
+		ctx        context.Context
+		reviewerID string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "missing permission, permission denied error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(accessReviewStartedEvent(context.Background(), deadline)),
+					),
+				),
+				checkPermission: newMockPermissionCheckNotAllowed(),
+			},
+			args: args{
+				ctx:        authz.NewMockContext("instance1", "org1", "creator1"),
+				reviewerID: "reviewer2",
+			},
+			res: res{
+				err: zerrors.IsPermissionDenied,
+			},
+		},
+		{
+			name: "reviewer is user of the item, precondition error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(accessReviewStartedEvent(context.Background(), deadline)),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args: args{
+				ctx:        authz.NewMockContext("instance1", "org1", "creator1"),
+				reviewerID: "user1",
+			},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "reassign, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(accessReviewStartedEvent(context.Background(), deadline)),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanAddedEvent(context.Background(),
+								&user.NewAggregate("reviewer2", "org1").Aggregate,
+								"username2",
+								"firstname2",
+								"lastname2",
+								"nickname2",
+								"displayname2",
+								language.German,
+								domain.GenderMale,
+								"email2",
+								true,
+							),
+						),
+					),
+					expectPush(
+						accessreview.NewItemReassignedEvent(authz.NewMockContext("instance1", "org1", "creator1"),
+							&accessreview.NewAggregate("review1", "org1").Aggregate,
+							"item1",
+							"reviewer2",
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args: args{
+				ctx:        authz.NewMockContext("instance1", "org1", "creator1"),
+				reviewerID: "reviewer2",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore:      tt.fields.eventstore,
+				checkPermission: tt.fields.checkPermission,
+			}
+			got, err := r.ReassignAccessReviewItem(tt.args.ctx, "review1", "org1", "item1", tt.args.reviewerID)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommandSide_ExpireAccessReview(t *testing.T) {
+	type fields struct {
+		eventstore *eventstore.Eventstore
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		res    res
+	}{
+		{
+			name: "deadline not reached, precondition error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(accessReviewStartedEvent(context.Background(), time.Now().Add(time.Hour))),
+					),
+				),
+			},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "already closed, precondition error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(accessReviewStartedEvent(context.Background(), time.Now().Add(-time.Hour))),
+						eventFromEventPusher(
+							accessreview.NewClosedEvent(context.Background(),
+								&accessreview.NewAggregate("review1", "org1").Aggregate,
+							),
+						),
+					),
+				),
+			},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "pending items revoked, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(accessReviewStartedEvent(context.Background(), time.Now().Add(-time.Hour))),
+						eventFromEventPusher(
+							accessreview.NewItemDecidedEvent(context.Background(),
+								&accessreview.NewAggregate("review1", "org1").Aggregate,
+								"item2",
+								domain.AccessReviewDecisionKeep,
+								"",
+							),
+						),
+					),
+					// the grant was already removed in the meantime
+					expectFilter(
+						eventFromEventPusher(accessReviewUserGrantAddedEvent()),
+						eventFromEventPusher(
+							usergrant.NewUserGrantRemovedEvent(context.Background(),
+								&usergrant.NewAggregate("grant1", "org1").Aggregate,
+								"user1",
+								"project1",
+								"",
+							),
+						),
+					),
+					expectPush(
+						accessreview.NewItemDecidedEvent(authz.NewMockContext("instance1", "org1", "system"),
+							&accessreview.NewAggregate("review1", "org1").Aggregate,
+							"item1",
+							domain.AccessReviewDecisionExpired,
+							"",
+						),
+						accessreview.NewClosedEvent(authz.NewMockContext("instance1", "org1", "system"),
+							&accessreview.NewAggregate("review1", "org1").Aggregate,
+						),
+					),
+				),
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore,
+			}
+			got, err := r.ExpireAccessReview(authz.NewMockContext("instance1", "org1", "system"), "review1", "org1")
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.want, got)
+			}
+		})
+	}
+}
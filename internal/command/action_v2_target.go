@@ -2,6 +2,8 @@ package command
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"net/url"
 	"time"
 
@@ -21,10 +23,68 @@ type AddTarget struct {
 	Endpoint         string
 	Timeout          time.Duration
 	InterruptOnError bool
+	TLS              *TargetTLS
 
 	SigningKey string
 }
 
+// TargetTLS configures the connection to targets of type [domain.TargetTypeGRPC],
+// all certificates and keys are PEM encoded.
+type TargetTLS struct {
+	// ClientCertificate and ClientKey are presented to the target for mutual TLS
+	ClientCertificate []byte
+	ClientKey         []byte
+	// RootCA is used to verify the certificate of the target instead of the system roots
+	RootCA []byte
+}
+
+func (t *TargetTLS) isEmpty() bool {
+	return len(t.ClientCertificate) == 0 && len(t.ClientKey) == 0 && len(t.RootCA) == 0
+}
+
+func (t *TargetTLS) IsValid() error {
+	if len(t.ClientCertificate) > 0 || len(t.ClientKey) > 0 {
+		if _, err := tls.X509KeyPair(t.ClientCertificate, t.ClientKey); err != nil {
+			return zerrors.ThrowInvalidArgument(err, "COMMAND-ieD3u", "Errors.Target.InvalidClientCertificate")
+		}
+	}
+	if len(t.RootCA) > 0 && !x509.NewCertPool().AppendCertsFromPEM(t.RootCA) {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Ooz7b", "Errors.Target.InvalidRootCA")
+	}
+	return nil
+}
+
+// validateGRPCTarget checks that the endpoint is the address of the gRPC service,
+// https is used to connect with TLS, http for plaintext connections.
+func validateGRPCTarget(endpoint string, tlsConfig *TargetTLS) error {
+	u, err := url.Parse(endpoint)
+	if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" || (u.Path != "" && u.Path != "/") {
+		return zerrors.ThrowInvalidArgument(err, "COMMAND-Ahj0e", "Errors.Target.InvalidURL")
+	}
+	if tlsConfig != nil && !tlsConfig.isEmpty() && u.Scheme != "https" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-ee5Ai", "Errors.Target.TLSNotSupported")
+	}
+	return nil
+}
+
+func (c *Commands) encryptTargetTLS(t *TargetTLS) (*target.TLS, error) {
+	if t == nil {
+		return nil, nil
+	}
+	encrypted := &target.TLS{
+		ClientCertificate: t.ClientCertificate,
+		RootCA:            t.RootCA,
+	}
+	if len(t.ClientKey) > 0 {
+		key, err := crypto.Encrypt(t.ClientKey, c.targetEncryption)
+		if err != nil {
+			return nil, err
+		}
+		encrypted.ClientKey = key
+	}
+	return encrypted, nil
+}
+
 func (a *AddTarget) IsValid() error {
 	if a.Name == "" {
 		return zerrors.ThrowInvalidArgument(nil, "COMMAND-ddqbm9us5p", "Errors.Target.Invalid")
@@ -36,7 +96,16 @@ func (a *AddTarget) IsValid() error {
 	if err != nil || a.Endpoint == "" {
 		return zerrors.ThrowInvalidArgument(err, "COMMAND-1r2k6qo6wg", "Errors.Target.InvalidURL")
 	}
-
+	if a.TargetType == domain.TargetTypeGRPC {
+		if err := validateGRPCTarget(a.Endpoint, a.TLS); err != nil {
+			return err
+		}
+	} else if a.TLS != nil && !a.TLS.isEmpty() {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Phie4", "Errors.Target.TLSNotSupported")
+	}
+	if a.TLS != nil {
+		return a.TLS.IsValid()
+	}
 	return nil
 }
 
@@ -67,6 +136,10 @@ func (c *Commands) AddTarget(ctx context.Context, add *AddTarget, resourceOwner
 		return time.Time{}, err
 	}
 	add.SigningKey = code.PlainCode()
+	tlsConfig, err := c.encryptTargetTLS(add.TLS)
+	if err != nil {
+		return time.Time{}, err
+	}
 	pushedEvents, err := c.eventstore.Push(ctx, target.NewAddedEvent(
 		ctx,
 		TargetAggregateFromWriteModel(&wm.WriteModel),
@@ -76,6 +149,7 @@ func (c *Commands) AddTarget(ctx context.Context, add *AddTarget, resourceOwner
 		add.Timeout,
 		add.InterruptOnError,
 		code.Crypted,
+		tlsConfig,
 	))
 	if err != nil {
 		return time.Time{}, err
@@ -94,6 +168,8 @@ type ChangeTarget struct {
 	Endpoint         *string
 	Timeout          *time.Duration
 	InterruptOnError *bool
+	// TLS replaces the whole configuration, an empty configuration removes it
+	TLS *TargetTLS
 
 	ExpirationSigningKey bool
 	SigningKey           *string
@@ -115,6 +191,9 @@ func (a *ChangeTarget) IsValid() error {
 			return zerrors.ThrowInvalidArgument(err, "COMMAND-jsbaera7b6", "Errors.Target.InvalidURL")
 		}
 	}
+	if a.TLS != nil {
+		return a.TLS.IsValid()
+	}
 	return nil
 }
 
@@ -132,6 +211,13 @@ func (c *Commands) ChangeTarget(ctx context.Context, change *ChangeTarget, resou
 	if !existing.State.Exists() {
 		return time.Time{}, zerrors.ThrowNotFound(nil, "COMMAND-xj14f2cccn", "Errors.Target.NotFound")
 	}
+	if err := change.validateResulting(existing); err != nil {
+		return time.Time{}, err
+	}
+	tlsConfig, err := c.encryptTargetTLS(change.TLS)
+	if err != nil {
+		return time.Time{}, err
+	}
 
 	var changedSigningKey *crypto.CryptoValue
 	if change.ExpirationSigningKey {
@@ -152,6 +238,7 @@ func (c *Commands) ChangeTarget(ctx context.Context, change *ChangeTarget, resou
 		change.Timeout,
 		change.InterruptOnError,
 		changedSigningKey,
+		tlsConfig,
 	)
 	if changedEvent == nil {
 		return existing.WriteModel.ChangeDate, nil
@@ -167,6 +254,24 @@ func (c *Commands) ChangeTarget(ctx context.Context, change *ChangeTarget, resou
 	return existing.WriteModel.ChangeDate, nil
 }
 
+// validateResulting validates the target type specific settings of the target as it will be after the change.
+func (a *ChangeTarget) validateResulting(existing *TargetWriteModel) error {
+	targetType, endpoint := existing.TargetType, existing.Endpoint
+	if a.TargetType != nil {
+		targetType = *a.TargetType
+	}
+	if a.Endpoint != nil {
+		endpoint = *a.Endpoint
+	}
+	if targetType == domain.TargetTypeGRPC {
+		return validateGRPCTarget(endpoint, a.TLS)
+	}
+	if a.TLS != nil && !a.TLS.isEmpty() {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-ya2Oo", "Errors.Target.TLSNotSupported")
+	}
+	return nil
+}
+
 func (c *Commands) DeleteTarget(ctx context.Context, id, resourceOwner string) (time.Time, error) {
 	if id == "" || resourceOwner == "" {
 		return time.Time{}, zerrors.ThrowInvalidArgument(nil, "COMMAND-obqos2l3no", "Errors.IDMissing")
@@ -91,6 +91,7 @@ func (wm *TargetWriteModel) NewChangedEvent(
 	timeout *time.Duration,
 	interruptOnError *bool,
 	signingKey *crypto.CryptoValue,
+	tls *target.TLS,
 ) *target.ChangedEvent {
 	changes := make([]target.Changes, 0)
 	if name != nil && wm.Name != *name {
@@ -112,6 +113,10 @@ func (wm *TargetWriteModel) NewChangedEvent(
 	if signingKey != nil {
 		changes = append(changes, target.ChangeSigningKey(signingKey))
 	}
+	// the client key is encrypted, so the configuration is always replaced if set
+	if tls != nil {
+		changes = append(changes, target.ChangeTLS(tls))
+	}
 	if len(changes) == 0 {
 		return nil
 	}
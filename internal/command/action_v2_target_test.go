@@ -2,11 +2,20 @@ package command
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
 	"testing"
 	"time"
 
 	"github.com/muhlemmer/gu"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
 
 	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/domain"
@@ -18,12 +27,32 @@ import (
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
+// targetTLSKeyPair returns a self-signed certificate and its private key in PEM format.
+func targetTLSKeyPair(t *testing.T) (certificate, key []byte) {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "target.example.com"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &privateKey.PublicKey, privateKey)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(privateKey)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
+
 func TestCommands_AddTarget(t *testing.T) {
+	certificate, key := targetTLSKeyPair(t)
 	type fields struct {
 		eventstore                  func(t *testing.T) *eventstore.Eventstore
 		idGenerator                 id.Generator
 		newEncryptedCodeWithDefault encryptedCodeWithDefaultFunc
 		defaultSecretGenerators     *SecretGenerators
+		targetEncryption            crypto.EncryptionAlgorithm
 	}
 	type args struct {
 		ctx           context.Context
@@ -140,6 +169,7 @@ func TestCommands_AddTarget(t *testing.T) {
 								KeyID:      "id",
 								Crypted:    []byte("12345678"),
 							},
+							nil,
 						),
 					),
 				),
@@ -246,6 +276,132 @@ func TestCommands_AddTarget(t *testing.T) {
 				id: "id1",
 			},
 		},
+		{
+			"grpc, endpoint with path, error",
+			fields{
+				eventstore: expectEventstore(),
+			},
+			args{
+				ctx: context.Background(),
+				add: &AddTarget{
+					Name:       "name",
+					TargetType: domain.TargetTypeGRPC,
+					Timeout:    time.Second,
+					Endpoint:   "https://example.com/path",
+				},
+				resourceOwner: "instance",
+			},
+			res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			"grpc, tls on plaintext endpoint, error",
+			fields{
+				eventstore: expectEventstore(),
+			},
+			args{
+				ctx: context.Background(),
+				add: &AddTarget{
+					Name:       "name",
+					TargetType: domain.TargetTypeGRPC,
+					Timeout:    time.Second,
+					Endpoint:   "http://example.com",
+					TLS:        &TargetTLS{RootCA: certificate},
+				},
+				resourceOwner: "instance",
+			},
+			res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			"grpc, invalid client certificate, error",
+			fields{
+				eventstore: expectEventstore(),
+			},
+			args{
+				ctx: context.Background(),
+				add: &AddTarget{
+					Name:       "name",
+					TargetType: domain.TargetTypeGRPC,
+					Timeout:    time.Second,
+					Endpoint:   "https://example.com",
+					TLS:        &TargetTLS{ClientCertificate: certificate, ClientKey: []byte("invalid")},
+				},
+				resourceOwner: "instance",
+			},
+			res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			"webhook with tls, error",
+			fields{
+				eventstore: expectEventstore(),
+			},
+			args{
+				ctx: context.Background(),
+				add: &AddTarget{
+					Name:       "name",
+					TargetType: domain.TargetTypeWebhook,
+					Timeout:    time.Second,
+					Endpoint:   "https://example.com",
+					TLS:        &TargetTLS{RootCA: certificate},
+				},
+				resourceOwner: "instance",
+			},
+			res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			"grpc with tls, push ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+					expectPush(
+						func() eventstore.Command {
+							event := targetAddEvent("id1", "instance")
+							event.TargetType = domain.TargetTypeGRPC
+							event.TLS = &target.TLS{
+								ClientCertificate: certificate,
+								ClientKey: &crypto.CryptoValue{
+									CryptoType: crypto.TypeEncryption,
+									Algorithm:  "enc",
+									KeyID:      "id",
+									Crypted:    key,
+								},
+								RootCA: certificate,
+							}
+							return event
+						}(),
+					),
+				),
+				idGenerator:                 mock.ExpectID(t, "id1"),
+				newEncryptedCodeWithDefault: mockEncryptedCodeWithDefault("12345678", time.Hour),
+				defaultSecretGenerators:     &SecretGenerators{},
+				targetEncryption:            crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			},
+			args{
+				ctx: context.Background(),
+				add: &AddTarget{
+					Name:       "name",
+					TargetType: domain.TargetTypeGRPC,
+					Timeout:    time.Second,
+					Endpoint:   "https://example.com",
+					TLS: &TargetTLS{
+						ClientCertificate: certificate,
+						ClientKey:         key,
+						RootCA:            certificate,
+					},
+				},
+				resourceOwner: "instance",
+			},
+			res{
+				id: "id1",
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -254,6 +410,7 @@ func TestCommands_AddTarget(t *testing.T) {
 				idGenerator:                 tt.fields.idGenerator,
 				newEncryptedCodeWithDefault: tt.fields.newEncryptedCodeWithDefault,
 				defaultSecretGenerators:     tt.fields.defaultSecretGenerators,
+				targetEncryption:            tt.fields.targetEncryption,
 			}
 			_, err := c.AddTarget(tt.args.ctx, tt.args.add, tt.args.resourceOwner)
 			if tt.res.err == nil {
@@ -270,10 +427,12 @@ func TestCommands_AddTarget(t *testing.T) {
 }
 
 func TestCommands_ChangeTarget(t *testing.T) {
+	certificate, key := targetTLSKeyPair(t)
 	type fields struct {
 		eventstore                  func(t *testing.T) *eventstore.Eventstore
 		newEncryptedCodeWithDefault encryptedCodeWithDefaultFunc
 		defaultSecretGenerators     *SecretGenerators
+		targetEncryption            crypto.EncryptionAlgorithm
 	}
 	type args struct {
 		ctx           context.Context
@@ -538,6 +697,76 @@ func TestCommands_ChangeTarget(t *testing.T) {
 			},
 			res{},
 		},
+		{
+			"tls on webhook target, error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							targetAddEvent("id1", "instance"),
+						),
+					),
+				),
+			},
+			args{
+				ctx: context.Background(),
+				change: &ChangeTarget{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID: "id1",
+					},
+					TLS: &TargetTLS{RootCA: certificate},
+				},
+				resourceOwner: "instance",
+			},
+			res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			"change to grpc with tls, push ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							targetAddEvent("id1", "instance"),
+						),
+					),
+					expectPush(
+						target.NewChangedEvent(context.Background(),
+							target.NewAggregate("id1", "instance"),
+							[]target.Changes{
+								target.ChangeTargetType(domain.TargetTypeGRPC),
+								target.ChangeTLS(&target.TLS{
+									ClientCertificate: certificate,
+									ClientKey: &crypto.CryptoValue{
+										CryptoType: crypto.TypeEncryption,
+										Algorithm:  "enc",
+										KeyID:      "id",
+										Crypted:    key,
+									},
+								}),
+							},
+						),
+					),
+				),
+				targetEncryption: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			},
+			args{
+				ctx: context.Background(),
+				change: &ChangeTarget{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID: "id1",
+					},
+					TargetType: gu.Ptr(domain.TargetTypeGRPC),
+					TLS: &TargetTLS{
+						ClientCertificate: certificate,
+						ClientKey:         key,
+					},
+				},
+				resourceOwner: "instance",
+			},
+			res{},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -545,6 +774,7 @@ func TestCommands_ChangeTarget(t *testing.T) {
 				eventstore:                  tt.fields.eventstore(t),
 				newEncryptedCodeWithDefault: tt.fields.newEncryptedCodeWithDefault,
 				defaultSecretGenerators:     tt.fields.defaultSecretGenerators,
+				targetEncryption:            tt.fields.targetEncryption,
 			}
 			_, err := c.ChangeTarget(tt.args.ctx, tt.args.change, tt.args.resourceOwner)
 			if tt.res.err == nil {
@@ -2,10 +2,12 @@ package command
 
 import (
 	"context"
+	"net/url"
 	"time"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/repository/authrequest"
 	"github.com/zitadel/zitadel/internal/telemetry/tracing"
 	"github.com/zitadel/zitadel/internal/zerrors"
@@ -30,6 +32,8 @@ type AuthRequest struct {
 	HintUserID       *string
 	NeedRefreshToken bool
 	Issuer           string
+	// AuthorizationDetails are the fine-grained authorizations requested by the client (RFC 9396).
+	AuthorizationDetails domain.AuthorizationDetails
 }
 
 type CurrentAuthRequest struct {
@@ -75,6 +79,7 @@ func (c *Commands) AddAuthRequest(ctx context.Context, authRequest *AuthRequest)
 		authRequest.HintUserID,
 		authRequest.NeedRefreshToken,
 		authRequest.Issuer,
+		authRequest.AuthorizationDetails,
 	))
 	if err != nil {
 		return nil, err
@@ -82,7 +87,7 @@ func (c *Commands) AddAuthRequest(ctx context.Context, authRequest *AuthRequest)
 	return authRequestWriteModelToCurrentAuthRequest(writeModel), nil
 }
 
-func (c *Commands) LinkSessionToAuthRequest(ctx context.Context, id, sessionID, sessionToken string, checkLoginClient bool, projectPermissionCheck domain.ProjectPermissionCheck) (*domain.ObjectDetails, *CurrentAuthRequest, error) {
+func (c *Commands) LinkSessionToAuthRequest(ctx context.Context, id, sessionID, sessionToken string, checkLoginClient bool, projectPermissionCheck domain.ProjectPermissionCheck, consentCheck OIDCConsentCheck) (*domain.ObjectDetails, *CurrentAuthRequest, error) {
 	writeModel, err := c.getAuthRequestWriteModel(ctx, id)
 	if err != nil {
 		return nil, nil, err
@@ -116,14 +121,37 @@ func (c *Commands) LinkSessionToAuthRequest(ctx context.Context, id, sessionID,
 			return nil, nil, err
 		}
 	}
+	cmds := []eventstore.Command{
+		authrequest.NewSessionLinkedEvent(
+			ctx, &authrequest.NewAggregate(id, authz.GetInstance(ctx).InstanceID()).Aggregate,
+			sessionID,
+			sessionWriteModel.UserID,
+			sessionWriteModel.AuthenticationTime(),
+			sessionWriteModel.AuthMethodTypes(),
+		),
+	}
+	if consentCheck != nil {
+		consent, err := consentCheck(ctx, writeModel.ClientID, sessionWriteModel.UserID, writeModel.Scope)
+		if err != nil {
+			return nil, nil, err
+		}
+		if consent != nil {
+			_, consentCmd, err := c.grantOIDCConsent(ctx, consent)
+			if err != nil {
+				return nil, nil, err
+			}
+			if consentCmd != nil {
+				cmds = append(cmds, consentCmd)
+			}
+		}
+	}
 
-	if err := c.pushAppendAndReduce(ctx, writeModel, authrequest.NewSessionLinkedEvent(
-		ctx, &authrequest.NewAggregate(id, authz.GetInstance(ctx).InstanceID()).Aggregate,
-		sessionID,
-		sessionWriteModel.UserID,
-		sessionWriteModel.AuthenticationTime(),
-		sessionWriteModel.AuthMethodTypes(),
-	)); err != nil {
+	pushedEvents, err := c.eventstore.Push(ctx, cmds...)
+	if err != nil {
+		return nil, nil, err
+	}
+	// only the first event belongs to the auth request
+	if err = AppendAndReduce(writeModel, pushedEvents[0]); err != nil {
 		return nil, nil, err
 	}
 	return writeModelToObjectDetails(&writeModel.WriteModel), authRequestWriteModelToCurrentAuthRequest(writeModel), nil
@@ -163,6 +191,56 @@ func (c *Commands) AddAuthRequestCode(ctx context.Context, authRequestID, code s
 		&authrequest.NewAggregate(writeModel.AggregateID, authz.GetInstance(ctx).InstanceID()).Aggregate))
 }
 
+// AddPushedAuthRequest stores the parameters of a pushed authorization request (RFC 9126)
+// of an already authenticated client. The returned ID is used to reference the request on the authorization endpoint.
+func (c *Commands) AddPushedAuthRequest(ctx context.Context, clientID string, parameters url.Values, expiration time.Time) (_ string, err error) {
+	if clientID == "" {
+		return "", zerrors.ThrowInvalidArgument(nil, "COMMAND-Aiv3u", "Errors.IDMissing")
+	}
+	id, err := c.idGenerator.Next()
+	if err != nil {
+		return "", err
+	}
+	writeModel, err := c.getAuthRequestWriteModel(ctx, id)
+	if err != nil {
+		return "", err
+	}
+	if writeModel.AuthRequestState != domain.AuthRequestStateUnspecified {
+		return "", zerrors.ThrowPreconditionFailed(nil, "COMMAND-ohX6e", "Errors.AuthRequest.AlreadyExisting")
+	}
+	err = c.pushAppendAndReduce(ctx, writeModel, authrequest.NewPushedEvent(
+		ctx,
+		&authrequest.NewAggregate(id, authz.GetInstance(ctx).InstanceID()).Aggregate,
+		clientID,
+		parameters,
+		expiration,
+	))
+	if err != nil {
+		return "", err
+	}
+	return id, nil
+}
+
+// UsePushedAuthRequest returns the parameters of a pushed authorization request
+// and ensures the request_uri can only be used once and only by the client which pushed it.
+func (c *Commands) UsePushedAuthRequest(ctx context.Context, id, clientID string) (_ url.Values, err error) {
+	writeModel, err := c.getAuthRequestWriteModel(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if writeModel.AuthRequestState != domain.AuthRequestStatePushed ||
+		writeModel.ClientID != clientID ||
+		writeModel.Expiration.Before(time.Now()) {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Eeng3", "Errors.AuthRequest.RequestURIInvalid")
+	}
+	err = c.pushAppendAndReduce(ctx, writeModel, authrequest.NewRequestURIUsedEvent(ctx,
+		&authrequest.NewAggregate(writeModel.AggregateID, authz.GetInstance(ctx).InstanceID()).Aggregate))
+	if err != nil {
+		return nil, err
+	}
+	return writeModel.Parameters, nil
+}
+
 func authRequestWriteModelToCurrentAuthRequest(writeModel *AuthRequestWriteModel) (_ *CurrentAuthRequest) {
 	return &CurrentAuthRequest{
 		AuthRequest: &AuthRequest{
@@ -183,6 +261,8 @@ func authRequestWriteModelToCurrentAuthRequest(writeModel *AuthRequestWriteModel
 			LoginHint:     writeModel.LoginHint,
 			HintUserID:    writeModel.HintUserID,
 			Issuer:        writeModel.Issuer,
+
+			AuthorizationDetails: writeModel.AuthorizationDetails,
 		},
 		SessionID:   writeModel.SessionID,
 		UserID:      writeModel.UserID,
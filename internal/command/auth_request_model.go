@@ -2,6 +2,7 @@ package command
 
 import (
 	"context"
+	"net/url"
 	"time"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
@@ -37,6 +38,10 @@ type AuthRequestWriteModel struct {
 	AuthRequestState domain.AuthRequestState
 	NeedRefreshToken bool
 	Issuer           string
+	Parameters       url.Values
+	// AuthorizationDetails are the fine-grained authorizations requested by the client (RFC 9396).
+	AuthorizationDetails domain.AuthorizationDetails
+	Expiration           time.Time
 }
 
 func NewAuthRequestWriteModel(ctx context.Context, id string) *AuthRequestWriteModel {
@@ -70,6 +75,7 @@ func (m *AuthRequestWriteModel) Reduce() error {
 			m.AuthRequestState = domain.AuthRequestStateAdded
 			m.NeedRefreshToken = e.NeedRefreshToken
 			m.Issuer = e.Issuer
+			m.AuthorizationDetails = e.AuthorizationDetails
 		case *authrequest.SessionLinkedEvent:
 			m.SessionID = e.SessionID
 			m.UserID = e.UserID
@@ -83,6 +89,13 @@ func (m *AuthRequestWriteModel) Reduce() error {
 			m.AuthRequestState = domain.AuthRequestStateCodeExchanged
 		case *authrequest.SucceededEvent:
 			m.AuthRequestState = domain.AuthRequestStateSucceeded
+		case *authrequest.PushedEvent:
+			m.ClientID = e.ClientID
+			m.Parameters = e.Parameters
+			m.Expiration = e.Expiration
+			m.AuthRequestState = domain.AuthRequestStatePushed
+		case *authrequest.RequestURIUsedEvent:
+			m.AuthRequestState = domain.AuthRequestStateRequestURIUsed
 		}
 	}
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"net"
 	"net/http"
+	"net/url"
 	"testing"
 	"time"
 
@@ -18,7 +19,9 @@ import (
 	"github.com/zitadel/zitadel/internal/id"
 	"github.com/zitadel/zitadel/internal/id/mock"
 	"github.com/zitadel/zitadel/internal/repository/authrequest"
+	"github.com/zitadel/zitadel/internal/repository/oidcconsent"
 	"github.com/zitadel/zitadel/internal/repository/session"
+	"github.com/zitadel/zitadel/internal/repository/user"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
@@ -63,6 +66,7 @@ func TestCommands_AddAuthRequest(t *testing.T) {
 								nil,
 								false,
 								"issuer",
+								nil,
 							),
 						),
 					),
@@ -103,6 +107,7 @@ func TestCommands_AddAuthRequest(t *testing.T) {
 							gu.Ptr("hintUserID"),
 							false,
 							"issuer",
+							nil,
 						),
 					),
 				),
@@ -186,6 +191,7 @@ func TestCommands_LinkSessionToAuthRequest(t *testing.T) {
 		sessionToken     string
 		checkLoginClient bool
 		permissionCheck  domain.ProjectPermissionCheck
+		consentCheck     OIDCConsentCheck
 	}
 	type res struct {
 		details *domain.ObjectDetails
@@ -239,6 +245,7 @@ func TestCommands_LinkSessionToAuthRequest(t *testing.T) {
 								nil,
 								true,
 								"issuer",
+								nil,
 							),
 						),
 						eventFromEventPusher(
@@ -282,6 +289,7 @@ func TestCommands_LinkSessionToAuthRequest(t *testing.T) {
 								nil,
 								true,
 								"issuer",
+								nil,
 							),
 						),
 					),
@@ -324,6 +332,7 @@ func TestCommands_LinkSessionToAuthRequest(t *testing.T) {
 								nil,
 								true,
 								"issuer",
+								nil,
 							),
 						),
 					),
@@ -364,6 +373,7 @@ func TestCommands_LinkSessionToAuthRequest(t *testing.T) {
 								nil,
 								true,
 								"issuer",
+								nil,
 							),
 						),
 					),
@@ -427,6 +437,7 @@ func TestCommands_LinkSessionToAuthRequest(t *testing.T) {
 								nil,
 								true,
 								"issuer",
+								nil,
 							),
 						),
 					),
@@ -479,6 +490,7 @@ func TestCommands_LinkSessionToAuthRequest(t *testing.T) {
 								nil,
 								true,
 								"issuer",
+								nil,
 							),
 						),
 					),
@@ -569,6 +581,7 @@ func TestCommands_LinkSessionToAuthRequest(t *testing.T) {
 								nil,
 								true,
 								"issuer",
+								nil,
 							),
 						),
 					),
@@ -660,6 +673,7 @@ func TestCommands_LinkSessionToAuthRequest(t *testing.T) {
 								nil,
 								true,
 								"issuer",
+								nil,
 							),
 						),
 					),
@@ -752,6 +766,101 @@ func TestCommands_LinkSessionToAuthRequest(t *testing.T) {
 								nil,
 								true,
 								"issuer",
+								nil,
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							session.NewAddedEvent(mockCtx,
+								&session.NewAggregate("sessionID", "instance1").Aggregate,
+								&domain.UserAgent{
+									FingerprintID: gu.Ptr("fp1"),
+									IP:            net.ParseIP("1.2.3.4"),
+									Description:   gu.Ptr("firefox"),
+									Header:        http.Header{"foo": []string{"bar"}},
+								},
+							)),
+						eventFromEventPusher(
+							session.NewUserCheckedEvent(mockCtx, &session.NewAggregate("sessionID", "instance1").Aggregate,
+								"userID", "org1", testNow, &language.Afrikaans),
+						),
+						eventFromEventPusher(
+							session.NewPasswordCheckedEvent(mockCtx, &session.NewAggregate("sessionID", "instance1").Aggregate,
+								testNow),
+						),
+						eventFromEventPusherWithCreationDateNow(
+							session.NewLifetimeSetEvent(mockCtx, &session.NewAggregate("sessionID", "instance1").Aggregate,
+								2*time.Minute),
+						),
+					),
+					expectPush(
+						authrequest.NewSessionLinkedEvent(mockCtx, &authrequest.NewAggregate("V2_id", "instanceID").Aggregate,
+							"sessionID",
+							"userID",
+							testNow,
+							[]domain.UserAuthMethodType{domain.UserAuthMethodTypePassword},
+						),
+					),
+				),
+				tokenVerifier:   newMockTokenVerifierValid(),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args{
+				ctx:              authz.NewMockContext("instanceID", "orgID", "loginClient"),
+				id:               "V2_id",
+				sessionID:        "sessionID",
+				sessionToken:     "token",
+				checkLoginClient: true,
+				permissionCheck:  newMockProjectPermissionCheckAllowed(),
+			},
+			res{
+				details: &domain.ObjectDetails{ResourceOwner: "instanceID"},
+				authReq: &CurrentAuthRequest{
+					AuthRequest: &AuthRequest{
+						ID:           "V2_id",
+						LoginClient:  "otherLoginClient",
+						ClientID:     "clientID",
+						RedirectURI:  "redirectURI",
+						State:        "state",
+						Nonce:        "nonce",
+						Scope:        []string{"openid"},
+						Audience:     []string{"audience"},
+						ResponseType: domain.OIDCResponseTypeCode,
+						ResponseMode: domain.OIDCResponseModeQuery,
+						Issuer:       "issuer",
+					},
+					SessionID:   "sessionID",
+					UserID:      "userID",
+					AuthMethods: []domain.UserAuthMethodType{domain.UserAuthMethodTypePassword},
+				},
+			},
+		},
+		{
+			"linked with permission, consent granted",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							authrequest.NewAddedEvent(mockCtx, &authrequest.NewAggregate("V2_id", "instanceID").Aggregate,
+								"otherLoginClient",
+								"clientID",
+								"redirectURI",
+								"state",
+								"nonce",
+								[]string{"openid"},
+								[]string{"audience"},
+								domain.OIDCResponseTypeCode,
+								domain.OIDCResponseModeQuery,
+								nil,
+								nil,
+								nil,
+								nil,
+								nil,
+								nil,
+								true,
+								"issuer",
+								nil,
 							),
 						),
 					),
@@ -779,6 +888,23 @@ func TestCommands_LinkSessionToAuthRequest(t *testing.T) {
 								2*time.Minute),
 						),
 					),
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanAddedEvent(mockCtx,
+								&user.NewAggregate("userID", "org1").Aggregate,
+								"username",
+								"firstname",
+								"lastname",
+								"nickname",
+								"displayname",
+								language.German,
+								domain.GenderUnspecified,
+								"email@test.ch",
+								true,
+							),
+						),
+					),
+					expectFilter(),
 					expectPush(
 						authrequest.NewSessionLinkedEvent(mockCtx, &authrequest.NewAggregate("V2_id", "instanceID").Aggregate,
 							"sessionID",
@@ -786,6 +912,13 @@ func TestCommands_LinkSessionToAuthRequest(t *testing.T) {
 							testNow,
 							[]domain.UserAuthMethodType{domain.UserAuthMethodTypePassword},
 						),
+						oidcconsent.NewGrantedEvent(mockCtx, oidcconsent.NewAggregate(oidcConsentID("userID", "clientID"), "org1", "instanceID"),
+							"userID",
+							"clientID",
+							"appID",
+							"projectID",
+							[]string{"openid"},
+						),
 					),
 				),
 				tokenVerifier:   newMockTokenVerifierValid(),
@@ -798,6 +931,7 @@ func TestCommands_LinkSessionToAuthRequest(t *testing.T) {
 				sessionToken:     "token",
 				checkLoginClient: true,
 				permissionCheck:  newMockProjectPermissionCheckAllowed(),
+				consentCheck:     newMockConsentCheckGrant("appID", "projectID"),
 			},
 			res{
 				details: &domain.ObjectDetails{ResourceOwner: "instanceID"},
@@ -845,6 +979,7 @@ func TestCommands_LinkSessionToAuthRequest(t *testing.T) {
 								nil,
 								true,
 								"issuer",
+								nil,
 							),
 						),
 					),
@@ -888,6 +1023,75 @@ func TestCommands_LinkSessionToAuthRequest(t *testing.T) {
 				wantErr: zerrors.ThrowPermissionDenied(nil, "OIDC-foSyH49RvL", "Errors.PermissionDenied"),
 			},
 		},
+		{
+			"linked with permission, consent missing",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							authrequest.NewAddedEvent(mockCtx, &authrequest.NewAggregate("V2_id", "instanceID").Aggregate,
+								"otherLoginClient",
+								"clientID",
+								"redirectURI",
+								"state",
+								"nonce",
+								[]string{"openid"},
+								[]string{"audience"},
+								domain.OIDCResponseTypeCode,
+								domain.OIDCResponseModeQuery,
+								nil,
+								nil,
+								nil,
+								nil,
+								nil,
+								nil,
+								true,
+								"issuer",
+								nil,
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							session.NewAddedEvent(mockCtx,
+								&session.NewAggregate("sessionID", "instance1").Aggregate,
+								&domain.UserAgent{
+									FingerprintID: gu.Ptr("fp1"),
+									IP:            net.ParseIP("1.2.3.4"),
+									Description:   gu.Ptr("firefox"),
+									Header:        http.Header{"foo": []string{"bar"}},
+								},
+							)),
+						eventFromEventPusher(
+							session.NewUserCheckedEvent(mockCtx, &session.NewAggregate("sessionID", "instance1").Aggregate,
+								"userID", "org1", testNow, &language.Afrikaans),
+						),
+						eventFromEventPusher(
+							session.NewPasswordCheckedEvent(mockCtx, &session.NewAggregate("sessionID", "instance1").Aggregate,
+								testNow),
+						),
+						eventFromEventPusherWithCreationDateNow(
+							session.NewLifetimeSetEvent(mockCtx, &session.NewAggregate("sessionID", "instance1").Aggregate,
+								2*time.Minute),
+						),
+					),
+				),
+				tokenVerifier:   newMockTokenVerifierValid(),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args{
+				ctx:              authz.NewMockContext("instanceID", "orgID", "loginClient"),
+				id:               "V2_id",
+				sessionID:        "sessionID",
+				sessionToken:     "token",
+				checkLoginClient: true,
+				permissionCheck:  newMockProjectPermissionCheckAllowed(),
+				consentCheck:     newMockConsentCheckMissing(),
+			},
+			res{
+				wantErr: zerrors.ThrowPreconditionFailed(nil, "OIDC-Ohx3i", "Errors.OIDCConsent.Required"),
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -896,7 +1100,7 @@ func TestCommands_LinkSessionToAuthRequest(t *testing.T) {
 				sessionTokenVerifier: tt.fields.tokenVerifier,
 				checkPermission:      tt.fields.checkPermission,
 			}
-			details, got, err := c.LinkSessionToAuthRequest(tt.args.ctx, tt.args.id, tt.args.sessionID, tt.args.sessionToken, tt.args.checkLoginClient, tt.args.permissionCheck)
+			details, got, err := c.LinkSessionToAuthRequest(tt.args.ctx, tt.args.id, tt.args.sessionID, tt.args.sessionToken, tt.args.checkLoginClient, tt.args.permissionCheck, tt.args.consentCheck)
 			require.ErrorIs(t, err, tt.res.wantErr)
 			assertObjectDetails(t, tt.res.details, details)
 			if err == nil {
@@ -969,6 +1173,7 @@ func TestCommands_FailAuthRequest(t *testing.T) {
 								nil,
 								true,
 								"issuer",
+								nil,
 							),
 						),
 					),
@@ -1071,6 +1276,7 @@ func TestCommands_AddAuthRequestCode(t *testing.T) {
 								gu.Ptr("hintUserID"),
 								true,
 								"issuer",
+								nil,
 							),
 						),
 					),
@@ -1110,6 +1316,7 @@ func TestCommands_AddAuthRequestCode(t *testing.T) {
 								gu.Ptr("hintUserID"),
 								true,
 								"issuer",
+								nil,
 							),
 						),
 						eventFromEventPusher(
@@ -1144,3 +1351,254 @@ func TestCommands_AddAuthRequestCode(t *testing.T) {
 		})
 	}
 }
+
+func TestCommands_AddPushedAuthRequest(t *testing.T) {
+	mockCtx := authz.NewMockContext("instanceID", "orgID", "loginClient")
+	expiration := time.Now().Add(time.Minute)
+	parameters := url.Values{
+		"client_id":     []string{"clientID"},
+		"redirect_uri":  []string{"redirectURI"},
+		"response_type": []string{"code"},
+		"scope":         []string{"openid"},
+	}
+	type fields struct {
+		eventstore  func(*testing.T) *eventstore.Eventstore
+		idGenerator id.Generator
+	}
+	type args struct {
+		ctx        context.Context
+		clientID   string
+		parameters url.Values
+		expiration time.Time
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    string
+		wantErr error
+	}{
+		{
+			"missing client id error",
+			fields{
+				eventstore: expectEventstore(),
+			},
+			args{
+				ctx:        mockCtx,
+				parameters: parameters,
+				expiration: expiration,
+			},
+			"",
+			zerrors.ThrowInvalidArgument(nil, "COMMAND-Aiv3u", "Errors.IDMissing"),
+		},
+		{
+			"already exists error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							authrequest.NewPushedEvent(mockCtx, &authrequest.NewAggregate("id", "instanceID").Aggregate,
+								"clientID",
+								parameters,
+								expiration,
+							),
+						),
+					),
+				),
+				idGenerator: mock.NewIDGeneratorExpectIDs(t, "id"),
+			},
+			args{
+				ctx:        mockCtx,
+				clientID:   "clientID",
+				parameters: parameters,
+				expiration: expiration,
+			},
+			"",
+			zerrors.ThrowPreconditionFailed(nil, "COMMAND-ohX6e", "Errors.AuthRequest.AlreadyExisting"),
+		},
+		{
+			"pushed",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+					expectPush(
+						authrequest.NewPushedEvent(mockCtx, &authrequest.NewAggregate("id", "instanceID").Aggregate,
+							"clientID",
+							parameters,
+							expiration,
+						),
+					),
+				),
+				idGenerator: mock.NewIDGeneratorExpectIDs(t, "id"),
+			},
+			args{
+				ctx:        mockCtx,
+				clientID:   "clientID",
+				parameters: parameters,
+				expiration: expiration,
+			},
+			"id",
+			nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:  tt.fields.eventstore(t),
+				idGenerator: tt.fields.idGenerator,
+			}
+			got, err := c.AddPushedAuthRequest(tt.args.ctx, tt.args.clientID, tt.args.parameters, tt.args.expiration)
+			require.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCommands_UsePushedAuthRequest(t *testing.T) {
+	mockCtx := authz.NewMockContext("instanceID", "orgID", "loginClient")
+	parameters := url.Values{
+		"client_id":     []string{"clientID"},
+		"redirect_uri":  []string{"redirectURI"},
+		"response_type": []string{"code"},
+		"scope":         []string{"openid"},
+	}
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		ctx      context.Context
+		id       string
+		clientID string
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    url.Values
+		wantErr error
+	}{
+		{
+			"not existing error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args{
+				ctx:      mockCtx,
+				id:       "id",
+				clientID: "clientID",
+			},
+			nil,
+			zerrors.ThrowPreconditionFailed(nil, "COMMAND-Eeng3", "Errors.AuthRequest.RequestURIInvalid"),
+		},
+		{
+			"other client error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							authrequest.NewPushedEvent(mockCtx, &authrequest.NewAggregate("id", "instanceID").Aggregate,
+								"clientID",
+								parameters,
+								time.Now().Add(time.Minute),
+							),
+						),
+					),
+				),
+			},
+			args{
+				ctx:      mockCtx,
+				id:       "id",
+				clientID: "otherClientID",
+			},
+			nil,
+			zerrors.ThrowPreconditionFailed(nil, "COMMAND-Eeng3", "Errors.AuthRequest.RequestURIInvalid"),
+		},
+		{
+			"expired error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							authrequest.NewPushedEvent(mockCtx, &authrequest.NewAggregate("id", "instanceID").Aggregate,
+								"clientID",
+								parameters,
+								time.Now().Add(-time.Minute),
+							),
+						),
+					),
+				),
+			},
+			args{
+				ctx:      mockCtx,
+				id:       "id",
+				clientID: "clientID",
+			},
+			nil,
+			zerrors.ThrowPreconditionFailed(nil, "COMMAND-Eeng3", "Errors.AuthRequest.RequestURIInvalid"),
+		},
+		{
+			"already used error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							authrequest.NewPushedEvent(mockCtx, &authrequest.NewAggregate("id", "instanceID").Aggregate,
+								"clientID",
+								parameters,
+								time.Now().Add(time.Minute),
+							),
+						),
+						eventFromEventPusher(
+							authrequest.NewRequestURIUsedEvent(mockCtx, &authrequest.NewAggregate("id", "instanceID").Aggregate),
+						),
+					),
+				),
+			},
+			args{
+				ctx:      mockCtx,
+				id:       "id",
+				clientID: "clientID",
+			},
+			nil,
+			zerrors.ThrowPreconditionFailed(nil, "COMMAND-Eeng3", "Errors.AuthRequest.RequestURIInvalid"),
+		},
+		{
+			"success",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							authrequest.NewPushedEvent(mockCtx, &authrequest.NewAggregate("id", "instanceID").Aggregate,
+								"clientID",
+								parameters,
+								time.Now().Add(time.Minute),
+							),
+						),
+					),
+					expectPush(
+						authrequest.NewRequestURIUsedEvent(mockCtx, &authrequest.NewAggregate("id", "instanceID").Aggregate),
+					),
+				),
+			},
+			args{
+				ctx:      mockCtx,
+				id:       "id",
+				clientID: "clientID",
+			},
+			parameters,
+			nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := c.UsePushedAuthRequest(tt.args.ctx, tt.args.id, tt.args.clientID)
+			require.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
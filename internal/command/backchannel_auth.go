@@ -0,0 +1,259 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/backchannelauth"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// BackChannelAuthRequest is a client initiated backchannel authentication request (CIBA),
+// which was already validated and authenticated by the OIDC layer.
+type BackChannelAuthRequest struct {
+	// ID is used as auth_req_id and must therefore be unguessable.
+	ID               string
+	ClientID         string
+	Scopes           []string
+	Audience         []string
+	UserID           string
+	UserOrgID        string
+	BindingMessage   string
+	Expires          time.Time
+	NeedRefreshToken bool
+	// NotificationURI and NotificationToken are only set for clients using the ping mode.
+	NotificationURI   string
+	NotificationToken string
+}
+
+// AddBackChannelAuth creates a pending backchannel authentication request.
+// The user is notified asynchronously and has to approve or deny the request.
+func (c *Commands) AddBackChannelAuth(ctx context.Context, req *BackChannelAuthRequest) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if req.ID == "" || req.ClientID == "" || req.UserID == "" || req.UserOrgID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-ohP4e", "Errors.IDMissing")
+	}
+	if req.NotificationURI != "" && req.NotificationToken == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ieg0o", "Errors.BackChannelAuth.NotificationTokenMissing")
+	}
+	model, err := c.getBackChannelAuthWriteModel(ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+	if model.State.Exists() {
+		return nil, zerrors.ThrowAlreadyExists(nil, "COMMAND-aeS0u", "Errors.BackChannelAuth.AlreadyExists")
+	}
+	var notificationToken *crypto.CryptoValue
+	if req.NotificationToken != "" {
+		notificationToken, err = crypto.Encrypt([]byte(req.NotificationToken), c.userEncryption)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return c.pushAppendAndReduceDetails(ctx, model, backchannelauth.NewAddedEvent(
+		ctx,
+		model.aggregate,
+		req.ClientID,
+		req.Scopes,
+		req.Audience,
+		req.UserID,
+		req.UserOrgID,
+		req.BindingMessage,
+		req.Expires,
+		req.NeedRefreshToken,
+		req.NotificationURI,
+		notificationToken,
+	))
+}
+
+// ApproveBackChannelAuthWithSession approves the backchannel authentication request
+// with the session of the user the request was issued for.
+func (c *Commands) ApproveBackChannelAuthWithSession(
+	ctx context.Context,
+	id,
+	sessionID,
+	sessionToken string,
+) (*domain.ObjectDetails, error) {
+	model, err := c.getBackChannelAuthWriteModel(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err = model.checkPending(); err != nil {
+		return nil, err
+	}
+	if err := c.checkPermission(ctx, domain.PermissionSessionLink, model.ResourceOwner, ""); err != nil {
+		return nil, err
+	}
+
+	sessionWriteModel := NewSessionWriteModel(sessionID, authz.GetInstance(ctx).InstanceID())
+	err = c.eventstore.FilterToQueryReducer(ctx, sessionWriteModel)
+	if err != nil {
+		return nil, err
+	}
+	if err = sessionWriteModel.CheckIsActive(); err != nil {
+		return nil, err
+	}
+	if err := c.sessionTokenVerifier(ctx, sessionToken, sessionWriteModel.AggregateID, sessionWriteModel.TokenID); err != nil {
+		return nil, err
+	}
+	if sessionWriteModel.UserID != model.UserID {
+		return nil, zerrors.ThrowPermissionDenied(nil, "COMMAND-ahH5e", "Errors.BackChannelAuth.UserMismatch")
+	}
+
+	return c.pushAppendAndReduceDetails(ctx, model, backchannelauth.NewApprovedEvent(
+		ctx,
+		model.aggregate,
+		sessionWriteModel.AuthMethodTypes(),
+		sessionWriteModel.AuthenticationTime(),
+		sessionWriteModel.PreferredLanguage,
+		sessionWriteModel.UserAgent,
+		sessionID,
+	))
+}
+
+// CancelBackChannelAuth denies or expires a pending backchannel authentication request.
+func (c *Commands) CancelBackChannelAuth(ctx context.Context, id string, reason domain.DeviceAuthCanceled) (*domain.ObjectDetails, error) {
+	model, err := c.getBackChannelAuthWriteModel(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !model.State.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Thai3", "Errors.BackChannelAuth.NotFound")
+	}
+	if model.State != domain.DeviceAuthStateInitiated {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-ev5Ai", "Errors.BackChannelAuth.AlreadyHandled")
+	}
+	return c.pushAppendAndReduceDetails(ctx, model, backchannelauth.NewCanceledEvent(ctx, model.aggregate, reason))
+}
+
+// BackChannelAuthUserNotified marks the user of the request as notified.
+func (c *Commands) BackChannelAuthUserNotified(ctx context.Context, id string) error {
+	model, err := c.getBackChannelAuthWriteModel(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !model.State.Exists() {
+		return zerrors.ThrowNotFound(nil, "COMMAND-ooP8a", "Errors.BackChannelAuth.NotFound")
+	}
+	if model.UserNotified {
+		return nil
+	}
+	return c.pushAppendAndReduce(ctx, model, backchannelauth.NewUserNotifiedEvent(ctx, model.aggregate))
+}
+
+// BackChannelAuthClientNotified marks the ping callback of the client as sent.
+func (c *Commands) BackChannelAuthClientNotified(ctx context.Context, id string) error {
+	model, err := c.getBackChannelAuthWriteModel(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !model.State.Exists() {
+		return zerrors.ThrowNotFound(nil, "COMMAND-Oob6a", "Errors.BackChannelAuth.NotFound")
+	}
+	if model.ClientNotified {
+		return nil
+	}
+	return c.pushAppendAndReduce(ctx, model, backchannelauth.NewClientNotifiedEvent(ctx, model.aggregate))
+}
+
+func (c *Commands) getBackChannelAuthWriteModel(ctx context.Context, id string) (*BackChannelAuthWriteModel, error) {
+	model := NewBackChannelAuthWriteModel(id, authz.GetInstance(ctx).InstanceID())
+	err := c.eventstore.FilterToQueryReducer(ctx, model)
+	if err != nil {
+		return nil, err
+	}
+	return model, nil
+}
+
+func (m *BackChannelAuthWriteModel) checkPending() error {
+	if !m.State.Exists() {
+		return zerrors.ThrowNotFound(nil, "COMMAND-Ooh3a", "Errors.BackChannelAuth.NotFound")
+	}
+	if m.State != domain.DeviceAuthStateInitiated {
+		return zerrors.ThrowPreconditionFailed(nil, "COMMAND-ieB2u", "Errors.BackChannelAuth.AlreadyHandled")
+	}
+	if m.Expires.Before(time.Now()) {
+		return zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ahx8o", "Errors.BackChannelAuth.Expired")
+	}
+	return nil
+}
+
+// CreateOIDCSessionFromBackChannelAuth creates a new OIDC session if the backchannel authentication
+// was approved by the user. Only the client which initiated the request is able to redeem it.
+// A [DeviceAuthStateError] is returned if the request was not approved,
+// as both decoupled flows share the same states.
+func (c *Commands) CreateOIDCSessionFromBackChannelAuth(ctx context.Context, id, clientID, backChannelLogoutURI, dpopJKT, certificateThumbprint string) (_ *OIDCSession, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	model, err := c.getBackChannelAuthWriteModel(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if model.State.Exists() && model.ClientID != clientID {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Ahm4i", "Errors.BackChannelAuth.NotFound")
+	}
+
+	switch model.State {
+	case domain.DeviceAuthStateApproved:
+		break
+	case domain.DeviceAuthStateUndefined:
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-eiK4u", "Errors.BackChannelAuth.NotFound")
+
+	case domain.DeviceAuthStateInitiated:
+		if model.Expires.Before(time.Now()) {
+			c.asyncPush(ctx, backchannelauth.NewCanceledEvent(ctx, model.aggregate, domain.DeviceAuthCanceledExpired))
+			return nil, DeviceAuthStateError(domain.DeviceAuthStateExpired)
+		}
+		fallthrough
+	case domain.DeviceAuthStateDenied, domain.DeviceAuthStateExpired, domain.DeviceAuthStateDone:
+		fallthrough
+	default:
+		return nil, DeviceAuthStateError(model.State)
+	}
+
+	cmd, err := c.newOIDCSessionAddEvents(ctx, model.UserID, model.UserOrgID)
+	if err != nil {
+		return nil, err
+	}
+
+	cmd.AddSession(ctx,
+		model.UserID,
+		model.UserOrgID,
+		model.SessionID,
+		model.ClientID,
+		model.Audience,
+		model.Scopes,
+		model.UserAuthMethods,
+		model.AuthTime,
+		"",
+		model.PreferredLanguage,
+		model.UserAgent,
+		dpopJKT,
+		certificateThumbprint,
+		nil,
+	)
+	cmd.RegisterLogout(ctx, model.SessionID, model.UserID, model.ClientID, backChannelLogoutURI)
+	if err = cmd.AddAccessToken(ctx, model.Scopes, nil, model.UserID, model.UserOrgID, domain.TokenReasonAuthRequest, nil); err != nil {
+		return nil, err
+	}
+
+	if model.NeedRefreshToken {
+		if err = cmd.AddRefreshToken(ctx, model.UserID); err != nil {
+			return nil, err
+		}
+	}
+	cmd.BackChannelAuthRequestDone(ctx, model.aggregate)
+	return cmd.PushEvents(ctx)
+}
+
+func (cmd *OIDCSessionEvents) BackChannelAuthRequestDone(ctx context.Context, backChannelAuthAggregate *eventstore.Aggregate) {
+	cmd.events = append(cmd.events, backchannelauth.NewDoneEvent(ctx, backChannelAuthAggregate))
+}
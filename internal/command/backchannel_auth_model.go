@@ -0,0 +1,104 @@
+package command
+
+import (
+	"time"
+
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/backchannelauth"
+)
+
+type BackChannelAuthWriteModel struct {
+	eventstore.WriteModel
+	aggregate *eventstore.Aggregate
+
+	ClientID          string
+	Scopes            []string
+	Audience          []string
+	UserID            string
+	UserOrgID         string
+	BindingMessage    string
+	Expires           time.Time
+	State             domain.DeviceAuthState
+	NeedRefreshToken  bool
+	NotificationURI   string
+	NotificationToken *crypto.CryptoValue
+	UserNotified      bool
+	ClientNotified    bool
+	UserAuthMethods   []domain.UserAuthMethodType
+	AuthTime          time.Time
+	PreferredLanguage *language.Tag
+	UserAgent         *domain.UserAgent
+	SessionID         string
+}
+
+func NewBackChannelAuthWriteModel(id, instanceID string) *BackChannelAuthWriteModel {
+	return &BackChannelAuthWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   id,
+			ResourceOwner: instanceID,
+			InstanceID:    instanceID,
+		},
+		aggregate: backchannelauth.NewAggregate(id, instanceID),
+	}
+}
+
+func (m *BackChannelAuthWriteModel) GetWriteModel() *eventstore.WriteModel {
+	return &m.WriteModel
+}
+
+func (m *BackChannelAuthWriteModel) Reduce() error {
+	for _, event := range m.Events {
+		switch e := event.(type) {
+		case *backchannelauth.AddedEvent:
+			m.ClientID = e.ClientID
+			m.Scopes = e.Scopes
+			m.Audience = e.Audience
+			m.UserID = e.UserID
+			m.UserOrgID = e.UserOrgID
+			m.BindingMessage = e.BindingMessage
+			m.Expires = e.Expires
+			m.State = e.State
+			m.NeedRefreshToken = e.NeedRefreshToken
+			m.NotificationURI = e.NotificationURI
+			m.NotificationToken = e.NotificationToken
+		case *backchannelauth.UserNotifiedEvent:
+			m.UserNotified = true
+		case *backchannelauth.ApprovedEvent:
+			m.State = domain.DeviceAuthStateApproved
+			m.UserAuthMethods = e.UserAuthMethods
+			m.AuthTime = e.AuthTime
+			m.PreferredLanguage = e.PreferredLanguage
+			m.UserAgent = e.UserAgent
+			m.SessionID = e.SessionID
+		case *backchannelauth.CanceledEvent:
+			m.State = e.Reason.State()
+		case *backchannelauth.ClientNotifiedEvent:
+			m.ClientNotified = true
+		case *backchannelauth.DoneEvent:
+			m.State = domain.DeviceAuthStateDone
+		}
+	}
+
+	return m.WriteModel.Reduce()
+}
+
+func (m *BackChannelAuthWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(m.ResourceOwner).
+		AddQuery().
+		AggregateTypes(backchannelauth.AggregateType).
+		AggregateIDs(m.AggregateID).
+		EventTypes(
+			backchannelauth.AddedEventType,
+			backchannelauth.UserNotifiedEventType,
+			backchannelauth.ApprovedEventType,
+			backchannelauth.CanceledEventType,
+			backchannelauth.ClientNotifiedEventType,
+			backchannelauth.DoneEventType,
+		).
+		Builder()
+}
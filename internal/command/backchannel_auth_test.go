@@ -0,0 +1,582 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/muhlemmer/gu"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/id"
+	"github.com/zitadel/zitadel/internal/id/mock"
+	"github.com/zitadel/zitadel/internal/repository/backchannelauth"
+	"github.com/zitadel/zitadel/internal/repository/oidcsession"
+	"github.com/zitadel/zitadel/internal/repository/session"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func backChannelAuthAddedEvent(ctx context.Context, expires time.Time, needRefreshToken bool) eventstore.Event {
+	return eventFromEventPusherWithInstanceID(
+		"instance1",
+		backchannelauth.NewAddedEvent(
+			ctx,
+			backchannelauth.NewAggregate("authReqID", "instance1"),
+			"clientID",
+			[]string{"openid", "offline_access"},
+			[]string{"audience"},
+			"userID", "org1",
+			"binding",
+			expires,
+			needRefreshToken,
+			"", nil,
+		),
+	)
+}
+
+func TestCommands_AddBackChannelAuth(t *testing.T) {
+	ctx := authz.WithInstanceID(context.Background(), "instance1")
+	pushErr := errors.New("pushErr")
+	now := time.Now()
+
+	type fields struct {
+		eventstore     func(*testing.T) *eventstore.Eventstore
+		userEncryption crypto.EncryptionAlgorithm
+	}
+	tests := []struct {
+		name        string
+		fields      fields
+		req         *BackChannelAuthRequest
+		wantDetails *domain.ObjectDetails
+		wantErr     error
+	}{
+		{
+			name: "missing user, error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			req: &BackChannelAuthRequest{
+				ID:       "authReqID",
+				ClientID: "clientID",
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "COMMAND-ohP4e", "Errors.IDMissing"),
+		},
+		{
+			name: "ping without notification token, error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			req: &BackChannelAuthRequest{
+				ID:              "authReqID",
+				ClientID:        "clientID",
+				UserID:          "userID",
+				UserOrgID:       "org1",
+				NotificationURI: "https://client.example.com/ciba",
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "COMMAND-Ieg0o", "Errors.BackChannelAuth.NotificationTokenMissing"),
+		},
+		{
+			name: "already existing, error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(backChannelAuthAddedEvent(ctx, now, false)),
+				),
+			},
+			req: &BackChannelAuthRequest{
+				ID:        "authReqID",
+				ClientID:  "clientID",
+				UserID:    "userID",
+				UserOrgID: "org1",
+			},
+			wantErr: zerrors.ThrowAlreadyExists(nil, "COMMAND-aeS0u", "Errors.BackChannelAuth.AlreadyExists"),
+		},
+		{
+			name: "push error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+					expectPushFailed(pushErr,
+						backchannelauth.NewAddedEvent(
+							ctx,
+							backchannelauth.NewAggregate("authReqID", "instance1"),
+							"clientID",
+							[]string{"openid"},
+							[]string{"audience"},
+							"userID", "org1",
+							"binding",
+							now,
+							false,
+							"", nil,
+						),
+					),
+				),
+			},
+			req: &BackChannelAuthRequest{
+				ID:             "authReqID",
+				ClientID:       "clientID",
+				Scopes:         []string{"openid"},
+				Audience:       []string{"audience"},
+				UserID:         "userID",
+				UserOrgID:      "org1",
+				BindingMessage: "binding",
+				Expires:        now,
+			},
+			wantErr: pushErr,
+		},
+		{
+			name: "ping mode, success",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+					expectPush(
+						backchannelauth.NewAddedEvent(
+							ctx,
+							backchannelauth.NewAggregate("authReqID", "instance1"),
+							"clientID",
+							[]string{"openid", "offline_access"},
+							[]string{"audience"},
+							"userID", "org1",
+							"binding",
+							now,
+							true,
+							"https://client.example.com/ciba",
+							&crypto.CryptoValue{
+								CryptoType: crypto.TypeEncryption,
+								Algorithm:  "enc",
+								KeyID:      "id",
+								Crypted:    []byte("notificationToken"),
+							},
+						),
+					),
+				),
+				userEncryption: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			},
+			req: &BackChannelAuthRequest{
+				ID:                "authReqID",
+				ClientID:          "clientID",
+				Scopes:            []string{"openid", "offline_access"},
+				Audience:          []string{"audience"},
+				UserID:            "userID",
+				UserOrgID:         "org1",
+				BindingMessage:    "binding",
+				Expires:           now,
+				NeedRefreshToken:  true,
+				NotificationURI:   "https://client.example.com/ciba",
+				NotificationToken: "notificationToken",
+			},
+			wantDetails: &domain.ObjectDetails{
+				ResourceOwner: "instance1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:     tt.fields.eventstore(t),
+				userEncryption: tt.fields.userEncryption,
+			}
+			gotDetails, err := c.AddBackChannelAuth(ctx, tt.req)
+			require.ErrorIs(t, err, tt.wantErr)
+			assertObjectDetails(t, tt.wantDetails, gotDetails)
+		})
+	}
+}
+
+func TestCommands_ApproveBackChannelAuthWithSession(t *testing.T) {
+	ctx := authz.WithInstanceID(context.Background(), "instance1")
+	future := time.Now().Add(time.Minute)
+	userAgent := &domain.UserAgent{
+		FingerprintID: gu.Ptr("fp1"),
+		IP:            net.ParseIP("1.2.3.4"),
+		Description:   gu.Ptr("firefox"),
+		Header:        http.Header{"foo": []string{"bar"}},
+	}
+	sessionEvents := func(userID string) []eventstore.Event {
+		return []eventstore.Event{
+			eventFromEventPusher(
+				session.NewAddedEvent(ctx, &session.NewAggregate("sessionID", "instance1").Aggregate, userAgent),
+			),
+			eventFromEventPusher(
+				session.NewUserCheckedEvent(ctx, &session.NewAggregate("sessionID", "instance1").Aggregate,
+					userID, "org1", testNow, &language.Afrikaans),
+			),
+			eventFromEventPusher(
+				session.NewPasswordCheckedEvent(ctx, &session.NewAggregate("sessionID", "instance1").Aggregate,
+					testNow),
+			),
+			eventFromEventPusherWithCreationDateNow(
+				session.NewLifetimeSetEvent(ctx, &session.NewAggregate("sessionID", "instance1").Aggregate,
+					2*time.Minute),
+			),
+		}
+	}
+
+	type fields struct {
+		eventstore      func(*testing.T) *eventstore.Eventstore
+		tokenVerifier   func(ctx context.Context, sessionToken, sessionID, tokenID string) (err error)
+		checkPermission domain.PermissionCheck
+	}
+	tests := []struct {
+		name        string
+		fields      fields
+		wantDetails *domain.ObjectDetails
+		wantErr     error
+	}{
+		{
+			name: "not found, error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			wantErr: zerrors.ThrowNotFound(nil, "COMMAND-Ooh3a", "Errors.BackChannelAuth.NotFound"),
+		},
+		{
+			name: "already denied, error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						backChannelAuthAddedEvent(ctx, future, false),
+						eventFromEventPusherWithInstanceID(
+							"instance1",
+							backchannelauth.NewCanceledEvent(ctx,
+								backchannelauth.NewAggregate("authReqID", "instance1"),
+								domain.DeviceAuthCanceledDenied,
+							),
+						),
+					),
+				),
+			},
+			wantErr: zerrors.ThrowPreconditionFailed(nil, "COMMAND-ieB2u", "Errors.BackChannelAuth.AlreadyHandled"),
+		},
+		{
+			name: "expired, error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(backChannelAuthAddedEvent(ctx, time.Now().Add(-time.Minute), false)),
+				),
+			},
+			wantErr: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ahx8o", "Errors.BackChannelAuth.Expired"),
+		},
+		{
+			name: "missing permission, error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(backChannelAuthAddedEvent(ctx, future, false)),
+				),
+				checkPermission: newMockPermissionCheckNotAllowed(),
+			},
+			wantErr: zerrors.ThrowPermissionDenied(nil, "AUTHZ-HKJD33", "Errors.PermissionDenied"),
+		},
+		{
+			name: "session of other user, error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(backChannelAuthAddedEvent(ctx, future, false)),
+					expectFilter(sessionEvents("otherUserID")...),
+				),
+				tokenVerifier:   newMockTokenVerifierValid(),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			wantErr: zerrors.ThrowPermissionDenied(nil, "COMMAND-ahH5e", "Errors.BackChannelAuth.UserMismatch"),
+		},
+		{
+			name: "approved",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(backChannelAuthAddedEvent(ctx, future, false)),
+					expectFilter(sessionEvents("userID")...),
+					expectPush(
+						backchannelauth.NewApprovedEvent(ctx,
+							backchannelauth.NewAggregate("authReqID", "instance1"),
+							[]domain.UserAuthMethodType{domain.UserAuthMethodTypePassword},
+							testNow, &language.Afrikaans, userAgent,
+							"sessionID",
+						),
+					),
+				),
+				tokenVerifier:   newMockTokenVerifierValid(),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			wantDetails: &domain.ObjectDetails{
+				ResourceOwner: "instance1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:           tt.fields.eventstore(t),
+				sessionTokenVerifier: tt.fields.tokenVerifier,
+				checkPermission:      tt.fields.checkPermission,
+			}
+			gotDetails, err := c.ApproveBackChannelAuthWithSession(ctx, "authReqID", "sessionID", "sessionToken")
+			require.ErrorIs(t, err, tt.wantErr)
+			assertObjectDetails(t, tt.wantDetails, gotDetails)
+		})
+	}
+}
+
+func TestCommands_CancelBackChannelAuth(t *testing.T) {
+	ctx := authz.WithInstanceID(context.Background(), "instance1")
+	now := time.Now()
+
+	tests := []struct {
+		name        string
+		eventstore  func(*testing.T) *eventstore.Eventstore
+		wantDetails *domain.ObjectDetails
+		wantErr     error
+	}{
+		{
+			name: "not found, error",
+			eventstore: expectEventstore(
+				expectFilter(),
+			),
+			wantErr: zerrors.ThrowNotFound(nil, "COMMAND-Thai3", "Errors.BackChannelAuth.NotFound"),
+		},
+		{
+			name: "already done, error",
+			eventstore: expectEventstore(
+				expectFilter(
+					backChannelAuthAddedEvent(ctx, now, false),
+					eventFromEventPusherWithInstanceID(
+						"instance1",
+						backchannelauth.NewDoneEvent(ctx, backchannelauth.NewAggregate("authReqID", "instance1")),
+					),
+				),
+			),
+			wantErr: zerrors.ThrowPreconditionFailed(nil, "COMMAND-ev5Ai", "Errors.BackChannelAuth.AlreadyHandled"),
+		},
+		{
+			name: "denied",
+			eventstore: expectEventstore(
+				expectFilter(backChannelAuthAddedEvent(ctx, now, false)),
+				expectPush(
+					backchannelauth.NewCanceledEvent(ctx,
+						backchannelauth.NewAggregate("authReqID", "instance1"),
+						domain.DeviceAuthCanceledDenied,
+					),
+				),
+			),
+			wantDetails: &domain.ObjectDetails{
+				ResourceOwner: "instance1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.eventstore(t),
+			}
+			gotDetails, err := c.CancelBackChannelAuth(ctx, "authReqID", domain.DeviceAuthCanceledDenied)
+			require.ErrorIs(t, err, tt.wantErr)
+			assertObjectDetails(t, tt.wantDetails, gotDetails)
+		})
+	}
+}
+
+func TestCommands_CreateOIDCSessionFromBackChannelAuth(t *testing.T) {
+	ctx := authz.WithInstanceID(context.Background(), "instance1")
+	userAgent := &domain.UserAgent{
+		FingerprintID: gu.Ptr("fp1"),
+		IP:            net.ParseIP("1.2.3.4"),
+		Description:   gu.Ptr("firefox"),
+		Header:        http.Header{"foo": []string{"bar"}},
+	}
+	approvedEvent := eventFromEventPusherWithInstanceID(
+		"instance1",
+		backchannelauth.NewApprovedEvent(ctx,
+			backchannelauth.NewAggregate("authReqID", "instance1"),
+			[]domain.UserAuthMethodType{domain.UserAuthMethodTypePassword},
+			testNow, &language.Afrikaans, userAgent,
+			"sessionID",
+		),
+	)
+
+	type fields struct {
+		eventstore                      func(*testing.T) *eventstore.Eventstore
+		idGenerator                     id.Generator
+		defaultAccessTokenLifetime      time.Duration
+		defaultRefreshTokenLifetime     time.Duration
+		defaultRefreshTokenIdleLifetime time.Duration
+		keyAlgorithm                    crypto.EncryptionAlgorithm
+	}
+	tests := []struct {
+		name     string
+		fields   fields
+		clientID string
+		want     *OIDCSession
+		wantErr  error
+	}{
+		{
+			name: "filter error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilterError(io.ErrClosedPipe),
+				),
+			},
+			clientID: "clientID",
+			wantErr:  io.ErrClosedPipe,
+		},
+		{
+			name: "not found",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			clientID: "clientID",
+			wantErr:  zerrors.ThrowNotFound(nil, "COMMAND-eiK4u", "Errors.BackChannelAuth.NotFound"),
+		},
+		{
+			name: "other client",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(backChannelAuthAddedEvent(ctx, time.Now().Add(time.Minute), false), approvedEvent),
+				),
+			},
+			clientID: "otherClientID",
+			wantErr:  zerrors.ThrowNotFound(nil, "COMMAND-Ahm4i", "Errors.BackChannelAuth.NotFound"),
+		},
+		{
+			name: "pending",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(backChannelAuthAddedEvent(ctx, time.Now().Add(time.Minute), false)),
+				),
+			},
+			clientID: "clientID",
+			wantErr:  DeviceAuthStateError(domain.DeviceAuthStateInitiated),
+		},
+		{
+			name: "expired",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(backChannelAuthAddedEvent(ctx, time.Now().Add(-time.Minute), false)),
+					expectPushSlow(time.Second, backchannelauth.NewCanceledEvent(ctx,
+						backchannelauth.NewAggregate("authReqID", "instance1"),
+						domain.DeviceAuthCanceledExpired,
+					)),
+				),
+			},
+			clientID: "clientID",
+			wantErr:  DeviceAuthStateError(domain.DeviceAuthStateExpired),
+		},
+		{
+			name: "denied",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						backChannelAuthAddedEvent(ctx, time.Now().Add(time.Minute), false),
+						eventFromEventPusherWithInstanceID(
+							"instance1",
+							backchannelauth.NewCanceledEvent(ctx,
+								backchannelauth.NewAggregate("authReqID", "instance1"),
+								domain.DeviceAuthCanceledDenied,
+							),
+						),
+					),
+				),
+			},
+			clientID: "clientID",
+			wantErr:  DeviceAuthStateError(domain.DeviceAuthStateDenied),
+		},
+		{
+			name: "approved, success",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(backChannelAuthAddedEvent(ctx, time.Now().Add(-time.Minute), false), approvedEvent),
+					expectFilter(
+						user.NewHumanAddedEvent(
+							ctx,
+							&user.NewAggregate("userID", "org1").Aggregate,
+							"username",
+							"firstname",
+							"lastname",
+							"nickname",
+							"displayname",
+							language.English,
+							domain.GenderUnspecified,
+							"email",
+							false,
+						),
+					),
+					expectFilter(), // token lifetime
+					expectPush(
+						oidcsession.NewAddedEvent(context.Background(), &oidcsession.NewAggregate("V2_oidcSessionID", "org1").Aggregate,
+							"userID", "org1", "sessionID", "clientID", []string{"audience"}, []string{"openid", "offline_access"},
+							[]domain.UserAuthMethodType{domain.UserAuthMethodTypePassword}, testNow, "", &language.Afrikaans, userAgent,
+							"",
+							"",
+							nil,
+						),
+						oidcsession.NewAccessTokenAddedEvent(context.Background(),
+							&oidcsession.NewAggregate("V2_oidcSessionID", "org1").Aggregate,
+							"at_accessTokenID", []string{"openid", "offline_access"}, time.Hour, domain.TokenReasonAuthRequest, nil,
+							nil,
+						),
+						user.NewUserTokenV2AddedEvent(context.Background(), &user.NewAggregate("userID", "org1").Aggregate, "at_accessTokenID"),
+						backchannelauth.NewDoneEvent(ctx,
+							backchannelauth.NewAggregate("authReqID", "instance1"),
+						),
+					),
+				),
+				idGenerator:                     mock.NewIDGeneratorExpectIDs(t, "oidcSessionID", "accessTokenID"),
+				defaultAccessTokenLifetime:      time.Hour,
+				defaultRefreshTokenLifetime:     7 * 24 * time.Hour,
+				defaultRefreshTokenIdleLifetime: 24 * time.Hour,
+				keyAlgorithm:                    crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			},
+			clientID: "clientID",
+			want: &OIDCSession{
+				TokenID:           "V2_oidcSessionID-at_accessTokenID",
+				ClientID:          "clientID",
+				UserID:            "userID",
+				Audience:          []string{"audience"},
+				Expiration:        time.Time{}.Add(time.Hour),
+				Scope:             []string{"openid", "offline_access"},
+				AuthMethods:       []domain.UserAuthMethodType{domain.UserAuthMethodTypePassword},
+				AuthTime:          testNow,
+				PreferredLanguage: &language.Afrikaans,
+				UserAgent:         userAgent,
+				Reason:            domain.TokenReasonAuthRequest,
+				SessionID:         "sessionID",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:                      tt.fields.eventstore(t),
+				idGenerator:                     tt.fields.idGenerator,
+				defaultAccessTokenLifetime:      tt.fields.defaultAccessTokenLifetime,
+				defaultRefreshTokenLifetime:     tt.fields.defaultRefreshTokenLifetime,
+				defaultRefreshTokenIdleLifetime: tt.fields.defaultRefreshTokenIdleLifetime,
+				keyAlgorithm:                    tt.fields.keyAlgorithm,
+			}
+			got, err := c.CreateOIDCSessionFromBackChannelAuth(ctx, "authReqID", tt.clientID, "", "", "")
+			c.jobs.Wait()
+
+			require.ErrorIs(t, err, tt.wantErr)
+
+			if got != nil {
+				assert.WithinRange(t, got.AuthTime, tt.want.AuthTime.Add(-time.Second), tt.want.AuthTime.Add(time.Second))
+				got.AuthTime = time.Time{}
+				tt.want.AuthTime = time.Time{}
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,407 @@
+package command
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
+	"github.com/zitadel/zitadel/internal/repository/clientregistration"
+	project_repo "github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// initialAccessTokenSeparator separates the ID of an initial access token from its secret,
+// so the token can be verified without comparing it to the hashes of all tokens of the instance.
+const initialAccessTokenSeparator = "."
+
+// ClientRegistrationConfig enables the dynamic client registration (RFC 7591) for the instance.
+type ClientRegistrationConfig struct {
+	// ProjectID is the project the clients are registered in as OIDC applications.
+	ProjectID string
+	// SoftwareStatementIssuers are trusted to sign software statements,
+	// which allow clients to register without initial access token.
+	SoftwareStatementIssuers []*clientregistration.SoftwareStatementIssuer
+}
+
+func (c *ClientRegistrationConfig) IsValid() error {
+	if c.ProjectID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Ahx8e", "Errors.ClientRegistration.Invalid")
+	}
+	issuers := make([]string, 0, len(c.SoftwareStatementIssuers))
+	for _, issuer := range c.SoftwareStatementIssuers {
+		if issuer == nil || issuer.Issuer == "" || slices.Contains(issuers, issuer.Issuer) {
+			return zerrors.ThrowInvalidArgument(nil, "COMMAND-aeX9o", "Errors.ClientRegistration.InvalidSoftwareStatementIssuer")
+		}
+		issuers = append(issuers, issuer.Issuer)
+		keySet := new(jose.JSONWebKeySet)
+		if err := json.Unmarshal(issuer.JWKS, keySet); err != nil || len(keySet.Keys) == 0 {
+			return zerrors.ThrowInvalidArgument(err, "COMMAND-Ooch7", "Errors.ClientRegistration.InvalidSoftwareStatementIssuer")
+		}
+		for _, key := range keySet.Keys {
+			if !key.Valid() || !key.IsPublic() {
+				return zerrors.ThrowInvalidArgument(nil, "COMMAND-eeM4a", "Errors.ClientRegistration.InvalidSoftwareStatementIssuer")
+			}
+		}
+	}
+	return nil
+}
+
+func (c *Commands) SetClientRegistrationConfig(ctx context.Context, config *ClientRegistrationConfig) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if err := config.IsValid(); err != nil {
+		return nil, err
+	}
+	projectResourceOwner, err := c.checkProjectExists(ctx, config.ProjectID, "")
+	if err != nil {
+		return nil, err
+	}
+	wm, err := c.getClientRegistrationWriteModel(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if wm.Enabled && wm.ProjectID == config.ProjectID &&
+		slices.EqualFunc(wm.SoftwareStatementIssuers, config.SoftwareStatementIssuers, softwareStatementIssuerEqual) {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Oov2i", "Errors.NoChangesFound")
+	}
+	if err := c.pushAppendAndReduce(ctx, wm, clientregistration.NewConfigSetEvent(
+		ctx,
+		ClientRegistrationAggregateFromWriteModel(ctx, &wm.WriteModel),
+		config.ProjectID,
+		projectResourceOwner,
+		config.SoftwareStatementIssuers,
+	)); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&wm.WriteModel), nil
+}
+
+func softwareStatementIssuerEqual(a, b *clientregistration.SoftwareStatementIssuer) bool {
+	return a.Issuer == b.Issuer && bytes.Equal(a.JWKS, b.JWKS)
+}
+
+// RemoveClientRegistrationConfig disables the dynamic client registration.
+// Already registered clients and their registration access tokens remain valid.
+func (c *Commands) RemoveClientRegistrationConfig(ctx context.Context) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	wm, err := c.getClientRegistrationWriteModel(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !wm.Enabled {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Phoh2", "Errors.ClientRegistration.NotConfigured")
+	}
+	if err := c.pushAppendAndReduce(ctx, wm, clientregistration.NewConfigRemovedEvent(
+		ctx,
+		ClientRegistrationAggregateFromWriteModel(ctx, &wm.WriteModel),
+	)); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&wm.WriteModel), nil
+}
+
+type ClientRegistrationInitialAccessToken struct {
+	ID string
+	// Token is only returned on creation.
+	Token   string
+	Details *domain.ObjectDetails
+}
+
+// AddClientRegistrationInitialAccessToken creates a token, which allows clients to register themselves until it expires.
+func (c *Commands) AddClientRegistrationInitialAccessToken(ctx context.Context, description string, expirationDate time.Time) (_ *ClientRegistrationInitialAccessToken, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if expirationDate.IsZero() || expirationDate.Before(time.Now()) {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-ooG5e", "Errors.ClientRegistration.InitialAccessTokenExpirationInvalid")
+	}
+	wm, err := c.getClientRegistrationWriteModel(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tokenID, err := c.idGenerator.Next()
+	if err != nil {
+		return nil, err
+	}
+	hashedToken, plain, err := c.newHashedSecret(ctx, c.eventstore.Filter) //nolint:staticcheck
+	if err != nil {
+		return nil, err
+	}
+	if err := c.pushAppendAndReduce(ctx, wm, clientregistration.NewInitialAccessTokenAddedEvent(
+		ctx,
+		ClientRegistrationAggregateFromWriteModel(ctx, &wm.WriteModel),
+		tokenID,
+		hashedToken,
+		description,
+		expirationDate,
+	)); err != nil {
+		return nil, err
+	}
+	return &ClientRegistrationInitialAccessToken{
+		ID:      tokenID,
+		Token:   tokenID + initialAccessTokenSeparator + plain,
+		Details: writeModelToObjectDetails(&wm.WriteModel),
+	}, nil
+}
+
+func (c *Commands) RemoveClientRegistrationInitialAccessToken(ctx context.Context, tokenID string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if tokenID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-ieV7u", "Errors.IDMissing")
+	}
+	wm, err := c.getClientRegistrationWriteModel(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := wm.InitialAccessTokens[tokenID]; !ok {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Eiqu4", "Errors.ClientRegistration.InitialAccessTokenNotFound")
+	}
+	if err := c.pushAppendAndReduce(ctx, wm, clientregistration.NewInitialAccessTokenRemovedEvent(
+		ctx,
+		ClientRegistrationAggregateFromWriteModel(ctx, &wm.WriteModel),
+		tokenID,
+	)); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&wm.WriteModel), nil
+}
+
+// VerifyClientRegistrationInitialAccessToken checks the token presented on the registration of a client.
+func (c *Commands) VerifyClientRegistrationInitialAccessToken(ctx context.Context, token string) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	tokenID, secret, ok := strings.Cut(token, initialAccessTokenSeparator)
+	if !ok || tokenID == "" || secret == "" {
+		return zerrors.ThrowUnauthenticated(nil, "COMMAND-Ahc5o", "Errors.ClientRegistration.InitialAccessTokenInvalid")
+	}
+	wm, err := c.getClientRegistrationWriteModel(ctx)
+	if err != nil {
+		return err
+	}
+	initialAccessToken, ok := wm.InitialAccessTokens[tokenID]
+	if !ok || initialAccessToken.expirationDate.Before(time.Now()) {
+		return zerrors.ThrowUnauthenticated(nil, "COMMAND-ieR1a", "Errors.ClientRegistration.InitialAccessTokenInvalid")
+	}
+	ctx, spanPasswordComparison := tracing.NewNamedSpan(ctx, "passwap.Verify")
+	_, err = c.secretHasher.Verify(initialAccessToken.hashedToken, secret)
+	spanPasswordComparison.EndWithError(err)
+	if err != nil {
+		return zerrors.ThrowUnauthenticated(err, "COMMAND-Ohj2u", "Errors.ClientRegistration.InitialAccessTokenInvalid")
+	}
+	return nil
+}
+
+// OIDCClientMetadata is the part of the OIDC application a client is able to register and update itself.
+type OIDCClientMetadata struct {
+	ClientName             string
+	RedirectURIs           []string
+	PostLogoutRedirectURIs []string
+	ResponseTypes          []domain.OIDCResponseType
+	GrantTypes             []domain.OIDCGrantType
+	ApplicationType        domain.OIDCApplicationType
+	AuthMethodType         domain.OIDCAuthMethodType
+}
+
+type RegisteredOIDCClient struct {
+	App *domain.OIDCApp
+	// RegistrationAccessToken is only returned on registration.
+	RegistrationAccessToken string
+}
+
+// RegisterOIDCClient creates an OIDC application in the project designated for the dynamic client registration.
+// The caller must have verified the initial access token or the software statement of the request.
+// The returned registration access token allows the client to read, update and delete the application (RFC 7592).
+func (c *Commands) RegisterOIDCClient(ctx context.Context, metadata *OIDCClientMetadata) (_ *RegisteredOIDCClient, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	wm, err := c.getClientRegistrationWriteModel(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !wm.Enabled {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-ooK3e", "Errors.ClientRegistration.NotConfigured")
+	}
+	app := &domain.OIDCApp{
+		ObjectRoot: models.ObjectRoot{
+			AggregateID: wm.ProjectID,
+		},
+		AppName:                metadata.ClientName,
+		OIDCVersion:            domain.OIDCVersionV1,
+		RedirectUris:           metadata.RedirectURIs,
+		ResponseTypes:          metadata.ResponseTypes,
+		GrantTypes:             metadata.GrantTypes,
+		ApplicationType:        metadata.ApplicationType,
+		AuthMethodType:         metadata.AuthMethodType,
+		PostLogoutRedirectUris: metadata.PostLogoutRedirectURIs,
+		AccessTokenType:        domain.OIDCTokenTypeBearer,
+	}
+	if app.AppName == "" || !app.IsValid() {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ieng4", "Errors.Project.App.Invalid")
+	}
+	if !isDynamicClientAuthMethod(app.AuthMethodType) {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Vai2o", "Errors.ClientRegistration.AuthMethodNotSupported")
+	}
+	if _, err := c.checkProjectExists(ctx, wm.ProjectID, wm.ProjectResourceOwner); err != nil {
+		return nil, err
+	}
+	appID, err := c.idGenerator.Next()
+	if err != nil {
+		return nil, err
+	}
+	hashedToken, plainToken, err := c.newHashedSecret(ctx, c.eventstore.Filter) //nolint:staticcheck
+	if err != nil {
+		return nil, err
+	}
+	projectAgg := project_repo.NewAggregate(wm.ProjectID, wm.ProjectResourceOwner)
+	app, err = c.addOIDCApplicationWithID(ctx, app, wm.ProjectResourceOwner, appID,
+		project_repo.NewOIDCConfigRegistrationAccessTokenSetEvent(ctx, &projectAgg.Aggregate, appID, hashedToken),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &RegisteredOIDCClient{
+		App:                     app,
+		RegistrationAccessToken: plainToken,
+	}, nil
+}
+
+// isDynamicClientAuthMethod returns true for the authentication methods which can be registered by the client itself,
+// as all others require keys or certificates to be configured on the application.
+func isDynamicClientAuthMethod(authMethod domain.OIDCAuthMethodType) bool {
+	return authMethod == domain.OIDCAuthMethodTypeNone ||
+		authMethod == domain.OIDCAuthMethodTypeBasic ||
+		authMethod == domain.OIDCAuthMethodTypePost
+}
+
+// VerifyOIDCClientRegistrationAccessToken checks the registration access token of a dynamically registered client.
+func (c *Commands) VerifyOIDCClientRegistrationAccessToken(ctx context.Context, projectID, appID, token string) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	app, err := c.getOIDCAppWriteModel(ctx, projectID, appID, "")
+	if err != nil {
+		return err
+	}
+	if !app.State.Exists() || app.HashedRegistrationAccessToken == "" || token == "" {
+		return zerrors.ThrowUnauthenticated(nil, "COMMAND-Aeth4", "Errors.ClientRegistration.RegistrationAccessTokenInvalid")
+	}
+	ctx, spanPasswordComparison := tracing.NewNamedSpan(ctx, "passwap.Verify")
+	_, err = c.secretHasher.Verify(app.HashedRegistrationAccessToken, token)
+	spanPasswordComparison.EndWithError(err)
+	if err != nil {
+		return zerrors.ThrowUnauthenticated(err, "COMMAND-Ui0ae", "Errors.ClientRegistration.RegistrationAccessTokenInvalid")
+	}
+	return nil
+}
+
+// ChangeRegisteredOIDCClient replaces the metadata of a dynamically registered client (RFC 7592).
+// All other settings of the application, which might have been changed by an administrator, are kept.
+// The authentication method can't be changed, as the client would miss the corresponding credentials.
+func (c *Commands) ChangeRegisteredOIDCClient(ctx context.Context, projectID, appID string, metadata *OIDCClientMetadata) (_ *domain.OIDCApp, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	wm, err := c.getOIDCAppWriteModel(ctx, projectID, appID, "")
+	if err != nil {
+		return nil, err
+	}
+	if !wm.State.Exists() || !wm.IsOIDC() || wm.HashedRegistrationAccessToken == "" {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Thoh8", "Errors.Project.App.NotExisting")
+	}
+	if metadata.AuthMethodType != wm.AuthMethodType {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-ahN9u", "Errors.ClientRegistration.AuthMethodNotSupported")
+	}
+	app := oidcWriteModelToOIDCConfig(wm)
+	app.AppName = metadata.ClientName
+	app.RedirectUris = metadata.RedirectURIs
+	app.PostLogoutRedirectUris = metadata.PostLogoutRedirectURIs
+	app.ResponseTypes = metadata.ResponseTypes
+	app.GrantTypes = metadata.GrantTypes
+	app.ApplicationType = metadata.ApplicationType
+	if app.AppName == "" || !app.IsValid() {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-eiP6u", "Errors.Project.App.Invalid")
+	}
+
+	projectAgg := ProjectAggregateFromWriteModel(&wm.WriteModel)
+	var events []eventstore.Command
+	if wm.AppName != app.AppName {
+		events = append(events, project_repo.NewApplicationChangedEvent(ctx, projectAgg, appID, wm.AppName, app.AppName))
+	}
+	changedEvent, hasChanged, err := wm.NewChangedEvent(
+		ctx,
+		projectAgg,
+		appID,
+		trimStringSliceWhiteSpaces(app.RedirectUris),
+		trimStringSliceWhiteSpaces(app.PostLogoutRedirectUris),
+		app.ResponseTypes,
+		app.GrantTypes,
+		app.ApplicationType,
+		app.AuthMethodType,
+		app.OIDCVersion,
+		app.AccessTokenType,
+		app.DevMode,
+		app.AccessTokenRoleAssertion,
+		app.IDTokenRoleAssertion,
+		app.IDTokenUserinfoAssertion,
+		app.ClockSkew,
+		app.AdditionalOrigins,
+		app.SkipNativeAppSuccessPage,
+		app.BackChannelLogoutURI,
+		app.LoginVersion,
+		app.LoginBaseURI,
+		app.DPoPBoundAccessTokens,
+		app.RequirePushedAuthRequests,
+		app.CIBANotificationURI,
+		app.ConsentRequired,
+		app.TLSClientAuthSubjectDN,
+		app.TLSClientCertificates,
+		app.TLSClientCertificateBoundAccessTokens,
+		app.RefreshTokenRotation,
+		app.EncryptAuthorizationResponse,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if hasChanged {
+		events = append(events, changedEvent)
+	}
+	// unlike the management API, an update without changes is not an error (RFC 7592, section 2.2)
+	if err = c.pushAppendAndReduce(ctx, wm, events...); err != nil {
+		return nil, err
+	}
+	result := oidcWriteModelToOIDCConfig(wm)
+	result.FillCompliance()
+	return result, nil
+}
+
+func (c *Commands) getClientRegistrationWriteModel(ctx context.Context) (_ *ClientRegistrationWriteModel, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	wm := NewClientRegistrationWriteModel(authz.GetInstance(ctx).InstanceID())
+	if err = c.eventstore.FilterToQueryReducer(ctx, wm); err != nil {
+		return nil, err
+	}
+	return wm, nil
+}
+
+func ClientRegistrationAggregateFromWriteModel(ctx context.Context, wm *eventstore.WriteModel) *eventstore.Aggregate {
+	return eventstore.AggregateFromWriteModelCtx(ctx, wm, clientregistration.AggregateType, clientregistration.AggregateVersion)
+}
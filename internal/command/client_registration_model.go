@@ -0,0 +1,74 @@
+package command
+
+import (
+	"time"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/clientregistration"
+)
+
+type ClientRegistrationWriteModel struct {
+	eventstore.WriteModel
+
+	Enabled                  bool
+	ProjectID                string
+	ProjectResourceOwner     string
+	SoftwareStatementIssuers []*clientregistration.SoftwareStatementIssuer
+	InitialAccessTokens      map[string]*clientRegistrationInitialAccessToken
+}
+
+type clientRegistrationInitialAccessToken struct {
+	hashedToken    string
+	expirationDate time.Time
+}
+
+func NewClientRegistrationWriteModel(instanceID string) *ClientRegistrationWriteModel {
+	return &ClientRegistrationWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   instanceID,
+			ResourceOwner: instanceID,
+			InstanceID:    instanceID,
+		},
+		InitialAccessTokens: make(map[string]*clientRegistrationInitialAccessToken),
+	}
+}
+
+func (wm *ClientRegistrationWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *clientregistration.ConfigSetEvent:
+			wm.Enabled = true
+			wm.ProjectID = e.ProjectID
+			wm.ProjectResourceOwner = e.ProjectResourceOwner
+			wm.SoftwareStatementIssuers = e.SoftwareStatementIssuers
+		case *clientregistration.ConfigRemovedEvent:
+			wm.Enabled = false
+			wm.ProjectID = ""
+			wm.ProjectResourceOwner = ""
+			wm.SoftwareStatementIssuers = nil
+		case *clientregistration.InitialAccessTokenAddedEvent:
+			wm.InitialAccessTokens[e.TokenID] = &clientRegistrationInitialAccessToken{
+				hashedToken:    e.HashedToken,
+				expirationDate: e.ExpirationDate,
+			}
+		case *clientregistration.InitialAccessTokenRemovedEvent:
+			delete(wm.InitialAccessTokens, e.TokenID)
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *ClientRegistrationWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(clientregistration.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			clientregistration.ConfigSetEventType,
+			clientregistration.ConfigRemovedEventType,
+			clientregistration.InitialAccessTokenAddedEventType,
+			clientregistration.InitialAccessTokenRemovedEventType,
+		).
+		Builder()
+}
@@ -0,0 +1,776 @@
+package command
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
+	"github.com/zitadel/zitadel/internal/id"
+	"github.com/zitadel/zitadel/internal/id/mock"
+	"github.com/zitadel/zitadel/internal/repository/clientregistration"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func clientRegistrationTestConfigSetEvent() eventstore.Event {
+	return eventFromEventPusher(
+		clientregistration.NewConfigSetEvent(context.Background(),
+			clientregistration.NewAggregate("instance1"),
+			"project1",
+			"org1",
+			nil,
+		),
+	)
+}
+
+func clientRegistrationTestProjectAddedEvent() eventstore.Event {
+	return eventFromEventPusher(
+		project.NewProjectAddedEvent(context.Background(),
+			&project.NewAggregate("project1", "org1").Aggregate,
+			"project", true, true, true,
+			domain.PrivateLabelingSettingUnspecified),
+	)
+}
+
+func TestCommands_SetClientRegistrationConfig(t *testing.T) {
+	ctx := authz.WithInstanceID(context.Background(), "instance1")
+	type res struct {
+		want *domain.ObjectDetails
+		err  error
+	}
+	tests := []struct {
+		name       string
+		eventstore func(t *testing.T) *eventstore.Eventstore
+		config     *ClientRegistrationConfig
+		res        res
+	}{
+		{
+			name:       "no project, error",
+			eventstore: expectEventstore(),
+			config:     &ClientRegistrationConfig{},
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-Ahx8e", "Errors.ClientRegistration.Invalid"),
+			},
+		},
+		{
+			name:       "software statement issuer without keys, error",
+			eventstore: expectEventstore(),
+			config: &ClientRegistrationConfig{
+				ProjectID: "project1",
+				SoftwareStatementIssuers: []*clientregistration.SoftwareStatementIssuer{
+					{Issuer: "https://issuer.example.com", JWKS: []byte(`{"keys":[]}`)},
+				},
+			},
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-Ooch7", "Errors.ClientRegistration.InvalidSoftwareStatementIssuer"),
+			},
+		},
+		{
+			name: "project not found, error",
+			eventstore: expectEventstore(
+				expectFilter(),
+			),
+			config: &ClientRegistrationConfig{
+				ProjectID: "project1",
+			},
+			res: res{
+				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-EbFMN", "Errors.Project.NotFound"),
+			},
+		},
+		{
+			name: "no changes, error",
+			eventstore: expectEventstore(
+				expectFilter(
+					clientRegistrationTestProjectAddedEvent(),
+				),
+				expectFilter(
+					clientRegistrationTestConfigSetEvent(),
+				),
+			),
+			config: &ClientRegistrationConfig{
+				ProjectID: "project1",
+			},
+			res: res{
+				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Oov2i", "Errors.NoChangesFound"),
+			},
+		},
+		{
+			name: "set, ok",
+			eventstore: expectEventstore(
+				expectFilter(
+					clientRegistrationTestProjectAddedEvent(),
+				),
+				expectFilter(),
+				expectPush(
+					clientregistration.NewConfigSetEvent(ctx,
+						clientregistration.NewAggregate("instance1"),
+						"project1",
+						"org1",
+						[]*clientregistration.SoftwareStatementIssuer{
+							{Issuer: "https://issuer.example.com", JWKS: []byte(workloadIdentityTestJWKS)},
+						},
+					),
+				),
+			),
+			config: &ClientRegistrationConfig{
+				ProjectID: "project1",
+				SoftwareStatementIssuers: []*clientregistration.SoftwareStatementIssuer{
+					{Issuer: "https://issuer.example.com", JWKS: []byte(workloadIdentityTestJWKS)},
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "instance1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.eventstore(t),
+			}
+			got, err := c.SetClientRegistrationConfig(ctx, tt.config)
+			assert.ErrorIs(t, err, tt.res.err)
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommands_RemoveClientRegistrationConfig(t *testing.T) {
+	ctx := authz.WithInstanceID(context.Background(), "instance1")
+	type res struct {
+		want *domain.ObjectDetails
+		err  error
+	}
+	tests := []struct {
+		name       string
+		eventstore func(t *testing.T) *eventstore.Eventstore
+		res        res
+	}{
+		{
+			name: "not configured, error",
+			eventstore: expectEventstore(
+				expectFilter(),
+			),
+			res: res{
+				err: zerrors.ThrowNotFound(nil, "COMMAND-Phoh2", "Errors.ClientRegistration.NotConfigured"),
+			},
+		},
+		{
+			name: "remove, ok",
+			eventstore: expectEventstore(
+				expectFilter(
+					clientRegistrationTestConfigSetEvent(),
+				),
+				expectPush(
+					clientregistration.NewConfigRemovedEvent(ctx,
+						clientregistration.NewAggregate("instance1"),
+					),
+				),
+			),
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "instance1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.eventstore(t),
+			}
+			got, err := c.RemoveClientRegistrationConfig(ctx)
+			assert.ErrorIs(t, err, tt.res.err)
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommands_AddClientRegistrationInitialAccessToken(t *testing.T) {
+	ctx := authz.WithInstanceID(context.Background(), "instance1")
+	expiration := time.Now().Add(time.Hour)
+	type args struct {
+		description    string
+		expirationDate time.Time
+	}
+	type res struct {
+		want *ClientRegistrationInitialAccessToken
+		err  error
+	}
+	tests := []struct {
+		name        string
+		eventstore  func(t *testing.T) *eventstore.Eventstore
+		idGenerator id.Generator
+		args        args
+		res         res
+	}{
+		{
+			name:       "no expiration, error",
+			eventstore: expectEventstore(),
+			args: args{
+				description: "mcp",
+			},
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-ooG5e", "Errors.ClientRegistration.InitialAccessTokenExpirationInvalid"),
+			},
+		},
+		{
+			name:       "expired, error",
+			eventstore: expectEventstore(),
+			args: args{
+				description:    "mcp",
+				expirationDate: time.Now().Add(-time.Hour),
+			},
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-ooG5e", "Errors.ClientRegistration.InitialAccessTokenExpirationInvalid"),
+			},
+		},
+		{
+			name: "add, ok",
+			eventstore: expectEventstore(
+				expectFilter(),
+				expectPush(
+					clientregistration.NewInitialAccessTokenAddedEvent(ctx,
+						clientregistration.NewAggregate("instance1"),
+						"token1",
+						"secret",
+						"mcp",
+						expiration,
+					),
+				),
+			),
+			idGenerator: mock.NewIDGeneratorExpectIDs(t, "token1"),
+			args: args{
+				description:    "mcp",
+				expirationDate: expiration,
+			},
+			res: res{
+				want: &ClientRegistrationInitialAccessToken{
+					ID:    "token1",
+					Token: "token1.secret",
+					Details: &domain.ObjectDetails{
+						ResourceOwner: "instance1",
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:      tt.eventstore(t),
+				idGenerator:     tt.idGenerator,
+				newHashedSecret: mockHashedSecret("secret"),
+			}
+			got, err := c.AddClientRegistrationInitialAccessToken(ctx, tt.args.description, tt.args.expirationDate)
+			assert.ErrorIs(t, err, tt.res.err)
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want.ID, got.ID)
+				assert.Equal(t, tt.res.want.Token, got.Token)
+				assertObjectDetails(t, tt.res.want.Details, got.Details)
+			}
+		})
+	}
+}
+
+func TestCommands_VerifyClientRegistrationInitialAccessToken(t *testing.T) {
+	ctx := authz.WithInstanceID(context.Background(), "instance1")
+	tokenAddedEvent := func(expiration time.Time) eventstore.Event {
+		return eventFromEventPusher(
+			clientregistration.NewInitialAccessTokenAddedEvent(ctx,
+				clientregistration.NewAggregate("instance1"),
+				"token1",
+				"$plain$x$secret",
+				"mcp",
+				expiration,
+			),
+		)
+	}
+	tests := []struct {
+		name       string
+		eventstore func(t *testing.T) *eventstore.Eventstore
+		token      string
+		err        error
+	}{
+		{
+			name:       "malformed token, error",
+			eventstore: expectEventstore(),
+			token:      "secret",
+			err:        zerrors.ThrowUnauthenticated(nil, "COMMAND-Ahc5o", "Errors.ClientRegistration.InitialAccessTokenInvalid"),
+		},
+		{
+			name: "unknown token, error",
+			eventstore: expectEventstore(
+				expectFilter(),
+			),
+			token: "token1.secret",
+			err:   zerrors.ThrowUnauthenticated(nil, "COMMAND-ieR1a", "Errors.ClientRegistration.InitialAccessTokenInvalid"),
+		},
+		{
+			name: "removed token, error",
+			eventstore: expectEventstore(
+				expectFilter(
+					tokenAddedEvent(time.Now().Add(time.Hour)),
+					eventFromEventPusher(
+						clientregistration.NewInitialAccessTokenRemovedEvent(ctx,
+							clientregistration.NewAggregate("instance1"),
+							"token1",
+						),
+					),
+				),
+			),
+			token: "token1.secret",
+			err:   zerrors.ThrowUnauthenticated(nil, "COMMAND-ieR1a", "Errors.ClientRegistration.InitialAccessTokenInvalid"),
+		},
+		{
+			name: "expired token, error",
+			eventstore: expectEventstore(
+				expectFilter(
+					tokenAddedEvent(time.Now().Add(-time.Hour)),
+				),
+			),
+			token: "token1.secret",
+			err:   zerrors.ThrowUnauthenticated(nil, "COMMAND-ieR1a", "Errors.ClientRegistration.InitialAccessTokenInvalid"),
+		},
+		{
+			name: "wrong secret, error",
+			eventstore: expectEventstore(
+				expectFilter(
+					tokenAddedEvent(time.Now().Add(time.Hour)),
+				),
+			),
+			token: "token1.wrong",
+			err:   zerrors.ThrowUnauthenticated(nil, "COMMAND-Ohj2u", "Errors.ClientRegistration.InitialAccessTokenInvalid"),
+		},
+		{
+			name: "valid token, ok",
+			eventstore: expectEventstore(
+				expectFilter(
+					tokenAddedEvent(time.Now().Add(time.Hour)),
+				),
+			),
+			token: "token1.secret",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:   tt.eventstore(t),
+				secretHasher: mockPasswordHasher("x"),
+			}
+			err := c.VerifyClientRegistrationInitialAccessToken(ctx, tt.token)
+			assert.ErrorIs(t, err, tt.err)
+		})
+	}
+}
+
+func TestCommands_RegisterOIDCClient(t *testing.T) {
+	ctx := authz.WithInstanceID(context.Background(), "instance1")
+	metadata := func(authMethod domain.OIDCAuthMethodType) *OIDCClientMetadata {
+		return &OIDCClientMetadata{
+			ClientName:      "mcp client",
+			RedirectURIs:    []string{"http://localhost:3000/callback"},
+			ResponseTypes:   []domain.OIDCResponseType{domain.OIDCResponseTypeCode},
+			GrantTypes:      []domain.OIDCGrantType{domain.OIDCGrantTypeAuthorizationCode},
+			ApplicationType: domain.OIDCApplicationTypeNative,
+			AuthMethodType:  authMethod,
+		}
+	}
+	type res struct {
+		want *RegisteredOIDCClient
+		err  error
+	}
+	tests := []struct {
+		name        string
+		eventstore  func(t *testing.T) *eventstore.Eventstore
+		idGenerator id.Generator
+		metadata    *OIDCClientMetadata
+		res         res
+	}{
+		{
+			name: "not configured, error",
+			eventstore: expectEventstore(
+				expectFilter(),
+			),
+			metadata: metadata(domain.OIDCAuthMethodTypeNone),
+			res: res{
+				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-ooK3e", "Errors.ClientRegistration.NotConfigured"),
+			},
+		},
+		{
+			name: "missing grant type, error",
+			eventstore: expectEventstore(
+				expectFilter(
+					clientRegistrationTestConfigSetEvent(),
+				),
+			),
+			metadata: &OIDCClientMetadata{
+				ClientName:     "mcp client",
+				RedirectURIs:   []string{"http://localhost:3000/callback"},
+				ResponseTypes:  []domain.OIDCResponseType{domain.OIDCResponseTypeCode},
+				AuthMethodType: domain.OIDCAuthMethodTypeNone,
+			},
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-Ieng4", "Errors.Project.App.Invalid"),
+			},
+		},
+		{
+			name: "private key jwt, error",
+			eventstore: expectEventstore(
+				expectFilter(
+					clientRegistrationTestConfigSetEvent(),
+				),
+			),
+			metadata: metadata(domain.OIDCAuthMethodTypePrivateKeyJWT),
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-Vai2o", "Errors.ClientRegistration.AuthMethodNotSupported"),
+			},
+		},
+		{
+			name: "register, ok",
+			eventstore: expectEventstore(
+				expectFilter(
+					clientRegistrationTestConfigSetEvent(),
+				),
+				expectFilter(
+					clientRegistrationTestProjectAddedEvent(),
+				),
+				expectPush(
+					project.NewApplicationAddedEvent(context.Background(),
+						&project.NewAggregate("project1", "org1").Aggregate,
+						"app1",
+						"mcp client",
+					),
+					project.NewOIDCConfigAddedEvent(context.Background(),
+						&project.NewAggregate("project1", "org1").Aggregate,
+						domain.OIDCVersionV1,
+						"app1",
+						"client1",
+						"",
+						[]string{"http://localhost:3000/callback"},
+						[]domain.OIDCResponseType{domain.OIDCResponseTypeCode},
+						[]domain.OIDCGrantType{domain.OIDCGrantTypeAuthorizationCode},
+						domain.OIDCApplicationTypeNative,
+						domain.OIDCAuthMethodTypeNone,
+						nil,
+						false,
+						domain.OIDCTokenTypeBearer,
+						false,
+						false,
+						false,
+						0,
+						nil,
+						false,
+						"",
+						domain.LoginVersionUnspecified,
+						"",
+						false,
+						false,
+						"",
+						false,
+						"",
+						nil,
+						false,
+						domain.OIDCRefreshTokenRotationDefault,
+						false,
+					),
+					project.NewOIDCConfigRegistrationAccessTokenSetEvent(context.Background(),
+						&project.NewAggregate("project1", "org1").Aggregate,
+						"app1",
+						"secret",
+					),
+				),
+			),
+			idGenerator: mock.NewIDGeneratorExpectIDs(t, "app1", "client1"),
+			metadata:    metadata(domain.OIDCAuthMethodTypeNone),
+			res: res{
+				want: &RegisteredOIDCClient{
+					App: &domain.OIDCApp{
+						ObjectRoot: models.ObjectRoot{
+							AggregateID:   "project1",
+							ResourceOwner: "org1",
+						},
+						AppID:           "app1",
+						AppName:         "mcp client",
+						ClientID:        "client1",
+						AuthMethodType:  domain.OIDCAuthMethodTypeNone,
+						OIDCVersion:     domain.OIDCVersionV1,
+						RedirectUris:    []string{"http://localhost:3000/callback"},
+						ResponseTypes:   []domain.OIDCResponseType{domain.OIDCResponseTypeCode},
+						GrantTypes:      []domain.OIDCGrantType{domain.OIDCGrantTypeAuthorizationCode},
+						ApplicationType: domain.OIDCApplicationTypeNative,
+						AccessTokenType: domain.OIDCTokenTypeBearer,
+						State:           domain.AppStateActive,
+						Compliance:      &domain.Compliance{},
+					},
+					RegistrationAccessToken: "secret",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:      tt.eventstore(t),
+				idGenerator:     tt.idGenerator,
+				newHashedSecret: mockHashedSecret("secret"),
+				defaultSecretGenerators: &SecretGenerators{
+					ClientSecret: emptyConfig,
+				},
+			}
+			c.setMilestonesCompletedForTest("instance1")
+			got, err := c.RegisterOIDCClient(ctx, tt.metadata)
+			assert.ErrorIs(t, err, tt.res.err)
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func clientRegistrationTestOIDCAppEvents(hashedRegistrationAccessToken string) []eventstore.Event {
+	events := []eventstore.Event{
+		eventFromEventPusher(
+			project.NewApplicationAddedEvent(context.Background(),
+				&project.NewAggregate("project1", "org1").Aggregate,
+				"app1",
+				"mcp client",
+			),
+		),
+		eventFromEventPusher(
+			project.NewOIDCConfigAddedEvent(context.Background(),
+				&project.NewAggregate("project1", "org1").Aggregate,
+				domain.OIDCVersionV1,
+				"app1",
+				"client1",
+				"",
+				[]string{"http://localhost:3000/callback"},
+				[]domain.OIDCResponseType{domain.OIDCResponseTypeCode},
+				[]domain.OIDCGrantType{domain.OIDCGrantTypeAuthorizationCode},
+				domain.OIDCApplicationTypeNative,
+				domain.OIDCAuthMethodTypeNone,
+				nil,
+				true,
+				domain.OIDCTokenTypeBearer,
+				false,
+				false,
+				false,
+				0,
+				nil,
+				false,
+				"",
+				domain.LoginVersionUnspecified,
+				"",
+				false,
+				false,
+				"",
+				false,
+				"",
+				nil,
+				false,
+				domain.OIDCRefreshTokenRotationDefault,
+				false,
+			),
+		),
+	}
+	if hashedRegistrationAccessToken != "" {
+		events = append(events, eventFromEventPusher(
+			project.NewOIDCConfigRegistrationAccessTokenSetEvent(context.Background(),
+				&project.NewAggregate("project1", "org1").Aggregate,
+				"app1",
+				hashedRegistrationAccessToken,
+			),
+		))
+	}
+	return events
+}
+
+func TestCommands_VerifyOIDCClientRegistrationAccessToken(t *testing.T) {
+	tests := []struct {
+		name       string
+		eventstore func(t *testing.T) *eventstore.Eventstore
+		token      string
+		err        error
+	}{
+		{
+			name: "not registered dynamically, error",
+			eventstore: expectEventstore(
+				expectFilter(clientRegistrationTestOIDCAppEvents("")...),
+			),
+			token: "secret",
+			err:   zerrors.ThrowUnauthenticated(nil, "COMMAND-Aeth4", "Errors.ClientRegistration.RegistrationAccessTokenInvalid"),
+		},
+		{
+			name: "wrong token, error",
+			eventstore: expectEventstore(
+				expectFilter(clientRegistrationTestOIDCAppEvents("$plain$x$secret")...),
+			),
+			token: "wrong",
+			err:   zerrors.ThrowUnauthenticated(nil, "COMMAND-Ui0ae", "Errors.ClientRegistration.RegistrationAccessTokenInvalid"),
+		},
+		{
+			name: "valid token, ok",
+			eventstore: expectEventstore(
+				expectFilter(clientRegistrationTestOIDCAppEvents("$plain$x$secret")...),
+			),
+			token: "secret",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:   tt.eventstore(t),
+				secretHasher: mockPasswordHasher("x"),
+			}
+			err := c.VerifyOIDCClientRegistrationAccessToken(context.Background(), "project1", "app1", tt.token)
+			assert.ErrorIs(t, err, tt.err)
+		})
+	}
+}
+
+func TestCommands_ChangeRegisteredOIDCClient(t *testing.T) {
+	tests := []struct {
+		name       string
+		eventstore func(t *testing.T) *eventstore.Eventstore
+		metadata   *OIDCClientMetadata
+		want       *domain.OIDCApp
+		err        error
+	}{
+		{
+			name: "not registered dynamically, error",
+			eventstore: expectEventstore(
+				expectFilter(clientRegistrationTestOIDCAppEvents("")...),
+			),
+			metadata: &OIDCClientMetadata{},
+			err:      zerrors.ThrowNotFound(nil, "COMMAND-Thoh8", "Errors.Project.App.NotExisting"),
+		},
+		{
+			name: "auth method changed, error",
+			eventstore: expectEventstore(
+				expectFilter(clientRegistrationTestOIDCAppEvents("$plain$x$secret")...),
+			),
+			metadata: &OIDCClientMetadata{
+				ClientName:      "mcp client",
+				RedirectURIs:    []string{"http://localhost:3000/callback"},
+				ResponseTypes:   []domain.OIDCResponseType{domain.OIDCResponseTypeCode},
+				GrantTypes:      []domain.OIDCGrantType{domain.OIDCGrantTypeAuthorizationCode},
+				ApplicationType: domain.OIDCApplicationTypeNative,
+				AuthMethodType:  domain.OIDCAuthMethodTypeBasic,
+			},
+			err: zerrors.ThrowInvalidArgument(nil, "COMMAND-ahN9u", "Errors.ClientRegistration.AuthMethodNotSupported"),
+		},
+		{
+			name: "no changes, ok",
+			eventstore: expectEventstore(
+				expectFilter(clientRegistrationTestOIDCAppEvents("$plain$x$secret")...),
+			),
+			metadata: &OIDCClientMetadata{
+				ClientName:      "mcp client",
+				RedirectURIs:    []string{"http://localhost:3000/callback"},
+				ResponseTypes:   []domain.OIDCResponseType{domain.OIDCResponseTypeCode},
+				GrantTypes:      []domain.OIDCGrantType{domain.OIDCGrantTypeAuthorizationCode},
+				ApplicationType: domain.OIDCApplicationTypeNative,
+				AuthMethodType:  domain.OIDCAuthMethodTypeNone,
+			},
+			want: &domain.OIDCApp{
+				ObjectRoot: models.ObjectRoot{
+					AggregateID:   "project1",
+					ResourceOwner: "org1",
+				},
+				AppID:           "app1",
+				AppName:         "mcp client",
+				ClientID:        "client1",
+				AuthMethodType:  domain.OIDCAuthMethodTypeNone,
+				OIDCVersion:     domain.OIDCVersionV1,
+				RedirectUris:    []string{"http://localhost:3000/callback"},
+				ResponseTypes:   []domain.OIDCResponseType{domain.OIDCResponseTypeCode},
+				GrantTypes:      []domain.OIDCGrantType{domain.OIDCGrantTypeAuthorizationCode},
+				ApplicationType: domain.OIDCApplicationTypeNative,
+				AccessTokenType: domain.OIDCTokenTypeBearer,
+				DevMode:         true,
+				State:           domain.AppStateActive,
+				Compliance:      &domain.Compliance{},
+			},
+		},
+		{
+			name: "name and redirect uris changed, settings kept, ok",
+			eventstore: expectEventstore(
+				expectFilter(clientRegistrationTestOIDCAppEvents("$plain$x$secret")...),
+				expectPush(
+					project.NewApplicationChangedEvent(context.Background(),
+						&project.NewAggregate("project1", "org1").Aggregate,
+						"app1",
+						"mcp client",
+						"renamed client",
+					),
+					newOIDCAppChangedEventRedirectURIs(t, "http://localhost:4000/callback"),
+				),
+			),
+			metadata: &OIDCClientMetadata{
+				ClientName:      "renamed client",
+				RedirectURIs:    []string{"http://localhost:4000/callback"},
+				ResponseTypes:   []domain.OIDCResponseType{domain.OIDCResponseTypeCode},
+				GrantTypes:      []domain.OIDCGrantType{domain.OIDCGrantTypeAuthorizationCode},
+				ApplicationType: domain.OIDCApplicationTypeNative,
+				AuthMethodType:  domain.OIDCAuthMethodTypeNone,
+			},
+			want: &domain.OIDCApp{
+				ObjectRoot: models.ObjectRoot{
+					AggregateID:   "project1",
+					ResourceOwner: "org1",
+				},
+				AppID:           "app1",
+				AppName:         "renamed client",
+				ClientID:        "client1",
+				AuthMethodType:  domain.OIDCAuthMethodTypeNone,
+				OIDCVersion:     domain.OIDCVersionV1,
+				RedirectUris:    []string{"http://localhost:4000/callback"},
+				ResponseTypes:   []domain.OIDCResponseType{domain.OIDCResponseTypeCode},
+				GrantTypes:      []domain.OIDCGrantType{domain.OIDCGrantTypeAuthorizationCode},
+				ApplicationType: domain.OIDCApplicationTypeNative,
+				AccessTokenType: domain.OIDCTokenTypeBearer,
+				DevMode:         true,
+				State:           domain.AppStateActive,
+				Compliance:      &domain.Compliance{},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.eventstore(t),
+			}
+			got, err := c.ChangeRegisteredOIDCClient(context.Background(), "project1", "app1", tt.metadata)
+			assert.ErrorIs(t, err, tt.err)
+			if tt.err == nil {
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func newOIDCAppChangedEventRedirectURIs(t *testing.T, redirectURIs ...string) *project.OIDCConfigChangedEvent {
+	event, err := project.NewOIDCConfigChangedEvent(context.Background(),
+		&project.NewAggregate("project1", "org1").Aggregate,
+		"app1",
+		[]project.OIDCConfigChanges{
+			project.ChangeRedirectURIs(redirectURIs),
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return event
+}
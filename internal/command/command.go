@@ -43,6 +43,7 @@ type Commands struct {
 	newEncryptedCode            encrypedCodeFunc
 	newEncryptedCodeWithDefault encryptedCodeWithDefaultFunc
 	newHashedSecret             hashedSecretFunc
+	newRecoveryCode             func() (encodedHash, plain string, err error)
 
 	eventstore     *eventstore.Eventstore
 	static         static.Storage
@@ -203,6 +204,7 @@ func StartCommands(
 		caches:         caches,
 	}
 
+	repo.newRecoveryCode = crypto.NewHashGenerator(domain.RecoveryCodeGeneratorConfig, secretHasher).NewCode
 	if defaultSecretGenerators != nil && defaultSecretGenerators.ClientSecret != nil {
 		repo.newHashedSecret = newHashedSecretWithDefault(secretHasher, defaultSecretGenerators.ClientSecret)
 	}
@@ -2,6 +2,7 @@ package command
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"github.com/zitadel/zitadel/internal/command/preparation"
@@ -110,6 +111,7 @@ type LDAPProvider struct {
 	UserFilters       []string
 	Timeout           time.Duration
 	RootCA            []byte
+	GroupSync         *idp.LDAPGroupSync
 	LDAPAttributes    idp.LDAPAttributes
 	IDPOptions        idp.Options
 }
@@ -221,3 +223,25 @@ func IDPProviderWriteModel(ctx context.Context, filter preparation.FilterToQuery
 
 	return allWriteModel, err
 }
+
+// validateLDAPGroupSync ensures every group mapping grants at least one role of a project to a group.
+func validateLDAPGroupSync(groupSync *idp.LDAPGroupSync) error {
+	if groupSync == nil {
+		return nil
+	}
+	for _, mapping := range groupSync.GroupMappings {
+		if mapping == nil {
+			return zerrors.ThrowInvalidArgument(nil, "COMMAND-ohG0a", "Errors.Invalid.Argument")
+		}
+		if mapping.Group = strings.TrimSpace(mapping.Group); mapping.Group == "" {
+			return zerrors.ThrowInvalidArgument(nil, "COMMAND-Aeph4", "Errors.Invalid.Argument")
+		}
+		if mapping.ProjectID = strings.TrimSpace(mapping.ProjectID); mapping.ProjectID == "" {
+			return zerrors.ThrowInvalidArgument(nil, "COMMAND-ieZ7u", "Errors.Invalid.Argument")
+		}
+		if len(mapping.RoleKeys) == 0 {
+			return zerrors.ThrowInvalidArgument(nil, "COMMAND-Xu1ee", "Errors.Invalid.Argument")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,176 @@
+package command
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/idp"
+	"github.com/zitadel/zitadel/internal/repository/usergrant"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// SyncLDAPUserGrants adds, changes and removes the user grants of the user,
+// so they match the roles mapped to the LDAP groups (DNs) the user is member of.
+// Only the roles of the projects defined in the group mappings of the IdP are managed,
+// any other role or grant of the user is kept as is.
+// The grants are created on the organization owning the project.
+func (c *Commands) SyncLDAPUserGrants(ctx context.Context, idpID, userID string, groups []string) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if idpID == "" || userID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-ahP4o", "Errors.IDMissing")
+	}
+	provider, err := IDPProviderWriteModel(ctx, c.eventstore.Filter, idpID)
+	if err != nil {
+		return err
+	}
+	groupSync := provider.LDAPGroupSync()
+	if groupSync == nil || len(groupSync.GroupMappings) == 0 {
+		return nil
+	}
+	desired, managed := ldapGroupMappingsToRoles(groupSync.GroupMappings, groups)
+
+	existing, err := c.userGrantsOfProjects(ctx, userID, managed)
+	if err != nil {
+		return err
+	}
+	cmds := make([]eventstore.Command, 0, len(managed))
+	for _, projectID := range sortedKeys(managed) {
+		cmd, err := c.syncLDAPProjectGrant(ctx, userID, projectID, existing[projectID], desired[projectID], managed[projectID])
+		if err != nil {
+			return err
+		}
+		if cmd != nil {
+			cmds = append(cmds, cmd)
+		}
+	}
+	if len(cmds) == 0 {
+		return nil
+	}
+	_, err = c.eventstore.Push(ctx, cmds...)
+	return err
+}
+
+// syncLDAPProjectGrant returns the command to add, change or remove the grant of the user on the project.
+// The role keys not managed by the group mappings are kept in the grant.
+func (c *Commands) syncLDAPProjectGrant(ctx context.Context, userID, projectID string, existing *UserGrantWriteModel, desired, managed []string) (eventstore.Command, error) {
+	if existing == nil {
+		if len(desired) == 0 {
+			return nil, nil
+		}
+		resourceOwner, err := c.checkProjectExists(ctx, projectID, "")
+		if err != nil {
+			return nil, err
+		}
+		grant := &domain.UserGrant{
+			UserID:    userID,
+			ProjectID: projectID,
+			RoleKeys:  desired,
+		}
+		if err = c.checkUserGrantPreCondition(ctx, grant, resourceOwner); err != nil {
+			return nil, err
+		}
+		grantID, err := c.idGenerator.Next()
+		if err != nil {
+			return nil, err
+		}
+		return usergrant.NewUserGrantAddedEvent(ctx, &usergrant.NewAggregate(grantID, resourceOwner).Aggregate, userID, projectID, "", desired), nil
+	}
+
+	roleKeys := make([]string, 0, len(existing.RoleKeys)+len(desired))
+	for _, key := range existing.RoleKeys {
+		if !slices.Contains(managed, key) {
+			roleKeys = append(roleKeys, key)
+		}
+	}
+	roleKeys = append(roleKeys, desired...)
+	agg := UserGrantAggregateFromWriteModel(&existing.WriteModel)
+	if len(roleKeys) == 0 {
+		return usergrant.NewUserGrantRemovedEvent(ctx, agg, userID, existing.ProjectID, existing.ProjectGrantID), nil
+	}
+	if equalRoleKeys(existing.RoleKeys, roleKeys) {
+		return nil, nil
+	}
+	if err := c.checkUserGrantPreCondition(ctx, &domain.UserGrant{UserID: userID, ProjectID: projectID, RoleKeys: roleKeys}, existing.ResourceOwner); err != nil {
+		return nil, err
+	}
+	return usergrant.NewUserGrantChangedEvent(ctx, agg, userID, roleKeys), nil
+}
+
+// userGrantsOfProjects returns the active or inactive grant of the user for each of the projects,
+// which was created on the project directly (not on a project grant).
+func (c *Commands) userGrantsOfProjects(ctx context.Context, userID string, projects map[string][]string) (_ map[string]*UserGrantWriteModel, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	grantsOfUser, err := c.userGrantsOfUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	grants := make(map[string]*UserGrantWriteModel, len(projects))
+	for _, grantID := range sortedKeys(grantsOfUser.Grants) {
+		grant := grantsOfUser.Grants[grantID]
+		if _, ok := projects[grant.ProjectID]; !ok || grant.ProjectGrantID != "" || grants[grant.ProjectID] != nil {
+			continue
+		}
+		writeModel, err := c.userGrantWriteModelByID(ctx, grantID, grant.ResourceOwner)
+		if err != nil {
+			return nil, err
+		}
+		if writeModel.State == domain.UserGrantStateUnspecified || writeModel.State == domain.UserGrantStateRemoved {
+			continue
+		}
+		grants[grant.ProjectID] = writeModel
+	}
+	return grants, nil
+}
+
+// ldapGroupMappingsToRoles returns the role keys per project the groups are mapped to (desired)
+// and all role keys per project used in the mappings (managed).
+// Groups are compared case-insensitive, as DNs are.
+func ldapGroupMappingsToRoles(mappings []*idp.LDAPGroupMapping, groups []string) (desired, managed map[string][]string) {
+	desired = make(map[string][]string)
+	managed = make(map[string][]string)
+	for _, mapping := range mappings {
+		for _, key := range mapping.RoleKeys {
+			if !slices.Contains(managed[mapping.ProjectID], key) {
+				managed[mapping.ProjectID] = append(managed[mapping.ProjectID], key)
+			}
+		}
+		if !slices.ContainsFunc(groups, func(group string) bool { return strings.EqualFold(group, mapping.Group) }) {
+			continue
+		}
+		for _, key := range mapping.RoleKeys {
+			if !slices.Contains(desired[mapping.ProjectID], key) {
+				desired[mapping.ProjectID] = append(desired[mapping.ProjectID], key)
+			}
+		}
+	}
+	return desired, managed
+}
+
+func equalRoleKeys(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for _, key := range a {
+		if !slices.Contains(b, key) {
+			return false
+		}
+	}
+	return true
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
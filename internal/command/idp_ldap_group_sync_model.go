@@ -0,0 +1,90 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/usergrant"
+)
+
+// userGrantsOfUserWriteModel collects the IDs of the (not removed) user grants of a user
+// and the projects they were created on, see [Commands.userGrantsOfUser].
+// The role keys are not part of the model, since they can be changed by events not containing the user.
+type userGrantsOfUserWriteModel struct {
+	eventstore.WriteModel
+
+	UserID string
+	// Grants maps the ID of the user grant to the project.
+	Grants map[string]*userGrantOfUser
+}
+
+type userGrantOfUser struct {
+	ProjectID      string
+	ProjectGrantID string
+	ResourceOwner  string
+}
+
+func newUserGrantsOfUserWriteModel(userID string) *userGrantsOfUserWriteModel {
+	return &userGrantsOfUserWriteModel{
+		UserID: userID,
+		Grants: make(map[string]*userGrantOfUser),
+	}
+}
+
+func (wm *userGrantsOfUserWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *usergrant.UserGrantAddedEvent:
+			wm.Grants[e.Aggregate().ID] = &userGrantOfUser{
+				ProjectID:      e.ProjectID,
+				ProjectGrantID: e.ProjectGrantID,
+				ResourceOwner:  e.Aggregate().ResourceOwner,
+			}
+		case *usergrant.UserGrantRemovedEvent:
+			delete(wm.Grants, e.Aggregate().ID)
+		case *usergrant.UserGrantCascadeRemovedEvent:
+			delete(wm.Grants, e.Aggregate().ID)
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+// Query returns the added user grants of the user.
+// The removals are queried by the IDs of the grants, see [userGrantsOfUserWriteModel.removedQuery].
+func (wm *userGrantsOfUserWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(usergrant.AggregateType).
+		EventTypes(usergrant.UserGrantAddedType).
+		EventData(map[string]interface{}{"userId": wm.UserID}).
+		Builder()
+}
+
+// removedQuery returns the removals of the user grants found by [userGrantsOfUserWriteModel.Query],
+// as not all of them contain the user, e.g. [usergrant.UserGrantCascadeRemovedEvent].
+func (wm *userGrantsOfUserWriteModel) removedQuery() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(usergrant.AggregateType).
+		AggregateIDs(sortedKeys(wm.Grants)...).
+		EventTypes(
+			usergrant.UserGrantRemovedType,
+			usergrant.UserGrantCascadeRemovedType,
+		).
+		Builder()
+}
+
+// userGrantsOfUser returns the (not removed) user grants of the user.
+func (c *Commands) userGrantsOfUser(ctx context.Context, userID string) (*userGrantsOfUserWriteModel, error) {
+	writeModel := newUserGrantsOfUserWriteModel(userID)
+	if err := c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	if len(writeModel.Grants) == 0 {
+		return writeModel, nil
+	}
+	if err := c.eventstore.FilterToReducer(ctx, writeModel.removedQuery(), writeModel); err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
@@ -0,0 +1,256 @@
+package command
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/id"
+	id_mock "github.com/zitadel/zitadel/internal/id/mock"
+	"github.com/zitadel/zitadel/internal/repository/idp"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/repository/usergrant"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommandSide_SyncLDAPUserGrants(t *testing.T) {
+	ldapIDPAdded := func(groupSync *idp.LDAPGroupSync) eventstore.Event {
+		return eventFromEventPusher(
+			instance.NewLDAPIDPAddedEvent(context.Background(), &instance.NewAggregate("instance1").Aggregate,
+				"idp1",
+				"name",
+				[]string{"server"},
+				false,
+				"basedn",
+				"binddn",
+				&crypto.CryptoValue{
+					CryptoType: crypto.TypeEncryption,
+					Algorithm:  "enc",
+					KeyID:      "id",
+					Crypted:    []byte("password"),
+				},
+				"user",
+				[]string{"object"},
+				[]string{"filter"},
+				time.Second*30,
+				nil,
+				groupSync,
+				idp.LDAPAttributes{},
+				idp.Options{},
+			),
+		)
+	}
+	groupSync := &idp.LDAPGroupSync{
+		GroupMappings: []*idp.LDAPGroupMapping{
+			{Group: "cn=admins,dc=example,dc=com", ProjectID: "project1", RoleKeys: []string{"admin"}},
+			{Group: "cn=users,dc=example,dc=com", ProjectID: "project1", RoleKeys: []string{"viewer"}},
+		},
+	}
+	userAdded := eventFromEventPusher(
+		user.NewHumanAddedEvent(context.Background(),
+			&user.NewAggregate("user1", "org1").Aggregate,
+			"username1",
+			"firstname1",
+			"lastname1",
+			"nickname1",
+			"displayname1",
+			language.German,
+			domain.GenderMale,
+			"email1",
+			true,
+		),
+	)
+	projectAdded := eventFromEventPusher(
+		project.NewProjectAddedEvent(context.Background(),
+			&project.NewAggregate("project1", "org1").Aggregate,
+			"projectname1", true, true, true,
+			domain.PrivateLabelingSettingUnspecified,
+		),
+	)
+	roleAdded := func(key string) eventstore.Event {
+		return eventFromEventPusher(
+			project.NewRoleAddedEvent(context.Background(),
+				&project.NewAggregate("project1", "org1").Aggregate,
+				key,
+				key,
+				"",
+			),
+		)
+	}
+	userGrantAdded := func(roleKeys ...string) eventstore.Event {
+		return eventFromEventPusher(
+			usergrant.NewUserGrantAddedEvent(context.Background(),
+				&usergrant.NewAggregate("usergrant1", "org1").Aggregate,
+				"user1",
+				"project1",
+				"",
+				roleKeys,
+			),
+		)
+	}
+
+	type fields struct {
+		eventstore  func(t *testing.T) *eventstore.Eventstore
+		idGenerator id.Generator
+	}
+	type args struct {
+		ctx    context.Context
+		idpID  string
+		userID string
+		groups []string
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr func(error) bool
+	}{
+		{
+			name: "missing user id, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				ctx:   authz.WithInstanceID(context.Background(), "instance1"),
+				idpID: "idp1",
+			},
+			wantErr: zerrors.IsErrorInvalidArgument,
+		},
+		{
+			name: "no group sync, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(ldapIDPAdded(nil)),
+					expectFilter(ldapIDPAdded(nil)),
+				),
+			},
+			args: args{
+				ctx:    authz.WithInstanceID(context.Background(), "instance1"),
+				idpID:  "idp1",
+				userID: "user1",
+				groups: []string{"cn=admins,dc=example,dc=com"},
+			},
+		},
+		{
+			name: "add grant, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(ldapIDPAdded(groupSync)),
+					expectFilter(ldapIDPAdded(groupSync)),
+					expectFilter(),
+					expectFilter(projectAdded),
+					expectFilter(userAdded, projectAdded, roleAdded("admin"), roleAdded("viewer")),
+					expectPush(
+						usergrant.NewUserGrantAddedEvent(context.Background(),
+							&usergrant.NewAggregate("usergrant1", "org1").Aggregate,
+							"user1",
+							"project1",
+							"",
+							[]string{"admin"},
+						),
+					),
+				),
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "usergrant1"),
+			},
+			args: args{
+				ctx:    authz.WithInstanceID(context.Background(), "instance1"),
+				idpID:  "idp1",
+				userID: "user1",
+				groups: []string{"CN=Admins,DC=example,DC=com", "cn=other,dc=example,dc=com"},
+			},
+		},
+		{
+			name: "change grant, keeps unmanaged roles, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(ldapIDPAdded(groupSync)),
+					expectFilter(ldapIDPAdded(groupSync)),
+					expectFilter(userGrantAdded("admin", "other")),
+					expectFilter(),
+					expectFilter(userGrantAdded("admin", "other")),
+					expectFilter(userAdded, projectAdded, roleAdded("admin"), roleAdded("viewer"), roleAdded("other")),
+					expectPush(
+						usergrant.NewUserGrantChangedEvent(context.Background(),
+							&usergrant.NewAggregate("usergrant1", "org1").Aggregate,
+							"user1",
+							[]string{"other", "viewer"},
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:    authz.WithInstanceID(context.Background(), "instance1"),
+				idpID:  "idp1",
+				userID: "user1",
+				groups: []string{"cn=users,dc=example,dc=com"},
+			},
+		},
+		{
+			name: "unchanged grant, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(ldapIDPAdded(groupSync)),
+					expectFilter(ldapIDPAdded(groupSync)),
+					expectFilter(userGrantAdded("viewer")),
+					expectFilter(),
+					expectFilter(userGrantAdded("viewer")),
+				),
+			},
+			args: args{
+				ctx:    authz.WithInstanceID(context.Background(), "instance1"),
+				idpID:  "idp1",
+				userID: "user1",
+				groups: []string{"cn=users,dc=example,dc=com"},
+			},
+		},
+		{
+			name: "remove grant, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(ldapIDPAdded(groupSync)),
+					expectFilter(ldapIDPAdded(groupSync)),
+					expectFilter(userGrantAdded("admin", "viewer")),
+					expectFilter(),
+					expectFilter(userGrantAdded("admin", "viewer")),
+					expectPush(
+						usergrant.NewUserGrantRemovedEvent(context.Background(),
+							&usergrant.NewAggregate("usergrant1", "org1").Aggregate,
+							"user1",
+							"project1",
+							"",
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:    authz.WithInstanceID(context.Background(), "instance1"),
+				idpID:  "idp1",
+				userID: "user1",
+				groups: nil,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:  tt.fields.eventstore(t),
+				idGenerator: tt.fields.idGenerator,
+			}
+			err := c.SyncLDAPUserGrants(tt.args.ctx, tt.args.idpID, tt.args.userID, tt.args.groups)
+			if tt.wantErr != nil {
+				assert.True(t, tt.wantErr(err))
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -1385,6 +1385,7 @@ type LDAPIDPWriteModel struct {
 	UserFilters       []string
 	Timeout           time.Duration
 	RootCA            []byte
+	GroupSync         *idp.LDAPGroupSync
 	idp.LDAPAttributes
 	idp.Options
 
@@ -1426,6 +1427,7 @@ func (wm *LDAPIDPWriteModel) reduceAddedEvent(e *idp.LDAPIDPAddedEvent) {
 	wm.UserFilters = e.UserFilters
 	wm.Timeout = e.Timeout
 	wm.RootCA = e.RootCA
+	wm.GroupSync = e.GroupSync
 	wm.LDAPAttributes = e.LDAPAttributes
 	wm.Options = e.Options
 	wm.State = domain.IDPStateActive
@@ -1465,6 +1467,12 @@ func (wm *LDAPIDPWriteModel) reduceChangedEvent(e *idp.LDAPIDPChangedEvent) {
 	if e.Timeout != nil {
 		wm.Timeout = *e.Timeout
 	}
+	if e.GroupSync != nil {
+		wm.GroupSync = e.GroupSync
+		if e.GroupSync.IsZero() {
+			wm.GroupSync = nil
+		}
+	}
 	wm.LDAPAttributes.ReduceChanges(e.LDAPAttributeChanges)
 	wm.Options.ReduceChanges(e.OptionChanges)
 }
@@ -1481,6 +1489,7 @@ func (wm *LDAPIDPWriteModel) NewChanges(
 	userFilters []string,
 	timeout time.Duration,
 	rootCA []byte,
+	groupSync *idp.LDAPGroupSync,
 	secretCrypto crypto.EncryptionAlgorithm,
 	attributes idp.LDAPAttributes,
 	options idp.Options,
@@ -1525,6 +1534,12 @@ func (wm *LDAPIDPWriteModel) NewChanges(
 	if !bytes.Equal(wm.RootCA, rootCA) {
 		changes = append(changes, idp.ChangeLDAPRootCA(rootCA))
 	}
+	if groupSync.IsZero() {
+		groupSync = nil
+	}
+	if !reflect.DeepEqual(wm.GroupSync, groupSync) {
+		changes = append(changes, idp.ChangeLDAPGroupSync(groupSync))
+	}
 	attrs := wm.LDAPAttributes.Changes(attributes)
 	if !attrs.IsZero() {
 		changes = append(changes, idp.ChangeLDAPAttributes(attrs))
@@ -1596,6 +1611,9 @@ func (wm *LDAPIDPWriteModel) ToProvider(callbackURL string, idpAlg crypto.Encryp
 	if wm.IsAutoUpdate {
 		opts = append(opts, ldap.WithAutoUpdate())
 	}
+	if wm.GroupSync != nil && len(wm.GroupSync.GroupMappings) > 0 {
+		opts = append(opts, ldap.WithGroupSearch(wm.GroupSync.GroupBaseDN, wm.GroupSync.GroupObjectClasses, wm.GroupSync.GroupMemberAttribute))
+	}
 	return ldap.New(
 		wm.Name,
 		wm.Servers,
@@ -2304,6 +2322,19 @@ func (wm *AllIDPWriteModel) GetProviderOptions() idp.Options {
 	return wm.samlModel.GetProviderOptions()
 }
 
+// LDAPGroupSync returns the group synchronisation settings of an LDAP provider,
+// nil is returned for other types or if the synchronisation is not configured.
+func (wm *AllIDPWriteModel) LDAPGroupSync() *idp.LDAPGroupSync {
+	switch model := wm.model.(type) {
+	case *InstanceLDAPIDPWriteModel:
+		return model.GroupSync
+	case *OrgLDAPIDPWriteModel:
+		return model.GroupSync
+	default:
+		return nil
+	}
+}
+
 func (wm *AllIDPWriteModel) ToSAMLProvider(callbackURL string, idpAlg crypto.EncryptionAlgorithm, getRequest requesttracker.GetRequest, addRequest requesttracker.AddRequest) (providers.Provider, error) {
 	if wm.samlModel == nil {
 		return nil, zerrors.ThrowInternal(nil, "COMMAND-csi30hdscv", "ErrorsIDPConfig.NotExisting")
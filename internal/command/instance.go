@@ -61,6 +61,8 @@ type InstanceSetup struct {
 	PasswordAgePolicy struct {
 		ExpireWarnDays uint64
 		MaxAgeDays     uint64
+		HistoryCount   uint64
+		MinAgeDays     uint64
 	}
 	DomainPolicy struct {
 		UserLoginMustBeDomain                  bool
@@ -333,6 +335,8 @@ func setupInstanceElements(instanceAgg *instance.Aggregate, setup *InstanceSetup
 			instanceAgg,
 			setup.PasswordAgePolicy.ExpireWarnDays,
 			setup.PasswordAgePolicy.MaxAgeDays,
+			setup.PasswordAgePolicy.HistoryCount,
+			setup.PasswordAgePolicy.MinAgeDays,
 		),
 		prepareAddDefaultDomainPolicy(
 			instanceAgg,
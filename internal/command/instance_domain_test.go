@@ -158,6 +158,15 @@ func TestCommandSide_AddInstanceDomain(t *testing.T) {
 								"",
 								domain.LoginVersionUnspecified,
 								"",
+								false,
+								false,
+								"",
+								false,
+								"",
+								nil,
+								false,
+								domain.OIDCRefreshTokenRotationDefault,
+								false,
 							),
 						),
 					),
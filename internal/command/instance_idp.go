@@ -1538,6 +1538,9 @@ func (c *Commands) prepareAddInstanceLDAPProvider(a *instance.Aggregate, writeMo
 				return nil, err
 			}
 		}
+		if err := validateLDAPGroupSync(provider.GroupSync); err != nil {
+			return nil, err
+		}
 
 		return func(ctx context.Context, filter preparation.FilterToQueryReducer) ([]eventstore.Command, error) {
 			events, err := filter(ctx, writeModel.Query())
@@ -1568,6 +1571,7 @@ func (c *Commands) prepareAddInstanceLDAPProvider(a *instance.Aggregate, writeMo
 					provider.UserFilters,
 					provider.Timeout,
 					provider.RootCA,
+					provider.GroupSync,
 					provider.LDAPAttributes,
 					provider.IDPOptions,
 				),
@@ -1615,6 +1619,9 @@ func (c *Commands) prepareUpdateInstanceLDAPProvider(a *instance.Aggregate, writ
 				return nil, err
 			}
 		}
+		if err := validateLDAPGroupSync(provider.GroupSync); err != nil {
+			return nil, err
+		}
 		return func(ctx context.Context, filter preparation.FilterToQueryReducer) ([]eventstore.Command, error) {
 			events, err := filter(ctx, writeModel.Query())
 			if err != nil {
@@ -1642,6 +1649,7 @@ func (c *Commands) prepareUpdateInstanceLDAPProvider(a *instance.Aggregate, writ
 				provider.UserFilters,
 				provider.Timeout,
 				provider.RootCA,
+				provider.GroupSync,
 				c.idpConfigEncryption,
 				provider.LDAPAttributes,
 				provider.IDPOptions,
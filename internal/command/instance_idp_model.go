@@ -772,6 +772,7 @@ func (wm *InstanceLDAPIDPWriteModel) NewChangedEvent(
 	userFilters []string,
 	timeout time.Duration,
 	rootCA []byte,
+	groupSync *idp.LDAPGroupSync,
 	secretCrypto crypto.EncryptionAlgorithm,
 	attributes idp.LDAPAttributes,
 	options idp.Options,
@@ -789,6 +790,7 @@ func (wm *InstanceLDAPIDPWriteModel) NewChangedEvent(
 		userFilters,
 		timeout,
 		rootCA,
+		groupSync,
 		secretCrypto,
 		attributes,
 		options,
@@ -4330,6 +4330,7 @@ func TestCommandSide_AddInstanceLDAPIDP(t *testing.T) {
 							[]string{"filter"},
 							time.Second*30,
 							nil,
+							nil,
 							idp.LDAPAttributes{},
 							idp.Options{},
 						),
@@ -4382,6 +4383,7 @@ func TestCommandSide_AddInstanceLDAPIDP(t *testing.T) {
 							[]string{"filter"},
 							time.Second*30,
 							validLDAPRootCA,
+							nil,
 							idp.LDAPAttributes{
 								IDAttribute:                "id",
 								FirstNameAttribute:         "firstName",
@@ -4725,6 +4727,7 @@ func TestCommandSide_UpdateInstanceLDAPIDP(t *testing.T) {
 								[]string{"filter"},
 								time.Second*30,
 								validLDAPRootCA,
+								nil,
 								idp.LDAPAttributes{},
 								idp.Options{},
 							)),
@@ -4775,6 +4778,7 @@ func TestCommandSide_UpdateInstanceLDAPIDP(t *testing.T) {
 								[]string{"filter"},
 								time.Second*30,
 								nil,
+								nil,
 								idp.LDAPAttributes{},
 								idp.Options{},
 							)),
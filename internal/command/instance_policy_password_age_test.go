@@ -23,6 +23,8 @@ func TestCommandSide_AddDefaultPasswordAgePolicy(t *testing.T) {
 		ctx            context.Context
 		maxAgeDays     uint64
 		expireWarnDays uint64
+		historyCount   uint64
+		minAgeDays     uint64
 	}
 	type res struct {
 		want *domain.ObjectDetails
@@ -45,6 +47,8 @@ func TestCommandSide_AddDefaultPasswordAgePolicy(t *testing.T) {
 								&instance.NewAggregate("INSTANCE").Aggregate,
 								365,
 								10,
+								0,
+								0,
 							),
 						),
 					),
@@ -70,6 +74,8 @@ func TestCommandSide_AddDefaultPasswordAgePolicy(t *testing.T) {
 							&instance.NewAggregate("INSTANCE").Aggregate,
 							365,
 							10,
+							0,
+							0,
 						),
 					),
 				),
@@ -91,7 +97,7 @@ func TestCommandSide_AddDefaultPasswordAgePolicy(t *testing.T) {
 			r := &Commands{
 				eventstore: tt.fields.eventstore,
 			}
-			got, err := r.AddDefaultPasswordAgePolicy(tt.args.ctx, tt.args.expireWarnDays, tt.args.maxAgeDays)
+			got, err := r.AddDefaultPasswordAgePolicy(tt.args.ctx, tt.args.expireWarnDays, tt.args.maxAgeDays, tt.args.historyCount, tt.args.minAgeDays)
 			if tt.res.err == nil {
 				assert.NoError(t, err)
 			}
@@ -153,6 +159,8 @@ func TestCommandSide_ChangeDefaultPasswordAgePolicy(t *testing.T) {
 								&instance.NewAggregate("INSTANCE").Aggregate,
 								365,
 								10,
+								0,
+								0,
 							),
 						),
 					),
@@ -180,6 +188,8 @@ func TestCommandSide_ChangeDefaultPasswordAgePolicy(t *testing.T) {
 								&instance.NewAggregate("INSTANCE").Aggregate,
 								365,
 								10,
+								0,
+								0,
 							),
 						),
 					),
@@ -207,6 +217,59 @@ func TestCommandSide_ChangeDefaultPasswordAgePolicy(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "change with password history, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewPasswordAgePolicyAddedEvent(context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								365,
+								10,
+								0,
+								0,
+							),
+						),
+					),
+					expectPush(
+						func() eventstore.Command {
+							event, _ := instance.NewPasswordAgePolicyChangedEvent(context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								[]policy.PasswordAgePolicyChanges{
+									policy.ChangeHistoryCount(5),
+									policy.ChangeMinAgeDays(1),
+								},
+							)
+							return event
+						}(),
+					),
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				policy: &domain.PasswordAgePolicy{
+					MaxAgeDays:     10,
+					ExpireWarnDays: 365,
+					HistoryCount:   5,
+					MinAgeDays:     1,
+				},
+			},
+			res: res{
+				want: &domain.PasswordAgePolicy{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID:   "INSTANCE",
+						ResourceOwner: "INSTANCE",
+						InstanceID:    "INSTANCE",
+					},
+					MaxAgeDays:     10,
+					ExpireWarnDays: 365,
+					HistoryCount:   5,
+					MinAgeDays:     1,
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -125,6 +125,15 @@ func oidcAppEvents(ctx context.Context, orgID, projectID, id, name, clientID str
 			"",
 			domain.LoginVersionUnspecified,
 			"",
+			false,
+			false,
+			"",
+			false,
+			"",
+			nil,
+			false,
+			domain.OIDCRefreshTokenRotationDefault,
+			false,
 		),
 	}
 }
@@ -202,7 +211,7 @@ func instancePoliciesEvents(ctx context.Context, instanceID string) []eventstore
 	instanceAgg := instance.NewAggregate(instanceID)
 	return []eventstore.Command{
 		instance.NewPasswordComplexityPolicyAddedEvent(ctx, &instanceAgg.Aggregate, 8, true, true, true, true),
-		instance.NewPasswordAgePolicyAddedEvent(ctx, &instanceAgg.Aggregate, 0, 0),
+		instance.NewPasswordAgePolicyAddedEvent(ctx, &instanceAgg.Aggregate, 0, 0, 0, 0),
 		instance.NewDomainPolicyAddedEvent(ctx, &instanceAgg.Aggregate, false, false, false),
 		instance.NewLoginPolicyAddedEvent(ctx, &instanceAgg.Aggregate, true, true, true, false, false, false, false, true, false, false, domain.PasswordlessTypeAllowed, "", 240*time.Hour, 240*time.Hour, 720*time.Hour, 18*time.Hour, 12*time.Hour),
 		instance.NewLoginPolicySecondFactorAddedEvent(ctx, &instanceAgg.Aggregate, domain.SecondFactorTypeTOTP),
@@ -228,7 +237,9 @@ func instanceSetupPoliciesConfig() *InstanceSetup {
 		PasswordAgePolicy: struct {
 			ExpireWarnDays uint64
 			MaxAgeDays     uint64
-		}{0, 0},
+			HistoryCount   uint64
+			MinAgeDays     uint64
+		}{0, 0, 0, 0},
 		DomainPolicy: struct {
 			UserLoginMustBeDomain                  bool
 			ValidateOrgDomains                     bool
@@ -441,6 +452,15 @@ func generatedDomainFilters(instanceID, orgID, projectID, appID, generatedDomain
 				"",
 				domain.LoginVersionUnspecified,
 				"",
+				false,
+				false,
+				"",
+				false,
+				"",
+				nil,
+				false,
+				domain.OIDCRefreshTokenRotationDefault,
+				false,
 			),
 		),
 		expectFilter(
@@ -0,0 +1,524 @@
+package command
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"io"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	// InstanceTransferVersion is the version of the format written by [Commands.ExportInstance].
+	InstanceTransferVersion = "v2"
+
+	instanceTransferBatchSize   = 200
+	instanceTransferKeyLength   = 32
+	instanceTransferKeyIDPrefix = "transfer:"
+	instanceTransferKeyCheck    = "zitadel-instance-transfer"
+)
+
+var (
+	//go:embed instance_transfer_unique_constraints.sql
+	instanceTransferUniqueConstraintsQuery string
+)
+
+// instanceTransferRecord is a single line of an instance transfer.
+// A transfer starts with the header, followed by the unique constraints and the events of the instance.
+type instanceTransferRecord struct {
+	Header           *instanceTransferHeader           `json:"header,omitempty"`
+	UniqueConstraint *instanceTransferUniqueConstraint `json:"uniqueConstraint,omitempty"`
+	Event            *instanceTransferEvent            `json:"event,omitempty"`
+}
+
+type instanceTransferHeader struct {
+	Version    string    `json:"version"`
+	InstanceID string    `json:"instanceId"`
+	ExportedAt time.Time `json:"exportedAt"`
+	// KeyCheck is a known value encrypted with the transfer key,
+	// to detect a wrong key before anything is imported.
+	KeyCheck []byte `json:"keyCheck"`
+}
+
+type instanceTransferUniqueConstraint struct {
+	Type   string `json:"type"`
+	Field  string `json:"field"`
+	Global bool   `json:"global,omitempty"`
+}
+
+type instanceTransferEvent struct {
+	AggregateType eventstore.AggregateType `json:"aggregateType"`
+	AggregateID   string                   `json:"aggregateId"`
+	ResourceOwner string                   `json:"resourceOwner"`
+	Type          eventstore.EventType     `json:"type"`
+	Revision      uint16                   `json:"revision"`
+	Creator       string                   `json:"creator"`
+	CreatedAt     time.Time                `json:"createdAt"`
+	Payload       json.RawMessage          `json:"payload,omitempty"`
+}
+
+// ExportInstance writes all events of the instance of the context as versioned JSON lines.
+// Encrypted values in the payloads are decrypted and encrypted with the transfer key,
+// hashes (e.g. of passwords) are exported unchanged.
+// The export can be imported using [Commands.ImportInstance].
+func (c *Commands) ExportInstance(ctx context.Context, transferKey string, write func(line []byte) error) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if len(transferKey) != instanceTransferKeyLength {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-ahX0e", "Errors.Instance.Transfer.InvalidKey")
+	}
+	instanceID := authz.GetInstance(ctx).InstanceID()
+	keyCheck, err := crypto.EncryptAES([]byte(instanceTransferKeyCheck), transferKey)
+	if err != nil {
+		return zerrors.ThrowInvalidArgument(err, "COMMAND-Gie0u", "Errors.Instance.Transfer.InvalidKey")
+	}
+	err = writeInstanceTransferRecord(write, &instanceTransferRecord{Header: &instanceTransferHeader{
+		Version:    InstanceTransferVersion,
+		InstanceID: instanceID,
+		ExportedAt: time.Now(),
+		KeyCheck:   keyCheck,
+	}})
+	if err != nil {
+		return err
+	}
+	constraints, err := c.instanceTransferUniqueConstraints(ctx, instanceID)
+	if err != nil {
+		return err
+	}
+	for _, constraint := range constraints {
+		if err = writeInstanceTransferRecord(write, &instanceTransferRecord{UniqueConstraint: constraint}); err != nil {
+			return err
+		}
+	}
+	return c.exportInstanceEvents(ctx, instanceID, c.exportCryptoValue(transferKey), write)
+}
+
+func (c *Commands) instanceTransferUniqueConstraints(ctx context.Context, instanceID string) ([]*instanceTransferUniqueConstraint, error) {
+	domainsWriteModel := NewInstanceDomainsWriteModel(instanceID)
+	if err := c.eventstore.FilterToQueryReducer(ctx, domainsWriteModel); err != nil {
+		return nil, err
+	}
+	domains := make(database.TextArray[string], len(domainsWriteModel.Domains))
+	for i, instanceDomain := range domainsWriteModel.Domains {
+		domains[i] = strings.ToLower(instanceDomain)
+	}
+	constraints := make([]*instanceTransferUniqueConstraint, 0)
+	err := c.eventstore.Client().QueryContext(ctx,
+		func(rows *sql.Rows) error {
+			for rows.Next() {
+				constraint := new(instanceTransferUniqueConstraint)
+				if err := rows.Scan(&constraint.Global, &constraint.Type, &constraint.Field); err != nil {
+					return err
+				}
+				constraints = append(constraints, constraint)
+			}
+			return rows.Err()
+		},
+		instanceTransferUniqueConstraintsQuery,
+		instanceID, instance.UniqueInstanceDomain, domains,
+	)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "COMMAND-Ooz7a", "Errors.Internal")
+	}
+	return constraints, nil
+}
+
+func (c *Commands) exportInstanceEvents(ctx context.Context, instanceID string, transferCryptoValue func(*crypto.CryptoValue) (*crypto.CryptoValue, error), write func(line []byte) error) error {
+	var (
+		position decimal.Decimal
+		offset   uint32
+	)
+	for {
+		builder := eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+			AwaitOpenTransactions().
+			OrderAsc().
+			InstanceID(instanceID).
+			Limit(instanceTransferBatchSize)
+		if position.IsPositive() {
+			builder = builder.PositionAtLeast(position).Offset(offset)
+		}
+		events, err := c.eventstore.Filter(ctx, builder)
+		if err != nil {
+			return err
+		}
+		for _, event := range events {
+			record, err := exportInstanceTransferEvent(event, transferCryptoValue)
+			if err != nil {
+				return err
+			}
+			if err = writeInstanceTransferRecord(write, &instanceTransferRecord{Event: record}); err != nil {
+				return err
+			}
+			if event.Position().Equal(position) {
+				offset++
+				continue
+			}
+			position, offset = event.Position(), 1
+		}
+		if len(events) < instanceTransferBatchSize {
+			return nil
+		}
+	}
+}
+
+func exportInstanceTransferEvent(event eventstore.Event, transferCryptoValue func(*crypto.CryptoValue) (*crypto.CryptoValue, error)) (*instanceTransferEvent, error) {
+	var payload json.RawMessage
+	if err := event.Unmarshal(&payload); err != nil {
+		return nil, zerrors.ThrowInternal(err, "COMMAND-Iech5", "Errors.Internal")
+	}
+	payload, err := transferCryptoValues(payload, transferCryptoValue)
+	if err != nil {
+		return nil, err
+	}
+	return &instanceTransferEvent{
+		AggregateType: event.Aggregate().Type,
+		AggregateID:   event.Aggregate().ID,
+		ResourceOwner: event.Aggregate().ResourceOwner,
+		Type:          event.Type(),
+		Revision:      event.Revision(),
+		Creator:       event.Creator(),
+		CreatedAt:     event.CreatedAt(),
+		Payload:       payload,
+	}, nil
+}
+
+func writeInstanceTransferRecord(write func(line []byte) error, record *instanceTransferRecord) error {
+	line, err := json.Marshal(record)
+	if err != nil {
+		return zerrors.ThrowInternal(err, "COMMAND-Ahr2i", "Errors.Internal")
+	}
+	return write(line)
+}
+
+// ImportInstance creates the instance exported by [Commands.ExportInstance] with the same ids.
+// next must return the lines of the export and [io.EOF] once all lines are read.
+// The encrypted values are decrypted using the transfer key and encrypted with the keys of this system.
+// The events are pushed in batches, so if the import fails, the partially imported instance must be removed.
+func (c *Commands) ImportInstance(ctx context.Context, transferKey string, next func() ([]byte, error)) (_ string, _ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	header, err := readInstanceTransferHeader(next, transferKey)
+	if err != nil {
+		return "", nil, err
+	}
+	ctx = authz.WithInstanceID(ctx, header.InstanceID)
+	writeModel := NewInstanceWriteModel(header.InstanceID)
+	if err = c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return "", nil, err
+	}
+	if writeModel.State != domain.InstanceStateUnspecified {
+		return "", nil, zerrors.ThrowAlreadyExists(nil, "COMMAND-Eiqu9", "Errors.Instance.AlreadyExists")
+	}
+
+	transferCryptoValue := c.importCryptoValue(transferKey)
+	var (
+		constraints []*eventstore.UniqueConstraint
+		cmds        = make([]eventstore.Command, 0, instanceTransferBatchSize)
+		pushed      []eventstore.Event
+	)
+	for {
+		line, err := next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return "", nil, err
+		}
+		record := new(instanceTransferRecord)
+		if err = json.Unmarshal(line, record); err != nil {
+			return "", nil, zerrors.ThrowInvalidArgument(err, "COMMAND-ooW4e", "Errors.Instance.Transfer.InvalidRecord")
+		}
+		switch {
+		case record.UniqueConstraint != nil && len(pushed) == 0 && len(cmds) == 0:
+			constraints = append(constraints, instanceTransferConstraint(record.UniqueConstraint))
+		case record.Event != nil:
+			cmd, err := importInstanceTransferEvent(header.InstanceID, record.Event, transferCryptoValue)
+			if err != nil {
+				return "", nil, err
+			}
+			// the unique constraints are added together with the first events,
+			// so the import fails before anything is written if they already exist
+			if len(pushed) == 0 && len(cmds) == 0 {
+				cmd.constraints = constraints
+			}
+			cmds = append(cmds, cmd)
+		default:
+			return "", nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Xoh4a", "Errors.Instance.Transfer.InvalidRecord")
+		}
+		if len(cmds) < instanceTransferBatchSize {
+			continue
+		}
+		if pushed, err = c.eventstore.Push(ctx, cmds...); err != nil {
+			return "", nil, err
+		}
+		cmds = cmds[:0]
+	}
+	if len(cmds) > 0 {
+		if pushed, err = c.eventstore.Push(ctx, cmds...); err != nil {
+			return "", nil, err
+		}
+	}
+	if len(pushed) == 0 {
+		return "", nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Aeb7o", "Errors.Instance.Transfer.NoEvents")
+	}
+	return header.InstanceID, pushedEventsToObjectDetails(pushed), nil
+}
+
+func readInstanceTransferHeader(next func() ([]byte, error), transferKey string) (*instanceTransferHeader, error) {
+	if len(transferKey) != instanceTransferKeyLength {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-ieF4o", "Errors.Instance.Transfer.InvalidKey")
+	}
+	line, err := next()
+	if errors.Is(err, io.EOF) {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Uo1ah", "Errors.Instance.Transfer.InvalidRecord")
+	}
+	if err != nil {
+		return nil, err
+	}
+	record := new(instanceTransferRecord)
+	if err = json.Unmarshal(line, record); err != nil || record.Header == nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "COMMAND-eeP7u", "Errors.Instance.Transfer.InvalidRecord")
+	}
+	if record.Header.Version != InstanceTransferVersion {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ra8ai", "Errors.Instance.Transfer.InvalidVersion")
+	}
+	if record.Header.InstanceID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-oV0ie", "Errors.Instance.Transfer.InvalidRecord")
+	}
+	keyCheck, err := crypto.DecryptAES(record.Header.KeyCheck, transferKey)
+	if err != nil || string(keyCheck) != instanceTransferKeyCheck {
+		return nil, zerrors.ThrowInvalidArgument(err, "COMMAND-Iej9o", "Errors.Instance.Transfer.InvalidKey")
+	}
+	return record.Header, nil
+}
+
+func instanceTransferConstraint(constraint *instanceTransferUniqueConstraint) *eventstore.UniqueConstraint {
+	if constraint.Global {
+		return eventstore.NewAddGlobalUniqueConstraint(constraint.Type, constraint.Field, "Errors.Instance.Transfer.AlreadyExists")
+	}
+	return eventstore.NewAddEventUniqueConstraint(constraint.Type, constraint.Field, "Errors.Instance.Transfer.AlreadyExists")
+}
+
+func importInstanceTransferEvent(instanceID string, event *instanceTransferEvent, transferCryptoValue func(*crypto.CryptoValue) (*crypto.CryptoValue, error)) (*instanceTransferCommand, error) {
+	if event.AggregateType == "" || event.AggregateID == "" || event.Type == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ahm3e", "Errors.Instance.Transfer.InvalidRecord")
+	}
+	payload, err := transferCryptoValues(event.Payload, transferCryptoValue)
+	if err != nil {
+		return nil, err
+	}
+	event.Payload = payload
+	return &instanceTransferCommand{
+		aggregate: &eventstore.Aggregate{
+			ID:            event.AggregateID,
+			Type:          event.AggregateType,
+			ResourceOwner: event.ResourceOwner,
+			InstanceID:    instanceID,
+		},
+		event: event,
+	}, nil
+}
+
+// instanceTransferCommand pushes an imported event with its original payload.
+type instanceTransferCommand struct {
+	aggregate   *eventstore.Aggregate
+	event       *instanceTransferEvent
+	constraints []*eventstore.UniqueConstraint
+}
+
+func (c *instanceTransferCommand) Aggregate() *eventstore.Aggregate {
+	return c.aggregate
+}
+
+func (c *instanceTransferCommand) Creator() string {
+	return c.event.Creator
+}
+
+func (c *instanceTransferCommand) Type() eventstore.EventType {
+	return c.event.Type
+}
+
+func (c *instanceTransferCommand) Revision() uint16 {
+	return c.event.Revision
+}
+
+func (c *instanceTransferCommand) Payload() any {
+	if len(c.event.Payload) == 0 {
+		return nil
+	}
+	return []byte(c.event.Payload)
+}
+
+func (c *instanceTransferCommand) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return c.constraints
+}
+
+// Fields returns nil, as the fields of the imported events are filled by the field handlers.
+func (c *instanceTransferCommand) Fields() []*eventstore.FieldOperation {
+	return nil
+}
+
+type instanceTransferAlgorithm struct {
+	name      string
+	algorithm crypto.EncryptionAlgorithm
+}
+
+// instanceTransferAlgorithms returns the encryption algorithms by a name,
+// which is used to find the corresponding algorithm on import.
+func (c *Commands) instanceTransferAlgorithms() []instanceTransferAlgorithm {
+	algorithms := []instanceTransferAlgorithm{
+		{name: "idp", algorithm: c.idpConfigEncryption},
+		{name: "otp", algorithm: c.multifactors.OTP.CryptoMFA},
+		{name: "smtp", algorithm: c.smtpEncryption},
+		{name: "sms", algorithm: c.smsEncryption},
+		{name: "user", algorithm: c.userEncryption},
+		{name: "domain_verification", algorithm: c.domainVerificationAlg},
+		{name: "oidc", algorithm: c.keyAlgorithm},
+		{name: "saml", algorithm: c.certificateAlgorithm},
+		{name: "target", algorithm: c.targetEncryption},
+	}
+	return slices.DeleteFunc(algorithms, func(alg instanceTransferAlgorithm) bool {
+		return alg.algorithm == nil
+	})
+}
+
+func (c *Commands) exportCryptoValue(transferKey string) func(*crypto.CryptoValue) (*crypto.CryptoValue, error) {
+	algorithms := c.instanceTransferAlgorithms()
+	return func(value *crypto.CryptoValue) (*crypto.CryptoValue, error) {
+		for _, alg := range algorithms {
+			if alg.algorithm.Algorithm() != value.Algorithm || !slices.Contains(alg.algorithm.DecryptionKeyIDs(), value.KeyID) {
+				continue
+			}
+			decrypted, err := crypto.Decrypt(value, alg.algorithm)
+			if err != nil {
+				return nil, err
+			}
+			encrypted, err := crypto.EncryptAES(decrypted, transferKey)
+			if err != nil {
+				return nil, zerrors.ThrowInternal(err, "COMMAND-ohY1u", "Errors.Internal")
+			}
+			return &crypto.CryptoValue{
+				CryptoType: crypto.TypeEncryption,
+				Algorithm:  "aes",
+				KeyID:      instanceTransferKeyIDPrefix + alg.name,
+				Crypted:    encrypted,
+			}, nil
+		}
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Zai8u", "Errors.Instance.Transfer.UnknownKey")
+	}
+}
+
+func (c *Commands) importCryptoValue(transferKey string) func(*crypto.CryptoValue) (*crypto.CryptoValue, error) {
+	algorithms := c.instanceTransferAlgorithms()
+	return func(value *crypto.CryptoValue) (*crypto.CryptoValue, error) {
+		name, ok := strings.CutPrefix(value.KeyID, instanceTransferKeyIDPrefix)
+		if !ok {
+			return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Phai4", "Errors.Instance.Transfer.UnknownKey")
+		}
+		i := slices.IndexFunc(algorithms, func(alg instanceTransferAlgorithm) bool {
+			return alg.name == name
+		})
+		if i < 0 {
+			return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-ieX7o", "Errors.Instance.Transfer.UnknownKey")
+		}
+		decrypted, err := crypto.DecryptAES(value.Crypted, transferKey)
+		if err != nil {
+			return nil, zerrors.ThrowInvalidArgument(err, "COMMAND-Vah3e", "Errors.Instance.Transfer.InvalidKey")
+		}
+		return crypto.Encrypt(decrypted, algorithms[i].algorithm)
+	}
+}
+
+// transferCryptoValues replaces all encrypted [crypto.CryptoValue] in the payload
+// by the value returned by transferCryptoValue.
+// The payload is returned unchanged if it does not contain any encrypted value.
+func transferCryptoValues(payload json.RawMessage, transferCryptoValue func(*crypto.CryptoValue) (*crypto.CryptoValue, error)) (json.RawMessage, error) {
+	if len(payload) == 0 || !bytes.Contains(payload, []byte(`"Crypted"`)) {
+		return payload, nil
+	}
+	decoder := json.NewDecoder(bytes.NewReader(payload))
+	decoder.UseNumber()
+	var data any
+	if err := decoder.Decode(&data); err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "COMMAND-ua5Ie", "Errors.Instance.Transfer.InvalidRecord")
+	}
+	data, changed, err := transferCryptoValuesOf(data, transferCryptoValue)
+	if err != nil || !changed {
+		return payload, err
+	}
+	payload, err = json.Marshal(data)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "COMMAND-oN2ah", "Errors.Internal")
+	}
+	return payload, nil
+}
+
+func transferCryptoValuesOf(data any, transferCryptoValue func(*crypto.CryptoValue) (*crypto.CryptoValue, error)) (_ any, changed bool, err error) {
+	switch value := data.(type) {
+	case map[string]any:
+		if cryptoValue := asEncryptedCryptoValue(value); cryptoValue != nil {
+			transferred, err := transferCryptoValue(cryptoValue)
+			return transferred, err == nil, err
+		}
+		for key, field := range value {
+			transferred, fieldChanged, err := transferCryptoValuesOf(field, transferCryptoValue)
+			if err != nil {
+				return nil, false, err
+			}
+			value[key] = transferred
+			changed = changed || fieldChanged
+		}
+		return value, changed, nil
+	case []any:
+		for i, item := range value {
+			transferred, itemChanged, err := transferCryptoValuesOf(item, transferCryptoValue)
+			if err != nil {
+				return nil, false, err
+			}
+			value[i] = transferred
+			changed = changed || itemChanged
+		}
+		return value, changed, nil
+	default:
+		return data, false, nil
+	}
+}
+
+// asEncryptedCryptoValue returns the [crypto.CryptoValue] if the object is an encrypted value.
+func asEncryptedCryptoValue(object map[string]any) *crypto.CryptoValue {
+	if len(object) != 4 {
+		return nil
+	}
+	for _, key := range []string{"CryptoType", "Algorithm", "KeyID", "Crypted"} {
+		if _, ok := object[key]; !ok {
+			return nil
+		}
+	}
+	data, err := json.Marshal(object)
+	if err != nil {
+		return nil
+	}
+	value := new(crypto.CryptoValue)
+	if err = json.Unmarshal(data, value); err != nil || value.CryptoType != crypto.TypeEncryption {
+		return nil
+	}
+	return value
+}
@@ -0,0 +1,246 @@
+package command
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const testTransferKey = "01234567890123456789012345678901"
+
+func instanceTransferLines(t *testing.T, records ...*instanceTransferRecord) func() ([]byte, error) {
+	lines := make([][]byte, len(records))
+	for i, record := range records {
+		line, err := json.Marshal(record)
+		require.NoError(t, err)
+		lines[i] = line
+	}
+	return func() ([]byte, error) {
+		if len(lines) == 0 {
+			return nil, io.EOF
+		}
+		line := lines[0]
+		lines = lines[1:]
+		return line, nil
+	}
+}
+
+func instanceTransferTestHeader(t *testing.T, version, key string) *instanceTransferRecord {
+	keyCheck, err := crypto.EncryptAES([]byte(instanceTransferKeyCheck), key)
+	require.NoError(t, err)
+	return &instanceTransferRecord{Header: &instanceTransferHeader{
+		Version:    version,
+		InstanceID: "instance1",
+		KeyCheck:   keyCheck,
+	}}
+}
+
+func Test_transferCryptoValues(t *testing.T) {
+	c := &Commands{
+		userEncryption: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+	}
+	tests := []struct {
+		name          string
+		payload       string
+		wantUnchanged bool
+		wantErr       error
+	}{
+		{
+			name:          "no payload",
+			payload:       "",
+			wantUnchanged: true,
+		},
+		{
+			name:          "no crypto value",
+			payload:       `{"userName":"username","age":1.50}`,
+			wantUnchanged: true,
+		},
+		{
+			name:          "hash",
+			payload:       `{"code":{"CryptoType":1,"Algorithm":"hash","KeyID":"","Crypted":"aGFzaA=="}}`,
+			wantUnchanged: true,
+		},
+		{
+			name:    "encrypted value",
+			payload: `{"code":{"CryptoType":0,"Algorithm":"enc","KeyID":"id","Crypted":"c2VjcmV0"},"expiry":300}`,
+		},
+		{
+			name:    "nested encrypted value",
+			payload: `{"keys":[{"key":{"CryptoType":0,"Algorithm":"enc","KeyID":"id","Crypted":"c2VjcmV0"}}]}`,
+		},
+		{
+			name:    "unknown key",
+			payload: `{"code":{"CryptoType":0,"Algorithm":"enc","KeyID":"unknown","Crypted":"c2VjcmV0"}}`,
+			wantErr: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Zai8u", "Errors.Instance.Transfer.UnknownKey"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exported, err := transferCryptoValues(json.RawMessage(tt.payload), c.exportCryptoValue(testTransferKey))
+			require.ErrorIs(t, err, tt.wantErr)
+			if tt.wantErr != nil {
+				return
+			}
+			if tt.wantUnchanged {
+				assert.Equal(t, tt.payload, string(exported))
+			} else {
+				assert.NotContains(t, string(exported), `"c2VjcmV0"`)
+				assert.Contains(t, string(exported), `"KeyID":"transfer:user"`)
+			}
+
+			imported, err := transferCryptoValues(exported, c.importCryptoValue(testTransferKey))
+			require.NoError(t, err)
+			if tt.payload == "" {
+				assert.Empty(t, imported)
+				return
+			}
+			assert.JSONEq(t, tt.payload, string(imported))
+		})
+	}
+}
+
+func TestCommands_ImportInstance(t *testing.T) {
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		transferKey string
+		records     []*instanceTransferRecord
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr error
+	}{
+		{
+			name: "invalid key length",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				transferKey: "key",
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "COMMAND-ieF4o", "Errors.Instance.Transfer.InvalidKey"),
+		},
+		{
+			name: "missing header",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				transferKey: testTransferKey,
+				records: []*instanceTransferRecord{
+					{UniqueConstraint: &instanceTransferUniqueConstraint{Type: "type", Field: "field"}},
+				},
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "COMMAND-eeP7u", "Errors.Instance.Transfer.InvalidRecord"),
+		},
+		{
+			name: "unsupported version",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				transferKey: testTransferKey,
+				records: []*instanceTransferRecord{
+					instanceTransferTestHeader(t, "v1", testTransferKey),
+				},
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "COMMAND-Ra8ai", "Errors.Instance.Transfer.InvalidVersion"),
+		},
+		{
+			name: "wrong key",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				transferKey: testTransferKey,
+				records: []*instanceTransferRecord{
+					instanceTransferTestHeader(t, InstanceTransferVersion, "10987654321098765432109876543210"),
+				},
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "COMMAND-Iej9o", "Errors.Instance.Transfer.InvalidKey"),
+		},
+		{
+			name: "instance already exists",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewInstanceAddedEvent(context.Background(), &instance.NewAggregate("instance1").Aggregate, "instance"),
+						),
+					),
+				),
+			},
+			args: args{
+				transferKey: testTransferKey,
+				records: []*instanceTransferRecord{
+					instanceTransferTestHeader(t, InstanceTransferVersion, testTransferKey),
+				},
+			},
+			wantErr: zerrors.ThrowAlreadyExists(nil, "COMMAND-Eiqu9", "Errors.Instance.AlreadyExists"),
+		},
+		{
+			name: "unique constraint after events",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				transferKey: testTransferKey,
+				records: []*instanceTransferRecord{
+					instanceTransferTestHeader(t, InstanceTransferVersion, testTransferKey),
+					{Event: &instanceTransferEvent{AggregateType: instance.AggregateType, AggregateID: "instance1", ResourceOwner: "instance1", Type: instance.InstanceAddedEventType, Revision: 1}},
+					{UniqueConstraint: &instanceTransferUniqueConstraint{Type: "type", Field: "field"}},
+				},
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "COMMAND-Xoh4a", "Errors.Instance.Transfer.InvalidRecord"),
+		},
+		{
+			name: "no events",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				transferKey: testTransferKey,
+				records: []*instanceTransferRecord{
+					instanceTransferTestHeader(t, InstanceTransferVersion, testTransferKey),
+					{UniqueConstraint: &instanceTransferUniqueConstraint{Type: "type", Field: "field"}},
+				},
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "COMMAND-Aeb7o", "Errors.Instance.Transfer.NoEvents"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			_, _, err := c.ImportInstance(context.Background(), tt.args.transferKey, instanceTransferLines(t, tt.args.records...))
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func Test_instanceTransferConstraint(t *testing.T) {
+	constraint := instanceTransferConstraint(&instanceTransferUniqueConstraint{Type: instance.UniqueInstanceDomain, Field: "domain.ch", Global: true})
+	assert.True(t, constraint.IsGlobal)
+	assert.Equal(t, instance.UniqueInstanceDomain, constraint.UniqueType)
+
+	constraint = instanceTransferConstraint(&instanceTransferUniqueConstraint{Type: "usernames", Field: "username"})
+	assert.False(t, constraint.IsGlobal)
+}
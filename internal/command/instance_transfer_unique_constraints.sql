@@ -0,0 +1,17 @@
+SELECT
+    instance_id = '' AS is_global
+    , unique_type
+    , unique_field
+FROM
+    eventstore.unique_constraints
+WHERE
+    instance_id = $1
+    OR (
+        instance_id = ''
+        AND unique_type = $2
+        AND unique_field = ANY($3)
+    )
+ORDER BY
+    is_global
+    , unique_type
+    , unique_field
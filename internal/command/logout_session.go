@@ -3,8 +3,13 @@ package command
 import (
 	"context"
 
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/samlsession"
+	"github.com/zitadel/zitadel/internal/repository/session"
 	"github.com/zitadel/zitadel/internal/repository/sessionlogout"
 	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
 func (c *Commands) BackChannelLogoutSent(ctx context.Context, id, oidcSessionID, instanceID string) (err error) {
@@ -22,3 +27,71 @@ func (c *Commands) BackChannelLogoutSent(ctx context.Context, id, oidcSessionID,
 		sessionlogout.NewBackChannelLogoutSentEvent(ctx, sessionWriteModel.aggregate, oidcSessionID),
 	)
 }
+
+func (c *Commands) SAMLLogoutSent(ctx context.Context, id, samlSessionID, instanceID string) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	sessionWriteModel := NewSAMLSessionLogoutWriteModel(id, instanceID, samlSessionID)
+	if err = c.eventstore.FilterToQueryReducer(ctx, sessionWriteModel); err != nil {
+		return err
+	}
+	if sessionWriteModel.SAMLLogoutSent {
+		return nil
+	}
+
+	return c.pushAppendAndReduce(
+		ctx,
+		sessionWriteModel,
+		sessionlogout.NewSAMLLogoutSentEvent(ctx, sessionWriteModel.aggregate, samlSessionID),
+		samlsession.NewTerminateEvent(ctx, &samlsession.NewAggregate(samlSessionID, sessionWriteModel.UserResourceOwner).Aggregate),
+	)
+}
+
+// SAMLLogout handles a LogoutRequest of a SAML service provider (SP-initiated single logout).
+// It terminates the SAML sessions of the subject at the service provider as well as the underlying sessions.
+// The service provider requesting the logout is marked as logged out, so it will not receive a LogoutRequest itself,
+// where all other participants of the terminated sessions will be notified.
+func (c *Commands) SAMLLogout(ctx context.Context, entityID, nameID string, sessionIndexes []string) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if entityID == "" || nameID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Rt5Kd", "Errors.SAMLSession.InvalidLogoutRequest")
+	}
+	instanceID := authz.GetInstance(ctx).InstanceID()
+	subjectWriteModel := NewSAMLLogoutSubjectWriteModel(instanceID, entityID, nameID, sessionIndexes)
+	if err = c.eventstore.FilterToQueryReducer(ctx, subjectWriteModel); err != nil {
+		return err
+	}
+
+	cmds := make([]eventstore.Command, 0, len(subjectWriteModel.Registrations)*3)
+	activeSessions := make(map[string]*eventstore.Aggregate)
+	for _, registration := range subjectWriteModel.Registrations {
+		sessionAggregate, checked := activeSessions[registration.SessionID]
+		if !checked {
+			sessionWriteModel := NewSessionWriteModel(registration.SessionID, instanceID)
+			if err = c.eventstore.FilterToQueryReducer(ctx, sessionWriteModel); err != nil {
+				return err
+			}
+			if sessionWriteModel.CheckIsActive() == nil {
+				sessionAggregate = &session.NewAggregate(sessionWriteModel.AggregateID, sessionWriteModel.ResourceOwner).Aggregate
+				cmds = append(cmds, session.NewTerminateEvent(ctx, sessionAggregate))
+			}
+			activeSessions[registration.SessionID] = sessionAggregate
+		}
+		// the session was already terminated, so the logout was already handled
+		if sessionAggregate == nil {
+			continue
+		}
+		cmds = append(cmds,
+			sessionlogout.NewSAMLLogoutSentEvent(ctx, &sessionlogout.NewAggregate(registration.SessionID, instanceID).Aggregate, registration.SAMLSessionID),
+			samlsession.NewTerminateEvent(ctx, &samlsession.NewAggregate(registration.SAMLSessionID, registration.UserResourceOwner).Aggregate),
+		)
+	}
+	if len(cmds) == 0 {
+		return nil
+	}
+	_, err = c.eventstore.Push(ctx, cmds...)
+	return err
+}
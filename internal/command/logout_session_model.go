@@ -1,6 +1,8 @@
 package command
 
 import (
+	"slices"
+
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/repository/sessionlogout"
 )
@@ -72,3 +74,138 @@ func (wm *SessionLogoutWriteModel) reduceSent(e *sessionlogout.BackChannelLogout
 	}
 	wm.BackChannelLogoutSent = true
 }
+
+type SAMLSessionLogoutWriteModel struct {
+	eventstore.WriteModel
+
+	UserID            string
+	UserResourceOwner string
+	SAMLSessionID     string
+	EntityID          string
+	SAMLLogoutSent    bool
+
+	aggregate *eventstore.Aggregate
+}
+
+func NewSAMLSessionLogoutWriteModel(id string, instanceID string, samlSessionID string) *SAMLSessionLogoutWriteModel {
+	return &SAMLSessionLogoutWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   id,
+			ResourceOwner: instanceID,
+			InstanceID:    instanceID,
+		},
+		aggregate:     &sessionlogout.NewAggregate(id, instanceID).Aggregate,
+		SAMLSessionID: samlSessionID,
+	}
+}
+
+func (wm *SAMLSessionLogoutWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *sessionlogout.SAMLLogoutRegisteredEvent:
+			wm.reduceRegistered(e)
+		case *sessionlogout.SAMLLogoutSentEvent:
+			wm.reduceSent(e)
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *SAMLSessionLogoutWriteModel) Query() *eventstore.SearchQueryBuilder {
+	query := eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(sessionlogout.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			sessionlogout.SAMLLogoutRegisteredType,
+			sessionlogout.SAMLLogoutSentType,
+		).
+		EventData(map[string]interface{}{
+			"saml_session_id": wm.SAMLSessionID,
+		}).
+		Builder()
+	return query
+}
+
+func (wm *SAMLSessionLogoutWriteModel) reduceRegistered(e *sessionlogout.SAMLLogoutRegisteredEvent) {
+	if wm.SAMLSessionID != e.SAMLSessionID {
+		return
+	}
+	wm.UserID = e.UserID
+	wm.UserResourceOwner = e.UserResourceOwner
+	wm.EntityID = e.EntityID
+}
+
+func (wm *SAMLSessionLogoutWriteModel) reduceSent(e *sessionlogout.SAMLLogoutSentEvent) {
+	if wm.SAMLSessionID != e.SAMLSessionID {
+		return
+	}
+	wm.SAMLLogoutSent = true
+}
+
+// SAMLLogoutSubjectWriteModel collects the SAML logout registrations
+// of a subject (NameID) at a specific service provider (EntityID).
+// If SessionIndexes are provided, only the registrations matching one of them are collected.
+type SAMLLogoutSubjectWriteModel struct {
+	eventstore.WriteModel
+
+	EntityID       string
+	NameID         string
+	SessionIndexes []string
+
+	Registrations []*SAMLLogoutRegistration
+}
+
+type SAMLLogoutRegistration struct {
+	SessionID         string
+	SAMLSessionID     string
+	UserResourceOwner string
+}
+
+func NewSAMLLogoutSubjectWriteModel(instanceID, entityID, nameID string, sessionIndexes []string) *SAMLLogoutSubjectWriteModel {
+	return &SAMLLogoutSubjectWriteModel{
+		WriteModel: eventstore.WriteModel{
+			ResourceOwner: instanceID,
+			InstanceID:    instanceID,
+		},
+		EntityID:       entityID,
+		NameID:         nameID,
+		SessionIndexes: sessionIndexes,
+	}
+}
+
+func (wm *SAMLLogoutSubjectWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		if e, ok := event.(*sessionlogout.SAMLLogoutRegisteredEvent); ok {
+			wm.reduceRegistered(e)
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *SAMLLogoutSubjectWriteModel) Query() *eventstore.SearchQueryBuilder {
+	query := eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(sessionlogout.AggregateType).
+		EventTypes(sessionlogout.SAMLLogoutRegisteredType).
+		EventData(map[string]interface{}{
+			"entity_id": wm.EntityID,
+			"name_id":   wm.NameID,
+		}).
+		Builder()
+	return query
+}
+
+func (wm *SAMLLogoutSubjectWriteModel) reduceRegistered(e *sessionlogout.SAMLLogoutRegisteredEvent) {
+	if wm.EntityID != e.EntityID || wm.NameID != e.NameID {
+		return
+	}
+	if len(wm.SessionIndexes) > 0 && !slices.Contains(wm.SessionIndexes, e.SessionIndex) {
+		return
+	}
+	wm.Registrations = append(wm.Registrations, &SAMLLogoutRegistration{
+		SessionID:         e.Aggregate().ID,
+		SAMLSessionID:     e.SAMLSessionID,
+		UserResourceOwner: e.UserResourceOwner,
+	})
+}
@@ -0,0 +1,274 @@
+package command
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/samlsession"
+	"github.com/zitadel/zitadel/internal/repository/session"
+	"github.com/zitadel/zitadel/internal/repository/sessionlogout"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommands_SAMLLogout(t *testing.T) {
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		ctx            context.Context
+		entityID       string
+		nameID         string
+		sessionIndexes []string
+	}
+	type res struct {
+		err error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"missing name id",
+			fields{
+				eventstore: expectEventstore(),
+			},
+			args{
+				ctx:      authz.WithInstanceID(context.Background(), "instanceID"),
+				entityID: "entityID",
+			},
+			res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-Rt5Kd", "Errors.SAMLSession.InvalidLogoutRequest"),
+			},
+		},
+		{
+			"filter error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilterError(io.ErrClosedPipe),
+				),
+			},
+			args{
+				ctx:      authz.WithInstanceID(context.Background(), "instanceID"),
+				entityID: "entityID",
+				nameID:   "username",
+			},
+			res{
+				err: io.ErrClosedPipe,
+			},
+		},
+		{
+			"no registration",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args{
+				ctx:      authz.WithInstanceID(context.Background(), "instanceID"),
+				entityID: "entityID",
+				nameID:   "username",
+			},
+			res{},
+		},
+		{
+			"session already terminated",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							sessionlogout.NewSAMLLogoutRegisteredEvent(context.Background(), &sessionlogout.NewAggregate("sessionID", "instanceID").Aggregate,
+								"V2_samlSessionID", "userID", "org1", "entityID", "username", "", "assertionID",
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							session.NewAddedEvent(context.Background(), &session.NewAggregate("sessionID", "instanceID").Aggregate, &domain.UserAgent{}),
+						),
+						eventFromEventPusher(
+							session.NewTerminateEvent(context.Background(), &session.NewAggregate("sessionID", "instanceID").Aggregate),
+						),
+					),
+				),
+			},
+			args{
+				ctx:      authz.WithInstanceID(context.Background(), "instanceID"),
+				entityID: "entityID",
+				nameID:   "username",
+			},
+			res{},
+		},
+		{
+			"session index not matching",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							sessionlogout.NewSAMLLogoutRegisteredEvent(context.Background(), &sessionlogout.NewAggregate("sessionID", "instanceID").Aggregate,
+								"V2_samlSessionID", "userID", "org1", "entityID", "username", "", "assertionID",
+							),
+						),
+					),
+				),
+			},
+			args{
+				ctx:            authz.WithInstanceID(context.Background(), "instanceID"),
+				entityID:       "entityID",
+				nameID:         "username",
+				sessionIndexes: []string{"otherAssertionID"},
+			},
+			res{},
+		},
+		{
+			"logout successful",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							sessionlogout.NewSAMLLogoutRegisteredEvent(context.Background(), &sessionlogout.NewAggregate("sessionID", "instanceID").Aggregate,
+								"V2_samlSessionID", "userID", "org1", "entityID", "username", "", "assertionID",
+							),
+						),
+						eventFromEventPusher(
+							sessionlogout.NewSAMLLogoutRegisteredEvent(context.Background(), &sessionlogout.NewAggregate("sessionID", "instanceID").Aggregate,
+								"V2_samlSessionID2", "userID", "org1", "entityID", "username", "", "assertionID2",
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							session.NewAddedEvent(context.Background(), &session.NewAggregate("sessionID", "instanceID").Aggregate, &domain.UserAgent{}),
+						),
+					),
+					expectPush(
+						session.NewTerminateEvent(context.Background(), &session.NewAggregate("sessionID", "instanceID").Aggregate),
+						sessionlogout.NewSAMLLogoutSentEvent(context.Background(), &sessionlogout.NewAggregate("sessionID", "instanceID").Aggregate, "V2_samlSessionID"),
+						samlsession.NewTerminateEvent(context.Background(), &samlsession.NewAggregate("V2_samlSessionID", "org1").Aggregate),
+						sessionlogout.NewSAMLLogoutSentEvent(context.Background(), &sessionlogout.NewAggregate("sessionID", "instanceID").Aggregate, "V2_samlSessionID2"),
+						samlsession.NewTerminateEvent(context.Background(), &samlsession.NewAggregate("V2_samlSessionID2", "org1").Aggregate),
+					),
+				),
+			},
+			args{
+				ctx:      authz.WithInstanceID(context.Background(), "instanceID"),
+				entityID: "entityID",
+				nameID:   "username",
+			},
+			res{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			err := c.SAMLLogout(tt.args.ctx, tt.args.entityID, tt.args.nameID, tt.args.sessionIndexes)
+			require.ErrorIs(t, err, tt.res.err)
+		})
+	}
+}
+
+func TestCommands_SAMLLogoutSent(t *testing.T) {
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		ctx           context.Context
+		id            string
+		samlSessionID string
+		instanceID    string
+	}
+	type res struct {
+		err error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"filter error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilterError(io.ErrClosedPipe),
+				),
+			},
+			args{
+				ctx:           context.Background(),
+				id:            "sessionID",
+				samlSessionID: "V2_samlSessionID",
+				instanceID:    "instanceID",
+			},
+			res{
+				err: io.ErrClosedPipe,
+			},
+		},
+		{
+			"already sent",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							sessionlogout.NewSAMLLogoutRegisteredEvent(context.Background(), &sessionlogout.NewAggregate("sessionID", "instanceID").Aggregate,
+								"V2_samlSessionID", "userID", "org1", "entityID", "username", "", "assertionID",
+							),
+						),
+						eventFromEventPusher(
+							sessionlogout.NewSAMLLogoutSentEvent(context.Background(), &sessionlogout.NewAggregate("sessionID", "instanceID").Aggregate, "V2_samlSessionID"),
+						),
+					),
+				),
+			},
+			args{
+				ctx:           context.Background(),
+				id:            "sessionID",
+				samlSessionID: "V2_samlSessionID",
+				instanceID:    "instanceID",
+			},
+			res{},
+		},
+		{
+			"sent",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							sessionlogout.NewSAMLLogoutRegisteredEvent(context.Background(), &sessionlogout.NewAggregate("sessionID", "instanceID").Aggregate,
+								"V2_samlSessionID", "userID", "org1", "entityID", "username", "", "assertionID",
+							),
+						),
+					),
+					expectPush(
+						sessionlogout.NewSAMLLogoutSentEvent(context.Background(), &sessionlogout.NewAggregate("sessionID", "instanceID").Aggregate, "V2_samlSessionID"),
+						samlsession.NewTerminateEvent(context.Background(), &samlsession.NewAggregate("V2_samlSessionID", "org1").Aggregate),
+					),
+				),
+			},
+			args{
+				ctx:           context.Background(),
+				id:            "sessionID",
+				samlSessionID: "V2_samlSessionID",
+				instanceID:    "instanceID",
+			},
+			res{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			err := c.SAMLLogoutSent(tt.args.ctx, tt.args.id, tt.args.samlSessionID, tt.args.instanceID)
+			require.ErrorIs(t, err, tt.res.err)
+		})
+	}
+}
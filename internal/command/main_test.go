@@ -250,6 +250,24 @@ func newMockProjectPermissionCheckSAMLNotAllowed() domain.ProjectPermissionCheck
 	}
 }
 
+func newMockConsentCheckMissing() OIDCConsentCheck {
+	return func(ctx context.Context, clientID, userID string, scope []string) (*OIDCConsent, error) {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "OIDC-Ohx3i", "Errors.OIDCConsent.Required")
+	}
+}
+
+func newMockConsentCheckGrant(appID, projectID string) OIDCConsentCheck {
+	return func(ctx context.Context, clientID, userID string, scope []string) (*OIDCConsent, error) {
+		return &OIDCConsent{
+			UserID:    userID,
+			ClientID:  clientID,
+			AppID:     appID,
+			ProjectID: projectID,
+			Scope:     scope,
+		}, nil
+	}
+}
+
 func newMockTokenVerifierValid() func(ctx context.Context, sessionToken, sessionID, tokenID string) (err error) {
 	return func(ctx context.Context, sessionToken, sessionID, tokenID string) (err error) {
 		return nil
@@ -0,0 +1,186 @@
+package command
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/oidcconsent"
+	"github.com/zitadel/zitadel/internal/repository/oidcsession"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// OIDCConsentCheck is used to ensure the user consented to the requested scopes of the client,
+// when linking a session to an auth request, see [Commands.LinkSessionToAuthRequest].
+// If the user grants the consent with the link, the consent to record is returned,
+// it is pushed together with the link.
+type OIDCConsentCheck func(ctx context.Context, clientID, userID string, scope []string) (grant *OIDCConsent, err error)
+
+// OIDCSessionIDsByUserAndClient returns the ids of the OIDC sessions the user created for the client.
+type OIDCSessionIDsByUserAndClient func(ctx context.Context, userID, clientID string) ([]string, error)
+
+type OIDCConsent struct {
+	UserID    string
+	ClientID  string
+	AppID     string
+	ProjectID string
+	Scope     []string
+}
+
+func (c *OIDCConsent) IsValid() error {
+	if c.UserID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-ooT4a", "Errors.User.UserIDMissing")
+	}
+	if c.ClientID == "" || c.AppID == "" || c.ProjectID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Ahl8e", "Errors.IDMissing")
+	}
+	return nil
+}
+
+// GrantOIDCConsent records the consent of the user to the requested scopes of the client.
+// Scopes already granted are kept, so the consent only grows until it's revoked.
+func (c *Commands) GrantOIDCConsent(ctx context.Context, consent *OIDCConsent) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel, cmd, err := c.grantOIDCConsent(ctx, consent)
+	if err != nil {
+		return nil, err
+	}
+	if cmd == nil {
+		return writeModelToObjectDetails(&writeModel.WriteModel), nil
+	}
+	if err = c.pushAppendAndReduce(ctx, writeModel, cmd); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// grantOIDCConsent returns the event granting the missing scopes of the consent,
+// or no event if the user already consented to all scopes.
+func (c *Commands) grantOIDCConsent(ctx context.Context, consent *OIDCConsent) (_ *OIDCConsentWriteModel, _ eventstore.Command, err error) {
+	if err := consent.IsValid(); err != nil {
+		return nil, nil, err
+	}
+	userWriteModel, err := c.userStateWriteModel(ctx, consent.UserID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !userWriteModel.UserState.IsEnabled() {
+		return nil, nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-aiK3h", "Errors.User.NotActive")
+	}
+	writeModel := NewOIDCConsentWriteModel(oidcConsentID(consent.UserID, consent.ClientID), "")
+	if err = c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, nil, err
+	}
+	var granted []string
+	if writeModel.State.Exists() {
+		granted = writeModel.Scope
+	}
+	missing := domain.MissingConsentScopes(granted, consent.Scope)
+	if writeModel.State.Exists() && len(missing) == 0 {
+		return writeModel, nil, nil
+	}
+	if writeModel.State == domain.OIDCConsentStateUnspecified {
+		writeModel.ResourceOwner = userWriteModel.ResourceOwner
+		writeModel.InstanceID = authz.GetInstance(ctx).InstanceID()
+	}
+	scope := make([]string, 0, len(granted)+len(missing))
+	scope = append(scope, granted...)
+	scope = append(scope, missing...)
+	return writeModel, oidcconsent.NewGrantedEvent(
+		ctx,
+		writeModel.Aggregate(),
+		consent.UserID,
+		consent.ClientID,
+		consent.AppID,
+		consent.ProjectID,
+		scope,
+	), nil
+}
+
+// oidcConsentID returns the id of the consent aggregate of the user for the client.
+// The id is derived from the user and client, so there's at most one consent per user and client,
+// even if the consent is granted concurrently.
+func oidcConsentID(userID, clientID string) string {
+	hash := sha256.Sum256([]byte(userID + "\x00" + clientID))
+	return base64.RawURLEncoding.EncodeToString(hash[:])
+}
+
+// RevokeOIDCConsent revokes the consent of the user
+// and all refresh tokens issued to the client for the user.
+// The OIDC sessions of the user for the client are looked up with sessionIDs.
+func (c *Commands) RevokeOIDCConsent(ctx context.Context, userID, consentID string, sessionIDs OIDCSessionIDsByUserAndClient) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if userID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Eim2o", "Errors.User.UserIDMissing")
+	}
+	if consentID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-ue3Ai", "Errors.IDMissing")
+	}
+	writeModel := NewOIDCConsentWriteModel(consentID, "")
+	if err = c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	if !writeModel.State.Exists() || writeModel.UserID != userID {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-ieC0u", "Errors.OIDCConsent.NotFound")
+	}
+	cmds := []eventstore.Command{
+		oidcconsent.NewRevokedEvent(ctx, writeModel.Aggregate(), writeModel.UserID, writeModel.ClientID),
+	}
+	refreshTokenCmds, err := c.revokeRefreshTokensOfClient(ctx, writeModel.UserID, writeModel.ResourceOwner, writeModel.ClientID, sessionIDs)
+	if err != nil {
+		return nil, err
+	}
+	cmds = append(cmds, refreshTokenCmds...)
+	pushedEvents, err := c.eventstore.Push(ctx, cmds...)
+	if err != nil {
+		return nil, err
+	}
+	if err = AppendAndReduce(writeModel, pushedEvents...); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// revokeRefreshTokensOfClient returns the commands to revoke the refresh tokens of the user for the client,
+// both the ones of the (v1) user aggregate and the ones of the OIDC sessions.
+func (c *Commands) revokeRefreshTokensOfClient(ctx context.Context, userID, resourceOwner, clientID string, sessionIDs OIDCSessionIDsByUserAndClient) (_ []eventstore.Command, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	userTokens := newUserRefreshTokensByClientWriteModel(userID, resourceOwner, clientID)
+	if err = c.eventstore.FilterToQueryReducer(ctx, userTokens); err != nil {
+		return nil, err
+	}
+	cmds := make([]eventstore.Command, 0, len(userTokens.TokenIDs))
+	userAgg := UserAggregateFromWriteModel(&userTokens.WriteModel)
+	for _, tokenID := range userTokens.TokenIDs {
+		cmds = append(cmds, user.NewHumanRefreshTokenRemovedEvent(ctx, userAgg, tokenID))
+	}
+
+	sessions, err := sessionIDs(ctx, userID, clientID)
+	if err != nil {
+		return nil, err
+	}
+	if len(sessions) == 0 {
+		return cmds, nil
+	}
+	sessionTokens := newOIDCSessionsRefreshTokenWriteModel(sessions)
+	if err = c.eventstore.FilterToQueryReducer(ctx, sessionTokens); err != nil {
+		return nil, err
+	}
+	for _, sessionID := range sessions {
+		if aggregate, ok := sessionTokens.Active[sessionID]; ok {
+			cmds = append(cmds, oidcsession.NewRefreshTokenRevokedEvent(ctx, aggregate))
+		}
+	}
+	return cmds, nil
+}
@@ -0,0 +1,158 @@
+package command
+
+import (
+	"slices"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/oidcconsent"
+	"github.com/zitadel/zitadel/internal/repository/oidcsession"
+	"github.com/zitadel/zitadel/internal/repository/user"
+)
+
+type OIDCConsentWriteModel struct {
+	eventstore.WriteModel
+
+	UserID    string
+	ClientID  string
+	AppID     string
+	ProjectID string
+	Scope     []string
+	State     domain.OIDCConsentState
+}
+
+func NewOIDCConsentWriteModel(id, resourceOwner string) *OIDCConsentWriteModel {
+	return &OIDCConsentWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   id,
+			ResourceOwner: resourceOwner,
+		},
+	}
+}
+
+func (wm *OIDCConsentWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *oidcconsent.GrantedEvent:
+			wm.UserID = e.UserID
+			wm.ClientID = e.ClientID
+			wm.AppID = e.AppID
+			wm.ProjectID = e.ProjectID
+			wm.Scope = e.Scope
+			wm.State = domain.OIDCConsentStateActive
+		case *oidcconsent.RevokedEvent:
+			wm.Scope = nil
+			wm.State = domain.OIDCConsentStateRevoked
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *OIDCConsentWriteModel) Query() *eventstore.SearchQueryBuilder {
+	builder := eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(oidcconsent.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			oidcconsent.GrantedType,
+			oidcconsent.RevokedType,
+		).
+		Builder()
+	if wm.ResourceOwner != "" {
+		builder.ResourceOwner(wm.ResourceOwner)
+	}
+	return builder
+}
+
+func (wm *OIDCConsentWriteModel) Aggregate() *eventstore.Aggregate {
+	return oidcconsent.NewAggregate(wm.AggregateID, wm.ResourceOwner, wm.InstanceID)
+}
+
+// userRefreshTokensByClientWriteModel collects the active refresh tokens of a user,
+// which were issued to the client.
+type userRefreshTokensByClientWriteModel struct {
+	eventstore.WriteModel
+
+	ClientID string
+	TokenIDs []string
+}
+
+func newUserRefreshTokensByClientWriteModel(userID, resourceOwner, clientID string) *userRefreshTokensByClientWriteModel {
+	return &userRefreshTokensByClientWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   userID,
+			ResourceOwner: resourceOwner,
+		},
+		ClientID: clientID,
+	}
+}
+
+func (wm *userRefreshTokensByClientWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *user.HumanRefreshTokenAddedEvent:
+			if e.ClientID == wm.ClientID {
+				wm.TokenIDs = append(wm.TokenIDs, e.TokenID)
+			}
+		case *user.HumanRefreshTokenRemovedEvent:
+			wm.TokenIDs = slices.DeleteFunc(wm.TokenIDs, func(id string) bool {
+				return id == e.TokenID
+			})
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *userRefreshTokensByClientWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(user.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			user.HumanRefreshTokenAddedType,
+			user.HumanRefreshTokenRemovedType,
+		).
+		Builder()
+}
+
+// oidcSessionsRefreshTokenWriteModel collects the OIDC sessions with an active refresh token.
+type oidcSessionsRefreshTokenWriteModel struct {
+	eventstore.WriteModel
+
+	sessionIDs []string
+	// Active contains the aggregates of the sessions with an active refresh token
+	Active map[string]*eventstore.Aggregate
+}
+
+func newOIDCSessionsRefreshTokenWriteModel(sessionIDs []string) *oidcSessionsRefreshTokenWriteModel {
+	return &oidcSessionsRefreshTokenWriteModel{
+		sessionIDs: sessionIDs,
+		Active:     make(map[string]*eventstore.Aggregate),
+	}
+}
+
+func (wm *oidcSessionsRefreshTokenWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch event.(type) {
+		case *oidcsession.RefreshTokenAddedEvent:
+			aggregate := event.Aggregate()
+			wm.Active[aggregate.ID] = aggregate
+		case *oidcsession.RefreshTokenRevokedEvent:
+			delete(wm.Active, event.Aggregate().ID)
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *oidcSessionsRefreshTokenWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(oidcsession.AggregateType).
+		AggregateIDs(wm.sessionIDs...).
+		EventTypes(
+			oidcsession.RefreshTokenAddedType,
+			oidcsession.RefreshTokenRevokedType,
+		).
+		Builder()
+}
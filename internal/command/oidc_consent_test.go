@@ -0,0 +1,425 @@
+package command
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/oidcconsent"
+	"github.com/zitadel/zitadel/internal/repository/oidcsession"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommands_GrantOIDCConsent(t *testing.T) {
+	ctx := authz.NewMockContext("instance1", "org1", "user1")
+	userAgg := &user.NewAggregate("user1", "org1").Aggregate
+	consentID := oidcConsentID("user1", "client1")
+	consentAgg := oidcconsent.NewAggregate(consentID, "org1", "instance1")
+
+	humanAddedEvent := eventFromEventPusher(
+		user.NewHumanAddedEvent(ctx,
+			userAgg,
+			"username",
+			"firstname",
+			"lastname",
+			"nickname",
+			"displayname",
+			language.German,
+			domain.GenderUnspecified,
+			"email@test.ch",
+			true,
+		),
+	)
+
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		consent *OIDCConsent
+		want    *domain.ObjectDetails
+		wantErr error
+	}{
+		{
+			name: "missing user id, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			consent: &OIDCConsent{
+				ClientID:  "client1",
+				AppID:     "app1",
+				ProjectID: "project1",
+				Scope:     []string{"openid"},
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "COMMAND-ooT4a", "Errors.User.UserIDMissing"),
+		},
+		{
+			name: "missing client id, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			consent: &OIDCConsent{
+				UserID:    "user1",
+				AppID:     "app1",
+				ProjectID: "project1",
+				Scope:     []string{"openid"},
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "COMMAND-Ahl8e", "Errors.IDMissing"),
+		},
+		{
+			name: "user not active, precondition failed error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			consent: &OIDCConsent{
+				UserID:    "user1",
+				ClientID:  "client1",
+				AppID:     "app1",
+				ProjectID: "project1",
+				Scope:     []string{"openid"},
+			},
+			wantErr: zerrors.ThrowPreconditionFailed(nil, "COMMAND-aiK3h", "Errors.User.NotActive"),
+		},
+		{
+			name: "new consent, push error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(humanAddedEvent),
+					expectFilter(),
+					expectPushFailed(io.ErrClosedPipe,
+						oidcconsent.NewGrantedEvent(ctx, consentAgg, "user1", "client1", "app1", "project1", []string{"openid", "profile"}),
+					),
+				),
+			},
+			consent: &OIDCConsent{
+				UserID:    "user1",
+				ClientID:  "client1",
+				AppID:     "app1",
+				ProjectID: "project1",
+				Scope:     []string{"openid", "profile", "openid"},
+			},
+			wantErr: io.ErrClosedPipe,
+		},
+		{
+			name: "new consent, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(humanAddedEvent),
+					expectFilter(),
+					expectPush(
+						oidcconsent.NewGrantedEvent(ctx, consentAgg, "user1", "client1", "app1", "project1", []string{"openid", "profile"}),
+					),
+				),
+			},
+			consent: &OIDCConsent{
+				UserID:    "user1",
+				ClientID:  "client1",
+				AppID:     "app1",
+				ProjectID: "project1",
+				Scope:     []string{"openid", "profile", "openid"},
+			},
+			want: &domain.ObjectDetails{
+				ID:            consentID,
+				ResourceOwner: "org1",
+			},
+		},
+		{
+			name: "scopes already granted, no change",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(humanAddedEvent),
+					expectFilter(
+						eventFromEventPusher(
+							oidcconsent.NewGrantedEvent(ctx, consentAgg, "user1", "client1", "app1", "project1", []string{"openid", "profile"}),
+						),
+					),
+				),
+			},
+			consent: &OIDCConsent{
+				UserID:    "user1",
+				ClientID:  "client1",
+				AppID:     "app1",
+				ProjectID: "project1",
+				Scope:     []string{"profile"},
+			},
+			want: &domain.ObjectDetails{
+				ID:            consentID,
+				ResourceOwner: "org1",
+			},
+		},
+		{
+			name: "additional scopes, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(humanAddedEvent),
+					expectFilter(
+						eventFromEventPusher(
+							oidcconsent.NewGrantedEvent(ctx, consentAgg, "user1", "client1", "app1", "project1", []string{"openid"}),
+						),
+					),
+					expectPush(
+						oidcconsent.NewGrantedEvent(ctx, consentAgg, "user1", "client1", "app1", "project1", []string{"openid", "email"}),
+					),
+				),
+			},
+			consent: &OIDCConsent{
+				UserID:    "user1",
+				ClientID:  "client1",
+				AppID:     "app1",
+				ProjectID: "project1",
+				Scope:     []string{"openid", "email"},
+			},
+			want: &domain.ObjectDetails{
+				ID:            consentID,
+				ResourceOwner: "org1",
+			},
+		},
+		{
+			name: "revoked consent, granted again",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(humanAddedEvent),
+					expectFilter(
+						eventFromEventPusher(
+							oidcconsent.NewGrantedEvent(ctx, consentAgg, "user1", "client1", "app1", "project1", []string{"openid", "email"}),
+						),
+						eventFromEventPusher(
+							oidcconsent.NewRevokedEvent(ctx, consentAgg, "user1", "client1"),
+						),
+					),
+					expectPush(
+						oidcconsent.NewGrantedEvent(ctx, consentAgg, "user1", "client1", "app1", "project1", []string{"openid"}),
+					),
+				),
+			},
+			consent: &OIDCConsent{
+				UserID:    "user1",
+				ClientID:  "client1",
+				AppID:     "app1",
+				ProjectID: "project1",
+				Scope:     []string{"openid"},
+			},
+			want: &domain.ObjectDetails{
+				ID:            consentID,
+				ResourceOwner: "org1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := c.GrantOIDCConsent(ctx, tt.consent)
+			require.ErrorIs(t, err, tt.wantErr)
+			assertObjectDetails(t, tt.want, got)
+		})
+	}
+}
+
+func TestCommands_RevokeOIDCConsent(t *testing.T) {
+	ctx := authz.NewMockContext("instance1", "org1", "user1")
+	userAgg := &user.NewAggregate("user1", "org1").Aggregate
+	consentAgg := oidcconsent.NewAggregate("consent1", "org1", "instance1")
+	sessionAgg1 := &oidcsession.NewAggregate("V2_session1", "org1").Aggregate
+	sessionAgg2 := &oidcsession.NewAggregate("V2_session2", "org1").Aggregate
+
+	grantedEvent := eventFromEventPusher(
+		oidcconsent.NewGrantedEvent(ctx, consentAgg, "user1", "client1", "app1", "project1", []string{"openid", "offline_access"}),
+	)
+	sessionIDs := func(ids ...string) OIDCSessionIDsByUserAndClient {
+		return func(_ context.Context, userID, clientID string) ([]string, error) {
+			if userID != "user1" || clientID != "client1" {
+				return nil, nil
+			}
+			return ids, nil
+		}
+	}
+
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		userID     string
+		consentID  string
+		sessionIDs OIDCSessionIDsByUserAndClient
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    *domain.ObjectDetails
+		wantErr error
+	}{
+		{
+			name: "missing user id, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				consentID: "consent1",
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "COMMAND-Eim2o", "Errors.User.UserIDMissing"),
+		},
+		{
+			name: "missing consent id, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				userID: "user1",
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "COMMAND-ue3Ai", "Errors.IDMissing"),
+		},
+		{
+			name: "consent not existing, not found error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				userID:    "user1",
+				consentID: "consent1",
+			},
+			wantErr: zerrors.ThrowNotFound(nil, "COMMAND-ieC0u", "Errors.OIDCConsent.NotFound"),
+		},
+		{
+			name: "consent already revoked, not found error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						grantedEvent,
+						eventFromEventPusher(
+							oidcconsent.NewRevokedEvent(ctx, consentAgg, "user1", "client1"),
+						),
+					),
+				),
+			},
+			args: args{
+				userID:    "user1",
+				consentID: "consent1",
+			},
+			wantErr: zerrors.ThrowNotFound(nil, "COMMAND-ieC0u", "Errors.OIDCConsent.NotFound"),
+		},
+		{
+			name: "consent of other user, not found error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(grantedEvent),
+				),
+			},
+			args: args{
+				userID:    "user2",
+				consentID: "consent1",
+			},
+			wantErr: zerrors.ThrowNotFound(nil, "COMMAND-ieC0u", "Errors.OIDCConsent.NotFound"),
+		},
+		{
+			name: "session lookup error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(grantedEvent),
+					expectFilter(),
+				),
+			},
+			args: args{
+				userID:    "user1",
+				consentID: "consent1",
+				sessionIDs: func(context.Context, string, string) ([]string, error) {
+					return nil, io.ErrClosedPipe
+				},
+			},
+			wantErr: io.ErrClosedPipe,
+		},
+		{
+			name: "no refresh tokens, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(grantedEvent),
+					expectFilter(),
+					expectPush(
+						oidcconsent.NewRevokedEvent(ctx, consentAgg, "user1", "client1"),
+					),
+				),
+			},
+			args: args{
+				userID:     "user1",
+				consentID:  "consent1",
+				sessionIDs: sessionIDs(),
+			},
+			want: &domain.ObjectDetails{
+				ID:            "consent1",
+				ResourceOwner: "org1",
+			},
+		},
+		{
+			name: "refresh tokens revoked, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(grantedEvent),
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanRefreshTokenAddedEvent(ctx, userAgg, "token1", "client1", "agent1", "de", nil, nil, nil, time.Now(), time.Hour, time.Hour, nil),
+						),
+						eventFromEventPusher(
+							user.NewHumanRefreshTokenAddedEvent(ctx, userAgg, "token2", "client2", "agent1", "de", nil, nil, nil, time.Now(), time.Hour, time.Hour, nil),
+						),
+						eventFromEventPusher(
+							user.NewHumanRefreshTokenAddedEvent(ctx, userAgg, "token3", "client1", "agent1", "de", nil, nil, nil, time.Now(), time.Hour, time.Hour, nil),
+						),
+						eventFromEventPusher(
+							user.NewHumanRefreshTokenRemovedEvent(ctx, userAgg, "token3"),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							oidcsession.NewRefreshTokenAddedEvent(ctx, sessionAgg1, "rt1", time.Hour, time.Hour),
+						),
+						eventFromEventPusher(
+							oidcsession.NewRefreshTokenAddedEvent(ctx, sessionAgg2, "rt2", time.Hour, time.Hour),
+						),
+						eventFromEventPusher(
+							oidcsession.NewRefreshTokenRevokedEvent(ctx, sessionAgg2),
+						),
+					),
+					expectPush(
+						oidcconsent.NewRevokedEvent(ctx, consentAgg, "user1", "client1"),
+						user.NewHumanRefreshTokenRemovedEvent(ctx, userAgg, "token1"),
+						oidcsession.NewRefreshTokenRevokedEvent(ctx, sessionAgg1),
+					),
+				),
+			},
+			args: args{
+				userID:     "user1",
+				consentID:  "consent1",
+				sessionIDs: sessionIDs("V2_session1", "V2_session2"),
+			},
+			want: &domain.ObjectDetails{
+				ID:            "consent1",
+				ResourceOwner: "org1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := c.RevokeOIDCConsent(ctx, tt.args.userID, tt.args.consentID, tt.args.sessionIDs)
+			require.ErrorIs(t, err, tt.wantErr)
+			assertObjectDetails(t, tt.want, got)
+		})
+	}
+}
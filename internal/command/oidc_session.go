@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/base64"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
@@ -33,21 +34,24 @@ const (
 )
 
 type OIDCSession struct {
-	SessionID         string
-	TokenID           string
-	ClientID          string
-	UserID            string
-	Audience          []string
-	Expiration        time.Time
-	Scope             []string
-	AuthMethods       []domain.UserAuthMethodType
-	AuthTime          time.Time
-	Nonce             string
-	PreferredLanguage *language.Tag
-	UserAgent         *domain.UserAgent
-	Reason            domain.TokenReason
-	Actor             *domain.TokenActor
-	RefreshToken      string
+	SessionID             string
+	TokenID               string
+	ClientID              string
+	UserID                string
+	Audience              []string
+	Expiration            time.Time
+	Scope                 []string
+	AuthMethods           []domain.UserAuthMethodType
+	AuthTime              time.Time
+	Nonce                 string
+	PreferredLanguage     *language.Tag
+	UserAgent             *domain.UserAgent
+	Reason                domain.TokenReason
+	Actor                 *domain.TokenActor
+	RefreshToken          string
+	DPoPJKT               string
+	CertificateThumbprint string
+	AuthorizationDetails  domain.AuthorizationDetails
 }
 
 type AuthRequestComplianceChecker func(context.Context, *AuthRequestWriteModel) error
@@ -55,12 +59,18 @@ type AuthRequestComplianceChecker func(context.Context, *AuthRequestWriteModel)
 // CreateOIDCSessionFromAuthRequest creates a new OIDC Session, creates an access token and refresh token.
 // It returns the access token id, expiration and the refresh token.
 // If the underlying [AuthRequest] is a OIDC Auth Code Flow, it will set the code as exchanged.
+// If a dpopJKT is provided, the tokens of the session are bound to the DPoP key with this thumbprint.
+// If a certificateThumbprint is provided, they are bound to the client certificate with this thumbprint.
+// If an audience is provided, the access token is restricted to it, which must be part of the audience of the auth request.
 func (c *Commands) CreateOIDCSessionFromAuthRequest(
 	ctx context.Context,
 	authReqId string,
 	complianceCheck AuthRequestComplianceChecker,
+	audience []string,
 	needRefreshToken bool,
 	backChannelLogoutURI string,
+	dpopJKT string,
+	certificateThumbprint string,
 ) (session *OIDCSession, state string, err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
@@ -100,6 +110,9 @@ func (c *Commands) CreateOIDCSessionFromAuthRequest(
 	if err = complianceCheck(ctx, authReqModel); err != nil {
 		return nil, "", err
 	}
+	if err = checkAudienceGranted(authReqModel.Audience, audience); err != nil {
+		return nil, "", err
+	}
 
 	cmd.AddSession(ctx,
 		sessionModel.UserID,
@@ -113,11 +126,14 @@ func (c *Commands) CreateOIDCSessionFromAuthRequest(
 		authReqModel.Nonce,
 		sessionModel.PreferredLanguage,
 		sessionModel.UserAgent,
+		dpopJKT,
+		certificateThumbprint,
+		authReqModel.AuthorizationDetails,
 	)
 	cmd.RegisterLogout(ctx, sessionModel.AggregateID, sessionModel.UserID, authReqModel.ClientID, backChannelLogoutURI)
 
 	if authReqModel.ResponseType != domain.OIDCResponseTypeIDToken {
-		if err = cmd.AddAccessToken(ctx, authReqModel.Scope, sessionModel.UserID, sessionModel.UserResourceOwner, domain.TokenReasonAuthRequest, nil); err != nil {
+		if err = cmd.AddAccessToken(ctx, authReqModel.Scope, audience, sessionModel.UserID, sessionModel.UserResourceOwner, domain.TokenReasonAuthRequest, nil); err != nil {
 			return nil, "", err
 		}
 	}
@@ -138,13 +154,16 @@ func (c *Commands) CreateOIDCSessionFromAuthRequest(
 	return session, authReqModel.State, nil
 }
 
+// CreateOIDCSession creates a new OIDC Session without an auth request, e.g. for token exchange or client credentials.
+// If an accessTokenAudience is provided, the access token is restricted to it, which must be part of the audience of the session.
 func (c *Commands) CreateOIDCSession(ctx context.Context,
 	userID,
 	resourceOwner,
 	clientID,
 	backChannelLogoutURI string,
 	scope,
-	audience []string,
+	audience,
+	accessTokenAudience []string,
 	authMethods []domain.UserAuthMethodType,
 	authTime time.Time,
 	nonce string,
@@ -155,10 +174,16 @@ func (c *Commands) CreateOIDCSession(ctx context.Context,
 	needRefreshToken bool,
 	sessionID string,
 	responseType domain.OIDCResponseType,
+	dpopJKT string,
+	certificateThumbprint string,
+	authorizationDetails domain.AuthorizationDetails,
 ) (session *OIDCSession, err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
+	if err = checkAudienceGranted(audience, accessTokenAudience); err != nil {
+		return nil, err
+	}
 	cmd, err := c.newOIDCSessionAddEvents(ctx, userID, resourceOwner)
 	if err != nil {
 		return nil, err
@@ -170,10 +195,10 @@ func (c *Commands) CreateOIDCSession(ctx context.Context,
 		cmd.UserImpersonated(ctx, userID, resourceOwner, clientID, actor)
 	}
 
-	cmd.AddSession(ctx, userID, resourceOwner, sessionID, clientID, audience, scope, authMethods, authTime, nonce, preferredLanguage, userAgent)
+	cmd.AddSession(ctx, userID, resourceOwner, sessionID, clientID, audience, scope, authMethods, authTime, nonce, preferredLanguage, userAgent, dpopJKT, certificateThumbprint, authorizationDetails)
 	cmd.RegisterLogout(ctx, sessionID, userID, clientID, backChannelLogoutURI)
 	if responseType != domain.OIDCResponseTypeIDToken {
-		if err = cmd.AddAccessToken(ctx, scope, userID, resourceOwner, reason, actor); err != nil {
+		if err = cmd.AddAccessToken(ctx, scope, accessTokenAudience, userID, resourceOwner, reason, actor); err != nil {
 			return nil, err
 		}
 	}
@@ -197,11 +222,15 @@ type RefreshTokenComplianceChecker func(ctx context.Context, wm *OIDCSessionWrit
 
 // ExchangeOIDCSessionRefreshAndAccessToken updates an existing OIDC Session, creates a new access and refresh token.
 // It returns the access token id and expiration and the new refresh token.
-func (c *Commands) ExchangeOIDCSessionRefreshAndAccessToken(ctx context.Context, refreshToken string, scope []string, complianceCheck RefreshTokenComplianceChecker) (_ *OIDCSession, err error) {
+// If the session is bound to a DPoP key, the dpopJKT of the proof presented with the refresh token must match.
+// If the session is bound to a client certificate, the certificateThumbprint of the presented certificate must match.
+// With a strict refreshTokenRotation, the reuse of an already rotated refresh token revokes all tokens of the session.
+// If an audience is provided, the new access token is restricted to it, which must be part of the audience of the session.
+func (c *Commands) ExchangeOIDCSessionRefreshAndAccessToken(ctx context.Context, refreshToken string, scope, audience []string, dpopJKT, certificateThumbprint string, refreshTokenRotation domain.OIDCRefreshTokenRotation, complianceCheck RefreshTokenComplianceChecker) (_ *OIDCSession, err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
-	cmd, err := c.newOIDCSessionUpdateEvents(ctx, refreshToken)
+	cmd, err := c.newOIDCSessionUpdateEvents(ctx, refreshToken, dpopJKT, certificateThumbprint, refreshTokenRotation)
 	if err != nil {
 		return nil, err
 	}
@@ -209,7 +238,10 @@ func (c *Commands) ExchangeOIDCSessionRefreshAndAccessToken(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	err = cmd.AddAccessToken(ctx, scope,
+	if err = checkAudienceGranted(cmd.oidcSessionWriteModel.Audience, audience); err != nil {
+		return nil, err
+	}
+	err = cmd.AddAccessToken(ctx, scope, audience,
 		cmd.oidcSessionWriteModel.UserID,
 		cmd.oidcSessionWriteModel.UserResourceOwner,
 		domain.TokenReasonRefresh,
@@ -294,6 +326,18 @@ func (c *Commands) RevokeOIDCSessionToken(ctx context.Context, token, clientID s
 	return c.pushAppendAndReduce(ctx, writeModel, oidcsession.NewAccessTokenRevokedEvent(ctx, writeModel.aggregate))
 }
 
+// RefreshTokenReuseNotificationSent marks the notification about a reused refresh token as sent.
+func (c *Commands) RefreshTokenReuseNotificationSent(ctx context.Context, resourceOwner, oidcSessionID string) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if oidcSessionID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "OIDCS-Aeg4k", "Errors.IDMissing")
+	}
+	_, err = c.eventstore.Push(ctx, oidcsession.NewRefreshTokenReuseNotificationSentEvent(ctx, &oidcsession.NewAggregate(oidcSessionID, resourceOwner).Aggregate))
+	return err
+}
+
 func (c *Commands) newOIDCSessionAddEvents(ctx context.Context, userID, resourceOwner string, pending ...eventstore.Command) (*OIDCSessionEvents, error) {
 	userStateModel, err := c.userStateWriteModel(ctx, userID)
 	if err != nil {
@@ -346,7 +390,7 @@ func parseRefreshToken(refreshToken string) (oidcSessionID, refreshTokenID strin
 	return split[0], strings.Split(split[1], oidcTokenSubjectDelimiter)[0], nil
 }
 
-func (c *Commands) newOIDCSessionUpdateEvents(ctx context.Context, refreshToken string) (*OIDCSessionEvents, error) {
+func (c *Commands) newOIDCSessionUpdateEvents(ctx context.Context, refreshToken, dpopJKT, certificateThumbprint string, refreshTokenRotation domain.OIDCRefreshTokenRotation) (*OIDCSessionEvents, error) {
 	oidcSessionID, refreshTokenID, err := c.decryptRefreshToken(refreshToken)
 	if err != nil {
 		return nil, err
@@ -356,6 +400,15 @@ func (c *Commands) newOIDCSessionUpdateEvents(ctx context.Context, refreshToken
 		return nil, err
 	}
 	if err = sessionWriteModel.CheckRefreshToken(refreshTokenID); err != nil {
+		if refreshTokenRotation.IsStrict() && sessionWriteModel.IsRefreshTokenReused(refreshTokenID) {
+			return nil, c.revokeReusedRefreshTokenFamily(ctx, sessionWriteModel, refreshTokenID, refreshTokenRotation)
+		}
+		return nil, err
+	}
+	if err = sessionWriteModel.CheckDPoPJKT(dpopJKT); err != nil {
+		return nil, err
+	}
+	if err = sessionWriteModel.CheckCertificateThumbprint(certificateThumbprint); err != nil {
 		return nil, err
 	}
 	userStateWriteModel, err := c.userStateWriteModel(ctx, sessionWriteModel.UserID)
@@ -380,6 +433,27 @@ func (c *Commands) newOIDCSessionUpdateEvents(ctx context.Context, refreshToken
 	}, nil
 }
 
+// revokeReusedRefreshTokenFamily terminates the session and revokes all of its tokens,
+// since an already rotated refresh token might have been leaked.
+// It always returns an error, which should be passed to the client.
+func (c *Commands) revokeReusedRefreshTokenFamily(ctx context.Context, wm *OIDCSessionWriteModel, refreshTokenID string, refreshTokenRotation domain.OIDCRefreshTokenRotation) error {
+	logging.WithFields("oidcSessionID", wm.AggregateID, "refreshTokenID", refreshTokenID, "clientID", wm.ClientID).
+		Warn("reuse of rotated refresh token detected, revoking session")
+	err := c.pushAppendAndReduce(ctx, wm,
+		oidcsession.NewRefreshTokenReusedEvent(ctx, wm.aggregate,
+			refreshTokenID,
+			wm.ClientID,
+			wm.UserID,
+			refreshTokenRotation == domain.OIDCRefreshTokenRotationStrictNotify,
+		),
+		oidcsession.NewRefreshTokenRevokedEvent(ctx, wm.aggregate),
+	)
+	if err != nil {
+		return err
+	}
+	return zerrors.ThrowPreconditionFailed(nil, "OIDCS-Reu5e", "Errors.OIDCSession.RefreshTokenReused")
+}
+
 type OIDCSessionEvents struct {
 	commands              *Commands
 	idGenerator           id.Generator
@@ -413,6 +487,9 @@ func (c *OIDCSessionEvents) AddSession(
 	nonce string,
 	preferredLanguage *language.Tag,
 	userAgent *domain.UserAgent,
+	dpopJKT,
+	certificateThumbprint string,
+	authorizationDetails domain.AuthorizationDetails,
 ) {
 	c.events = append(c.events, oidcsession.NewAddedEvent(
 		ctx,
@@ -428,6 +505,9 @@ func (c *OIDCSessionEvents) AddSession(
 		nonce,
 		preferredLanguage,
 		userAgent,
+		dpopJKT,
+		certificateThumbprint,
+		authorizationDetails,
 	))
 }
 
@@ -466,13 +546,13 @@ func (c *OIDCSessionEvents) RegisterLogout(ctx context.Context, sessionID, userI
 	))
 }
 
-func (c *OIDCSessionEvents) AddAccessToken(ctx context.Context, scope []string, userID, resourceOwner string, reason domain.TokenReason, actor *domain.TokenActor) error {
+func (c *OIDCSessionEvents) AddAccessToken(ctx context.Context, scope, audience []string, userID, resourceOwner string, reason domain.TokenReason, actor *domain.TokenActor) error {
 	accessTokenID, err := c.idGenerator.Next()
 	if err != nil {
 		return err
 	}
 	c.accessTokenID = AccessTokenPrefix + accessTokenID
-	c.events = append(c.events, oidcsession.NewAccessTokenAddedEvent(ctx, c.oidcSessionWriteModel.aggregate, c.accessTokenID, scope, c.accessTokenLifetime, reason, actor))
+	c.events = append(c.events, oidcsession.NewAccessTokenAddedEvent(ctx, c.oidcSessionWriteModel.aggregate, c.accessTokenID, scope, c.accessTokenLifetime, reason, actor, audience))
 	if !authz.GetFeatures(ctx).DisableUserTokenEvent {
 		c.events = append(c.events, user.NewUserTokenV2AddedEvent(ctx, &user.NewAggregate(userID, resourceOwner).Aggregate, c.accessTokenID))
 	}
@@ -525,20 +605,23 @@ func (c *OIDCSessionEvents) PushEvents(ctx context.Context) (*OIDCSession, error
 		return nil, err
 	}
 	session := &OIDCSession{
-		SessionID:         c.oidcSessionWriteModel.SessionID,
-		ClientID:          c.oidcSessionWriteModel.ClientID,
-		UserID:            c.oidcSessionWriteModel.UserID,
-		Audience:          c.oidcSessionWriteModel.Audience,
-		Expiration:        c.oidcSessionWriteModel.AccessTokenExpiration,
-		Scope:             c.oidcSessionWriteModel.Scope,
-		AuthMethods:       c.oidcSessionWriteModel.AuthMethods,
-		AuthTime:          c.oidcSessionWriteModel.AuthTime,
-		Nonce:             c.oidcSessionWriteModel.Nonce,
-		PreferredLanguage: c.oidcSessionWriteModel.PreferredLanguage,
-		UserAgent:         c.oidcSessionWriteModel.UserAgent,
-		Reason:            c.oidcSessionWriteModel.AccessTokenReason,
-		Actor:             c.oidcSessionWriteModel.AccessTokenActor,
-		RefreshToken:      c.refreshToken,
+		SessionID:             c.oidcSessionWriteModel.SessionID,
+		ClientID:              c.oidcSessionWriteModel.ClientID,
+		UserID:                c.oidcSessionWriteModel.UserID,
+		Audience:              c.oidcSessionWriteModel.TokenAudience(),
+		Expiration:            c.oidcSessionWriteModel.AccessTokenExpiration,
+		Scope:                 c.oidcSessionWriteModel.Scope,
+		AuthMethods:           c.oidcSessionWriteModel.AuthMethods,
+		AuthTime:              c.oidcSessionWriteModel.AuthTime,
+		Nonce:                 c.oidcSessionWriteModel.Nonce,
+		PreferredLanguage:     c.oidcSessionWriteModel.PreferredLanguage,
+		UserAgent:             c.oidcSessionWriteModel.UserAgent,
+		Reason:                c.oidcSessionWriteModel.AccessTokenReason,
+		Actor:                 c.oidcSessionWriteModel.AccessTokenActor,
+		RefreshToken:          c.refreshToken,
+		DPoPJKT:               c.oidcSessionWriteModel.DPoPJKT,
+		CertificateThumbprint: c.oidcSessionWriteModel.CertificateThumbprint,
+		AuthorizationDetails:  c.oidcSessionWriteModel.AuthorizationDetails,
 	}
 	if c.accessTokenID != "" {
 		// prefix the returned id with the oidcSessionID so that we can retrieve it later on
@@ -549,6 +632,17 @@ func (c *OIDCSessionEvents) PushEvents(ctx context.Context) (*OIDCSession, error
 	return session, nil
 }
 
+// checkAudienceGranted ensures that the audience requested for an access token (RFC 8707)
+// is part of the granted audience.
+func checkAudienceGranted(granted, requested []string) error {
+	for _, aud := range requested {
+		if !slices.Contains(granted, aud) {
+			return zerrors.ThrowPreconditionFailed(nil, "OIDCS-Ruo9e", "Errors.OIDCSession.ResourceInvalid")
+		}
+	}
+	return nil
+}
+
 func (c *Commands) tokenTokenLifetimes(ctx context.Context) (accessTokenLifetime time.Duration, refreshTokenLifetime time.Duration, refreshTokenIdleLifetime time.Duration, err error) {
 	oidcSettings := NewInstanceOIDCSettingsWriteModel(ctx)
 	err = c.eventstore.FilterToQueryReducer(ctx, oidcSettings)
@@ -1,6 +1,7 @@
 package command
 
 import (
+	"slices"
 	"time"
 
 	"golang.org/x/text/language"
@@ -25,16 +26,22 @@ type OIDCSessionWriteModel struct {
 	AuthTime                   time.Time
 	Nonce                      string
 	UserAgent                  *domain.UserAgent
+	DPoPJKT                    string
+	CertificateThumbprint      string
+	AuthorizationDetails       domain.AuthorizationDetails
 	State                      domain.OIDCSessionState
 	AccessTokenID              string
 	AccessTokenCreation        time.Time
 	AccessTokenExpiration      time.Time
 	AccessTokenReason          domain.TokenReason
 	AccessTokenActor           *domain.TokenActor
+	AccessTokenAudience        []string
 	RefreshTokenID             string
 	RefreshToken               string
 	RefreshTokenExpiration     time.Time
 	RefreshTokenIdleExpiration time.Time
+	// RotatedRefreshTokenIDs are the ids of all refresh tokens, which were already replaced by a renewed one.
+	RotatedRefreshTokenIDs []string
 
 	aggregate *eventstore.Aggregate
 }
@@ -64,6 +71,8 @@ func (wm *OIDCSessionWriteModel) Reduce() error {
 			wm.reduceRefreshTokenRenewed(e)
 		case *oidcsession.RefreshTokenRevokedEvent:
 			wm.reduceRefreshTokenRevoked(e)
+		case *oidcsession.RefreshTokenReusedEvent:
+			wm.reduceRefreshTokenReused(e)
 		}
 	}
 	return wm.WriteModel.Reduce()
@@ -80,6 +89,7 @@ func (wm *OIDCSessionWriteModel) Query() *eventstore.SearchQueryBuilder {
 			oidcsession.RefreshTokenAddedType,
 			oidcsession.RefreshTokenRenewedType,
 			oidcsession.RefreshTokenRevokedType,
+			oidcsession.RefreshTokenReusedType,
 		).
 		Builder()
 
@@ -101,6 +111,9 @@ func (wm *OIDCSessionWriteModel) reduceAdded(e *oidcsession.AddedEvent) {
 	wm.Nonce = e.Nonce
 	wm.PreferredLanguage = e.PreferredLanguage
 	wm.UserAgent = e.UserAgent
+	wm.DPoPJKT = e.DPoPJKT
+	wm.CertificateThumbprint = e.CertificateThumbprint
+	wm.AuthorizationDetails = e.AuthorizationDetails
 	wm.State = domain.OIDCSessionStateActive
 	// the write model might be initialized without resource owner,
 	// so update the aggregate
@@ -114,6 +127,7 @@ func (wm *OIDCSessionWriteModel) reduceAccessTokenAdded(e *oidcsession.AccessTok
 	wm.AccessTokenExpiration = e.CreationDate().Add(e.Lifetime)
 	wm.AccessTokenReason = e.Reason
 	wm.AccessTokenActor = e.Actor
+	wm.AccessTokenAudience = e.Audience
 }
 
 func (wm *OIDCSessionWriteModel) reduceAccessTokenRevoked(e *oidcsession.AccessTokenRevokedEvent) {
@@ -128,6 +142,9 @@ func (wm *OIDCSessionWriteModel) reduceRefreshTokenAdded(e *oidcsession.RefreshT
 }
 
 func (wm *OIDCSessionWriteModel) reduceRefreshTokenRenewed(e *oidcsession.RefreshTokenRenewedEvent) {
+	if wm.RefreshTokenID != "" {
+		wm.RotatedRefreshTokenIDs = append(wm.RotatedRefreshTokenIDs, wm.RefreshTokenID)
+	}
 	wm.RefreshTokenID = e.ID
 	wm.RefreshTokenIdleExpiration = e.CreationDate().Add(e.IdleLifetime)
 }
@@ -140,6 +157,21 @@ func (wm *OIDCSessionWriteModel) reduceRefreshTokenRevoked(e *oidcsession.Refres
 	wm.AccessTokenExpiration = e.CreationDate()
 }
 
+func (wm *OIDCSessionWriteModel) reduceRefreshTokenReused(e *oidcsession.RefreshTokenReusedEvent) {
+	wm.State = domain.OIDCSessionStateTerminated
+	wm.RefreshTokenID = ""
+	wm.RefreshTokenExpiration = e.CreationDate()
+	wm.RefreshTokenIdleExpiration = e.CreationDate()
+	wm.AccessTokenID = ""
+	wm.AccessTokenExpiration = e.CreationDate()
+}
+
+// IsRefreshTokenReused checks if the provided refresh token id belongs to an active session,
+// but was already replaced by a renewed refresh token.
+func (wm *OIDCSessionWriteModel) IsRefreshTokenReused(refreshTokenID string) bool {
+	return wm.State == domain.OIDCSessionStateActive && slices.Contains(wm.RotatedRefreshTokenIDs, refreshTokenID)
+}
+
 func (wm *OIDCSessionWriteModel) CheckRefreshToken(refreshTokenID string) error {
 	if wm.State != domain.OIDCSessionStateActive {
 		return zerrors.ThrowPreconditionFailed(nil, "OIDCS-s3hjk", "Errors.OIDCSession.RefreshTokenInvalid")
@@ -167,7 +199,37 @@ func (wm *OIDCSessionWriteModel) CheckAccessToken(accessTokenID string) error {
 	return nil
 }
 
+// CheckDPoPJKT ensures that the proof of a session bound to a DPoP key was created with the same key.
+func (wm *OIDCSessionWriteModel) CheckDPoPJKT(dpopJKT string) error {
+	if wm.DPoPJKT != "" && wm.DPoPJKT != dpopJKT {
+		return zerrors.ThrowPreconditionFailed(nil, "OIDCS-Dp0pK", "Errors.OIDCSession.DPoPProofInvalid")
+	}
+	return nil
+}
+
+// CheckCertificateThumbprint ensures that the refresh token of a session bound to a client certificate
+// is presented with the same certificate.
+func (wm *OIDCSessionWriteModel) CheckCertificateThumbprint(certificateThumbprint string) error {
+	if wm.CertificateThumbprint != "" && wm.CertificateThumbprint != certificateThumbprint {
+		return zerrors.ThrowPreconditionFailed(nil, "OIDCS-Ce9tb", "Errors.OIDCSession.ClientCertificateInvalid")
+	}
+	return nil
+}
+
+// TokenAudience returns the audience of the current access token,
+// which might be restricted to the resources requested for it.
+func (wm *OIDCSessionWriteModel) TokenAudience() []string {
+	if len(wm.AccessTokenAudience) > 0 {
+		return wm.AccessTokenAudience
+	}
+	return wm.Audience
+}
+
 func (wm *OIDCSessionWriteModel) CheckClient(clientID string) error {
+	// the audience of sessions created for specific resources does not contain the client itself
+	if wm.ClientID == clientID {
+		return nil
+	}
 	for _, aud := range wm.Audience {
 		if aud == clientID {
 			return nil
@@ -1521,6 +1521,9 @@ func (c *Commands) prepareAddOrgLDAPProvider(a *org.Aggregate, writeModel *OrgLD
 				return nil, err
 			}
 		}
+		if err := validateLDAPGroupSync(provider.GroupSync); err != nil {
+			return nil, err
+		}
 		return func(ctx context.Context, filter preparation.FilterToQueryReducer) ([]eventstore.Command, error) {
 			events, err := filter(ctx, writeModel.Query())
 			if err != nil {
@@ -1550,6 +1553,7 @@ func (c *Commands) prepareAddOrgLDAPProvider(a *org.Aggregate, writeModel *OrgLD
 					provider.UserFilters,
 					provider.Timeout,
 					provider.RootCA,
+					provider.GroupSync,
 					provider.LDAPAttributes,
 					provider.IDPOptions,
 				),
@@ -1589,6 +1593,9 @@ func (c *Commands) prepareUpdateOrgLDAPProvider(a *org.Aggregate, writeModel *Or
 				return nil, err
 			}
 		}
+		if err := validateLDAPGroupSync(provider.GroupSync); err != nil {
+			return nil, err
+		}
 		return func(ctx context.Context, filter preparation.FilterToQueryReducer) ([]eventstore.Command, error) {
 			events, err := filter(ctx, writeModel.Query())
 			if err != nil {
@@ -1616,6 +1623,7 @@ func (c *Commands) prepareUpdateOrgLDAPProvider(a *org.Aggregate, writeModel *Or
 				provider.UserFilters,
 				provider.Timeout,
 				provider.RootCA,
+				provider.GroupSync,
 				c.idpConfigEncryption,
 				provider.LDAPAttributes,
 				provider.IDPOptions,
@@ -782,6 +782,7 @@ func (wm *OrgLDAPIDPWriteModel) NewChangedEvent(
 	userFilters []string,
 	timeout time.Duration,
 	rootCA []byte,
+	groupSync *idp.LDAPGroupSync,
 	secretCrypto crypto.EncryptionAlgorithm,
 	attributes idp.LDAPAttributes,
 	options idp.Options,
@@ -799,6 +800,7 @@ func (wm *OrgLDAPIDPWriteModel) NewChangedEvent(
 		userFilters,
 		timeout,
 		rootCA,
+		groupSync,
 		secretCrypto,
 		attributes,
 		options,
@@ -4377,6 +4377,7 @@ func TestCommandSide_AddOrgLDAPIDP(t *testing.T) {
 							[]string{"filter"},
 							time.Second*30,
 							nil,
+							nil,
 							idp.LDAPAttributes{},
 							idp.Options{},
 						),
@@ -4430,6 +4431,7 @@ func TestCommandSide_AddOrgLDAPIDP(t *testing.T) {
 							[]string{"filter"},
 							time.Second*30,
 							validLDAPRootCA,
+							nil,
 							idp.LDAPAttributes{
 								IDAttribute:                "id",
 								FirstNameAttribute:         "firstName",
@@ -4783,6 +4785,7 @@ func TestCommandSide_UpdateOrgLDAPIDP(t *testing.T) {
 								[]string{"filter"},
 								time.Second*30,
 								validLDAPRootCA,
+								nil,
 								idp.LDAPAttributes{},
 								idp.Options{},
 							)),
@@ -4833,6 +4836,7 @@ func TestCommandSide_UpdateOrgLDAPIDP(t *testing.T) {
 								[]string{"filter"},
 								time.Second*30,
 								nil,
+								nil,
 								idp.LDAPAttributes{},
 								idp.Options{},
 							)),
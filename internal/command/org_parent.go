@@ -0,0 +1,123 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// SetOrgParent places the organization below the parent organization.
+// The organization inherits the policies of the parent it doesn't define itself.
+// If delegateAdministration is set, the members of the parent organization
+// keep their roles on the organization.
+// The caller needs the permission to write the parent organization.
+func (c *Commands) SetOrgParent(ctx context.Context, orgID, parentOrgID string, delegateAdministration bool) (*domain.ObjectDetails, error) {
+	if orgID == "" || parentOrgID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "ORG-Ohr7a", "Errors.IDMissing")
+	}
+	if orgID == parentOrgID {
+		return nil, zerrors.ThrowInvalidArgument(nil, "ORG-eiX3u", "Errors.Org.Hierarchy.Cycle")
+	}
+	writeModel, err := c.getOrgParentWriteModelByID(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	if !isOrgStateExists(writeModel.State) {
+		return nil, zerrors.ThrowNotFound(nil, "ORG-Aec1o", "Errors.Org.NotFound")
+	}
+	if writeModel.ParentOrgID == parentOrgID && writeModel.DelegateAdministration == delegateAdministration {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "ORG-Ahf5o", "Errors.NoChangesFound")
+	}
+	if err = c.checkPermissionWriteOrg(ctx, parentOrgID); err != nil {
+		return nil, err
+	}
+	if err = c.checkOrgAncestors(ctx, orgID, parentOrgID); err != nil {
+		return nil, err
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, org.NewOrgParentSetEvent(
+		ctx,
+		OrgAggregateFromWriteModel(&writeModel.WriteModel),
+		parentOrgID,
+		delegateAdministration,
+	))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(writeModel, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// RemoveOrgParent makes the organization a top level organization of the instance again.
+func (c *Commands) RemoveOrgParent(ctx context.Context, orgID string) (*domain.ObjectDetails, error) {
+	if orgID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "ORG-ooS9d", "Errors.IDMissing")
+	}
+	writeModel, err := c.getOrgParentWriteModelByID(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	if !isOrgStateExists(writeModel.State) {
+		return nil, zerrors.ThrowNotFound(nil, "ORG-Ieng4", "Errors.Org.NotFound")
+	}
+	if writeModel.ParentOrgID == "" {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "ORG-vah4E", "Errors.Org.Hierarchy.NoParent")
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, org.NewOrgParentRemovedEvent(
+		ctx,
+		OrgAggregateFromWriteModel(&writeModel.WriteModel),
+		writeModel.ParentOrgID,
+	))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(writeModel, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// checkOrgAncestors walks up the hierarchy starting at the new parent of the organization
+// and ensures the parent exists, the organization is not its own ancestor
+// and the hierarchy doesn't exceed [domain.OrgHierarchyMaxDepth].
+func (c *Commands) checkOrgAncestors(ctx context.Context, orgID, parentOrgID string) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	ancestorID := parentOrgID
+	for depth := 1; ancestorID != ""; depth++ {
+		if depth > domain.OrgHierarchyMaxDepth {
+			return zerrors.ThrowPreconditionFailed(nil, "ORG-Ohng0", "Errors.Org.Hierarchy.MaxDepthExceeded")
+		}
+		ancestor, err := c.getOrgParentWriteModelByID(ctx, ancestorID)
+		if err != nil {
+			return err
+		}
+		if ancestorID == parentOrgID && !isOrgStateExists(ancestor.State) {
+			return zerrors.ThrowNotFound(nil, "ORG-Ju4ae", "Errors.Org.Hierarchy.ParentNotFound")
+		}
+		if ancestor.ParentOrgID == orgID {
+			return zerrors.ThrowPreconditionFailed(nil, "ORG-zoo3E", "Errors.Org.Hierarchy.Cycle")
+		}
+		ancestorID = ancestor.ParentOrgID
+	}
+	return nil
+}
+
+func (c *Commands) getOrgParentWriteModelByID(ctx context.Context, orgID string) (_ *OrgParentWriteModel, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel := NewOrgParentWriteModel(orgID)
+	err = c.eventstore.FilterToQueryReducer(ctx, writeModel)
+	if err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
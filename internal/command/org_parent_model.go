@@ -0,0 +1,64 @@
+package command
+
+import (
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/org"
+)
+
+type OrgParentWriteModel struct {
+	eventstore.WriteModel
+
+	State                  domain.OrgState
+	ParentOrgID            string
+	DelegateAdministration bool
+}
+
+func NewOrgParentWriteModel(orgID string) *OrgParentWriteModel {
+	return &OrgParentWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   orgID,
+			ResourceOwner: orgID,
+		},
+	}
+}
+
+func (wm *OrgParentWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *org.OrgAddedEvent:
+			wm.State = domain.OrgStateActive
+		case *org.OrgDeactivatedEvent:
+			wm.State = domain.OrgStateInactive
+		case *org.OrgReactivatedEvent:
+			wm.State = domain.OrgStateActive
+		case *org.OrgRemovedEvent:
+			wm.State = domain.OrgStateRemoved
+			wm.ParentOrgID = ""
+			wm.DelegateAdministration = false
+		case *org.OrgParentSetEvent:
+			wm.ParentOrgID = e.ParentOrgID
+			wm.DelegateAdministration = e.DelegateAdministration
+		case *org.OrgParentRemovedEvent:
+			wm.ParentOrgID = ""
+			wm.DelegateAdministration = false
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *OrgParentWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(org.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			org.OrgAddedEventType,
+			org.OrgDeactivatedEventType,
+			org.OrgReactivatedEventType,
+			org.OrgRemovedEventType,
+			org.OrgParentSetEventType,
+			org.OrgParentRemovedEventType).
+		Builder()
+}
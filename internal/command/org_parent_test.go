@@ -0,0 +1,332 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func orgParentTestOrgAddedEvent(orgID string) eventstore.Event {
+	return eventFromEventPusher(
+		org.NewOrgAddedEvent(context.Background(),
+			&org.NewAggregate(orgID).Aggregate,
+			orgID,
+		),
+	)
+}
+
+func orgParentTestParentSetEvent(orgID, parentOrgID string, delegateAdministration bool) eventstore.Event {
+	return eventFromEventPusher(
+		org.NewOrgParentSetEvent(context.Background(),
+			&org.NewAggregate(orgID).Aggregate,
+			parentOrgID,
+			delegateAdministration,
+		),
+	)
+}
+
+// orgParentTestAncestors expects the filters of a hierarchy of ancestors
+// starting at org2 with a depth of the given count.
+func orgParentTestAncestors(count int) []expect {
+	expects := make([]expect, count)
+	for i := range expects {
+		orgID := fmt.Sprintf("org%d", i+2)
+		expects[i] = expectFilter(
+			orgParentTestOrgAddedEvent(orgID),
+			orgParentTestParentSetEvent(orgID, fmt.Sprintf("org%d", i+3), false),
+		)
+	}
+	return expects
+}
+
+func TestCommands_SetOrgParent(t *testing.T) {
+	ctx := authz.WithInstanceID(context.Background(), "instance1")
+	type args struct {
+		orgID                  string
+		parentOrgID            string
+		delegateAdministration bool
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  error
+	}
+	tests := []struct {
+		name            string
+		eventstore      func(t *testing.T) *eventstore.Eventstore
+		checkPermission domain.PermissionCheck
+		args            args
+		res             res
+	}{
+		{
+			name:            "missing parent, error",
+			eventstore:      expectEventstore(),
+			checkPermission: newMockPermissionCheckAllowed(),
+			args: args{
+				orgID: "org1",
+			},
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "ORG-Ohr7a", "Errors.IDMissing"),
+			},
+		},
+		{
+			name:            "own parent, error",
+			eventstore:      expectEventstore(),
+			checkPermission: newMockPermissionCheckAllowed(),
+			args: args{
+				orgID:       "org1",
+				parentOrgID: "org1",
+			},
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "ORG-eiX3u", "Errors.Org.Hierarchy.Cycle"),
+			},
+		},
+		{
+			name: "org not found, error",
+			eventstore: expectEventstore(
+				expectFilter(),
+			),
+			checkPermission: newMockPermissionCheckAllowed(),
+			args: args{
+				orgID:       "org1",
+				parentOrgID: "org2",
+			},
+			res: res{
+				err: zerrors.ThrowNotFound(nil, "ORG-Aec1o", "Errors.Org.NotFound"),
+			},
+		},
+		{
+			name: "no changes, error",
+			eventstore: expectEventstore(
+				expectFilter(
+					orgParentTestOrgAddedEvent("org1"),
+					orgParentTestParentSetEvent("org1", "org2", true),
+				),
+			),
+			checkPermission: newMockPermissionCheckAllowed(),
+			args: args{
+				orgID:                  "org1",
+				parentOrgID:            "org2",
+				delegateAdministration: true,
+			},
+			res: res{
+				err: zerrors.ThrowPreconditionFailed(nil, "ORG-Ahf5o", "Errors.NoChangesFound"),
+			},
+		},
+		{
+			name: "no permission on parent, error",
+			eventstore: expectEventstore(
+				expectFilter(
+					orgParentTestOrgAddedEvent("org1"),
+				),
+			),
+			checkPermission: newMockPermissionCheckNotAllowed(),
+			args: args{
+				orgID:       "org1",
+				parentOrgID: "org2",
+			},
+			res: res{
+				err: zerrors.ThrowPermissionDenied(nil, "AUTHZ-HKJD33", "Errors.PermissionDenied"),
+			},
+		},
+		{
+			name: "parent not found, error",
+			eventstore: expectEventstore(
+				expectFilter(
+					orgParentTestOrgAddedEvent("org1"),
+				),
+				expectFilter(),
+			),
+			checkPermission: newMockPermissionCheckAllowed(),
+			args: args{
+				orgID:       "org1",
+				parentOrgID: "org2",
+			},
+			res: res{
+				err: zerrors.ThrowNotFound(nil, "ORG-Ju4ae", "Errors.Org.Hierarchy.ParentNotFound"),
+			},
+		},
+		{
+			name: "org is ancestor of parent, error",
+			eventstore: expectEventstore(
+				expectFilter(
+					orgParentTestOrgAddedEvent("org1"),
+				),
+				expectFilter(
+					orgParentTestOrgAddedEvent("org2"),
+					orgParentTestParentSetEvent("org2", "org3", false),
+				),
+				expectFilter(
+					orgParentTestOrgAddedEvent("org3"),
+					orgParentTestParentSetEvent("org3", "org1", false),
+				),
+			),
+			checkPermission: newMockPermissionCheckAllowed(),
+			args: args{
+				orgID:       "org1",
+				parentOrgID: "org2",
+			},
+			res: res{
+				err: zerrors.ThrowPreconditionFailed(nil, "ORG-zoo3E", "Errors.Org.Hierarchy.Cycle"),
+			},
+		},
+		{
+			name: "hierarchy too deep, error",
+			eventstore: expectEventstore(
+				append(
+					[]expect{
+						expectFilter(
+							orgParentTestOrgAddedEvent("org1"),
+						),
+					},
+					orgParentTestAncestors(domain.OrgHierarchyMaxDepth)...,
+				)...,
+			),
+			checkPermission: newMockPermissionCheckAllowed(),
+			args: args{
+				orgID:       "org1",
+				parentOrgID: "org2",
+			},
+			res: res{
+				err: zerrors.ThrowPreconditionFailed(nil, "ORG-Ohng0", "Errors.Org.Hierarchy.MaxDepthExceeded"),
+			},
+		},
+		{
+			name: "set, ok",
+			eventstore: expectEventstore(
+				expectFilter(
+					orgParentTestOrgAddedEvent("org1"),
+				),
+				expectFilter(
+					orgParentTestOrgAddedEvent("org2"),
+					orgParentTestParentSetEvent("org2", "org3", true),
+				),
+				expectFilter(
+					orgParentTestOrgAddedEvent("org3"),
+				),
+				expectPush(
+					org.NewOrgParentSetEvent(ctx,
+						&org.NewAggregate("org1").Aggregate,
+						"org2",
+						true,
+					),
+				),
+			),
+			checkPermission: newMockPermissionCheckAllowed(),
+			args: args{
+				orgID:                  "org1",
+				parentOrgID:            "org2",
+				delegateAdministration: true,
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:      tt.eventstore(t),
+				checkPermission: tt.checkPermission,
+			}
+			got, err := c.SetOrgParent(ctx, tt.args.orgID, tt.args.parentOrgID, tt.args.delegateAdministration)
+			assert.ErrorIs(t, err, tt.res.err)
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommands_RemoveOrgParent(t *testing.T) {
+	ctx := authz.WithInstanceID(context.Background(), "instance1")
+	type res struct {
+		want *domain.ObjectDetails
+		err  error
+	}
+	tests := []struct {
+		name       string
+		eventstore func(t *testing.T) *eventstore.Eventstore
+		orgID      string
+		res        res
+	}{
+		{
+			name:       "missing id, error",
+			eventstore: expectEventstore(),
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "ORG-ooS9d", "Errors.IDMissing"),
+			},
+		},
+		{
+			name: "org not found, error",
+			eventstore: expectEventstore(
+				expectFilter(),
+			),
+			orgID: "org1",
+			res: res{
+				err: zerrors.ThrowNotFound(nil, "ORG-Ieng4", "Errors.Org.NotFound"),
+			},
+		},
+		{
+			name: "no parent, error",
+			eventstore: expectEventstore(
+				expectFilter(
+					orgParentTestOrgAddedEvent("org1"),
+					orgParentTestParentSetEvent("org1", "org2", false),
+					eventFromEventPusher(
+						org.NewOrgParentRemovedEvent(context.Background(),
+							&org.NewAggregate("org1").Aggregate,
+							"org2",
+						),
+					),
+				),
+			),
+			orgID: "org1",
+			res: res{
+				err: zerrors.ThrowPreconditionFailed(nil, "ORG-vah4E", "Errors.Org.Hierarchy.NoParent"),
+			},
+		},
+		{
+			name: "remove, ok",
+			eventstore: expectEventstore(
+				expectFilter(
+					orgParentTestOrgAddedEvent("org1"),
+					orgParentTestParentSetEvent("org1", "org2", true),
+				),
+				expectPush(
+					org.NewOrgParentRemovedEvent(ctx,
+						&org.NewAggregate("org1").Aggregate,
+						"org2",
+					),
+				),
+			),
+			orgID: "org1",
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.eventstore(t),
+			}
+			got, err := c.RemoveOrgParent(ctx, tt.orgID)
+			assert.ErrorIs(t, err, tt.res.err)
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.want, got)
+			}
+		})
+	}
+}
@@ -62,6 +62,8 @@ func TestCommandSide_AddPasswordAgePolicy(t *testing.T) {
 								&org.NewAggregate("org1").Aggregate,
 								365,
 								10,
+								0,
+								0,
 							),
 						),
 					),
@@ -90,6 +92,8 @@ func TestCommandSide_AddPasswordAgePolicy(t *testing.T) {
 							&org.NewAggregate("org1").Aggregate,
 							10,
 							365,
+							0,
+							0,
 						),
 					),
 				),
@@ -201,6 +205,8 @@ func TestCommandSide_ChangePasswordAgePolicy(t *testing.T) {
 								&org.NewAggregate("org1").Aggregate,
 								10,
 								365,
+								0,
+								0,
 							),
 						),
 					),
@@ -229,6 +235,8 @@ func TestCommandSide_ChangePasswordAgePolicy(t *testing.T) {
 								&org.NewAggregate("org1").Aggregate,
 								10,
 								365,
+								0,
+								0,
 							),
 						),
 					),
@@ -335,6 +343,8 @@ func TestCommandSide_RemovePasswordAgePolicy(t *testing.T) {
 								&org.NewAggregate("org1").Aggregate,
 								10,
 								365,
+								0,
+								0,
 							),
 						),
 					),
@@ -6,6 +6,7 @@ import (
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/org"
 	"github.com/zitadel/zitadel/internal/repository/project"
 	"github.com/zitadel/zitadel/internal/v2/user"
 	"github.com/zitadel/zitadel/internal/zerrors"
@@ -60,6 +61,12 @@ func (c *Commands) checkPermissionUpdateUserCredentials(ctx context.Context, res
 	return c.checkPermissionOnUser(ctx, domain.PermissionUserCredentialWrite)(resourceOwner, userID)
 }
 
+// checkPermissionWriteOrg checks the permission to write the organization.
+// Members of an ancestor organization delegating its administration are granted the permission as well.
+func (c *Commands) checkPermissionWriteOrg(ctx context.Context, orgID string) error {
+	return c.newPermissionCheck(ctx, domain.PermissionOrgWrite, org.AggregateType)(orgID, orgID)
+}
+
 func (c *Commands) checkPermissionDeleteProject(ctx context.Context, resourceOwner, projectID string) error {
 	return c.newPermissionCheck(ctx, domain.PermissionProjectDelete, project.AggregateType)(resourceOwner, projectID)
 }
@@ -71,3 +78,12 @@ func (c *Commands) checkPermissionUpdateProject(ctx context.Context, resourceOwn
 func (c *Commands) checkPermissionWriteProjectGrant(ctx context.Context, resourceOwner, projectGrantID string) error {
 	return c.newPermissionCheck(ctx, domain.PermissionProjectGrantWrite, project.AggregateType)(resourceOwner, projectGrantID)
 }
+
+// checkPermissionApproveAccessRequest checks the permission to approve requests for roles of the project,
+// respectively of the project grant if the roles were requested on a granted project.
+func (c *Commands) checkPermissionApproveAccessRequest(ctx context.Context, resourceOwner, projectID, projectGrantID string) error {
+	if projectGrantID != "" {
+		return c.checkPermission(ctx, domain.PermissionUserGrantApprove, resourceOwner, projectGrantID)
+	}
+	return c.checkPermission(ctx, domain.PermissionUserGrantApprove, resourceOwner, projectID)
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	http_util "github.com/zitadel/zitadel/internal/api/http"
+	"github.com/zitadel/zitadel/internal/api/mtls"
 	"github.com/zitadel/zitadel/internal/command/preparation"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
@@ -16,24 +17,33 @@ import (
 
 type addOIDCApp struct {
 	AddApp
-	Version                     domain.OIDCVersion
-	RedirectUris                []string
-	ResponseTypes               []domain.OIDCResponseType
-	GrantTypes                  []domain.OIDCGrantType
-	ApplicationType             domain.OIDCApplicationType
-	AuthMethodType              domain.OIDCAuthMethodType
-	PostLogoutRedirectUris      []string
-	DevMode                     bool
-	AccessTokenType             domain.OIDCTokenType
-	AccessTokenRoleAssertion    bool
-	IDTokenRoleAssertion        bool
-	IDTokenUserinfoAssertion    bool
-	ClockSkew                   time.Duration
-	AdditionalOrigins           []string
-	SkipSuccessPageForNativeApp bool
-	BackChannelLogoutURI        string
-	LoginVersion                domain.LoginVersion
-	LoginBaseURI                string
+	Version                               domain.OIDCVersion
+	RedirectUris                          []string
+	ResponseTypes                         []domain.OIDCResponseType
+	GrantTypes                            []domain.OIDCGrantType
+	ApplicationType                       domain.OIDCApplicationType
+	AuthMethodType                        domain.OIDCAuthMethodType
+	PostLogoutRedirectUris                []string
+	DevMode                               bool
+	AccessTokenType                       domain.OIDCTokenType
+	AccessTokenRoleAssertion              bool
+	IDTokenRoleAssertion                  bool
+	IDTokenUserinfoAssertion              bool
+	ClockSkew                             time.Duration
+	AdditionalOrigins                     []string
+	SkipSuccessPageForNativeApp           bool
+	BackChannelLogoutURI                  string
+	LoginVersion                          domain.LoginVersion
+	LoginBaseURI                          string
+	DPoPBoundAccessTokens                 bool
+	RequirePushedAuthRequests             bool
+	CIBANotificationURI                   string
+	ConsentRequired                       bool
+	TLSClientAuthSubjectDN                string
+	TLSClientCertificates                 []string
+	TLSClientCertificateBoundAccessTokens bool
+	RefreshTokenRotation                  domain.OIDCRefreshTokenRotation
+	EncryptAuthorizationResponse          bool
 
 	ClientID          string
 	ClientSecret      string
@@ -65,6 +75,10 @@ func (c *Commands) AddOIDCAppCommand(app *addOIDCApp) preparation.Validation {
 			return nil, zerrors.ThrowInvalidArgument(nil, "V2-sLpW1", "Errors.Invalid.Argument")
 		}
 
+		if err := validateOIDCTLSClientAuth(app.AuthMethodType, app.TLSClientAuthSubjectDN, app.TLSClientCertificates); err != nil {
+			return nil, err
+		}
+
 		return func(ctx context.Context, filter preparation.FilterToQueryReducer) (_ []eventstore.Command, err error) {
 			project, err := projectWriteModel(ctx, filter, app.Aggregate.ID, app.Aggregate.ResourceOwner)
 			if err != nil || !project.State.Valid() {
@@ -114,6 +128,15 @@ func (c *Commands) AddOIDCAppCommand(app *addOIDCApp) preparation.Validation {
 					app.BackChannelLogoutURI,
 					app.LoginVersion,
 					app.LoginBaseURI,
+					app.DPoPBoundAccessTokens,
+					app.RequirePushedAuthRequests,
+					app.CIBANotificationURI,
+					app.ConsentRequired,
+					strings.TrimSpace(app.TLSClientAuthSubjectDN),
+					app.TLSClientCertificates,
+					app.TLSClientCertificateBoundAccessTokens,
+					app.RefreshTokenRotation,
+					app.EncryptAuthorizationResponse,
 				),
 			}, nil
 		}, nil
@@ -148,6 +171,9 @@ func (c *Commands) AddOIDCApplication(ctx context.Context, oidcApp *domain.OIDCA
 	if oidcApp.AppName == "" || !oidcApp.IsValid() {
 		return nil, zerrors.ThrowInvalidArgument(nil, "PROJECT-1n8df", "Errors.Project.App.Invalid")
 	}
+	if err := validateOIDCTLSClientAuth(oidcApp.AuthMethodType, oidcApp.TLSClientAuthSubjectDN, oidcApp.TLSClientCertificates); err != nil {
+		return nil, err
+	}
 
 	appID, err := c.idGenerator.Next()
 	if err != nil {
@@ -157,7 +183,8 @@ func (c *Commands) AddOIDCApplication(ctx context.Context, oidcApp *domain.OIDCA
 	return c.addOIDCApplicationWithID(ctx, oidcApp, resourceOwner, appID)
 }
 
-func (c *Commands) addOIDCApplicationWithID(ctx context.Context, oidcApp *domain.OIDCApp, resourceOwner string, appID string) (_ *domain.OIDCApp, err error) {
+// addOIDCApplicationWithID pushes the application together with the additionalEvents.
+func (c *Commands) addOIDCApplicationWithID(ctx context.Context, oidcApp *domain.OIDCApp, resourceOwner string, appID string, additionalEvents ...eventstore.Command) (_ *domain.OIDCApp, err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
@@ -204,7 +231,17 @@ func (c *Commands) addOIDCApplicationWithID(ctx context.Context, oidcApp *domain
 		strings.TrimSpace(oidcApp.BackChannelLogoutURI),
 		oidcApp.LoginVersion,
 		strings.TrimSpace(oidcApp.LoginBaseURI),
+		oidcApp.DPoPBoundAccessTokens,
+		oidcApp.RequirePushedAuthRequests,
+		oidcApp.CIBANotificationURI,
+		oidcApp.ConsentRequired,
+		strings.TrimSpace(oidcApp.TLSClientAuthSubjectDN),
+		oidcApp.TLSClientCertificates,
+		oidcApp.TLSClientCertificateBoundAccessTokens,
+		oidcApp.RefreshTokenRotation,
+		oidcApp.EncryptAuthorizationResponse,
 	))
+	events = append(events, additionalEvents...)
 
 	addedApplication.AppID = oidcApp.AppID
 	postCommit, err := c.applicationCreatedMilestone(ctx, &events)
@@ -241,6 +278,9 @@ func (c *Commands) ChangeOIDCApplication(ctx context.Context, oidc *domain.OIDCA
 	if !existingOIDC.IsOIDC() {
 		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-GBr34", "Errors.Project.App.IsNotOIDC")
 	}
+	if err := validateOIDCTLSClientAuth(oidc.AuthMethodType, oidc.TLSClientAuthSubjectDN, oidc.TLSClientCertificates); err != nil {
+		return nil, err
+	}
 	projectAgg := ProjectAggregateFromWriteModel(&existingOIDC.WriteModel)
 	changedEvent, hasChanged, err := existingOIDC.NewChangedEvent(
 		ctx,
@@ -264,6 +304,15 @@ func (c *Commands) ChangeOIDCApplication(ctx context.Context, oidc *domain.OIDCA
 		strings.TrimSpace(oidc.BackChannelLogoutURI),
 		oidc.LoginVersion,
 		strings.TrimSpace(oidc.LoginBaseURI),
+		oidc.DPoPBoundAccessTokens,
+		oidc.RequirePushedAuthRequests,
+		oidc.CIBANotificationURI,
+		oidc.ConsentRequired,
+		strings.TrimSpace(oidc.TLSClientAuthSubjectDN),
+		oidc.TLSClientCertificates,
+		oidc.TLSClientCertificateBoundAccessTokens,
+		oidc.RefreshTokenRotation,
+		oidc.EncryptAuthorizationResponse,
 	)
 	if err != nil {
 		return nil, err
@@ -394,3 +443,24 @@ func trimStringSliceWhiteSpaces(slice []string) []string {
 func (c *Commands) oidcUpdateSecret(ctx context.Context, agg *eventstore.Aggregate, appID, updated string) {
 	c.asyncPush(ctx, project_repo.NewOIDCConfigSecretHashUpdatedEvent(ctx, agg, appID, updated))
 }
+
+// validateOIDCTLSClientAuth ensures the mutual-TLS client authentication methods (RFC 8705) are configured:
+// tls_client_auth requires the expected subject DN, self_signed_tls_client_auth at least one registered certificate.
+func validateOIDCTLSClientAuth(authMethod domain.OIDCAuthMethodType, subjectDN string, certificates []string) error {
+	switch authMethod {
+	case domain.OIDCAuthMethodTypeTLSClientAuth:
+		if strings.TrimSpace(subjectDN) == "" {
+			return zerrors.ThrowInvalidArgument(nil, "COMMAND-Ohx3a", "Errors.Project.App.TLSClientAuthSubjectDNMissing")
+		}
+	case domain.OIDCAuthMethodTypeSelfSignedTLSClientAuth:
+		if len(certificates) == 0 {
+			return zerrors.ThrowInvalidArgument(nil, "COMMAND-ieP4o", "Errors.Project.App.TLSClientCertificatesInvalid")
+		}
+	}
+	for _, certificate := range certificates {
+		if _, err := mtls.ParseCertificates(certificate); err != nil {
+			return zerrors.ThrowInvalidArgument(err, "COMMAND-Zai9u", "Errors.Project.App.TLSClientCertificatesInvalid")
+		}
+	}
+	return nil
+}
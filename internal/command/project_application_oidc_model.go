@@ -14,32 +14,42 @@ import (
 type OIDCApplicationWriteModel struct {
 	eventstore.WriteModel
 
-	AppID                    string
-	AppName                  string
-	ClientID                 string
-	HashedSecret             string
-	ClientSecretString       string
-	RedirectUris             []string
-	ResponseTypes            []domain.OIDCResponseType
-	GrantTypes               []domain.OIDCGrantType
-	ApplicationType          domain.OIDCApplicationType
-	AuthMethodType           domain.OIDCAuthMethodType
-	PostLogoutRedirectUris   []string
-	OIDCVersion              domain.OIDCVersion
-	Compliance               *domain.Compliance
-	DevMode                  bool
-	AccessTokenType          domain.OIDCTokenType
-	AccessTokenRoleAssertion bool
-	IDTokenRoleAssertion     bool
-	IDTokenUserinfoAssertion bool
-	ClockSkew                time.Duration
-	State                    domain.AppState
-	AdditionalOrigins        []string
-	SkipNativeAppSuccessPage bool
-	BackChannelLogoutURI     string
-	LoginVersion             domain.LoginVersion
-	LoginBaseURI             string
-	oidc                     bool
+	AppID                                 string
+	AppName                               string
+	ClientID                              string
+	HashedSecret                          string
+	ClientSecretString                    string
+	RedirectUris                          []string
+	ResponseTypes                         []domain.OIDCResponseType
+	GrantTypes                            []domain.OIDCGrantType
+	ApplicationType                       domain.OIDCApplicationType
+	AuthMethodType                        domain.OIDCAuthMethodType
+	PostLogoutRedirectUris                []string
+	OIDCVersion                           domain.OIDCVersion
+	Compliance                            *domain.Compliance
+	DevMode                               bool
+	AccessTokenType                       domain.OIDCTokenType
+	AccessTokenRoleAssertion              bool
+	IDTokenRoleAssertion                  bool
+	IDTokenUserinfoAssertion              bool
+	ClockSkew                             time.Duration
+	State                                 domain.AppState
+	AdditionalOrigins                     []string
+	SkipNativeAppSuccessPage              bool
+	BackChannelLogoutURI                  string
+	LoginVersion                          domain.LoginVersion
+	LoginBaseURI                          string
+	DPoPBoundAccessTokens                 bool
+	RequirePushedAuthRequests             bool
+	CIBANotificationURI                   string
+	ConsentRequired                       bool
+	TLSClientAuthSubjectDN                string
+	TLSClientCertificates                 []string
+	TLSClientCertificateBoundAccessTokens bool
+	RefreshTokenRotation                  domain.OIDCRefreshTokenRotation
+	EncryptAuthorizationResponse          bool
+	HashedRegistrationAccessToken         string
+	oidc                                  bool
 }
 
 func NewOIDCApplicationWriteModelWithAppID(projectID, appID, resourceOwner string) *OIDCApplicationWriteModel {
@@ -108,6 +118,11 @@ func (wm *OIDCApplicationWriteModel) AppendEvents(events ...eventstore.Event) {
 				continue
 			}
 			wm.WriteModel.AppendEvents(e)
+		case *project.OIDCConfigRegistrationAccessTokenSetEvent:
+			if e.AppID != wm.AppID {
+				continue
+			}
+			wm.WriteModel.AppendEvents(e)
 		case *project.ProjectRemovedEvent:
 			wm.WriteModel.AppendEvents(e)
 		}
@@ -142,6 +157,8 @@ func (wm *OIDCApplicationWriteModel) Reduce() error {
 			wm.HashedSecret = crypto.SecretOrEncodedHash(e.ClientSecret, e.HashedSecret)
 		case *project.OIDCConfigSecretHashUpdatedEvent:
 			wm.HashedSecret = e.HashedSecret
+		case *project.OIDCConfigRegistrationAccessTokenSetEvent:
+			wm.HashedRegistrationAccessToken = e.HashedToken
 		case *project.ProjectRemovedEvent:
 			wm.State = domain.AppStateRemoved
 		}
@@ -171,6 +188,15 @@ func (wm *OIDCApplicationWriteModel) appendAddOIDCEvent(e *project.OIDCConfigAdd
 	wm.BackChannelLogoutURI = e.BackChannelLogoutURI
 	wm.LoginVersion = e.LoginVersion
 	wm.LoginBaseURI = e.LoginBaseURI
+	wm.DPoPBoundAccessTokens = e.DPoPBoundAccessTokens
+	wm.RequirePushedAuthRequests = e.RequirePushedAuthRequests
+	wm.CIBANotificationURI = e.CIBANotificationURI
+	wm.ConsentRequired = e.ConsentRequired
+	wm.TLSClientAuthSubjectDN = e.TLSClientAuthSubjectDN
+	wm.TLSClientCertificates = e.TLSClientCertificates
+	wm.TLSClientCertificateBoundAccessTokens = e.TLSClientCertificateBoundAccessTokens
+	wm.RefreshTokenRotation = e.RefreshTokenRotation
+	wm.EncryptAuthorizationResponse = e.EncryptAuthorizationResponse
 }
 
 func (wm *OIDCApplicationWriteModel) appendChangeOIDCEvent(e *project.OIDCConfigChangedEvent) {
@@ -228,6 +254,33 @@ func (wm *OIDCApplicationWriteModel) appendChangeOIDCEvent(e *project.OIDCConfig
 	if e.LoginBaseURI != nil {
 		wm.LoginBaseURI = *e.LoginBaseURI
 	}
+	if e.DPoPBoundAccessTokens != nil {
+		wm.DPoPBoundAccessTokens = *e.DPoPBoundAccessTokens
+	}
+	if e.RequirePushedAuthRequests != nil {
+		wm.RequirePushedAuthRequests = *e.RequirePushedAuthRequests
+	}
+	if e.CIBANotificationURI != nil {
+		wm.CIBANotificationURI = *e.CIBANotificationURI
+	}
+	if e.ConsentRequired != nil {
+		wm.ConsentRequired = *e.ConsentRequired
+	}
+	if e.TLSClientAuthSubjectDN != nil {
+		wm.TLSClientAuthSubjectDN = *e.TLSClientAuthSubjectDN
+	}
+	if e.TLSClientCertificates != nil {
+		wm.TLSClientCertificates = *e.TLSClientCertificates
+	}
+	if e.TLSClientCertificateBoundAccessTokens != nil {
+		wm.TLSClientCertificateBoundAccessTokens = *e.TLSClientCertificateBoundAccessTokens
+	}
+	if e.RefreshTokenRotation != nil {
+		wm.RefreshTokenRotation = *e.RefreshTokenRotation
+	}
+	if e.EncryptAuthorizationResponse != nil {
+		wm.EncryptAuthorizationResponse = *e.EncryptAuthorizationResponse
+	}
 }
 
 func (wm *OIDCApplicationWriteModel) Query() *eventstore.SearchQueryBuilder {
@@ -246,6 +299,7 @@ func (wm *OIDCApplicationWriteModel) Query() *eventstore.SearchQueryBuilder {
 			project.OIDCConfigChangedType,
 			project.OIDCConfigSecretChangedType,
 			project.OIDCConfigSecretHashUpdatedType,
+			project.OIDCConfigRegistrationAccessTokenSetType,
 			project.ProjectRemovedType,
 		).Builder()
 }
@@ -272,6 +326,15 @@ func (wm *OIDCApplicationWriteModel) NewChangedEvent(
 	backChannelLogoutURI string,
 	loginVersion domain.LoginVersion,
 	loginBaseURI string,
+	dpopBoundAccessTokens,
+	requirePushedAuthRequests bool,
+	cibaNotificationURI string,
+	consentRequired bool,
+	tlsClientAuthSubjectDN string,
+	tlsClientCertificates []string,
+	tlsClientCertificateBoundAccessTokens bool,
+	refreshTokenRotation domain.OIDCRefreshTokenRotation,
+	encryptAuthorizationResponse bool,
 ) (*project.OIDCConfigChangedEvent, bool, error) {
 	changes := make([]project.OIDCConfigChanges, 0)
 	var err error
@@ -330,6 +393,33 @@ func (wm *OIDCApplicationWriteModel) NewChangedEvent(
 	if wm.LoginBaseURI != loginBaseURI {
 		changes = append(changes, project.ChangeOIDCLoginBaseURI(loginBaseURI))
 	}
+	if wm.DPoPBoundAccessTokens != dpopBoundAccessTokens {
+		changes = append(changes, project.ChangeDPoPBoundAccessTokens(dpopBoundAccessTokens))
+	}
+	if wm.RequirePushedAuthRequests != requirePushedAuthRequests {
+		changes = append(changes, project.ChangeRequirePushedAuthRequests(requirePushedAuthRequests))
+	}
+	if wm.CIBANotificationURI != cibaNotificationURI {
+		changes = append(changes, project.ChangeCIBANotificationURI(cibaNotificationURI))
+	}
+	if wm.ConsentRequired != consentRequired {
+		changes = append(changes, project.ChangeConsentRequired(consentRequired))
+	}
+	if wm.TLSClientAuthSubjectDN != tlsClientAuthSubjectDN {
+		changes = append(changes, project.ChangeTLSClientAuthSubjectDN(tlsClientAuthSubjectDN))
+	}
+	if !slices.Equal(wm.TLSClientCertificates, tlsClientCertificates) {
+		changes = append(changes, project.ChangeTLSClientCertificates(tlsClientCertificates))
+	}
+	if wm.TLSClientCertificateBoundAccessTokens != tlsClientCertificateBoundAccessTokens {
+		changes = append(changes, project.ChangeTLSClientCertificateBoundAccessTokens(tlsClientCertificateBoundAccessTokens))
+	}
+	if wm.RefreshTokenRotation != refreshTokenRotation {
+		changes = append(changes, project.ChangeRefreshTokenRotation(refreshTokenRotation))
+	}
+	if wm.EncryptAuthorizationResponse != encryptAuthorizationResponse {
+		changes = append(changes, project.ChangeEncryptAuthorizationResponse(encryptAuthorizationResponse))
+	}
 
 	if len(changes) == 0 {
 		return nil, false, nil
@@ -178,6 +178,15 @@ func TestAddOIDCApp(t *testing.T) {
 						"",
 						domain.LoginVersionUnspecified,
 						"",
+						false,
+						false,
+						"",
+						false,
+						"",
+						nil,
+						false,
+						domain.OIDCRefreshTokenRotationDefault,
+						false,
 					),
 				},
 			},
@@ -246,6 +255,15 @@ func TestAddOIDCApp(t *testing.T) {
 						"",
 						domain.LoginVersionUnspecified,
 						"",
+						false,
+						false,
+						"",
+						false,
+						"",
+						nil,
+						false,
+						domain.OIDCRefreshTokenRotationDefault,
+						false,
 					),
 				},
 			},
@@ -314,6 +332,15 @@ func TestAddOIDCApp(t *testing.T) {
 						"",
 						domain.LoginVersionUnspecified,
 						"",
+						false,
+						false,
+						"",
+						false,
+						"",
+						nil,
+						false,
+						domain.OIDCRefreshTokenRotationDefault,
+						false,
 					),
 				},
 			},
@@ -382,6 +409,15 @@ func TestAddOIDCApp(t *testing.T) {
 						"",
 						domain.LoginVersionUnspecified,
 						"",
+						false,
+						false,
+						"",
+						false,
+						"",
+						nil,
+						false,
+						domain.OIDCRefreshTokenRotationDefault,
+						false,
 					),
 				},
 			},
@@ -490,6 +526,76 @@ func TestCommandSide_AddOIDCApplication(t *testing.T) {
 				err: zerrors.IsErrorInvalidArgument,
 			},
 		},
+		{
+			name: "tls client auth without subject dn, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							project.NewProjectAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"project", true, true, true,
+								domain.PrivateLabelingSettingUnspecified),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "instanceID"),
+				oidcApp: &domain.OIDCApp{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID: "project1",
+					},
+					AppName:                "app",
+					AuthMethodType:         domain.OIDCAuthMethodTypeTLSClientAuth,
+					OIDCVersion:            domain.OIDCVersionV1,
+					RedirectUris:           []string{"https://test.ch"},
+					ResponseTypes:          []domain.OIDCResponseType{domain.OIDCResponseTypeCode},
+					GrantTypes:             []domain.OIDCGrantType{domain.OIDCGrantTypeAuthorizationCode},
+					ApplicationType:        domain.OIDCApplicationTypeWeb,
+					TLSClientAuthSubjectDN: " ",
+				},
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "self signed tls client auth with invalid certificate, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							project.NewProjectAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"project", true, true, true,
+								domain.PrivateLabelingSettingUnspecified),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "instanceID"),
+				oidcApp: &domain.OIDCApp{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID: "project1",
+					},
+					AppName:               "app",
+					AuthMethodType:        domain.OIDCAuthMethodTypeSelfSignedTLSClientAuth,
+					OIDCVersion:           domain.OIDCVersionV1,
+					RedirectUris:          []string{"https://test.ch"},
+					ResponseTypes:         []domain.OIDCResponseType{domain.OIDCResponseTypeCode},
+					GrantTypes:            []domain.OIDCGrantType{domain.OIDCGrantTypeAuthorizationCode},
+					ApplicationType:       domain.OIDCApplicationTypeWeb,
+					TLSClientCertificates: []string{"not a certificate"},
+				},
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
 		{
 			name: "create oidc app basic using whitespaces in uris, ok",
 			fields: fields{
@@ -531,6 +637,15 @@ func TestCommandSide_AddOIDCApplication(t *testing.T) {
 							"https://test.ch/backchannel",
 							domain.LoginVersion2,
 							"https://login.test.ch",
+							false,
+							false,
+							"",
+							false,
+							"",
+							nil,
+							false,
+							domain.OIDCRefreshTokenRotationDefault,
+							false,
 						),
 					),
 				),
@@ -638,6 +753,15 @@ func TestCommandSide_AddOIDCApplication(t *testing.T) {
 							"https://test.ch/backchannel",
 							domain.LoginVersion2,
 							"https://login.test.ch",
+							false,
+							false,
+							"",
+							false,
+							"",
+							nil,
+							false,
+							domain.OIDCRefreshTokenRotationDefault,
+							false,
 						),
 					),
 				),
@@ -872,6 +996,15 @@ func TestCommandSide_ChangeOIDCApplication(t *testing.T) {
 								"https://test.ch/backchannel",
 								domain.LoginVersion2,
 								"https://login.test.ch",
+								false,
+								false,
+								"",
+								false,
+								"",
+								nil,
+								false,
+								domain.OIDCRefreshTokenRotationDefault,
+								false,
 							),
 						),
 					),
@@ -946,6 +1079,15 @@ func TestCommandSide_ChangeOIDCApplication(t *testing.T) {
 								"https://test.ch/backchannel",
 								domain.LoginVersion2,
 								"https://login.test.ch",
+								false,
+								false,
+								"",
+								false,
+								"",
+								nil,
+								false,
+								domain.OIDCRefreshTokenRotationDefault,
+								false,
 							),
 						),
 					),
@@ -1020,6 +1162,15 @@ func TestCommandSide_ChangeOIDCApplication(t *testing.T) {
 								"https://test.ch/backchannel",
 								domain.LoginVersion1,
 								"",
+								false,
+								false,
+								"",
+								false,
+								"",
+								nil,
+								false,
+								domain.OIDCRefreshTokenRotationDefault,
+								false,
 							),
 						),
 					),
@@ -1214,6 +1365,15 @@ func TestCommandSide_ChangeOIDCApplicationSecret(t *testing.T) {
 								"",
 								domain.LoginVersionUnspecified,
 								"",
+								false,
+								false,
+								"",
+								false,
+								"",
+								nil,
+								false,
+								domain.OIDCRefreshTokenRotationDefault,
+								false,
 							),
 						),
 					),
@@ -1378,6 +1538,15 @@ func TestCommands_VerifyOIDCClientSecret(t *testing.T) {
 							"",
 							domain.LoginVersionUnspecified,
 							"",
+							false,
+							false,
+							"",
+							false,
+							"",
+							nil,
+							false,
+							domain.OIDCRefreshTokenRotationDefault,
+							false,
 						),
 					),
 				),
@@ -1416,6 +1585,15 @@ func TestCommands_VerifyOIDCClientSecret(t *testing.T) {
 							"",
 							domain.LoginVersionUnspecified,
 							"",
+							false,
+							false,
+							"",
+							false,
+							"",
+							nil,
+							false,
+							domain.OIDCRefreshTokenRotationDefault,
+							false,
 						),
 					),
 				),
@@ -1453,6 +1631,15 @@ func TestCommands_VerifyOIDCClientSecret(t *testing.T) {
 							"",
 							domain.LoginVersionUnspecified,
 							"",
+							false,
+							false,
+							"",
+							false,
+							"",
+							nil,
+							false,
+							domain.OIDCRefreshTokenRotationDefault,
+							false,
 						),
 					),
 				),
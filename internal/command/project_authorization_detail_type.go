@@ -0,0 +1,103 @@
+package command
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	domain_schema "github.com/zitadel/zitadel/internal/domain/schema"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// SetProjectAuthorizationDetailType is the authorization details type (RFC 9396) clients can request for the project.
+// The Schema is a JSON schema the requested authorization details of the type are validated against.
+type SetProjectAuthorizationDetailType struct {
+	ProjectID     string
+	ResourceOwner string
+	Type          string
+	Schema        json.RawMessage
+}
+
+func (s *SetProjectAuthorizationDetailType) IsValid() error {
+	if s.ProjectID == "" || s.Type == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Adt1q", "Errors.Project.AuthorizationDetailType.Invalid")
+	}
+	if _, err := domain_schema.NewAuthorizationDetailSchema(bytes.NewReader(s.Schema)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// SetProjectAuthorizationDetailType adds the authorization details type to the project or replaces the schema of an existing one.
+func (c *Commands) SetProjectAuthorizationDetailType(ctx context.Context, detailType *SetProjectAuthorizationDetailType) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if err := detailType.IsValid(); err != nil {
+		return nil, err
+	}
+	schema := new(bytes.Buffer)
+	if err := json.Compact(schema, detailType.Schema); err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "COMMAND-Adt2q", "Errors.Project.AuthorizationDetailType.SchemaInvalid")
+	}
+
+	projectResourceOwner, err := c.checkProjectExists(ctx, detailType.ProjectID, detailType.ResourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.checkPermissionUpdateProject(ctx, projectResourceOwner, detailType.ProjectID); err != nil {
+		return nil, err
+	}
+
+	writeModel := NewProjectAuthorizationDetailTypeWriteModel(detailType.ProjectID, projectResourceOwner, detailType.Type)
+	if err := c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	if writeModel.Exists && bytes.Equal(writeModel.Schema, schema.Bytes()) {
+		return writeModelToObjectDetails(&writeModel.WriteModel), nil
+	}
+	return c.pushAppendAndReduceDetails(ctx, writeModel,
+		project.NewAuthorizationDetailTypeSetEvent(
+			ctx,
+			ProjectAggregateFromWriteModelWithCTX(ctx, &writeModel.WriteModel),
+			detailType.Type,
+			schema.Bytes(),
+		),
+	)
+}
+
+// RemoveProjectAuthorizationDetailType removes the authorization details type from the project,
+// so clients can no longer request authorization details of the type.
+func (c *Commands) RemoveProjectAuthorizationDetailType(ctx context.Context, projectID, resourceOwner, detailType string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if projectID == "" || detailType == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Adt3q", "Errors.Project.AuthorizationDetailType.Invalid")
+	}
+	projectResourceOwner, err := c.checkProjectExists(ctx, projectID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.checkPermissionUpdateProject(ctx, projectResourceOwner, projectID); err != nil {
+		return nil, err
+	}
+
+	writeModel := NewProjectAuthorizationDetailTypeWriteModel(projectID, projectResourceOwner, detailType)
+	if err := c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	if !writeModel.Exists {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Adt4q", "Errors.Project.AuthorizationDetailType.NotExisting")
+	}
+	return c.pushAppendAndReduceDetails(ctx, writeModel,
+		project.NewAuthorizationDetailTypeRemovedEvent(
+			ctx,
+			ProjectAggregateFromWriteModelWithCTX(ctx, &writeModel.WriteModel),
+			detailType,
+		),
+	)
+}
@@ -0,0 +1,78 @@
+package command
+
+import (
+	"encoding/json"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/project"
+)
+
+type ProjectAuthorizationDetailTypeWriteModel struct {
+	eventstore.WriteModel
+
+	DetailType string
+	Schema     json.RawMessage
+	Exists     bool
+}
+
+func (wm *ProjectAuthorizationDetailTypeWriteModel) GetWriteModel() *eventstore.WriteModel {
+	return &wm.WriteModel
+}
+
+func NewProjectAuthorizationDetailTypeWriteModel(projectID, resourceOwner, detailType string) *ProjectAuthorizationDetailTypeWriteModel {
+	return &ProjectAuthorizationDetailTypeWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   projectID,
+			ResourceOwner: resourceOwner,
+		},
+		DetailType: detailType,
+	}
+}
+
+func (wm *ProjectAuthorizationDetailTypeWriteModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		switch e := event.(type) {
+		case *project.AuthorizationDetailTypeSetEvent:
+			if e.DetailType == wm.DetailType {
+				wm.WriteModel.AppendEvents(e)
+			}
+		case *project.AuthorizationDetailTypeRemovedEvent:
+			if e.DetailType == wm.DetailType {
+				wm.WriteModel.AppendEvents(e)
+			}
+		case *project.ProjectRemovedEvent:
+			wm.WriteModel.AppendEvents(e)
+		}
+	}
+}
+
+func (wm *ProjectAuthorizationDetailTypeWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *project.AuthorizationDetailTypeSetEvent:
+			wm.Schema = e.Schema
+			wm.Exists = true
+		case *project.AuthorizationDetailTypeRemovedEvent:
+			wm.Schema = nil
+			wm.Exists = false
+		case *project.ProjectRemovedEvent:
+			wm.Schema = nil
+			wm.Exists = false
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *ProjectAuthorizationDetailTypeWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(project.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			project.AuthorizationDetailTypeSetType,
+			project.AuthorizationDetailTypeRemovedType,
+			project.ProjectRemovedType,
+		).
+		Builder()
+}
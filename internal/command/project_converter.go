@@ -27,29 +27,38 @@ func projectGrantWriteModelToProjectGrant(writeModel *ProjectGrantWriteModel) *d
 
 func oidcWriteModelToOIDCConfig(writeModel *OIDCApplicationWriteModel) *domain.OIDCApp {
 	return &domain.OIDCApp{
-		ObjectRoot:               writeModelToObjectRoot(writeModel.WriteModel),
-		AppID:                    writeModel.AppID,
-		AppName:                  writeModel.AppName,
-		State:                    writeModel.State,
-		ClientID:                 writeModel.ClientID,
-		RedirectUris:             writeModel.RedirectUris,
-		ResponseTypes:            writeModel.ResponseTypes,
-		GrantTypes:               writeModel.GrantTypes,
-		ApplicationType:          writeModel.ApplicationType,
-		AuthMethodType:           writeModel.AuthMethodType,
-		PostLogoutRedirectUris:   writeModel.PostLogoutRedirectUris,
-		OIDCVersion:              writeModel.OIDCVersion,
-		DevMode:                  writeModel.DevMode,
-		AccessTokenType:          writeModel.AccessTokenType,
-		AccessTokenRoleAssertion: writeModel.AccessTokenRoleAssertion,
-		IDTokenRoleAssertion:     writeModel.IDTokenRoleAssertion,
-		IDTokenUserinfoAssertion: writeModel.IDTokenUserinfoAssertion,
-		ClockSkew:                writeModel.ClockSkew,
-		AdditionalOrigins:        writeModel.AdditionalOrigins,
-		SkipNativeAppSuccessPage: writeModel.SkipNativeAppSuccessPage,
-		BackChannelLogoutURI:     writeModel.BackChannelLogoutURI,
-		LoginVersion:             writeModel.LoginVersion,
-		LoginBaseURI:             writeModel.LoginBaseURI,
+		ObjectRoot:                            writeModelToObjectRoot(writeModel.WriteModel),
+		AppID:                                 writeModel.AppID,
+		AppName:                               writeModel.AppName,
+		State:                                 writeModel.State,
+		ClientID:                              writeModel.ClientID,
+		RedirectUris:                          writeModel.RedirectUris,
+		ResponseTypes:                         writeModel.ResponseTypes,
+		GrantTypes:                            writeModel.GrantTypes,
+		ApplicationType:                       writeModel.ApplicationType,
+		AuthMethodType:                        writeModel.AuthMethodType,
+		PostLogoutRedirectUris:                writeModel.PostLogoutRedirectUris,
+		OIDCVersion:                           writeModel.OIDCVersion,
+		DevMode:                               writeModel.DevMode,
+		AccessTokenType:                       writeModel.AccessTokenType,
+		AccessTokenRoleAssertion:              writeModel.AccessTokenRoleAssertion,
+		IDTokenRoleAssertion:                  writeModel.IDTokenRoleAssertion,
+		IDTokenUserinfoAssertion:              writeModel.IDTokenUserinfoAssertion,
+		ClockSkew:                             writeModel.ClockSkew,
+		AdditionalOrigins:                     writeModel.AdditionalOrigins,
+		SkipNativeAppSuccessPage:              writeModel.SkipNativeAppSuccessPage,
+		BackChannelLogoutURI:                  writeModel.BackChannelLogoutURI,
+		LoginVersion:                          writeModel.LoginVersion,
+		LoginBaseURI:                          writeModel.LoginBaseURI,
+		DPoPBoundAccessTokens:                 writeModel.DPoPBoundAccessTokens,
+		RequirePushedAuthRequests:             writeModel.RequirePushedAuthRequests,
+		CIBANotificationURI:                   writeModel.CIBANotificationURI,
+		ConsentRequired:                       writeModel.ConsentRequired,
+		TLSClientAuthSubjectDN:                writeModel.TLSClientAuthSubjectDN,
+		TLSClientCertificates:                 writeModel.TLSClientCertificates,
+		TLSClientCertificateBoundAccessTokens: writeModel.TLSClientCertificateBoundAccessTokens,
+		RefreshTokenRotation:                  writeModel.RefreshTokenRotation,
+		EncryptAuthorizationResponse:          writeModel.EncryptAuthorizationResponse,
 	}
 }
 
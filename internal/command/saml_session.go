@@ -14,6 +14,7 @@ import (
 	"github.com/zitadel/zitadel/internal/id"
 	"github.com/zitadel/zitadel/internal/repository/samlrequest"
 	"github.com/zitadel/zitadel/internal/repository/samlsession"
+	"github.com/zitadel/zitadel/internal/repository/sessionlogout"
 	"github.com/zitadel/zitadel/internal/telemetry/tracing"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
@@ -31,9 +32,17 @@ type SAMLSession struct {
 	UserAgent         *domain.UserAgent
 }
 
+// SAMLLogoutSubject identifies the subject of an issued SAML response,
+// so a LogoutRequest can be sent to the service provider on single logout.
+type SAMLLogoutSubject struct {
+	NameID       string
+	NameIDFormat string
+	SessionIndex string
+}
+
 type SAMLRequestComplianceChecker func(context.Context, *SAMLRequestWriteModel) error
 
-func (c *Commands) CreateSAMLSessionFromSAMLRequest(ctx context.Context, samlReqId string, complianceCheck SAMLRequestComplianceChecker, samlResponseID string, samlResponseLifetime time.Duration) (err error) {
+func (c *Commands) CreateSAMLSessionFromSAMLRequest(ctx context.Context, samlReqId string, complianceCheck SAMLRequestComplianceChecker, samlResponseID string, logoutSubject *SAMLLogoutSubject, samlResponseLifetime time.Duration) (err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
@@ -79,6 +88,7 @@ func (c *Commands) CreateSAMLSessionFromSAMLRequest(ctx context.Context, samlReq
 	if err = cmd.AddSAMLResponse(ctx, samlResponseID, samlResponseLifetime); err != nil {
 		return err
 	}
+	cmd.RegisterLogout(ctx, sessionModel.AggregateID, sessionModel.UserID, sessionModel.UserResourceOwner, samlReqModel.Issuer, logoutSubject)
 	cmd.SetSAMLRequestSuccessful(ctx, samlReqModel.aggregate)
 	_, err = cmd.PushEvents(ctx)
 	return err
@@ -160,6 +170,29 @@ func (c *SAMLSessionEvents) AddSAMLResponse(ctx context.Context, id string, life
 	return nil
 }
 
+func (c *SAMLSessionEvents) RegisterLogout(ctx context.Context, sessionID, userID, userResourceOwner, entityID string, subject *SAMLLogoutSubject) {
+	// Without an SSO session there's nothing to log out from
+	// and without a subject the service provider would not be able to identify the session.
+	if sessionID == "" || subject == nil || subject.NameID == "" {
+		return
+	}
+	if !authz.GetFeatures(ctx).EnableBackChannelLogout {
+		return
+	}
+
+	c.events = append(c.events, sessionlogout.NewSAMLLogoutRegisteredEvent(
+		ctx,
+		&sessionlogout.NewAggregate(sessionID, authz.GetInstance(ctx).InstanceID()).Aggregate,
+		c.samlSessionWriteModel.AggregateID,
+		userID,
+		userResourceOwner,
+		entityID,
+		subject.NameID,
+		subject.NameIDFormat,
+		subject.SessionIndex,
+	))
+}
+
 func (c *SAMLSessionEvents) PushEvents(ctx context.Context) (*SAMLSession, error) {
 	pushedEvents, err := c.commands.eventstore.Push(ctx, c.events...)
 	if err != nil {
@@ -49,6 +49,8 @@ func (wm *SAMLSessionWriteModel) Reduce() error {
 			wm.reduceSAMLResponseAdded(e)
 		case *samlsession.SAMLResponseRevokedEvent:
 			wm.reduceSAMLResponseRevoked(e)
+		case *samlsession.TerminateEvent:
+			wm.reduceTerminate()
 		}
 	}
 	return wm.WriteModel.Reduce()
@@ -63,6 +65,7 @@ func (wm *SAMLSessionWriteModel) Query() *eventstore.SearchQueryBuilder {
 			samlsession.AddedType,
 			samlsession.SAMLResponseAddedType,
 			samlsession.SAMLResponseRevokedType,
+			samlsession.TerminateType,
 		).
 		Builder()
 
@@ -100,3 +103,7 @@ func (wm *SAMLSessionWriteModel) reduceSAMLResponseRevoked(e *samlsession.SAMLRe
 	wm.SAMLResponseID = ""
 	wm.SAMLResponseExpiration = e.CreationDate()
 }
+
+func (wm *SAMLSessionWriteModel) reduceTerminate() {
+	wm.State = domain.SAMLSessionStateTerminated
+}
@@ -0,0 +1,236 @@
+package command
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
+	"github.com/zitadel/zitadel/internal/repository/scimconnector"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// AddSCIMConnector configures a downstream SCIM 2.0 service provider,
+// which gets the users granted on the project provisioned.
+type AddSCIMConnector struct {
+	models.ObjectRoot
+
+	ProjectID string
+	Name      string
+	// Endpoint is the base URL of the SCIM service provider, e.g. https://app.example.com/scim/v2
+	Endpoint string
+	// Token is sent as bearer token on every request to the SCIM service provider
+	Token string
+}
+
+func (a *AddSCIMConnector) IsValid() error {
+	if a.ProjectID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Ahx4e", "Errors.IDMissing")
+	}
+	if a.Name == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Ue8oh", "Errors.SCIMConnector.Invalid")
+	}
+	if err := validateSCIMConnectorEndpoint(a.Endpoint); err != nil {
+		return err
+	}
+	if a.Token == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-weeC2", "Errors.SCIMConnector.NoToken")
+	}
+	return nil
+}
+
+func validateSCIMConnectorEndpoint(endpoint string) error {
+	u, err := url.Parse(endpoint)
+	if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+		return zerrors.ThrowInvalidArgument(err, "COMMAND-iePh7", "Errors.SCIMConnector.InvalidURL")
+	}
+	return nil
+}
+
+func (c *Commands) AddSCIMConnector(ctx context.Context, add *AddSCIMConnector, resourceOwner string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if resourceOwner == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ohs3o", "Errors.ResourceOwnerMissing")
+	}
+	if err := add.IsValid(); err != nil {
+		return nil, err
+	}
+	if _, err := c.checkProjectExists(ctx, add.ProjectID, resourceOwner); err != nil {
+		return nil, err
+	}
+	if add.AggregateID == "" {
+		add.AggregateID, err = c.idGenerator.Next()
+		if err != nil {
+			return nil, err
+		}
+	}
+	wm, err := c.getSCIMConnectorWriteModelByID(ctx, add.AggregateID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if wm.State.Exists() {
+		return nil, zerrors.ThrowAlreadyExists(nil, "COMMAND-Ooy8u", "Errors.SCIMConnector.AlreadyExists")
+	}
+	token, err := crypto.Encrypt([]byte(add.Token), c.targetEncryption)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.pushAppendAndReduce(ctx, wm, scimconnector.NewAddedEvent(
+		ctx,
+		SCIMConnectorAggregateFromWriteModel(ctx, &wm.WriteModel),
+		add.ProjectID,
+		add.Name,
+		add.Endpoint,
+		token,
+	)); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&wm.WriteModel), nil
+}
+
+type ChangeSCIMConnector struct {
+	models.ObjectRoot
+
+	ProjectID string
+	Name      *string
+	Endpoint  *string
+	Token     *string
+}
+
+func (a *ChangeSCIMConnector) IsValid() error {
+	if a.AggregateID == "" || a.ProjectID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Thoh5", "Errors.IDMissing")
+	}
+	if a.Name != nil && *a.Name == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Ia2ee", "Errors.SCIMConnector.Invalid")
+	}
+	if a.Endpoint != nil {
+		if err := validateSCIMConnectorEndpoint(*a.Endpoint); err != nil {
+			return err
+		}
+	}
+	if a.Token != nil && *a.Token == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Ahch1", "Errors.SCIMConnector.NoToken")
+	}
+	return nil
+}
+
+func (c *Commands) ChangeSCIMConnector(ctx context.Context, change *ChangeSCIMConnector, resourceOwner string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if resourceOwner == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-ieR7k", "Errors.ResourceOwnerMissing")
+	}
+	if err := change.IsValid(); err != nil {
+		return nil, err
+	}
+	existing, err := c.getSCIMConnectorWriteModelByID(ctx, change.AggregateID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !existing.State.Exists() || existing.ProjectID != change.ProjectID {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Eix2a", "Errors.SCIMConnector.NotFound")
+	}
+	var token *crypto.CryptoValue
+	if change.Token != nil {
+		token, err = crypto.Encrypt([]byte(*change.Token), c.targetEncryption)
+		if err != nil {
+			return nil, err
+		}
+	}
+	changedEvent := existing.NewChangedEvent(
+		ctx,
+		SCIMConnectorAggregateFromWriteModel(ctx, &existing.WriteModel),
+		change.Name,
+		change.Endpoint,
+		token,
+	)
+	if changedEvent == nil {
+		return writeModelToObjectDetails(&existing.WriteModel), nil
+	}
+	if err := c.pushAppendAndReduce(ctx, existing, changedEvent); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existing.WriteModel), nil
+}
+
+func (c *Commands) DeactivateSCIMConnector(ctx context.Context, projectID, id, resourceOwner string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	existing, err := c.projectSCIMConnectorWriteModel(ctx, projectID, id, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if existing.State != domain.SCIMConnectorStateActive {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Gai0o", "Errors.SCIMConnector.NotActive")
+	}
+	if err := c.pushAppendAndReduce(ctx, existing,
+		scimconnector.NewDeactivatedEvent(ctx, SCIMConnectorAggregateFromWriteModel(ctx, &existing.WriteModel)),
+	); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existing.WriteModel), nil
+}
+
+func (c *Commands) ReactivateSCIMConnector(ctx context.Context, projectID, id, resourceOwner string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	existing, err := c.projectSCIMConnectorWriteModel(ctx, projectID, id, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if existing.State != domain.SCIMConnectorStateInactive {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-ooF1u", "Errors.SCIMConnector.NotInactive")
+	}
+	if err := c.pushAppendAndReduce(ctx, existing,
+		scimconnector.NewReactivatedEvent(ctx, SCIMConnectorAggregateFromWriteModel(ctx, &existing.WriteModel)),
+	); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existing.WriteModel), nil
+}
+
+func (c *Commands) RemoveSCIMConnector(ctx context.Context, projectID, id, resourceOwner string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	existing, err := c.projectSCIMConnectorWriteModel(ctx, projectID, id, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.pushAppendAndReduce(ctx, existing,
+		scimconnector.NewRemovedEvent(ctx, SCIMConnectorAggregateFromWriteModel(ctx, &existing.WriteModel)),
+	); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existing.WriteModel), nil
+}
+
+func (c *Commands) projectSCIMConnectorWriteModel(ctx context.Context, projectID, id, resourceOwner string) (*SCIMConnectorWriteModel, error) {
+	if projectID == "" || id == "" || resourceOwner == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Quu7a", "Errors.IDMissing")
+	}
+	existing, err := c.getSCIMConnectorWriteModelByID(ctx, id, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !existing.State.Exists() || existing.ProjectID != projectID {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Ohl8i", "Errors.SCIMConnector.NotFound")
+	}
+	return existing, nil
+}
+
+func (c *Commands) getSCIMConnectorWriteModelByID(ctx context.Context, id, resourceOwner string) (*SCIMConnectorWriteModel, error) {
+	wm := NewSCIMConnectorWriteModel(id, resourceOwner)
+	if err := c.eventstore.FilterToQueryReducer(ctx, wm); err != nil {
+		return nil, err
+	}
+	return wm, nil
+}
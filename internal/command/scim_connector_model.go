@@ -0,0 +1,104 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/scimconnector"
+)
+
+type SCIMConnectorWriteModel struct {
+	eventstore.WriteModel
+
+	ProjectID string
+	Name      string
+	Endpoint  string
+	Token     *crypto.CryptoValue
+
+	State domain.SCIMConnectorState
+}
+
+func NewSCIMConnectorWriteModel(id, resourceOwner string) *SCIMConnectorWriteModel {
+	return &SCIMConnectorWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   id,
+			ResourceOwner: resourceOwner,
+		},
+	}
+}
+
+func (wm *SCIMConnectorWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *scimconnector.AddedEvent:
+			wm.ProjectID = e.ProjectID
+			wm.Name = e.Name
+			wm.Endpoint = e.Endpoint
+			wm.Token = e.Token
+			wm.State = domain.SCIMConnectorStateActive
+		case *scimconnector.ChangedEvent:
+			if e.Name != nil {
+				wm.Name = *e.Name
+			}
+			if e.Endpoint != nil {
+				wm.Endpoint = *e.Endpoint
+			}
+			if e.Token != nil {
+				wm.Token = e.Token
+			}
+		case *scimconnector.DeactivatedEvent:
+			wm.State = domain.SCIMConnectorStateInactive
+		case *scimconnector.ReactivatedEvent:
+			wm.State = domain.SCIMConnectorStateActive
+		case *scimconnector.RemovedEvent:
+			wm.State = domain.SCIMConnectorStateRemoved
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *SCIMConnectorWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(scimconnector.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			scimconnector.AddedEventType,
+			scimconnector.ChangedEventType,
+			scimconnector.DeactivatedEventType,
+			scimconnector.ReactivatedEventType,
+			scimconnector.RemovedEventType,
+		).
+		Builder()
+}
+
+func (wm *SCIMConnectorWriteModel) NewChangedEvent(
+	ctx context.Context,
+	agg *eventstore.Aggregate,
+	name *string,
+	endpoint *string,
+	token *crypto.CryptoValue,
+) *scimconnector.ChangedEvent {
+	changes := make([]scimconnector.Changes, 0, 3)
+	if name != nil && wm.Name != *name {
+		changes = append(changes, scimconnector.ChangeName(*name))
+	}
+	if endpoint != nil && wm.Endpoint != *endpoint {
+		changes = append(changes, scimconnector.ChangeEndpoint(*endpoint))
+	}
+	// the token is encrypted, so it is always updated if set
+	if token != nil {
+		changes = append(changes, scimconnector.ChangeToken(token))
+	}
+	if len(changes) == 0 {
+		return nil
+	}
+	return scimconnector.NewChangedEvent(ctx, agg, changes)
+}
+
+func SCIMConnectorAggregateFromWriteModel(ctx context.Context, wm *eventstore.WriteModel) *eventstore.Aggregate {
+	return eventstore.AggregateFromWriteModelCtx(ctx, wm, scimconnector.AggregateType, scimconnector.AggregateVersion)
+}
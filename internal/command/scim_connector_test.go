@@ -0,0 +1,482 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/muhlemmer/gu"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
+	"github.com/zitadel/zitadel/internal/id"
+	"github.com/zitadel/zitadel/internal/id/mock"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/repository/scimconnector"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func scimConnectorTestToken(token string) *crypto.CryptoValue {
+	return &crypto.CryptoValue{
+		CryptoType: crypto.TypeEncryption,
+		Algorithm:  "enc",
+		KeyID:      "id",
+		Crypted:    []byte(token),
+	}
+}
+
+func scimConnectorTestAddedEvent() *scimconnector.AddedEvent {
+	return scimconnector.NewAddedEvent(context.Background(),
+		scimconnector.NewAggregate("connector1", "org1", ""),
+		"project1",
+		"name",
+		"https://example.com/scim/v2",
+		scimConnectorTestToken("token"),
+	)
+}
+
+func TestCommands_AddSCIMConnector(t *testing.T) {
+	type fields struct {
+		eventstore  func(t *testing.T) *eventstore.Eventstore
+		idGenerator id.Generator
+	}
+	type args struct {
+		add           *AddSCIMConnector
+		resourceOwner string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "no resource owner, error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				add: &AddSCIMConnector{},
+			},
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-Ohs3o", "Errors.ResourceOwnerMissing"),
+			},
+		},
+		{
+			name: "no name, error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				add: &AddSCIMConnector{
+					ProjectID: "project1",
+					Endpoint:  "https://example.com/scim/v2",
+					Token:     "token",
+				},
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-Ue8oh", "Errors.SCIMConnector.Invalid"),
+			},
+		},
+		{
+			name: "invalid endpoint, error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				add: &AddSCIMConnector{
+					ProjectID: "project1",
+					Name:      "name",
+					Endpoint:  "example.com/scim/v2",
+					Token:     "token",
+				},
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-iePh7", "Errors.SCIMConnector.InvalidURL"),
+			},
+		},
+		{
+			name: "no token, error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				add: &AddSCIMConnector{
+					ProjectID: "project1",
+					Name:      "name",
+					Endpoint:  "https://example.com/scim/v2",
+				},
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-weeC2", "Errors.SCIMConnector.NoToken"),
+			},
+		},
+		{
+			name: "project not found, error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				add: &AddSCIMConnector{
+					ProjectID: "project1",
+					Name:      "name",
+					Endpoint:  "https://example.com/scim/v2",
+					Token:     "token",
+				},
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-EbFMN", "Errors.Project.NotFound"),
+			},
+		},
+		{
+			name: "add, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							project.NewProjectAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"projectname1", true, true, true,
+								domain.PrivateLabelingSettingUnspecified,
+							),
+						),
+					),
+					expectFilter(),
+					expectPush(
+						scimConnectorTestAddedEvent(),
+					),
+				),
+				idGenerator: mock.ExpectID(t, "connector1"),
+			},
+			args: args{
+				add: &AddSCIMConnector{
+					ProjectID: "project1",
+					Name:      "name",
+					Endpoint:  "https://example.com/scim/v2",
+					Token:     "token",
+				},
+				resourceOwner: "org1",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+					ID:            "connector1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:       tt.fields.eventstore(t),
+				idGenerator:      tt.fields.idGenerator,
+				targetEncryption: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			}
+			got, err := c.AddSCIMConnector(context.Background(), tt.args.add, tt.args.resourceOwner)
+			assert.ErrorIs(t, err, tt.res.err)
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommands_ChangeSCIMConnector(t *testing.T) {
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		change        *ChangeSCIMConnector
+		resourceOwner string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "empty token, error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				change: &ChangeSCIMConnector{
+					ObjectRoot: models.ObjectRoot{AggregateID: "connector1"},
+					ProjectID:  "project1",
+					Token:      gu.Ptr(""),
+				},
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-Ahch1", "Errors.SCIMConnector.NoToken"),
+			},
+		},
+		{
+			name: "not found, error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				change: &ChangeSCIMConnector{
+					ObjectRoot: models.ObjectRoot{AggregateID: "connector1"},
+					ProjectID:  "project1",
+					Name:       gu.Ptr("name2"),
+				},
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: zerrors.ThrowNotFound(nil, "COMMAND-Eix2a", "Errors.SCIMConnector.NotFound"),
+			},
+		},
+		{
+			name: "other project, error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(scimConnectorTestAddedEvent()),
+					),
+				),
+			},
+			args: args{
+				change: &ChangeSCIMConnector{
+					ObjectRoot: models.ObjectRoot{AggregateID: "connector1"},
+					ProjectID:  "project2",
+					Name:       gu.Ptr("name2"),
+				},
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: zerrors.ThrowNotFound(nil, "COMMAND-Eix2a", "Errors.SCIMConnector.NotFound"),
+			},
+		},
+		{
+			name: "no changes, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(scimConnectorTestAddedEvent()),
+					),
+				),
+			},
+			args: args{
+				change: &ChangeSCIMConnector{
+					ObjectRoot: models.ObjectRoot{AggregateID: "connector1"},
+					ProjectID:  "project1",
+					Name:       gu.Ptr("name"),
+				},
+				resourceOwner: "org1",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+					ID:            "connector1",
+				},
+			},
+		},
+		{
+			name: "change, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(scimConnectorTestAddedEvent()),
+					),
+					expectPush(
+						scimconnector.NewChangedEvent(context.Background(),
+							scimconnector.NewAggregate("connector1", "org1", ""),
+							[]scimconnector.Changes{
+								scimconnector.ChangeEndpoint("https://example.com/scim"),
+								scimconnector.ChangeToken(scimConnectorTestToken("token2")),
+							},
+						),
+					),
+				),
+			},
+			args: args{
+				change: &ChangeSCIMConnector{
+					ObjectRoot: models.ObjectRoot{AggregateID: "connector1"},
+					ProjectID:  "project1",
+					Endpoint:   gu.Ptr("https://example.com/scim"),
+					Token:      gu.Ptr("token2"),
+				},
+				resourceOwner: "org1",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+					ID:            "connector1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:       tt.fields.eventstore(t),
+				targetEncryption: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			}
+			got, err := c.ChangeSCIMConnector(context.Background(), tt.args.change, tt.args.resourceOwner)
+			assert.ErrorIs(t, err, tt.res.err)
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommands_DeactivateSCIMConnector(t *testing.T) {
+	tests := []struct {
+		name       string
+		eventstore func(t *testing.T) *eventstore.Eventstore
+		wantErr    error
+	}{
+		{
+			name: "not found, error",
+			eventstore: expectEventstore(
+				expectFilter(),
+			),
+			wantErr: zerrors.ThrowNotFound(nil, "COMMAND-Ohl8i", "Errors.SCIMConnector.NotFound"),
+		},
+		{
+			name: "already inactive, error",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(scimConnectorTestAddedEvent()),
+					eventFromEventPusher(
+						scimconnector.NewDeactivatedEvent(context.Background(), scimconnector.NewAggregate("connector1", "org1", "")),
+					),
+				),
+			),
+			wantErr: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Gai0o", "Errors.SCIMConnector.NotActive"),
+		},
+		{
+			name: "deactivate, ok",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(scimConnectorTestAddedEvent()),
+				),
+				expectPush(
+					scimconnector.NewDeactivatedEvent(context.Background(), scimconnector.NewAggregate("connector1", "org1", "")),
+				),
+			),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.eventstore(t),
+			}
+			_, err := c.DeactivateSCIMConnector(context.Background(), "project1", "connector1", "org1")
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestCommands_ReactivateSCIMConnector(t *testing.T) {
+	tests := []struct {
+		name       string
+		eventstore func(t *testing.T) *eventstore.Eventstore
+		wantErr    error
+	}{
+		{
+			name: "active, error",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(scimConnectorTestAddedEvent()),
+				),
+			),
+			wantErr: zerrors.ThrowPreconditionFailed(nil, "COMMAND-ooF1u", "Errors.SCIMConnector.NotInactive"),
+		},
+		{
+			name: "reactivate, ok",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(scimConnectorTestAddedEvent()),
+					eventFromEventPusher(
+						scimconnector.NewDeactivatedEvent(context.Background(), scimconnector.NewAggregate("connector1", "org1", "")),
+					),
+				),
+				expectPush(
+					scimconnector.NewReactivatedEvent(context.Background(), scimconnector.NewAggregate("connector1", "org1", "")),
+				),
+			),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.eventstore(t),
+			}
+			_, err := c.ReactivateSCIMConnector(context.Background(), "project1", "connector1", "org1")
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestCommands_RemoveSCIMConnector(t *testing.T) {
+	tests := []struct {
+		name       string
+		eventstore func(t *testing.T) *eventstore.Eventstore
+		projectID  string
+		wantErr    error
+	}{
+		{
+			name:       "missing id, error",
+			eventstore: expectEventstore(),
+			wantErr:    zerrors.ThrowInvalidArgument(nil, "COMMAND-Quu7a", "Errors.IDMissing"),
+		},
+		{
+			name: "removed, error",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(scimConnectorTestAddedEvent()),
+					eventFromEventPusher(
+						scimconnector.NewRemovedEvent(context.Background(), scimconnector.NewAggregate("connector1", "org1", "")),
+					),
+				),
+			),
+			projectID: "project1",
+			wantErr:   zerrors.ThrowNotFound(nil, "COMMAND-Ohl8i", "Errors.SCIMConnector.NotFound"),
+		},
+		{
+			name: "remove, ok",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(scimConnectorTestAddedEvent()),
+				),
+				expectPush(
+					scimconnector.NewRemovedEvent(context.Background(), scimconnector.NewAggregate("connector1", "org1", "")),
+				),
+			),
+			projectID: "project1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.eventstore(t),
+			}
+			_, err := c.RemoveSCIMConnector(context.Background(), tt.projectID, "connector1", "org1")
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
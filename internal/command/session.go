@@ -34,6 +34,7 @@ type SessionCommands struct {
 	eventCommands     []eventstore.Command
 
 	hasher               *crypto.Hasher
+	secretHasher         *crypto.Hasher
 	intentAlg            crypto.EncryptionAlgorithm
 	totpAlg              crypto.EncryptionAlgorithm
 	otpAlg               crypto.EncryptionAlgorithm
@@ -51,6 +52,7 @@ func (c *Commands) NewSessionCommands(cmds []SessionCommand, session *SessionWri
 		sessionWriteModel:    session,
 		eventstore:           c.eventstore,
 		hasher:               c.userPasswordHasher,
+		secretHasher:         c.secretHasher,
 		intentAlg:            c.idpConfigEncryption,
 		totpAlg:              c.multifactors.OTP.CryptoMFA,
 		otpAlg:               c.userEncryption,
@@ -145,6 +147,28 @@ func CheckTOTP(code string) SessionCommand {
 	}
 }
 
+// CheckRecoveryCode defines a check of one of the user's recovery codes to be executed for a session update.
+// A successfully checked code is consumed and can not be used again.
+func CheckRecoveryCode(code string) SessionCommand {
+	return func(ctx context.Context, cmd *SessionCommands) (_ []eventstore.Command, err error) {
+		commands, err := checkRecoveryCode(
+			ctx,
+			cmd.sessionWriteModel.UserID,
+			"",
+			code,
+			cmd.eventstore.FilterToQueryReducer,
+			cmd.secretHasher,
+			nil,
+		)
+		if err != nil {
+			return commands, err
+		}
+		cmd.eventCommands = append(cmd.eventCommands, commands...)
+		cmd.RecoveryCodeChecked(ctx, cmd.now())
+		return nil, nil
+	}
+}
+
 // Exec will execute the commands specified and returns an error on the first occurrence.
 // In case of an error there might be specific commands returned, e.g. a failed pw check will have to be stored.
 func (s *SessionCommands) Exec(ctx context.Context) ([]eventstore.Command, error) {
@@ -200,6 +224,10 @@ func (s *SessionCommands) TOTPChecked(ctx context.Context, checkedAt time.Time)
 	s.eventCommands = append(s.eventCommands, session.NewTOTPCheckedEvent(ctx, s.sessionWriteModel.aggregate, checkedAt))
 }
 
+func (s *SessionCommands) RecoveryCodeChecked(ctx context.Context, checkedAt time.Time) {
+	s.eventCommands = append(s.eventCommands, session.NewRecoveryCodeCheckedEvent(ctx, s.sessionWriteModel.aggregate, checkedAt))
+}
+
 func (s *SessionCommands) OTPSMSChallenged(ctx context.Context, code *crypto.CryptoValue, expiry time.Duration, returnCode bool, generatorID string) {
 	s.eventCommands = append(s.eventCommands, session.NewOTPSMSChallengedEvent(ctx, s.sessionWriteModel.aggregate, code, expiry, returnCode, generatorID))
 }
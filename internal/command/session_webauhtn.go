@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/json"
 
+	"golang.org/x/text/language"
+
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/zerrors"
@@ -41,13 +43,43 @@ func (s *SessionCommands) getHumanWebAuthNTokenReadModel(ctx context.Context, us
 	return readModel, nil
 }
 
+// getDiscoverableHumanWebAuthNTokens resolves the user of a discoverable credential (passkey) by the user handle,
+// which is the ID of the user. The credential itself is verified against the passkeys of the user.
+func (s *SessionCommands) getDiscoverableHumanWebAuthNTokens(ctx context.Context, userHandle []byte) (*humanWebAuthNTokens, error) {
+	if len(userHandle) == 0 {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ve3ai", "Errors.User.UserIDMissing")
+	}
+	humanWriteModel := NewHumanWriteModel(string(userHandle), "")
+	err := s.eventstore.FilterToQueryReducer(ctx, humanWriteModel)
+	if err != nil {
+		return nil, err
+	}
+	if humanWriteModel.UserState != domain.UserStateActive {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-kee7O", "Errors.User.NotFound")
+	}
+	tokenReadModel := NewHumanPasswordlessTokensReadModel(humanWriteModel.AggregateID, humanWriteModel.ResourceOwner)
+	err = s.eventstore.FilterToQueryReducer(ctx, tokenReadModel)
+	if err != nil {
+		return nil, err
+	}
+	return &humanWebAuthNTokens{
+		human:  writeModelToHuman(humanWriteModel),
+		tokens: readModelToWebAuthNTokens(tokenReadModel),
+	}, nil
+}
+
+// CreateWebAuthNChallenge creates a challenge for the user of the session.
+// If the session has no user yet and user verification is required,
+// a challenge for discoverable credentials (passkeys) without allowed credentials is created,
+// so the user can be resolved by [Commands.CheckWebAuthN] from the returned credential (usernameless login).
 func (c *Commands) CreateWebAuthNChallenge(userVerification domain.UserVerificationRequirement, rpid string, dst json.Unmarshaler) SessionCommand {
-	return func(ctx context.Context, cmd *SessionCommands) ([]eventstore.Command, error) {
-		humanPasskeys, err := cmd.getHumanWebAuthNTokens(ctx, userVerification)
-		if err != nil {
-			return nil, err
+	return func(ctx context.Context, cmd *SessionCommands) (_ []eventstore.Command, err error) {
+		var webAuthNLogin *domain.WebAuthNLogin
+		if cmd.sessionWriteModel.UserID == "" && userVerification == domain.UserVerificationRequirementRequired {
+			webAuthNLogin, err = c.webauthnConfig.BeginDiscoverableLogin(ctx, userVerification, rpid)
+		} else {
+			webAuthNLogin, err = c.beginSessionWebAuthNLogin(ctx, cmd, userVerification, rpid)
 		}
-		webAuthNLogin, err := c.webauthnConfig.BeginLogin(ctx, humanPasskeys.human, userVerification, rpid, humanPasskeys.tokens...)
 		if err != nil {
 			return nil, err
 		}
@@ -60,6 +92,14 @@ func (c *Commands) CreateWebAuthNChallenge(userVerification domain.UserVerificat
 	}
 }
 
+func (c *Commands) beginSessionWebAuthNLogin(ctx context.Context, cmd *SessionCommands, userVerification domain.UserVerificationRequirement, rpid string) (*domain.WebAuthNLogin, error) {
+	humanPasskeys, err := cmd.getHumanWebAuthNTokens(ctx, userVerification)
+	if err != nil {
+		return nil, err
+	}
+	return c.webauthnConfig.BeginLogin(ctx, humanPasskeys.human, userVerification, rpid, humanPasskeys.tokens...)
+}
+
 func (c *Commands) CheckWebAuthN(credentialAssertionData json.Marshaler) SessionCommand {
 	return func(ctx context.Context, cmd *SessionCommands) ([]eventstore.Command, error) {
 		credentialAssertionData, err := json.Marshal(credentialAssertionData)
@@ -70,6 +110,9 @@ func (c *Commands) CheckWebAuthN(credentialAssertionData json.Marshaler) Session
 		if challenge == nil {
 			return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ioqu5", "Errors.Session.WebAuthN.NoChallenge")
 		}
+		if cmd.sessionWriteModel.UserID == "" {
+			return c.checkDiscoverableWebAuthN(ctx, cmd, challenge, credentialAssertionData)
+		}
 		webAuthNTokens, err := cmd.getHumanWebAuthNTokens(ctx, challenge.UserVerification)
 		if err != nil {
 			return nil, err
@@ -88,3 +131,39 @@ func (c *Commands) CheckWebAuthN(credentialAssertionData json.Marshaler) Session
 		return nil, nil
 	}
 }
+
+// checkDiscoverableWebAuthN checks the assertion of a discoverable credential (passkey)
+// and sets the user of the credential on the session.
+func (c *Commands) checkDiscoverableWebAuthN(ctx context.Context, cmd *SessionCommands, challenge *WebAuthNChallengeModel, credentialAssertionData []byte) ([]eventstore.Command, error) {
+	if challenge.UserVerification != domain.UserVerificationRequirementRequired || len(challenge.AllowedCrentialIDs) > 0 {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ohb4u", "Errors.User.UserIDMissing")
+	}
+	var webAuthNTokens *humanWebAuthNTokens
+	human, credential, err := c.webauthnConfig.FinishDiscoverableLogin(ctx,
+		challenge.WebAuthNLogin(&domain.Human{}, credentialAssertionData),
+		credentialAssertionData,
+		func(_, userHandle []byte) (_ *domain.Human, _ []*domain.WebAuthNToken, err error) {
+			webAuthNTokens, err = cmd.getDiscoverableHumanWebAuthNTokens(ctx, userHandle)
+			if err != nil {
+				return nil, nil, err
+			}
+			return webAuthNTokens.human, webAuthNTokens.tokens, nil
+		},
+	)
+	if err != nil && (credential == nil || credential.ID == nil) {
+		return nil, err
+	}
+	_, token := domain.GetTokenByKeyID(webAuthNTokens.tokens, credential.ID)
+	if token == nil {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-iu4Ee", "Errors.User.WebAuthN.NotFound")
+	}
+	var preferredLanguage *language.Tag
+	if !human.PreferredLanguage.IsRoot() {
+		preferredLanguage = &human.PreferredLanguage
+	}
+	if err = cmd.UserChecked(ctx, human.AggregateID, human.ResourceOwner, cmd.now(), preferredLanguage); err != nil {
+		return nil, err
+	}
+	cmd.WebAuthNChecked(ctx, cmd.now(), token.WebAuthNTokenID, credential.Authenticator.SignCount, credential.Flags.UserVerified)
+	return nil, nil
+}
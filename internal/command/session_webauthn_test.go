@@ -8,12 +8,16 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"golang.org/x/text/language"
+	"google.golang.org/protobuf/types/known/structpb"
 
+	http_util "github.com/zitadel/zitadel/internal/api/http"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
 	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/session"
 	"github.com/zitadel/zitadel/internal/repository/user"
+	webauthn_helper "github.com/zitadel/zitadel/internal/webauthn"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
@@ -248,3 +252,149 @@ func TestSessionCommands_getHumanWebAuthNTokens(t *testing.T) {
 		assert.Equal(t, tt.res.want, got)
 	}
 }
+
+func TestSessionCommands_getDiscoverableHumanWebAuthNTokens(t *testing.T) {
+	userAggr := &user.NewAggregate("user1", "org1").Aggregate
+
+	tests := []struct {
+		name       string
+		eventstore *eventstore.Eventstore
+		userHandle []byte
+		want       *humanWebAuthNTokens
+		wantErr    error
+	}{
+		{
+			name:       "missing user handle",
+			eventstore: &eventstore.Eventstore{},
+			wantErr:    zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ve3ai", "Errors.User.UserIDMissing"),
+		},
+		{
+			name: "user not found",
+			eventstore: eventstoreExpect(t,
+				expectFilter(),
+			),
+			userHandle: []byte("user1"),
+			wantErr:    zerrors.ThrowPreconditionFailed(nil, "COMMAND-kee7O", "Errors.User.NotFound"),
+		},
+		{
+			name: "passwordless filter error",
+			eventstore: eventstoreExpect(t,
+				expectFilter(
+					eventFromEventPusher(
+						user.NewHumanAddedEvent(context.Background(),
+							userAggr,
+							"", "", "", "", "", language.Georgian,
+							domain.GenderDiverse, "", true,
+						),
+					),
+				),
+				expectFilterError(io.ErrClosedPipe),
+			),
+			userHandle: []byte("user1"),
+			wantErr:    io.ErrClosedPipe,
+		},
+		{
+			name: "ok",
+			eventstore: eventstoreExpect(t,
+				expectFilter(
+					eventFromEventPusher(
+						user.NewHumanAddedEvent(context.Background(),
+							userAggr,
+							"", "", "", "", "", language.Georgian,
+							domain.GenderDiverse, "", true,
+						),
+					),
+				),
+				expectFilter(eventFromEventPusher(
+					user.NewHumanWebAuthNAddedEvent(eventstore.NewBaseEventForPush(
+						context.Background(), &org.NewAggregate("org1").Aggregate, user.HumanPasswordlessTokenAddedType,
+					), "111", "challenge", "rpID"),
+				)),
+			),
+			userHandle: []byte("user1"),
+			want: &humanWebAuthNTokens{
+				human: &domain.Human{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID:   "user1",
+						ResourceOwner: "org1",
+					},
+					State: domain.UserStateActive,
+					Profile: &domain.Profile{
+						PreferredLanguage: language.Georgian,
+						Gender:            domain.GenderDiverse,
+					},
+					Email: &domain.Email{},
+				},
+				tokens: []*domain.WebAuthNToken{{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID: "org1",
+					},
+					WebAuthNTokenID: "111",
+					State:           domain.MFAStateNotReady,
+					Challenge:       "challenge",
+					RPID:            "rpID",
+				}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &SessionCommands{
+				eventstore:        tt.eventstore,
+				sessionWriteModel: &SessionWriteModel{},
+			}
+			got, err := s.getDiscoverableHumanWebAuthNTokens(context.Background(), tt.userHandle)
+			require.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCommands_CreateWebAuthNChallenge_discoverable(t *testing.T) {
+	ctx := http_util.WithRequestedHost(context.Background(), "example.com")
+	c := &Commands{
+		webauthnConfig: &webauthn_helper.Config{
+			DisplayName:    "test",
+			ExternalSecure: true,
+		},
+	}
+	cmd := &SessionCommands{
+		sessionWriteModel: NewSessionWriteModel("session1", "instance1"),
+	}
+	dst := new(structpb.Struct)
+
+	_, err := c.CreateWebAuthNChallenge(domain.UserVerificationRequirementRequired, "example.com", dst)(ctx, cmd)
+	require.NoError(t, err)
+
+	require.Len(t, cmd.eventCommands, 1)
+	challenged, ok := cmd.eventCommands[0].(*session.WebAuthNChallengedEvent)
+	require.True(t, ok)
+	assert.NotEmpty(t, challenged.Challenge)
+	assert.Empty(t, challenged.AllowedCrentialIDs)
+	assert.Equal(t, domain.UserVerificationRequirementRequired, challenged.UserVerification)
+	assert.Equal(t, "example.com", challenged.RPID)
+
+	publicKey := dst.GetFields()["publicKey"].GetStructValue()
+	require.NotNil(t, publicKey)
+	assert.Nil(t, publicKey.GetFields()["allowCredentials"])
+}
+
+func TestCommands_CheckWebAuthN_discoverableNotAllowed(t *testing.T) {
+	c := &Commands{
+		webauthnConfig: &webauthn_helper.Config{
+			DisplayName:    "test",
+			ExternalSecure: true,
+		},
+	}
+	cmd := &SessionCommands{
+		sessionWriteModel: &SessionWriteModel{
+			WebAuthNChallenge: &WebAuthNChallengeModel{
+				Challenge:        "challenge",
+				UserVerification: domain.UserVerificationRequirementDiscouraged,
+				RPID:             "example.com",
+			},
+		},
+	}
+	_, err := c.CheckWebAuthN(new(structpb.Struct))(context.Background(), cmd)
+	require.ErrorIs(t, err, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ohb4u", "Errors.User.UserIDMissing"))
+}
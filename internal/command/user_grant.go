@@ -3,6 +3,7 @@ package command
 import (
 	"context"
 	"reflect"
+	"time"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/domain"
@@ -39,6 +40,9 @@ func (c *Commands) addUserGrant(ctx context.Context, userGrant *domain.UserGrant
 	if !userGrant.IsValid() {
 		return nil, nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-kVfMa", "Errors.UserGrant.Invalid")
 	}
+	if !userGrant.HasValidValidity() {
+		return nil, nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Aeg3o", "Errors.UserGrant.InvalidValidity")
+	}
 	err = c.checkUserGrantPreCondition(ctx, userGrant, resourceOwner)
 	if err != nil {
 		return nil, nil, err
@@ -50,7 +54,7 @@ func (c *Commands) addUserGrant(ctx context.Context, userGrant *domain.UserGrant
 
 	addedUserGrant := NewUserGrantWriteModel(userGrant.AggregateID, resourceOwner)
 	userGrantAgg := UserGrantAggregateFromWriteModel(&addedUserGrant.WriteModel)
-	command = usergrant.NewUserGrantAddedEvent(
+	addedEvent := usergrant.NewUserGrantAddedEvent(
 		ctx,
 		userGrantAgg,
 		userGrant.UserID,
@@ -58,7 +62,9 @@ func (c *Commands) addUserGrant(ctx context.Context, userGrant *domain.UserGrant
 		userGrant.ProjectGrantID,
 		userGrant.RoleKeys,
 	)
-	return command, addedUserGrant, nil
+	addedEvent.ValidFrom = timePointer(userGrant.ValidFrom)
+	addedEvent.ValidUntil = timePointer(userGrant.ValidUntil)
+	return addedEvent, addedUserGrant, nil
 }
 
 func (c *Commands) ChangeUserGrant(ctx context.Context, userGrant *domain.UserGrant, resourceOwner string) (_ *domain.UserGrant, err error) {
@@ -192,6 +198,10 @@ func (c *Commands) ReactivateUserGrant(ctx context.Context, grantID, resourceOwn
 	if existingUserGrant.State != domain.UserGrantStateInactive {
 		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-1ML0v", "Errors.UserGrant.NotInactive")
 	}
+	// an expired grant has to be extended before it can be reactivated
+	if !existingUserGrant.ValidUntil.IsZero() && !time.Now().Before(existingUserGrant.ValidUntil) {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ioh8a", "Errors.UserGrant.Expired")
+	}
 	err = checkExplicitProjectPermission(ctx, existingUserGrant.ProjectGrantID, existingUserGrant.ProjectID)
 	if err != nil {
 		return nil, err
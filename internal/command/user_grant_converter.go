@@ -10,5 +10,7 @@ func userGrantWriteModelToUserGrant(writeModel *UserGrantWriteModel) *domain.Use
 		ProjectGrantID: writeModel.ProjectGrantID,
 		RoleKeys:       writeModel.RoleKeys,
 		State:          writeModel.State,
+		ValidFrom:      writeModel.ValidFrom,
+		ValidUntil:     writeModel.ValidUntil,
 	}
 }
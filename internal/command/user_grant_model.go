@@ -1,6 +1,8 @@
 package command
 
 import (
+	"time"
+
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/repository/project"
@@ -16,6 +18,9 @@ type UserGrantWriteModel struct {
 	ProjectGrantID string
 	RoleKeys       []string
 	State          domain.UserGrantState
+	ValidFrom      time.Time
+	ValidUntil     time.Time
+	ExpiryReminded bool
 }
 
 func NewUserGrantWriteModel(userGrantID string, resourceOwner string) *UserGrantWriteModel {
@@ -36,6 +41,8 @@ func (wm *UserGrantWriteModel) Reduce() error {
 			wm.ProjectGrantID = e.ProjectGrantID
 			wm.RoleKeys = e.RoleKeys
 			wm.State = domain.UserGrantStateActive
+			wm.ValidFrom = timeValue(e.ValidFrom)
+			wm.ValidUntil = timeValue(e.ValidUntil)
 		case *usergrant.UserGrantChangedEvent:
 			wm.RoleKeys = e.RoleKeys
 		case *usergrant.UserGrantCascadeChangedEvent:
@@ -54,6 +61,17 @@ func (wm *UserGrantWriteModel) Reduce() error {
 			wm.State = domain.UserGrantStateRemoved
 		case *usergrant.UserGrantCascadeRemovedEvent:
 			wm.State = domain.UserGrantStateRemoved
+		case *usergrant.UserGrantValidityChangedEvent:
+			wm.ValidFrom = timeValue(e.ValidFrom)
+			wm.ValidUntil = timeValue(e.ValidUntil)
+			wm.ExpiryReminded = false
+		case *usergrant.UserGrantExpiredEvent:
+			if wm.State == domain.UserGrantStateRemoved {
+				continue
+			}
+			wm.State = domain.UserGrantStateInactive
+		case *usergrant.UserGrantExpiryRemindedEvent:
+			wm.ExpiryReminded = true
 		}
 	}
 	return wm.WriteModel.Reduce()
@@ -70,7 +88,10 @@ func (wm *UserGrantWriteModel) Query() *eventstore.SearchQueryBuilder {
 			usergrant.UserGrantDeactivatedType,
 			usergrant.UserGrantReactivatedType,
 			usergrant.UserGrantRemovedType,
-			usergrant.UserGrantCascadeRemovedType).
+			usergrant.UserGrantCascadeRemovedType,
+			usergrant.UserGrantValidityChangedType,
+			usergrant.UserGrantExpiredType,
+			usergrant.UserGrantExpiryRemindedType).
 		Builder()
 
 	if wm.ResourceOwner != "" {
@@ -79,6 +100,20 @@ func (wm *UserGrantWriteModel) Query() *eventstore.SearchQueryBuilder {
 	return query
 }
 
+func timeValue(t *time.Time) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+	return *t
+}
+
+func timePointer(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
+
 func UserGrantAggregateFromWriteModel(wm *eventstore.WriteModel) *eventstore.Aggregate {
 	return eventstore.AggregateFromWriteModel(wm, usergrant.AggregateType, usergrant.AggregateVersion)
 }
@@ -3,6 +3,7 @@ package command
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"golang.org/x/text/language"
@@ -1577,6 +1578,32 @@ func TestCommandSide_ReactivateUserGrant(t *testing.T) {
 				err: zerrors.IsPreconditionFailed,
 			},
 		},
+		{
+			name: "validity ended, precondition error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							userGrantAddedEventWithValidity(time.Time{}, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+						),
+						eventFromEventPusher(
+							usergrant.NewUserGrantExpiredEvent(context.Background(),
+								&usergrant.NewAggregate("usergrant1", "org1").Aggregate,
+								time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:           authz.NewMockContextWithPermissions("", "", "", []string{domain.RoleProjectOwner}),
+				userGrantID:   "usergrant1",
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
 		{
 			name: "reactivated, ok",
 			fields: fields{
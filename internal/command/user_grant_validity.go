@@ -0,0 +1,127 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/usergrant"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// ChangeUserGrantValidity sets the time frame in which the roles of the grant are valid.
+// Zero values remove the restriction.
+func (c *Commands) ChangeUserGrantValidity(ctx context.Context, grantID, resourceOwner string, validFrom, validUntil time.Time) (*domain.ObjectDetails, error) {
+	if grantID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-ooX4i", "Errors.UserGrant.IDMissing")
+	}
+	if !(&domain.UserGrant{ValidFrom: validFrom, ValidUntil: validUntil}).HasValidValidity() {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-eeP2a", "Errors.UserGrant.InvalidValidity")
+	}
+	existingUserGrant, err := c.userGrantWriteModelByID(ctx, grantID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if existingUserGrant.State == domain.UserGrantStateUnspecified || existingUserGrant.State == domain.UserGrantStateRemoved {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Xah0e", "Errors.UserGrant.NotFound")
+	}
+	err = checkExplicitProjectPermission(ctx, existingUserGrant.ProjectGrantID, existingUserGrant.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+	if existingUserGrant.ValidFrom.Equal(validFrom) && existingUserGrant.ValidUntil.Equal(validUntil) {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Gai5o", "Errors.UserGrant.NotChanged")
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, usergrant.NewUserGrantValidityChangedEvent(
+		ctx,
+		UserGrantAggregateFromWriteModel(&existingUserGrant.WriteModel),
+		validFrom,
+		validUntil,
+	))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existingUserGrant, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingUserGrant.WriteModel), nil
+}
+
+// ExpireUserGrant deactivates an active grant after the end of its validity was reached.
+func (c *Commands) ExpireUserGrant(ctx context.Context, grantID, resourceOwner string) (*domain.ObjectDetails, error) {
+	if grantID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Lae7u", "Errors.UserGrant.IDMissing")
+	}
+	existingUserGrant, err := c.userGrantWriteModelByID(ctx, grantID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if existingUserGrant.State != domain.UserGrantStateActive {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-ieK9u", "Errors.UserGrant.NotActive")
+	}
+	if existingUserGrant.ValidUntil.IsZero() || time.Now().Before(existingUserGrant.ValidUntil) {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Quee5", "Errors.UserGrant.NotExpired")
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, usergrant.NewUserGrantExpiredEvent(
+		ctx,
+		UserGrantAggregateFromWriteModel(&existingUserGrant.WriteModel),
+		existingUserGrant.ValidUntil,
+	))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existingUserGrant, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingUserGrant.WriteModel), nil
+}
+
+// RemindUserGrantExpiry requests the notification of the user about the upcoming end of the validity of the grant.
+// The user is only reminded once per validity.
+func (c *Commands) RemindUserGrantExpiry(ctx context.Context, grantID, resourceOwner, projectName string) error {
+	if grantID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-ahT3e", "Errors.UserGrant.IDMissing")
+	}
+	existingUserGrant, err := c.userGrantWriteModelByID(ctx, grantID, resourceOwner)
+	if err != nil {
+		return err
+	}
+	if existingUserGrant.State != domain.UserGrantStateActive {
+		return zerrors.ThrowPreconditionFailed(nil, "COMMAND-Pheo6", "Errors.UserGrant.NotActive")
+	}
+	if existingUserGrant.ValidUntil.IsZero() || existingUserGrant.ExpiryReminded {
+		return nil
+	}
+	_, err = c.eventstore.Push(ctx, usergrant.NewUserGrantExpiryRemindedEvent(
+		ctx,
+		UserGrantAggregateFromWriteModel(&existingUserGrant.WriteModel),
+		existingUserGrant.UserID,
+		projectName,
+		existingUserGrant.ValidUntil,
+	))
+	return err
+}
+
+func (c *Commands) UserGrantExpiryReminderSent(ctx context.Context, orgID, grantID string) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if grantID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-wai4E", "Errors.UserGrant.IDMissing")
+	}
+	existingUserGrant, err := c.userGrantWriteModelByID(ctx, grantID, orgID)
+	if err != nil {
+		return err
+	}
+	if existingUserGrant.State == domain.UserGrantStateUnspecified || existingUserGrant.State == domain.UserGrantStateRemoved {
+		return zerrors.ThrowNotFound(nil, "COMMAND-Eip3u", "Errors.UserGrant.NotFound")
+	}
+	_, err = c.eventstore.Push(ctx, usergrant.NewUserGrantExpiryReminderSentEvent(
+		ctx,
+		UserGrantAggregateFromWriteModel(&existingUserGrant.WriteModel),
+	))
+	return err
+}
@@ -103,7 +103,7 @@ func (c *Commands) ChangePassword(ctx context.Context, orgID, userID, oldPasswor
 		"",
 		userAgentID,
 		changeRequired,
-		c.checkCurrentPassword(newPassword, "", oldPassword, wm.EncodedHash),
+		c.checkPasswordMinAge(wm.ResourceOwner, wm.PasswordHistory.ChangeDate, c.checkCurrentPassword(newPassword, "", oldPassword, wm.EncodedHash)),
 	)
 }
 
@@ -160,6 +160,27 @@ func (c *Commands) checkCurrentPassword(
 	}
 }
 
+// checkPasswordMinAge returns a [setPasswordVerification] implementation,
+// which additionally to the passed verification ensures the current password was used
+// for the minimum number of days defined in the password age policy.
+// It's only used for changes by the user themselves, so a reset or a set by an administrator is still possible.
+func (c *Commands) checkPasswordMinAge(resourceOwner string, changeDate time.Time, verification setPasswordVerification) setPasswordVerification {
+	return func(ctx context.Context) (string, error) {
+		newEncodedPassword, err := verification(ctx)
+		if err != nil || changeDate.IsZero() {
+			return newEncodedPassword, err
+		}
+		policy, err := c.getOrgPasswordAgePolicy(ctx, resourceOwner)
+		if err != nil {
+			return "", err
+		}
+		if policy.MinAgeDays > 0 && time.Since(changeDate) < time.Duration(policy.MinAgeDays)*24*time.Hour {
+			return "", zerrors.ThrowPreconditionFailed(nil, "COMMAND-ohW3o", "Errors.User.Password.MinAge")
+		}
+		return newEncodedPassword, nil
+	}
+}
+
 // setPassword directly pushes the intent of [setPasswordCommand] to the eventstore and returns the [domain.ObjectDetails]
 func (c *Commands) setPassword(
 	ctx context.Context,
@@ -169,7 +190,7 @@ func (c *Commands) setPassword(
 	verificationCheck setPasswordVerification,
 ) (*domain.ObjectDetails, error) {
 	agg := user.NewAggregate(wm.AggregateID, wm.ResourceOwner)
-	command, err := c.setPasswordCommand(ctx, &agg.Aggregate, wm.UserState, password, encodedPassword, userAgentID, changeRequired, verificationCheck)
+	command, err := c.setPasswordCommand(ctx, &agg.Aggregate, wm.UserState, password, encodedPassword, userAgentID, changeRequired, verificationCheck, &wm.PasswordHistory)
 	if err != nil {
 		return nil, err
 	}
@@ -183,9 +204,10 @@ func (c *Commands) setPassword(
 // setPasswordCommand creates the command / intent for changing a user's password.
 // It will check the user's [domain.UserState] to be existing and not initial,
 // if the caller is allowed to change the password (permission, by code or by providing the current password),
-// and it will ensure the new password (if provided as plain) corresponds to the password complexity policy.
+// and it will ensure the new password (if provided as plain) corresponds to the password complexity policy
+// and was not used before according to the password age policy, in case a history is passed.
 // If not already encoded, the new password will be hashed.
-func (c *Commands) setPasswordCommand(ctx context.Context, agg *eventstore.Aggregate, userState domain.UserState, password, encodedPassword, userAgentID string, changeRequired bool, verificationCheck setPasswordVerification) (_ eventstore.Command, err error) {
+func (c *Commands) setPasswordCommand(ctx context.Context, agg *eventstore.Aggregate, userState domain.UserState, password, encodedPassword, userAgentID string, changeRequired bool, verificationCheck setPasswordVerification, history *passwordHistory) (_ eventstore.Command, err error) {
 	if !isUserStateExists(userState) {
 		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-G8dh3", "Errors.User.Password.NotFound")
 	}
@@ -208,6 +230,9 @@ func (c *Commands) setPasswordCommand(ctx context.Context, agg *eventstore.Aggre
 		if err = c.checkPasswordComplexity(ctx, password, agg.ResourceOwner); err != nil {
 			return nil, err
 		}
+		if err = c.checkPasswordHistory(ctx, password, agg.ResourceOwner, history); err != nil {
+			return nil, err
+		}
 	}
 
 	// In case only a plain password was passed, we need to hash it.
@@ -235,6 +260,37 @@ func (c *Commands) verifyAndUpdatePassword(ctx context.Context, encodedHash, old
 	return updated, convertPasswapErr(err)
 }
 
+// checkPasswordHistory checks that the new password does not match
+// any of the last passwords of the user defined by the history count of the password age policy.
+// The previous passwords are verified using their encoded hashes, as they are not known in plain text.
+func (c *Commands) checkPasswordHistory(ctx context.Context, newPassword, resourceOwner string, history *passwordHistory) (err error) {
+	if history == nil || len(history.EncodedHashes) == 0 {
+		return nil
+	}
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	policy, err := c.getOrgPasswordAgePolicy(ctx, resourceOwner)
+	if err != nil {
+		return err
+	}
+	for _, encodedHash := range history.last(policy.HistoryCount) {
+		_, spanPasswap := tracing.NewNamedSpan(ctx, "passwap.Verify")
+		_, err = c.userPasswordHasher.Verify(encodedHash, newPassword)
+		spanPasswap.EndWithError(err)
+		if errors.Is(err, passwap.ErrPasswordMismatch) {
+			continue
+		}
+		if err != nil {
+			// the hash might have been created with an algorithm, which is no longer configured
+			logging.WithError(err).Warn("unable to verify previous password")
+			continue
+		}
+		return zerrors.ThrowPreconditionFailed(nil, "COMMAND-ooL6i", "Errors.User.Password.Reused")
+	}
+	return nil
+}
+
 // checkPasswordComplexity checks uf the given password can be used to be the password of a user
 func (c *Commands) checkPasswordComplexity(ctx context.Context, newPassword string, resourceOwner string) (err error) {
 	ctx, span := tracing.NewSpan(ctx)
@@ -23,6 +23,41 @@ type HumanPasswordWriteModel struct {
 	VerificationID           string
 
 	UserState domain.UserState
+
+	PasswordHistory passwordHistory
+}
+
+// passwordHistory keeps the encoded hashes of the passwords a user had,
+// so a new password can be verified against them to prevent its reuse.
+type passwordHistory struct {
+	// EncodedHashes contains the current and previous encoded password hashes, the latest last
+	EncodedHashes []string
+	// ChangeDate is the date the current password was set
+	ChangeDate time.Time
+}
+
+func (h *passwordHistory) set(encodedHash string, changeDate time.Time) {
+	if encodedHash == "" {
+		return
+	}
+	h.EncodedHashes = append(h.EncodedHashes, encodedHash)
+	h.ChangeDate = changeDate
+}
+
+// updated replaces the hash of the current password, e.g. after it was rehashed with another algorithm.
+func (h *passwordHistory) updated(encodedHash string) {
+	if len(h.EncodedHashes) == 0 {
+		return
+	}
+	h.EncodedHashes[len(h.EncodedHashes)-1] = encodedHash
+}
+
+// last returns the hashes of the last count passwords, including the current.
+func (h *passwordHistory) last(count uint64) []string {
+	if count >= uint64(len(h.EncodedHashes)) {
+		return h.EncodedHashes
+	}
+	return h.EncodedHashes[uint64(len(h.EncodedHashes))-count:]
 }
 
 func NewHumanPasswordWriteModel(userID, resourceOwner string) *HumanPasswordWriteModel {
@@ -41,10 +76,12 @@ func (wm *HumanPasswordWriteModel) Reduce() error {
 			wm.EncodedHash = crypto.SecretOrEncodedHash(e.Secret, e.EncodedHash)
 			wm.SecretChangeRequired = e.ChangeRequired
 			wm.UserState = domain.UserStateActive
+			wm.PasswordHistory.set(wm.EncodedHash, e.CreationDate())
 		case *user.HumanRegisteredEvent:
 			wm.EncodedHash = crypto.SecretOrEncodedHash(e.Secret, e.EncodedHash)
 			wm.SecretChangeRequired = e.ChangeRequired
 			wm.UserState = domain.UserStateActive
+			wm.PasswordHistory.set(wm.EncodedHash, e.CreationDate())
 		case *user.HumanInitialCodeAddedEvent:
 			wm.UserState = domain.UserStateInitial
 		case *user.HumanInitializedCheckSucceededEvent:
@@ -54,6 +91,7 @@ func (wm *HumanPasswordWriteModel) Reduce() error {
 			wm.SecretChangeRequired = e.ChangeRequired
 			wm.Code = nil
 			wm.PasswordCheckFailedCount = 0
+			wm.PasswordHistory.set(wm.EncodedHash, e.CreationDate())
 		case *user.HumanPasswordCodeAddedEvent:
 			wm.Code = e.Code
 			wm.CodeCreationDate = e.CreationDate()
@@ -81,6 +119,7 @@ func (wm *HumanPasswordWriteModel) Reduce() error {
 			wm.UserState = domain.UserStateDeleted
 		case *user.HumanPasswordHashUpdatedEvent:
 			wm.EncodedHash = e.EncodedHash
+			wm.PasswordHistory.updated(e.EncodedHash)
 		}
 	}
 	return wm.WriteModel.Reduce()
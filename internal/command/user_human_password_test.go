@@ -1029,6 +1029,17 @@ func TestCommandSide_ChangePassword(t *testing.T) {
 						),
 					),
 				),
+				expectFilter(
+					eventFromEventPusher(
+						org.NewPasswordAgePolicyAddedEvent(context.Background(),
+							&org.NewAggregate("org1").Aggregate,
+							0,
+							0,
+							5,
+							0,
+						),
+					),
+				),
 				expectPush(
 					user.NewHumanPasswordChangedEvent(context.Background(),
 						&user.NewAggregate("user1", "org1").Aggregate,
@@ -1097,6 +1108,17 @@ func TestCommandSide_ChangePassword(t *testing.T) {
 						),
 					),
 				),
+				expectFilter(
+					eventFromEventPusher(
+						org.NewPasswordAgePolicyAddedEvent(context.Background(),
+							&org.NewAggregate("org1").Aggregate,
+							0,
+							0,
+							5,
+							0,
+						),
+					),
+				),
 				expectPush(
 					user.NewHumanPasswordChangedEvent(context.Background(),
 						&user.NewAggregate("user1", "org1").Aggregate,
@@ -1166,6 +1188,17 @@ func TestCommandSide_ChangePassword(t *testing.T) {
 						),
 					),
 				),
+				expectFilter(
+					eventFromEventPusher(
+						org.NewPasswordAgePolicyAddedEvent(context.Background(),
+							&org.NewAggregate("org1").Aggregate,
+							0,
+							0,
+							5,
+							0,
+						),
+					),
+				),
 				expectPush(
 					user.NewHumanPasswordChangedEvent(context.Background(),
 						&user.NewAggregate("user1", "org1").Aggregate,
@@ -1181,6 +1214,126 @@ func TestCommandSide_ChangePassword(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "change password, reused password, precondition error",
+			fields: fields{
+				userPasswordHasher: mockPasswordHasher("x"),
+			},
+			args: args{
+				ctx:           context.Background(),
+				userID:        "user1",
+				resourceOwner: "org1",
+				oldPassword:   "password",
+				newPassword:   "password1",
+			},
+			expect: []expect{
+				expectFilter(
+					eventFromEventPusher(
+						user.NewHumanAddedEvent(context.Background(),
+							&user.NewAggregate("user1", "org1").Aggregate,
+							"username",
+							"firstname",
+							"lastname",
+							"nickname",
+							"displayname",
+							language.German,
+							domain.GenderUnspecified,
+							"email@test.ch",
+							true,
+						),
+					),
+					eventFromEventPusher(
+						user.NewHumanPasswordChangedEvent(context.Background(),
+							&user.NewAggregate("user1", "org1").Aggregate,
+							"$plain$x$password1",
+							false,
+							"")),
+					eventFromEventPusher(
+						user.NewHumanPasswordChangedEvent(context.Background(),
+							&user.NewAggregate("user1", "org1").Aggregate,
+							"$plain$x$password",
+							false,
+							"")),
+				),
+				expectFilter(
+					eventFromEventPusher(
+						org.NewPasswordComplexityPolicyAddedEvent(context.Background(),
+							&org.NewAggregate("org1").Aggregate,
+							1,
+							false,
+							false,
+							false,
+							false,
+						),
+					),
+				),
+				expectFilter(
+					eventFromEventPusher(
+						org.NewPasswordAgePolicyAddedEvent(context.Background(),
+							&org.NewAggregate("org1").Aggregate,
+							0,
+							0,
+							5,
+							0,
+						),
+					),
+				),
+			},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "change password, minimum age not reached, precondition error",
+			fields: fields{
+				userPasswordHasher: mockPasswordHasher("x"),
+			},
+			args: args{
+				ctx:           context.Background(),
+				userID:        "user1",
+				resourceOwner: "org1",
+				oldPassword:   "password",
+				newPassword:   "password1",
+			},
+			expect: []expect{
+				expectFilter(
+					eventFromEventPusher(
+						user.NewHumanAddedEvent(context.Background(),
+							&user.NewAggregate("user1", "org1").Aggregate,
+							"username",
+							"firstname",
+							"lastname",
+							"nickname",
+							"displayname",
+							language.German,
+							domain.GenderUnspecified,
+							"email@test.ch",
+							true,
+						),
+					),
+					eventFromEventPusherWithCreationDateNow(
+						user.NewHumanPasswordChangedEvent(context.Background(),
+							&user.NewAggregate("user1", "org1").Aggregate,
+							"$plain$x$password",
+							false,
+							"")),
+				),
+				expectFilter(
+					eventFromEventPusher(
+						org.NewPasswordAgePolicyAddedEvent(context.Background(),
+							&org.NewAggregate("org1").Aggregate,
+							0,
+							0,
+							0,
+							1,
+						),
+					),
+				),
+			},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
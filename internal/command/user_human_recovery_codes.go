@@ -0,0 +1,125 @@
+package command
+
+import (
+	"context"
+	"slices"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func (c *Commands) HumanCheckRecoveryCode(ctx context.Context, userID, code, resourceOwner string, authRequest *domain.AuthRequest) error {
+	commands, err := checkRecoveryCode(
+		ctx,
+		userID,
+		resourceOwner,
+		code,
+		c.eventstore.FilterToQueryReducer,
+		c.secretHasher,
+		authRequestDomainToAuthRequestInfo(authRequest),
+	)
+
+	if len(commands) > 0 {
+		_, pushErr := c.eventstore.Push(ctx, commands...)
+		logging.WithFields("userID", userID).OnError(pushErr).Error("recovery code check push failed")
+	}
+	return err
+}
+
+func checkRecoveryCode(
+	ctx context.Context,
+	userID, resourceOwner, code string,
+	queryReducer func(ctx context.Context, r eventstore.QueryReducer) error,
+	hasher *crypto.Hasher,
+	optionalAuthRequestInfo *user.AuthRequestInfo,
+) (_ []eventstore.Command, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if userID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-ooS4u", "Errors.User.UserIDMissing")
+	}
+	existingCodes := NewHumanRecoveryCodesWriteModel(userID, resourceOwner)
+	err = queryReducer(ctx, existingCodes)
+	if err != nil {
+		return nil, err
+	}
+	if existingCodes.State != domain.MFAStateReady {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Eeth4", "Errors.User.MFA.RecoveryCodes.NotReady")
+	}
+	userAgg := UserAggregateFromWriteModel(&existingCodes.WriteModel)
+	unused := existingCodes.Unused()
+	code = domain.NormalizeRecoveryCode(code)
+	matched := -1
+	for _, index := range unused {
+		if _, verifyErr := hasher.Verify(existingCodes.CodeHashes[index], code); verifyErr == nil {
+			matched = index
+			break
+		}
+	}
+
+	// recheck for additional events (failed checks, locks or codes used in the meantime)
+	recheckErr := queryReducer(ctx, existingCodes)
+	if recheckErr != nil {
+		return nil, recheckErr
+	}
+	if existingCodes.UserLocked {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ahch3", "Errors.User.Locked")
+	}
+
+	// the check succeeded and the code was not used in the meantime
+	if unused = existingCodes.Unused(); matched >= 0 && slices.Contains(unused, matched) {
+		return []eventstore.Command{
+			user.NewHumanRecoveryCodeCheckSucceededEvent(ctx, userAgg, matched, len(unused)-1, optionalAuthRequestInfo),
+		}, nil
+	}
+
+	// the check failed, therefore check if the limit was reached and the user must additionally be locked
+	commands := make([]eventstore.Command, 0, 2)
+	commands = append(commands, user.NewHumanRecoveryCodeCheckFailedEvent(ctx, userAgg, optionalAuthRequestInfo))
+	lockoutPolicy, err := getLockoutPolicy(ctx, existingCodes.ResourceOwner, queryReducer)
+	if err != nil {
+		return nil, err
+	}
+	if lockoutPolicy.MaxOTPAttempts > 0 && existingCodes.CheckFailedCount+1 >= lockoutPolicy.MaxOTPAttempts {
+		commands = append(commands, user.NewUserLockedEvent(ctx, userAgg))
+	}
+	return commands, zerrors.ThrowInvalidArgument(nil, "COMMAND-ieM7s", "Errors.User.MFA.RecoveryCodes.InvalidCode")
+}
+
+// RecoveryCodesLowSent notification sent that the user has only few recovery codes left
+func (c *Commands) RecoveryCodesLowSent(ctx context.Context, orgID, userID string) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if userID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Ohy7a", "Errors.User.UserIDMissing")
+	}
+	existingCodes, err := c.recoveryCodesWriteModelByID(ctx, userID, orgID)
+	if err != nil {
+		return err
+	}
+	if existingCodes.State != domain.MFAStateReady {
+		return zerrors.ThrowNotFound(nil, "COMMAND-ahX7o", "Errors.User.MFA.RecoveryCodes.NotExisting")
+	}
+	_, err = c.eventstore.Push(ctx, user.NewHumanRecoveryCodesLowSentEvent(ctx, UserAggregateFromWriteModel(&existingCodes.WriteModel)))
+	return err
+}
+
+func (c *Commands) recoveryCodesWriteModelByID(ctx context.Context, userID, resourceOwner string) (writeModel *HumanRecoveryCodesWriteModel, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel = NewHumanRecoveryCodesWriteModel(userID, resourceOwner)
+	err = c.eventstore.FilterToQueryReducer(ctx, writeModel)
+	if err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
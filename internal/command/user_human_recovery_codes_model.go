@@ -0,0 +1,98 @@
+package command
+
+import (
+	"slices"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/user"
+)
+
+type HumanRecoveryCodesWriteModel struct {
+	eventstore.WriteModel
+
+	UserState        domain.UserState
+	State            domain.MFAState
+	CodeHashes       []string
+	UsedCodes        []int
+	CheckFailedCount uint64
+	UserLocked       bool
+}
+
+func NewHumanRecoveryCodesWriteModel(userID, resourceOwner string) *HumanRecoveryCodesWriteModel {
+	return &HumanRecoveryCodesWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   userID,
+			ResourceOwner: resourceOwner,
+		},
+	}
+}
+
+func (wm *HumanRecoveryCodesWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *user.HumanAddedEvent, *user.HumanRegisteredEvent:
+			wm.UserState = domain.UserStateActive
+		case *user.HumanRecoveryCodesAddedEvent:
+			wm.State = domain.MFAStateReady
+			wm.CodeHashes = e.CodeHashes
+			wm.UsedCodes = nil
+			wm.CheckFailedCount = 0
+		case *user.HumanRecoveryCodeCheckSucceededEvent:
+			wm.UsedCodes = append(wm.UsedCodes, e.CodeIndex)
+			wm.CheckFailedCount = 0
+		case *user.HumanRecoveryCodeCheckFailedEvent:
+			wm.CheckFailedCount++
+		case *user.UserLockedEvent:
+			wm.UserLocked = true
+		case *user.UserUnlockedEvent:
+			wm.CheckFailedCount = 0
+			wm.UserLocked = false
+		case *user.HumanRecoveryCodesRemovedEvent:
+			wm.State = domain.MFAStateRemoved
+			wm.CodeHashes = nil
+			wm.UsedCodes = nil
+		case *user.UserRemovedEvent:
+			wm.UserState = domain.UserStateDeleted
+			wm.State = domain.MFAStateRemoved
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *HumanRecoveryCodesWriteModel) Query() *eventstore.SearchQueryBuilder {
+	query := eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(user.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			user.UserV1AddedType,
+			user.HumanAddedType,
+			user.UserV1RegisteredType,
+			user.HumanRegisteredType,
+			user.HumanRecoveryCodesAddedType,
+			user.HumanRecoveryCodesRemovedType,
+			user.HumanRecoveryCodeCheckSucceededType,
+			user.HumanRecoveryCodeCheckFailedType,
+			user.UserLockedType,
+			user.UserUnlockedType,
+			user.UserRemovedType,
+		).
+		Builder()
+
+	if wm.ResourceOwner != "" {
+		query.ResourceOwner(wm.ResourceOwner)
+	}
+	return query
+}
+
+// Unused returns the indexes of all codes, which were not used yet.
+func (wm *HumanRecoveryCodesWriteModel) Unused() []int {
+	unused := make([]int, 0, len(wm.CodeHashes))
+	for i := range wm.CodeHashes {
+		if !slices.Contains(wm.UsedCodes, i) {
+			unused = append(unused, i)
+		}
+	}
+	return unused
+}
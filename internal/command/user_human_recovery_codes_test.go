@@ -0,0 +1,373 @@
+package command
+
+import (
+	"context"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommandSide_HumanCheckRecoveryCode(t *testing.T) {
+	ctx := authz.NewMockContext("inst1", "org1", "user1")
+	userAgg := &user.NewAggregate("user1", "org1").Aggregate
+	authRequest := &domain.AuthRequest{
+		ID:      "authRequestID",
+		AgentID: "userAgentID",
+		BrowserInfo: &domain.BrowserInfo{
+			UserAgent:      "user-agent",
+			AcceptLanguage: "en",
+			RemoteIP:       net.IP{192, 0, 2, 1},
+		},
+	}
+	authRequestInfo := &user.AuthRequestInfo{
+		ID:          "authRequestID",
+		UserAgentID: "userAgentID",
+		BrowserInfo: &user.BrowserInfo{
+			UserAgent:      "user-agent",
+			AcceptLanguage: "en",
+			RemoteIP:       net.IP{192, 0, 2, 1},
+		},
+	}
+
+	type fields struct {
+		eventstore   func(*testing.T) *eventstore.Eventstore
+		secretHasher *crypto.Hasher
+	}
+	type args struct {
+		ctx           context.Context
+		userID        string
+		code          string
+		resourceOwner string
+		authRequest   *domain.AuthRequest
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr error
+	}{
+		{
+			name: "userid missing, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				ctx:           ctx,
+				userID:        "",
+				code:          "CODE000001",
+				resourceOwner: "org1",
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "COMMAND-ooS4u", "Errors.User.UserIDMissing"),
+		},
+		{
+			name: "recovery codes not added, precondition failed error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx:           ctx,
+				userID:        "user1",
+				code:          "CODE000001",
+				resourceOwner: "org1",
+			},
+			wantErr: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Eeth4", "Errors.User.MFA.RecoveryCodes.NotReady"),
+		},
+		{
+			name: "invalid code, error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanRecoveryCodesAddedEvent(ctx, userAgg, mockRecoveryCodeHashes()),
+						),
+					),
+					expectFilter(), // recheck
+					expectFilter(
+						eventFromEventPusher(
+							org.NewLockoutPolicyAddedEvent(ctx,
+								&org.NewAggregate("orgID").Aggregate,
+								3, 3, true,
+							),
+						),
+					),
+					expectPush(
+						user.NewHumanRecoveryCodeCheckFailedEvent(ctx, userAgg, authRequestInfo),
+					),
+				),
+				secretHasher: mockPasswordHasher("x"),
+			},
+			args: args{
+				ctx:           ctx,
+				userID:        "user1",
+				code:          "WRONGCODE1",
+				resourceOwner: "org1",
+				authRequest:   authRequest,
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "COMMAND-ieM7s", "Errors.User.MFA.RecoveryCodes.InvalidCode"),
+		},
+		{
+			name: "invalid code, max attempts reached, error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanRecoveryCodesAddedEvent(ctx, userAgg, mockRecoveryCodeHashes()),
+						),
+						eventFromEventPusher(
+							user.NewHumanRecoveryCodeCheckFailedEvent(ctx, userAgg, nil),
+						),
+					),
+					expectFilter(), // recheck
+					expectFilter(
+						eventFromEventPusher(
+							org.NewLockoutPolicyAddedEvent(ctx,
+								&org.NewAggregate("orgID").Aggregate,
+								2, 2, true,
+							),
+						),
+					),
+					expectPush(
+						user.NewHumanRecoveryCodeCheckFailedEvent(ctx, userAgg, authRequestInfo),
+						user.NewUserLockedEvent(ctx, userAgg),
+					),
+				),
+				secretHasher: mockPasswordHasher("x"),
+			},
+			args: args{
+				ctx:           ctx,
+				userID:        "user1",
+				code:          "WRONGCODE1",
+				resourceOwner: "org1",
+				authRequest:   authRequest,
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "COMMAND-ieM7s", "Errors.User.MFA.RecoveryCodes.InvalidCode"),
+		},
+		{
+			name: "code already used, error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanRecoveryCodesAddedEvent(ctx, userAgg, mockRecoveryCodeHashes()),
+						),
+						eventFromEventPusher(
+							user.NewHumanRecoveryCodeCheckSucceededEvent(ctx, userAgg, 0, 9, nil),
+						),
+					),
+					expectFilter(), // recheck
+					expectFilter(
+						eventFromEventPusher(
+							org.NewLockoutPolicyAddedEvent(ctx,
+								&org.NewAggregate("orgID").Aggregate,
+								3, 3, true,
+							),
+						),
+					),
+					expectPush(
+						user.NewHumanRecoveryCodeCheckFailedEvent(ctx, userAgg, authRequestInfo),
+					),
+				),
+				secretHasher: mockPasswordHasher("x"),
+			},
+			args: args{
+				ctx:           ctx,
+				userID:        "user1",
+				code:          "CODE000001",
+				resourceOwner: "org1",
+				authRequest:   authRequest,
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "COMMAND-ieM7s", "Errors.User.MFA.RecoveryCodes.InvalidCode"),
+		},
+		{
+			name: "code ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanRecoveryCodesAddedEvent(ctx, userAgg, mockRecoveryCodeHashes()),
+						),
+						eventFromEventPusher(
+							user.NewHumanRecoveryCodeCheckSucceededEvent(ctx, userAgg, 0, 9, nil),
+						),
+					),
+					expectFilter(), // recheck
+					expectPush(
+						user.NewHumanRecoveryCodeCheckSucceededEvent(ctx, userAgg, 1, 8, authRequestInfo),
+					),
+				),
+				secretHasher: mockPasswordHasher("x"),
+			},
+			args: args{
+				ctx:           ctx,
+				userID:        "user1",
+				code:          "CODE000002",
+				resourceOwner: "org1",
+				authRequest:   authRequest,
+			},
+		},
+		{
+			name: "code ok, normalized",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanRecoveryCodesAddedEvent(ctx, userAgg, mockRecoveryCodeHashes()),
+						),
+					),
+					expectFilter(), // recheck
+					expectPush(
+						user.NewHumanRecoveryCodeCheckSucceededEvent(ctx, userAgg, 2, 9, authRequestInfo),
+					),
+				),
+				secretHasher: mockPasswordHasher("x"),
+			},
+			args: args{
+				ctx:           ctx,
+				userID:        "user1",
+				code:          " code0-00003",
+				resourceOwner: "org1",
+				authRequest:   authRequest,
+			},
+		},
+		{
+			name: "code ok, locked in the meantime",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanRecoveryCodesAddedEvent(ctx, userAgg, mockRecoveryCodeHashes()),
+						),
+					),
+					expectFilter( // recheck
+						eventFromEventPusher(
+							user.NewUserLockedEvent(ctx, userAgg),
+						),
+					),
+				),
+				secretHasher: mockPasswordHasher("x"),
+			},
+			args: args{
+				ctx:           ctx,
+				userID:        "user1",
+				code:          "CODE000001",
+				resourceOwner: "org1",
+				authRequest:   authRequest,
+			},
+			wantErr: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ahch3", "Errors.User.Locked"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore:   tt.fields.eventstore(t),
+				secretHasher: tt.fields.secretHasher,
+			}
+			err := r.HumanCheckRecoveryCode(tt.args.ctx, tt.args.userID, tt.args.code, tt.args.resourceOwner, tt.args.authRequest)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestCommandSide_RecoveryCodesLowSent(t *testing.T) {
+	ctx := authz.NewMockContext("inst1", "org1", "user1")
+	userAgg := &user.NewAggregate("user1", "org1").Aggregate
+
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		orgID  string
+		userID string
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr error
+	}{
+		{
+			name: "userid missing, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				orgID:  "org1",
+				userID: "",
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "COMMAND-Ohy7a", "Errors.User.UserIDMissing"),
+		},
+		{
+			name: "recovery codes not existing, not found error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				orgID:  "org1",
+				userID: "user1",
+			},
+			wantErr: zerrors.ThrowNotFound(nil, "COMMAND-ahX7o", "Errors.User.MFA.RecoveryCodes.NotExisting"),
+		},
+		{
+			name: "push error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanRecoveryCodesAddedEvent(ctx, userAgg, mockRecoveryCodeHashes()),
+						),
+					),
+					expectPushFailed(io.ErrClosedPipe,
+						user.NewHumanRecoveryCodesLowSentEvent(ctx, userAgg),
+					),
+				),
+			},
+			args: args{
+				orgID:  "org1",
+				userID: "user1",
+			},
+			wantErr: io.ErrClosedPipe,
+		},
+		{
+			name: "sent",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanRecoveryCodesAddedEvent(ctx, userAgg, mockRecoveryCodeHashes()),
+						),
+					),
+					expectPush(
+						user.NewHumanRecoveryCodesLowSentEvent(ctx, userAgg),
+					),
+				),
+			},
+			args: args{
+				orgID:  "org1",
+				userID: "user1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			err := r.RecoveryCodesLowSent(ctx, tt.args.orgID, tt.args.userID)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
@@ -475,7 +475,11 @@ func (c *Commands) changeUserPassword(ctx context.Context, cmds []eventstore.Com
 	}
 	// ...or old password
 	if password.OldPassword != "" {
-		verification = c.checkCurrentPassword(password.Password, password.EncodedPasswordHash, password.OldPassword, wm.PasswordEncodedHash)
+		verification = c.checkPasswordMinAge(
+			wm.ResourceOwner,
+			wm.PasswordHistory.ChangeDate,
+			c.checkCurrentPassword(password.Password, password.EncodedPasswordHash, password.OldPassword, wm.PasswordEncodedHash),
+		)
 	}
 	cmd, err := c.setPasswordCommand(
 		ctx,
@@ -486,6 +490,7 @@ func (c *Commands) changeUserPassword(ctx context.Context, cmds []eventstore.Com
 		"",
 		password.ChangeRequired,
 		verification,
+		&wm.PasswordHistory,
 	)
 	if cmd != nil {
 		return append(cmds, cmd), err
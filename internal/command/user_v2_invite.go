@@ -149,6 +149,7 @@ func (c *Commands) VerifyInviteCodeSetPassword(ctx context.Context, userID, code
 			userAgentID,
 			false,
 			nil,
+			nil,
 		)
 		if err != nil {
 			return nil, err
@@ -53,6 +53,7 @@ type UserV2WriteModel struct {
 	PasswordCheckFailedCount   uint64
 	PasswordCodeGeneratorID    string
 	PasswordCodeVerificationID string
+	PasswordHistory            passwordHistory
 
 	EmailWriteModel       bool
 	Email                 domain.EmailAddress
@@ -277,6 +278,9 @@ func (wm *UserV2WriteModel) Reduce() error {
 
 		case *user.HumanPasswordHashUpdatedEvent:
 			wm.PasswordEncodedHash = e.EncodedHash
+			if wm.PasswordWriteModel {
+				wm.PasswordHistory.updated(e.EncodedHash)
+			}
 		case *user.HumanPasswordCheckFailedEvent:
 			wm.PasswordCheckFailedCount += 1
 		case *user.HumanPasswordCheckSucceededEvent:
@@ -284,6 +288,9 @@ func (wm *UserV2WriteModel) Reduce() error {
 		case *user.HumanPasswordChangedEvent:
 			wm.PasswordEncodedHash = crypto.SecretOrEncodedHash(e.Secret, e.EncodedHash)
 			wm.PasswordChangeRequired = e.ChangeRequired
+			if wm.PasswordWriteModel {
+				wm.PasswordHistory.set(wm.PasswordEncodedHash, e.CreationDate())
+			}
 			wm.EmptyPasswordCode()
 		case *user.HumanPasswordCodeAddedEvent:
 			wm.SetPasswordCode(e)
@@ -514,6 +521,9 @@ func (wm *UserV2WriteModel) reduceHumanAddedEvent(e *user.HumanAddedEvent) {
 	wm.UserState = domain.UserStateActive
 	wm.PasswordEncodedHash = crypto.SecretOrEncodedHash(e.Secret, e.EncodedHash)
 	wm.PasswordChangeRequired = e.ChangeRequired
+	if wm.PasswordWriteModel {
+		wm.PasswordHistory.set(wm.PasswordEncodedHash, e.CreationDate())
+	}
 	wm.CreationDate = e.Creation
 }
 
@@ -530,6 +540,9 @@ func (wm *UserV2WriteModel) reduceHumanRegisteredEvent(e *user.HumanRegisteredEv
 	wm.UserState = domain.UserStateActive
 	wm.PasswordEncodedHash = crypto.SecretOrEncodedHash(e.Secret, e.EncodedHash)
 	wm.PasswordChangeRequired = e.ChangeRequired
+	if wm.PasswordWriteModel {
+		wm.PasswordHistory.set(wm.PasswordEncodedHash, e.CreationDate())
+	}
 }
 
 func (wm *UserV2WriteModel) reduceHumanProfileChangedEvent(e *user.HumanProfileChangedEvent) {
@@ -1419,6 +1419,7 @@ func TestCommandSide_userHumanWriteModel_password(t *testing.T) {
 					DisplayName:            "firstname lastname",
 					PreferredLanguage:      language.English,
 					PasswordEncodedHash:    "hash",
+					PasswordHistory:        passwordHistory{EncodedHashes: []string{"hash"}},
 					PasswordChangeRequired: true,
 					Email:                  "email@test.ch",
 					IsEmailVerified:        false,
@@ -1466,6 +1467,7 @@ func TestCommandSide_userHumanWriteModel_password(t *testing.T) {
 					DisplayName:            "firstname lastname",
 					PreferredLanguage:      language.English,
 					PasswordEncodedHash:    "hash",
+					PasswordHistory:        passwordHistory{EncodedHashes: []string{"$plain$x$password", "hash"}},
 					PasswordChangeRequired: false,
 					Email:                  "email@test.ch",
 					IsEmailVerified:        false,
@@ -1521,6 +1523,7 @@ func TestCommandSide_userHumanWriteModel_password(t *testing.T) {
 					DisplayName:            "firstname lastname",
 					PreferredLanguage:      language.English,
 					PasswordEncodedHash:    "$plain$x$password",
+					PasswordHistory:        passwordHistory{EncodedHashes: []string{"$plain$x$password"}},
 					PasswordChangeRequired: true,
 					Email:                  "email@test.ch",
 					IsEmailVerified:        false,
@@ -1592,6 +1595,7 @@ func TestCommandSide_userHumanWriteModel_password(t *testing.T) {
 					DisplayName:            "firstname lastname",
 					PreferredLanguage:      language.English,
 					PasswordEncodedHash:    "hash",
+					PasswordHistory:        passwordHistory{EncodedHashes: []string{"$plain$x$password", "hash"}},
 					PasswordChangeRequired: true,
 					Email:                  "email@test.ch",
 					IsEmailVerified:        false,
@@ -0,0 +1,66 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// GenerateUserRecoveryCodes generates a new set of recovery codes for the user.
+// Previously generated codes are replaced and can no longer be used.
+// The plain codes are only returned once and are never stored.
+func (c *Commands) GenerateUserRecoveryCodes(ctx context.Context, userID, resourceOwner string) (*domain.RecoveryCodes, error) {
+	if userID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Cheo4", "Errors.User.UserIDMissing")
+	}
+	existingCodes, err := c.recoveryCodesWriteModelByID(ctx, userID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.checkPermissionUpdateUserCredentials(ctx, existingCodes.ResourceOwner, userID); err != nil {
+		return nil, err
+	}
+	if !isUserStateExists(existingCodes.UserState) {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Ohc4u", "Errors.User.NotFound")
+	}
+	hashes := make([]string, domain.RecoveryCodesCount)
+	codes := make([]string, domain.RecoveryCodesCount)
+	for i := range codes {
+		hashes[i], codes[i], err = c.newRecoveryCode()
+		if err != nil {
+			return nil, err
+		}
+	}
+	userAgg := UserAggregateFromWriteModel(&existingCodes.WriteModel)
+	if err = c.pushAppendAndReduce(ctx, existingCodes, user.NewHumanRecoveryCodesAddedEvent(ctx, userAgg, hashes)); err != nil {
+		return nil, err
+	}
+	return &domain.RecoveryCodes{
+		ObjectDetails: writeModelToObjectDetails(&existingCodes.WriteModel),
+		Codes:         codes,
+	}, nil
+}
+
+// RemoveUserRecoveryCodes removes all recovery codes of the user.
+func (c *Commands) RemoveUserRecoveryCodes(ctx context.Context, userID, resourceOwner string) (*domain.ObjectDetails, error) {
+	if userID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-iex0E", "Errors.User.UserIDMissing")
+	}
+	existingCodes, err := c.recoveryCodesWriteModelByID(ctx, userID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.checkPermissionUpdateUserCredentials(ctx, existingCodes.ResourceOwner, userID); err != nil {
+		return nil, err
+	}
+	if existingCodes.State != domain.MFAStateReady {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Ug6sh", "Errors.User.MFA.RecoveryCodes.NotExisting")
+	}
+	userAgg := UserAggregateFromWriteModel(&existingCodes.WriteModel)
+	if err = c.pushAppendAndReduce(ctx, existingCodes, user.NewHumanRecoveryCodesRemovedEvent(ctx, userAgg)); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingCodes.WriteModel), nil
+}
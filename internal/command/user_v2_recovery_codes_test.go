@@ -0,0 +1,342 @@
+package command
+
+import (
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func mockRecoveryCodeGenerator() func() (string, string, error) {
+	var i int
+	return func() (string, string, error) {
+		i++
+		code := fmt.Sprintf("CODE%06d", i)
+		return "$plain$x$" + code, code, nil
+	}
+}
+
+func mockRecoveryCodeHashes() []string {
+	generate := mockRecoveryCodeGenerator()
+	hashes := make([]string, domain.RecoveryCodesCount)
+	for i := range hashes {
+		hashes[i], _, _ = generate()
+	}
+	return hashes
+}
+
+func TestCommands_GenerateUserRecoveryCodes(t *testing.T) {
+	ctx := authz.NewMockContext("inst1", "org1", "user1")
+	userAgg := &user.NewAggregate("user1", "org1").Aggregate
+	userAgg2 := &user.NewAggregate("user2", "org1").Aggregate
+
+	humanAddedEvent := func(agg *eventstore.Aggregate) eventstore.Event {
+		return eventFromEventPusher(
+			user.NewHumanAddedEvent(ctx,
+				agg,
+				"username",
+				"firstname",
+				"lastname",
+				"nickname",
+				"displayname",
+				language.German,
+				domain.GenderUnspecified,
+				"email@test.ch",
+				true,
+			),
+		)
+	}
+
+	type fields struct {
+		eventstore      func(t *testing.T) *eventstore.Eventstore
+		checkPermission domain.PermissionCheck
+	}
+	type args struct {
+		userID        string
+		resourceOwner string
+	}
+	tests := []struct {
+		name      string
+		fields    fields
+		args      args
+		wantCodes []string
+		wantErr   error
+	}{
+		{
+			name: "missing user id, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				userID:        "",
+				resourceOwner: "org1",
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "COMMAND-Cheo4", "Errors.User.UserIDMissing"),
+		},
+		{
+			name: "user not found, not found error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				userID:        "user1",
+				resourceOwner: "org1",
+			},
+			wantErr: zerrors.ThrowNotFound(nil, "COMMAND-Ohc4u", "Errors.User.NotFound"),
+		},
+		{
+			name: "other user not found, permission error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+				checkPermission: newMockPermissionCheckNotAllowed(),
+			},
+			args: args{
+				userID:        "user2",
+				resourceOwner: "org1",
+			},
+			wantErr: zerrors.ThrowPermissionDenied(nil, "AUTHZ-HKJD33", "Errors.PermissionDenied"),
+		},
+		{
+			name: "other user, permission error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						humanAddedEvent(userAgg2),
+					),
+				),
+				checkPermission: newMockPermissionCheckNotAllowed(),
+			},
+			args: args{
+				userID:        "user2",
+				resourceOwner: "org1",
+			},
+			wantErr: zerrors.ThrowPermissionDenied(nil, "AUTHZ-HKJD33", "Errors.PermissionDenied"),
+		},
+		{
+			name: "push error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						humanAddedEvent(userAgg),
+					),
+					expectPushFailed(io.ErrClosedPipe,
+						user.NewHumanRecoveryCodesAddedEvent(ctx, userAgg, mockRecoveryCodeHashes()),
+					),
+				),
+			},
+			args: args{
+				userID:        "user1",
+				resourceOwner: "org1",
+			},
+			wantErr: io.ErrClosedPipe,
+		},
+		{
+			name: "success",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						humanAddedEvent(userAgg),
+					),
+					expectPush(
+						user.NewHumanRecoveryCodesAddedEvent(ctx, userAgg, mockRecoveryCodeHashes()),
+					),
+				),
+			},
+			args: args{
+				userID:        "user1",
+				resourceOwner: "org1",
+			},
+			wantCodes: []string{
+				"CODE000001", "CODE000002", "CODE000003", "CODE000004", "CODE000005",
+				"CODE000006", "CODE000007", "CODE000008", "CODE000009", "CODE000010",
+			},
+		},
+		{
+			name: "success, replace existing codes",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						humanAddedEvent(userAgg2),
+						eventFromEventPusher(
+							user.NewHumanRecoveryCodesAddedEvent(ctx, userAgg2, []string{"$plain$x$OLD"}),
+						),
+					),
+					expectPush(
+						user.NewHumanRecoveryCodesAddedEvent(ctx, userAgg2, mockRecoveryCodeHashes()),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args: args{
+				userID:        "user2",
+				resourceOwner: "org1",
+			},
+			wantCodes: []string{
+				"CODE000001", "CODE000002", "CODE000003", "CODE000004", "CODE000005",
+				"CODE000006", "CODE000007", "CODE000008", "CODE000009", "CODE000010",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:      tt.fields.eventstore(t),
+				checkPermission: tt.fields.checkPermission,
+				newRecoveryCode: mockRecoveryCodeGenerator(),
+			}
+			got, err := c.GenerateUserRecoveryCodes(ctx, tt.args.userID, tt.args.resourceOwner)
+			require.ErrorIs(t, err, tt.wantErr)
+			if tt.wantErr != nil {
+				return
+			}
+			assert.Equal(t, tt.wantCodes, got.Codes)
+			assert.Equal(t, tt.args.userID, got.ID)
+			assert.Equal(t, "org1", got.ResourceOwner)
+		})
+	}
+}
+
+func TestCommands_RemoveUserRecoveryCodes(t *testing.T) {
+	ctx := authz.NewMockContext("inst1", "org1", "user1")
+	userAgg := &user.NewAggregate("user1", "org1").Aggregate
+	userAgg2 := &user.NewAggregate("user2", "org1").Aggregate
+
+	type fields struct {
+		eventstore      func(t *testing.T) *eventstore.Eventstore
+		checkPermission domain.PermissionCheck
+	}
+	type args struct {
+		userID        string
+		resourceOwner string
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    *domain.ObjectDetails
+		wantErr error
+	}{
+		{
+			name: "missing user id, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				userID:        "",
+				resourceOwner: "org1",
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "COMMAND-iex0E", "Errors.User.UserIDMissing"),
+		},
+		{
+			name: "codes not existing, not found error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				userID:        "user1",
+				resourceOwner: "org1",
+			},
+			wantErr: zerrors.ThrowNotFound(nil, "COMMAND-Ug6sh", "Errors.User.MFA.RecoveryCodes.NotExisting"),
+		},
+		{
+			name: "other user, codes not existing, permission error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+				checkPermission: newMockPermissionCheckNotAllowed(),
+			},
+			args: args{
+				userID:        "user2",
+				resourceOwner: "org1",
+			},
+			wantErr: zerrors.ThrowPermissionDenied(nil, "AUTHZ-HKJD33", "Errors.PermissionDenied"),
+		},
+		{
+			name: "codes already removed, not found error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanRecoveryCodesAddedEvent(ctx, userAgg, mockRecoveryCodeHashes()),
+						),
+						eventFromEventPusher(
+							user.NewHumanRecoveryCodesRemovedEvent(ctx, userAgg),
+						),
+					),
+				),
+			},
+			args: args{
+				userID:        "user1",
+				resourceOwner: "org1",
+			},
+			wantErr: zerrors.ThrowNotFound(nil, "COMMAND-Ug6sh", "Errors.User.MFA.RecoveryCodes.NotExisting"),
+		},
+		{
+			name: "other user, permission error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanRecoveryCodesAddedEvent(ctx, userAgg2, mockRecoveryCodeHashes()),
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckNotAllowed(),
+			},
+			args: args{
+				userID:        "user2",
+				resourceOwner: "org1",
+			},
+			wantErr: zerrors.ThrowPermissionDenied(nil, "AUTHZ-HKJD33", "Errors.PermissionDenied"),
+		},
+		{
+			name: "success",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanRecoveryCodesAddedEvent(ctx, userAgg, mockRecoveryCodeHashes()),
+						),
+					),
+					expectPush(
+						user.NewHumanRecoveryCodesRemovedEvent(ctx, userAgg),
+					),
+				),
+			},
+			args: args{
+				userID:        "user1",
+				resourceOwner: "org1",
+			},
+			want: &domain.ObjectDetails{
+				ResourceOwner: "org1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:      tt.fields.eventstore(t),
+				checkPermission: tt.fields.checkPermission,
+			}
+			got, err := c.RemoveUserRecoveryCodes(ctx, tt.args.userID, tt.args.resourceOwner)
+			require.ErrorIs(t, err, tt.wantErr)
+			assertObjectDetails(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,270 @@
+package command
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+
+	"github.com/go-jose/go-jose/v4"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
+	"github.com/zitadel/zitadel/internal/repository/workloadidentity"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// AddWorkloadIdentityTrust configures an external issuer (e.g. a Kubernetes cluster or a CI system),
+// whose JWTs can be exchanged for tokens of the machine users mapped by the rules.
+type AddWorkloadIdentityTrust struct {
+	models.ObjectRoot
+
+	Name string
+	// Issuer must match the iss claim of the external tokens
+	Issuer string
+	// JWKSEndpoint is the URL the public keys of the issuer are fetched from.
+	// Either the JWKSEndpoint or the static JWKS must be set.
+	JWKSEndpoint string
+	JWKS         []byte
+	// Audience must contain at least one of the values of the aud claim of the external tokens
+	Audience []string
+	Rules    []*workloadidentity.Rule
+}
+
+func (a *AddWorkloadIdentityTrust) IsValid() error {
+	if a.Name == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-ooZ4a", "Errors.WorkloadIdentityTrust.Invalid")
+	}
+	if a.Issuer == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-eiH0u", "Errors.WorkloadIdentityTrust.InvalidIssuer")
+	}
+	if err := validateWorkloadIdentityKeys(a.JWKSEndpoint, a.JWKS); err != nil {
+		return err
+	}
+	if err := validateWorkloadIdentityAudience(a.Audience); err != nil {
+		return err
+	}
+	return validateWorkloadIdentityRules(a.Rules)
+}
+
+func validateWorkloadIdentityKeys(jwksEndpoint string, jwks []byte) error {
+	if (jwksEndpoint == "") == (len(jwks) == 0) {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Oosh8", "Errors.WorkloadIdentityTrust.InvalidKeys")
+	}
+	if jwksEndpoint != "" {
+		u, err := url.Parse(jwksEndpoint)
+		if err != nil || u.Scheme != "https" || u.Host == "" {
+			return zerrors.ThrowInvalidArgument(err, "COMMAND-uu4Ei", "Errors.WorkloadIdentityTrust.InvalidKeys")
+		}
+		return nil
+	}
+	keySet := new(jose.JSONWebKeySet)
+	if err := json.Unmarshal(jwks, keySet); err != nil || len(keySet.Keys) == 0 {
+		return zerrors.ThrowInvalidArgument(err, "COMMAND-Ahd5e", "Errors.WorkloadIdentityTrust.InvalidKeys")
+	}
+	for _, key := range keySet.Keys {
+		if !key.Valid() || !key.IsPublic() {
+			return zerrors.ThrowInvalidArgument(nil, "COMMAND-Thai6", "Errors.WorkloadIdentityTrust.InvalidKeys")
+		}
+	}
+	return nil
+}
+
+func validateWorkloadIdentityAudience(audience []string) error {
+	if len(audience) == 0 {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-xei3O", "Errors.WorkloadIdentityTrust.NoAudience")
+	}
+	for _, aud := range audience {
+		if aud == "" {
+			return zerrors.ThrowInvalidArgument(nil, "COMMAND-Vu3ie", "Errors.WorkloadIdentityTrust.NoAudience")
+		}
+	}
+	return nil
+}
+
+// validateWorkloadIdentityRules requires at least one condition per rule,
+// so a rule never matches all tokens of the issuer by accident.
+func validateWorkloadIdentityRules(rules []*workloadidentity.Rule) error {
+	if len(rules) == 0 {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-aiL6o", "Errors.WorkloadIdentityTrust.InvalidRule")
+	}
+	for _, rule := range rules {
+		if rule == nil || rule.UserID == "" || len(rule.Conditions) == 0 {
+			return zerrors.ThrowInvalidArgument(nil, "COMMAND-Ra7ie", "Errors.WorkloadIdentityTrust.InvalidRule")
+		}
+		for claim, value := range rule.Conditions {
+			if claim == "" || value == "" || value == "*" {
+				return zerrors.ThrowInvalidArgument(nil, "COMMAND-ohL2u", "Errors.WorkloadIdentityTrust.InvalidRule")
+			}
+		}
+	}
+	return nil
+}
+
+func (c *Commands) AddWorkloadIdentityTrust(ctx context.Context, add *AddWorkloadIdentityTrust, resourceOwner string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if resourceOwner == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-shoo0", "Errors.ResourceOwnerMissing")
+	}
+	if err := add.IsValid(); err != nil {
+		return nil, err
+	}
+	if err := c.checkWorkloadIdentityRuleUsers(ctx, add.Rules, resourceOwner); err != nil {
+		return nil, err
+	}
+	if add.AggregateID == "" {
+		add.AggregateID, err = c.idGenerator.Next()
+		if err != nil {
+			return nil, err
+		}
+	}
+	wm, err := c.getWorkloadIdentityTrustWriteModelByID(ctx, add.AggregateID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if wm.State.Exists() {
+		return nil, zerrors.ThrowAlreadyExists(nil, "COMMAND-Ua9ah", "Errors.WorkloadIdentityTrust.AlreadyExists")
+	}
+	if err := c.pushAppendAndReduce(ctx, wm, workloadidentity.NewAddedEvent(
+		ctx,
+		WorkloadIdentityTrustAggregateFromWriteModel(ctx, &wm.WriteModel),
+		add.Name,
+		add.Issuer,
+		add.JWKSEndpoint,
+		add.JWKS,
+		add.Audience,
+		add.Rules,
+	)); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&wm.WriteModel), nil
+}
+
+type ChangeWorkloadIdentityTrust struct {
+	models.ObjectRoot
+
+	Name   *string
+	Issuer *string
+	// JWKSEndpoint and JWKS are only changed together, if either of them is set
+	JWKSEndpoint string
+	JWKS         []byte
+	Audience     []string
+	Rules        []*workloadidentity.Rule
+}
+
+func (a *ChangeWorkloadIdentityTrust) keysChanged() bool {
+	return a.JWKSEndpoint != "" || len(a.JWKS) > 0
+}
+
+func (a *ChangeWorkloadIdentityTrust) IsValid() error {
+	if a.AggregateID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Ieb8a", "Errors.IDMissing")
+	}
+	if a.Name != nil && *a.Name == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-iu0Ae", "Errors.WorkloadIdentityTrust.Invalid")
+	}
+	if a.Issuer != nil && *a.Issuer == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Mai7j", "Errors.WorkloadIdentityTrust.InvalidIssuer")
+	}
+	if a.keysChanged() {
+		if err := validateWorkloadIdentityKeys(a.JWKSEndpoint, a.JWKS); err != nil {
+			return err
+		}
+	}
+	if a.Audience != nil {
+		if err := validateWorkloadIdentityAudience(a.Audience); err != nil {
+			return err
+		}
+	}
+	if a.Rules != nil {
+		return validateWorkloadIdentityRules(a.Rules)
+	}
+	return nil
+}
+
+func (c *Commands) ChangeWorkloadIdentityTrust(ctx context.Context, change *ChangeWorkloadIdentityTrust, resourceOwner string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if resourceOwner == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Phe9e", "Errors.ResourceOwnerMissing")
+	}
+	if err := change.IsValid(); err != nil {
+		return nil, err
+	}
+	existing, err := c.getWorkloadIdentityTrustWriteModelByID(ctx, change.AggregateID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !existing.State.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-yoh8E", "Errors.WorkloadIdentityTrust.NotFound")
+	}
+	changedEvent := existing.NewChangedEvent(
+		ctx,
+		WorkloadIdentityTrustAggregateFromWriteModel(ctx, &existing.WriteModel),
+		change,
+	)
+	if changedEvent == nil {
+		return writeModelToObjectDetails(&existing.WriteModel), nil
+	}
+	if changedEvent.Rules != nil {
+		if err := c.checkWorkloadIdentityRuleUsers(ctx, changedEvent.Rules, resourceOwner); err != nil {
+			return nil, err
+		}
+	}
+	if err := c.pushAppendAndReduce(ctx, existing, changedEvent); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existing.WriteModel), nil
+}
+
+func (c *Commands) RemoveWorkloadIdentityTrust(ctx context.Context, id, resourceOwner string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if id == "" || resourceOwner == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-eeL5i", "Errors.IDMissing")
+	}
+	existing, err := c.getWorkloadIdentityTrustWriteModelByID(ctx, id, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !existing.State.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Xoo6e", "Errors.WorkloadIdentityTrust.NotFound")
+	}
+	if err := c.pushAppendAndReduce(ctx, existing,
+		workloadidentity.NewRemovedEvent(ctx, WorkloadIdentityTrustAggregateFromWriteModel(ctx, &existing.WriteModel)),
+	); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existing.WriteModel), nil
+}
+
+// checkWorkloadIdentityRuleUsers ensures the rules only map to machine users of the organization of the trust.
+func (c *Commands) checkWorkloadIdentityRuleUsers(ctx context.Context, rules []*workloadidentity.Rule, resourceOwner string) error {
+	checked := make(map[string]struct{}, len(rules))
+	for _, rule := range rules {
+		if _, ok := checked[rule.UserID]; ok {
+			continue
+		}
+		machine, err := getMachineWriteModel(ctx, rule.UserID, resourceOwner, c.eventstore.Filter) //nolint:staticcheck
+		if err != nil {
+			return err
+		}
+		if !machine.UserState.Exists() {
+			return zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ohr3a", "Errors.WorkloadIdentityTrust.UserNotMachine")
+		}
+		checked[rule.UserID] = struct{}{}
+	}
+	return nil
+}
+
+func (c *Commands) getWorkloadIdentityTrustWriteModelByID(ctx context.Context, id, resourceOwner string) (*WorkloadIdentityTrustWriteModel, error) {
+	wm := NewWorkloadIdentityTrustWriteModel(id, resourceOwner)
+	if err := c.eventstore.FilterToQueryReducer(ctx, wm); err != nil {
+		return nil, err
+	}
+	return wm, nil
+}
@@ -0,0 +1,118 @@
+package command
+
+import (
+	"bytes"
+	"context"
+	"maps"
+	"slices"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/workloadidentity"
+)
+
+type WorkloadIdentityTrustWriteModel struct {
+	eventstore.WriteModel
+
+	Name         string
+	Issuer       string
+	JWKSEndpoint string
+	JWKS         []byte
+	Audience     []string
+	Rules        []*workloadidentity.Rule
+
+	State domain.WorkloadIdentityTrustState
+}
+
+func NewWorkloadIdentityTrustWriteModel(id, resourceOwner string) *WorkloadIdentityTrustWriteModel {
+	return &WorkloadIdentityTrustWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   id,
+			ResourceOwner: resourceOwner,
+		},
+	}
+}
+
+func (wm *WorkloadIdentityTrustWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *workloadidentity.AddedEvent:
+			wm.Name = e.Name
+			wm.Issuer = e.Issuer
+			wm.JWKSEndpoint = e.JWKSEndpoint
+			wm.JWKS = e.JWKS
+			wm.Audience = e.Audience
+			wm.Rules = e.Rules
+			wm.State = domain.WorkloadIdentityTrustStateActive
+		case *workloadidentity.ChangedEvent:
+			if e.Name != nil {
+				wm.Name = *e.Name
+			}
+			if e.Issuer != nil {
+				wm.Issuer = *e.Issuer
+			}
+			if e.JWKSEndpoint != nil {
+				wm.JWKSEndpoint = *e.JWKSEndpoint
+				wm.JWKS = e.JWKS
+			}
+			if e.Audience != nil {
+				wm.Audience = e.Audience
+			}
+			if e.Rules != nil {
+				wm.Rules = e.Rules
+			}
+		case *workloadidentity.RemovedEvent:
+			wm.State = domain.WorkloadIdentityTrustStateRemoved
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *WorkloadIdentityTrustWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(workloadidentity.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			workloadidentity.AddedEventType,
+			workloadidentity.ChangedEventType,
+			workloadidentity.RemovedEventType,
+		).
+		Builder()
+}
+
+func (wm *WorkloadIdentityTrustWriteModel) NewChangedEvent(
+	ctx context.Context,
+	agg *eventstore.Aggregate,
+	change *ChangeWorkloadIdentityTrust,
+) *workloadidentity.ChangedEvent {
+	changes := make([]workloadidentity.Changes, 0, 5)
+	if change.Name != nil && wm.Name != *change.Name {
+		changes = append(changes, workloadidentity.ChangeName(*change.Name))
+	}
+	if change.Issuer != nil && wm.Issuer != *change.Issuer {
+		changes = append(changes, workloadidentity.ChangeIssuer(*change.Issuer))
+	}
+	if change.keysChanged() && (wm.JWKSEndpoint != change.JWKSEndpoint || !bytes.Equal(wm.JWKS, change.JWKS)) {
+		changes = append(changes, workloadidentity.ChangeKeys(change.JWKSEndpoint, change.JWKS))
+	}
+	if change.Audience != nil && !slices.Equal(wm.Audience, change.Audience) {
+		changes = append(changes, workloadidentity.ChangeAudience(change.Audience))
+	}
+	if change.Rules != nil && !slices.EqualFunc(wm.Rules, change.Rules, equalWorkloadIdentityRule) {
+		changes = append(changes, workloadidentity.ChangeRules(change.Rules))
+	}
+	if len(changes) == 0 {
+		return nil
+	}
+	return workloadidentity.NewChangedEvent(ctx, agg, changes)
+}
+
+func equalWorkloadIdentityRule(a, b *workloadidentity.Rule) bool {
+	return a.UserID == b.UserID && maps.Equal(a.Conditions, b.Conditions)
+}
+
+func WorkloadIdentityTrustAggregateFromWriteModel(ctx context.Context, wm *eventstore.WriteModel) *eventstore.Aggregate {
+	return eventstore.AggregateFromWriteModelCtx(ctx, wm, workloadidentity.AggregateType, workloadidentity.AggregateVersion)
+}
@@ -0,0 +1,445 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/muhlemmer/gu"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
+	"github.com/zitadel/zitadel/internal/id"
+	"github.com/zitadel/zitadel/internal/id/mock"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/repository/workloadidentity"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const workloadIdentityTestJWKS = `{"keys":[{"kty":"EC","crv":"P-256","kid":"key1","x":"MKBCTNIcKUSDii11ySs3526iDZ8AiTo7Tu6KPAqv7D4","y":"4Etl6SRW2YiLUrN5vfvVHuhp7x8PxltmWWlbbM4IFyM"}]}`
+
+func workloadIdentityTestRules() []*workloadidentity.Rule {
+	return []*workloadidentity.Rule{
+		{
+			Conditions: map[string]string{"sub": "system:serviceaccount:ci:*"},
+			UserID:     "machine1",
+		},
+	}
+}
+
+func workloadIdentityTestAddedEvent() *workloadidentity.AddedEvent {
+	return workloadidentity.NewAddedEvent(context.Background(),
+		workloadidentity.NewAggregate("trust1", "org1", ""),
+		"name",
+		"https://kubernetes.default.svc",
+		"https://kubernetes.example.com/openid/v1/jwks",
+		nil,
+		[]string{"zitadel"},
+		workloadIdentityTestRules(),
+	)
+}
+
+func workloadIdentityTestMachineAddedEvent() eventstore.Event {
+	return eventFromEventPusher(
+		user.NewMachineAddedEvent(context.Background(),
+			&user.NewAggregate("machine1", "org1").Aggregate,
+			"machine",
+			"machine",
+			"",
+			true,
+			domain.OIDCTokenTypeBearer,
+		),
+	)
+}
+
+func TestCommands_AddWorkloadIdentityTrust(t *testing.T) {
+	type fields struct {
+		eventstore  func(t *testing.T) *eventstore.Eventstore
+		idGenerator id.Generator
+	}
+	type args struct {
+		add           *AddWorkloadIdentityTrust
+		resourceOwner string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "no resource owner, error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				add: &AddWorkloadIdentityTrust{},
+			},
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-shoo0", "Errors.ResourceOwnerMissing"),
+			},
+		},
+		{
+			name: "no issuer, error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				add: &AddWorkloadIdentityTrust{
+					Name:         "name",
+					JWKSEndpoint: "https://kubernetes.example.com/openid/v1/jwks",
+					Audience:     []string{"zitadel"},
+					Rules:        workloadIdentityTestRules(),
+				},
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-eiH0u", "Errors.WorkloadIdentityTrust.InvalidIssuer"),
+			},
+		},
+		{
+			name: "jwks endpoint and jwks, error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				add: &AddWorkloadIdentityTrust{
+					Name:         "name",
+					Issuer:       "https://kubernetes.default.svc",
+					JWKSEndpoint: "https://kubernetes.example.com/openid/v1/jwks",
+					JWKS:         []byte(workloadIdentityTestJWKS),
+					Audience:     []string{"zitadel"},
+					Rules:        workloadIdentityTestRules(),
+				},
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-Oosh8", "Errors.WorkloadIdentityTrust.InvalidKeys"),
+			},
+		},
+		{
+			name: "http jwks endpoint, error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				add: &AddWorkloadIdentityTrust{
+					Name:         "name",
+					Issuer:       "https://kubernetes.default.svc",
+					JWKSEndpoint: "http://kubernetes.example.com/openid/v1/jwks",
+					Audience:     []string{"zitadel"},
+					Rules:        workloadIdentityTestRules(),
+				},
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-uu4Ei", "Errors.WorkloadIdentityTrust.InvalidKeys"),
+			},
+		},
+		{
+			name: "invalid jwks, error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				add: &AddWorkloadIdentityTrust{
+					Name:     "name",
+					Issuer:   "https://kubernetes.default.svc",
+					JWKS:     []byte(`{"keys":[]}`),
+					Audience: []string{"zitadel"},
+					Rules:    workloadIdentityTestRules(),
+				},
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-Ahd5e", "Errors.WorkloadIdentityTrust.InvalidKeys"),
+			},
+		},
+		{
+			name: "no audience, error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				add: &AddWorkloadIdentityTrust{
+					Name:         "name",
+					Issuer:       "https://kubernetes.default.svc",
+					JWKSEndpoint: "https://kubernetes.example.com/openid/v1/jwks",
+					Rules:        workloadIdentityTestRules(),
+				},
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-xei3O", "Errors.WorkloadIdentityTrust.NoAudience"),
+			},
+		},
+		{
+			name: "rule without conditions, error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				add: &AddWorkloadIdentityTrust{
+					Name:         "name",
+					Issuer:       "https://kubernetes.default.svc",
+					JWKSEndpoint: "https://kubernetes.example.com/openid/v1/jwks",
+					Audience:     []string{"zitadel"},
+					Rules:        []*workloadidentity.Rule{{UserID: "machine1"}},
+				},
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-Ra7ie", "Errors.WorkloadIdentityTrust.InvalidRule"),
+			},
+		},
+		{
+			name: "rule matching any value, error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				add: &AddWorkloadIdentityTrust{
+					Name:         "name",
+					Issuer:       "https://kubernetes.default.svc",
+					JWKSEndpoint: "https://kubernetes.example.com/openid/v1/jwks",
+					Audience:     []string{"zitadel"},
+					Rules: []*workloadidentity.Rule{{
+						Conditions: map[string]string{"sub": "*"},
+						UserID:     "machine1",
+					}},
+				},
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-ohL2u", "Errors.WorkloadIdentityTrust.InvalidRule"),
+			},
+		},
+		{
+			name: "machine user not found, error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				add: &AddWorkloadIdentityTrust{
+					Name:         "name",
+					Issuer:       "https://kubernetes.default.svc",
+					JWKSEndpoint: "https://kubernetes.example.com/openid/v1/jwks",
+					Audience:     []string{"zitadel"},
+					Rules:        workloadIdentityTestRules(),
+				},
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ohr3a", "Errors.WorkloadIdentityTrust.UserNotMachine"),
+			},
+		},
+		{
+			name: "add, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						workloadIdentityTestMachineAddedEvent(),
+					),
+					expectFilter(),
+					expectPush(
+						workloadIdentityTestAddedEvent(),
+					),
+				),
+				idGenerator: mock.ExpectID(t, "trust1"),
+			},
+			args: args{
+				add: &AddWorkloadIdentityTrust{
+					Name:         "name",
+					Issuer:       "https://kubernetes.default.svc",
+					JWKSEndpoint: "https://kubernetes.example.com/openid/v1/jwks",
+					Audience:     []string{"zitadel"},
+					Rules:        workloadIdentityTestRules(),
+				},
+				resourceOwner: "org1",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+					ID:            "trust1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:  tt.fields.eventstore(t),
+				idGenerator: tt.fields.idGenerator,
+			}
+			got, err := c.AddWorkloadIdentityTrust(context.Background(), tt.args.add, tt.args.resourceOwner)
+			assert.ErrorIs(t, err, tt.res.err)
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommands_ChangeWorkloadIdentityTrust(t *testing.T) {
+	type args struct {
+		change *ChangeWorkloadIdentityTrust
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  error
+	}
+	tests := []struct {
+		name       string
+		eventstore func(t *testing.T) *eventstore.Eventstore
+		args       args
+		res        res
+	}{
+		{
+			name:       "empty name, error",
+			eventstore: expectEventstore(),
+			args: args{
+				change: &ChangeWorkloadIdentityTrust{
+					ObjectRoot: models.ObjectRoot{AggregateID: "trust1"},
+					Name:       gu.Ptr(""),
+				},
+			},
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-iu0Ae", "Errors.WorkloadIdentityTrust.Invalid"),
+			},
+		},
+		{
+			name: "not found, error",
+			eventstore: expectEventstore(
+				expectFilter(),
+			),
+			args: args{
+				change: &ChangeWorkloadIdentityTrust{
+					ObjectRoot: models.ObjectRoot{AggregateID: "trust1"},
+					Name:       gu.Ptr("name2"),
+				},
+			},
+			res: res{
+				err: zerrors.ThrowNotFound(nil, "COMMAND-yoh8E", "Errors.WorkloadIdentityTrust.NotFound"),
+			},
+		},
+		{
+			name: "no changes, ok",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(workloadIdentityTestAddedEvent()),
+				),
+			),
+			args: args{
+				change: &ChangeWorkloadIdentityTrust{
+					ObjectRoot: models.ObjectRoot{AggregateID: "trust1"},
+					Name:       gu.Ptr("name"),
+					Rules:      workloadIdentityTestRules(),
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+					ID:            "trust1",
+				},
+			},
+		},
+		{
+			name: "change keys and rules, ok",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(workloadIdentityTestAddedEvent()),
+				),
+				expectFilter(
+					workloadIdentityTestMachineAddedEvent(),
+				),
+				expectPush(
+					workloadidentity.NewChangedEvent(context.Background(),
+						workloadidentity.NewAggregate("trust1", "org1", ""),
+						[]workloadidentity.Changes{
+							workloadidentity.ChangeKeys("", []byte(workloadIdentityTestJWKS)),
+							workloadidentity.ChangeRules([]*workloadidentity.Rule{
+								{
+									Conditions: map[string]string{"sub": "system:serviceaccount:ci:deployer"},
+									UserID:     "machine1",
+								},
+							}),
+						},
+					),
+				),
+			),
+			args: args{
+				change: &ChangeWorkloadIdentityTrust{
+					ObjectRoot: models.ObjectRoot{AggregateID: "trust1"},
+					JWKS:       []byte(workloadIdentityTestJWKS),
+					Rules: []*workloadidentity.Rule{
+						{
+							Conditions: map[string]string{"sub": "system:serviceaccount:ci:deployer"},
+							UserID:     "machine1",
+						},
+					},
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+					ID:            "trust1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.eventstore(t),
+			}
+			got, err := c.ChangeWorkloadIdentityTrust(context.Background(), tt.args.change, "org1")
+			assert.ErrorIs(t, err, tt.res.err)
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommands_RemoveWorkloadIdentityTrust(t *testing.T) {
+	tests := []struct {
+		name       string
+		eventstore func(t *testing.T) *eventstore.Eventstore
+		wantErr    error
+	}{
+		{
+			name: "not found, error",
+			eventstore: expectEventstore(
+				expectFilter(),
+			),
+			wantErr: zerrors.ThrowNotFound(nil, "COMMAND-Xoo6e", "Errors.WorkloadIdentityTrust.NotFound"),
+		},
+		{
+			name: "remove, ok",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(workloadIdentityTestAddedEvent()),
+				),
+				expectPush(
+					workloadidentity.NewRemovedEvent(context.Background(), workloadidentity.NewAggregate("trust1", "org1", "")),
+				),
+			),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.eventstore(t),
+			}
+			_, err := c.RemoveWorkloadIdentityTrust(context.Background(), "trust1", "org1")
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
@@ -25,6 +25,13 @@ type TLS struct {
 	Key []byte
 	//Certificate for the TLS connection (CertPath will this overwrite, if specified)
 	Cert []byte
+	//If enabled, ZITADEL requests (but does not require) a certificate from the clients,
+	//which is used for the mutual-TLS client authentication and certificate-bound access tokens (RFC 8705)
+	RequestClientCertificate bool
+	//Name of the header a trusted reverse proxy terminating TLS passes the client certificate in,
+	//either as URL encoded PEM or base64 encoded DER.
+	//If set, the client certificate of the connection is ignored.
+	ClientCertificateHeader string
 }
 
 func (t *TLS) Config() (_ *tls.Config, err error) {
@@ -50,7 +57,12 @@ func (t *TLS) Config() (_ *tls.Config, err error) {
 	if err != nil {
 		return nil, err
 	}
-	return &tls.Config{
+	config := &tls.Config{
 		Certificates: []tls.Certificate{tlsCert},
-	}, nil
+	}
+	if t.RequestClientCertificate {
+		// the certificates are verified depending on the client authentication method of the application
+		config.ClientAuth = tls.RequestClientCert
+	}
+	return config, nil
 }
@@ -0,0 +1,15 @@
+package domain
+
+type AccessRequestState int32
+
+const (
+	AccessRequestStateUnspecified AccessRequestState = iota
+	AccessRequestStatePending
+	AccessRequestStateApproved
+	AccessRequestStateDenied
+	AccessRequestStateWithdrawn
+)
+
+func (s AccessRequestState) Exists() bool {
+	return s != AccessRequestStateUnspecified
+}
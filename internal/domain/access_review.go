@@ -0,0 +1,38 @@
+package domain
+
+type AccessReviewState int32
+
+const (
+	AccessReviewStateUnspecified AccessReviewState = iota
+	AccessReviewStateActive
+	AccessReviewStateClosed
+)
+
+func (s AccessReviewState) Exists() bool {
+	return s != AccessReviewStateUnspecified
+}
+
+// AccessReviewItemType defines the kind of access which is reviewed.
+type AccessReviewItemType int32
+
+const (
+	AccessReviewItemTypeUnspecified AccessReviewItemType = iota
+	AccessReviewItemTypeUserGrant
+	AccessReviewItemTypeOrgMember
+	AccessReviewItemTypeProjectMember
+)
+
+type AccessReviewDecision int32
+
+const (
+	AccessReviewDecisionPending AccessReviewDecision = iota
+	AccessReviewDecisionKeep
+	AccessReviewDecisionRevoke
+	// AccessReviewDecisionExpired is set if the item was not reviewed until the deadline,
+	// the access is revoked.
+	AccessReviewDecisionExpired
+)
+
+func (d AccessReviewDecision) IsRevoked() bool {
+	return d == AccessReviewDecisionRevoke || d == AccessReviewDecisionExpired
+}
@@ -24,30 +24,39 @@ const (
 type OIDCApp struct {
 	models.ObjectRoot
 
-	AppID                    string
-	AppName                  string
-	ClientID                 string
-	EncodedHash              string
-	ClientSecretString       string
-	RedirectUris             []string
-	ResponseTypes            []OIDCResponseType
-	GrantTypes               []OIDCGrantType
-	ApplicationType          OIDCApplicationType
-	AuthMethodType           OIDCAuthMethodType
-	PostLogoutRedirectUris   []string
-	OIDCVersion              OIDCVersion
-	Compliance               *Compliance
-	DevMode                  bool
-	AccessTokenType          OIDCTokenType
-	AccessTokenRoleAssertion bool
-	IDTokenRoleAssertion     bool
-	IDTokenUserinfoAssertion bool
-	ClockSkew                time.Duration
-	AdditionalOrigins        []string
-	SkipNativeAppSuccessPage bool
-	BackChannelLogoutURI     string
-	LoginVersion             LoginVersion
-	LoginBaseURI             string
+	AppID                                 string
+	AppName                               string
+	ClientID                              string
+	EncodedHash                           string
+	ClientSecretString                    string
+	RedirectUris                          []string
+	ResponseTypes                         []OIDCResponseType
+	GrantTypes                            []OIDCGrantType
+	ApplicationType                       OIDCApplicationType
+	AuthMethodType                        OIDCAuthMethodType
+	PostLogoutRedirectUris                []string
+	OIDCVersion                           OIDCVersion
+	Compliance                            *Compliance
+	DevMode                               bool
+	AccessTokenType                       OIDCTokenType
+	AccessTokenRoleAssertion              bool
+	IDTokenRoleAssertion                  bool
+	IDTokenUserinfoAssertion              bool
+	ClockSkew                             time.Duration
+	AdditionalOrigins                     []string
+	SkipNativeAppSuccessPage              bool
+	BackChannelLogoutURI                  string
+	LoginVersion                          LoginVersion
+	LoginBaseURI                          string
+	DPoPBoundAccessTokens                 bool
+	RequirePushedAuthRequests             bool
+	CIBANotificationURI                   string
+	ConsentRequired                       bool
+	TLSClientAuthSubjectDN                string
+	TLSClientCertificates                 []string
+	TLSClientCertificateBoundAccessTokens bool
+	RefreshTokenRotation                  OIDCRefreshTokenRotation
+	EncryptAuthorizationResponse          bool
 
 	State AppState
 }
@@ -95,8 +104,24 @@ const (
 	OIDCResponseModeQuery
 	OIDCResponseModeFragment
 	OIDCResponseModeFormPost
+	// JWT secured authorization response modes (JARM)
+	OIDCResponseModeQueryJWT
+	OIDCResponseModeFragmentJWT
+	OIDCResponseModeFormPostJWT
+	OIDCResponseModeJWT
 )
 
+// IsJWT reports whether the authorization response is returned as signed (and optionally encrypted) JWT.
+func (m OIDCResponseMode) IsJWT() bool {
+	switch m {
+	case OIDCResponseModeQueryJWT, OIDCResponseModeFragmentJWT, OIDCResponseModeFormPostJWT, OIDCResponseModeJWT:
+		return true
+	case OIDCResponseModeUnspecified, OIDCResponseModeQuery, OIDCResponseModeFragment, OIDCResponseModeFormPost:
+		return false
+	}
+	return false
+}
+
 type OIDCGrantType int32
 
 const (
@@ -105,6 +130,7 @@ const (
 	OIDCGrantTypeRefreshToken
 	OIDCGrantTypeDeviceCode
 	OIDCGrantTypeTokenExchange
+	OIDCGrantTypeCIBA
 )
 
 type OIDCApplicationType int32
@@ -122,6 +148,8 @@ const (
 	OIDCAuthMethodTypePost
 	OIDCAuthMethodTypeNone
 	OIDCAuthMethodTypePrivateKeyJWT
+	OIDCAuthMethodTypeTLSClientAuth
+	OIDCAuthMethodTypeSelfSignedTLSClientAuth
 )
 
 type Compliance struct {
@@ -136,6 +164,22 @@ const (
 	OIDCTokenTypeJWT
 )
 
+// OIDCRefreshTokenRotation defines how the reuse of an already rotated refresh token is handled.
+type OIDCRefreshTokenRotation int32
+
+const (
+	// OIDCRefreshTokenRotationDefault only rejects the reused refresh token.
+	OIDCRefreshTokenRotationDefault OIDCRefreshTokenRotation = iota
+	// OIDCRefreshTokenRotationStrict treats the reuse as compromise and revokes the whole token family of the session.
+	OIDCRefreshTokenRotationStrict
+	// OIDCRefreshTokenRotationStrictNotify additionally notifies the user about the reuse.
+	OIDCRefreshTokenRotationStrictNotify
+)
+
+func (r OIDCRefreshTokenRotation) IsStrict() bool {
+	return r == OIDCRefreshTokenRotationStrict || r == OIDCRefreshTokenRotationStrictNotify
+}
+
 func (a *OIDCApp) IsValid() bool {
 	if a.ClockSkew > time.Second*5 || a.ClockSkew < time.Second*0 || !a.OriginsValid() {
 		return false
@@ -173,11 +217,14 @@ func RequiredOIDCGrantTypes(responseTypes []OIDCResponseType, grantTypesSet []OI
 	for _, r := range responseTypes {
 		switch r {
 		case OIDCResponseTypeCode:
-			// #5684 when "Device Code" is selected, "Authorization Code" is no longer a hard requirement
-			if !containsOIDCGrantType(grantTypesSet, OIDCGrantTypeDeviceCode) {
-				grantTypes = append(grantTypes, OIDCGrantTypeAuthorizationCode)
-			} else {
+			// #5684 when "Device Code" (or "CIBA") is selected, "Authorization Code" is no longer a hard requirement
+			switch {
+			case containsOIDCGrantType(grantTypesSet, OIDCGrantTypeDeviceCode):
 				grantTypes = append(grantTypes, OIDCGrantTypeDeviceCode)
+			case containsOIDCGrantType(grantTypesSet, OIDCGrantTypeCIBA):
+				grantTypes = append(grantTypes, OIDCGrantTypeCIBA)
+			default:
+				grantTypes = append(grantTypes, OIDCGrantTypeAuthorizationCode)
 			}
 		case OIDCResponseTypeIDToken, OIDCResponseTypeIDTokenToken:
 			if !implicit {
@@ -203,6 +250,12 @@ func ContainsOIDCGrantTypes(shouldContain, list []OIDCGrantType) bool {
 	return true
 }
 
+// containsDecoupledOIDCGrantType returns true if the user can authenticate on another device
+// without being redirected back to the application (device code and CIBA).
+func containsDecoupledOIDCGrantType(grantTypes []OIDCGrantType) bool {
+	return containsOIDCGrantType(grantTypes, OIDCGrantTypeDeviceCode) || containsOIDCGrantType(grantTypes, OIDCGrantTypeCIBA)
+}
+
 func containsOIDCGrantType(grantTypes []OIDCGrantType, grantType OIDCGrantType) bool {
 	for _, gt := range grantTypes {
 		if gt == grantType {
@@ -241,15 +294,15 @@ func GetOIDCV1Compliance(appType OIDCApplicationType, grantTypes []OIDCGrantType
 }
 
 func checkGrantTypesCombination(compliance *Compliance, grantTypes []OIDCGrantType) {
-	if !containsOIDCGrantType(grantTypes, OIDCGrantTypeDeviceCode) && containsOIDCGrantType(grantTypes, OIDCGrantTypeRefreshToken) && !containsOIDCGrantType(grantTypes, OIDCGrantTypeAuthorizationCode) {
+	if !containsDecoupledOIDCGrantType(grantTypes) && containsOIDCGrantType(grantTypes, OIDCGrantTypeRefreshToken) && !containsOIDCGrantType(grantTypes, OIDCGrantTypeAuthorizationCode) {
 		compliance.NoneCompliant = true
 		compliance.Problems = append(compliance.Problems, "Application.OIDC.V1.GrantType.Refresh.NoAuthCode")
 	}
 }
 
 func checkRedirectURIs(compliance *Compliance, grantTypes []OIDCGrantType, appType OIDCApplicationType, redirectUris []string) {
-	// See #5684 for OIDCGrantTypeDeviceCode and redirectUris further explanation
-	if len(redirectUris) == 0 && (!containsOIDCGrantType(grantTypes, OIDCGrantTypeDeviceCode) || (containsOIDCGrantType(grantTypes, OIDCGrantTypeDeviceCode) && containsOIDCGrantType(grantTypes, OIDCGrantTypeAuthorizationCode))) {
+	// See #5684 for OIDCGrantTypeDeviceCode and redirectUris further explanation, the same applies to OIDCGrantTypeCIBA
+	if len(redirectUris) == 0 && (!containsDecoupledOIDCGrantType(grantTypes) || containsOIDCGrantType(grantTypes, OIDCGrantTypeAuthorizationCode)) {
 		compliance.NoneCompliant = true
 		compliance.Problems = append([]string{"Application.OIDC.V1.NoRedirectUris"}, compliance.Problems...)
 	}
@@ -59,6 +59,19 @@ func TestApplicationValid(t *testing.T) {
 			},
 			result: true,
 		},
+		{
+			name: "valid oidc application: responsetype code, grant type ciba",
+			args: args{
+				app: &OIDCApp{
+					ObjectRoot:    models.ObjectRoot{AggregateID: "AggregateID"},
+					AppID:         "AppID",
+					AppName:       "Name",
+					ResponseTypes: []OIDCResponseType{OIDCResponseTypeCode},
+					GrantTypes:    []OIDCGrantType{OIDCGrantTypeCIBA},
+				},
+			},
+			result: true,
+		},
 		{
 			name: "invalid oidc application: responsetype code",
 			args: args{
@@ -241,6 +254,11 @@ func Test_checkGrantTypesCombination(t *testing.T) {
 			want:       &Compliance{},
 			grantTypes: []OIDCGrantType{OIDCGrantTypeDeviceCode, OIDCGrantTypeRefreshToken},
 		},
+		{
+			name:       "ciba and refresh token doesnt require OIDCGrantTypeImplicit",
+			want:       &Compliance{},
+			grantTypes: []OIDCGrantType{OIDCGrantTypeCIBA, OIDCGrantTypeRefreshToken},
+		},
 		{
 			name:       "refresh token and authorization code",
 			want:       &Compliance{},
@@ -284,6 +302,25 @@ func Test_checkRedirectURIs(t *testing.T) {
 			},
 			args: args{},
 		},
+		{
+			name: "ciba without redirect uris",
+			want: &Compliance{},
+			args: args{
+				grantTypes: []OIDCGrantType{OIDCGrantTypeCIBA},
+			},
+		},
+		{
+			name: "ciba and authorization code without redirect uris",
+			want: &Compliance{
+				NoneCompliant: true,
+				Problems: []string{
+					"Application.OIDC.V1.NoRedirectUris",
+				},
+			},
+			args: args{
+				grantTypes: []OIDCGrantType{OIDCGrantTypeCIBA, OIDCGrantTypeAuthorizationCode},
+			},
+		},
 		{
 			name: "implicit and authorization code",
 			want: &Compliance{
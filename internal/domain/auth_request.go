@@ -50,6 +50,7 @@ type AuthRequest struct {
 	IDPLoginChecked          bool
 	MFAsVerified             []MFAType
 	Audience                 []string
+	AuthorizationDetails     AuthorizationDetails
 	AuthTime                 time.Time
 	Code                     string
 	LoginPolicy              *LoginPolicy
@@ -62,6 +63,8 @@ type AuthRequest struct {
 	OrgTranslations          []*CustomText
 	SAMLRequestID            string
 	RequestLocalAuth         bool
+	// ConsentDenied is set if the user denied the consent to the requested scopes of the client
+	ConsentDenied bool
 	// orgID the policies were last loaded with
 	policyOrgID string
 	// SessionID is set to the computed sessionID of the login session table
@@ -140,6 +143,7 @@ const (
 	MFATypeU2FUserVerification
 	MFATypeOTPSMS
 	MFATypeOTPEmail
+	MFATypeRecoveryCodes
 )
 
 func (m MFAType) UserAuthMethodType() UserAuthMethodType {
@@ -154,6 +158,8 @@ func (m MFAType) UserAuthMethodType() UserAuthMethodType {
 		return UserAuthMethodTypeOTPSMS
 	case MFATypeOTPEmail:
 		return UserAuthMethodTypeOTPEmail
+	case MFATypeRecoveryCodes:
+		return UserAuthMethodTypeRecoveryCodes
 	default:
 		return UserAuthMethodTypeUnspecified
 	}
@@ -177,6 +183,8 @@ const (
 	AuthRequestStateCodeExchanged
 	AuthRequestStateFailed
 	AuthRequestStateSucceeded
+	AuthRequestStatePushed
+	AuthRequestStateRequestURIUsed
 )
 
 func NewAuthRequestFromType(requestType AuthRequestType) (*AuthRequest, error) {
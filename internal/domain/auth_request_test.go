@@ -37,6 +37,11 @@ func TestMFAType_UserAuthMethodType(t *testing.T) {
 			m:    MFATypeOTPEmail,
 			want: UserAuthMethodTypeOTPEmail,
 		},
+		{
+			name: "recovery codes",
+			m:    MFATypeRecoveryCodes,
+			want: UserAuthMethodTypeRecoveryCodes,
+		},
 		{
 			name: "unspecified",
 			m:    99,
@@ -0,0 +1,15 @@
+package domain
+
+// AuthorizationDetail is a single object of the authorization_details request parameter (RFC 9396),
+// which expresses a fine-grained authorization requirement, e.g. the payment of a specific amount.
+// Besides the mandatory type, the fields of the object are defined by the resource server
+// and validated against the schema of the type configured on the project.
+type AuthorizationDetail map[string]any
+
+// Type returns the type of the authorization detail, which determines its structure.
+func (d AuthorizationDetail) Type() string {
+	detailType, _ := d["type"].(string)
+	return detailType
+}
+
+type AuthorizationDetails []AuthorizationDetail
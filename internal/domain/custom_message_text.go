@@ -18,6 +18,11 @@ const (
 	PasswordlessRegistrationMessageType = "PasswordlessRegistration"
 	PasswordChangeMessageType           = "PasswordChange"
 	InviteUserMessageType               = "InviteUser"
+	BackChannelAuthMessageType          = "BackChannelAuth"
+	RecoveryCodesLowMessageType         = "RecoveryCodesLow"
+	UserGrantExpiringMessageType        = "UserGrantExpiring"
+	AccessRequestedMessageType          = "AccessRequested"
+	RefreshTokenReusedMessageType       = "RefreshTokenReused"
 	MessageTitle                        = "Title"
 	MessagePreHeader                    = "PreHeader"
 	MessageSubject                      = "Subject"
@@ -0,0 +1,40 @@
+package domain
+
+import (
+	"strings"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+)
+
+const (
+	// RecoveryCodesCount is the amount of recovery codes generated for a user at once.
+	RecoveryCodesCount = 10
+	// RecoveryCodesLowThreshold is the amount of remaining recovery codes at which the user is notified to generate new ones.
+	RecoveryCodesLowThreshold = 3
+)
+
+// RecoveryCodeGeneratorConfig is used to generate the single recovery codes.
+// Codes only consist of upper case letters and digits, so they can easily be written down.
+var RecoveryCodeGeneratorConfig = crypto.GeneratorConfig{
+	Length:              10,
+	IncludeUpperLetters: true,
+	IncludeDigits:       true,
+}
+
+type RecoveryCodes struct {
+	*ObjectDetails
+
+	Codes []string
+}
+
+// NormalizeRecoveryCode removes separators and whitespaces a user might have entered
+// and converts the code to upper case.
+func NormalizeRecoveryCode(code string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case '-', ' ', '\t':
+			return -1
+		}
+		return r
+	}, strings.ToUpper(code))
+}
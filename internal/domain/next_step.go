@@ -30,6 +30,7 @@ const (
 	NextStepRedirectToExternalIDP
 	NextStepLoginSucceeded
 	NextStepVerifyInvite
+	NextStepConsent
 )
 
 type LoginStep struct{}
@@ -198,3 +199,12 @@ type VerifyInviteStep struct{}
 func (s *VerifyInviteStep) Type() NextStepType {
 	return NextStepVerifyInvite
 }
+
+type ConsentStep struct {
+	// Scopes the user did not yet consent to
+	Scopes []string
+}
+
+func (s *ConsentStep) Type() NextStepType {
+	return NextStepConsent
+}
@@ -45,6 +45,11 @@ type NotificationArguments struct {
 	CodeID          string        `json:"codeID,omitempty"`
 	SessionID       string        `json:"sessionID,omitempty"`
 	AuthRequestID   string        `json:"authRequestID,omitempty"`
+	ProjectName     string        `json:"projectName,omitempty"`
+	ValidUntil      time.Time     `json:"validUntil,omitempty"`
+	RequesterName   string        `json:"requesterName,omitempty"`
+	Roles           string        `json:"roles,omitempty"`
+	Reason          string        `json:"reason,omitempty"`
 }
 
 // ToMap creates a type safe map of the notification arguments.
@@ -62,5 +67,10 @@ func (n *NotificationArguments) ToMap() map[string]interface{} {
 	m["CodeID"] = n.CodeID
 	m["SessionID"] = n.SessionID
 	m["AuthRequestID"] = n.AuthRequestID
+	m["ProjectName"] = n.ProjectName
+	m["ValidUntil"] = n.ValidUntil
+	m["RequesterName"] = n.RequesterName
+	m["Roles"] = n.Roles
+	m["Reason"] = n.Reason
 	return m
 }
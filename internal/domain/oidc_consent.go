@@ -0,0 +1,28 @@
+package domain
+
+import (
+	"slices"
+)
+
+type OIDCConsentState int32
+
+const (
+	OIDCConsentStateUnspecified OIDCConsentState = iota
+	OIDCConsentStateActive
+	OIDCConsentStateRevoked
+)
+
+func (s OIDCConsentState) Exists() bool {
+	return s == OIDCConsentStateActive
+}
+
+// MissingConsentScopes returns the requested scopes, which are not part of the granted scopes.
+func MissingConsentScopes(granted, requested []string) []string {
+	missing := make([]string, 0, len(requested))
+	for _, scope := range requested {
+		if !slices.Contains(granted, scope) && !slices.Contains(missing, scope) {
+			missing = append(missing, scope)
+		}
+	}
+	return missing
+}
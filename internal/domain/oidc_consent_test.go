@@ -0,0 +1,40 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMissingConsentScopes(t *testing.T) {
+	tests := []struct {
+		name      string
+		granted   []string
+		requested []string
+		want      []string
+	}{
+		{
+			name:      "nothing granted",
+			granted:   nil,
+			requested: []string{"openid", "profile"},
+			want:      []string{"openid", "profile"},
+		},
+		{
+			name:      "all granted",
+			granted:   []string{"openid", "profile", "email"},
+			requested: []string{"openid", "profile"},
+			want:      []string{},
+		},
+		{
+			name:      "partially granted, duplicates",
+			granted:   []string{"openid"},
+			requested: []string{"openid", "offline_access", "offline_access"},
+			want:      []string{"offline_access"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, MissingConsentScopes(tt.granted, tt.requested))
+		})
+	}
+}
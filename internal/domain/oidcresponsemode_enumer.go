@@ -7,11 +7,11 @@ import (
 	"strings"
 )
 
-const _OIDCResponseModeName = "unspecifiedqueryfragmentform_post"
+const _OIDCResponseModeName = "unspecifiedqueryfragmentform_postquery_jwtfragment_jwtform_post_jwtjwt"
 
-var _OIDCResponseModeIndex = [...]uint8{0, 11, 16, 24, 33}
+var _OIDCResponseModeIndex = [...]uint8{0, 11, 16, 24, 33, 42, 54, 67, 70}
 
-const _OIDCResponseModeLowerName = "unspecifiedqueryfragmentform_post"
+const _OIDCResponseModeLowerName = "unspecifiedqueryfragmentform_postquery_jwtfragment_jwtform_post_jwtjwt"
 
 func (i OIDCResponseMode) String() string {
 	if i < 0 || i >= OIDCResponseMode(len(_OIDCResponseModeIndex)-1) {
@@ -28,9 +28,13 @@ func _OIDCResponseModeNoOp() {
 	_ = x[OIDCResponseModeQuery-(1)]
 	_ = x[OIDCResponseModeFragment-(2)]
 	_ = x[OIDCResponseModeFormPost-(3)]
+	_ = x[OIDCResponseModeQueryJWT-(4)]
+	_ = x[OIDCResponseModeFragmentJWT-(5)]
+	_ = x[OIDCResponseModeFormPostJWT-(6)]
+	_ = x[OIDCResponseModeJWT-(7)]
 }
 
-var _OIDCResponseModeValues = []OIDCResponseMode{OIDCResponseModeUnspecified, OIDCResponseModeQuery, OIDCResponseModeFragment, OIDCResponseModeFormPost}
+var _OIDCResponseModeValues = []OIDCResponseMode{OIDCResponseModeUnspecified, OIDCResponseModeQuery, OIDCResponseModeFragment, OIDCResponseModeFormPost, OIDCResponseModeQueryJWT, OIDCResponseModeFragmentJWT, OIDCResponseModeFormPostJWT, OIDCResponseModeJWT}
 
 var _OIDCResponseModeNameToValueMap = map[string]OIDCResponseMode{
 	_OIDCResponseModeName[0:11]:       OIDCResponseModeUnspecified,
@@ -41,6 +45,14 @@ var _OIDCResponseModeNameToValueMap = map[string]OIDCResponseMode{
 	_OIDCResponseModeLowerName[16:24]: OIDCResponseModeFragment,
 	_OIDCResponseModeName[24:33]:      OIDCResponseModeFormPost,
 	_OIDCResponseModeLowerName[24:33]: OIDCResponseModeFormPost,
+	_OIDCResponseModeName[33:42]:      OIDCResponseModeQueryJWT,
+	_OIDCResponseModeLowerName[33:42]: OIDCResponseModeQueryJWT,
+	_OIDCResponseModeName[42:54]:      OIDCResponseModeFragmentJWT,
+	_OIDCResponseModeLowerName[42:54]: OIDCResponseModeFragmentJWT,
+	_OIDCResponseModeName[54:67]:      OIDCResponseModeFormPostJWT,
+	_OIDCResponseModeLowerName[54:67]: OIDCResponseModeFormPostJWT,
+	_OIDCResponseModeName[67:70]:      OIDCResponseModeJWT,
+	_OIDCResponseModeLowerName[67:70]: OIDCResponseModeJWT,
 }
 
 var _OIDCResponseModeNames = []string{
@@ -48,6 +60,10 @@ var _OIDCResponseModeNames = []string{
 	_OIDCResponseModeName[11:16],
 	_OIDCResponseModeName[16:24],
 	_OIDCResponseModeName[24:33],
+	_OIDCResponseModeName[33:42],
+	_OIDCResponseModeName[42:54],
+	_OIDCResponseModeName[54:67],
+	_OIDCResponseModeName[67:70],
 }
 
 // OIDCResponseModeString retrieves an enum value from the enum constants string name.
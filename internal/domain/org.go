@@ -43,3 +43,7 @@ const (
 func (s OrgState) Valid() bool {
 	return s > OrgStateUnspecified && s < orgStateMax
 }
+
+// OrgHierarchyMaxDepth limits the number of ancestors an organization can have,
+// so resolving policies and memberships through the hierarchy stays cheap.
+const OrgHierarchyMaxDepth = 10
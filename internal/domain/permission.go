@@ -36,6 +36,7 @@ const (
 	PermissionSessionLink         = "session.link"
 	PermissionSessionDelete       = "session.delete"
 	PermissionOrgRead             = "org.read"
+	PermissionOrgWrite            = "org.write"
 	PermissionIDPRead             = "iam.idp.read"
 	PermissionOrgIDPRead          = "org.idp.read"
 	PermissionProjectWrite        = "project.write"
@@ -47,6 +48,10 @@ const (
 	PermissionProjectRoleWrite    = "project.role.write"
 	PermissionProjectRoleRead     = "project.role.read"
 	PermissionProjectRoleDelete   = "project.role.delete"
+	PermissionUserGrantRead       = "user.grant.read"
+	PermissionUserGrantApprove    = "user.grant.approve"
+	PermissionAccessReviewRead    = "org.access_review.read"
+	PermissionAccessReviewWrite   = "org.access_review.write"
 )
 
 // ProjectPermissionCheck is used as a check for preconditions dependent on application, project, user resourceowner and usergrants.
@@ -9,4 +9,9 @@ type PasswordAgePolicy struct {
 
 	MaxAgeDays     uint64
 	ExpireWarnDays uint64
+	// HistoryCount is the number of previous passwords, which can't be reused
+	HistoryCount uint64
+	// MinAgeDays is the number of days a password must be used,
+	// before the user can change it again
+	MinAgeDays uint64
 }
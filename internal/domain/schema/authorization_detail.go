@@ -0,0 +1,23 @@
+package schema
+
+import (
+	"io"
+
+	"github.com/santhosh-tekuri/jsonschema/v5"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// NewAuthorizationDetailSchema compiles the JSON schema of an authorization details type (RFC 9396),
+// against which the authorization details of the type requested by a client are validated.
+func NewAuthorizationDetailSchema(r io.Reader) (*jsonschema.Schema, error) {
+	c := jsonschema.NewCompiler()
+	if err := c.AddResource("authorization_detail.json", r); err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "COMMA-Ad3tl", "Errors.Project.AuthorizationDetailType.SchemaInvalid")
+	}
+	schema, err := c.Compile("authorization_detail.json")
+	if err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "COMMA-Ad4tl", "Errors.Project.AuthorizationDetailType.SchemaInvalid")
+	}
+	return schema, nil
+}
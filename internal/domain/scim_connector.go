@@ -0,0 +1,19 @@
+package domain
+
+type SCIMConnectorState int32
+
+const (
+	SCIMConnectorStateUnspecified SCIMConnectorState = iota
+	SCIMConnectorStateActive
+	SCIMConnectorStateInactive
+	SCIMConnectorStateRemoved
+	scimConnectorStateCount
+)
+
+func (s SCIMConnectorState) Valid() bool {
+	return s >= 0 && s < scimConnectorStateCount
+}
+
+func (s SCIMConnectorState) Exists() bool {
+	return s != SCIMConnectorStateUnspecified && s != SCIMConnectorStateRemoved
+}
@@ -6,6 +6,8 @@ const (
 	TargetTypeWebhook TargetType = iota
 	TargetTypeCall
 	TargetTypeAsync
+	// TargetTypeGRPC calls the TargetService of the gRPC contract and uses the response
+	TargetTypeGRPC
 )
 
 type TargetState int32
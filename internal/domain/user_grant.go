@@ -1,6 +1,10 @@
 package domain
 
-import es_models "github.com/zitadel/zitadel/internal/eventstore/v1/models"
+import (
+	"time"
+
+	es_models "github.com/zitadel/zitadel/internal/eventstore/v1/models"
+)
 
 type UserGrant struct {
 	es_models.ObjectRoot
@@ -10,6 +14,10 @@ type UserGrant struct {
 	ProjectID      string
 	ProjectGrantID string
 	RoleKeys       []string
+	// ValidFrom and ValidUntil optionally restrict the time the roles are granted,
+	// a zero value means no restriction.
+	ValidFrom  time.Time
+	ValidUntil time.Time
 }
 
 type UserGrantState int32
@@ -25,6 +33,17 @@ func (u *UserGrant) IsValid() bool {
 	return u.ProjectID != "" && u.UserID != ""
 }
 
+// HasValidValidity checks that the grant doesn't end before it starts.
+func (u *UserGrant) HasValidValidity() bool {
+	return u.ValidFrom.IsZero() || u.ValidUntil.IsZero() || u.ValidUntil.After(u.ValidFrom)
+}
+
+// UserGrantValidAt checks if a grant with the validity is valid at the given time.
+func UserGrantValidAt(validFrom, validUntil, at time.Time) bool {
+	return (validFrom.IsZero() || !at.Before(validFrom)) &&
+		(validUntil.IsZero() || at.Before(validUntil))
+}
+
 func (g *UserGrant) HasInvalidRoles(validRoles []string) bool {
 	for _, roleKey := range g.RoleKeys {
 		if !containsRoleKey(roleKey, validRoles) {
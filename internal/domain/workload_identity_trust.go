@@ -0,0 +1,18 @@
+package domain
+
+type WorkloadIdentityTrustState int32
+
+const (
+	WorkloadIdentityTrustStateUnspecified WorkloadIdentityTrustState = iota
+	WorkloadIdentityTrustStateActive
+	WorkloadIdentityTrustStateRemoved
+	workloadIdentityTrustStateCount
+)
+
+func (s WorkloadIdentityTrustState) Valid() bool {
+	return s >= 0 && s < workloadIdentityTrustStateCount
+}
+
+func (s WorkloadIdentityTrustState) Exists() bool {
+	return s != WorkloadIdentityTrustStateUnspecified && s != WorkloadIdentityTrustStateRemoved
+}
@@ -33,6 +33,9 @@ type Target interface {
 	GetTargetType() domain.TargetType
 	GetTimeout() time.Duration
 	GetSigningKey() string
+	GetClientCertificate() []byte
+	GetClientKey() []byte
+	GetRootCA() []byte
 }
 
 // CallTargets call a list of targets in order with handling of error and responses
@@ -88,6 +91,9 @@ func CallTarget(
 			}
 		}(context.WithoutCancel(ctx), target, info.GetHTTPRequestBody())
 		return nil, nil
+	// call the TargetService, return response and error
+	case domain.TargetTypeGRPC:
+		return CallGRPC(ctx, target, info.GetHTTPRequestBody())
 	default:
 		return nil, zerrors.ThrowInternal(nil, "EXEC-auqnansr2m", "Errors.Execution.Unknown")
 	}
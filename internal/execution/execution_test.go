@@ -458,6 +458,10 @@ type mockTarget struct {
 	Timeout          time.Duration
 	InterruptOnError bool
 	SigningKey       string
+
+	ClientCertificate []byte
+	ClientKey         []byte
+	RootCA            []byte
 }
 
 func (e *mockTarget) GetTargetID() string {
@@ -478,6 +482,15 @@ func (e *mockTarget) GetTimeout() time.Duration {
 func (e *mockTarget) GetSigningKey() string {
 	return e.SigningKey
 }
+func (e *mockTarget) GetClientCertificate() []byte {
+	return e.ClientCertificate
+}
+func (e *mockTarget) GetClientKey() []byte {
+	return e.ClientKey
+}
+func (e *mockTarget) GetRootCA() []byte {
+	return e.RootCA
+}
 
 type callTestServer struct {
 	method      string
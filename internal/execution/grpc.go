@@ -0,0 +1,236 @@
+package execution
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"net"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+	"github.com/zitadel/zitadel/pkg/actions"
+	target_pb "github.com/zitadel/zitadel/pkg/grpc/action/target/v2beta"
+)
+
+const (
+	// grpcConnIdleTimeout is the duration after which an unused connection is closed,
+	// e.g. because its target was removed or is no longer part of an execution.
+	grpcConnIdleTimeout = 30 * time.Minute
+	// grpcConnCleanupInterval is the interval in which idle connections are removed from the pool.
+	grpcConnCleanupInterval = time.Minute
+)
+
+var (
+	// grpcConns holds the connections to the gRPC targets, so they can be reused by subsequent calls.
+	grpcConns = newGRPCConnPool()
+	// grpcKeepalive keeps the connections to the targets open,
+	// the interval matches the minimum interval the gRPC servers allow by default.
+	grpcKeepalive = keepalive.ClientParameters{
+		Time:    5 * time.Minute,
+		Timeout: 20 * time.Second,
+	}
+)
+
+type grpcConn struct {
+	// configHash identifies the endpoint and TLS configuration the connection was created with
+	configHash string
+	conn       *grpc.ClientConn
+	// inUse is the number of calls currently using the connection
+	inUse    int
+	lastUsed time.Time
+	// removed is set if the connection was removed from the pool while in use,
+	// it is closed as soon as the last call released it.
+	removed bool
+}
+
+type grpcConnPool struct {
+	mu          sync.Mutex
+	conns       map[string]*grpcConn
+	nextCleanup time.Time
+}
+
+func newGRPCConnPool() *grpcConnPool {
+	return &grpcConnPool{
+		conns: make(map[string]*grpcConn),
+	}
+}
+
+// get returns the connection for the target and a function to release it after the call.
+// If the endpoint or TLS configuration of the target changed, the previous connection is closed and a new one is created.
+// Connections which were not used for [grpcConnIdleTimeout] are closed and removed from the pool.
+func (p *grpcConnPool) get(target Target, now time.Time) (*grpc.ClientConn, func(), error) {
+	configHash := grpcConfigHash(target)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if now.After(p.nextCleanup) {
+		for targetID, existing := range p.conns {
+			if existing.inUse == 0 && now.Sub(existing.lastUsed) >= grpcConnIdleTimeout {
+				p.remove(targetID, existing)
+			}
+		}
+		p.nextCleanup = now.Add(grpcConnCleanupInterval)
+	}
+	existing, ok := p.conns[target.GetTargetID()]
+	if ok && existing.configHash != configHash {
+		p.remove(target.GetTargetID(), existing)
+		ok = false
+	}
+	if !ok {
+		conn, err := newGRPCConn(target)
+		if err != nil {
+			return nil, nil, err
+		}
+		existing = &grpcConn{configHash: configHash, conn: conn}
+		p.conns[target.GetTargetID()] = existing
+	}
+	existing.inUse++
+	existing.lastUsed = now
+	return existing.conn, func() { p.release(existing) }, nil
+}
+
+func (p *grpcConnPool) release(conn *grpcConn) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	conn.inUse--
+	if conn.removed && conn.inUse == 0 {
+		conn.conn.Close()
+	}
+}
+
+// remove deletes the connection from the pool, it is closed immediately if not in use.
+// The caller must hold the lock.
+func (p *grpcConnPool) remove(targetID string, conn *grpcConn) {
+	delete(p.conns, targetID)
+	conn.removed = true
+	if conn.inUse == 0 {
+		conn.conn.Close()
+	}
+}
+
+func grpcConfigHash(target Target) string {
+	hash := sha256.New()
+	for _, value := range [][]byte{[]byte(target.GetEndpoint()), target.GetClientCertificate(), target.GetClientKey(), target.GetRootCA()} {
+		hash.Write(value)
+		hash.Write([]byte{0})
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+func newGRPCConn(target Target) (*grpc.ClientConn, error) {
+	endpoint, err := url.Parse(target.GetEndpoint())
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "EXEC-Ohz4e", "Errors.Execution.Failed")
+	}
+	address := endpoint.Host
+	if endpoint.Port() == "" {
+		port := "443"
+		if endpoint.Scheme == "http" {
+			port = "80"
+		}
+		address = net.JoinHostPort(endpoint.Hostname(), port)
+	}
+	transportCredentials := insecure.NewCredentials()
+	if endpoint.Scheme == "https" {
+		tlsConfig, err := grpcTLSConfig(endpoint.Hostname(), target)
+		if err != nil {
+			return nil, err
+		}
+		transportCredentials = credentials.NewTLS(tlsConfig)
+	}
+	conn, err := grpc.NewClient(address,
+		grpc.WithTransportCredentials(transportCredentials),
+		grpc.WithKeepaliveParams(grpcKeepalive),
+	)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "EXEC-ahT6u", "Errors.Execution.Failed")
+	}
+	return conn, nil
+}
+
+func grpcTLSConfig(serverName string, target Target) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: serverName,
+	}
+	if len(target.GetRootCA()) > 0 {
+		rootCAs := x509.NewCertPool()
+		if !rootCAs.AppendCertsFromPEM(target.GetRootCA()) {
+			return nil, zerrors.ThrowInternal(nil, "EXEC-Eiph4", "Errors.Target.InvalidRootCA")
+		}
+		tlsConfig.RootCAs = rootCAs
+	}
+	if len(target.GetClientCertificate()) > 0 {
+		certificate, err := tls.X509KeyPair(target.GetClientCertificate(), target.GetClientKey())
+		if err != nil {
+			return nil, zerrors.ThrowInternal(err, "EXEC-oo7Ch", "Errors.Target.InvalidClientCertificate")
+		}
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+	}
+	return tlsConfig, nil
+}
+
+// CallGRPC calls the TargetService of a gRPC target with timeout and returns the payload of the response
+func CallGRPC(ctx context.Context, target Target, body []byte) (_ []byte, err error) {
+	ctx, cancel := context.WithTimeout(ctx, target.GetTimeout())
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() {
+		cancel()
+		span.EndWithError(err)
+	}()
+
+	conn, release, err := grpcConns.get(target, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	if signingKey := target.GetSigningKey(); signingKey != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, strings.ToLower(actions.SigningHeader), actions.ComputeSignatureHeader(time.Now(), body, signingKey))
+	}
+	resp, err := target_pb.NewTargetServiceClient(conn).Call(ctx, &target_pb.CallRequest{Payload: body})
+	if err != nil {
+		return nil, handleGRPCError(err)
+	}
+	return resp.GetPayload(), nil
+}
+
+// handleGRPCError forwards the message of client errors returned by the target,
+// all other errors result in a generic error.
+func handleGRPCError(err error) error {
+	s, ok := status.FromError(err)
+	if !ok {
+		return zerrors.ThrowPreconditionFailed(err, "EXEC-ooL3a", "Errors.Execution.Failed")
+	}
+	switch s.Code() {
+	case codes.InvalidArgument:
+		return zerrors.ThrowInvalidArgument(nil, "EXEC-Kae7i", s.Message())
+	case codes.NotFound:
+		return zerrors.ThrowNotFound(nil, "EXEC-ga1Ei", s.Message())
+	case codes.AlreadyExists:
+		return zerrors.ThrowAlreadyExists(nil, "EXEC-aiG1o", s.Message())
+	case codes.PermissionDenied:
+		return zerrors.ThrowPermissionDenied(nil, "EXEC-Wai7e", s.Message())
+	case codes.FailedPrecondition:
+		return zerrors.ThrowPreconditionFailed(nil, "EXEC-eiW4u", s.Message())
+	case codes.Unauthenticated:
+		return zerrors.ThrowUnauthenticated(nil, "EXEC-Quee9", s.Message())
+	case codes.ResourceExhausted:
+		return zerrors.ThrowResourceExhausted(nil, "EXEC-uX8th", s.Message())
+	default:
+		return zerrors.ThrowPreconditionFailed(err, "EXEC-yie3E", "Errors.Execution.Failed")
+	}
+}
@@ -0,0 +1,75 @@
+package execution
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/connectivity"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+)
+
+func Test_grpcConnPool_get(t *testing.T) {
+	now := time.Now()
+	target := &query.ExecutionTarget{TargetID: "target", TargetType: domain.TargetTypeGRPC, Endpoint: "http://localhost:8080"}
+	otherTarget := &query.ExecutionTarget{TargetID: "other", TargetType: domain.TargetTypeGRPC, Endpoint: "http://localhost:8081"}
+
+	t.Run("reuse connection", func(t *testing.T) {
+		pool := newGRPCConnPool()
+		conn, release, err := pool.get(target, now)
+		require.NoError(t, err)
+		release()
+		reused, release, err := pool.get(target, now.Add(time.Second))
+		require.NoError(t, err)
+		release()
+		assert.Same(t, conn, reused)
+	})
+	t.Run("changed config, connection closed", func(t *testing.T) {
+		pool := newGRPCConnPool()
+		conn, release, err := pool.get(target, now)
+		require.NoError(t, err)
+		release()
+		changed, release, err := pool.get(&query.ExecutionTarget{TargetID: "target", TargetType: domain.TargetTypeGRPC, Endpoint: "http://localhost:8082"}, now)
+		require.NoError(t, err)
+		release()
+		assert.NotSame(t, conn, changed)
+		assert.Equal(t, connectivity.Shutdown, conn.GetState())
+	})
+	t.Run("changed config, connection closed after release", func(t *testing.T) {
+		pool := newGRPCConnPool()
+		conn, release, err := pool.get(target, now)
+		require.NoError(t, err)
+		_, releaseChanged, err := pool.get(&query.ExecutionTarget{TargetID: "target", TargetType: domain.TargetTypeGRPC, Endpoint: "http://localhost:8082"}, now)
+		require.NoError(t, err)
+		releaseChanged()
+		assert.NotEqual(t, connectivity.Shutdown, conn.GetState())
+		release()
+		assert.Equal(t, connectivity.Shutdown, conn.GetState())
+	})
+	t.Run("idle connection removed", func(t *testing.T) {
+		pool := newGRPCConnPool()
+		conn, release, err := pool.get(target, now)
+		require.NoError(t, err)
+		release()
+		_, release, err = pool.get(otherTarget, now.Add(grpcConnIdleTimeout))
+		require.NoError(t, err)
+		release()
+		assert.Equal(t, connectivity.Shutdown, conn.GetState())
+		assert.NotContains(t, pool.conns, target.GetTargetID())
+		assert.Contains(t, pool.conns, otherTarget.GetTargetID())
+	})
+	t.Run("connection in use not removed", func(t *testing.T) {
+		pool := newGRPCConnPool()
+		conn, release, err := pool.get(target, now)
+		require.NoError(t, err)
+		_, releaseOther, err := pool.get(otherTarget, now.Add(grpcConnIdleTimeout))
+		require.NoError(t, err)
+		releaseOther()
+		release()
+		assert.NotEqual(t, connectivity.Shutdown, conn.GetState())
+		assert.Contains(t, pool.conns, target.GetTargetID())
+	})
+}
@@ -0,0 +1,257 @@
+package execution_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/execution"
+	"github.com/zitadel/zitadel/internal/zerrors"
+	"github.com/zitadel/zitadel/pkg/actions"
+	target_pb "github.com/zitadel/zitadel/pkg/grpc/action/target/v2beta"
+)
+
+type grpcTargetServer struct {
+	target_pb.UnimplementedTargetServiceServer
+	signingKey string
+	sleep      time.Duration
+	respond    func(payload []byte) (*target_pb.CallResponse, error)
+}
+
+func (s *grpcTargetServer) Call(ctx context.Context, req *target_pb.CallRequest) (*target_pb.CallResponse, error) {
+	if s.signingKey != "" {
+		md, _ := metadata.FromIncomingContext(ctx)
+		signature := md.Get("zitadel-signature")
+		if len(signature) != 1 || actions.ValidatePayload(req.GetPayload(), signature[0], s.signingKey) != nil {
+			return nil, status.Error(codes.Unauthenticated, "invalid signature")
+		}
+	}
+	time.Sleep(s.sleep)
+	return s.respond(req.GetPayload())
+}
+
+type grpcRequest []byte
+
+func (r grpcRequest) GetHTTPRequestBody() []byte {
+	return r
+}
+
+func listenGRPC(t *testing.T, server *grpcTargetServer, opts ...grpc.ServerOption) string {
+	listener, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+	s := grpc.NewServer(opts...)
+	target_pb.RegisterTargetServiceServer(s, server)
+	go s.Serve(listener)
+	t.Cleanup(s.Stop)
+	return listener.Addr().String()
+}
+
+func testCertificate(t *testing.T, parent *x509.Certificate, parentKey *ecdsa.PrivateKey, template *x509.Certificate) (*x509.Certificate, *ecdsa.PrivateKey, []byte, []byte) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	if parent == nil {
+		parent, parentKey = template, key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	require.NoError(t, err)
+	certificate, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	return certificate, key,
+		pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
+
+func Test_CallGRPC(t *testing.T) {
+	type args struct {
+		timeout    time.Duration
+		signingKey string
+	}
+	type res struct {
+		body    []byte
+		wantErr func(error) bool
+	}
+	tests := []struct {
+		name   string
+		server *grpcTargetServer
+		args   args
+		res    res
+	}{
+		{
+			"ok",
+			&grpcTargetServer{
+				respond: func(payload []byte) (*target_pb.CallResponse, error) {
+					return &target_pb.CallResponse{Payload: append(payload, []byte("-response")...)}, nil
+				},
+			},
+			args{
+				timeout: time.Second,
+			},
+			res{
+				body: []byte("request-response"),
+			},
+		},
+		{
+			"signed, ok",
+			&grpcTargetServer{
+				signingKey: "signingkey",
+				respond: func(payload []byte) (*target_pb.CallResponse, error) {
+					return &target_pb.CallResponse{Payload: payload}, nil
+				},
+			},
+			args{
+				timeout:    time.Second,
+				signingKey: "signingkey",
+			},
+			res{
+				body: []byte("request"),
+			},
+		},
+		{
+			"timeout",
+			&grpcTargetServer{
+				sleep: time.Second,
+				respond: func(payload []byte) (*target_pb.CallResponse, error) {
+					return &target_pb.CallResponse{Payload: payload}, nil
+				},
+			},
+			args{
+				timeout: 100 * time.Millisecond,
+			},
+			res{
+				wantErr: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			"forwarded error",
+			&grpcTargetServer{
+				respond: func([]byte) (*target_pb.CallResponse, error) {
+					return nil, status.Error(codes.PermissionDenied, "forwarded")
+				},
+			},
+			args{
+				timeout: time.Second,
+			},
+			res{
+				wantErr: func(err error) bool {
+					return zerrors.IsPermissionDenied(err) && zerrors.Contains(err, "forwarded")
+				},
+			},
+		},
+		{
+			"internal error, not forwarded",
+			&grpcTargetServer{
+				respond: func([]byte) (*target_pb.CallResponse, error) {
+					return nil, status.Error(codes.Internal, "secret")
+				},
+			},
+			args{
+				timeout: time.Second,
+			},
+			res{
+				wantErr: func(err error) bool {
+					return zerrors.IsPreconditionFailed(err) && zerrors.Contains(err, "Errors.Execution.Failed")
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			address := listenGRPC(t, tt.server)
+			body, err := execution.CallTarget(context.Background(), &mockTarget{
+				TargetID:   tt.name,
+				TargetType: domain.TargetTypeGRPC,
+				Endpoint:   "http://" + address,
+				Timeout:    tt.args.timeout,
+				SigningKey: tt.args.signingKey,
+			}, grpcRequest("request"))
+			if tt.res.wantErr != nil {
+				assert.True(t, tt.res.wantErr(err), "unexpected error: %v", err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.res.body, body)
+		})
+	}
+}
+
+func Test_CallGRPC_mTLS(t *testing.T) {
+	ca, caKey, caPEM, _ := testCertificate(t, nil, nil, &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "ca"},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	})
+	_, _, serverCert, serverKey := testCertificate(t, ca, caKey, &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "localhost"},
+		DNSNames:     []string{"localhost"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	})
+	_, _, clientCert, clientKey := testCertificate(t, ca, caKey, &x509.Certificate{
+		SerialNumber: big.NewInt(3),
+		Subject:      pkix.Name{CommonName: "zitadel"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	})
+	serverKeyPair, err := tls.X509KeyPair(serverCert, serverKey)
+	require.NoError(t, err)
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(ca)
+
+	address := listenGRPC(t,
+		&grpcTargetServer{
+			respond: func(payload []byte) (*target_pb.CallResponse, error) {
+				return &target_pb.CallResponse{Payload: payload}, nil
+			},
+		},
+		grpc.Creds(credentials.NewTLS(&tls.Config{
+			Certificates: []tls.Certificate{serverKeyPair},
+			ClientCAs:    clientCAs,
+			ClientAuth:   tls.RequireAndVerifyClientCert,
+		})),
+	)
+	_, port, err := net.SplitHostPort(address)
+	require.NoError(t, err)
+
+	target := &mockTarget{
+		TargetID:   "mtls",
+		TargetType: domain.TargetTypeGRPC,
+		Endpoint:   "https://localhost:" + port,
+		Timeout:    time.Second,
+		RootCA:     caPEM,
+	}
+	_, err = execution.CallTarget(context.Background(), target, grpcRequest("request"))
+	assert.Error(t, err, "client certificate is required")
+
+	target.ClientCertificate = clientCert
+	target.ClientKey = clientKey
+	body, err := execution.CallTarget(context.Background(), target, grpcRequest("request"))
+	require.NoError(t, err)
+	assert.Equal(t, []byte("request"), body)
+}
@@ -0,0 +1,17 @@
+package grantexpiry
+
+import (
+	"github.com/zitadel/zitadel/internal/queue"
+	"github.com/zitadel/zitadel/internal/queue/instanceworker"
+)
+
+// Register schedules the periodic expiry of the user grants and the reminders about upcoming expiries.
+func Register(
+	config WorkerConfig,
+	commands Commands,
+	queries Queries,
+	es instanceworker.EventStore,
+	q *queue.Queue,
+) {
+	instanceworker.Register(q, config.Interval, NewWorker(config, commands, queries, es).Worker, new(Request))
+}
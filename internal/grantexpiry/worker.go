@@ -0,0 +1,171 @@
+package grantexpiry
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/riverqueue/river"
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/queue/instanceworker"
+	"github.com/zitadel/zitadel/internal/repository/usergrant"
+)
+
+const (
+	QueueName = "user_grant_expiry"
+
+	ExpiryUserID = "USER-GRANT-EXPIRY"
+
+	// grantsPageSize is the number of user grants loaded at once.
+	grantsPageSize = 100
+)
+
+// Request asks the worker to expire the user grants at the end of their validity
+// and to remind the users about upcoming expiries, in all instances.
+type Request struct{}
+
+func (r *Request) Kind() string {
+	return "user_grant_expiry_request"
+}
+
+type Commands interface {
+	ExpireUserGrant(ctx context.Context, grantID, resourceOwner string) (*domain.ObjectDetails, error)
+	RemindUserGrantExpiry(ctx context.Context, grantID, resourceOwner, projectName string) error
+}
+
+type Queries interface {
+	UserGrants(ctx context.Context, queries *query.UserGrantsQueries, shouldTriggerBulk bool) (*query.UserGrants, error)
+}
+
+type Worker struct {
+	*instanceworker.Worker[*Request]
+
+	config   WorkerConfig
+	commands Commands
+	queries  Queries
+}
+
+type WorkerConfig struct {
+	// Interval in which the validity of the user grants is checked.
+	// If set to 0, grants are not expired and users are not reminded,
+	// roles outside the validity are still not granted.
+	Interval time.Duration
+	// NotifyBefore is the duration before the end of the validity in which the user is reminded.
+	// If set to 0, users are not reminded.
+	NotifyBefore        time.Duration
+	TransactionDuration time.Duration
+}
+
+func NewWorker(
+	config WorkerConfig,
+	commands Commands,
+	queries Queries,
+	es instanceworker.EventStore,
+) *Worker {
+	w := &Worker{
+		config:   config,
+		commands: commands,
+		queries:  queries,
+	}
+	w.Worker = instanceworker.NewWorker[*Request](QueueName, ExpiryUserID, config.TransactionDuration, es, instancesQuery, w.processInstance)
+	return w
+}
+
+var _ river.Worker[*Request] = (*Worker)(nil)
+
+func instancesQuery() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsInstanceIDs).
+		OrderDesc().
+		AddQuery().
+		AggregateTypes(usergrant.AggregateType).
+		EventTypes(usergrant.UserGrantAddedType, usergrant.UserGrantValidityChangedType).
+		Builder()
+}
+
+// processInstance expires the user grants and reminds the users about upcoming expiries.
+func (w *Worker) processInstance(ctx context.Context) error {
+	return errors.Join(
+		w.expireInstance(ctx),
+		w.remindInstance(ctx),
+	)
+}
+
+func (w *Worker) expireInstance(ctx context.Context) error {
+	validUntilQuery, err := query.NewUserGrantValidUntilQuery(time.Now(), query.TimestampLessOrEquals)
+	if err != nil {
+		return err
+	}
+	return w.activeGrants(ctx, func(grant *query.UserGrant) {
+		_, err := w.commands.ExpireUserGrant(ctx, grant.ID, grant.ResourceOwner)
+		logging.WithFields("instance", authz.GetInstance(ctx).InstanceID(), "grant", grant.ID).OnError(err).Warn("unable to expire user grant")
+	}, validUntilQuery)
+}
+
+func (w *Worker) remindInstance(ctx context.Context) error {
+	if w.config.NotifyBefore <= 0 {
+		return nil
+	}
+	now := time.Now()
+	notExpiredQuery, err := query.NewUserGrantValidUntilQuery(now, query.TimestampGreater)
+	if err != nil {
+		return err
+	}
+	expiringQuery, err := query.NewUserGrantValidUntilQuery(now.Add(w.config.NotifyBefore), query.TimestampLessOrEquals)
+	if err != nil {
+		return err
+	}
+	notRemindedQuery, err := query.NewUserGrantExpiryRemindedQuery(false)
+	if err != nil {
+		return err
+	}
+	return w.activeGrants(ctx, func(grant *query.UserGrant) {
+		err := w.commands.RemindUserGrantExpiry(ctx, grant.ID, grant.ResourceOwner, grant.ProjectName)
+		logging.WithFields("instance", authz.GetInstance(ctx).InstanceID(), "grant", grant.ID).OnError(err).Warn("unable to remind user about expiring user grant")
+	}, notExpiredQuery, expiringQuery, notRemindedQuery)
+}
+
+// activeGrants calls process for each active grant matching the queries.
+// The grants are loaded in pages of [grantsPageSize] sorted by their ID,
+// so grants no longer matching the queries after processing do not shift the following pages.
+func (w *Worker) activeGrants(ctx context.Context, process func(grant *query.UserGrant), queries ...query.SearchQuery) error {
+	stateQuery, err := query.NewUserGrantStateQuery(domain.UserGrantStateActive)
+	if err != nil {
+		return err
+	}
+	queries = append(queries, stateQuery)
+	var lastID string
+	for {
+		pageQueries := queries
+		if lastID != "" {
+			idQuery, err := query.NewUserGrantIDGreaterSearchQuery(lastID)
+			if err != nil {
+				return err
+			}
+			pageQueries = append(slices.Clip(queries), idQuery)
+		}
+		grants, err := w.queries.UserGrants(ctx, &query.UserGrantsQueries{
+			SearchRequest: query.SearchRequest{
+				Limit:         grantsPageSize,
+				SortingColumn: query.UserGrantID,
+				Asc:           true,
+			},
+			Queries: pageQueries,
+		}, false)
+		if err != nil {
+			return err
+		}
+		for _, grant := range grants.UserGrants {
+			process(grant)
+		}
+		if len(grants.UserGrants) < grantsPageSize {
+			return nil
+		}
+		lastID = grants.UserGrants[len(grants.UserGrants)-1].ID
+	}
+}
@@ -0,0 +1,106 @@
+package grantexpiry
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/queue/instanceworker"
+)
+
+// fakeQueries returns the results in the order of the calls,
+// which is first the pages of the expired and then of the expiring grants of each instance.
+type fakeQueries struct {
+	results [][]*query.UserGrant
+	queries [][]query.SearchQuery
+}
+
+func (f *fakeQueries) UserGrants(_ context.Context, queries *query.UserGrantsQueries, _ bool) (*query.UserGrants, error) {
+	f.queries = append(f.queries, queries.Queries)
+	if len(f.results) == 0 {
+		return &query.UserGrants{}, nil
+	}
+	grants := f.results[0]
+	f.results = f.results[1:]
+	return &query.UserGrants{UserGrants: grants}, nil
+}
+
+type fakeCommands struct {
+	expired  []string
+	reminded []string
+}
+
+func (f *fakeCommands) ExpireUserGrant(ctx context.Context, grantID, resourceOwner string) (*domain.ObjectDetails, error) {
+	f.expired = append(f.expired, authz.GetInstance(ctx).InstanceID()+"/"+resourceOwner+"/"+grantID)
+	return &domain.ObjectDetails{}, nil
+}
+
+func (f *fakeCommands) RemindUserGrantExpiry(ctx context.Context, grantID, resourceOwner, projectName string) error {
+	f.reminded = append(f.reminded, authz.GetInstance(ctx).InstanceID()+"/"+resourceOwner+"/"+grantID+"/"+projectName)
+	return nil
+}
+
+func TestWorker_processInstance(t *testing.T) {
+	results := [][]*query.UserGrant{
+		{{ID: "grant1", ResourceOwner: "org1"}},
+		{{ID: "grant2", ResourceOwner: "org1", ProjectName: "project"}},
+	}
+	tests := []struct {
+		name         string
+		notifyBefore time.Duration
+		wantExpired  []string
+		wantReminded []string
+	}{
+		{
+			name:         "expired and reminded",
+			notifyBefore: 24 * time.Hour,
+			wantExpired:  []string{"instance1/org1/grant1"},
+			wantReminded: []string{"instance1/org1/grant2/project"},
+		},
+		{
+			name:         "reminders disabled",
+			notifyBefore: 0,
+			wantExpired:  []string{"instance1/org1/grant1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			commands := new(fakeCommands)
+			w := NewWorker(WorkerConfig{NotifyBefore: tt.notifyBefore}, commands, &fakeQueries{results: results}, nil)
+			err := w.processInstance(instanceworker.WithInstance(context.Background(), "instance1", ExpiryUserID))
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantExpired, commands.expired)
+			assert.Equal(t, tt.wantReminded, commands.reminded)
+		})
+	}
+}
+
+func TestWorker_expireInstance_pages(t *testing.T) {
+	firstPage := make([]*query.UserGrant, grantsPageSize)
+	wantExpired := make([]string, 0, grantsPageSize+1)
+	for i := range firstPage {
+		id := fmt.Sprintf("grant%03d", i)
+		firstPage[i] = &query.UserGrant{ID: id, ResourceOwner: "org1"}
+		wantExpired = append(wantExpired, "instance1/org1/"+id)
+	}
+	wantExpired = append(wantExpired, "instance1/org1/last")
+	queries := &fakeQueries{results: [][]*query.UserGrant{firstPage, {{ID: "last", ResourceOwner: "org1"}}}}
+	commands := new(fakeCommands)
+
+	w := NewWorker(WorkerConfig{}, commands, queries, nil)
+	err := w.expireInstance(instanceworker.WithInstance(context.Background(), "instance1", ExpiryUserID))
+	require.NoError(t, err)
+	assert.Equal(t, wantExpired, commands.expired)
+	require.Len(t, queries.queries, 2)
+	idQuery, err := query.NewUserGrantIDGreaterSearchQuery(firstPage[grantsPageSize-1].ID)
+	require.NoError(t, err)
+	assert.NotContains(t, queries.queries[0], idQuery)
+	assert.Contains(t, queries.queries[1], idQuery)
+}
@@ -7,7 +7,10 @@ import (
 	"github.com/zitadel/zitadel/internal/idp"
 )
 
-const DefaultPort = "389"
+const (
+	DefaultPort                 = "389"
+	DefaultGroupMemberAttribute = "member"
+)
 
 var _ idp.Provider = (*Provider)(nil)
 
@@ -45,6 +48,15 @@ type Provider struct {
 	preferredLanguageAttribute string
 	avatarURLAttribute         string
 	profileAttribute           string
+
+	groupSearch *groupSearch
+}
+
+// groupSearch defines where the groups a user is member of are searched
+type groupSearch struct {
+	baseDN          string
+	objectClasses   []string
+	memberAttribute string
 }
 
 type ProviderOpts func(provider *Provider)
@@ -176,6 +188,22 @@ func WithProfileAttribute(name string) ProviderOpts {
 	}
 }
 
+// WithGroupSearch enables searching the groups the user is member of.
+// The groups are searched below the baseDN (if empty the baseDN of the provider is used)
+// and filtered by the objectClasses and the memberAttribute (default `member`) containing the DN of the user.
+func WithGroupSearch(baseDN string, objectClasses []string, memberAttribute string) ProviderOpts {
+	return func(p *Provider) {
+		if memberAttribute == "" {
+			memberAttribute = DefaultGroupMemberAttribute
+		}
+		p.groupSearch = &groupSearch{
+			baseDN:          baseDN,
+			objectClasses:   objectClasses,
+			memberAttribute: memberAttribute,
+		}
+	}
+}
+
 func New(
 	name string,
 	servers []string,
@@ -245,6 +273,23 @@ func (p *Provider) IsAutoUpdate() bool {
 	return p.isAutoUpdate
 }
 
+// IsGroupSearch returns if the groups of the user are searched,
+// so [User.Groups] contains the DNs of the groups the user is member of.
+func (p *Provider) IsGroupSearch() bool {
+	return p.groupSearch != nil
+}
+
+func (p *Provider) getGroupSearch() *groupSearch {
+	if p.groupSearch == nil || p.groupSearch.baseDN != "" {
+		return p.groupSearch
+	}
+	return &groupSearch{
+		baseDN:          p.baseDN,
+		objectClasses:   p.groupSearch.objectClasses,
+		memberAttribute: p.groupSearch.memberAttribute,
+	}
+}
+
 func (p *Provider) getNecessaryAttributes() []string {
 	attributes := []string{p.userBase}
 	if p.idAttribute != "" {
@@ -205,3 +205,48 @@ func TestProvider_Options(t *testing.T) {
 		})
 	}
 }
+
+func TestProvider_GroupSearch(t *testing.T) {
+	tests := []struct {
+		name            string
+		opts            []ProviderOpts
+		wantGroupSearch *groupSearch
+	}{
+		{
+			name:            "disabled",
+			opts:            nil,
+			wantGroupSearch: nil,
+		},
+		{
+			name: "defaults",
+			opts: []ProviderOpts{
+				WithGroupSearch("", nil, ""),
+			},
+			wantGroupSearch: &groupSearch{
+				baseDN:          "base",
+				memberAttribute: DefaultGroupMemberAttribute,
+			},
+		},
+		{
+			name: "custom",
+			opts: []ProviderOpts{
+				WithGroupSearch("ou=groups,base", []string{"groupOfNames"}, "uniqueMember"),
+			},
+			wantGroupSearch: &groupSearch{
+				baseDN:          "ou=groups,base",
+				objectClasses:   []string{"groupOfNames"},
+				memberAttribute: "uniqueMember",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := assert.New(t)
+
+			provider := New("ldap", []string{"server"}, "base", "binddn", "password", "user", []string{"object"}, []string{"filter"}, 30*time.Second, nil, "url", tt.opts...)
+
+			a.Equal(tt.wantGroupSearch != nil, provider.IsGroupSearch())
+			a.Equal(tt.wantGroupSearch, provider.getGroupSearch())
+		})
+	}
+}
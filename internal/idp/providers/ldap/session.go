@@ -21,8 +21,10 @@ import (
 )
 
 var ErrNoSingleUser = errors.New("user does not exist or too many entries returned")
+var ErrUserNotFound = errors.New("user does not exist")
 var ErrFailedLogin = errors.New("user failed to login")
 var ErrUnableToAppendRootCA = errors.New("unable to append rootCA")
+var ErrGroupSearchNotPossible = errors.New("group search requires the group search and id attribute to be configured")
 
 var _ idp.Session = (*Session)(nil)
 
@@ -51,8 +53,9 @@ func (s *Session) PersistentParameters() map[string]any {
 // FetchUser implements the [idp.Session] interface.
 func (s *Session) FetchUser(_ context.Context) (_ idp.User, err error) {
 	var user *ldap.Entry
+	var groups []string
 	for _, server := range s.Provider.servers {
-		user, err = tryBind(server,
+		user, groups, err = tryBind(server,
 			s.Provider.startTLS,
 			s.Provider.bindDN,
 			s.Provider.bindPassword,
@@ -63,7 +66,8 @@ func (s *Session) FetchUser(_ context.Context) (_ idp.User, err error) {
 			s.User,
 			s.Password,
 			s.Provider.timeout,
-			s.Provider.rootCA)
+			s.Provider.rootCA,
+			s.Provider.getGroupSearch())
 		// If there were invalid credentials or multiple users with the credentials cancel process
 		if err != nil && (errors.Is(err, ErrFailedLogin) || errors.Is(err, ErrNoSingleUser)) {
 			return nil, err
@@ -78,7 +82,7 @@ func (s *Session) FetchUser(_ context.Context) (_ idp.User, err error) {
 	}
 	s.Entry = user
 
-	return mapLDAPEntryToUser(
+	mappedUser, err := mapLDAPEntryToUser(
 		user,
 		s.Provider.idAttribute,
 		s.Provider.firstNameAttribute,
@@ -94,6 +98,58 @@ func (s *Session) FetchUser(_ context.Context) (_ idp.User, err error) {
 		s.Provider.avatarURLAttribute,
 		s.Provider.profileAttribute,
 	)
+	if err != nil {
+		return nil, err
+	}
+	mappedUser.Groups = groups
+	return mappedUser, nil
+}
+
+// FetchUserGroups searches the user by the (external) id using the bind user
+// and returns the DNs of the groups the user is member of.
+// It's used to synchronise the groups without the user authenticating.
+// [ErrUserNotFound] is returned only if none of the servers finds the user,
+// [ErrNoSingleUser] if the id is not unique.
+func (p *Provider) FetchUserGroups(_ context.Context, externalUserID string) ([]string, error) {
+	if p.groupSearch == nil || p.idAttribute == "" {
+		return nil, ErrGroupSearchNotPossible
+	}
+	return fetchUserGroups(p.servers, func(server string) ([]string, error) {
+		return searchUserGroups(server,
+			p.startTLS,
+			p.bindDN,
+			p.bindPassword,
+			p.baseDN,
+			p.userObjectClasses,
+			p.idAttribute,
+			externalUserID,
+			p.timeout,
+			p.rootCA,
+			p.getGroupSearch())
+	})
+}
+
+// fetchUserGroups returns the groups of the first server finding the user.
+// As the user might not be replicated to all servers (yet), it's only reported as not found if no server found it.
+func fetchUserGroups(servers []string, search func(server string) ([]string, error)) (_ []string, err error) {
+	for _, server := range servers {
+		groups, searchErr := search(server)
+		if searchErr == nil {
+			return groups, nil
+		}
+		// the other servers would return the same entries
+		if errors.Is(searchErr, ErrNoSingleUser) {
+			return nil, searchErr
+		}
+		if !errors.Is(searchErr, ErrUserNotFound) {
+			err = searchErr
+		}
+	}
+	// a server which could not be searched might still know the user
+	if err != nil {
+		return nil, err
+	}
+	return nil, ErrUserNotFound
 }
 
 func (s *Session) ExpiresAt() time.Time {
@@ -113,18 +169,19 @@ func tryBind(
 	password string,
 	timeout time.Duration,
 	rootCA []byte,
-) (*ldap.Entry, error) {
+	groupSearch *groupSearch,
+) (*ldap.Entry, []string, error) {
 	conn, err := getConnection(server, startTLS, timeout, rootCA)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	defer conn.Close()
 
 	if err := conn.Bind(bindDN, bindPassword); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return trySearchAndUserBind(
+	user, err := trySearchAndUserBind(
 		conn,
 		baseDN,
 		attributes,
@@ -134,6 +191,90 @@ func tryBind(
 		password,
 		timeout,
 	)
+	if err != nil || groupSearch == nil {
+		return user, nil, err
+	}
+	// the groups are searched as bind user, since the user might not be allowed to
+	if err := conn.Bind(bindDN, bindPassword); err != nil {
+		return nil, nil, err
+	}
+	groups, err := searchGroups(conn, groupSearch, user.DN, timeout)
+	if err != nil {
+		return nil, nil, err
+	}
+	return user, groups, nil
+}
+
+func searchUserGroups(
+	server string,
+	startTLS bool,
+	bindDN string,
+	bindPassword string,
+	baseDN string,
+	objectClasses []string,
+	idAttribute string,
+	externalUserID string,
+	timeout time.Duration,
+	rootCA []byte,
+	groupSearch *groupSearch,
+) ([]string, error) {
+	conn, err := getConnection(server, startTLS, timeout, rootCA)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	if err := conn.Bind(bindDN, bindPassword); err != nil {
+		return nil, err
+	}
+
+	searchRequest := ldap.NewSearchRequest(
+		baseDN,
+		ldap.ScopeWholeSubtree, ldap.NeverDerefAliases, 0, int(timeout.Seconds()), false,
+		queriesAndToSearchQuery(
+			objectClassesToSearchQuery(objectClasses),
+			"("+idAttribute+"="+ldap.EscapeFilter(externalUserID)+")",
+		),
+		[]string{"dn"},
+		nil,
+	)
+	sr, err := conn.Search(searchRequest)
+	if err != nil {
+		return nil, err
+	}
+	if len(sr.Entries) == 0 {
+		return nil, ErrUserNotFound
+	}
+	if len(sr.Entries) > 1 {
+		logging.WithFields("entries", len(sr.Entries)).Info("ldap: no single user found")
+		return nil, ErrNoSingleUser
+	}
+	return searchGroups(conn, groupSearch, sr.Entries[0].DN, timeout)
+}
+
+// searchGroups returns the DNs of the groups the user (DN) is member of.
+func searchGroups(
+	conn *ldap.Conn,
+	groupSearch *groupSearch,
+	userDN string,
+	timeout time.Duration,
+) ([]string, error) {
+	searchRequest := ldap.NewSearchRequest(
+		groupSearch.baseDN,
+		ldap.ScopeWholeSubtree, ldap.NeverDerefAliases, 0, int(timeout.Seconds()), false,
+		groupsToSearchQuery(groupSearch.objectClasses, groupSearch.memberAttribute, userDN),
+		[]string{"dn"},
+		nil,
+	)
+	sr, err := conn.Search(searchRequest)
+	if err != nil {
+		return nil, err
+	}
+	groups := make([]string, len(sr.Entries))
+	for i, entry := range sr.Entries {
+		groups[i] = entry.DN
+	}
+	return groups, nil
 }
 
 func getConnection(
@@ -264,6 +405,17 @@ func objectClassesToSearchQuery(classes []string) string {
 	return searchQuery
 }
 
+func groupsToSearchQuery(objectClasses []string, memberAttribute, userDN string) string {
+	memberQuery := "(" + memberAttribute + "=" + ldap.EscapeFilter(userDN) + ")"
+	if len(objectClasses) == 0 {
+		return memberQuery
+	}
+	return queriesAndToSearchQuery(
+		objectClassesToSearchQuery(objectClasses),
+		memberQuery,
+	)
+}
+
 func userFiltersToSearchQuery(filters []string, username string) []string {
 	searchQueries := make([]string, len(filters))
 	for i, filter := range filters {
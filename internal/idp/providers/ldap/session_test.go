@@ -1,6 +1,7 @@
 package ldap
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/go-ldap/ldap/v3"
@@ -85,6 +86,45 @@ func TestProvider_userFiltersToSearchQuery(t *testing.T) {
 	}
 }
 
+func TestProvider_groupsToSearchQuery(t *testing.T) {
+	tests := []struct {
+		name            string
+		objectClasses   []string
+		memberAttribute string
+		userDN          string
+		want            string
+	}{
+		{
+			name:            "without object classes",
+			objectClasses:   nil,
+			memberAttribute: "member",
+			userDN:          "cn=user,dc=example,dc=com",
+			want:            "(member=cn=user,dc=example,dc=com)",
+		},
+		{
+			name:            "with object classes",
+			objectClasses:   []string{"group"},
+			memberAttribute: "member",
+			userDN:          "cn=user,dc=example,dc=com",
+			want:            "(&(objectClass=group)(member=cn=user,dc=example,dc=com))",
+		},
+		{
+			name:            "escaped user dn",
+			objectClasses:   []string{"groupOfNames"},
+			memberAttribute: "uniqueMember",
+			userDN:          "cn=user (admin)*,dc=example,dc=com",
+			want:            "(&(objectClass=groupOfNames)(uniqueMember=cn=user \\28admin\\29\\2a,dc=example,dc=com))",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := assert.New(t)
+
+			a.Equal(tt.want, groupsToSearchQuery(tt.objectClasses, tt.memberAttribute, tt.userDN))
+		})
+	}
+}
+
 func TestProvider_queriesAndToSearchQuery(t *testing.T) {
 	tests := []struct {
 		name   string
@@ -398,3 +438,60 @@ func TestProvider_mapLDAPEntryToUser(t *testing.T) {
 		})
 	}
 }
+
+func TestProvider_fetchUserGroups(t *testing.T) {
+	errConnection := errors.New("connection failed")
+	tests := []struct {
+		name     string
+		results  map[string]error
+		want     []string
+		wantErr  error
+		searched []string
+	}{
+		{
+			name:     "found on first server",
+			results:  map[string]error{"server1": nil, "server2": nil},
+			want:     []string{"server1"},
+			searched: []string{"server1"},
+		},
+		{
+			name:     "not found on first server, found on second",
+			results:  map[string]error{"server1": ErrUserNotFound, "server2": nil},
+			want:     []string{"server2"},
+			searched: []string{"server1", "server2"},
+		},
+		{
+			name:     "not found on any server",
+			results:  map[string]error{"server1": ErrUserNotFound, "server2": ErrUserNotFound},
+			wantErr:  ErrUserNotFound,
+			searched: []string{"server1", "server2"},
+		},
+		{
+			name:     "not found and unavailable server",
+			results:  map[string]error{"server1": ErrUserNotFound, "server2": errConnection},
+			wantErr:  errConnection,
+			searched: []string{"server1", "server2"},
+		},
+		{
+			name:     "not unique",
+			results:  map[string]error{"server1": ErrNoSingleUser, "server2": nil},
+			wantErr:  ErrNoSingleUser,
+			searched: []string{"server1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var searched []string
+			got, err := fetchUserGroups([]string{"server1", "server2"}, func(server string) ([]string, error) {
+				searched = append(searched, server)
+				if err := tt.results[server]; err != nil {
+					return nil, err
+				}
+				return []string{server}, nil
+			})
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.searched, searched)
+		})
+	}
+}
@@ -20,6 +20,8 @@ type User struct {
 	PreferredLanguage language.Tag        `json:"preferredLanguage,omitempty"`
 	AvatarURL         string              `json:"avatarURL,omitempty"`
 	Profile           string              `json:"profile,omitempty"`
+	// Groups contains the DNs of the groups the user is member of, in case the group search is enabled.
+	Groups []string `json:"groups,omitempty"`
 }
 
 func NewUser(
@@ -51,6 +53,7 @@ func NewUser(
 		preferredLanguage,
 		avatarURL,
 		profile,
+		nil,
 	}
 }
 
@@ -93,3 +96,6 @@ func (u *User) GetAvatarURL() string {
 func (u *User) GetProfile() string {
 	return u.Profile
 }
+func (u *User) GetGroups() []string {
+	return u.Groups
+}
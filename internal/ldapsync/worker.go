@@ -0,0 +1,145 @@
+package ldapsync
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/riverqueue/river"
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/idp"
+	"github.com/zitadel/zitadel/internal/idp/providers/ldap"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/queue/instanceworker"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+)
+
+const (
+	QueueName = "ldap_group_sync"
+
+	SyncUserID = "LDAP-GROUP-SYNC"
+)
+
+// Request asks the worker to synchronise the user grants of all users linked to
+// an LDAP IdP with group mappings, in all instances.
+type Request struct{}
+
+func (r *Request) Kind() string {
+	return "ldap_group_sync_request"
+}
+
+type Commands interface {
+	GetProvider(ctx context.Context, idpID string, idpCallback string, samlRootURL string) (idp.Provider, error)
+	SyncLDAPUserGrants(ctx context.Context, idpID, userID string, groups []string) error
+}
+
+type Queries interface {
+	IDPTemplates(ctx context.Context, queries *query.IDPTemplateSearchQueries, withOwnerRemoved bool) (*query.IDPTemplates, error)
+	IDPUserLinks(ctx context.Context, queries *query.IDPUserLinksSearchQuery, permissionCheck domain.PermissionCheck) (*query.IDPUserLinks, error)
+}
+
+// groupProvider is implemented by providers able to search the groups of a user
+// without the user authenticating, see [ldap.Provider.FetchUserGroups].
+type groupProvider interface {
+	FetchUserGroups(ctx context.Context, externalUserID string) ([]string, error)
+}
+
+type Worker struct {
+	*instanceworker.Worker[*Request]
+
+	commands Commands
+	queries  Queries
+}
+
+type WorkerConfig struct {
+	// Interval in which the groups of all linked users are synchronised.
+	// If set to 0, the groups are only synchronised on login.
+	Interval            time.Duration
+	TransactionDuration time.Duration
+}
+
+func NewWorker(
+	config WorkerConfig,
+	commands Commands,
+	queries Queries,
+	es instanceworker.EventStore,
+) *Worker {
+	w := &Worker{
+		commands: commands,
+		queries:  queries,
+	}
+	w.Worker = instanceworker.NewWorker[*Request](QueueName, SyncUserID, config.TransactionDuration, es, instancesQuery, w.syncInstance)
+	return w
+}
+
+var _ river.Worker[*Request] = (*Worker)(nil)
+
+func instancesQuery() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsInstanceIDs).
+		OrderDesc().
+		AddQuery().
+		AggregateTypes(instance.AggregateType, org.AggregateType).
+		EventTypes(instance.LDAPIDPAddedEventType, org.LDAPIDPAddedEventType).
+		Builder()
+}
+
+// syncInstance synchronises the groups of the users linked to the LDAP IdPs of the instance.
+func (w *Worker) syncInstance(ctx context.Context) error {
+	typeQuery, err := query.NewIDPTemplateTypeSearchQuery(domain.IDPTypeLDAP)
+	if err != nil {
+		return err
+	}
+	templates, err := w.queries.IDPTemplates(ctx, &query.IDPTemplateSearchQueries{Queries: []query.SearchQuery{typeQuery}}, false)
+	if err != nil {
+		return err
+	}
+	for _, template := range templates.Templates {
+		if template.LDAPIDPTemplate == nil || template.LDAPIDPTemplate.GroupSync == nil || len(template.LDAPIDPTemplate.GroupSync.GroupMappings) == 0 {
+			continue
+		}
+		err = w.syncIDP(ctx, template.ID)
+		logging.WithFields("instance", authz.GetInstance(ctx).InstanceID(), "idp", template.ID).OnError(err).Warn("unable to sync ldap groups of idp")
+	}
+	return nil
+}
+
+func (w *Worker) syncIDP(ctx context.Context, idpID string) error {
+	provider, err := w.commands.GetProvider(ctx, idpID, "", "")
+	if err != nil {
+		return err
+	}
+	groupProvider, ok := provider.(groupProvider)
+	if !ok {
+		return nil
+	}
+	idpIDQuery, err := query.NewIDPUserLinkIDPIDSearchQuery(idpID)
+	if err != nil {
+		return err
+	}
+	links, err := w.queries.IDPUserLinks(ctx, &query.IDPUserLinksSearchQuery{Queries: []query.SearchQuery{idpIDQuery}}, nil)
+	if err != nil {
+		return err
+	}
+	for _, link := range links.Links {
+		groups, err := groupProvider.FetchUserGroups(ctx, link.ProvidedUserID)
+		// without the id attribute the users can't be searched, so no user can be synced
+		if errors.Is(err, ldap.ErrGroupSearchNotPossible) {
+			return err
+		}
+		// users no longer found in the directory lose their mapped roles,
+		// ambiguous results are only logged, so the roles are not revoked because of a duplicate id
+		if errors.Is(err, ldap.ErrUserNotFound) {
+			groups, err = nil, nil
+		}
+		if err == nil {
+			err = w.commands.SyncLDAPUserGrants(ctx, idpID, link.UserID, groups)
+		}
+		logging.WithFields("idp", idpID, "user", link.UserID).OnError(err).Warn("unable to sync ldap groups of user")
+	}
+	return nil
+}
@@ -0,0 +1,133 @@
+package ldapsync
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/idp"
+	"github.com/zitadel/zitadel/internal/idp/providers/ldap"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/queue/instanceworker"
+	repo_idp "github.com/zitadel/zitadel/internal/repository/idp"
+)
+
+type fakeQueries struct {
+	templates []*query.IDPTemplate
+	links     map[string][]*query.IDPUserLink
+}
+
+func (f *fakeQueries) IDPTemplates(context.Context, *query.IDPTemplateSearchQueries, bool) (*query.IDPTemplates, error) {
+	return &query.IDPTemplates{Templates: f.templates}, nil
+}
+
+func (f *fakeQueries) IDPUserLinks(ctx context.Context, _ *query.IDPUserLinksSearchQuery, _ domain.PermissionCheck) (*query.IDPUserLinks, error) {
+	return &query.IDPUserLinks{Links: f.links[authz.GetInstance(ctx).InstanceID()]}, nil
+}
+
+type fakeGroupProvider struct {
+	idp.Provider
+	groups map[string][]string
+	errs   map[string]error
+}
+
+func (f *fakeGroupProvider) FetchUserGroups(_ context.Context, externalUserID string) ([]string, error) {
+	if err := f.errs[externalUserID]; err != nil {
+		return nil, err
+	}
+	groups, ok := f.groups[externalUserID]
+	if !ok {
+		return nil, ldap.ErrUserNotFound
+	}
+	return groups, nil
+}
+
+type fakeCommands struct {
+	provider idp.Provider
+	synced   map[string][]string
+}
+
+func (f *fakeCommands) GetProvider(context.Context, string, string, string) (idp.Provider, error) {
+	if f.provider == nil {
+		return nil, errors.New("not found")
+	}
+	return f.provider, nil
+}
+
+func (f *fakeCommands) SyncLDAPUserGrants(ctx context.Context, idpID, userID string, groups []string) error {
+	f.synced[authz.GetInstance(ctx).InstanceID()+"/"+idpID+"/"+userID] = groups
+	return nil
+}
+
+func TestWorker_syncInstance(t *testing.T) {
+	groupSync := &repo_idp.LDAPGroupSync{
+		GroupMappings: []*repo_idp.LDAPGroupMapping{
+			{Group: "cn=admins", ProjectID: "project1", RoleKeys: []string{"admin"}},
+		},
+	}
+	templates := []*query.IDPTemplate{
+		{ID: "idp1", LDAPIDPTemplate: &query.LDAPIDPTemplate{GroupSync: groupSync}},
+		{ID: "idp2", LDAPIDPTemplate: &query.LDAPIDPTemplate{}},
+	}
+	links := map[string][]*query.IDPUserLink{
+		"instance1": {
+			{IDPID: "idp1", UserID: "user1", ProvidedUserID: "ext1"},
+			{IDPID: "idp1", UserID: "user2", ProvidedUserID: "ext2"},
+		},
+	}
+	tests := []struct {
+		name     string
+		provider idp.Provider
+		want     map[string][]string
+	}{
+		{
+			name:     "provider without group search, no sync",
+			provider: ldap.New("ldap", []string{"server"}, "basedn", "binddn", "password", "user", nil, nil, 0, nil, ""),
+			want:     map[string][]string{},
+		},
+		{
+			name: "users synced, missing user without groups",
+			provider: &fakeGroupProvider{
+				groups: map[string][]string{"ext1": {"cn=admins"}},
+			},
+			want: map[string][]string{
+				"instance1/idp1/user1": {"cn=admins"},
+				"instance1/idp1/user2": nil,
+			},
+		},
+		{
+			name: "ambiguous user not synced",
+			provider: &fakeGroupProvider{
+				groups: map[string][]string{"ext1": {"cn=admins"}},
+				errs:   map[string]error{"ext2": ldap.ErrNoSingleUser},
+			},
+			want: map[string][]string{
+				"instance1/idp1/user1": {"cn=admins"},
+			},
+		},
+		{
+			name: "failed search not synced",
+			provider: &fakeGroupProvider{
+				groups: map[string][]string{"ext1": {"cn=admins"}},
+				errs:   map[string]error{"ext2": errors.New("connection failed")},
+			},
+			want: map[string][]string{
+				"instance1/idp1/user1": {"cn=admins"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			commands := &fakeCommands{provider: tt.provider, synced: make(map[string][]string)}
+			w := NewWorker(WorkerConfig{}, commands, &fakeQueries{templates: templates, links: links}, nil)
+			err := w.syncInstance(instanceworker.WithInstance(context.Background(), "instance1", SyncUserID))
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, commands.synced)
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package handlers
+
+import (
+	"context"
+	"slices"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+)
+
+// AccessRequestApprovers returns the members allowed to decide on requests for roles of the project,
+// respectively of the project grant if projectGrantID is set.
+func (n *NotificationQueries) AccessRequestApprovers(ctx context.Context, projectID, projectGrantID, resourceOwner string) (approvers []*query.Member, err error) {
+	var members *query.Members
+	approverRoles := []string{domain.RoleProjectOwner, domain.RoleProjectAccessApprover}
+	if projectGrantID != "" {
+		approverRoles = []string{domain.RoleProjectGrantOwner}
+		members, err = n.ProjectGrantMembers(ctx, &query.ProjectGrantMembersQuery{
+			ProjectID: projectID,
+			GrantID:   projectGrantID,
+			OrgID:     resourceOwner,
+		})
+	} else {
+		members, err = n.ProjectMembers(ctx, &query.ProjectMembersQuery{
+			ProjectID: projectID,
+		})
+	}
+	if err != nil {
+		return nil, err
+	}
+	for _, member := range members.Members {
+		if slices.ContainsFunc(member.Roles, func(role string) bool {
+			return slices.Contains(approverRoles, role)
+		}) {
+			approvers = append(approvers, member)
+		}
+	}
+	return approvers, nil
+}
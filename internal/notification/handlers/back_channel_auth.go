@@ -0,0 +1,209 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	http_utils "github.com/zitadel/zitadel/internal/api/http"
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/notification/channels"
+	"github.com/zitadel/zitadel/internal/notification/channels/webhook"
+	"github.com/zitadel/zitadel/internal/notification/senders"
+	_ "github.com/zitadel/zitadel/internal/notification/statik"
+	"github.com/zitadel/zitadel/internal/notification/types"
+	"github.com/zitadel/zitadel/internal/repository/backchannelauth"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	BackChannelAuthNotificationsProjectionTable = "projections.notifications_back_channel_auth"
+)
+
+// backChannelAuthNotifier asks users to approve backchannel authentication requests (CIBA)
+// and notifies clients using the ping mode as soon as the user approved or denied the request.
+type backChannelAuthNotifier struct {
+	commands   *command.Commands
+	queries    *NotificationQueries
+	eventstore *eventstore.Eventstore
+	channels   types.ChannelChains
+	urlTmpl    string
+}
+
+func NewBackChannelAuthNotifier(
+	ctx context.Context,
+	config handler.Config,
+	commands *command.Commands,
+	queries *NotificationQueries,
+	es *eventstore.Eventstore,
+	channels types.ChannelChains,
+	urlTmpl string,
+) *handler.Handler {
+	return handler.NewHandler(ctx, &config, &backChannelAuthNotifier{
+		commands:   commands,
+		queries:    queries,
+		eventstore: es,
+		channels:   channels,
+		urlTmpl:    urlTmpl,
+	})
+}
+
+func (*backChannelAuthNotifier) Name() string {
+	return BackChannelAuthNotificationsProjectionTable
+}
+
+func (u *backChannelAuthNotifier) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: backchannelauth.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  backchannelauth.AddedEventType,
+					Reduce: u.reduceAdded,
+				},
+				{
+					Event:  backchannelauth.ApprovedEventType,
+					Reduce: u.reduceCompleted,
+				},
+				{
+					Event:  backchannelauth.CanceledEventType,
+					Reduce: u.reduceCompleted,
+				},
+			},
+		},
+	}
+}
+
+func (u *backChannelAuthNotifier) reduceAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*backchannelauth.AddedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Ohch4", "reduce.wrong.event.type %s", backchannelauth.AddedEventType)
+	}
+
+	return handler.NewStatement(event, func(ex handler.Executer, projectionName string) error {
+		ctx, err := u.queries.HandlerContext(event.Aggregate())
+		if err != nil {
+			return err
+		}
+		model, err := u.writeModel(ctx, e.Aggregate())
+		if err != nil {
+			return err
+		}
+		if model.UserNotified || model.State != domain.DeviceAuthStateInitiated || model.Expires.Before(time.Now()) {
+			return nil
+		}
+		err = u.notifyUser(ctx, e)
+		if err != nil {
+			if errors.Is(err, &channels.CancelError{}) {
+				// if the notification was canceled, we don't want to return the error, so there is no retry
+				return nil
+			}
+			return err
+		}
+		return u.commands.BackChannelAuthUserNotified(ctx, e.Aggregate().ID)
+	}), nil
+}
+
+// notifyUser sends the request by SMS if the user has a verified phone number, by email otherwise.
+func (u *backChannelAuthNotifier) notifyUser(ctx context.Context, e *backchannelauth.AddedEvent) error {
+	notifyUser, err := u.queries.GetNotifyUserByID(ctx, true, e.UserID)
+	if err != nil {
+		return err
+	}
+	colors, err := u.queries.ActiveLabelPolicyByOrg(ctx, e.UserOrgID, false)
+	if err != nil {
+		return err
+	}
+	translator, err := u.queries.GetTranslatorWithOrgTexts(ctx, e.UserOrgID, domain.BackChannelAuthMessageType)
+	if err != nil {
+		return err
+	}
+	app, err := u.queries.AppByOIDCClientID(ctx, e.ClientID)
+	if err != nil {
+		return err
+	}
+	ctx, err = u.queries.Origin(ctx, e)
+	if err != nil {
+		return err
+	}
+	var buf strings.Builder
+	err = domain.RenderURLTemplate(&buf, http_utils.DomainContext(ctx).Origin()+u.urlTmpl, map[string]any{
+		"AuthRequestID": e.Aggregate().ID,
+		"LoginName":     notifyUser.PreferredLoginName,
+	})
+	if err != nil {
+		return err
+	}
+	expiry := time.Until(e.Expires).Round(time.Second)
+
+	if notifyUser.VerifiedPhone != "" {
+		notify := types.SendSMS(ctx, u.channels, translator, notifyUser, colors, e.Type(), e.Aggregate().InstanceID, e.Aggregate().ID, &senders.CodeGeneratorInfo{})
+		return notify.SendBackChannelAuthRequest(ctx, buf.String(), app.Name, e.BindingMessage, expiry)
+	}
+	template, err := u.queries.MailTemplateByOrg(ctx, e.UserOrgID, false)
+	if err != nil {
+		return err
+	}
+	notify := types.SendEmail(ctx, u.channels, string(template.Template), translator, notifyUser, colors, e.Type())
+	return notify.SendBackChannelAuthRequest(ctx, buf.String(), app.Name, e.BindingMessage, expiry)
+}
+
+func (u *backChannelAuthNotifier) reduceCompleted(event eventstore.Event) (*handler.Statement, error) {
+	switch event.(type) {
+	case *backchannelauth.ApprovedEvent, *backchannelauth.CanceledEvent:
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Eiv1e", "reduce.wrong.event.type %v", []eventstore.EventType{backchannelauth.ApprovedEventType, backchannelauth.CanceledEventType})
+	}
+
+	return handler.NewStatement(event, func(ex handler.Executer, projectionName string) error {
+		ctx, err := u.queries.HandlerContext(event.Aggregate())
+		if err != nil {
+			return err
+		}
+		model, err := u.writeModel(ctx, event.Aggregate())
+		if err != nil {
+			return err
+		}
+		if model.NotificationURI == "" || model.ClientNotified {
+			return nil
+		}
+		token, err := crypto.DecryptString(model.NotificationToken, u.queries.UserDataCrypto)
+		if err != nil {
+			return err
+		}
+		err = types.SendJSON(
+			ctx,
+			webhook.Config{
+				CallURL: model.NotificationURI,
+				Method:  http.MethodPost,
+				Headers: http.Header{"Authorization": []string{"Bearer " + token}},
+			},
+			u.channels,
+			&BackChannelAuthPingMessage{AuthReqID: event.Aggregate().ID},
+			event.Type(),
+		).WithoutTemplate()
+		if err != nil {
+			return err
+		}
+		return u.commands.BackChannelAuthClientNotified(ctx, event.Aggregate().ID)
+	}), nil
+}
+
+func (u *backChannelAuthNotifier) writeModel(ctx context.Context, aggregate *eventstore.Aggregate) (*command.BackChannelAuthWriteModel, error) {
+	model := command.NewBackChannelAuthWriteModel(aggregate.ID, aggregate.InstanceID)
+	if err := u.eventstore.FilterToQueryReducer(ctx, model); err != nil {
+		return nil, err
+	}
+	return model, nil
+}
+
+// BackChannelAuthPingMessage is sent to the client notification endpoint in the ping mode.
+type BackChannelAuthPingMessage struct {
+	AuthReqID string `json:"auth_req_id"`
+}
@@ -11,10 +11,13 @@ import (
 	"github.com/zitadel/oidc/v3/pkg/crypto"
 	"github.com/zitadel/oidc/v3/pkg/oidc"
 	"github.com/zitadel/oidc/v3/pkg/op"
+	"github.com/zitadel/saml/pkg/provider"
+	"github.com/zitadel/saml/pkg/provider/key"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
 	http_utils "github.com/zitadel/zitadel/internal/api/http"
 	zoidc "github.com/zitadel/zitadel/internal/api/oidc"
+	zsaml "github.com/zitadel/zitadel/internal/api/saml"
 	"github.com/zitadel/zitadel/internal/command"
 	zcrypto "github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/eventstore"
@@ -31,6 +34,8 @@ import (
 
 const (
 	BackChannelLogoutNotificationsProjectionTable = "projections.notifications_back_channel_logout"
+
+	samlSignatureAlgorithm = "http://www.w3.org/2001/04/xmldsig-more#rsa-sha256"
 )
 
 type backChannelLogoutNotifier struct {
@@ -135,6 +140,8 @@ type backChannelLogoutSession struct {
 
 	// sessions contain a map of oidc session IDs and their corresponding clientID
 	sessions []backChannelLogoutOIDCSessions
+	// samlSessions contain the saml sessions, which need to be logged out at their service provider
+	samlSessions []backChannelLogoutSAMLSessions
 }
 
 func (u *backChannelLogoutNotifier) terminateSession(ctx context.Context, id string, e eventstore.Event) error {
@@ -151,23 +158,45 @@ func (u *backChannelLogoutNotifier) terminateSession(ctx context.Context, id str
 
 	getSigner := zoidc.GetSignerOnce(u.queries.GetActiveSigningWebKey, u.signingKey)
 
-	var wg sync.WaitGroup
-	wg.Add(len(sessions.sessions))
-	errs := make([]error, 0, len(sessions.sessions))
+	var (
+		wg    sync.WaitGroup
+		errMu sync.Mutex
+	)
+	wg.Add(len(sessions.sessions) + len(sessions.samlSessions))
+	errs := make([]error, 0, len(sessions.sessions)+len(sessions.samlSessions))
+	addErr := func(err error) {
+		errMu.Lock()
+		defer errMu.Unlock()
+		errs = append(errs, err)
+	}
 	for _, oidcSession := range sessions.sessions {
 		go func(oidcSession *backChannelLogoutOIDCSessions) {
 			defer wg.Done()
 			err := u.sendLogoutToken(ctx, oidcSession, e, getSigner)
 			if err != nil {
-				errs = append(errs, err)
+				addErr(err)
 				return
 			}
 			err = u.commands.BackChannelLogoutSent(ctx, oidcSession.SessionID, oidcSession.OIDCSessionID, e.Aggregate().InstanceID)
 			if err != nil {
-				errs = append(errs, err)
+				addErr(err)
 			}
 		}(&oidcSession)
 	}
+	for _, samlSession := range sessions.samlSessions {
+		go func(samlSession *backChannelLogoutSAMLSessions) {
+			defer wg.Done()
+			err := u.sendSAMLLogoutRequest(ctx, samlSession, e)
+			if err != nil {
+				addErr(err)
+				return
+			}
+			err = u.commands.SAMLLogoutSent(ctx, samlSession.SessionID, samlSession.SAMLSessionID, e.Aggregate().InstanceID)
+			if err != nil {
+				addErr(err)
+			}
+		}(&samlSession)
+	}
 	wg.Wait()
 	return errors.Join(errs...)
 }
@@ -223,6 +252,54 @@ type LogoutTokenMessage struct {
 	LogoutToken string `schema:"logout_token"`
 }
 
+// sendSAMLLogoutRequest sends a signed LogoutRequest to the single logout service (HTTP-POST binding) of the service provider.
+// Service providers without such a service are skipped.
+func (u *backChannelLogoutNotifier) sendSAMLLogoutRequest(ctx context.Context, samlSession *backChannelLogoutSAMLSessions, e eventstore.Event) error {
+	sp, err := u.queries.ActiveSAMLServiceProviderByID(ctx, samlSession.EntityID)
+	if err != nil {
+		return err
+	}
+	logoutURL, err := zsaml.LogoutRequestURL(sp.Metadata)
+	if err != nil || logoutURL == "" {
+		logging.WithFields("instanceID", authz.GetInstance(ctx).InstanceID(), "entityID", samlSession.EntityID).OnError(err).
+			Info("unable to send saml logout request, service provider does not provide a single logout service")
+		return nil
+	}
+	certAndKey, err := u.samlSigningCertificate(ctx)
+	if err != nil {
+		return err
+	}
+	request, err := zsaml.CreateLogoutRequest(
+		certAndKey,
+		samlSignatureAlgorithm,
+		http_utils.DomainContext(ctx).Origin()+zsaml.HandlerPrefix+provider.DefaultMetadataEndpoint,
+		logoutURL,
+		samlSession.NameID,
+		samlSession.NameIDFormat,
+		samlSession.SessionIndex,
+		u.tokenLifetime,
+	)
+	if err != nil {
+		return err
+	}
+	return types.SendSecurityTokenEvent(ctx, set.Config{CallURL: logoutURL}, u.channels, &SAMLLogoutRequestMessage{SAMLRequest: request}, e.Type()).WithoutTemplate()
+}
+
+func (u *backChannelLogoutNotifier) samlSigningCertificate(ctx context.Context) (*key.CertificateAndKey, error) {
+	certs, err := u.queries.ActiveCertificates(ctx, time.Now(), zcrypto.KeyUsageSAMLResponseSinging)
+	if err != nil {
+		return nil, err
+	}
+	if len(certs.Certificates) == 0 {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "HANDL-Sd3gq", "no active saml signing certificate")
+	}
+	return zsaml.CertificateToCertificateAndKey(zsaml.SelectCertificate(certs.Certificates), u.keyEncryptionAlg)
+}
+
+type SAMLLogoutRequestMessage struct {
+	SAMLRequest string `schema:"SAMLRequest"`
+}
+
 type backChannelLogoutOIDCSessions struct {
 	SessionID            string
 	OIDCSessionID        string
@@ -231,6 +308,15 @@ type backChannelLogoutOIDCSessions struct {
 	BackChannelLogoutURI string
 }
 
+type backChannelLogoutSAMLSessions struct {
+	SessionID     string
+	SAMLSessionID string
+	EntityID      string
+	NameID        string
+	NameIDFormat  string
+	SessionIndex  string
+}
+
 func (b *backChannelLogoutSession) Reduce() error {
 	return nil
 }
@@ -250,6 +336,19 @@ func (b *backChannelLogoutSession) AppendEvents(events ...eventstore.Event) {
 			b.sessions = slices.DeleteFunc(b.sessions, func(session backChannelLogoutOIDCSessions) bool {
 				return session.OIDCSessionID == e.OIDCSessionID
 			})
+		case *sessionlogout.SAMLLogoutRegisteredEvent:
+			b.samlSessions = append(b.samlSessions, backChannelLogoutSAMLSessions{
+				SessionID:     b.sessionID,
+				SAMLSessionID: e.SAMLSessionID,
+				EntityID:      e.EntityID,
+				NameID:        e.NameID,
+				NameIDFormat:  e.NameIDFormat,
+				SessionIndex:  e.SessionIndex,
+			})
+		case *sessionlogout.SAMLLogoutSentEvent:
+			b.samlSessions = slices.DeleteFunc(b.samlSessions, func(session backChannelLogoutSAMLSessions) bool {
+				return session.SAMLSessionID == e.SAMLSessionID
+			})
 		}
 	}
 }
@@ -261,6 +360,8 @@ func (b *backChannelLogoutSession) Query() *eventstore.SearchQueryBuilder {
 		AggregateIDs(b.sessionID).
 		EventTypes(
 			sessionlogout.BackChannelLogoutRegisteredType,
-			sessionlogout.BackChannelLogoutSentType).
+			sessionlogout.BackChannelLogoutSentType,
+			sessionlogout.SAMLLogoutRegisteredType,
+			sessionlogout.SAMLLogoutSentType).
 		Builder()
 }
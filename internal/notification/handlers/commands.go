@@ -19,8 +19,12 @@ type Commands interface {
 	UserDomainClaimedSent(ctx context.Context, orgID, userID string) error
 	HumanPasswordlessInitCodeSent(ctx context.Context, userID, resourceOwner, codeID string) error
 	PasswordChangeSent(ctx context.Context, orgID, userID string) error
+	RecoveryCodesLowSent(ctx context.Context, orgID, userID string) error
 	HumanPhoneVerificationCodeSent(ctx context.Context, orgID, userID string, generatorInfo *senders.CodeGeneratorInfo) error
 	InviteCodeSent(ctx context.Context, orgID, userID string) error
+	UserGrantExpiryReminderSent(ctx context.Context, orgID, grantID string) error
+	AccessRequestApproversNotified(ctx context.Context, orgID, id string) error
+	RefreshTokenReuseNotificationSent(ctx context.Context, orgID, oidcSessionID string) error
 	UsageNotificationSent(ctx context.Context, dueEvent *quota.NotificationDueEvent) error
 	MilestonePushed(ctx context.Context, instanceID string, msType milestone.Type, endpoints []string) error
 }
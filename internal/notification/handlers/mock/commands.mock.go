@@ -42,6 +42,20 @@ func (m *MockCommands) EXPECT() *MockCommandsMockRecorder {
 	return m.recorder
 }
 
+// AccessRequestApproversNotified mocks base method.
+func (m *MockCommands) AccessRequestApproversNotified(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AccessRequestApproversNotified", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AccessRequestApproversNotified indicates an expected call of AccessRequestApproversNotified.
+func (mr *MockCommandsMockRecorder) AccessRequestApproversNotified(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AccessRequestApproversNotified", reflect.TypeOf((*MockCommands)(nil).AccessRequestApproversNotified), arg0, arg1, arg2)
+}
+
 // HumanEmailVerificationCodeSent mocks base method.
 func (m *MockCommands) HumanEmailVerificationCodeSent(arg0 context.Context, arg1, arg2 string) error {
 	m.ctrl.T.Helper()
@@ -196,6 +210,34 @@ func (mr *MockCommandsMockRecorder) PasswordChangeSent(arg0, arg1, arg2 any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PasswordChangeSent", reflect.TypeOf((*MockCommands)(nil).PasswordChangeSent), arg0, arg1, arg2)
 }
 
+// RecoveryCodesLowSent mocks base method.
+func (m *MockCommands) RecoveryCodesLowSent(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecoveryCodesLowSent", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecoveryCodesLowSent indicates an expected call of RecoveryCodesLowSent.
+func (mr *MockCommandsMockRecorder) RecoveryCodesLowSent(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecoveryCodesLowSent", reflect.TypeOf((*MockCommands)(nil).RecoveryCodesLowSent), arg0, arg1, arg2)
+}
+
+// RefreshTokenReuseNotificationSent mocks base method.
+func (m *MockCommands) RefreshTokenReuseNotificationSent(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshTokenReuseNotificationSent", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RefreshTokenReuseNotificationSent indicates an expected call of RefreshTokenReuseNotificationSent.
+func (mr *MockCommandsMockRecorder) RefreshTokenReuseNotificationSent(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshTokenReuseNotificationSent", reflect.TypeOf((*MockCommands)(nil).RefreshTokenReuseNotificationSent), arg0, arg1, arg2)
+}
+
 // PasswordCodeSent mocks base method.
 func (m *MockCommands) PasswordCodeSent(arg0 context.Context, arg1, arg2 string, arg3 *senders.CodeGeneratorInfo) error {
 	m.ctrl.T.Helper()
@@ -210,6 +252,20 @@ func (mr *MockCommandsMockRecorder) PasswordCodeSent(arg0, arg1, arg2, arg3 any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PasswordCodeSent", reflect.TypeOf((*MockCommands)(nil).PasswordCodeSent), arg0, arg1, arg2, arg3)
 }
 
+// UserGrantExpiryReminderSent mocks base method.
+func (m *MockCommands) UserGrantExpiryReminderSent(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserGrantExpiryReminderSent", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UserGrantExpiryReminderSent indicates an expected call of UserGrantExpiryReminderSent.
+func (mr *MockCommandsMockRecorder) UserGrantExpiryReminderSent(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserGrantExpiryReminderSent", reflect.TypeOf((*MockCommands)(nil).UserGrantExpiryReminderSent), arg0, arg1, arg2)
+}
+
 // UsageNotificationSent mocks base method.
 func (m *MockCommands) UsageNotificationSent(arg0 context.Context, arg1 *quota.NotificationDueEvent) error {
 	m.ctrl.T.Helper()
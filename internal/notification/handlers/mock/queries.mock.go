@@ -16,6 +16,7 @@ import (
 
 	jose "github.com/go-jose/go-jose/v4"
 	authz "github.com/zitadel/zitadel/internal/api/authz"
+	crypto "github.com/zitadel/zitadel/internal/crypto"
 	domain "github.com/zitadel/zitadel/internal/domain"
 	query "github.com/zitadel/zitadel/internal/query"
 	gomock "go.uber.org/mock/gomock"
@@ -45,6 +46,21 @@ func (m *MockQueries) EXPECT() *MockQueriesMockRecorder {
 	return m.recorder
 }
 
+// ActiveCertificates mocks base method.
+func (m *MockQueries) ActiveCertificates(arg0 context.Context, arg1 time.Time, arg2 crypto.KeyUsage) (*query.Certificates, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ActiveCertificates", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*query.Certificates)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ActiveCertificates indicates an expected call of ActiveCertificates.
+func (mr *MockQueriesMockRecorder) ActiveCertificates(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActiveCertificates", reflect.TypeOf((*MockQueries)(nil).ActiveCertificates), arg0, arg1, arg2)
+}
+
 // ActiveInstances mocks base method.
 func (m *MockQueries) ActiveInstances() []string {
 	m.ctrl.T.Helper()
@@ -89,6 +105,36 @@ func (mr *MockQueriesMockRecorder) ActivePrivateSigningKey(arg0, arg1 any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActivePrivateSigningKey", reflect.TypeOf((*MockQueries)(nil).ActivePrivateSigningKey), arg0, arg1)
 }
 
+// ActiveSAMLServiceProviderByID mocks base method.
+func (m *MockQueries) ActiveSAMLServiceProviderByID(arg0 context.Context, arg1 string) (*query.SAMLServiceProvider, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ActiveSAMLServiceProviderByID", arg0, arg1)
+	ret0, _ := ret[0].(*query.SAMLServiceProvider)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ActiveSAMLServiceProviderByID indicates an expected call of ActiveSAMLServiceProviderByID.
+func (mr *MockQueriesMockRecorder) ActiveSAMLServiceProviderByID(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActiveSAMLServiceProviderByID", reflect.TypeOf((*MockQueries)(nil).ActiveSAMLServiceProviderByID), arg0, arg1)
+}
+
+// AppByOIDCClientID mocks base method.
+func (m *MockQueries) AppByOIDCClientID(arg0 context.Context, arg1 string) (*query.App, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AppByOIDCClientID", arg0, arg1)
+	ret0, _ := ret[0].(*query.App)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AppByOIDCClientID indicates an expected call of AppByOIDCClientID.
+func (mr *MockQueriesMockRecorder) AppByOIDCClientID(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppByOIDCClientID", reflect.TypeOf((*MockQueries)(nil).AppByOIDCClientID), arg0, arg1)
+}
+
 // CustomTextListByTemplate mocks base method.
 func (m *MockQueries) CustomTextListByTemplate(arg0 context.Context, arg1, arg2 string, arg3 bool) (*query.CustomTexts, error) {
 	m.ctrl.T.Helper()
@@ -223,6 +269,51 @@ func (mr *MockQueriesMockRecorder) NotificationProviderByIDAndType(arg0, arg1, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotificationProviderByIDAndType", reflect.TypeOf((*MockQueries)(nil).NotificationProviderByIDAndType), arg0, arg1, arg2)
 }
 
+// ProjectByID mocks base method.
+func (m *MockQueries) ProjectByID(arg0 context.Context, arg1 bool, arg2 string) (*query.Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProjectByID", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*query.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProjectByID indicates an expected call of ProjectByID.
+func (mr *MockQueriesMockRecorder) ProjectByID(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProjectByID", reflect.TypeOf((*MockQueries)(nil).ProjectByID), arg0, arg1, arg2)
+}
+
+// ProjectGrantMembers mocks base method.
+func (m *MockQueries) ProjectGrantMembers(arg0 context.Context, arg1 *query.ProjectGrantMembersQuery) (*query.Members, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProjectGrantMembers", arg0, arg1)
+	ret0, _ := ret[0].(*query.Members)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProjectGrantMembers indicates an expected call of ProjectGrantMembers.
+func (mr *MockQueriesMockRecorder) ProjectGrantMembers(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProjectGrantMembers", reflect.TypeOf((*MockQueries)(nil).ProjectGrantMembers), arg0, arg1)
+}
+
+// ProjectMembers mocks base method.
+func (m *MockQueries) ProjectMembers(arg0 context.Context, arg1 *query.ProjectMembersQuery) (*query.Members, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProjectMembers", arg0, arg1)
+	ret0, _ := ret[0].(*query.Members)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProjectMembers indicates an expected call of ProjectMembers.
+func (mr *MockQueriesMockRecorder) ProjectMembers(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProjectMembers", reflect.TypeOf((*MockQueries)(nil).ProjectMembers), arg0, arg1)
+}
+
 // SMSProviderConfigActive mocks base method.
 func (m *MockQueries) SMSProviderConfigActive(arg0 context.Context, arg1 string) (*query.SMSConfig, error) {
 	m.ctrl.T.Helper()
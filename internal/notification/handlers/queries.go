@@ -31,6 +31,12 @@ type Queries interface {
 	InstanceByID(ctx context.Context, id string) (instance authz.Instance, err error)
 	GetActiveSigningWebKey(ctx context.Context) (*jose.JSONWebKey, error)
 	ActivePrivateSigningKey(ctx context.Context, t time.Time) (keys *query.PrivateKeys, err error)
+	ActiveCertificates(ctx context.Context, t time.Time, usage crypto.KeyUsage) (certs *query.Certificates, err error)
+	ActiveSAMLServiceProviderByID(ctx context.Context, entityID string) (sp *query.SAMLServiceProvider, err error)
+	AppByOIDCClientID(ctx context.Context, clientID string) (app *query.App, err error)
+	ProjectByID(ctx context.Context, shouldTriggerBulk bool, id string) (project *query.Project, err error)
+	ProjectMembers(ctx context.Context, queries *query.ProjectMembersQuery) (members *query.Members, err error)
+	ProjectGrantMembers(ctx context.Context, queries *query.ProjectGrantMembersQuery) (members *query.Members, err error)
 
 	ActiveInstances() []string
 }
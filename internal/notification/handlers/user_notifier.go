@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	http_util "github.com/zitadel/zitadel/internal/api/http"
@@ -13,9 +14,12 @@ import (
 	"github.com/zitadel/zitadel/internal/notification/senders"
 	"github.com/zitadel/zitadel/internal/notification/types"
 	"github.com/zitadel/zitadel/internal/queue"
+	"github.com/zitadel/zitadel/internal/repository/accessrequest"
 	"github.com/zitadel/zitadel/internal/repository/notification"
+	"github.com/zitadel/zitadel/internal/repository/oidcsession"
 	"github.com/zitadel/zitadel/internal/repository/session"
 	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/repository/usergrant"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
@@ -70,6 +74,26 @@ func init() {
 			return commands.PasswordChangeSent(ctx, orgID, id)
 		},
 	)
+	RegisterSentHandler(user.HumanRecoveryCodeCheckSucceededType,
+		func(ctx context.Context, commands Commands, id, orgID string, generatorInfo *senders.CodeGeneratorInfo, args map[string]any) error {
+			return commands.RecoveryCodesLowSent(ctx, orgID, id)
+		},
+	)
+	RegisterSentHandler(usergrant.UserGrantExpiryRemindedType,
+		func(ctx context.Context, commands Commands, id, orgID string, generatorInfo *senders.CodeGeneratorInfo, args map[string]any) error {
+			return commands.UserGrantExpiryReminderSent(ctx, orgID, id)
+		},
+	)
+	RegisterSentHandler(oidcsession.RefreshTokenReusedType,
+		func(ctx context.Context, commands Commands, id, orgID string, generatorInfo *senders.CodeGeneratorInfo, args map[string]any) error {
+			return commands.RefreshTokenReuseNotificationSent(ctx, orgID, id)
+		},
+	)
+	RegisterSentHandler(accessrequest.AddedType,
+		func(ctx context.Context, commands Commands, id, orgID string, generatorInfo *senders.CodeGeneratorInfo, args map[string]any) error {
+			return commands.AccessRequestApproversNotified(ctx, orgID, id)
+		},
+	)
 	RegisterSentHandler(user.HumanPhoneCodeAddedType,
 		func(ctx context.Context, commands Commands, id, orgID string, generatorInfo *senders.CodeGeneratorInfo, args map[string]any) error {
 			return commands.HumanPhoneVerificationCodeSent(ctx, orgID, id, generatorInfo)
@@ -168,6 +192,10 @@ func (u *userNotifier) Reducers() []handler.AggregateReducer {
 					Event:  user.HumanPasswordChangedType,
 					Reduce: u.reducePasswordChanged,
 				},
+				{
+					Event:  user.HumanRecoveryCodeCheckSucceededType,
+					Reduce: u.reduceRecoveryCodeCheckSucceeded,
+				},
 				{
 					Event:  user.HumanOTPSMSCodeAddedType,
 					Reduce: u.reduceOTPSMSCodeAdded,
@@ -195,6 +223,33 @@ func (u *userNotifier) Reducers() []handler.AggregateReducer {
 				},
 			},
 		},
+		{
+			Aggregate: usergrant.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  usergrant.UserGrantExpiryRemindedType,
+					Reduce: u.reduceUserGrantExpiryReminded,
+				},
+			},
+		},
+		{
+			Aggregate: accessrequest.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  accessrequest.AddedType,
+					Reduce: u.reduceAccessRequestAdded,
+				},
+			},
+		},
+		{
+			Aggregate: oidcsession.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  oidcsession.RefreshTokenReusedType,
+					Reduce: u.reduceRefreshTokenReused,
+				},
+			},
+		},
 	}
 }
 
@@ -711,6 +766,167 @@ func (u *userNotifier) reducePasswordChanged(event eventstore.Event) (*handler.S
 	}), nil
 }
 
+func (u *userNotifier) reduceRecoveryCodeCheckSucceeded(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.HumanRecoveryCodeCheckSucceededEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-aeG4u", "reduce.wrong.event.type %s", user.HumanRecoveryCodeCheckSucceededType)
+	}
+	// the user is only notified once per set of codes, when the threshold is reached
+	if e.RemainingCodes != domain.RecoveryCodesLowThreshold {
+		return handler.NewNoOpStatement(e), nil
+	}
+
+	return handler.NewStatement(event, func(ex handler.Executer, projectionName string) error {
+		ctx := HandlerContext(event.Aggregate())
+		alreadyHandled, err := u.queries.IsAlreadyHandled(ctx, event, nil, user.HumanRecoveryCodesLowSentType)
+		if err != nil {
+			return err
+		}
+		if alreadyHandled {
+			return nil
+		}
+
+		ctx, err = u.queries.Origin(ctx, e)
+		if err != nil {
+			return err
+		}
+		origin := http_util.DomainContext(ctx).Origin()
+
+		return u.queue.Insert(ctx,
+			&notification.Request{
+				Aggregate:                     e.Aggregate(),
+				UserID:                        e.Aggregate().ID,
+				UserResourceOwner:             e.Aggregate().ResourceOwner,
+				TriggeredAtOrigin:             origin,
+				EventType:                     e.EventType,
+				NotificationType:              domain.NotificationTypeEmail,
+				MessageType:                   domain.RecoveryCodesLowMessageType,
+				URLTemplate:                   console.LoginHintLink(origin, "{{.PreferredLoginName}}"),
+				UnverifiedNotificationChannel: true,
+			},
+			queue.WithQueueName(notification.QueueName),
+			queue.WithMaxAttempts(u.maxAttempts),
+		)
+	}), nil
+}
+
+func (u *userNotifier) reduceUserGrantExpiryReminded(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*usergrant.UserGrantExpiryRemindedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Aiwe4", "reduce.wrong.event.type %s", usergrant.UserGrantExpiryRemindedType)
+	}
+
+	return handler.NewStatement(event, func(ex handler.Executer, projectionName string) error {
+		ctx := HandlerContext(event.Aggregate())
+		alreadyHandled, err := u.queries.IsAlreadyHandled(ctx, event, nil, usergrant.UserGrantExpiryReminderSentType)
+		if err != nil {
+			return err
+		}
+		if alreadyHandled {
+			return nil
+		}
+		// the grant might be owned by another organization than the user
+		notifyUser, err := u.queries.GetNotifyUserByID(ctx, true, e.UserID)
+		if err != nil {
+			return err
+		}
+
+		ctx, err = u.queries.Origin(ctx, e)
+		if err != nil {
+			return err
+		}
+		origin := http_util.DomainContext(ctx).Origin()
+
+		return u.queue.Insert(ctx,
+			&notification.Request{
+				Aggregate:         e.Aggregate(),
+				UserID:            e.UserID,
+				UserResourceOwner: notifyUser.ResourceOwner,
+				TriggeredAtOrigin: origin,
+				EventType:         e.EventType,
+				NotificationType:  domain.NotificationTypeEmail,
+				MessageType:       domain.UserGrantExpiringMessageType,
+				URLTemplate:       console.LoginHintLink(origin, "{{.PreferredLoginName}}"),
+				Args: &domain.NotificationArguments{
+					ProjectName: e.ProjectName,
+					ValidUntil:  e.ValidUntil,
+				},
+				UnverifiedNotificationChannel: true,
+			},
+			queue.WithQueueName(notification.QueueName),
+			queue.WithMaxAttempts(u.maxAttempts),
+		)
+	}), nil
+}
+
+func (u *userNotifier) reduceAccessRequestAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*accessrequest.AddedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Ea3ie", "reduce.wrong.event.type %s", accessrequest.AddedType)
+	}
+
+	return handler.NewStatement(event, func(ex handler.Executer, projectionName string) error {
+		ctx := HandlerContext(event.Aggregate())
+		alreadyHandled, err := u.queries.IsAlreadyHandled(ctx, event, nil, accessrequest.ApproversNotifiedType)
+		if err != nil {
+			return err
+		}
+		if alreadyHandled {
+			return nil
+		}
+		approvers, err := u.queries.AccessRequestApprovers(ctx, e.ProjectID, e.ProjectGrantID, e.Aggregate().ResourceOwner)
+		if err != nil {
+			return err
+		}
+		requester, err := u.queries.GetNotifyUserByID(ctx, true, e.UserID)
+		if err != nil {
+			return err
+		}
+		project, err := u.queries.ProjectByID(ctx, false, e.ProjectID)
+		if err != nil {
+			return err
+		}
+
+		ctx, err = u.queries.Origin(ctx, e)
+		if err != nil {
+			return err
+		}
+		origin := http_util.DomainContext(ctx).Origin()
+
+		for _, approver := range approvers {
+			// a user might be allowed to approve requests, but never the own ones
+			if approver.UserID == e.UserID {
+				continue
+			}
+			err = u.queue.Insert(ctx,
+				&notification.Request{
+					Aggregate:         e.Aggregate(),
+					UserID:            approver.UserID,
+					UserResourceOwner: approver.UserResourceOwner,
+					TriggeredAtOrigin: origin,
+					EventType:         e.EventType,
+					NotificationType:  domain.NotificationTypeEmail,
+					MessageType:       domain.AccessRequestedMessageType,
+					URLTemplate:       console.LoginHintLink(origin, "{{.PreferredLoginName}}"),
+					Args: &domain.NotificationArguments{
+						RequesterName: requester.DisplayName,
+						ProjectName:   project.Name,
+						Roles:         strings.Join(e.RoleKeys, ", "),
+						Reason:        e.Reason,
+					},
+					UnverifiedNotificationChannel: true,
+				},
+				queue.WithQueueName(notification.QueueName),
+				queue.WithMaxAttempts(u.maxAttempts),
+			)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}), nil
+}
+
 func (u *userNotifier) reducePhoneCodeAdded(event eventstore.Event) (*handler.Statement, error) {
 	e, ok := event.(*user.HumanPhoneCodeAddedEvent)
 	if !ok {
@@ -826,3 +1042,46 @@ func (u *userNotifier) checkIfCodeAlreadyHandledOrExpired(ctx context.Context, e
 	}
 	return u.queries.IsAlreadyHandled(ctx, event, data, eventTypes...)
 }
+
+func (u *userNotifier) reduceRefreshTokenReused(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*oidcsession.RefreshTokenReusedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Oo3ae", "reduce.wrong.event.type %s", oidcsession.RefreshTokenReusedType)
+	}
+	if !e.NotifyUser {
+		return handler.NewNoOpStatement(e), nil
+	}
+
+	return handler.NewStatement(event, func(ex handler.Executer, projectionName string) error {
+		ctx := HandlerContext(event.Aggregate())
+		alreadyHandled, err := u.queries.IsAlreadyHandled(ctx, event, nil, oidcsession.RefreshTokenReuseNotificationSentType)
+		if err != nil {
+			return err
+		}
+		if alreadyHandled {
+			return nil
+		}
+
+		ctx, err = u.queries.Origin(ctx, e)
+		if err != nil {
+			return err
+		}
+		origin := http_util.DomainContext(ctx).Origin()
+
+		return u.queue.Insert(ctx,
+			&notification.Request{
+				Aggregate:                     e.Aggregate(),
+				UserID:                        e.UserID,
+				UserResourceOwner:             e.Aggregate().ResourceOwner,
+				TriggeredAtOrigin:             origin,
+				EventType:                     e.EventType,
+				NotificationType:              domain.NotificationTypeEmail,
+				MessageType:                   domain.RefreshTokenReusedMessageType,
+				URLTemplate:                   console.LoginHintLink(origin, "{{.PreferredLoginName}}"),
+				UnverifiedNotificationChannel: true,
+			},
+			queue.WithQueueName(notification.QueueName),
+			queue.WithMaxAttempts(u.maxAttempts),
+		)
+	}), nil
+}
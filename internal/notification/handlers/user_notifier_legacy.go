@@ -16,8 +16,11 @@ import (
 	"github.com/zitadel/zitadel/internal/notification/senders"
 	"github.com/zitadel/zitadel/internal/notification/types"
 	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/repository/accessrequest"
+	"github.com/zitadel/zitadel/internal/repository/oidcsession"
 	"github.com/zitadel/zitadel/internal/repository/session"
 	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/repository/usergrant"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
@@ -97,6 +100,10 @@ func (u *userNotifierLegacy) Reducers() []handler.AggregateReducer {
 					Event:  user.HumanPasswordChangedType,
 					Reduce: u.reducePasswordChanged,
 				},
+				{
+					Event:  user.HumanRecoveryCodeCheckSucceededType,
+					Reduce: u.reduceRecoveryCodeCheckSucceeded,
+				},
 				{
 					Event:  user.HumanOTPSMSCodeAddedType,
 					Reduce: u.reduceOTPSMSCodeAdded,
@@ -124,6 +131,33 @@ func (u *userNotifierLegacy) Reducers() []handler.AggregateReducer {
 				},
 			},
 		},
+		{
+			Aggregate: usergrant.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  usergrant.UserGrantExpiryRemindedType,
+					Reduce: u.reduceUserGrantExpiryReminded,
+				},
+			},
+		},
+		{
+			Aggregate: accessrequest.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  accessrequest.AddedType,
+					Reduce: u.reduceAccessRequestAdded,
+				},
+			},
+		},
+		{
+			Aggregate: oidcsession.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  oidcsession.RefreshTokenReusedType,
+					Reduce: u.reduceRefreshTokenReused,
+				},
+			},
+		},
 	}
 }
 
@@ -710,6 +744,176 @@ func (u *userNotifierLegacy) reducePasswordChanged(event eventstore.Event) (*han
 	}), nil
 }
 
+func (u *userNotifierLegacy) reduceRecoveryCodeCheckSucceeded(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.HumanRecoveryCodeCheckSucceededEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-aeG4u", "reduce.wrong.event.type %s", user.HumanRecoveryCodeCheckSucceededType)
+	}
+	// the user is only notified once per set of codes, when the threshold is reached
+	if e.RemainingCodes != domain.RecoveryCodesLowThreshold {
+		return handler.NewNoOpStatement(e), nil
+	}
+
+	return handler.NewStatement(event, func(ex handler.Executer, projectionName string) error {
+		ctx := HandlerContext(event.Aggregate())
+		alreadyHandled, err := u.queries.IsAlreadyHandled(ctx, event, nil, user.HumanRecoveryCodesLowSentType)
+		if err != nil {
+			return err
+		}
+		if alreadyHandled {
+			return nil
+		}
+
+		colors, err := u.queries.ActiveLabelPolicyByOrg(ctx, e.Aggregate().ResourceOwner, false)
+		if err != nil {
+			return err
+		}
+
+		template, err := u.queries.MailTemplateByOrg(ctx, e.Aggregate().ResourceOwner, false)
+		if err != nil {
+			return err
+		}
+
+		notifyUser, err := u.queries.GetNotifyUserByID(ctx, true, e.Aggregate().ID)
+		if err != nil {
+			return err
+		}
+		translator, err := u.queries.GetTranslatorWithOrgTexts(ctx, notifyUser.ResourceOwner, domain.RecoveryCodesLowMessageType)
+		if err != nil {
+			return err
+		}
+		ctx, err = u.queries.Origin(ctx, e)
+		if err != nil {
+			return err
+		}
+		err = types.SendEmail(ctx, u.channels, string(template.Template), translator, notifyUser, colors, event.Type()).
+			SendRecoveryCodesLow(ctx, notifyUser)
+		if err != nil {
+			if errors.Is(err, &channels.CancelError{}) {
+				// if the notification was canceled, we don't want to return the error, so there is no retry
+				return nil
+			}
+			return err
+		}
+		return u.commands.RecoveryCodesLowSent(ctx, e.Aggregate().ResourceOwner, e.Aggregate().ID)
+	}), nil
+}
+
+func (u *userNotifierLegacy) reduceUserGrantExpiryReminded(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*usergrant.UserGrantExpiryRemindedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Aiwe4", "reduce.wrong.event.type %s", usergrant.UserGrantExpiryRemindedType)
+	}
+
+	return handler.NewStatement(event, func(ex handler.Executer, projectionName string) error {
+		ctx := HandlerContext(event.Aggregate())
+		alreadyHandled, err := u.queries.IsAlreadyHandled(ctx, event, nil, usergrant.UserGrantExpiryReminderSentType)
+		if err != nil {
+			return err
+		}
+		if alreadyHandled {
+			return nil
+		}
+
+		// the grant might be owned by another organization than the user
+		notifyUser, err := u.queries.GetNotifyUserByID(ctx, true, e.UserID)
+		if err != nil {
+			return err
+		}
+		colors, err := u.queries.ActiveLabelPolicyByOrg(ctx, notifyUser.ResourceOwner, false)
+		if err != nil {
+			return err
+		}
+
+		template, err := u.queries.MailTemplateByOrg(ctx, notifyUser.ResourceOwner, false)
+		if err != nil {
+			return err
+		}
+
+		translator, err := u.queries.GetTranslatorWithOrgTexts(ctx, notifyUser.ResourceOwner, domain.UserGrantExpiringMessageType)
+		if err != nil {
+			return err
+		}
+		ctx, err = u.queries.Origin(ctx, e)
+		if err != nil {
+			return err
+		}
+		err = types.SendEmail(ctx, u.channels, string(template.Template), translator, notifyUser, colors, event.Type()).
+			SendUserGrantExpiring(ctx, notifyUser, e.ProjectName, e.ValidUntil)
+		if err != nil {
+			if errors.Is(err, &channels.CancelError{}) {
+				// if the notification was canceled, we don't want to return the error, so there is no retry
+				return nil
+			}
+			return err
+		}
+		return u.commands.UserGrantExpiryReminderSent(ctx, e.Aggregate().ResourceOwner, e.Aggregate().ID)
+	}), nil
+}
+
+func (u *userNotifierLegacy) reduceAccessRequestAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*accessrequest.AddedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Ea3ie", "reduce.wrong.event.type %s", accessrequest.AddedType)
+	}
+
+	return handler.NewStatement(event, func(ex handler.Executer, projectionName string) error {
+		ctx := HandlerContext(event.Aggregate())
+		alreadyHandled, err := u.queries.IsAlreadyHandled(ctx, event, nil, accessrequest.ApproversNotifiedType)
+		if err != nil {
+			return err
+		}
+		if alreadyHandled {
+			return nil
+		}
+		approvers, err := u.queries.AccessRequestApprovers(ctx, e.ProjectID, e.ProjectGrantID, e.Aggregate().ResourceOwner)
+		if err != nil {
+			return err
+		}
+		requester, err := u.queries.GetNotifyUserByID(ctx, true, e.UserID)
+		if err != nil {
+			return err
+		}
+		project, err := u.queries.ProjectByID(ctx, false, e.ProjectID)
+		if err != nil {
+			return err
+		}
+		ctx, err = u.queries.Origin(ctx, e)
+		if err != nil {
+			return err
+		}
+
+		for _, approver := range approvers {
+			// a user might be allowed to approve requests, but never the own ones
+			if approver.UserID == e.UserID {
+				continue
+			}
+			notifyUser, err := u.queries.GetNotifyUserByID(ctx, true, approver.UserID)
+			if err != nil {
+				return err
+			}
+			colors, err := u.queries.ActiveLabelPolicyByOrg(ctx, notifyUser.ResourceOwner, false)
+			if err != nil {
+				return err
+			}
+			template, err := u.queries.MailTemplateByOrg(ctx, notifyUser.ResourceOwner, false)
+			if err != nil {
+				return err
+			}
+			translator, err := u.queries.GetTranslatorWithOrgTexts(ctx, notifyUser.ResourceOwner, domain.AccessRequestedMessageType)
+			if err != nil {
+				return err
+			}
+			err = types.SendEmail(ctx, u.channels, string(template.Template), translator, notifyUser, colors, event.Type()).
+				SendAccessRequested(ctx, notifyUser, requester.DisplayName, project.Name, strings.Join(e.RoleKeys, ", "), e.Reason)
+			if err != nil && !errors.Is(err, &channels.CancelError{}) {
+				return err
+			}
+		}
+		return u.commands.AccessRequestApproversNotified(ctx, e.Aggregate().ResourceOwner, e.Aggregate().ID)
+	}), nil
+}
+
 func (u *userNotifierLegacy) reducePhoneCodeAdded(event eventstore.Event) (*handler.Statement, error) {
 	e, ok := event.(*user.HumanPhoneCodeAddedEvent)
 	if !ok {
@@ -833,3 +1037,57 @@ func (u *userNotifierLegacy) checkIfCodeAlreadyHandledOrExpired(ctx context.Cont
 	}
 	return u.queries.IsAlreadyHandled(ctx, event, data, eventTypes...)
 }
+
+func (u *userNotifierLegacy) reduceRefreshTokenReused(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*oidcsession.RefreshTokenReusedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Oo3ae", "reduce.wrong.event.type %s", oidcsession.RefreshTokenReusedType)
+	}
+	if !e.NotifyUser {
+		return handler.NewNoOpStatement(e), nil
+	}
+
+	return handler.NewStatement(event, func(ex handler.Executer, projectionName string) error {
+		ctx := HandlerContext(event.Aggregate())
+		alreadyHandled, err := u.queries.IsAlreadyHandled(ctx, event, nil, oidcsession.RefreshTokenReuseNotificationSentType)
+		if err != nil {
+			return err
+		}
+		if alreadyHandled {
+			return nil
+		}
+
+		notifyUser, err := u.queries.GetNotifyUserByID(ctx, true, e.UserID)
+		if err != nil {
+			return err
+		}
+		colors, err := u.queries.ActiveLabelPolicyByOrg(ctx, notifyUser.ResourceOwner, false)
+		if err != nil {
+			return err
+		}
+
+		template, err := u.queries.MailTemplateByOrg(ctx, notifyUser.ResourceOwner, false)
+		if err != nil {
+			return err
+		}
+
+		translator, err := u.queries.GetTranslatorWithOrgTexts(ctx, notifyUser.ResourceOwner, domain.RefreshTokenReusedMessageType)
+		if err != nil {
+			return err
+		}
+		ctx, err = u.queries.Origin(ctx, e)
+		if err != nil {
+			return err
+		}
+		err = types.SendEmail(ctx, u.channels, string(template.Template), translator, notifyUser, colors, event.Type()).
+			SendRefreshTokenReused(ctx, notifyUser)
+		if err != nil {
+			if errors.Is(err, &channels.CancelError{}) {
+				// if the notification was canceled, we don't want to return the error, so there is no retry
+				return nil
+			}
+			return err
+		}
+		return u.commands.RefreshTokenReuseNotificationSent(ctx, e.Aggregate().ResourceOwner, e.Aggregate().ID)
+	}), nil
+}
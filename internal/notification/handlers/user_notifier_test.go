@@ -13,6 +13,7 @@ import (
 	"golang.org/x/text/language"
 
 	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/database"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/eventstore/repository"
@@ -26,9 +27,12 @@ import (
 	"github.com/zitadel/zitadel/internal/notification/senders"
 	"github.com/zitadel/zitadel/internal/notification/types"
 	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/repository/accessrequest"
 	"github.com/zitadel/zitadel/internal/repository/notification"
+	"github.com/zitadel/zitadel/internal/repository/oidcsession"
 	"github.com/zitadel/zitadel/internal/repository/session"
 	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/repository/usergrant"
 )
 
 const (
@@ -1076,6 +1080,492 @@ func Test_userNotifier_reducePasswordChanged(t *testing.T) {
 	}
 }
 
+func Test_userNotifier_reduceRecoveryCodeCheckSucceeded(t *testing.T) {
+	tests := []struct {
+		name string
+		test func(*gomock.Controller, *mock.MockQueries, *mock.MockQueue) (fields, args, want)
+	}{
+		{
+			name: "threshold reached",
+			test: func(ctrl *gomock.Controller, queries *mock.MockQueries, queue *mock.MockQueue) (f fields, a args, w want) {
+				queue.EXPECT().Insert(
+					gomock.Any(),
+					&notification.Request{
+						Aggregate: &eventstore.Aggregate{
+							ID:            userID,
+							InstanceID:    instanceID,
+							ResourceOwner: orgID,
+						},
+						UserID:                        userID,
+						UserResourceOwner:             orgID,
+						TriggeredAtOrigin:             eventOrigin,
+						URLTemplate:                   fmt.Sprintf("%s/ui/console?login_hint={{.PreferredLoginName}}", eventOrigin),
+						EventType:                     user.HumanRecoveryCodeCheckSucceededType,
+						NotificationType:              domain.NotificationTypeEmail,
+						MessageType:                   domain.RecoveryCodesLowMessageType,
+						UnverifiedNotificationChannel: true,
+					},
+					gomock.Any(),
+					gomock.Any(),
+				).Return(nil)
+				return fields{
+						queries: queries,
+						queue:   queue,
+						es: eventstore.NewEventstore(&eventstore.Config{
+							Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+						}),
+					}, args{
+						event: &user.HumanRecoveryCodeCheckSucceededEvent{
+							BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
+								InstanceID:    instanceID,
+								AggregateID:   userID,
+								ResourceOwner: sql.NullString{String: orgID},
+								CreationDate:  time.Now().UTC(),
+								Typ:           user.HumanRecoveryCodeCheckSucceededType,
+							}),
+							RemainingCodes:    domain.RecoveryCodesLowThreshold,
+							TriggeredAtOrigin: eventOrigin,
+						},
+					}, w
+			},
+		},
+		{
+			name: "enough codes left",
+			test: func(ctrl *gomock.Controller, queries *mock.MockQueries, queue *mock.MockQueue) (f fields, a args, w want) {
+				return fields{
+						queries: queries,
+						queue:   queue,
+						es: eventstore.NewEventstore(&eventstore.Config{
+							Querier: es_repo_mock.NewRepo(t).MockQuerier,
+						}),
+					}, args{
+						event: &user.HumanRecoveryCodeCheckSucceededEvent{
+							BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
+								InstanceID:    instanceID,
+								AggregateID:   userID,
+								ResourceOwner: sql.NullString{String: orgID},
+								CreationDate:  time.Now().UTC(),
+								Typ:           user.HumanRecoveryCodeCheckSucceededType,
+							}),
+							RemainingCodes:    domain.RecoveryCodesLowThreshold + 1,
+							TriggeredAtOrigin: eventOrigin,
+						},
+					}, w
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			queries := mock.NewMockQueries(ctrl)
+			queue := mock.NewMockQueue(ctrl)
+			f, a, w := tt.test(ctrl, queries, queue)
+			stmt, err := newUserNotifier(t, ctrl, queries, f).reduceRecoveryCodeCheckSucceeded(a.event)
+			if w.err != nil {
+				w.err(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			if stmt.Execute == nil {
+				return
+			}
+			err = stmt.Execute(nil, "")
+			if w.err != nil {
+				w.err(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_userNotifier_reduceUserGrantExpiryReminded(t *testing.T) {
+	validUntil := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name string
+		test func(*gomock.Controller, *mock.MockQueries, *mock.MockQueue) (fields, args, want)
+	}{
+		{
+			name: "user of other org",
+			test: func(ctrl *gomock.Controller, queries *mock.MockQueries, queue *mock.MockQueue) (f fields, a args, w want) {
+				queries.EXPECT().GetNotifyUserByID(gomock.Any(), true, userID).Return(&query.NotifyUser{
+					ID:                 userID,
+					ResourceOwner:      "userOrg",
+					LastEmail:          lastEmail,
+					VerifiedEmail:      verifiedEmail,
+					PreferredLoginName: preferredLoginName,
+				}, nil)
+				queue.EXPECT().Insert(
+					gomock.Any(),
+					&notification.Request{
+						Aggregate: &eventstore.Aggregate{
+							ID:            "grant1",
+							InstanceID:    instanceID,
+							ResourceOwner: orgID,
+						},
+						UserID:                        userID,
+						UserResourceOwner:             "userOrg",
+						TriggeredAtOrigin:             eventOrigin,
+						URLTemplate:                   fmt.Sprintf("%s/ui/console?login_hint={{.PreferredLoginName}}", eventOrigin),
+						EventType:                     usergrant.UserGrantExpiryRemindedType,
+						NotificationType:              domain.NotificationTypeEmail,
+						MessageType:                   domain.UserGrantExpiringMessageType,
+						UnverifiedNotificationChannel: true,
+						Args: &domain.NotificationArguments{
+							ProjectName: "project",
+							ValidUntil:  validUntil,
+						},
+					},
+					gomock.Any(),
+					gomock.Any(),
+				).Return(nil)
+				return fields{
+						queries: queries,
+						queue:   queue,
+						es: eventstore.NewEventstore(&eventstore.Config{
+							Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+						}),
+					}, args{
+						event: &usergrant.UserGrantExpiryRemindedEvent{
+							BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
+								InstanceID:    instanceID,
+								AggregateID:   "grant1",
+								ResourceOwner: sql.NullString{String: orgID},
+								CreationDate:  time.Now().UTC(),
+								Typ:           usergrant.UserGrantExpiryRemindedType,
+							}),
+							UserID:            userID,
+							ProjectName:       "project",
+							ValidUntil:        validUntil,
+							TriggeredAtOrigin: eventOrigin,
+						},
+					}, w
+			},
+		},
+		{
+			name: "already sent",
+			test: func(ctrl *gomock.Controller, queries *mock.MockQueries, queue *mock.MockQueue) (f fields, a args, w want) {
+				return fields{
+						queries: queries,
+						queue:   queue,
+						es: eventstore.NewEventstore(&eventstore.Config{
+							Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents(
+								eventstore.NewBaseEventForPush(
+									context.Background(),
+									&usergrant.NewAggregate("grant1", orgID).Aggregate,
+									usergrant.UserGrantExpiryReminderSentType,
+								),
+							).MockQuerier,
+						}),
+					}, args{
+						event: &usergrant.UserGrantExpiryRemindedEvent{
+							BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
+								InstanceID:    instanceID,
+								AggregateID:   "grant1",
+								ResourceOwner: sql.NullString{String: orgID},
+								CreationDate:  time.Now().UTC(),
+								Typ:           usergrant.UserGrantExpiryRemindedType,
+							}),
+							UserID:            userID,
+							ProjectName:       "project",
+							ValidUntil:        validUntil,
+							TriggeredAtOrigin: eventOrigin,
+						},
+					}, w
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			queries := mock.NewMockQueries(ctrl)
+			queue := mock.NewMockQueue(ctrl)
+			f, a, w := tt.test(ctrl, queries, queue)
+			stmt, err := newUserNotifier(t, ctrl, queries, f).reduceUserGrantExpiryReminded(a.event)
+			if w.err != nil {
+				w.err(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			err = stmt.Execute(nil, "")
+			if w.err != nil {
+				w.err(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_userNotifier_reduceRefreshTokenReused(t *testing.T) {
+	tests := []struct {
+		name string
+		test func(*gomock.Controller, *mock.MockQueries, *mock.MockQueue) (fields, args, want)
+	}{
+		{
+			name: "notification disabled",
+			test: func(ctrl *gomock.Controller, queries *mock.MockQueries, queue *mock.MockQueue) (f fields, a args, w want) {
+				w.noOperation = true
+				return fields{
+						queries: queries,
+						queue:   queue,
+						es: eventstore.NewEventstore(&eventstore.Config{
+							Querier: es_repo_mock.NewRepo(t).MockQuerier,
+						}),
+					}, args{
+						event: &oidcsession.RefreshTokenReusedEvent{
+							BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
+								InstanceID:    instanceID,
+								AggregateID:   "V2_oidcSessionID",
+								ResourceOwner: sql.NullString{String: orgID},
+								CreationDate:  time.Now().UTC(),
+								Typ:           oidcsession.RefreshTokenReusedType,
+							}),
+							ID:                "refreshTokenID",
+							ClientID:          "clientID",
+							UserID:            userID,
+							NotifyUser:        false,
+							TriggeredAtOrigin: eventOrigin,
+						},
+					}, w
+			},
+		},
+		{
+			name: "notification sent",
+			test: func(ctrl *gomock.Controller, queries *mock.MockQueries, queue *mock.MockQueue) (f fields, a args, w want) {
+				queue.EXPECT().Insert(
+					gomock.Any(),
+					&notification.Request{
+						Aggregate: &eventstore.Aggregate{
+							ID:            "V2_oidcSessionID",
+							InstanceID:    instanceID,
+							ResourceOwner: orgID,
+						},
+						UserID:                        userID,
+						UserResourceOwner:             orgID,
+						TriggeredAtOrigin:             eventOrigin,
+						URLTemplate:                   fmt.Sprintf("%s/ui/console?login_hint={{.PreferredLoginName}}", eventOrigin),
+						EventType:                     oidcsession.RefreshTokenReusedType,
+						NotificationType:              domain.NotificationTypeEmail,
+						MessageType:                   domain.RefreshTokenReusedMessageType,
+						UnverifiedNotificationChannel: true,
+					},
+					gomock.Any(),
+					gomock.Any(),
+				).Return(nil)
+				return fields{
+						queries: queries,
+						queue:   queue,
+						es: eventstore.NewEventstore(&eventstore.Config{
+							Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+						}),
+					}, args{
+						event: &oidcsession.RefreshTokenReusedEvent{
+							BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
+								InstanceID:    instanceID,
+								AggregateID:   "V2_oidcSessionID",
+								ResourceOwner: sql.NullString{String: orgID},
+								CreationDate:  time.Now().UTC(),
+								Typ:           oidcsession.RefreshTokenReusedType,
+							}),
+							ID:                "refreshTokenID",
+							ClientID:          "clientID",
+							UserID:            userID,
+							NotifyUser:        true,
+							TriggeredAtOrigin: eventOrigin,
+						},
+					}, w
+			},
+		},
+		{
+			name: "already sent",
+			test: func(ctrl *gomock.Controller, queries *mock.MockQueries, queue *mock.MockQueue) (f fields, a args, w want) {
+				return fields{
+						queries: queries,
+						queue:   queue,
+						es: eventstore.NewEventstore(&eventstore.Config{
+							Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents(
+								eventstore.NewBaseEventForPush(
+									context.Background(),
+									&oidcsession.NewAggregate("V2_oidcSessionID", orgID).Aggregate,
+									oidcsession.RefreshTokenReuseNotificationSentType,
+								),
+							).MockQuerier,
+						}),
+					}, args{
+						event: &oidcsession.RefreshTokenReusedEvent{
+							BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
+								InstanceID:    instanceID,
+								AggregateID:   "V2_oidcSessionID",
+								ResourceOwner: sql.NullString{String: orgID},
+								CreationDate:  time.Now().UTC(),
+								Typ:           oidcsession.RefreshTokenReusedType,
+							}),
+							ID:                "refreshTokenID",
+							ClientID:          "clientID",
+							UserID:            userID,
+							NotifyUser:        true,
+							TriggeredAtOrigin: eventOrigin,
+						},
+					}, w
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			queries := mock.NewMockQueries(ctrl)
+			queue := mock.NewMockQueue(ctrl)
+			f, a, w := tt.test(ctrl, queries, queue)
+			stmt, err := newUserNotifier(t, ctrl, queries, f).reduceRefreshTokenReused(a.event)
+			if w.err != nil {
+				w.err(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			if w.noOperation {
+				assert.Nil(t, stmt.Execute)
+				return
+			}
+			err = stmt.Execute(nil, "")
+			if w.err != nil {
+				w.err(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_userNotifier_reduceAccessRequestAdded(t *testing.T) {
+	tests := []struct {
+		name string
+		test func(*gomock.Controller, *mock.MockQueries, *mock.MockQueue) (fields, args, want)
+	}{
+		{
+			name: "approvers notified",
+			test: func(ctrl *gomock.Controller, queries *mock.MockQueries, queue *mock.MockQueue) (f fields, a args, w want) {
+				queries.EXPECT().ProjectMembers(gomock.Any(), &query.ProjectMembersQuery{ProjectID: "project1"}).Return(&query.Members{
+					Members: []*query.Member{
+						{UserID: "approver1", UserResourceOwner: orgID, Roles: database.TextArray[string]{domain.RoleProjectOwner}},
+						{UserID: "viewer1", UserResourceOwner: orgID, Roles: database.TextArray[string]{"PROJECT_OWNER_VIEWER"}},
+						{UserID: userID, UserResourceOwner: orgID, Roles: database.TextArray[string]{domain.RoleProjectAccessApprover}},
+					},
+				}, nil)
+				queries.EXPECT().GetNotifyUserByID(gomock.Any(), true, userID).Return(&query.NotifyUser{
+					ID:            userID,
+					ResourceOwner: orgID,
+					DisplayName:   "Requester",
+				}, nil)
+				queries.EXPECT().ProjectByID(gomock.Any(), false, "project1").Return(&query.Project{
+					ID:   "project1",
+					Name: "project",
+				}, nil)
+				queue.EXPECT().Insert(
+					gomock.Any(),
+					&notification.Request{
+						Aggregate: &eventstore.Aggregate{
+							ID:            "request1",
+							InstanceID:    instanceID,
+							ResourceOwner: orgID,
+						},
+						UserID:                        "approver1",
+						UserResourceOwner:             orgID,
+						TriggeredAtOrigin:             eventOrigin,
+						URLTemplate:                   fmt.Sprintf("%s/ui/console?login_hint={{.PreferredLoginName}}", eventOrigin),
+						EventType:                     accessrequest.AddedType,
+						NotificationType:              domain.NotificationTypeEmail,
+						MessageType:                   domain.AccessRequestedMessageType,
+						UnverifiedNotificationChannel: true,
+						Args: &domain.NotificationArguments{
+							RequesterName: "Requester",
+							ProjectName:   "project",
+							Roles:         "role1, role2",
+							Reason:        "reason",
+						},
+					},
+					gomock.Any(),
+					gomock.Any(),
+				).Return(nil)
+				return fields{
+						queries: queries,
+						queue:   queue,
+						es: eventstore.NewEventstore(&eventstore.Config{
+							Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+						}),
+					}, args{
+						event: &accessrequest.AddedEvent{
+							BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
+								InstanceID:    instanceID,
+								AggregateID:   "request1",
+								ResourceOwner: sql.NullString{String: orgID},
+								CreationDate:  time.Now().UTC(),
+								Typ:           accessrequest.AddedType,
+							}),
+							UserID:            userID,
+							ProjectID:         "project1",
+							RoleKeys:          []string{"role1", "role2"},
+							Reason:            "reason",
+							TriggeredAtOrigin: eventOrigin,
+						},
+					}, w
+			},
+		},
+		{
+			name: "already notified",
+			test: func(ctrl *gomock.Controller, queries *mock.MockQueries, queue *mock.MockQueue) (f fields, a args, w want) {
+				return fields{
+						queries: queries,
+						queue:   queue,
+						es: eventstore.NewEventstore(&eventstore.Config{
+							Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents(
+								eventstore.NewBaseEventForPush(
+									context.Background(),
+									&accessrequest.NewAggregate("request1", orgID).Aggregate,
+									accessrequest.ApproversNotifiedType,
+								),
+							).MockQuerier,
+						}),
+					}, args{
+						event: &accessrequest.AddedEvent{
+							BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
+								InstanceID:    instanceID,
+								AggregateID:   "request1",
+								ResourceOwner: sql.NullString{String: orgID},
+								CreationDate:  time.Now().UTC(),
+								Typ:           accessrequest.AddedType,
+							}),
+							UserID:            userID,
+							ProjectID:         "project1",
+							RoleKeys:          []string{"role1"},
+							TriggeredAtOrigin: eventOrigin,
+						},
+					}, w
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			queries := mock.NewMockQueries(ctrl)
+			queue := mock.NewMockQueue(ctrl)
+			f, a, w := tt.test(ctrl, queries, queue)
+			stmt, err := newUserNotifier(t, ctrl, queries, f).reduceAccessRequestAdded(a.event)
+			if w.err != nil {
+				w.err(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			err = stmt.Execute(nil, "")
+			if w.err != nil {
+				w.err(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
 func Test_userNotifier_reduceOTPEmailChallenged(t *testing.T) {
 	tests := []struct {
 		name string
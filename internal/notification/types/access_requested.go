@@ -0,0 +1,20 @@
+package types
+
+import (
+	"context"
+
+	http_utils "github.com/zitadel/zitadel/internal/api/http"
+	"github.com/zitadel/zitadel/internal/api/ui/console"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+)
+
+func (notify Notify) SendAccessRequested(ctx context.Context, approver *query.NotifyUser, requesterName, projectName, roles, reason string) error {
+	url := console.LoginHintLink(http_utils.DomainContext(ctx).Origin(), approver.PreferredLoginName)
+	args := make(map[string]interface{})
+	args["RequesterName"] = requesterName
+	args["ProjectName"] = projectName
+	args["Roles"] = roles
+	args["Reason"] = reason
+	return notify(url, args, domain.AccessRequestedMessageType, true)
+}
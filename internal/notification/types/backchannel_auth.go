@@ -0,0 +1,23 @@
+package types
+
+import (
+	"context"
+	"time"
+
+	http_utils "github.com/zitadel/zitadel/internal/api/http"
+	"github.com/zitadel/zitadel/internal/domain"
+)
+
+// SendBackChannelAuthRequest asks the user to approve or deny the sign-in
+// requested by a client through the backchannel authentication endpoint (CIBA).
+func (notify Notify) SendBackChannelAuthRequest(ctx context.Context, url, applicationName, bindingMessage string, expiry time.Duration) error {
+	domainCtx := http_utils.DomainContext(ctx)
+	args := make(map[string]interface{})
+	args["URL"] = url
+	args["ApplicationName"] = applicationName
+	args["BindingMessage"] = bindingMessage
+	args["Origin"] = domainCtx.Origin()
+	args["Domain"] = domainCtx.RequestedDomain()
+	args["Expiry"] = expiry
+	return notify(url, args, domain.BackChannelAuthMessageType, false)
+}
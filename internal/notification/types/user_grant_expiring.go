@@ -0,0 +1,19 @@
+package types
+
+import (
+	"context"
+	"time"
+
+	http_utils "github.com/zitadel/zitadel/internal/api/http"
+	"github.com/zitadel/zitadel/internal/api/ui/console"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+)
+
+func (notify Notify) SendUserGrantExpiring(ctx context.Context, user *query.NotifyUser, projectName string, validUntil time.Time) error {
+	url := console.LoginHintLink(http_utils.DomainContext(ctx).Origin(), user.PreferredLoginName)
+	args := make(map[string]interface{})
+	args["ProjectName"] = projectName
+	args["ValidUntil"] = validUntil
+	return notify(url, args, domain.UserGrantExpiringMessageType, true)
+}
@@ -0,0 +1,148 @@
+package provisioning
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+)
+
+const (
+	contentTypeSCIM  = "application/scim+json"
+	schemaUser       = "urn:ietf:params:scim:schemas:core:2.0:User"
+	maxErrorBodySize = 1 << 10
+)
+
+// User is the SCIM 2.0 representation of a provisioned user (RFC 7643, section 4.1).
+// The ZITADEL user ID is used as externalId to correlate the users.
+type User struct {
+	Schemas           []string      `json:"schemas"`
+	ID                string        `json:"id,omitempty"`
+	ExternalID        string        `json:"externalId"`
+	UserName          string        `json:"userName"`
+	Name              *Name         `json:"name,omitempty"`
+	DisplayName       string        `json:"displayName,omitempty"`
+	NickName          string        `json:"nickName,omitempty"`
+	PreferredLanguage string        `json:"preferredLanguage,omitempty"`
+	Active            bool          `json:"active"`
+	Emails            []*MultiValue `json:"emails,omitempty"`
+	PhoneNumbers      []*MultiValue `json:"phoneNumbers,omitempty"`
+	Roles             []*MultiValue `json:"roles,omitempty"`
+}
+
+type Name struct {
+	Formatted  string `json:"formatted,omitempty"`
+	GivenName  string `json:"givenName,omitempty"`
+	FamilyName string `json:"familyName,omitempty"`
+}
+
+type MultiValue struct {
+	Value   string `json:"value"`
+	Primary bool   `json:"primary,omitempty"`
+}
+
+type listResponse struct {
+	TotalResults int     `json:"totalResults"`
+	Resources    []*User `json:"Resources"`
+}
+
+// StatusError is returned if the SCIM service provider responds with an unexpected status code.
+type StatusError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("scim service provider responded with status %d: %s", e.StatusCode, e.Body)
+}
+
+// Retryable reports whether the request might succeed if it is sent again later.
+func (e *StatusError) Retryable() bool {
+	return e.StatusCode == http.StatusTooManyRequests || e.StatusCode >= http.StatusInternalServerError
+}
+
+// Client calls the /Users endpoints of a SCIM 2.0 service provider.
+type Client struct {
+	httpClient *http.Client
+	endpoint   string
+	token      string
+}
+
+func NewClient(httpClient *http.Client, endpoint, token string) *Client {
+	return &Client{
+		httpClient: httpClient,
+		endpoint:   strings.TrimSuffix(endpoint, "/"),
+		token:      token,
+	}
+}
+
+// FindUser returns the id of the user with the given externalId in the service provider.
+// An empty id is returned if the user is not provisioned yet.
+// Only a user returned with the same externalId is matched, so a service provider ignoring the filter
+// can never lead to another user being replaced or deleted.
+func (c *Client) FindUser(ctx context.Context, externalID string) (string, error) {
+	filter := url.Values{"filter": {fmt.Sprintf("externalId eq %q", externalID)}}
+	resp := new(listResponse)
+	if err := c.do(ctx, http.MethodGet, "/Users?"+filter.Encode(), nil, resp, http.StatusOK); err != nil {
+		return "", err
+	}
+	for _, user := range resp.Resources {
+		if user.ExternalID == externalID {
+			return user.ID, nil
+		}
+	}
+	return "", nil
+}
+
+func (c *Client) CreateUser(ctx context.Context, user *User) error {
+	return c.do(ctx, http.MethodPost, "/Users", user, nil, http.StatusCreated, http.StatusOK)
+}
+
+func (c *Client) ReplaceUser(ctx context.Context, id string, user *User) error {
+	user.ID = id
+	return c.do(ctx, http.MethodPut, "/Users/"+url.PathEscape(id), user, nil, http.StatusOK, http.StatusNoContent)
+}
+
+// DeleteUser removes the user from the service provider, a user which is already gone is not an error.
+func (c *Client) DeleteUser(ctx context.Context, id string) error {
+	return c.do(ctx, http.MethodDelete, "/Users/"+url.PathEscape(id), nil, nil, http.StatusNoContent, http.StatusOK, http.StatusNotFound)
+}
+
+func (c *Client) do(ctx context.Context, method, path string, body, result any, expectedStatus ...int) error {
+	var reqBody io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reqBody = bytes.NewReader(data)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, c.endpoint+path, reqBody)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+c.token)
+	req.Header.Set("Accept", contentTypeSCIM)
+	if body != nil {
+		req.Header.Set("Content-Type", contentTypeSCIM)
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if !slices.Contains(expectedStatus, resp.StatusCode) {
+		errBody, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+		return &StatusError{StatusCode: resp.StatusCode, Body: string(errBody)}
+	}
+	if result == nil {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(result)
+}
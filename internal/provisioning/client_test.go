@@ -0,0 +1,50 @@
+package provisioning
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_FindUser(t *testing.T) {
+	tests := []struct {
+		name      string
+		resources []*User
+		want      string
+	}{
+		{
+			name:      "not found",
+			resources: []*User{},
+		},
+		{
+			name:      "matching externalId",
+			resources: []*User{{ID: "remote2", ExternalID: "user2"}, {ID: "remote1", ExternalID: "user1"}},
+			want:      "remote1",
+		},
+		{
+			name:      "filter ignored, other user",
+			resources: []*User{{ID: "remote2", ExternalID: "user2"}},
+		},
+		{
+			name:      "filter ignored, user without externalId",
+			resources: []*User{{ID: "remote2"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				require.NoError(t, json.NewEncoder(w).Encode(&listResponse{TotalResults: len(tt.resources), Resources: tt.resources}))
+			}))
+			t.Cleanup(server.Close)
+
+			got, err := NewClient(server.Client(), server.URL, "token").FindUser(context.Background(), "user1")
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
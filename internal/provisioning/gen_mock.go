@@ -0,0 +1,5 @@
+package provisioning
+
+//go:generate mockgen -package mock -destination ./mock/queries.mock.go github.com/zitadel/zitadel/internal/provisioning Queries
+//go:generate mockgen -package mock -destination ./mock/queue.mock.go github.com/zitadel/zitadel/internal/provisioning Queue
+//go:generate mockgen -package mock -destination ./mock/eventstore.mock.go github.com/zitadel/zitadel/internal/provisioning EventStore
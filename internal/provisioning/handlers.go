@@ -0,0 +1,338 @@
+package provisioning
+
+import (
+	"context"
+	"slices"
+
+	"github.com/riverqueue/river"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/queue"
+	"github.com/zitadel/zitadel/internal/repository/scimconnector"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/repository/usergrant"
+)
+
+const (
+	HandlerTable = "projections.scim_provisioning_handler"
+
+	ProvisioningUserID = "SCIM-PROVISIONING"
+)
+
+type Queue interface {
+	Insert(ctx context.Context, args river.JobArgs, opts ...queue.InsertOpt) error
+}
+
+type Queries interface {
+	SearchSCIMConnectors(ctx context.Context, queries *query.SCIMConnectorSearchQueries) (*query.SCIMConnectors, error)
+	GetSCIMConnectorWithTokenByID(ctx context.Context, shouldTriggerBulk bool, id string) (*query.SCIMConnector, error)
+	GetUserByID(ctx context.Context, shouldTriggerBulk bool, userID string) (*query.User, error)
+	UserGrants(ctx context.Context, queries *query.UserGrantsQueries, shouldTriggerBulk bool) (*query.UserGrants, error)
+}
+
+type EventStore interface {
+	FilterToQueryReducer(ctx context.Context, reducer eventstore.QueryReducer) error
+}
+
+// userEventTypes are the events of a user which change the provisioned representation of the user.
+var userEventTypes = []eventstore.EventType{
+	user.UserUserNameChangedType,
+	user.HumanProfileChangedType,
+	user.HumanEmailChangedType,
+	user.HumanPhoneChangedType,
+	user.HumanPhoneRemovedType,
+	user.MachineChangedEventType,
+	user.UserLockedType,
+	user.UserUnlockedType,
+	user.UserDeactivatedType,
+	user.UserReactivatedType,
+	user.UserRemovedType,
+}
+
+// userGrantEventTypes are the events of a user grant which change whether or with which roles a user is provisioned.
+var userGrantEventTypes = []eventstore.EventType{
+	usergrant.UserGrantAddedType,
+	usergrant.UserGrantChangedType,
+	usergrant.UserGrantCascadeChangedType,
+	usergrant.UserGrantDeactivatedType,
+	usergrant.UserGrantReactivatedType,
+	usergrant.UserGrantValidityChangedType,
+	usergrant.UserGrantExpiredType,
+	usergrant.UserGrantRemovedType,
+	usergrant.UserGrantCascadeRemovedType,
+}
+
+type eventHandler struct {
+	queries     Queries
+	eventstore  EventStore
+	queue       Queue
+	maxAttempts uint8
+}
+
+func NewEventHandler(
+	ctx context.Context,
+	config handler.Config,
+	queries Queries,
+	es EventStore,
+	queue Queue,
+	maxAttempts uint8,
+) *handler.Handler {
+	return handler.NewHandler(ctx, &config, &eventHandler{
+		queries:     queries,
+		eventstore:  es,
+		queue:       queue,
+		maxAttempts: maxAttempts,
+	})
+}
+
+func (*eventHandler) Name() string {
+	return HandlerTable
+}
+
+func (h *eventHandler) Reducers() []handler.AggregateReducer {
+	userReducers := make([]handler.EventReducer, len(userEventTypes))
+	for i, eventType := range userEventTypes {
+		userReducers[i] = handler.EventReducer{
+			Event:  eventType,
+			Reduce: h.reduceUserChanged,
+		}
+	}
+	userGrantReducers := make([]handler.EventReducer, len(userGrantEventTypes))
+	for i, eventType := range userGrantEventTypes {
+		userGrantReducers[i] = handler.EventReducer{
+			Event:  eventType,
+			Reduce: h.reduceUserGrantChanged,
+		}
+	}
+	return []handler.AggregateReducer{
+		{
+			Aggregate: scimconnector.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  scimconnector.AddedEventType,
+					Reduce: h.reduceConnectorChanged,
+				},
+				{
+					Event:  scimconnector.ChangedEventType,
+					Reduce: h.reduceConnectorChanged,
+				},
+				{
+					Event:  scimconnector.ReactivatedEventType,
+					Reduce: h.reduceConnectorChanged,
+				},
+			},
+		},
+		{
+			Aggregate:     user.AggregateType,
+			EventReducers: userReducers,
+		},
+		{
+			Aggregate:     usergrant.AggregateType,
+			EventReducers: userGrantReducers,
+		},
+	}
+}
+
+func HandlerContext(aggregate *eventstore.Aggregate) context.Context {
+	return ContextWithProvisioner(context.Background(), aggregate)
+}
+
+func ContextWithProvisioner(ctx context.Context, aggregate *eventstore.Aggregate) context.Context {
+	ctx = authz.WithInstanceID(ctx, aggregate.InstanceID)
+	return authz.SetCtxData(ctx, authz.CtxData{UserID: ProvisioningUserID, OrgID: aggregate.ResourceOwner})
+}
+
+// reduceConnectorChanged reconciles all users granted on the project of the connector,
+// so that existing grants are provisioned when a connector is added, reactivated or pointed to another endpoint.
+func (h *eventHandler) reduceConnectorChanged(e eventstore.Event) (*handler.Statement, error) {
+	ctx := HandlerContext(e.Aggregate())
+	connector := newSCIMConnectorWriteModel(e.Aggregate().ID)
+	if err := h.eventstore.FilterToQueryReducer(ctx, connector); err != nil {
+		return nil, err
+	}
+	if connector.ProjectID == "" {
+		return handler.NewNoOpStatement(e), nil
+	}
+	projectIDQuery, err := query.NewUserGrantProjectIDSearchQuery(connector.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+	grants, err := h.queries.UserGrants(ctx, &query.UserGrantsQueries{Queries: []query.SearchQuery{projectIDQuery}}, false)
+	if err != nil {
+		return nil, err
+	}
+	userIDs := make([]string, 0, len(grants.UserGrants))
+	for _, grant := range grants.UserGrants {
+		if !slices.Contains(userIDs, grant.UserID) {
+			userIDs = append(userIDs, grant.UserID)
+		}
+	}
+	if len(userIDs) == 0 {
+		return handler.NewNoOpStatement(e), nil
+	}
+	return handler.NewStatement(e, func(ex handler.Executer, projectionName string) error {
+		ctx := HandlerContext(e.Aggregate())
+		for _, userID := range userIDs {
+			if err := h.insert(ctx, e, connector.AggregateID, userID); err != nil {
+				return err
+			}
+		}
+		return nil
+	}), nil
+}
+
+// reduceUserChanged reconciles the user in all connectors of the projects the user is granted on.
+func (h *eventHandler) reduceUserChanged(e eventstore.Event) (*handler.Statement, error) {
+	ctx := HandlerContext(e.Aggregate())
+	userIDQuery, err := query.NewUserGrantUserIDSearchQuery(e.Aggregate().ID)
+	if err != nil {
+		return nil, err
+	}
+	grants, err := h.queries.UserGrants(ctx, &query.UserGrantsQueries{Queries: []query.SearchQuery{userIDQuery}}, false)
+	if err != nil {
+		return nil, err
+	}
+	projectIDs := make([]string, 0, len(grants.UserGrants))
+	for _, grant := range grants.UserGrants {
+		projectIDs = append(projectIDs, grant.ProjectID)
+	}
+	return h.reconcileStatement(ctx, e, e.Aggregate().ID, projectIDs)
+}
+
+// reduceUserGrantChanged reconciles the user of the grant in all connectors of the granted project.
+// Not every user grant event contains the user and project, so they are taken from the added event.
+func (h *eventHandler) reduceUserGrantChanged(e eventstore.Event) (*handler.Statement, error) {
+	ctx := HandlerContext(e.Aggregate())
+	grant := newUserGrantWriteModel(e.Aggregate().ID)
+	if err := h.eventstore.FilterToQueryReducer(ctx, grant); err != nil {
+		return nil, err
+	}
+	if grant.UserID == "" || grant.ProjectID == "" {
+		return handler.NewNoOpStatement(e), nil
+	}
+	return h.reconcileStatement(ctx, e, grant.UserID, []string{grant.ProjectID})
+}
+
+func (h *eventHandler) reconcileStatement(ctx context.Context, e eventstore.Event, userID string, projectIDs []string) (*handler.Statement, error) {
+	if len(projectIDs) == 0 {
+		return handler.NewNoOpStatement(e), nil
+	}
+	connectors, err := h.activeConnectors(ctx, projectIDs)
+	if err != nil {
+		return nil, err
+	}
+	// no provisioning from worker necessary
+	if len(connectors) == 0 {
+		return handler.NewNoOpStatement(e), nil
+	}
+	return handler.NewStatement(e, func(ex handler.Executer, projectionName string) error {
+		ctx := HandlerContext(e.Aggregate())
+		for _, connector := range connectors {
+			if err := h.insert(ctx, e, connector.ID, userID); err != nil {
+				return err
+			}
+		}
+		return nil
+	}), nil
+}
+
+func (h *eventHandler) insert(ctx context.Context, e eventstore.Event, connectorID, userID string) error {
+	return h.queue.Insert(ctx,
+		&scimconnector.Request{
+			Aggregate:   e.Aggregate(),
+			ConnectorID: connectorID,
+			UserID:      userID,
+			EventType:   e.Type(),
+		},
+		queue.WithQueueName(scimconnector.QueueName),
+		queue.WithMaxAttempts(h.maxAttempts),
+	)
+}
+
+func (h *eventHandler) activeConnectors(ctx context.Context, projectIDs []string) ([]*query.SCIMConnector, error) {
+	projectIDsQuery, err := query.NewSCIMConnectorInProjectIDsSearchQuery(projectIDs)
+	if err != nil {
+		return nil, err
+	}
+	stateQuery, err := query.NewSCIMConnectorStateSearchQuery(domain.SCIMConnectorStateActive)
+	if err != nil {
+		return nil, err
+	}
+	connectors, err := h.queries.SearchSCIMConnectors(ctx, &query.SCIMConnectorSearchQueries{
+		Queries: []query.SearchQuery{projectIDsQuery, stateQuery},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return connectors.SCIMConnectors, nil
+}
+
+type userGrantWriteModel struct {
+	eventstore.WriteModel
+
+	UserID    string
+	ProjectID string
+}
+
+func newUserGrantWriteModel(id string) *userGrantWriteModel {
+	return &userGrantWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID: id,
+		},
+	}
+}
+
+func (wm *userGrantWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		if e, ok := event.(*usergrant.UserGrantAddedEvent); ok {
+			wm.UserID = e.UserID
+			wm.ProjectID = e.ProjectID
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *userGrantWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(usergrant.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(usergrant.UserGrantAddedType).
+		Builder()
+}
+
+type scimConnectorWriteModel struct {
+	eventstore.WriteModel
+
+	ProjectID string
+}
+
+func newSCIMConnectorWriteModel(id string) *scimConnectorWriteModel {
+	return &scimConnectorWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID: id,
+		},
+	}
+}
+
+func (wm *scimConnectorWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		if e, ok := event.(*scimconnector.AddedEvent); ok {
+			wm.ProjectID = e.ProjectID
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *scimConnectorWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(scimconnector.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(scimconnector.AddedEventType).
+		Builder()
+}
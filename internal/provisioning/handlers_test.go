@@ -0,0 +1,155 @@
+package provisioning
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/provisioning/mock"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/repository/scimconnector"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/repository/usergrant"
+)
+
+func expectFilter(es *mock.MockEventStore, events ...eventstore.Event) {
+	es.EXPECT().FilterToQueryReducer(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, reducer eventstore.QueryReducer) error {
+			reducer.AppendEvents(events...)
+			return reducer.Reduce()
+		},
+	)
+}
+
+func expectInsert(q *mock.MockQueue, connectorID, userID string, eventType eventstore.EventType) {
+	q.EXPECT().Insert(
+		gomock.Any(),
+		gomock.Cond(func(args any) bool {
+			req, ok := args.(*scimconnector.Request)
+			return ok && req.ConnectorID == connectorID && req.UserID == userID && req.EventType == eventType
+		}),
+		gomock.Any(),
+		gomock.Any(),
+	).Return(nil)
+}
+
+func Test_eventHandler_reduce(t *testing.T) {
+	ctx := context.Background()
+	grantAgg := &usergrant.NewAggregate("grant1", "org1").Aggregate
+	grantAdded := usergrant.NewUserGrantAddedEvent(ctx, grantAgg, "user1", "project1", "", []string{"role1"})
+	connectors := &query.SCIMConnectors{
+		SCIMConnectors: []*query.SCIMConnector{
+			{ObjectDetails: domain.ObjectDetails{ID: "connector1"}, ProjectID: "project1"},
+		},
+	}
+
+	type mocks struct {
+		queries *mock.MockQueries
+		es      *mock.MockEventStore
+		queue   *mock.MockQueue
+	}
+	tests := []struct {
+		name        string
+		event       eventstore.Event
+		reduce      func(h *eventHandler) func(eventstore.Event) (*handler.Statement, error)
+		expect      func(m mocks)
+		noOperation bool
+	}{
+		{
+			name:   "user grant deactivated, no connectors",
+			event:  usergrant.NewUserGrantDeactivatedEvent(ctx, grantAgg),
+			reduce: func(h *eventHandler) func(eventstore.Event) (*handler.Statement, error) { return h.reduceUserGrantChanged },
+			expect: func(m mocks) {
+				expectFilter(m.es, grantAdded)
+				m.queries.EXPECT().SearchSCIMConnectors(gomock.Any(), gomock.Any()).Return(&query.SCIMConnectors{}, nil)
+			},
+			noOperation: true,
+		},
+		{
+			name:   "user grant without added event",
+			event:  usergrant.NewUserGrantDeactivatedEvent(ctx, grantAgg),
+			reduce: func(h *eventHandler) func(eventstore.Event) (*handler.Statement, error) { return h.reduceUserGrantChanged },
+			expect: func(m mocks) {
+				expectFilter(m.es)
+			},
+			noOperation: true,
+		},
+		{
+			name:   "user grant deactivated, provisioned",
+			event:  usergrant.NewUserGrantDeactivatedEvent(ctx, grantAgg),
+			reduce: func(h *eventHandler) func(eventstore.Event) (*handler.Statement, error) { return h.reduceUserGrantChanged },
+			expect: func(m mocks) {
+				expectFilter(m.es, grantAdded)
+				m.queries.EXPECT().SearchSCIMConnectors(gomock.Any(), gomock.Any()).Return(connectors, nil)
+				expectInsert(m.queue, "connector1", "user1", usergrant.UserGrantDeactivatedType)
+			},
+		},
+		{
+			name:   "user deactivated, no grants",
+			event:  user.NewUserDeactivatedEvent(ctx, &user.NewAggregate("user1", "org1").Aggregate),
+			reduce: func(h *eventHandler) func(eventstore.Event) (*handler.Statement, error) { return h.reduceUserChanged },
+			expect: func(m mocks) {
+				m.queries.EXPECT().UserGrants(gomock.Any(), gomock.Any(), false).Return(&query.UserGrants{}, nil)
+			},
+			noOperation: true,
+		},
+		{
+			name:   "user deactivated, provisioned",
+			event:  user.NewUserDeactivatedEvent(ctx, &user.NewAggregate("user1", "org1").Aggregate),
+			reduce: func(h *eventHandler) func(eventstore.Event) (*handler.Statement, error) { return h.reduceUserChanged },
+			expect: func(m mocks) {
+				m.queries.EXPECT().UserGrants(gomock.Any(), gomock.Any(), false).Return(&query.UserGrants{
+					UserGrants: []*query.UserGrant{{ID: "grant1", UserID: "user1", ProjectID: "project1"}},
+				}, nil)
+				m.queries.EXPECT().SearchSCIMConnectors(gomock.Any(), gomock.Any()).Return(connectors, nil)
+				expectInsert(m.queue, "connector1", "user1", user.UserDeactivatedType)
+			},
+		},
+		{
+			name:   "connector reactivated, granted users provisioned",
+			event:  scimconnector.NewReactivatedEvent(ctx, scimconnector.NewAggregate("connector1", "org1", "instance1")),
+			reduce: func(h *eventHandler) func(eventstore.Event) (*handler.Statement, error) { return h.reduceConnectorChanged },
+			expect: func(m mocks) {
+				expectFilter(m.es, scimconnector.NewAddedEvent(ctx, scimconnector.NewAggregate("connector1", "org1", "instance1"), "project1", "name", "https://example.com", nil))
+				m.queries.EXPECT().UserGrants(gomock.Any(), gomock.Any(), false).Return(&query.UserGrants{
+					UserGrants: []*query.UserGrant{
+						{ID: "grant1", UserID: "user1", ProjectID: "project1"},
+						{ID: "grant2", UserID: "user2", ProjectID: "project1"},
+						{ID: "grant3", UserID: "user1", ProjectID: "project1", GrantID: "projectgrant1"},
+					},
+				}, nil)
+				expectInsert(m.queue, "connector1", "user1", scimconnector.ReactivatedEventType)
+				expectInsert(m.queue, "connector1", "user2", scimconnector.ReactivatedEventType)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			m := mocks{
+				queries: mock.NewMockQueries(ctrl),
+				es:      mock.NewMockEventStore(ctrl),
+				queue:   mock.NewMockQueue(ctrl),
+			}
+			tt.expect(m)
+			h := &eventHandler{
+				queries:    m.queries,
+				eventstore: m.es,
+				queue:      m.queue,
+			}
+			stmt, err := tt.reduce(h)(tt.event)
+			require.NoError(t, err)
+			if tt.noOperation {
+				assert.Nil(t, stmt.Execute)
+				return
+			}
+			assert.NoError(t, stmt.Execute(nil, ""))
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package provisioning
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/queue"
+)
+
+var (
+	projections []*handler.Handler
+)
+
+func Register(
+	ctx context.Context,
+	provisioningCustomConfig projection.CustomConfig,
+	workerConfig WorkerConfig,
+	queries *query.Queries,
+	es *eventstore.Eventstore,
+	queue *queue.Queue,
+) {
+	queue.ShouldStart()
+	projections = []*handler.Handler{
+		NewEventHandler(ctx, projection.ApplyCustomConfig(provisioningCustomConfig), queries, es, queue, workerConfig.MaxAttempts),
+	}
+	queue.AddWorkers(NewWorker(workerConfig, queries))
+}
+
+func Start(ctx context.Context) {
+	for _, projection := range projections {
+		projection.Start(ctx)
+	}
+}
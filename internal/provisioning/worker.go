@@ -0,0 +1,183 @@
+package provisioning
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/riverqueue/river"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/repository/scimconnector"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type Worker struct {
+	river.WorkerDefaults[*scimconnector.Request]
+
+	config     WorkerConfig
+	queries    Queries
+	httpClient *http.Client
+}
+
+type WorkerConfig struct {
+	Workers             uint8
+	TransactionDuration time.Duration
+	MaxAttempts         uint8
+	// RequestTimeout is the maximum duration of a single request to a SCIM service provider.
+	RequestTimeout time.Duration
+}
+
+func NewWorker(
+	config WorkerConfig,
+	queries Queries,
+) *Worker {
+	return &Worker{
+		config:     config,
+		queries:    queries,
+		httpClient: &http.Client{Timeout: config.RequestTimeout},
+	}
+}
+
+var _ river.Worker[*scimconnector.Request] = (*Worker)(nil)
+
+func (w *Worker) Register(workers *river.Workers, queues map[string]river.QueueConfig) {
+	river.AddWorker(workers, w)
+	queues[scimconnector.QueueName] = river.QueueConfig{
+		MaxWorkers: int(w.config.Workers),
+	}
+}
+
+// Timeout implements the Timeout-function of [river.Worker].
+func (w *Worker) Timeout(*river.Job[*scimconnector.Request]) time.Duration {
+	return w.config.TransactionDuration
+}
+
+// Work implements [river.Worker].
+// Instead of replaying the triggering event, the current state of the user is provisioned,
+// which makes retries and out of order execution of jobs safe.
+func (w *Worker) Work(ctx context.Context, job *river.Job[*scimconnector.Request]) error {
+	ctx = ContextWithProvisioner(ctx, job.Args.Aggregate)
+
+	connector, err := w.queries.GetSCIMConnectorWithTokenByID(ctx, true, job.Args.ConnectorID)
+	if zerrors.IsNotFound(err) {
+		return river.JobCancel(fmt.Errorf("connector %s not found", job.Args.ConnectorID))
+	}
+	if err != nil {
+		return err
+	}
+	// an inactive connector does not provision any changes
+	if connector.State != domain.SCIMConnectorStateActive {
+		return nil
+	}
+	desired, err := w.desiredUser(ctx, connector.ProjectID, job.Args.UserID)
+	if err != nil {
+		return err
+	}
+	return permanentError(w.provision(ctx, NewClient(w.httpClient, connector.Endpoint, connector.Token), job.Args.UserID, desired))
+}
+
+// desiredUser returns the SCIM representation of the user as it should be in the service provider.
+// Nil is returned if the user should not be provisioned, because it does not exist anymore
+// or is not granted on the project.
+func (w *Worker) desiredUser(ctx context.Context, projectID, userID string) (*User, error) {
+	user, err := w.queries.GetUserByID(ctx, true, userID)
+	if zerrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	userIDQuery, err := query.NewUserGrantUserIDSearchQuery(userID)
+	if err != nil {
+		return nil, err
+	}
+	projectIDQuery, err := query.NewUserGrantProjectIDSearchQuery(projectID)
+	if err != nil {
+		return nil, err
+	}
+	grants, err := w.queries.UserGrants(ctx, &query.UserGrantsQueries{Queries: []query.SearchQuery{userIDQuery, projectIDQuery}}, true)
+	if err != nil {
+		return nil, err
+	}
+	if len(grants.UserGrants) == 0 {
+		return nil, nil
+	}
+	return userToSCIM(user, grants.UserGrants), nil
+}
+
+func (w *Worker) provision(ctx context.Context, client *Client, userID string, desired *User) error {
+	id, err := client.FindUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+	switch {
+	case desired == nil && id == "":
+		return nil
+	case desired == nil:
+		return client.DeleteUser(ctx, id)
+	case id == "":
+		return client.CreateUser(ctx, desired)
+	default:
+		return client.ReplaceUser(ctx, id, desired)
+	}
+}
+
+// permanentError cancels the job if the service provider rejected the request,
+// as sending the same request again will not succeed.
+func permanentError(err error) error {
+	var statusErr *StatusError
+	if errors.As(err, &statusErr) && !statusErr.Retryable() {
+		return river.JobCancel(err)
+	}
+	return err
+}
+
+func userToSCIM(user *query.User, grants []*query.UserGrant) *User {
+	scimUser := &User{
+		Schemas:    []string{schemaUser},
+		ExternalID: user.ID,
+		UserName:   user.Username,
+		Active:     user.State == domain.UserStateActive || user.State == domain.UserStateInitial,
+	}
+	if user.Human != nil {
+		scimUser.Name = &Name{
+			Formatted:  user.Human.DisplayName,
+			GivenName:  user.Human.FirstName,
+			FamilyName: user.Human.LastName,
+		}
+		scimUser.DisplayName = user.Human.DisplayName
+		scimUser.NickName = user.Human.NickName
+		if !user.Human.PreferredLanguage.IsRoot() {
+			scimUser.PreferredLanguage = user.Human.PreferredLanguage.String()
+		}
+		if user.Human.Email != "" {
+			scimUser.Emails = []*MultiValue{{Value: string(user.Human.Email), Primary: true}}
+		}
+		if user.Human.Phone != "" {
+			scimUser.PhoneNumbers = []*MultiValue{{Value: string(user.Human.Phone), Primary: true}}
+		}
+	}
+	if user.Machine != nil {
+		scimUser.DisplayName = user.Machine.Name
+	}
+
+	grantActive := false
+	for _, grant := range grants {
+		if grant.State != domain.UserGrantStateActive || !domain.UserGrantValidAt(grant.ValidFrom, grant.ValidUntil, time.Now()) {
+			continue
+		}
+		grantActive = true
+		for _, role := range grant.Roles {
+			if !slices.ContainsFunc(scimUser.Roles, func(v *MultiValue) bool { return v.Value == role }) {
+				scimUser.Roles = append(scimUser.Roles, &MultiValue{Value: role})
+			}
+		}
+	}
+	scimUser.Active = scimUser.Active && grantActive
+	return scimUser
+}
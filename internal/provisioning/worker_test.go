@@ -0,0 +1,275 @@
+package provisioning
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/riverqueue/river"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/provisioning/mock"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/repository/scimconnector"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// scimServer is a minimal in-memory SCIM service provider.
+type scimServer struct {
+	mu     sync.Mutex
+	users  map[string]*User
+	status int
+}
+
+func newSCIMServer(t *testing.T, users ...*User) (*scimServer, *httptest.Server) {
+	s := &scimServer{users: make(map[string]*User)}
+	for _, user := range users {
+		s.users[user.ID] = user
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if s.status != 0 {
+			w.WriteHeader(s.status)
+			return
+		}
+		id := strings.TrimPrefix(r.URL.Path, "/scim/v2/Users/")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/scim/v2/Users":
+			resp := &listResponse{Resources: []*User{}}
+			for _, user := range s.users {
+				if r.URL.Query().Get("filter") == `externalId eq "`+user.ExternalID+`"` {
+					resp.Resources = append(resp.Resources, user)
+				}
+			}
+			resp.TotalResults = len(resp.Resources)
+			require.NoError(t, json.NewEncoder(w).Encode(resp))
+		case r.Method == http.MethodPost && r.URL.Path == "/scim/v2/Users":
+			user := new(User)
+			require.NoError(t, json.NewDecoder(r.Body).Decode(user))
+			user.ID = "scim-" + user.ExternalID
+			s.users[user.ID] = user
+			w.WriteHeader(http.StatusCreated)
+		case r.Method == http.MethodPut:
+			user := new(User)
+			require.NoError(t, json.NewDecoder(r.Body).Decode(user))
+			s.users[id] = user
+		case r.Method == http.MethodDelete:
+			if _, ok := s.users[id]; !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			delete(s.users, id)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotImplemented)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return s, server
+}
+
+func testJob() *river.Job[*scimconnector.Request] {
+	return &river.Job[*scimconnector.Request]{
+		Args: &scimconnector.Request{
+			Aggregate:   &eventstore.Aggregate{ID: "grant1", ResourceOwner: "org1", InstanceID: "instance1"},
+			ConnectorID: "connector1",
+			UserID:      "user1",
+		},
+	}
+}
+
+func testUser(state domain.UserState) *query.User {
+	return &query.User{
+		ID:       "user1",
+		State:    state,
+		Username: "gigi",
+		Human: &query.Human{
+			FirstName:   "Gigi",
+			LastName:    "Giraffe",
+			DisplayName: "Gigi Giraffe",
+			Email:       "gigi@example.com",
+		},
+	}
+}
+
+func TestWorker_Work(t *testing.T) {
+	type want struct {
+		users  map[string]*User
+		cancel bool
+		err    bool
+	}
+	tests := []struct {
+		name     string
+		existing []*User
+		status   int
+		expect   func(q *mock.MockQueries, endpoint string)
+		want     want
+	}{
+		{
+			name: "connector not found, cancel",
+			expect: func(q *mock.MockQueries, endpoint string) {
+				q.EXPECT().GetSCIMConnectorWithTokenByID(gomock.Any(), true, "connector1").Return(nil, zerrors.ThrowNotFound(nil, "QUERY-Eej3a", "Errors.SCIMConnector.NotFound"))
+			},
+			want: want{
+				users:  map[string]*User{},
+				cancel: true,
+			},
+		},
+		{
+			name: "connector inactive, nothing provisioned",
+			expect: func(q *mock.MockQueries, endpoint string) {
+				q.EXPECT().GetSCIMConnectorWithTokenByID(gomock.Any(), true, "connector1").Return(&query.SCIMConnector{
+					State: domain.SCIMConnectorStateInactive, ProjectID: "project1", Endpoint: endpoint, Token: "token",
+				}, nil)
+			},
+			want: want{
+				users: map[string]*User{},
+			},
+		},
+		{
+			name: "granted user, created",
+			expect: func(q *mock.MockQueries, endpoint string) {
+				q.EXPECT().GetSCIMConnectorWithTokenByID(gomock.Any(), true, "connector1").Return(&query.SCIMConnector{
+					State: domain.SCIMConnectorStateActive, ProjectID: "project1", Endpoint: endpoint, Token: "token",
+				}, nil)
+				q.EXPECT().GetUserByID(gomock.Any(), true, "user1").Return(testUser(domain.UserStateActive), nil)
+				q.EXPECT().UserGrants(gomock.Any(), gomock.Any(), true).Return(&query.UserGrants{
+					UserGrants: []*query.UserGrant{{State: domain.UserGrantStateActive, Roles: []string{"role1", "role2"}}},
+				}, nil)
+			},
+			want: want{
+				users: map[string]*User{
+					"scim-user1": {
+						Schemas:     []string{schemaUser},
+						ID:          "scim-user1",
+						ExternalID:  "user1",
+						UserName:    "gigi",
+						Name:        &Name{Formatted: "Gigi Giraffe", GivenName: "Gigi", FamilyName: "Giraffe"},
+						DisplayName: "Gigi Giraffe",
+						Active:      true,
+						Emails:      []*MultiValue{{Value: "gigi@example.com", Primary: true}},
+						Roles:       []*MultiValue{{Value: "role1"}, {Value: "role2"}},
+					},
+				},
+			},
+		},
+		{
+			name:     "deactivated user, replaced inactive",
+			existing: []*User{{ID: "remote1", ExternalID: "user1", UserName: "gigi", Active: true}},
+			expect: func(q *mock.MockQueries, endpoint string) {
+				q.EXPECT().GetSCIMConnectorWithTokenByID(gomock.Any(), true, "connector1").Return(&query.SCIMConnector{
+					State: domain.SCIMConnectorStateActive, ProjectID: "project1", Endpoint: endpoint, Token: "token",
+				}, nil)
+				q.EXPECT().GetUserByID(gomock.Any(), true, "user1").Return(testUser(domain.UserStateInactive), nil)
+				q.EXPECT().UserGrants(gomock.Any(), gomock.Any(), true).Return(&query.UserGrants{
+					UserGrants: []*query.UserGrant{{State: domain.UserGrantStateActive, Roles: []string{"role1"}}},
+				}, nil)
+			},
+			want: want{
+				users: map[string]*User{
+					"remote1": {
+						Schemas:     []string{schemaUser},
+						ID:          "remote1",
+						ExternalID:  "user1",
+						UserName:    "gigi",
+						Name:        &Name{Formatted: "Gigi Giraffe", GivenName: "Gigi", FamilyName: "Giraffe"},
+						DisplayName: "Gigi Giraffe",
+						Active:      false,
+						Emails:      []*MultiValue{{Value: "gigi@example.com", Primary: true}},
+						Roles:       []*MultiValue{{Value: "role1"}},
+					},
+				},
+			},
+		},
+		{
+			name:     "grant removed, deleted",
+			existing: []*User{{ID: "remote1", ExternalID: "user1", UserName: "gigi", Active: true}},
+			expect: func(q *mock.MockQueries, endpoint string) {
+				q.EXPECT().GetSCIMConnectorWithTokenByID(gomock.Any(), true, "connector1").Return(&query.SCIMConnector{
+					State: domain.SCIMConnectorStateActive, ProjectID: "project1", Endpoint: endpoint, Token: "token",
+				}, nil)
+				q.EXPECT().GetUserByID(gomock.Any(), true, "user1").Return(testUser(domain.UserStateActive), nil)
+				q.EXPECT().UserGrants(gomock.Any(), gomock.Any(), true).Return(&query.UserGrants{}, nil)
+			},
+			want: want{
+				users: map[string]*User{},
+			},
+		},
+		{
+			name:     "user removed, deleted",
+			existing: []*User{{ID: "remote1", ExternalID: "user1", UserName: "gigi", Active: true}},
+			expect: func(q *mock.MockQueries, endpoint string) {
+				q.EXPECT().GetSCIMConnectorWithTokenByID(gomock.Any(), true, "connector1").Return(&query.SCIMConnector{
+					State: domain.SCIMConnectorStateActive, ProjectID: "project1", Endpoint: endpoint, Token: "token",
+				}, nil)
+				q.EXPECT().GetUserByID(gomock.Any(), true, "user1").Return(nil, zerrors.ThrowNotFound(nil, "QUERY-Dfbg2", "Errors.User.NotFound"))
+			},
+			want: want{
+				users: map[string]*User{},
+			},
+		},
+		{
+			name:   "service provider unavailable, retry",
+			status: http.StatusServiceUnavailable,
+			expect: func(q *mock.MockQueries, endpoint string) {
+				q.EXPECT().GetSCIMConnectorWithTokenByID(gomock.Any(), true, "connector1").Return(&query.SCIMConnector{
+					State: domain.SCIMConnectorStateActive, ProjectID: "project1", Endpoint: endpoint, Token: "token",
+				}, nil)
+				q.EXPECT().GetUserByID(gomock.Any(), true, "user1").Return(nil, zerrors.ThrowNotFound(nil, "QUERY-Dfbg2", "Errors.User.NotFound"))
+			},
+			want: want{
+				users: map[string]*User{},
+				err:   true,
+			},
+		},
+		{
+			name:   "service provider rejects, cancel",
+			status: http.StatusBadRequest,
+			expect: func(q *mock.MockQueries, endpoint string) {
+				q.EXPECT().GetSCIMConnectorWithTokenByID(gomock.Any(), true, "connector1").Return(&query.SCIMConnector{
+					State: domain.SCIMConnectorStateActive, ProjectID: "project1", Endpoint: endpoint, Token: "token",
+				}, nil)
+				q.EXPECT().GetUserByID(gomock.Any(), true, "user1").Return(nil, zerrors.ThrowNotFound(nil, "QUERY-Dfbg2", "Errors.User.NotFound"))
+			},
+			want: want{
+				users:  map[string]*User{},
+				cancel: true,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scim, server := newSCIMServer(t, tt.existing...)
+			scim.status = tt.status
+			queries := mock.NewMockQueries(gomock.NewController(t))
+			tt.expect(queries, server.URL+"/scim/v2/")
+
+			w := NewWorker(WorkerConfig{}, queries)
+			err := w.Work(context.Background(), testJob())
+			switch {
+			case tt.want.cancel:
+				assert.True(t, errors.Is(err, new(river.JobCancelError)), "expected job cancel, got %v", err)
+			case tt.want.err:
+				assert.Error(t, err)
+				assert.False(t, errors.Is(err, new(river.JobCancelError)))
+			default:
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.want.users, scim.users)
+		})
+	}
+}
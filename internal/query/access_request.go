@@ -0,0 +1,305 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"slices"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	accessRequestTable = table{
+		name:          projection.AccessRequestTable,
+		instanceIDCol: projection.AccessRequestInstanceIDCol,
+	}
+	AccessRequestColumnID = Column{
+		name:  projection.AccessRequestIDCol,
+		table: accessRequestTable,
+	}
+	AccessRequestColumnCreationDate = Column{
+		name:  projection.AccessRequestCreationDateCol,
+		table: accessRequestTable,
+	}
+	AccessRequestColumnChangeDate = Column{
+		name:  projection.AccessRequestChangeDateCol,
+		table: accessRequestTable,
+	}
+	AccessRequestColumnResourceOwner = Column{
+		name:  projection.AccessRequestResourceOwnerCol,
+		table: accessRequestTable,
+	}
+	AccessRequestColumnInstanceID = Column{
+		name:  projection.AccessRequestInstanceIDCol,
+		table: accessRequestTable,
+	}
+	AccessRequestColumnSequence = Column{
+		name:  projection.AccessRequestSequenceCol,
+		table: accessRequestTable,
+	}
+	AccessRequestColumnState = Column{
+		name:  projection.AccessRequestStateCol,
+		table: accessRequestTable,
+	}
+	AccessRequestColumnUserID = Column{
+		name:  projection.AccessRequestUserIDCol,
+		table: accessRequestTable,
+	}
+	AccessRequestColumnProjectID = Column{
+		name:  projection.AccessRequestProjectIDCol,
+		table: accessRequestTable,
+	}
+	AccessRequestColumnProjectGrantID = Column{
+		name:  projection.AccessRequestProjectGrantIDCol,
+		table: accessRequestTable,
+	}
+	AccessRequestColumnRoleKeys = Column{
+		name:  projection.AccessRequestRoleKeysCol,
+		table: accessRequestTable,
+	}
+	AccessRequestColumnReason = Column{
+		name:  projection.AccessRequestReasonCol,
+		table: accessRequestTable,
+	}
+	AccessRequestColumnDeciderID = Column{
+		name:  projection.AccessRequestDeciderIDCol,
+		table: accessRequestTable,
+	}
+	AccessRequestColumnDecisionComment = Column{
+		name:  projection.AccessRequestDecisionCommentCol,
+		table: accessRequestTable,
+	}
+	AccessRequestColumnUserGrantID = Column{
+		name:  projection.AccessRequestUserGrantIDCol,
+		table: accessRequestTable,
+	}
+)
+
+type AccessRequests struct {
+	SearchResponse
+	AccessRequests []*AccessRequest
+}
+
+func (r *AccessRequests) SetState(s *State) {
+	r.State = s
+}
+
+type AccessRequest struct {
+	domain.ObjectDetails
+
+	State           domain.AccessRequestState
+	UserID          string
+	ProjectID       string
+	ProjectGrantID  string
+	RoleKeys        database.TextArray[string]
+	Reason          string
+	DeciderID       string
+	DecisionComment string
+	UserGrantID     string
+}
+
+type AccessRequestSearchQueries struct {
+	SearchRequest
+	Queries []SearchQuery
+}
+
+func (q *AccessRequestSearchQueries) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	query = q.SearchRequest.toQuery(query)
+	for _, q := range q.Queries {
+		query = q.toQuery(query)
+	}
+	return query
+}
+
+// accessRequestCheckPermission allows the requesting user to read the own requests,
+// everyone else needs the permission to read the user grants of the project (grant).
+func accessRequestCheckPermission(ctx context.Context, request *AccessRequest, permissionCheck domain.PermissionCheck) error {
+	if request.UserID == authz.GetCtxData(ctx).UserID {
+		return nil
+	}
+	if request.ProjectGrantID != "" {
+		return permissionCheck(ctx, domain.PermissionUserGrantRead, request.ResourceOwner, request.ProjectGrantID)
+	}
+	return permissionCheck(ctx, domain.PermissionUserGrantRead, request.ResourceOwner, request.ProjectID)
+}
+
+func accessRequestsCheckPermission(ctx context.Context, requests *AccessRequests, permissionCheck domain.PermissionCheck) {
+	requests.AccessRequests = slices.DeleteFunc(requests.AccessRequests,
+		func(request *AccessRequest) bool {
+			return accessRequestCheckPermission(ctx, request, permissionCheck) != nil
+		},
+	)
+}
+
+func (q *Queries) SearchAccessRequests(ctx context.Context, queries *AccessRequestSearchQueries, permissionCheck domain.PermissionCheck) (_ *AccessRequests, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	eq := sq.Eq{
+		AccessRequestColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+	}
+	query, scan := prepareAccessRequestsQuery()
+	requests, err := genericRowsQueryWithState(ctx, q.client, accessRequestTable, combineToWhereStmt(query, queries.toQuery, eq), scan)
+	if err != nil {
+		return nil, err
+	}
+	if permissionCheck != nil {
+		accessRequestsCheckPermission(ctx, requests, permissionCheck)
+	}
+	return requests, nil
+}
+
+func (q *Queries) GetAccessRequestByID(ctx context.Context, id string, permissionCheck domain.PermissionCheck) (_ *AccessRequest, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	eq := sq.Eq{
+		AccessRequestColumnID.identifier():         id,
+		AccessRequestColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+	}
+	query, scan := prepareAccessRequestQuery()
+	request, err := genericRowQuery(ctx, q.client, query.Where(eq), scan)
+	if err != nil {
+		return nil, err
+	}
+	if permissionCheck != nil {
+		if err := accessRequestCheckPermission(ctx, request, permissionCheck); err != nil {
+			return nil, err
+		}
+	}
+	return request, nil
+}
+
+func NewAccessRequestResourceOwnerSearchQuery(resourceOwner string) (SearchQuery, error) {
+	return NewTextQuery(AccessRequestColumnResourceOwner, resourceOwner, TextEquals)
+}
+
+func NewAccessRequestUserIDSearchQuery(userID string) (SearchQuery, error) {
+	return NewTextQuery(AccessRequestColumnUserID, userID, TextEquals)
+}
+
+func NewAccessRequestProjectIDSearchQuery(projectID string) (SearchQuery, error) {
+	return NewTextQuery(AccessRequestColumnProjectID, projectID, TextEquals)
+}
+
+func NewAccessRequestProjectGrantIDSearchQuery(projectGrantID string) (SearchQuery, error) {
+	return NewTextQuery(AccessRequestColumnProjectGrantID, projectGrantID, TextEquals)
+}
+
+func NewAccessRequestStateSearchQuery(state domain.AccessRequestState) (SearchQuery, error) {
+	return NewNumberQuery(AccessRequestColumnState, state, NumberEquals)
+}
+
+func prepareAccessRequestsQuery() (sq.SelectBuilder, func(rows *sql.Rows) (*AccessRequests, error)) {
+	return sq.Select(
+			AccessRequestColumnID.identifier(),
+			AccessRequestColumnCreationDate.identifier(),
+			AccessRequestColumnChangeDate.identifier(),
+			AccessRequestColumnResourceOwner.identifier(),
+			AccessRequestColumnSequence.identifier(),
+			AccessRequestColumnState.identifier(),
+			AccessRequestColumnUserID.identifier(),
+			AccessRequestColumnProjectID.identifier(),
+			AccessRequestColumnProjectGrantID.identifier(),
+			AccessRequestColumnRoleKeys.identifier(),
+			AccessRequestColumnReason.identifier(),
+			AccessRequestColumnDeciderID.identifier(),
+			AccessRequestColumnDecisionComment.identifier(),
+			AccessRequestColumnUserGrantID.identifier(),
+			countColumn.identifier(),
+		).From(accessRequestTable.identifier()).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*AccessRequests, error) {
+			requests := make([]*AccessRequest, 0)
+			var count uint64
+			for rows.Next() {
+				request := new(AccessRequest)
+				err := rows.Scan(
+					&request.ID,
+					&request.CreationDate,
+					&request.EventDate,
+					&request.ResourceOwner,
+					&request.Sequence,
+					&request.State,
+					&request.UserID,
+					&request.ProjectID,
+					&request.ProjectGrantID,
+					&request.RoleKeys,
+					&request.Reason,
+					&request.DeciderID,
+					&request.DecisionComment,
+					&request.UserGrantID,
+					&count,
+				)
+				if err != nil {
+					return nil, err
+				}
+				requests = append(requests, request)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-Iev4o", "Errors.Query.CloseRows")
+			}
+
+			return &AccessRequests{
+				AccessRequests: requests,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
+
+func prepareAccessRequestQuery() (sq.SelectBuilder, func(row *sql.Row) (*AccessRequest, error)) {
+	return sq.Select(
+			AccessRequestColumnID.identifier(),
+			AccessRequestColumnCreationDate.identifier(),
+			AccessRequestColumnChangeDate.identifier(),
+			AccessRequestColumnResourceOwner.identifier(),
+			AccessRequestColumnSequence.identifier(),
+			AccessRequestColumnState.identifier(),
+			AccessRequestColumnUserID.identifier(),
+			AccessRequestColumnProjectID.identifier(),
+			AccessRequestColumnProjectGrantID.identifier(),
+			AccessRequestColumnRoleKeys.identifier(),
+			AccessRequestColumnReason.identifier(),
+			AccessRequestColumnDeciderID.identifier(),
+			AccessRequestColumnDecisionComment.identifier(),
+			AccessRequestColumnUserGrantID.identifier(),
+		).From(accessRequestTable.identifier()).
+			PlaceholderFormat(sq.Dollar),
+		func(row *sql.Row) (*AccessRequest, error) {
+			request := new(AccessRequest)
+			err := row.Scan(
+				&request.ID,
+				&request.CreationDate,
+				&request.EventDate,
+				&request.ResourceOwner,
+				&request.Sequence,
+				&request.State,
+				&request.UserID,
+				&request.ProjectID,
+				&request.ProjectGrantID,
+				&request.RoleKeys,
+				&request.Reason,
+				&request.DeciderID,
+				&request.DecisionComment,
+				&request.UserGrantID,
+			)
+			if err != nil {
+				if errors.Is(err, sql.ErrNoRows) {
+					return nil, zerrors.ThrowNotFound(err, "QUERY-Uo4ae", "Errors.AccessRequest.NotFound")
+				}
+				return nil, zerrors.ThrowInternal(err, "QUERY-ohB6e", "Errors.Internal")
+			}
+			return request, nil
+		}
+}
@@ -0,0 +1,225 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	prepareAccessRequestsStmt = `SELECT projections.access_requests.id,` +
+		` projections.access_requests.creation_date,` +
+		` projections.access_requests.change_date,` +
+		` projections.access_requests.resource_owner,` +
+		` projections.access_requests.sequence,` +
+		` projections.access_requests.state,` +
+		` projections.access_requests.user_id,` +
+		` projections.access_requests.project_id,` +
+		` projections.access_requests.grant_id,` +
+		` projections.access_requests.role_keys,` +
+		` projections.access_requests.reason,` +
+		` projections.access_requests.decider_id,` +
+		` projections.access_requests.decision_comment,` +
+		` projections.access_requests.user_grant_id,` +
+		` COUNT(*) OVER ()` +
+		` FROM projections.access_requests`
+	prepareAccessRequestsCols = []string{
+		"id",
+		"creation_date",
+		"change_date",
+		"resource_owner",
+		"sequence",
+		"state",
+		"user_id",
+		"project_id",
+		"grant_id",
+		"role_keys",
+		"reason",
+		"decider_id",
+		"decision_comment",
+		"user_grant_id",
+		"count",
+	}
+
+	prepareAccessRequestStmt = `SELECT projections.access_requests.id,` +
+		` projections.access_requests.creation_date,` +
+		` projections.access_requests.change_date,` +
+		` projections.access_requests.resource_owner,` +
+		` projections.access_requests.sequence,` +
+		` projections.access_requests.state,` +
+		` projections.access_requests.user_id,` +
+		` projections.access_requests.project_id,` +
+		` projections.access_requests.grant_id,` +
+		` projections.access_requests.role_keys,` +
+		` projections.access_requests.reason,` +
+		` projections.access_requests.decider_id,` +
+		` projections.access_requests.decision_comment,` +
+		` projections.access_requests.user_grant_id` +
+		` FROM projections.access_requests`
+	prepareAccessRequestCols = prepareAccessRequestsCols[:len(prepareAccessRequestsCols)-1]
+)
+
+func Test_AccessRequestPrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareAccessRequestsQuery no result",
+			prepare: prepareAccessRequestsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareAccessRequestsStmt),
+					nil,
+					nil,
+				),
+			},
+			object: &AccessRequests{AccessRequests: []*AccessRequest{}},
+		},
+		{
+			name:    "prepareAccessRequestsQuery one result",
+			prepare: prepareAccessRequestsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareAccessRequestsStmt),
+					prepareAccessRequestsCols,
+					[][]driver.Value{
+						{
+							"id",
+							testNow,
+							testNow,
+							"ro",
+							uint64(20211109),
+							domain.AccessRequestStateApproved,
+							"user-id",
+							"project-id",
+							"",
+							database.TextArray[string]{"role"},
+							"reason",
+							"decider-id",
+							"comment",
+							"user-grant-id",
+						},
+					},
+				),
+			},
+			object: &AccessRequests{
+				SearchResponse: SearchResponse{
+					Count: 1,
+				},
+				AccessRequests: []*AccessRequest{
+					{
+						ObjectDetails: domain.ObjectDetails{
+							ID:            "id",
+							EventDate:     testNow,
+							CreationDate:  testNow,
+							ResourceOwner: "ro",
+							Sequence:      20211109,
+						},
+						State:           domain.AccessRequestStateApproved,
+						UserID:          "user-id",
+						ProjectID:       "project-id",
+						RoleKeys:        database.TextArray[string]{"role"},
+						Reason:          "reason",
+						DeciderID:       "decider-id",
+						DecisionComment: "comment",
+						UserGrantID:     "user-grant-id",
+					},
+				},
+			},
+		},
+		{
+			name:    "prepareAccessRequestsQuery sql err",
+			prepare: prepareAccessRequestsQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(prepareAccessRequestsStmt),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*AccessRequests)(nil),
+		},
+		{
+			name:    "prepareAccessRequestQuery no result",
+			prepare: prepareAccessRequestQuery,
+			want: want{
+				sqlExpectations: mockQueriesScanErr(
+					regexp.QuoteMeta(prepareAccessRequestStmt),
+					nil,
+					nil,
+				),
+				err: func(err error) (error, bool) {
+					if !zerrors.IsNotFound(err) {
+						return fmt.Errorf("err should be zitadel.NotFoundError got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*AccessRequest)(nil),
+		},
+		{
+			name:    "prepareAccessRequestQuery found",
+			prepare: prepareAccessRequestQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					regexp.QuoteMeta(prepareAccessRequestStmt),
+					prepareAccessRequestCols,
+					[]driver.Value{
+						"id",
+						testNow,
+						testNow,
+						"ro",
+						uint64(20211109),
+						domain.AccessRequestStatePending,
+						"user-id",
+						"project-id",
+						"grant-id",
+						database.TextArray[string]{"role"},
+						"",
+						"",
+						"",
+						"",
+					},
+				),
+			},
+			object: &AccessRequest{
+				ObjectDetails: domain.ObjectDetails{
+					ID:            "id",
+					EventDate:     testNow,
+					CreationDate:  testNow,
+					ResourceOwner: "ro",
+					Sequence:      20211109,
+				},
+				State:          domain.AccessRequestStatePending,
+				UserID:         "user-id",
+				ProjectID:      "project-id",
+				ProjectGrantID: "grant-id",
+				RoleKeys:       database.TextArray[string]{"role"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err)
+		})
+	}
+}
@@ -0,0 +1,426 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	accessReviewTable = table{
+		name:          projection.AccessReviewTable,
+		instanceIDCol: projection.AccessReviewInstanceIDCol,
+	}
+	AccessReviewColumnID = Column{
+		name:  projection.AccessReviewIDCol,
+		table: accessReviewTable,
+	}
+	AccessReviewColumnCreationDate = Column{
+		name:  projection.AccessReviewCreationDateCol,
+		table: accessReviewTable,
+	}
+	AccessReviewColumnChangeDate = Column{
+		name:  projection.AccessReviewChangeDateCol,
+		table: accessReviewTable,
+	}
+	AccessReviewColumnResourceOwner = Column{
+		name:  projection.AccessReviewResourceOwnerCol,
+		table: accessReviewTable,
+	}
+	AccessReviewColumnInstanceID = Column{
+		name:  projection.AccessReviewInstanceIDCol,
+		table: accessReviewTable,
+	}
+	AccessReviewColumnSequence = Column{
+		name:  projection.AccessReviewSequenceCol,
+		table: accessReviewTable,
+	}
+	AccessReviewColumnState = Column{
+		name:  projection.AccessReviewStateCol,
+		table: accessReviewTable,
+	}
+	AccessReviewColumnName = Column{
+		name:  projection.AccessReviewNameCol,
+		table: accessReviewTable,
+	}
+	AccessReviewColumnProjectID = Column{
+		name:  projection.AccessReviewProjectIDCol,
+		table: accessReviewTable,
+	}
+	AccessReviewColumnDeadline = Column{
+		name:  projection.AccessReviewDeadlineCol,
+		table: accessReviewTable,
+	}
+)
+
+var (
+	accessReviewItemTable = table{
+		name:          projection.AccessReviewTable + "_" + projection.AccessReviewItemSuffix,
+		instanceIDCol: projection.AccessReviewItemInstanceIDCol,
+	}
+	AccessReviewItemColumnInstanceID = Column{
+		name:  projection.AccessReviewItemInstanceIDCol,
+		table: accessReviewItemTable,
+	}
+	AccessReviewItemColumnReviewID = Column{
+		name:  projection.AccessReviewItemReviewIDCol,
+		table: accessReviewItemTable,
+	}
+	AccessReviewItemColumnID = Column{
+		name:  projection.AccessReviewItemIDCol,
+		table: accessReviewItemTable,
+	}
+	AccessReviewItemColumnChangeDate = Column{
+		name:  projection.AccessReviewItemChangeDateCol,
+		table: accessReviewItemTable,
+	}
+	AccessReviewItemColumnType = Column{
+		name:  projection.AccessReviewItemTypeCol,
+		table: accessReviewItemTable,
+	}
+	AccessReviewItemColumnUserID = Column{
+		name:  projection.AccessReviewItemUserIDCol,
+		table: accessReviewItemTable,
+	}
+	AccessReviewItemColumnProjectID = Column{
+		name:  projection.AccessReviewItemProjectIDCol,
+		table: accessReviewItemTable,
+	}
+	AccessReviewItemColumnGrantID = Column{
+		name:  projection.AccessReviewItemGrantIDCol,
+		table: accessReviewItemTable,
+	}
+	AccessReviewItemColumnUserGrantID = Column{
+		name:  projection.AccessReviewItemUserGrantIDCol,
+		table: accessReviewItemTable,
+	}
+	AccessReviewItemColumnRoles = Column{
+		name:  projection.AccessReviewItemRolesCol,
+		table: accessReviewItemTable,
+	}
+	AccessReviewItemColumnReviewerID = Column{
+		name:  projection.AccessReviewItemReviewerIDCol,
+		table: accessReviewItemTable,
+	}
+	AccessReviewItemColumnDecision = Column{
+		name:  projection.AccessReviewItemDecisionCol,
+		table: accessReviewItemTable,
+	}
+	AccessReviewItemColumnDeciderID = Column{
+		name:  projection.AccessReviewItemDeciderIDCol,
+		table: accessReviewItemTable,
+	}
+	AccessReviewItemColumnComment = Column{
+		name:  projection.AccessReviewItemCommentCol,
+		table: accessReviewItemTable,
+	}
+)
+
+type AccessReviews struct {
+	SearchResponse
+	AccessReviews []*AccessReview
+}
+
+func (r *AccessReviews) SetState(s *State) {
+	r.State = s
+}
+
+type AccessReview struct {
+	domain.ObjectDetails
+
+	State     domain.AccessReviewState
+	Name      string
+	ProjectID string
+	Deadline  time.Time
+}
+
+type AccessReviewSearchQueries struct {
+	SearchRequest
+	Queries []SearchQuery
+}
+
+func (q *AccessReviewSearchQueries) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	query = q.SearchRequest.toQuery(query)
+	for _, q := range q.Queries {
+		query = q.toQuery(query)
+	}
+	return query
+}
+
+type AccessReviewItems struct {
+	SearchResponse
+	Items []*AccessReviewItem
+}
+
+func (r *AccessReviewItems) SetState(s *State) {
+	r.State = s
+}
+
+// AccessReviewItem is a user grant or membership captured by an access review,
+// the resource owner, name and deadline are taken from the review.
+type AccessReviewItem struct {
+	ID            string
+	ReviewID      string
+	ChangeDate    time.Time
+	ResourceOwner string
+	ReviewName    string
+	Deadline      time.Time
+
+	Type           domain.AccessReviewItemType
+	UserID         string
+	ProjectID      string
+	ProjectGrantID string
+	UserGrantID    string
+	Roles          database.TextArray[string]
+	ReviewerID     string
+	Decision       domain.AccessReviewDecision
+	DeciderID      string
+	Comment        string
+}
+
+type AccessReviewItemSearchQueries struct {
+	SearchRequest
+	Queries []SearchQuery
+}
+
+func (q *AccessReviewItemSearchQueries) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	query = q.SearchRequest.toQuery(query)
+	for _, q := range q.Queries {
+		query = q.toQuery(query)
+	}
+	return query
+}
+
+func (q *Queries) SearchAccessReviews(ctx context.Context, queries *AccessReviewSearchQueries) (_ *AccessReviews, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	eq := sq.Eq{
+		AccessReviewColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+	}
+	query, scan := prepareAccessReviewsQuery()
+	return genericRowsQueryWithState(ctx, q.client, accessReviewTable, combineToWhereStmt(query, queries.toQuery, eq), scan)
+}
+
+func (q *Queries) AccessReviewByID(ctx context.Context, id, resourceOwner string) (_ *AccessReview, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	eq := sq.Eq{
+		AccessReviewColumnID.identifier():         id,
+		AccessReviewColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+	}
+	if resourceOwner != "" {
+		eq[AccessReviewColumnResourceOwner.identifier()] = resourceOwner
+	}
+	query, scan := prepareAccessReviewQuery()
+	return genericRowQuery(ctx, q.client, query.Where(eq), scan)
+}
+
+func (q *Queries) SearchAccessReviewItems(ctx context.Context, queries *AccessReviewItemSearchQueries) (_ *AccessReviewItems, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	eq := sq.Eq{
+		AccessReviewItemColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+	}
+	query, scan := prepareAccessReviewItemsQuery()
+	return genericRowsQueryWithState(ctx, q.client, accessReviewTable, combineToWhereStmt(query, queries.toQuery, eq), scan)
+}
+
+func NewAccessReviewResourceOwnerSearchQuery(resourceOwner string) (SearchQuery, error) {
+	return NewTextQuery(AccessReviewColumnResourceOwner, resourceOwner, TextEquals)
+}
+
+func NewAccessReviewProjectIDSearchQuery(projectID string) (SearchQuery, error) {
+	return NewTextQuery(AccessReviewColumnProjectID, projectID, TextEquals)
+}
+
+func NewAccessReviewStateSearchQuery(state domain.AccessReviewState) (SearchQuery, error) {
+	return NewNumberQuery(AccessReviewColumnState, state, NumberEquals)
+}
+
+func NewAccessReviewDeadlineSearchQuery(deadline time.Time, comparison TimestampComparison) (SearchQuery, error) {
+	return NewTimestampQuery(AccessReviewColumnDeadline, deadline, comparison)
+}
+
+func NewAccessReviewItemResourceOwnerSearchQuery(resourceOwner string) (SearchQuery, error) {
+	return NewTextQuery(AccessReviewColumnResourceOwner, resourceOwner, TextEquals)
+}
+
+func NewAccessReviewItemReviewIDSearchQuery(reviewID string) (SearchQuery, error) {
+	return NewTextQuery(AccessReviewItemColumnReviewID, reviewID, TextEquals)
+}
+
+func NewAccessReviewItemReviewerIDSearchQuery(reviewerID string) (SearchQuery, error) {
+	return NewTextQuery(AccessReviewItemColumnReviewerID, reviewerID, TextEquals)
+}
+
+func NewAccessReviewItemUserIDSearchQuery(userID string) (SearchQuery, error) {
+	return NewTextQuery(AccessReviewItemColumnUserID, userID, TextEquals)
+}
+
+func NewAccessReviewItemDecisionSearchQuery(decision domain.AccessReviewDecision) (SearchQuery, error) {
+	return NewNumberQuery(AccessReviewItemColumnDecision, decision, NumberEquals)
+}
+
+func prepareAccessReviewsQuery() (sq.SelectBuilder, func(rows *sql.Rows) (*AccessReviews, error)) {
+	return sq.Select(
+			AccessReviewColumnID.identifier(),
+			AccessReviewColumnCreationDate.identifier(),
+			AccessReviewColumnChangeDate.identifier(),
+			AccessReviewColumnResourceOwner.identifier(),
+			AccessReviewColumnSequence.identifier(),
+			AccessReviewColumnState.identifier(),
+			AccessReviewColumnName.identifier(),
+			AccessReviewColumnProjectID.identifier(),
+			AccessReviewColumnDeadline.identifier(),
+			countColumn.identifier(),
+		).From(accessReviewTable.identifier()).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*AccessReviews, error) {
+			reviews := make([]*AccessReview, 0)
+			var count uint64
+			for rows.Next() {
+				review := new(AccessReview)
+				err := rows.Scan(
+					&review.ID,
+					&review.CreationDate,
+					&review.EventDate,
+					&review.ResourceOwner,
+					&review.Sequence,
+					&review.State,
+					&review.Name,
+					&review.ProjectID,
+					&review.Deadline,
+					&count,
+				)
+				if err != nil {
+					return nil, err
+				}
+				reviews = append(reviews, review)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-eiV3u", "Errors.Query.CloseRows")
+			}
+
+			return &AccessReviews{
+				AccessReviews: reviews,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
+
+func prepareAccessReviewQuery() (sq.SelectBuilder, func(row *sql.Row) (*AccessReview, error)) {
+	return sq.Select(
+			AccessReviewColumnID.identifier(),
+			AccessReviewColumnCreationDate.identifier(),
+			AccessReviewColumnChangeDate.identifier(),
+			AccessReviewColumnResourceOwner.identifier(),
+			AccessReviewColumnSequence.identifier(),
+			AccessReviewColumnState.identifier(),
+			AccessReviewColumnName.identifier(),
+			AccessReviewColumnProjectID.identifier(),
+			AccessReviewColumnDeadline.identifier(),
+		).From(accessReviewTable.identifier()).
+			PlaceholderFormat(sq.Dollar),
+		func(row *sql.Row) (*AccessReview, error) {
+			review := new(AccessReview)
+			err := row.Scan(
+				&review.ID,
+				&review.CreationDate,
+				&review.EventDate,
+				&review.ResourceOwner,
+				&review.Sequence,
+				&review.State,
+				&review.Name,
+				&review.ProjectID,
+				&review.Deadline,
+			)
+			if err != nil {
+				if errors.Is(err, sql.ErrNoRows) {
+					return nil, zerrors.ThrowNotFound(err, "QUERY-Thee9", "Errors.AccessReview.NotFound")
+				}
+				return nil, zerrors.ThrowInternal(err, "QUERY-ahX7o", "Errors.Internal")
+			}
+			return review, nil
+		}
+}
+
+func prepareAccessReviewItemsQuery() (sq.SelectBuilder, func(rows *sql.Rows) (*AccessReviewItems, error)) {
+	return sq.Select(
+			AccessReviewItemColumnID.identifier(),
+			AccessReviewItemColumnReviewID.identifier(),
+			AccessReviewItemColumnChangeDate.identifier(),
+			AccessReviewColumnResourceOwner.identifier(),
+			AccessReviewColumnName.identifier(),
+			AccessReviewColumnDeadline.identifier(),
+			AccessReviewItemColumnType.identifier(),
+			AccessReviewItemColumnUserID.identifier(),
+			AccessReviewItemColumnProjectID.identifier(),
+			AccessReviewItemColumnGrantID.identifier(),
+			AccessReviewItemColumnUserGrantID.identifier(),
+			AccessReviewItemColumnRoles.identifier(),
+			AccessReviewItemColumnReviewerID.identifier(),
+			AccessReviewItemColumnDecision.identifier(),
+			AccessReviewItemColumnDeciderID.identifier(),
+			AccessReviewItemColumnComment.identifier(),
+			countColumn.identifier(),
+		).From(accessReviewItemTable.identifier()).
+			Join(join(AccessReviewColumnID, AccessReviewItemColumnReviewID)).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*AccessReviewItems, error) {
+			items := make([]*AccessReviewItem, 0)
+			var count uint64
+			for rows.Next() {
+				item := new(AccessReviewItem)
+				err := rows.Scan(
+					&item.ID,
+					&item.ReviewID,
+					&item.ChangeDate,
+					&item.ResourceOwner,
+					&item.ReviewName,
+					&item.Deadline,
+					&item.Type,
+					&item.UserID,
+					&item.ProjectID,
+					&item.ProjectGrantID,
+					&item.UserGrantID,
+					&item.Roles,
+					&item.ReviewerID,
+					&item.Decision,
+					&item.DeciderID,
+					&item.Comment,
+					&count,
+				)
+				if err != nil {
+					return nil, err
+				}
+				items = append(items, item)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-Ohng4", "Errors.Query.CloseRows")
+			}
+
+			return &AccessReviewItems{
+				Items: items,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
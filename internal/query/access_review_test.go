@@ -0,0 +1,316 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	prepareAccessReviewsStmt = `SELECT projections.access_reviews.id,` +
+		` projections.access_reviews.creation_date,` +
+		` projections.access_reviews.change_date,` +
+		` projections.access_reviews.resource_owner,` +
+		` projections.access_reviews.sequence,` +
+		` projections.access_reviews.state,` +
+		` projections.access_reviews.name,` +
+		` projections.access_reviews.project_id,` +
+		` projections.access_reviews.deadline,` +
+		` COUNT(*) OVER ()` +
+		` FROM projections.access_reviews`
+	prepareAccessReviewsCols = []string{
+		"id",
+		"creation_date",
+		"change_date",
+		"resource_owner",
+		"sequence",
+		"state",
+		"name",
+		"project_id",
+		"deadline",
+		"count",
+	}
+
+	prepareAccessReviewStmt = `SELECT projections.access_reviews.id,` +
+		` projections.access_reviews.creation_date,` +
+		` projections.access_reviews.change_date,` +
+		` projections.access_reviews.resource_owner,` +
+		` projections.access_reviews.sequence,` +
+		` projections.access_reviews.state,` +
+		` projections.access_reviews.name,` +
+		` projections.access_reviews.project_id,` +
+		` projections.access_reviews.deadline` +
+		` FROM projections.access_reviews`
+	prepareAccessReviewCols = prepareAccessReviewsCols[:len(prepareAccessReviewsCols)-1]
+
+	prepareAccessReviewItemsStmt = `SELECT projections.access_reviews_items.id,` +
+		` projections.access_reviews_items.review_id,` +
+		` projections.access_reviews_items.change_date,` +
+		` projections.access_reviews.resource_owner,` +
+		` projections.access_reviews.name,` +
+		` projections.access_reviews.deadline,` +
+		` projections.access_reviews_items.type,` +
+		` projections.access_reviews_items.user_id,` +
+		` projections.access_reviews_items.project_id,` +
+		` projections.access_reviews_items.grant_id,` +
+		` projections.access_reviews_items.user_grant_id,` +
+		` projections.access_reviews_items.roles,` +
+		` projections.access_reviews_items.reviewer_id,` +
+		` projections.access_reviews_items.decision,` +
+		` projections.access_reviews_items.decider_id,` +
+		` projections.access_reviews_items.comment,` +
+		` COUNT(*) OVER ()` +
+		` FROM projections.access_reviews_items` +
+		` JOIN projections.access_reviews ON projections.access_reviews_items.review_id = projections.access_reviews.id AND projections.access_reviews_items.instance_id = projections.access_reviews.instance_id`
+	prepareAccessReviewItemsCols = []string{
+		"id",
+		"review_id",
+		"change_date",
+		"resource_owner",
+		"name",
+		"deadline",
+		"type",
+		"user_id",
+		"project_id",
+		"grant_id",
+		"user_grant_id",
+		"roles",
+		"reviewer_id",
+		"decision",
+		"decider_id",
+		"comment",
+		"count",
+	}
+)
+
+func Test_AccessReviewPrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareAccessReviewsQuery no result",
+			prepare: prepareAccessReviewsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareAccessReviewsStmt),
+					nil,
+					nil,
+				),
+			},
+			object: &AccessReviews{AccessReviews: []*AccessReview{}},
+		},
+		{
+			name:    "prepareAccessReviewsQuery one result",
+			prepare: prepareAccessReviewsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareAccessReviewsStmt),
+					prepareAccessReviewsCols,
+					[][]driver.Value{
+						{
+							"id",
+							testNow,
+							testNow,
+							"ro",
+							uint64(20211109),
+							domain.AccessReviewStateActive,
+							"Q1",
+							"project-id",
+							testNow,
+						},
+					},
+				),
+			},
+			object: &AccessReviews{
+				SearchResponse: SearchResponse{
+					Count: 1,
+				},
+				AccessReviews: []*AccessReview{
+					{
+						ObjectDetails: domain.ObjectDetails{
+							ID:            "id",
+							EventDate:     testNow,
+							CreationDate:  testNow,
+							ResourceOwner: "ro",
+							Sequence:      20211109,
+						},
+						State:     domain.AccessReviewStateActive,
+						Name:      "Q1",
+						ProjectID: "project-id",
+						Deadline:  testNow,
+					},
+				},
+			},
+		},
+		{
+			name:    "prepareAccessReviewsQuery sql err",
+			prepare: prepareAccessReviewsQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(prepareAccessReviewsStmt),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*AccessReviews)(nil),
+		},
+		{
+			name:    "prepareAccessReviewQuery no result",
+			prepare: prepareAccessReviewQuery,
+			want: want{
+				sqlExpectations: mockQueriesScanErr(
+					regexp.QuoteMeta(prepareAccessReviewStmt),
+					nil,
+					nil,
+				),
+				err: func(err error) (error, bool) {
+					if !zerrors.IsNotFound(err) {
+						return fmt.Errorf("err should be zitadel.NotFoundError got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*AccessReview)(nil),
+		},
+		{
+			name:    "prepareAccessReviewQuery found",
+			prepare: prepareAccessReviewQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					regexp.QuoteMeta(prepareAccessReviewStmt),
+					prepareAccessReviewCols,
+					[]driver.Value{
+						"id",
+						testNow,
+						testNow,
+						"ro",
+						uint64(20211109),
+						domain.AccessReviewStateClosed,
+						"Q1",
+						"",
+						testNow,
+					},
+				),
+			},
+			object: &AccessReview{
+				ObjectDetails: domain.ObjectDetails{
+					ID:            "id",
+					EventDate:     testNow,
+					CreationDate:  testNow,
+					ResourceOwner: "ro",
+					Sequence:      20211109,
+				},
+				State:    domain.AccessReviewStateClosed,
+				Name:     "Q1",
+				Deadline: testNow,
+			},
+		},
+		{
+			name:    "prepareAccessReviewItemsQuery no result",
+			prepare: prepareAccessReviewItemsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareAccessReviewItemsStmt),
+					nil,
+					nil,
+				),
+			},
+			object: &AccessReviewItems{Items: []*AccessReviewItem{}},
+		},
+		{
+			name:    "prepareAccessReviewItemsQuery one result",
+			prepare: prepareAccessReviewItemsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareAccessReviewItemsStmt),
+					prepareAccessReviewItemsCols,
+					[][]driver.Value{
+						{
+							"item-id",
+							"review-id",
+							testNow,
+							"ro",
+							"Q1",
+							testNow,
+							domain.AccessReviewItemTypeUserGrant,
+							"user-id",
+							"project-id",
+							"",
+							"user-grant-id",
+							database.TextArray[string]{"role"},
+							"reviewer-id",
+							domain.AccessReviewDecisionKeep,
+							"reviewer-id",
+							"comment",
+						},
+					},
+				),
+			},
+			object: &AccessReviewItems{
+				SearchResponse: SearchResponse{
+					Count: 1,
+				},
+				Items: []*AccessReviewItem{
+					{
+						ID:            "item-id",
+						ReviewID:      "review-id",
+						ChangeDate:    testNow,
+						ResourceOwner: "ro",
+						ReviewName:    "Q1",
+						Deadline:      testNow,
+						Type:          domain.AccessReviewItemTypeUserGrant,
+						UserID:        "user-id",
+						ProjectID:     "project-id",
+						UserGrantID:   "user-grant-id",
+						Roles:         database.TextArray[string]{"role"},
+						ReviewerID:    "reviewer-id",
+						Decision:      domain.AccessReviewDecisionKeep,
+						DeciderID:     "reviewer-id",
+						Comment:       "comment",
+					},
+				},
+			},
+		},
+		{
+			name:    "prepareAccessReviewItemsQuery sql err",
+			prepare: prepareAccessReviewItemsQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(prepareAccessReviewItemsStmt),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*AccessReviewItems)(nil),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err)
+		})
+	}
+}
@@ -36,6 +36,11 @@ type OIDCSessionAccessTokenReadModel struct {
 	UserAgent             *domain.UserAgent
 	Reason                domain.TokenReason
 	Actor                 *domain.TokenActor
+	DPoPJKT               string
+	CertificateThumbprint string
+	AuthorizationDetails  domain.AuthorizationDetails
+
+	sessionAudience []string
 }
 
 func newOIDCSessionAccessTokenReadModel(id string) *OIDCSessionAccessTokenReadModel {
@@ -79,6 +84,7 @@ func (wm *OIDCSessionAccessTokenReadModel) reduceAdded(e *oidcsession.AddedEvent
 	wm.UserID = e.UserID
 	wm.SessionID = e.SessionID
 	wm.ClientID = e.ClientID
+	wm.sessionAudience = e.Audience
 	wm.Audience = e.Audience
 	wm.Scope = e.Scope
 	wm.AuthMethods = e.AuthMethods
@@ -86,6 +92,9 @@ func (wm *OIDCSessionAccessTokenReadModel) reduceAdded(e *oidcsession.AddedEvent
 	wm.Nonce = e.Nonce
 	wm.PreferredLanguage = e.PreferredLanguage
 	wm.UserAgent = e.UserAgent
+	wm.DPoPJKT = e.DPoPJKT
+	wm.CertificateThumbprint = e.CertificateThumbprint
+	wm.AuthorizationDetails = e.AuthorizationDetails
 	wm.State = domain.OIDCSessionStateActive
 }
 
@@ -95,6 +104,11 @@ func (wm *OIDCSessionAccessTokenReadModel) reduceAccessTokenAdded(e *oidcsession
 	wm.AccessTokenExpiration = e.CreationDate().Add(e.Lifetime)
 	wm.Reason = e.Reason
 	wm.Actor = e.Actor
+	// the access token might be restricted to the resources requested for it
+	wm.Audience = wm.sessionAudience
+	if len(e.Audience) > 0 {
+		wm.Audience = e.Audience
+	}
 }
 
 func (wm *OIDCSessionAccessTokenReadModel) reduceTokenRevoked(e eventstore.Event) {
@@ -41,27 +41,36 @@ type App struct {
 }
 
 type OIDCApp struct {
-	RedirectURIs             database.TextArray[string]
-	ResponseTypes            database.NumberArray[domain.OIDCResponseType]
-	GrantTypes               database.NumberArray[domain.OIDCGrantType]
-	AppType                  domain.OIDCApplicationType
-	ClientID                 string
-	AuthMethodType           domain.OIDCAuthMethodType
-	PostLogoutRedirectURIs   database.TextArray[string]
-	Version                  domain.OIDCVersion
-	ComplianceProblems       database.TextArray[string]
-	IsDevMode                bool
-	AccessTokenType          domain.OIDCTokenType
-	AssertAccessTokenRole    bool
-	AssertIDTokenRole        bool
-	AssertIDTokenUserinfo    bool
-	ClockSkew                time.Duration
-	AdditionalOrigins        database.TextArray[string]
-	AllowedOrigins           database.TextArray[string]
-	SkipNativeAppSuccessPage bool
-	BackChannelLogoutURI     string
-	LoginVersion             domain.LoginVersion
-	LoginBaseURI             *string
+	RedirectURIs                          database.TextArray[string]
+	ResponseTypes                         database.NumberArray[domain.OIDCResponseType]
+	GrantTypes                            database.NumberArray[domain.OIDCGrantType]
+	AppType                               domain.OIDCApplicationType
+	ClientID                              string
+	AuthMethodType                        domain.OIDCAuthMethodType
+	PostLogoutRedirectURIs                database.TextArray[string]
+	Version                               domain.OIDCVersion
+	ComplianceProblems                    database.TextArray[string]
+	IsDevMode                             bool
+	AccessTokenType                       domain.OIDCTokenType
+	AssertAccessTokenRole                 bool
+	AssertIDTokenRole                     bool
+	AssertIDTokenUserinfo                 bool
+	ClockSkew                             time.Duration
+	AdditionalOrigins                     database.TextArray[string]
+	AllowedOrigins                        database.TextArray[string]
+	SkipNativeAppSuccessPage              bool
+	BackChannelLogoutURI                  string
+	LoginVersion                          domain.LoginVersion
+	LoginBaseURI                          *string
+	DPoPBoundAccessTokens                 bool
+	RequirePushedAuthRequests             bool
+	CIBANotificationURI                   string
+	ConsentRequired                       bool
+	TLSClientAuthSubjectDN                string
+	TLSClientCertificates                 database.TextArray[string]
+	TLSClientCertificateBoundAccessTokens bool
+	RefreshTokenRotation                  domain.OIDCRefreshTokenRotation
+	EncryptAuthorizationResponse          bool
 }
 
 type SAMLApp struct {
@@ -276,6 +285,42 @@ var (
 		name:  projection.AppOIDCConfigColumnLoginBaseURI,
 		table: appOIDCConfigsTable,
 	}
+	AppOIDCConfigColumnDPoPBoundAccessTokens = Column{
+		name:  projection.AppOIDCConfigColumnDPoPBoundAccessTokens,
+		table: appOIDCConfigsTable,
+	}
+	AppOIDCConfigColumnRequirePushedAuthRequests = Column{
+		name:  projection.AppOIDCConfigColumnRequirePushedAuthRequests,
+		table: appOIDCConfigsTable,
+	}
+	AppOIDCConfigColumnCIBANotificationURI = Column{
+		name:  projection.AppOIDCConfigColumnCIBANotificationURI,
+		table: appOIDCConfigsTable,
+	}
+	AppOIDCConfigColumnConsentRequired = Column{
+		name:  projection.AppOIDCConfigColumnConsentRequired,
+		table: appOIDCConfigsTable,
+	}
+	AppOIDCConfigColumnTLSClientAuthSubjectDN = Column{
+		name:  projection.AppOIDCConfigColumnTLSClientAuthSubjectDN,
+		table: appOIDCConfigsTable,
+	}
+	AppOIDCConfigColumnTLSClientCertificates = Column{
+		name:  projection.AppOIDCConfigColumnTLSClientCertificates,
+		table: appOIDCConfigsTable,
+	}
+	AppOIDCConfigColumnTLSClientCertificateBoundAccessTokens = Column{
+		name:  projection.AppOIDCConfigColumnTLSClientCertificateBoundAccessTokens,
+		table: appOIDCConfigsTable,
+	}
+	AppOIDCConfigColumnRefreshTokenRotation = Column{
+		name:  projection.AppOIDCConfigColumnRefreshTokenRotation,
+		table: appOIDCConfigsTable,
+	}
+	AppOIDCConfigColumnEncryptAuthorizationResponse = Column{
+		name:  projection.AppOIDCConfigColumnEncryptAuthorizationResponse,
+		table: appOIDCConfigsTable,
+	}
 )
 
 func (q *Queries) AppByProjectAndAppID(ctx context.Context, shouldTriggerBulk bool, projectID, appID string) (app *App, err error) {
@@ -667,6 +712,15 @@ func prepareAppQuery(activeOnly bool) (sq.SelectBuilder, func(*sql.Row) (*App, e
 		AppOIDCConfigColumnBackChannelLogoutURI.identifier(),
 		AppOIDCConfigColumnLoginVersion.identifier(),
 		AppOIDCConfigColumnLoginBaseURI.identifier(),
+		AppOIDCConfigColumnDPoPBoundAccessTokens.identifier(),
+		AppOIDCConfigColumnRequirePushedAuthRequests.identifier(),
+		AppOIDCConfigColumnCIBANotificationURI.identifier(),
+		AppOIDCConfigColumnConsentRequired.identifier(),
+		AppOIDCConfigColumnTLSClientAuthSubjectDN.identifier(),
+		AppOIDCConfigColumnTLSClientCertificates.identifier(),
+		AppOIDCConfigColumnTLSClientCertificateBoundAccessTokens.identifier(),
+		AppOIDCConfigColumnRefreshTokenRotation.identifier(),
+		AppOIDCConfigColumnEncryptAuthorizationResponse.identifier(),
 
 		AppSAMLConfigColumnAppID.identifier(),
 		AppSAMLConfigColumnEntityID.identifier(),
@@ -736,6 +790,15 @@ func scanApp(row *sql.Row) (*App, error) {
 		&oidcConfig.backChannelLogoutURI,
 		&oidcConfig.loginVersion,
 		&oidcConfig.loginBaseURI,
+		&oidcConfig.dpopBoundAccessTokens,
+		&oidcConfig.requirePushedAuthRequests,
+		&oidcConfig.cibaNotificationURI,
+		&oidcConfig.consentRequired,
+		&oidcConfig.tlsClientAuthSubjectDN,
+		&oidcConfig.tlsClientCertificates,
+		&oidcConfig.tlsClientCertificateBoundAccessTokens,
+		&oidcConfig.refreshTokenRotation,
+		&oidcConfig.encryptAuthorizationResponse,
 
 		&samlConfig.appID,
 		&samlConfig.entityID,
@@ -790,6 +853,15 @@ func prepareOIDCAppQuery() (sq.SelectBuilder, func(*sql.Row) (*App, error)) {
 			AppOIDCConfigColumnBackChannelLogoutURI.identifier(),
 			AppOIDCConfigColumnLoginVersion.identifier(),
 			AppOIDCConfigColumnLoginBaseURI.identifier(),
+			AppOIDCConfigColumnDPoPBoundAccessTokens.identifier(),
+			AppOIDCConfigColumnRequirePushedAuthRequests.identifier(),
+			AppOIDCConfigColumnCIBANotificationURI.identifier(),
+			AppOIDCConfigColumnConsentRequired.identifier(),
+			AppOIDCConfigColumnTLSClientAuthSubjectDN.identifier(),
+			AppOIDCConfigColumnTLSClientCertificates.identifier(),
+			AppOIDCConfigColumnTLSClientCertificateBoundAccessTokens.identifier(),
+			AppOIDCConfigColumnRefreshTokenRotation.identifier(),
+			AppOIDCConfigColumnEncryptAuthorizationResponse.identifier(),
 		).From(appsTable.identifier()).
 			Join(join(AppOIDCConfigColumnAppID, AppColumnID)).
 			PlaceholderFormat(sq.Dollar), func(row *sql.Row) (*App, error) {
@@ -829,6 +901,15 @@ func prepareOIDCAppQuery() (sq.SelectBuilder, func(*sql.Row) (*App, error)) {
 				&oidcConfig.backChannelLogoutURI,
 				&oidcConfig.loginVersion,
 				&oidcConfig.loginBaseURI,
+				&oidcConfig.dpopBoundAccessTokens,
+				&oidcConfig.requirePushedAuthRequests,
+				&oidcConfig.cibaNotificationURI,
+				&oidcConfig.consentRequired,
+				&oidcConfig.tlsClientAuthSubjectDN,
+				&oidcConfig.tlsClientCertificates,
+				&oidcConfig.tlsClientCertificateBoundAccessTokens,
+				&oidcConfig.refreshTokenRotation,
+				&oidcConfig.encryptAuthorizationResponse,
 			)
 
 			if err != nil {
@@ -988,6 +1069,15 @@ func prepareAppsQuery() (sq.SelectBuilder, func(*sql.Rows) (*Apps, error)) {
 			AppOIDCConfigColumnBackChannelLogoutURI.identifier(),
 			AppOIDCConfigColumnLoginVersion.identifier(),
 			AppOIDCConfigColumnLoginBaseURI.identifier(),
+			AppOIDCConfigColumnDPoPBoundAccessTokens.identifier(),
+			AppOIDCConfigColumnRequirePushedAuthRequests.identifier(),
+			AppOIDCConfigColumnCIBANotificationURI.identifier(),
+			AppOIDCConfigColumnConsentRequired.identifier(),
+			AppOIDCConfigColumnTLSClientAuthSubjectDN.identifier(),
+			AppOIDCConfigColumnTLSClientCertificates.identifier(),
+			AppOIDCConfigColumnTLSClientCertificateBoundAccessTokens.identifier(),
+			AppOIDCConfigColumnRefreshTokenRotation.identifier(),
+			AppOIDCConfigColumnEncryptAuthorizationResponse.identifier(),
 
 			AppSAMLConfigColumnAppID.identifier(),
 			AppSAMLConfigColumnEntityID.identifier(),
@@ -1045,6 +1135,15 @@ func prepareAppsQuery() (sq.SelectBuilder, func(*sql.Rows) (*Apps, error)) {
 					&oidcConfig.backChannelLogoutURI,
 					&oidcConfig.loginVersion,
 					&oidcConfig.loginBaseURI,
+					&oidcConfig.dpopBoundAccessTokens,
+					&oidcConfig.requirePushedAuthRequests,
+					&oidcConfig.cibaNotificationURI,
+					&oidcConfig.consentRequired,
+					&oidcConfig.tlsClientAuthSubjectDN,
+					&oidcConfig.tlsClientCertificates,
+					&oidcConfig.tlsClientCertificateBoundAccessTokens,
+					&oidcConfig.refreshTokenRotation,
+					&oidcConfig.encryptAuthorizationResponse,
 
 					&samlConfig.appID,
 					&samlConfig.entityID,
@@ -1132,26 +1231,35 @@ func prepareLoginVersionBySAMLAppID() (sq.SelectBuilder, func(*sql.Row) (domain.
 }
 
 type sqlOIDCConfig struct {
-	appID                    sql.NullString
-	version                  sql.NullInt32
-	clientID                 sql.NullString
-	redirectUris             database.TextArray[string]
-	applicationType          sql.NullInt16
-	authMethodType           sql.NullInt16
-	postLogoutRedirectUris   database.TextArray[string]
-	devMode                  sql.NullBool
-	accessTokenType          sql.NullInt16
-	accessTokenRoleAssertion sql.NullBool
-	iDTokenRoleAssertion     sql.NullBool
-	iDTokenUserinfoAssertion sql.NullBool
-	clockSkew                sql.NullInt64
-	additionalOrigins        database.TextArray[string]
-	responseTypes            database.NumberArray[domain.OIDCResponseType]
-	grantTypes               database.NumberArray[domain.OIDCGrantType]
-	skipNativeAppSuccessPage sql.NullBool
-	backChannelLogoutURI     sql.NullString
-	loginVersion             sql.NullInt16
-	loginBaseURI             sql.NullString
+	appID                                 sql.NullString
+	version                               sql.NullInt32
+	clientID                              sql.NullString
+	redirectUris                          database.TextArray[string]
+	applicationType                       sql.NullInt16
+	authMethodType                        sql.NullInt16
+	postLogoutRedirectUris                database.TextArray[string]
+	devMode                               sql.NullBool
+	accessTokenType                       sql.NullInt16
+	accessTokenRoleAssertion              sql.NullBool
+	iDTokenRoleAssertion                  sql.NullBool
+	iDTokenUserinfoAssertion              sql.NullBool
+	clockSkew                             sql.NullInt64
+	additionalOrigins                     database.TextArray[string]
+	responseTypes                         database.NumberArray[domain.OIDCResponseType]
+	grantTypes                            database.NumberArray[domain.OIDCGrantType]
+	skipNativeAppSuccessPage              sql.NullBool
+	backChannelLogoutURI                  sql.NullString
+	loginVersion                          sql.NullInt16
+	loginBaseURI                          sql.NullString
+	dpopBoundAccessTokens                 sql.NullBool
+	requirePushedAuthRequests             sql.NullBool
+	cibaNotificationURI                   sql.NullString
+	consentRequired                       sql.NullBool
+	tlsClientAuthSubjectDN                sql.NullString
+	tlsClientCertificates                 database.TextArray[string]
+	tlsClientCertificateBoundAccessTokens sql.NullBool
+	refreshTokenRotation                  sql.NullInt16
+	encryptAuthorizationResponse          sql.NullBool
 }
 
 func (c sqlOIDCConfig) set(app *App) {
@@ -1159,24 +1267,33 @@ func (c sqlOIDCConfig) set(app *App) {
 		return
 	}
 	app.OIDCConfig = &OIDCApp{
-		Version:                  domain.OIDCVersion(c.version.Int32),
-		ClientID:                 c.clientID.String,
-		RedirectURIs:             c.redirectUris,
-		AppType:                  domain.OIDCApplicationType(c.applicationType.Int16),
-		AuthMethodType:           domain.OIDCAuthMethodType(c.authMethodType.Int16),
-		PostLogoutRedirectURIs:   c.postLogoutRedirectUris,
-		IsDevMode:                c.devMode.Bool,
-		AccessTokenType:          domain.OIDCTokenType(c.accessTokenType.Int16),
-		AssertAccessTokenRole:    c.accessTokenRoleAssertion.Bool,
-		AssertIDTokenRole:        c.iDTokenRoleAssertion.Bool,
-		AssertIDTokenUserinfo:    c.iDTokenUserinfoAssertion.Bool,
-		ClockSkew:                time.Duration(c.clockSkew.Int64),
-		AdditionalOrigins:        c.additionalOrigins,
-		ResponseTypes:            c.responseTypes,
-		GrantTypes:               c.grantTypes,
-		SkipNativeAppSuccessPage: c.skipNativeAppSuccessPage.Bool,
-		BackChannelLogoutURI:     c.backChannelLogoutURI.String,
-		LoginVersion:             domain.LoginVersion(c.loginVersion.Int16),
+		Version:                               domain.OIDCVersion(c.version.Int32),
+		ClientID:                              c.clientID.String,
+		RedirectURIs:                          c.redirectUris,
+		AppType:                               domain.OIDCApplicationType(c.applicationType.Int16),
+		AuthMethodType:                        domain.OIDCAuthMethodType(c.authMethodType.Int16),
+		PostLogoutRedirectURIs:                c.postLogoutRedirectUris,
+		IsDevMode:                             c.devMode.Bool,
+		AccessTokenType:                       domain.OIDCTokenType(c.accessTokenType.Int16),
+		AssertAccessTokenRole:                 c.accessTokenRoleAssertion.Bool,
+		AssertIDTokenRole:                     c.iDTokenRoleAssertion.Bool,
+		AssertIDTokenUserinfo:                 c.iDTokenUserinfoAssertion.Bool,
+		ClockSkew:                             time.Duration(c.clockSkew.Int64),
+		AdditionalOrigins:                     c.additionalOrigins,
+		ResponseTypes:                         c.responseTypes,
+		GrantTypes:                            c.grantTypes,
+		SkipNativeAppSuccessPage:              c.skipNativeAppSuccessPage.Bool,
+		BackChannelLogoutURI:                  c.backChannelLogoutURI.String,
+		LoginVersion:                          domain.LoginVersion(c.loginVersion.Int16),
+		DPoPBoundAccessTokens:                 c.dpopBoundAccessTokens.Bool,
+		RequirePushedAuthRequests:             c.requirePushedAuthRequests.Bool,
+		CIBANotificationURI:                   c.cibaNotificationURI.String,
+		ConsentRequired:                       c.consentRequired.Bool,
+		TLSClientAuthSubjectDN:                c.tlsClientAuthSubjectDN.String,
+		TLSClientCertificates:                 c.tlsClientCertificates,
+		TLSClientCertificateBoundAccessTokens: c.tlsClientCertificateBoundAccessTokens.Bool,
+		RefreshTokenRotation:                  domain.OIDCRefreshTokenRotation(c.refreshTokenRotation.Int16),
+		EncryptAuthorizationResponse:          c.encryptAuthorizationResponse.Bool,
 	}
 	if c.loginBaseURI.Valid {
 		app.OIDCConfig.LoginBaseURI = &c.loginBaseURI.String
@@ -51,6 +51,15 @@ var (
 		` projections.apps7_oidc_configs.back_channel_logout_uri,` +
 		` projections.apps7_oidc_configs.login_version,` +
 		` projections.apps7_oidc_configs.login_base_uri,` +
+		` projections.apps7_oidc_configs.dpop_bound_access_tokens,` +
+		` projections.apps7_oidc_configs.require_pushed_auth_requests,` +
+		` projections.apps7_oidc_configs.ciba_notification_uri,` +
+		` projections.apps7_oidc_configs.consent_required,` +
+		` projections.apps7_oidc_configs.tls_client_auth_subject_dn,` +
+		` projections.apps7_oidc_configs.tls_client_certificates,` +
+		` projections.apps7_oidc_configs.tls_client_certificate_bound_access_tokens,` +
+		` projections.apps7_oidc_configs.refresh_token_rotation,` +
+		` projections.apps7_oidc_configs.encrypt_authorization_response,` +
 		//saml config
 		` projections.apps7_saml_configs.app_id,` +
 		` projections.apps7_saml_configs.entity_id,` +
@@ -99,6 +108,15 @@ var (
 		` projections.apps7_oidc_configs.back_channel_logout_uri,` +
 		` projections.apps7_oidc_configs.login_version,` +
 		` projections.apps7_oidc_configs.login_base_uri,` +
+		` projections.apps7_oidc_configs.dpop_bound_access_tokens,` +
+		` projections.apps7_oidc_configs.require_pushed_auth_requests,` +
+		` projections.apps7_oidc_configs.ciba_notification_uri,` +
+		` projections.apps7_oidc_configs.consent_required,` +
+		` projections.apps7_oidc_configs.tls_client_auth_subject_dn,` +
+		` projections.apps7_oidc_configs.tls_client_certificates,` +
+		` projections.apps7_oidc_configs.tls_client_certificate_bound_access_tokens,` +
+		` projections.apps7_oidc_configs.refresh_token_rotation,` +
+		` projections.apps7_oidc_configs.encrypt_authorization_response,` +
 		//saml config
 		` projections.apps7_saml_configs.app_id,` +
 		` projections.apps7_saml_configs.entity_id,` +
@@ -172,6 +190,15 @@ var (
 		"back_channel_logout_uri",
 		"login_version",
 		"login_base_uri",
+		"dpop_bound_access_tokens",
+		"require_pushed_auth_requests",
+		"ciba_notification_uri",
+		"consent_required",
+		"tls_client_auth_subject_dn",
+		"tls_client_certificates",
+		"tls_client_certificate_bound_access_tokens",
+		"refresh_token_rotation",
+		"encrypt_authorization_response",
 		//saml config
 		"app_id",
 		"entity_id",
@@ -248,6 +275,15 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
@@ -319,6 +355,15 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
@@ -393,6 +438,15 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							"app-id",
 							"https://test.com/saml/metadata",
@@ -469,6 +523,15 @@ func Test_AppsPrepare(t *testing.T) {
 							"back.channel.logout.ch",
 							domain.LoginVersionUnspecified,
 							nil,
+							false,
+							false,
+							nil,
+							false,
+							nil,
+							nil,
+							false,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
@@ -495,6 +558,7 @@ func Test_AppsPrepare(t *testing.T) {
 						Name:          "app-name",
 						ProjectID:     "project-id",
 						OIDCConfig: &OIDCApp{
+							TLSClientCertificates:    database.TextArray[string]{},
 							Version:                  domain.OIDCVersionV1,
 							ClientID:                 "oidc-client-id",
 							RedirectURIs:             database.TextArray[string]{"https://redirect.to/me"},
@@ -563,6 +627,15 @@ func Test_AppsPrepare(t *testing.T) {
 							"back.channel.logout.ch",
 							domain.LoginVersionUnspecified,
 							nil,
+							false,
+							false,
+							nil,
+							false,
+							nil,
+							nil,
+							false,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
@@ -589,6 +662,7 @@ func Test_AppsPrepare(t *testing.T) {
 						Name:          "app-name",
 						ProjectID:     "project-id",
 						OIDCConfig: &OIDCApp{
+							TLSClientCertificates:    database.TextArray[string]{},
 							Version:                  domain.OIDCVersionV1,
 							ClientID:                 "oidc-client-id",
 							RedirectURIs:             database.TextArray[string]{"https://redirect.to/me"},
@@ -657,6 +731,15 @@ func Test_AppsPrepare(t *testing.T) {
 							"back.channel.logout.ch",
 							domain.LoginVersionUnspecified,
 							nil,
+							false,
+							false,
+							nil,
+							false,
+							nil,
+							nil,
+							false,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
@@ -683,6 +766,7 @@ func Test_AppsPrepare(t *testing.T) {
 						Name:          "app-name",
 						ProjectID:     "project-id",
 						OIDCConfig: &OIDCApp{
+							TLSClientCertificates:    database.TextArray[string]{},
 							Version:                  domain.OIDCVersionV1,
 							ClientID:                 "oidc-client-id",
 							RedirectURIs:             database.TextArray[string]{"https://redirect.to/me"},
@@ -751,6 +835,15 @@ func Test_AppsPrepare(t *testing.T) {
 							"back.channel.logout.ch",
 							domain.LoginVersionUnspecified,
 							nil,
+							false,
+							false,
+							nil,
+							false,
+							nil,
+							nil,
+							false,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
@@ -777,6 +870,7 @@ func Test_AppsPrepare(t *testing.T) {
 						Name:          "app-name",
 						ProjectID:     "project-id",
 						OIDCConfig: &OIDCApp{
+							TLSClientCertificates:    database.TextArray[string]{},
 							Version:                  domain.OIDCVersionV1,
 							ClientID:                 "oidc-client-id",
 							RedirectURIs:             database.TextArray[string]{"https://redirect.to/me"},
@@ -845,6 +939,15 @@ func Test_AppsPrepare(t *testing.T) {
 							"back.channel.logout.ch",
 							domain.LoginVersionUnspecified,
 							nil,
+							false,
+							false,
+							nil,
+							false,
+							nil,
+							nil,
+							false,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
@@ -871,6 +974,7 @@ func Test_AppsPrepare(t *testing.T) {
 						Name:          "app-name",
 						ProjectID:     "project-id",
 						OIDCConfig: &OIDCApp{
+							TLSClientCertificates:    database.TextArray[string]{},
 							Version:                  domain.OIDCVersionV1,
 							ClientID:                 "oidc-client-id",
 							RedirectURIs:             database.TextArray[string]{"https://redirect.to/me"},
@@ -939,6 +1043,15 @@ func Test_AppsPrepare(t *testing.T) {
 							"back.channel.logout.ch",
 							domain.LoginVersionUnspecified,
 							nil,
+							false,
+							false,
+							nil,
+							false,
+							nil,
+							nil,
+							false,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
@@ -965,6 +1078,7 @@ func Test_AppsPrepare(t *testing.T) {
 						Name:          "app-name",
 						ProjectID:     "project-id",
 						OIDCConfig: &OIDCApp{
+							TLSClientCertificates:    database.TextArray[string]{},
 							Version:                  domain.OIDCVersionV1,
 							ClientID:                 "oidc-client-id",
 							RedirectURIs:             database.TextArray[string]{"https://redirect.to/me"},
@@ -1033,6 +1147,15 @@ func Test_AppsPrepare(t *testing.T) {
 							"back.channel.logout.ch",
 							domain.LoginVersion2,
 							"https://login.ch/",
+							false,
+							false,
+							nil,
+							false,
+							nil,
+							nil,
+							false,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
@@ -1075,6 +1198,15 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
@@ -1117,6 +1249,15 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							"saml-app-id",
 							"https://test.com/saml/metadata",
@@ -1143,6 +1284,7 @@ func Test_AppsPrepare(t *testing.T) {
 						Name:          "app-name",
 						ProjectID:     "project-id",
 						OIDCConfig: &OIDCApp{
+							TLSClientCertificates:    database.TextArray[string]{},
 							Version:                  domain.OIDCVersionV1,
 							ClientID:                 "oidc-client-id",
 							RedirectURIs:             database.TextArray[string]{"https://redirect.to/me"},
@@ -1302,6 +1444,15 @@ func Test_AppPrepare(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
 						// saml config
 						nil,
 						nil,
@@ -1367,6 +1518,15 @@ func Test_AppPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
@@ -1437,6 +1597,15 @@ func Test_AppPrepare(t *testing.T) {
 							"back.channel.logout.ch",
 							domain.LoginVersionUnspecified,
 							nil,
+							false,
+							false,
+							nil,
+							false,
+							nil,
+							nil,
+							false,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
@@ -1458,6 +1627,7 @@ func Test_AppPrepare(t *testing.T) {
 				Name:          "app-name",
 				ProjectID:     "project-id",
 				OIDCConfig: &OIDCApp{
+					TLSClientCertificates:    database.TextArray[string]{},
 					Version:                  domain.OIDCVersionV1,
 					ClientID:                 "oidc-client-id",
 					RedirectURIs:             database.TextArray[string]{"https://redirect.to/me"},
@@ -1526,6 +1696,15 @@ func Test_AppPrepare(t *testing.T) {
 							"back.channel.logout.ch",
 							domain.LoginVersionUnspecified,
 							nil,
+							false,
+							false,
+							nil,
+							false,
+							nil,
+							nil,
+							false,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
@@ -1547,6 +1726,7 @@ func Test_AppPrepare(t *testing.T) {
 				Name:          "app-name",
 				ProjectID:     "project-id",
 				OIDCConfig: &OIDCApp{
+					TLSClientCertificates:    database.TextArray[string]{},
 					Version:                  domain.OIDCVersionV1,
 					ClientID:                 "oidc-client-id",
 					RedirectURIs:             database.TextArray[string]{"https://redirect.to/me"},
@@ -1615,6 +1795,15 @@ func Test_AppPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							"app-id",
 							"https://test.com/saml/metadata",
@@ -1688,6 +1877,15 @@ func Test_AppPrepare(t *testing.T) {
 							"back.channel.logout.ch",
 							domain.LoginVersionUnspecified,
 							nil,
+							false,
+							false,
+							nil,
+							false,
+							nil,
+							nil,
+							false,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
@@ -1709,6 +1907,7 @@ func Test_AppPrepare(t *testing.T) {
 				Name:          "app-name",
 				ProjectID:     "project-id",
 				OIDCConfig: &OIDCApp{
+					TLSClientCertificates:    database.TextArray[string]{},
 					Version:                  domain.OIDCVersionV1,
 					ClientID:                 "oidc-client-id",
 					RedirectURIs:             database.TextArray[string]{"https://redirect.to/me"},
@@ -1777,6 +1976,15 @@ func Test_AppPrepare(t *testing.T) {
 							"back.channel.logout.ch",
 							domain.LoginVersionUnspecified,
 							nil,
+							false,
+							false,
+							nil,
+							false,
+							nil,
+							nil,
+							false,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
@@ -1798,6 +2006,7 @@ func Test_AppPrepare(t *testing.T) {
 				Name:          "app-name",
 				ProjectID:     "project-id",
 				OIDCConfig: &OIDCApp{
+					TLSClientCertificates:    database.TextArray[string]{},
 					Version:                  domain.OIDCVersionV1,
 					ClientID:                 "oidc-client-id",
 					RedirectURIs:             database.TextArray[string]{"https://redirect.to/me"},
@@ -1866,6 +2075,15 @@ func Test_AppPrepare(t *testing.T) {
 							"back.channel.logout.ch",
 							domain.LoginVersionUnspecified,
 							nil,
+							false,
+							false,
+							nil,
+							false,
+							nil,
+							nil,
+							false,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
@@ -1887,6 +2105,7 @@ func Test_AppPrepare(t *testing.T) {
 				Name:          "app-name",
 				ProjectID:     "project-id",
 				OIDCConfig: &OIDCApp{
+					TLSClientCertificates:    database.TextArray[string]{},
 					Version:                  domain.OIDCVersionV1,
 					ClientID:                 "oidc-client-id",
 					RedirectURIs:             database.TextArray[string]{"https://redirect.to/me"},
@@ -1955,6 +2174,15 @@ func Test_AppPrepare(t *testing.T) {
 							"back.channel.logout.ch",
 							domain.LoginVersionUnspecified,
 							nil,
+							false,
+							false,
+							nil,
+							false,
+							nil,
+							nil,
+							false,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
@@ -1976,6 +2204,7 @@ func Test_AppPrepare(t *testing.T) {
 				Name:          "app-name",
 				ProjectID:     "project-id",
 				OIDCConfig: &OIDCApp{
+					TLSClientCertificates:    database.TextArray[string]{},
 					Version:                  domain.OIDCVersionV1,
 					ClientID:                 "oidc-client-id",
 					RedirectURIs:             database.TextArray[string]{"https://redirect.to/me"},
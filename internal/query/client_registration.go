@@ -0,0 +1,155 @@
+package query
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/clientregistration"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// ClientRegistrationConfig is the configuration of the dynamic client registration (RFC 7591) of the instance.
+type ClientRegistrationConfig struct {
+	ProjectID                string
+	ProjectResourceOwner     string
+	SoftwareStatementIssuers []*clientregistration.SoftwareStatementIssuer
+
+	ResourceOwner string
+	CreationDate  time.Time
+	ChangeDate    time.Time
+	Sequence      uint64
+}
+
+// SoftwareStatementIssuer returns the trusted issuer of software statements or nil.
+func (c *ClientRegistrationConfig) SoftwareStatementIssuer(issuer string) *clientregistration.SoftwareStatementIssuer {
+	i := slices.IndexFunc(c.SoftwareStatementIssuers, func(s *clientregistration.SoftwareStatementIssuer) bool {
+		return s.Issuer == issuer
+	})
+	if i < 0 {
+		return nil
+	}
+	return c.SoftwareStatementIssuers[i]
+}
+
+// ClientRegistrationInitialAccessToken allows clients to register themselves.
+// The token itself is only returned on creation.
+type ClientRegistrationInitialAccessToken struct {
+	ID             string
+	Description    string
+	CreationDate   time.Time
+	ExpirationDate time.Time
+}
+
+// ClientRegistrationConfig returns the configuration of the dynamic client registration
+// or a not found error if the registration is not enabled for the instance.
+func (q *Queries) ClientRegistrationConfig(ctx context.Context) (_ *ClientRegistrationConfig, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	readModel, err := q.clientRegistrationReadModel(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !readModel.enabled {
+		return nil, zerrors.ThrowNotFound(nil, "QUERY-Ohm1u", "Errors.ClientRegistration.NotConfigured")
+	}
+	return &ClientRegistrationConfig{
+		ProjectID:                readModel.projectID,
+		ProjectResourceOwner:     readModel.projectResourceOwner,
+		SoftwareStatementIssuers: readModel.softwareStatementIssuers,
+		ResourceOwner:            readModel.ResourceOwner,
+		CreationDate:             readModel.CreationDate,
+		ChangeDate:               readModel.ChangeDate,
+		Sequence:                 readModel.ProcessedSequence,
+	}, nil
+}
+
+// ClientRegistrationInitialAccessTokens returns the initial access tokens of the instance, including expired ones,
+// ordered by their creation.
+func (q *Queries) ClientRegistrationInitialAccessTokens(ctx context.Context) (_ []*ClientRegistrationInitialAccessToken, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	readModel, err := q.clientRegistrationReadModel(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return readModel.initialAccessTokens, nil
+}
+
+func (q *Queries) clientRegistrationReadModel(ctx context.Context) (_ *clientRegistrationReadModel, err error) {
+	readModel := newClientRegistrationReadModel(authz.GetInstance(ctx).InstanceID())
+	if err = q.eventstore.FilterToQueryReducer(ctx, readModel); err != nil {
+		return nil, err
+	}
+	return readModel, nil
+}
+
+type clientRegistrationReadModel struct {
+	eventstore.ReadModel
+
+	enabled                  bool
+	projectID                string
+	projectResourceOwner     string
+	softwareStatementIssuers []*clientregistration.SoftwareStatementIssuer
+	initialAccessTokens      []*ClientRegistrationInitialAccessToken
+}
+
+func newClientRegistrationReadModel(instanceID string) *clientRegistrationReadModel {
+	return &clientRegistrationReadModel{
+		ReadModel: eventstore.ReadModel{
+			AggregateID:   instanceID,
+			ResourceOwner: instanceID,
+			InstanceID:    instanceID,
+		},
+	}
+}
+
+func (rm *clientRegistrationReadModel) Reduce() error {
+	for _, event := range rm.Events {
+		switch e := event.(type) {
+		case *clientregistration.ConfigSetEvent:
+			rm.enabled = true
+			rm.projectID = e.ProjectID
+			rm.projectResourceOwner = e.ProjectResourceOwner
+			rm.softwareStatementIssuers = e.SoftwareStatementIssuers
+		case *clientregistration.ConfigRemovedEvent:
+			rm.enabled = false
+			rm.projectID = ""
+			rm.projectResourceOwner = ""
+			rm.softwareStatementIssuers = nil
+		case *clientregistration.InitialAccessTokenAddedEvent:
+			rm.initialAccessTokens = append(rm.initialAccessTokens, &ClientRegistrationInitialAccessToken{
+				ID:             e.TokenID,
+				Description:    e.Description,
+				CreationDate:   e.CreatedAt(),
+				ExpirationDate: e.ExpirationDate,
+			})
+		case *clientregistration.InitialAccessTokenRemovedEvent:
+			rm.initialAccessTokens = slices.DeleteFunc(rm.initialAccessTokens, func(token *ClientRegistrationInitialAccessToken) bool {
+				return token.ID == e.TokenID
+			})
+		}
+	}
+	return rm.ReadModel.Reduce()
+}
+
+func (rm *clientRegistrationReadModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(rm.ResourceOwner).
+		AwaitOpenTransactions().
+		AddQuery().
+		AggregateTypes(clientregistration.AggregateType).
+		AggregateIDs(rm.AggregateID).
+		EventTypes(
+			clientregistration.ConfigSetEventType,
+			clientregistration.ConfigRemovedEventType,
+			clientregistration.InitialAccessTokenAddedEventType,
+			clientregistration.InitialAccessTokenRemovedEventType,
+		).
+		Builder()
+}
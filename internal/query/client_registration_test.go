@@ -0,0 +1,131 @@
+package query
+
+import (
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/clientregistration"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestQueries_ClientRegistrationConfig(t *testing.T) {
+	ctx := authz.NewMockContextWithPermissions("instance1", "org1", "user1", nil)
+	issuers := []*clientregistration.SoftwareStatementIssuer{
+		{Issuer: "https://issuer.example.com", JWKS: []byte(`{"keys":[]}`)},
+	}
+	tests := []struct {
+		name       string
+		eventstore func(*testing.T) *eventstore.Eventstore
+		want       *ClientRegistrationConfig
+		wantErr    error
+	}{
+		{
+			name: "filter error",
+			eventstore: expectEventstore(
+				expectFilterError(io.ErrClosedPipe),
+			),
+			wantErr: io.ErrClosedPipe,
+		},
+		{
+			name: "not configured, not found error",
+			eventstore: expectEventstore(
+				expectFilter(),
+			),
+			wantErr: zerrors.ThrowNotFound(nil, "QUERY-Ohm1u", "Errors.ClientRegistration.NotConfigured"),
+		},
+		{
+			name: "removed, not found error",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(clientregistration.NewConfigSetEvent(ctx,
+						clientregistration.NewAggregate("instance1"),
+						"project1",
+						"org1",
+						issuers,
+					)),
+					eventFromEventPusher(clientregistration.NewConfigRemovedEvent(ctx,
+						clientregistration.NewAggregate("instance1"),
+					)),
+				),
+			),
+			wantErr: zerrors.ThrowNotFound(nil, "QUERY-Ohm1u", "Errors.ClientRegistration.NotConfigured"),
+		},
+		{
+			name: "ok",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(clientregistration.NewConfigSetEvent(ctx,
+						clientregistration.NewAggregate("instance1"),
+						"project1",
+						"org1",
+						issuers,
+					)),
+				),
+			),
+			want: &ClientRegistrationConfig{
+				ProjectID:                "project1",
+				ProjectResourceOwner:     "org1",
+				SoftwareStatementIssuers: issuers,
+				ResourceOwner:            "instance1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := &Queries{
+				eventstore: tt.eventstore(t),
+			}
+			got, err := q.ClientRegistrationConfig(ctx)
+			require.ErrorIs(t, err, tt.wantErr)
+			if tt.wantErr != nil {
+				return
+			}
+			// dates and sequences are set by the eventstore
+			got.CreationDate, got.ChangeDate, got.Sequence = time.Time{}, time.Time{}, 0
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, issuers[0], got.SoftwareStatementIssuer("https://issuer.example.com"))
+			assert.Nil(t, got.SoftwareStatementIssuer("https://other.example.com"))
+		})
+	}
+}
+
+func TestQueries_ClientRegistrationInitialAccessTokens(t *testing.T) {
+	ctx := authz.NewMockContextWithPermissions("instance1", "org1", "user1", nil)
+	expiration := time.Now().Add(time.Hour).UTC()
+	q := &Queries{
+		eventstore: expectEventstore(
+			expectFilter(
+				eventFromEventPusher(clientregistration.NewInitialAccessTokenAddedEvent(ctx,
+					clientregistration.NewAggregate("instance1"),
+					"token1",
+					"hash1",
+					"first",
+					expiration,
+				)),
+				eventFromEventPusher(clientregistration.NewInitialAccessTokenAddedEvent(ctx,
+					clientregistration.NewAggregate("instance1"),
+					"token2",
+					"hash2",
+					"second",
+					expiration,
+				)),
+				eventFromEventPusher(clientregistration.NewInitialAccessTokenRemovedEvent(ctx,
+					clientregistration.NewAggregate("instance1"),
+					"token1",
+				)),
+			),
+		)(t),
+	}
+	got, err := q.ClientRegistrationInitialAccessTokens(ctx)
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	assert.Equal(t, "token2", got[0].ID)
+	assert.Equal(t, "second", got[0].Description)
+	assert.True(t, expiration.Equal(got[0].ExpirationDate))
+}
@@ -91,6 +91,11 @@ func (q *Queries) DomainPolicyByOrg(ctx context.Context, shouldTriggerBulk bool,
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
+	chain, err := q.orgPolicyChain(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+
 	if shouldTriggerBulk {
 		_, traceSpan := tracing.NewNamedSpan(ctx, "TriggerDomainPolicyProjection")
 		ctx, err = projection.DomainPolicyProjection.Trigger(ctx, handler.WithAwaitRunning())
@@ -99,10 +104,7 @@ func (q *Queries) DomainPolicyByOrg(ctx context.Context, shouldTriggerBulk bool,
 	}
 	eq := sq.And{
 		sq.Eq{DomainPolicyColInstanceID.identifier(): authz.GetInstance(ctx).InstanceID()},
-		sq.Or{
-			sq.Eq{DomainPolicyColID.identifier(): orgID},
-			sq.Eq{DomainPolicyColID.identifier(): authz.GetInstance(ctx).InstanceID()},
-		},
+		orgPolicyChainCondition(DomainPolicyColID, chain, authz.GetInstance(ctx).InstanceID()),
 	}
 	if !withOwnerRemoved {
 		eq = sq.And{
@@ -118,7 +120,7 @@ func (q *Queries) DomainPolicyByOrg(ctx context.Context, shouldTriggerBulk bool,
 	}
 
 	stmt, scan := prepareDomainPolicyQuery()
-	query, args, err := stmt.Where(eq).OrderBy(DomainPolicyColIsDefault.identifier()).
+	query, args, err := stmt.Where(eq).OrderByClause(orgPolicyChainOrder(DomainPolicyColID, DomainPolicyColIsDefault, chain)).
 		Limit(1).ToSql()
 	if err != nil {
 		return nil, zerrors.ThrowInternal(err, "QUERY-D3CqT", "Errors.Query.SQLStatement")
@@ -4,6 +4,8 @@ import (
 	"context"
 	"time"
 
+	"github.com/shopspring/decimal"
+
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/api/call"
 	"github.com/zitadel/zitadel/internal/eventstore"
@@ -15,6 +17,7 @@ type Event struct {
 	Aggregate    *eventstore.Aggregate
 	Sequence     uint64
 	CreationDate time.Time
+	Position     decimal.Decimal
 	Type         string
 	Payload      []byte
 }
@@ -43,13 +46,7 @@ func (r *eventsReducer) Reduce() error { return nil }
 func (q *Queries) SearchEvents(ctx context.Context, query *eventstore.SearchQueryBuilder) (_ []*Event, err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
-	auditLogRetention := q.defaultAuditLogRetention
-	if instanceAuditLogRetention := authz.GetInstance(ctx).AuditLogRetention(); instanceAuditLogRetention != nil {
-		auditLogRetention = *instanceAuditLogRetention
-	}
-	if auditLogRetention != 0 {
-		query = filterAuditLogRetention(ctx, auditLogRetention, query)
-	}
+	query = q.withAuditLogRetention(ctx, query)
 	reducer := &eventsReducer{ctx: ctx, q: q, editors: make(map[string]*EventEditor, query.GetLimit())}
 	if err = q.eventstore.FilterToReducer(ctx, query, reducer); err != nil {
 		return nil, err
@@ -57,6 +54,18 @@ func (q *Queries) SearchEvents(ctx context.Context, query *eventstore.SearchQuer
 	return reducer.events, nil
 }
 
+// withAuditLogRetention restricts the query to the events within the audit log retention of the instance.
+func (q *Queries) withAuditLogRetention(ctx context.Context, builder *eventstore.SearchQueryBuilder) *eventstore.SearchQueryBuilder {
+	auditLogRetention := q.defaultAuditLogRetention
+	if instanceAuditLogRetention := authz.GetInstance(ctx).AuditLogRetention(); instanceAuditLogRetention != nil {
+		auditLogRetention = *instanceAuditLogRetention
+	}
+	if auditLogRetention == 0 {
+		return builder
+	}
+	return filterAuditLogRetention(ctx, auditLogRetention, builder)
+}
+
 func filterAuditLogRetention(ctx context.Context, auditLogRetention time.Duration, builder *eventstore.SearchQueryBuilder) *eventstore.SearchQueryBuilder {
 	callTime := call.FromContext(ctx)
 	if callTime.IsZero() {
@@ -105,6 +114,7 @@ func (er *eventsReducer) convertEvent(ctx context.Context, event eventstore.Even
 		Aggregate:    event.Aggregate(),
 		Sequence:     event.Sequence(),
 		CreationDate: event.CreatedAt(),
+		Position:     event.Position(),
 		Type:         string(event.Type()),
 		Payload:      event.DataAsBytes(),
 	}
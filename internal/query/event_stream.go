@@ -0,0 +1,152 @@
+package query
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	eventStreamDefaultBatchSize    = 200
+	eventStreamDefaultPollInterval = time.Second
+)
+
+// EventStreamCursor identifies the last streamed event.
+// Events pushed in the same transaction share the position and are distinguished by the offset,
+// which is the number of events already streamed at that position.
+type EventStreamCursor struct {
+	Position decimal.Decimal
+	Offset   uint32
+}
+
+// String returns the cursor in the format `<position>:<offset>`.
+func (c EventStreamCursor) String() string {
+	return c.Position.String() + ":" + strconv.FormatUint(uint64(c.Offset), 10)
+}
+
+// next returns the cursor after the event at the given position.
+func (c EventStreamCursor) next(position decimal.Decimal) EventStreamCursor {
+	if position.Equal(c.Position) {
+		return EventStreamCursor{Position: c.Position, Offset: c.Offset + 1}
+	}
+	return EventStreamCursor{Position: position, Offset: 1}
+}
+
+// ParseEventStreamCursor parses a cursor returned by [EventStreamCursor.String].
+// An empty string returns the cursor of the beginning of the eventstore.
+func ParseEventStreamCursor(cursor string) (EventStreamCursor, error) {
+	if cursor == "" {
+		return EventStreamCursor{}, nil
+	}
+	position, offset, ok := strings.Cut(cursor, ":")
+	if !ok {
+		return EventStreamCursor{}, zerrors.ThrowInvalidArgument(nil, "QUERY-Aeph3", "Errors.Event.InvalidCursor")
+	}
+	pos, err := decimal.NewFromString(position)
+	if err != nil || pos.IsNegative() {
+		return EventStreamCursor{}, zerrors.ThrowInvalidArgument(err, "QUERY-ouR4i", "Errors.Event.InvalidCursor")
+	}
+	off, err := strconv.ParseUint(offset, 10, 32)
+	if err != nil {
+		return EventStreamCursor{}, zerrors.ThrowInvalidArgument(err, "QUERY-Jah0e", "Errors.Event.InvalidCursor")
+	}
+	return EventStreamCursor{Position: pos, Offset: uint32(off)}, nil
+}
+
+// EventStreamQuery filters the events of the instance of the context.
+// A cursor is only valid for the filter it was returned for.
+type EventStreamQuery struct {
+	AggregateTypes []eventstore.AggregateType
+	EventTypes     []eventstore.EventType
+	ResourceOwner  string
+	From           EventStreamCursor
+
+	BatchSize    uint32
+	PollInterval time.Duration
+}
+
+func (q *EventStreamQuery) batchSize() uint32 {
+	if q.BatchSize == 0 {
+		return eventStreamDefaultBatchSize
+	}
+	return q.BatchSize
+}
+
+func (q *EventStreamQuery) pollInterval() time.Duration {
+	if q.PollInterval == 0 {
+		return eventStreamDefaultPollInterval
+	}
+	return q.PollInterval
+}
+
+func (q *EventStreamQuery) builder(ctx context.Context, cursor EventStreamCursor) *eventstore.SearchQueryBuilder {
+	builder := eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		// events of open transactions must not be skipped,
+		// as they would get a lower position than the events already streamed
+		AwaitOpenTransactions().
+		OrderAsc().
+		InstanceID(authz.GetInstance(ctx).InstanceID()).
+		ResourceOwner(q.ResourceOwner).
+		Limit(uint64(q.batchSize()))
+	if cursor.Position.IsPositive() {
+		builder = builder.PositionAtLeast(cursor.Position)
+		if cursor.Offset > 0 {
+			builder = builder.Offset(cursor.Offset)
+		}
+	}
+	if len(q.AggregateTypes) > 0 || len(q.EventTypes) > 0 {
+		builder = builder.AddQuery().
+			AggregateTypes(q.AggregateTypes...).
+			EventTypes(q.EventTypes...).
+			Builder()
+	}
+	return builder
+}
+
+// StreamEvents reads the events matching the query in the order of their position and passes each of them to send,
+// together with the cursor to resume the stream after the event.
+// Once all events are read, the eventstore is polled in the poll interval of the query.
+// As all replicas share the eventstore, events pushed by any replica are streamed.
+// StreamEvents returns when ctx is done or send returns an error.
+func (q *Queries) StreamEvents(ctx context.Context, query *EventStreamQuery, send func(event *Event, cursor EventStreamCursor) error) error {
+	cursor := query.From
+	ticker := time.NewTicker(query.pollInterval())
+	defer ticker.Stop()
+	for {
+		events, err := q.streamEventsBatch(ctx, query, cursor)
+		if err != nil {
+			return err
+		}
+		for _, event := range events {
+			cursor = cursor.next(event.Position)
+			if err = send(event, cursor); err != nil {
+				return err
+			}
+		}
+		// read the next batch immediately if the current one was full
+		if len(events) == int(query.batchSize()) {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (q *Queries) streamEventsBatch(ctx context.Context, query *EventStreamQuery, cursor EventStreamCursor) ([]*Event, error) {
+	builder := q.withAuditLogRetention(ctx, query.builder(ctx, cursor))
+	reducer := &eventsReducer{ctx: ctx, q: q, editors: make(map[string]*EventEditor)}
+	if err := q.eventstore.FilterToReducer(ctx, builder, reducer); err != nil {
+		return nil, err
+	}
+	return reducer.events, nil
+}
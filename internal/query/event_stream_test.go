@@ -0,0 +1,101 @@
+package query
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestParseEventStreamCursor(t *testing.T) {
+	tests := []struct {
+		name    string
+		cursor  string
+		want    EventStreamCursor
+		wantErr error
+	}{
+		{
+			name:   "empty",
+			cursor: "",
+			want:   EventStreamCursor{},
+		},
+		{
+			name:   "valid",
+			cursor: "1714652362.417631:2",
+			want:   EventStreamCursor{Position: decimal.RequireFromString("1714652362.417631"), Offset: 2},
+		},
+		{
+			name:    "missing offset",
+			cursor:  "1714652362.417631",
+			wantErr: zerrors.ThrowInvalidArgument(nil, "QUERY-Aeph3", "Errors.Event.InvalidCursor"),
+		},
+		{
+			name:    "invalid position",
+			cursor:  "position:2",
+			wantErr: zerrors.ThrowInvalidArgument(nil, "QUERY-ouR4i", "Errors.Event.InvalidCursor"),
+		},
+		{
+			name:    "negative position",
+			cursor:  "-1:2",
+			wantErr: zerrors.ThrowInvalidArgument(nil, "QUERY-ouR4i", "Errors.Event.InvalidCursor"),
+		},
+		{
+			name:    "invalid offset",
+			cursor:  "1714652362.417631:-2",
+			wantErr: zerrors.ThrowInvalidArgument(nil, "QUERY-Jah0e", "Errors.Event.InvalidCursor"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseEventStreamCursor(tt.cursor)
+			require.ErrorIs(t, err, tt.wantErr)
+			if tt.wantErr != nil {
+				return
+			}
+			assert.True(t, tt.want.Position.Equal(got.Position))
+			assert.Equal(t, tt.want.Offset, got.Offset)
+		})
+	}
+}
+
+func TestEventStreamCursor_next(t *testing.T) {
+	position := decimal.RequireFromString("1714652362.417631")
+	tests := []struct {
+		name     string
+		cursor   EventStreamCursor
+		position decimal.Decimal
+		want     string
+	}{
+		{
+			name:     "start",
+			cursor:   EventStreamCursor{},
+			position: position,
+			want:     "1714652362.417631:1",
+		},
+		{
+			name:     "same position",
+			cursor:   EventStreamCursor{Position: position, Offset: 1},
+			position: position,
+			want:     "1714652362.417631:2",
+		},
+		{
+			name:     "next position",
+			cursor:   EventStreamCursor{Position: position, Offset: 3},
+			position: decimal.RequireFromString("1714652363"),
+			want:     "1714652363:1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.cursor.next(tt.position)
+			assert.Equal(t, tt.want, got.String())
+			parsed, err := ParseEventStreamCursor(got.String())
+			require.NoError(t, err)
+			assert.Equal(t, got.Offset, parsed.Offset)
+			assert.True(t, got.Position.Equal(parsed.Position))
+		})
+	}
+}
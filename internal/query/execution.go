@@ -182,6 +182,9 @@ func (q *Queries) TargetsByExecutionID(ctx context.Context, ids []string) (execu
 		if err := execution[i].decryptSigningKey(q.targetEncryptionAlgorithm); err != nil {
 			return nil, err
 		}
+		if err := execution[i].decryptClientKey(q.targetEncryptionAlgorithm); err != nil {
+			return nil, err
+		}
 	}
 	return execution, err
 }
@@ -217,6 +220,9 @@ func (q *Queries) TargetsByExecutionIDs(ctx context.Context, ids1, ids2 []string
 		if err := execution[i].decryptSigningKey(q.targetEncryptionAlgorithm); err != nil {
 			return nil, err
 		}
+		if err := execution[i].decryptClientKey(q.targetEncryptionAlgorithm); err != nil {
+			return nil, err
+		}
 	}
 	return execution, err
 }
@@ -369,6 +375,11 @@ type ExecutionTarget struct {
 	InterruptOnError bool
 	signingKey       *crypto.CryptoValue
 	SigningKey       string
+	// ClientCertificate, ClientKey and RootCA are only set for gRPC targets using TLS.
+	ClientCertificate []byte
+	clientKey         *crypto.CryptoValue
+	ClientKey         []byte
+	RootCA            []byte
 }
 
 func (e *ExecutionTarget) GetExecutionID() string {
@@ -392,6 +403,15 @@ func (e *ExecutionTarget) GetTimeout() time.Duration {
 func (e *ExecutionTarget) GetSigningKey() string {
 	return e.SigningKey
 }
+func (e *ExecutionTarget) GetClientCertificate() []byte {
+	return e.ClientCertificate
+}
+func (e *ExecutionTarget) GetClientKey() []byte {
+	return e.ClientKey
+}
+func (e *ExecutionTarget) GetRootCA() []byte {
+	return e.RootCA
+}
 
 func (t *ExecutionTarget) decryptSigningKey(alg crypto.EncryptionAlgorithm) error {
 	if t.signingKey == nil {
@@ -405,6 +425,18 @@ func (t *ExecutionTarget) decryptSigningKey(alg crypto.EncryptionAlgorithm) erro
 	return nil
 }
 
+func (t *ExecutionTarget) decryptClientKey(alg crypto.EncryptionAlgorithm) error {
+	if t.clientKey == nil {
+		return nil
+	}
+	keyValue, err := crypto.Decrypt(t.clientKey, alg)
+	if err != nil {
+		return zerrors.ThrowInternal(err, "QUERY-Aeb3o", "Errors.Internal")
+	}
+	t.ClientKey = keyValue
+	return nil
+}
+
 func scanExecutionTargets(rows *sql.Rows) ([]*ExecutionTarget, error) {
 	targets := make([]*ExecutionTarget, 0)
 	for rows.Next() {
@@ -419,6 +451,7 @@ func scanExecutionTargets(rows *sql.Rows) ([]*ExecutionTarget, error) {
 			timeout          = &sql.NullInt64{}
 			interruptOnError = &sql.NullBool{}
 			signingKey       = &crypto.CryptoValue{}
+			clientKey        = &crypto.CryptoValue{}
 		)
 
 		err := rows.Scan(
@@ -430,6 +463,9 @@ func scanExecutionTargets(rows *sql.Rows) ([]*ExecutionTarget, error) {
 			timeout,
 			interruptOnError,
 			signingKey,
+			&target.ClientCertificate,
+			clientKey,
+			&target.RootCA,
 		)
 
 		if err != nil {
@@ -444,6 +480,9 @@ func scanExecutionTargets(rows *sql.Rows) ([]*ExecutionTarget, error) {
 		target.Timeout = time.Duration(timeout.Int64)
 		target.InterruptOnError = interruptOnError.Bool
 		target.signingKey = signingKey
+		if len(clientKey.Crypted) > 0 {
+			target.clientKey = clientKey
+		}
 
 		targets = append(targets, target)
 	}
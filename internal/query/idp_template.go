@@ -3,6 +3,7 @@ package query
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"time"
 
@@ -144,9 +145,33 @@ type LDAPIDPTemplate struct {
 	UserFilters       []string
 	Timeout           time.Duration
 	RootCA            []byte
+	GroupSync         *idp.LDAPGroupSync
 	idp.LDAPAttributes
 }
 
+// ldapGroupSyncScanner scans the group sync settings of an LDAP IdP stored as JSON.
+type ldapGroupSyncScanner struct {
+	groupSync *idp.LDAPGroupSync
+}
+
+func (s *ldapGroupSyncScanner) Scan(src any) error {
+	var data []byte
+	switch v := src.(type) {
+	case nil:
+		return nil
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	default:
+		return zerrors.ThrowInternal(nil, "QUERY-aeK3u", "Errors.Internal")
+	}
+	if len(data) == 0 {
+		return nil
+	}
+	return json.Unmarshal(data, &s.groupSync)
+}
+
 type AppleIDPTemplate struct {
 	IDPID      string
 	ClientID   string
@@ -647,6 +672,10 @@ var (
 		name:  projection.LDAPProfileAttributeCol,
 		table: ldapIdpTemplateTable,
 	}
+	LDAPGroupSyncCol = Column{
+		name:  projection.LDAPGroupSyncCol,
+		table: ldapIdpTemplateTable,
+	}
 )
 
 var (
@@ -824,6 +853,10 @@ func NewIDPTemplateIDSearchQuery(id string) (SearchQuery, error) {
 	return NewTextQuery(IDPTemplateIDCol, id, TextEquals)
 }
 
+func NewIDPTemplateTypeSearchQuery(idpType domain.IDPType) (SearchQuery, error) {
+	return NewNumberQuery(IDPTemplateTypeCol, idpType, NumberEquals)
+}
+
 func NewIDPTemplateOwnerTypeSearchQuery(ownerType domain.IdentityProviderType) (SearchQuery, error) {
 	return NewNumberQuery(IDPTemplateOwnerTypeCol, ownerType, NumberEquals)
 }
@@ -979,6 +1012,7 @@ func prepareIDPTemplateByIDQuery() (sq.SelectBuilder, func(*sql.Row) (*IDPTempla
 			LDAPPreferredLanguageAttributeCol.identifier(),
 			LDAPAvatarURLAttributeCol.identifier(),
 			LDAPProfileAttributeCol.identifier(),
+			LDAPGroupSyncCol.identifier(),
 			// apple
 			AppleIDCol.identifier(),
 			AppleClientIDCol.identifier(),
@@ -1099,6 +1133,7 @@ func prepareIDPTemplateByIDQuery() (sq.SelectBuilder, func(*sql.Row) (*IDPTempla
 			ldapPreferredLanguageAttribute := sql.NullString{}
 			ldapAvatarURLAttribute := sql.NullString{}
 			ldapProfileAttribute := sql.NullString{}
+			ldapGroupSync := ldapGroupSyncScanner{}
 
 			appleID := sql.NullString{}
 			appleClientID := sql.NullString{}
@@ -1217,6 +1252,7 @@ func prepareIDPTemplateByIDQuery() (sq.SelectBuilder, func(*sql.Row) (*IDPTempla
 				&ldapPreferredLanguageAttribute,
 				&ldapAvatarURLAttribute,
 				&ldapProfileAttribute,
+				&ldapGroupSync,
 				// apple
 				&appleID,
 				&appleClientID,
@@ -1347,6 +1383,7 @@ func prepareIDPTemplateByIDQuery() (sq.SelectBuilder, func(*sql.Row) (*IDPTempla
 					UserFilters:       ldapUserFilters,
 					Timeout:           time.Duration(ldapTimeout.Int64),
 					RootCA:            ldapRootCA,
+					GroupSync:         ldapGroupSync.groupSync,
 					LDAPAttributes: idp.LDAPAttributes{
 						IDAttribute:                ldapIDAttribute.String,
 						FirstNameAttribute:         ldapFirstNameAttribute.String,
@@ -1491,6 +1528,7 @@ func prepareIDPTemplatesQuery() (sq.SelectBuilder, func(*sql.Rows) (*IDPTemplate
 			LDAPPreferredLanguageAttributeCol.identifier(),
 			LDAPAvatarURLAttributeCol.identifier(),
 			LDAPProfileAttributeCol.identifier(),
+			LDAPGroupSyncCol.identifier(),
 			// apple
 			AppleIDCol.identifier(),
 			AppleClientIDCol.identifier(),
@@ -1616,6 +1654,7 @@ func prepareIDPTemplatesQuery() (sq.SelectBuilder, func(*sql.Rows) (*IDPTemplate
 				ldapPreferredLanguageAttribute := sql.NullString{}
 				ldapAvatarURLAttribute := sql.NullString{}
 				ldapProfileAttribute := sql.NullString{}
+				ldapGroupSync := ldapGroupSyncScanner{}
 
 				appleID := sql.NullString{}
 				appleClientID := sql.NullString{}
@@ -1734,6 +1773,7 @@ func prepareIDPTemplatesQuery() (sq.SelectBuilder, func(*sql.Rows) (*IDPTemplate
 					&ldapPreferredLanguageAttribute,
 					&ldapAvatarURLAttribute,
 					&ldapProfileAttribute,
+					&ldapGroupSync,
 					// apple
 					&appleID,
 					&appleClientID,
@@ -1863,6 +1903,7 @@ func prepareIDPTemplatesQuery() (sq.SelectBuilder, func(*sql.Rows) (*IDPTemplate
 						UserFilters:       ldapUserFilters,
 						Timeout:           time.Duration(ldapTimeout.Int64),
 						RootCA:            ldapRootCA,
+						GroupSync:         ldapGroupSync.groupSync,
 						LDAPAttributes: idp.LDAPAttributes{
 							IDAttribute:                ldapIDAttribute.String,
 							FirstNameAttribute:         ldapFirstNameAttribute.String,
@@ -125,6 +125,7 @@ var (
 		` projections.idp_templates6_ldap2.preferred_language_attribute,` +
 		` projections.idp_templates6_ldap2.avatar_url_attribute,` +
 		` projections.idp_templates6_ldap2.profile_attribute,` +
+		` projections.idp_templates6_ldap2.group_sync,` +
 		// apple
 		` projections.idp_templates6_apple.idp_id,` +
 		` projections.idp_templates6_apple.client_id,` +
@@ -255,6 +256,7 @@ var (
 		"preferred_language_attribute",
 		"avatar_url_attribute",
 		"profile_attribute",
+		"group_sync",
 		// apple config
 		"idp_id",
 		"client_id",
@@ -372,6 +374,7 @@ var (
 		` projections.idp_templates6_ldap2.preferred_language_attribute,` +
 		` projections.idp_templates6_ldap2.avatar_url_attribute,` +
 		` projections.idp_templates6_ldap2.profile_attribute,` +
+		` projections.idp_templates6_ldap2.group_sync,` +
 		// apple
 		` projections.idp_templates6_apple.idp_id,` +
 		` projections.idp_templates6_apple.client_id,` +
@@ -503,6 +506,7 @@ var (
 		"preferred_language_attribute",
 		"avatar_url_attribute",
 		"profile_attribute",
+		"group_sync",
 		// apple config
 		"idp_id",
 		"client_id",
@@ -660,6 +664,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
 						// apple
 						nil,
 						nil,
@@ -815,6 +820,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
 						// apple
 						nil,
 						nil,
@@ -968,6 +974,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
 						// apple
 						nil,
 						nil,
@@ -1119,6 +1126,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
 						// apple
 						nil,
 						nil,
@@ -1269,6 +1277,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
 						// apple
 						nil,
 						nil,
@@ -1419,6 +1428,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
 						// apple
 						nil,
 						nil,
@@ -1570,6 +1580,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
 						// apple
 						nil,
 						nil,
@@ -1720,6 +1731,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
 						// apple
 						nil,
 						nil,
@@ -1875,6 +1887,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						"lang",
 						"avatar",
 						"profile",
+						[]byte(`{"groupBaseDN":"groups","groupMemberAttribute":"member","groupMappings":[{"group":"cn=admins","projectId":"project","roleKeys":["admin"]}]}`),
 						// apple
 						nil,
 						nil,
@@ -1911,6 +1924,13 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 					UserFilters:       []string{"filter"},
 					Timeout:           time.Duration(30000000000),
 					RootCA:            []byte("certificate"),
+					GroupSync: &idp.LDAPGroupSync{
+						GroupBaseDN:          "groups",
+						GroupMemberAttribute: "member",
+						GroupMappings: []*idp.LDAPGroupMapping{
+							{Group: "cn=admins", ProjectID: "project", RoleKeys: []string{"admin"}},
+						},
+					},
 					LDAPAttributes: idp.LDAPAttributes{
 						IDAttribute:                "id",
 						FirstNameAttribute:         "first",
@@ -2046,6 +2066,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
 						// apple
 						"idp-id",
 						"client_id",
@@ -2198,6 +2219,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
 						// apple
 						nil,
 						nil,
@@ -2378,6 +2400,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							"lang",
 							"avatar",
 							"profile",
+							nil,
 							// apple
 							nil,
 							nil,
@@ -2558,6 +2581,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
 							// apple
 							nil,
 							nil,
@@ -2711,6 +2735,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							"lang",
 							"avatar",
 							"profile",
+							nil,
 							// apple
 							nil,
 							nil,
@@ -2829,6 +2854,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
 							// apple
 							nil,
 							nil,
@@ -2947,6 +2973,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
 							// apple
 							nil,
 							nil,
@@ -3065,6 +3092,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
 							// apple
 							nil,
 							nil,
@@ -3183,6 +3211,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
 							// apple
 							nil,
 							nil,
@@ -3301,6 +3330,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
 							// apple
 							nil,
 							nil,
@@ -46,6 +46,11 @@ func (q *Queries) ActiveLabelPolicyByOrg(ctx context.Context, orgID string, with
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
+	chain, err := q.orgPolicyChain(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+
 	stmt, scan := prepareLabelPolicyQuery()
 	eq := sq.Eq{
 		LabelPolicyColState.identifier():      domain.LabelPolicyStateActive,
@@ -56,13 +61,10 @@ func (q *Queries) ActiveLabelPolicyByOrg(ctx context.Context, orgID string, with
 	}
 	query, args, err := stmt.Where(
 		sq.And{
-			sq.Or{
-				sq.Eq{LabelPolicyColID.identifier(): orgID},
-				sq.Eq{LabelPolicyColID.identifier(): authz.GetInstance(ctx).InstanceID()},
-			},
+			orgPolicyChainCondition(LabelPolicyColID, chain, authz.GetInstance(ctx).InstanceID()),
 			eq,
 		}).
-		OrderBy(LabelPolicyColIsDefault.identifier()).
+		OrderByClause(orgPolicyChainOrder(LabelPolicyColID, LabelPolicyColIsDefault, chain)).
 		Limit(1).ToSql()
 	if err != nil {
 		return nil, zerrors.ThrowInternal(err, "QUERY-V22un", "unable to create sql stmt")
@@ -79,23 +81,21 @@ func (q *Queries) PreviewLabelPolicyByOrg(ctx context.Context, orgID string) (po
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
+	chain, err := q.orgPolicyChain(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+
 	stmt, scan := prepareLabelPolicyQuery()
 	query, args, err := stmt.Where(
 		sq.And{
-			sq.Or{
-				sq.Eq{
-					LabelPolicyColID.identifier(): orgID,
-				},
-				sq.Eq{
-					LabelPolicyColID.identifier(): authz.GetInstance(ctx).InstanceID(),
-				},
-			},
+			orgPolicyChainCondition(LabelPolicyColID, chain, authz.GetInstance(ctx).InstanceID()),
 			sq.Eq{
 				LabelPolicyColState.identifier():      domain.LabelPolicyStatePreview,
 				LabelPolicyColInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
 			},
 		}).
-		OrderBy(LabelPolicyColIsDefault.identifier()).
+		OrderByClause(orgPolicyChainOrder(LabelPolicyColID, LabelPolicyColIsDefault, chain)).
 		Limit(1).ToSql()
 	if err != nil {
 		return nil, zerrors.ThrowInternal(err, "QUERY-AG5eq", "unable to create sql stmt")
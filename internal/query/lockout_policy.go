@@ -87,6 +87,11 @@ func (q *Queries) LockoutPolicyByOrg(ctx context.Context, shouldTriggerBulk bool
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
+	chain, err := q.orgPolicyChain(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+
 	if shouldTriggerBulk {
 		_, traceSpan := tracing.NewNamedSpan(ctx, "TriggerLockoutPolicyProjection")
 		ctx, err = projection.LockoutPolicyProjection.Trigger(ctx, handler.WithAwaitRunning())
@@ -101,12 +106,9 @@ func (q *Queries) LockoutPolicyByOrg(ctx context.Context, shouldTriggerBulk bool
 	query, args, err := stmt.Where(
 		sq.And{
 			eq,
-			sq.Or{
-				sq.Eq{LockoutColID.identifier(): orgID},
-				sq.Eq{LockoutColID.identifier(): authz.GetInstance(ctx).InstanceID()},
-			},
+			orgPolicyChainCondition(LockoutColID, chain, authz.GetInstance(ctx).InstanceID()),
 		}).
-		OrderBy(LockoutColIsDefault.identifier()).
+		OrderByClause(orgPolicyChainOrder(LockoutColID, LockoutColIsDefault, chain)).
 		Limit(1).ToSql()
 	if err != nil {
 		return nil, zerrors.ThrowInternal(err, "QUERY-SKR6X", "Errors.Query.SQLStatement")
@@ -171,6 +171,11 @@ func (q *Queries) LoginPolicyByID(ctx context.Context, shouldTriggerBulk bool, o
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
+	chain, err := q.orgPolicyChain(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+
 	if shouldTriggerBulk {
 		_, traceSpan := tracing.NewNamedSpan(ctx, "TriggerLoginPolicyProjection")
 		ctx, err = projection.LoginPolicyProjection.Trigger(ctx, handler.WithAwaitRunning())
@@ -186,11 +191,8 @@ func (q *Queries) LoginPolicyByID(ctx context.Context, shouldTriggerBulk bool, o
 	stmt, args, err := query.Where(
 		sq.And{
 			eq,
-			sq.Or{
-				sq.Eq{LoginPolicyColumnOrgID.identifier(): orgID},
-				sq.Eq{LoginPolicyColumnOrgID.identifier(): authz.GetInstance(ctx).InstanceID()},
-			},
-		}).Limit(1).OrderBy(LoginPolicyColumnIsDefault.identifier()).ToSql()
+			orgPolicyChainCondition(LoginPolicyColumnOrgID, chain, authz.GetInstance(ctx).InstanceID()),
+		}).Limit(1).OrderByClause(orgPolicyChainOrder(LoginPolicyColumnOrgID, LoginPolicyColumnIsDefault, chain)).ToSql()
 	if err != nil {
 		return nil, zerrors.ThrowInternal(err, "QUERY-scVHo", "Errors.Query.SQLStatement")
 	}
@@ -241,22 +243,20 @@ func (q *Queries) SecondFactorsByOrg(ctx context.Context, orgID string) (factors
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
+	chain, err := q.orgPolicyChain(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+
 	query, scan := prepareLoginPolicy2FAsQuery()
 	stmt, args, err := query.Where(
 		sq.And{
 			sq.Eq{
 				LoginPolicyColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
 			},
-			sq.Or{
-				sq.Eq{
-					LoginPolicyColumnOrgID.identifier(): orgID,
-				},
-				sq.Eq{
-					LoginPolicyColumnOrgID.identifier(): authz.GetInstance(ctx).InstanceID(),
-				},
-			},
+			orgPolicyChainCondition(LoginPolicyColumnOrgID, chain, authz.GetInstance(ctx).InstanceID()),
 		}).
-		OrderBy(LoginPolicyColumnIsDefault.identifier()).
+		OrderByClause(orgPolicyChainOrder(LoginPolicyColumnOrgID, LoginPolicyColumnIsDefault, chain)).
 		Limit(1).ToSql()
 	if err != nil {
 		return nil, zerrors.ThrowInternal(err, "QUERY-scVHo", "Errors.Query.SQLStatement")
@@ -301,22 +301,20 @@ func (q *Queries) MultiFactorsByOrg(ctx context.Context, orgID string) (factors
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
+	chain, err := q.orgPolicyChain(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+
 	query, scan := prepareLoginPolicyMFAsQuery()
 	stmt, args, err := query.Where(
 		sq.And{
 			sq.Eq{
 				LoginPolicyColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
 			},
-			sq.Or{
-				sq.Eq{
-					LoginPolicyColumnOrgID.identifier(): orgID,
-				},
-				sq.Eq{
-					LoginPolicyColumnOrgID.identifier(): authz.GetInstance(ctx).InstanceID(),
-				},
-			},
+			orgPolicyChainCondition(LoginPolicyColumnOrgID, chain, authz.GetInstance(ctx).InstanceID()),
 		}).
-		OrderBy(LoginPolicyColumnIsDefault.identifier()).
+		OrderByClause(orgPolicyChainOrder(LoginPolicyColumnOrgID, LoginPolicyColumnIsDefault, chain)).
 		Limit(1).ToSql()
 	if err != nil {
 		return nil, zerrors.ThrowInternal(err, "QUERY-B4o7h", "Errors.Query.SQLStatement")
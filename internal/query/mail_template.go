@@ -73,6 +73,11 @@ func (q *Queries) MailTemplateByOrg(ctx context.Context, orgID string, withOwner
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
+	chain, err := q.orgPolicyChain(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+
 	stmt, scan := prepareMailTemplateQuery()
 	eq := sq.Eq{MailTemplateColInstanceID.identifier(): authz.GetInstance(ctx).InstanceID()}
 	if !withOwnerRemoved {
@@ -81,12 +86,9 @@ func (q *Queries) MailTemplateByOrg(ctx context.Context, orgID string, withOwner
 	query, args, err := stmt.Where(
 		sq.And{
 			eq,
-			sq.Or{
-				sq.Eq{MailTemplateColAggregateID.identifier(): orgID},
-				sq.Eq{MailTemplateColAggregateID.identifier(): authz.GetInstance(ctx).InstanceID()},
-			},
+			orgPolicyChainCondition(MailTemplateColAggregateID, chain, authz.GetInstance(ctx).InstanceID()),
 		}).
-		OrderBy(MailTemplateColIsDefault.identifier()).
+		OrderByClause(orgPolicyChainOrder(MailTemplateColAggregateID, MailTemplateColIsDefault, chain)).
 		Limit(1).ToSql()
 	if err != nil {
 		return nil, zerrors.ThrowInternal(err, "QUERY-m0sJg", "Errors.Query.SQLStatement")
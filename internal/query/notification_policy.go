@@ -80,6 +80,11 @@ func (q *Queries) NotificationPolicyByOrg(ctx context.Context, shouldTriggerBulk
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
+	chain, err := q.orgPolicyChain(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+
 	if shouldTriggerBulk {
 		_, traceSpan := tracing.NewNamedSpan(ctx, "TriggerNotificationPolicyProjection")
 		ctx, err = projection.NotificationPolicyProjection.Trigger(ctx, handler.WithAwaitRunning())
@@ -96,12 +101,9 @@ func (q *Queries) NotificationPolicyByOrg(ctx context.Context, shouldTriggerBulk
 	query, args, err := stmt.Where(
 		sq.And{
 			eq,
-			sq.Or{
-				sq.Eq{NotificationPolicyColID.identifier(): orgID},
-				sq.Eq{NotificationPolicyColID.identifier(): authz.GetInstance(ctx).InstanceID()},
-			},
+			orgPolicyChainCondition(NotificationPolicyColID, chain, authz.GetInstance(ctx).InstanceID()),
 		}).
-		OrderBy(NotificationPolicyColIsDefault.identifier()).Limit(1).ToSql()
+		OrderByClause(orgPolicyChainOrder(NotificationPolicyColID, NotificationPolicyColIsDefault, chain)).Limit(1).ToSql()
 	if err != nil {
 		return nil, zerrors.ThrowInternal(err, "QUERY-Xuoapqm", "Errors.Query.SQLStatement")
 	}
@@ -136,28 +136,32 @@ low2kyJov38V4Uk2I8kuXpLcnrpw5Tio2ooiUE27b0vHZqBKOei9Uo88qCrn3EKx
 			name: "public client",
 			mock: mockQuery(expQuery, cols, []driver.Value{testdataOidcClientPublic}, "instanceID", "clientID", true),
 			want: &OIDCClient{
-				InstanceID:               "230690539048009730",
-				AppID:                    "236646457053020162",
-				State:                    domain.AppStateActive,
-				ClientID:                 "236646457053085698",
-				HashedSecret:             "",
-				RedirectURIs:             []string{"http://localhost:9999/auth/callback"},
-				ResponseTypes:            []domain.OIDCResponseType{domain.OIDCResponseTypeCode},
-				GrantTypes:               []domain.OIDCGrantType{domain.OIDCGrantTypeAuthorizationCode},
-				ApplicationType:          domain.OIDCApplicationTypeWeb,
-				AuthMethodType:           domain.OIDCAuthMethodTypeNone,
-				PostLogoutRedirectURIs:   nil,
-				IsDevMode:                true,
-				AccessTokenType:          domain.OIDCTokenTypeBearer,
-				AccessTokenRoleAssertion: false,
-				IDTokenRoleAssertion:     false,
-				IDTokenUserinfoAssertion: false,
-				ClockSkew:                0,
-				AdditionalOrigins:        nil,
-				PublicKeys:               nil,
-				ProjectID:                "236645808328409090",
-				ProjectRoleAssertion:     true,
-				ProjectRoleKeys:          []string{"role1", "role2"},
+				InstanceID:                            "230690539048009730",
+				AppID:                                 "236646457053020162",
+				State:                                 domain.AppStateActive,
+				ClientID:                              "236646457053085698",
+				HashedSecret:                          "",
+				RedirectURIs:                          []string{"http://localhost:9999/auth/callback"},
+				ResponseTypes:                         []domain.OIDCResponseType{domain.OIDCResponseTypeCode},
+				GrantTypes:                            []domain.OIDCGrantType{domain.OIDCGrantTypeAuthorizationCode},
+				ApplicationType:                       domain.OIDCApplicationTypeWeb,
+				AuthMethodType:                        domain.OIDCAuthMethodTypeNone,
+				PostLogoutRedirectURIs:                nil,
+				IsDevMode:                             true,
+				AccessTokenType:                       domain.OIDCTokenTypeBearer,
+				AccessTokenRoleAssertion:              false,
+				IDTokenRoleAssertion:                  false,
+				IDTokenUserinfoAssertion:              false,
+				ClockSkew:                             0,
+				AdditionalOrigins:                     nil,
+				PublicKeys:                            nil,
+				ProjectID:                             "236645808328409090",
+				ProjectRoleAssertion:                  true,
+				ProjectRoleKeys:                       []string{"role1", "role2"},
+				DPoPBoundAccessTokens:                 true,
+				TLSClientCertificateBoundAccessTokens: true,
+				RefreshTokenRotation:                  domain.OIDCRefreshTokenRotationStrict,
+				EncryptAuthorizationResponse:          true,
 				Settings: &OIDCSettings{
 					AccessTokenLifetime: 43200000000000,
 					IdTokenLifetime:     43200000000000,
@@ -200,28 +204,29 @@ low2kyJov38V4Uk2I8kuXpLcnrpw5Tio2ooiUE27b0vHZqBKOei9Uo88qCrn3EKx
 			name: "secret client",
 			mock: mockQuery(expQuery, cols, []driver.Value{testdataOidcClientSecret}, "instanceID", "clientID", true),
 			want: &OIDCClient{
-				InstanceID:               "230690539048009730",
-				AppID:                    "236646858984783874",
-				State:                    domain.AppStateActive,
-				ClientID:                 "236646858984849410",
-				HashedSecret:             "$2a$14$OzZ0XEZZEtD13py/EPba2evsS6WcKZ5orVMj9pWHEGEHmLu2h3PFq",
-				RedirectURIs:             []string{"http://localhost:9999/auth/callback"},
-				ResponseTypes:            []domain.OIDCResponseType{0},
-				GrantTypes:               []domain.OIDCGrantType{0},
-				ApplicationType:          domain.OIDCApplicationTypeWeb,
-				AuthMethodType:           domain.OIDCAuthMethodTypeBasic,
-				PostLogoutRedirectURIs:   nil,
-				IsDevMode:                true,
-				AccessTokenType:          domain.OIDCTokenTypeBearer,
-				AccessTokenRoleAssertion: false,
-				IDTokenRoleAssertion:     false,
-				IDTokenUserinfoAssertion: false,
-				ClockSkew:                0,
-				AdditionalOrigins:        nil,
-				PublicKeys:               nil,
-				ProjectID:                "236645808328409090",
-				ProjectRoleAssertion:     false,
-				ProjectRoleKeys:          []string{"role1", "role2"},
+				InstanceID:                "230690539048009730",
+				AppID:                     "236646858984783874",
+				State:                     domain.AppStateActive,
+				ClientID:                  "236646858984849410",
+				HashedSecret:              "$2a$14$OzZ0XEZZEtD13py/EPba2evsS6WcKZ5orVMj9pWHEGEHmLu2h3PFq",
+				RedirectURIs:              []string{"http://localhost:9999/auth/callback"},
+				ResponseTypes:             []domain.OIDCResponseType{0},
+				GrantTypes:                []domain.OIDCGrantType{0},
+				ApplicationType:           domain.OIDCApplicationTypeWeb,
+				AuthMethodType:            domain.OIDCAuthMethodTypeBasic,
+				PostLogoutRedirectURIs:    nil,
+				IsDevMode:                 true,
+				AccessTokenType:           domain.OIDCTokenTypeBearer,
+				AccessTokenRoleAssertion:  false,
+				IDTokenRoleAssertion:      false,
+				IDTokenUserinfoAssertion:  false,
+				ClockSkew:                 0,
+				AdditionalOrigins:         nil,
+				PublicKeys:                nil,
+				ProjectID:                 "236645808328409090",
+				ProjectRoleAssertion:      false,
+				ProjectRoleKeys:           []string{"role1", "role2"},
+				RequirePushedAuthRequests: true,
 				Settings: &OIDCSettings{
 					AccessTokenLifetime: 43200000000000,
 					IdTokenLifetime:     43200000000000,
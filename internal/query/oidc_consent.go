@@ -0,0 +1,243 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	oidcConsentTable = table{
+		name:          projection.OIDCConsentTable,
+		instanceIDCol: projection.OIDCConsentInstanceIDCol,
+	}
+	OIDCConsentColumnID = Column{
+		name:  projection.OIDCConsentIDCol,
+		table: oidcConsentTable,
+	}
+	OIDCConsentColumnCreationDate = Column{
+		name:  projection.OIDCConsentCreationDateCol,
+		table: oidcConsentTable,
+	}
+	OIDCConsentColumnChangeDate = Column{
+		name:  projection.OIDCConsentChangeDateCol,
+		table: oidcConsentTable,
+	}
+	OIDCConsentColumnResourceOwner = Column{
+		name:  projection.OIDCConsentResourceOwnerCol,
+		table: oidcConsentTable,
+	}
+	OIDCConsentColumnInstanceID = Column{
+		name:  projection.OIDCConsentInstanceIDCol,
+		table: oidcConsentTable,
+	}
+	OIDCConsentColumnSequence = Column{
+		name:  projection.OIDCConsentSequenceCol,
+		table: oidcConsentTable,
+	}
+	OIDCConsentColumnUserID = Column{
+		name:  projection.OIDCConsentUserIDCol,
+		table: oidcConsentTable,
+	}
+	OIDCConsentColumnClientID = Column{
+		name:  projection.OIDCConsentClientIDCol,
+		table: oidcConsentTable,
+	}
+	OIDCConsentColumnAppID = Column{
+		name:  projection.OIDCConsentAppIDCol,
+		table: oidcConsentTable,
+	}
+	OIDCConsentColumnProjectID = Column{
+		name:  projection.OIDCConsentProjectIDCol,
+		table: oidcConsentTable,
+	}
+	OIDCConsentColumnScope = Column{
+		name:  projection.OIDCConsentScopeCol,
+		table: oidcConsentTable,
+	}
+)
+
+type OIDCConsents struct {
+	SearchResponse
+	OIDCConsents []*OIDCConsent
+}
+
+func (c *OIDCConsents) SetState(s *State) {
+	c.State = s
+}
+
+type OIDCConsent struct {
+	domain.ObjectDetails
+
+	UserID    string
+	ClientID  string
+	AppID     string
+	AppName   string
+	ProjectID string
+	Scope     database.TextArray[string]
+}
+
+type OIDCConsentSearchQueries struct {
+	SearchRequest
+	Queries []SearchQuery
+}
+
+func (q *OIDCConsentSearchQueries) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	query = q.SearchRequest.toQuery(query)
+	for _, q := range q.Queries {
+		query = q.toQuery(query)
+	}
+	return query
+}
+
+func (q *Queries) SearchOIDCConsents(ctx context.Context, queries *OIDCConsentSearchQueries) (_ *OIDCConsents, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	eq := sq.Eq{
+		OIDCConsentColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+	}
+	query, scan := prepareOIDCConsentsQuery()
+	return genericRowsQueryWithState(ctx, q.client, oidcConsentTable, combineToWhereStmt(query, queries.toQuery, eq), scan)
+}
+
+// OIDCConsentByUserAndClientID returns the active consent of the user for the client,
+// it's used to check if the user needs to consent to (additional) scopes.
+func (q *Queries) OIDCConsentByUserAndClientID(ctx context.Context, shouldTriggerBulk bool, userID, clientID string) (_ *OIDCConsent, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if shouldTriggerBulk {
+		_, traceSpan := tracing.NewNamedSpan(ctx, "TriggerOIDCConsentProjection")
+		ctx, err = projection.OIDCConsentProjection.Trigger(ctx, handler.WithAwaitRunning())
+		logging.OnError(err).Debug("trigger failed")
+		traceSpan.EndWithError(err)
+	}
+
+	eq := sq.Eq{
+		OIDCConsentColumnUserID.identifier():     userID,
+		OIDCConsentColumnClientID.identifier():   clientID,
+		OIDCConsentColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+	}
+	query, scan := prepareOIDCConsentQuery()
+	return genericRowQuery(ctx, q.client, query.Where(eq), scan)
+}
+
+func NewOIDCConsentUserIDSearchQuery(userID string) (SearchQuery, error) {
+	return NewTextQuery(OIDCConsentColumnUserID, userID, TextEquals)
+}
+
+func NewOIDCConsentClientIDSearchQuery(clientID string) (SearchQuery, error) {
+	return NewTextQuery(OIDCConsentColumnClientID, clientID, TextEquals)
+}
+
+func prepareOIDCConsentsQuery() (sq.SelectBuilder, func(rows *sql.Rows) (*OIDCConsents, error)) {
+	return sq.Select(
+			OIDCConsentColumnID.identifier(),
+			OIDCConsentColumnCreationDate.identifier(),
+			OIDCConsentColumnChangeDate.identifier(),
+			OIDCConsentColumnResourceOwner.identifier(),
+			OIDCConsentColumnSequence.identifier(),
+			OIDCConsentColumnUserID.identifier(),
+			OIDCConsentColumnClientID.identifier(),
+			OIDCConsentColumnAppID.identifier(),
+			AppColumnName.identifier(),
+			OIDCConsentColumnProjectID.identifier(),
+			OIDCConsentColumnScope.identifier(),
+			countColumn.identifier(),
+		).From(oidcConsentTable.identifier()).
+			LeftJoin(join(AppColumnID, OIDCConsentColumnAppID)).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*OIDCConsents, error) {
+			consents := make([]*OIDCConsent, 0)
+			var count uint64
+			for rows.Next() {
+				consent := new(OIDCConsent)
+				var appName sql.NullString
+				err := rows.Scan(
+					&consent.ID,
+					&consent.CreationDate,
+					&consent.EventDate,
+					&consent.ResourceOwner,
+					&consent.Sequence,
+					&consent.UserID,
+					&consent.ClientID,
+					&consent.AppID,
+					&appName,
+					&consent.ProjectID,
+					&consent.Scope,
+					&count,
+				)
+				if err != nil {
+					return nil, err
+				}
+				consent.AppName = appName.String
+				consents = append(consents, consent)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-Fai3o", "Errors.Query.CloseRows")
+			}
+
+			return &OIDCConsents{
+				OIDCConsents: consents,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
+
+func prepareOIDCConsentQuery() (sq.SelectBuilder, func(row *sql.Row) (*OIDCConsent, error)) {
+	return sq.Select(
+			OIDCConsentColumnID.identifier(),
+			OIDCConsentColumnCreationDate.identifier(),
+			OIDCConsentColumnChangeDate.identifier(),
+			OIDCConsentColumnResourceOwner.identifier(),
+			OIDCConsentColumnSequence.identifier(),
+			OIDCConsentColumnUserID.identifier(),
+			OIDCConsentColumnClientID.identifier(),
+			OIDCConsentColumnAppID.identifier(),
+			AppColumnName.identifier(),
+			OIDCConsentColumnProjectID.identifier(),
+			OIDCConsentColumnScope.identifier(),
+		).From(oidcConsentTable.identifier()).
+			LeftJoin(join(AppColumnID, OIDCConsentColumnAppID)).
+			PlaceholderFormat(sq.Dollar),
+		func(row *sql.Row) (*OIDCConsent, error) {
+			consent := new(OIDCConsent)
+			var appName sql.NullString
+			err := row.Scan(
+				&consent.ID,
+				&consent.CreationDate,
+				&consent.EventDate,
+				&consent.ResourceOwner,
+				&consent.Sequence,
+				&consent.UserID,
+				&consent.ClientID,
+				&consent.AppID,
+				&appName,
+				&consent.ProjectID,
+				&consent.Scope,
+			)
+			if err != nil {
+				if errors.Is(err, sql.ErrNoRows) {
+					return nil, zerrors.ThrowNotFound(err, "QUERY-ouN4a", "Errors.OIDCConsent.NotFound")
+				}
+				return nil, zerrors.ThrowInternal(err, "QUERY-Eiw6o", "Errors.Internal")
+			}
+			consent.AppName = appName.String
+			return consent, nil
+		}
+}
@@ -0,0 +1,227 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	prepareOIDCConsentsStmt = `SELECT projections.oidc_consents.id,` +
+		` projections.oidc_consents.creation_date,` +
+		` projections.oidc_consents.change_date,` +
+		` projections.oidc_consents.resource_owner,` +
+		` projections.oidc_consents.sequence,` +
+		` projections.oidc_consents.user_id,` +
+		` projections.oidc_consents.client_id,` +
+		` projections.oidc_consents.app_id,` +
+		` projections.apps7.name,` +
+		` projections.oidc_consents.project_id,` +
+		` projections.oidc_consents.scope,` +
+		` COUNT(*) OVER ()` +
+		` FROM projections.oidc_consents` +
+		` LEFT JOIN projections.apps7 ON projections.oidc_consents.app_id = projections.apps7.id AND projections.oidc_consents.instance_id = projections.apps7.instance_id`
+	prepareOIDCConsentsCols = []string{
+		"id",
+		"creation_date",
+		"change_date",
+		"resource_owner",
+		"sequence",
+		"user_id",
+		"client_id",
+		"app_id",
+		"name",
+		"project_id",
+		"scope",
+		"count",
+	}
+
+	prepareOIDCConsentStmt = `SELECT projections.oidc_consents.id,` +
+		` projections.oidc_consents.creation_date,` +
+		` projections.oidc_consents.change_date,` +
+		` projections.oidc_consents.resource_owner,` +
+		` projections.oidc_consents.sequence,` +
+		` projections.oidc_consents.user_id,` +
+		` projections.oidc_consents.client_id,` +
+		` projections.oidc_consents.app_id,` +
+		` projections.apps7.name,` +
+		` projections.oidc_consents.project_id,` +
+		` projections.oidc_consents.scope` +
+		` FROM projections.oidc_consents` +
+		` LEFT JOIN projections.apps7 ON projections.oidc_consents.app_id = projections.apps7.id AND projections.oidc_consents.instance_id = projections.apps7.instance_id`
+	prepareOIDCConsentCols = prepareOIDCConsentsCols[:len(prepareOIDCConsentsCols)-1]
+)
+
+func Test_OIDCConsentPrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareOIDCConsentsQuery no result",
+			prepare: prepareOIDCConsentsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareOIDCConsentsStmt),
+					nil,
+					nil,
+				),
+			},
+			object: &OIDCConsents{OIDCConsents: []*OIDCConsent{}},
+		},
+		{
+			name:    "prepareOIDCConsentsQuery one result",
+			prepare: prepareOIDCConsentsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareOIDCConsentsStmt),
+					prepareOIDCConsentsCols,
+					[][]driver.Value{
+						{
+							"id",
+							testNow,
+							testNow,
+							"ro",
+							uint64(20211109),
+							"user-id",
+							"client-id",
+							"app-id",
+							"app-name",
+							"project-id",
+							database.TextArray[string]{"openid", "profile"},
+						},
+					},
+				),
+			},
+			object: &OIDCConsents{
+				SearchResponse: SearchResponse{
+					Count: 1,
+				},
+				OIDCConsents: []*OIDCConsent{
+					{
+						ObjectDetails: domain.ObjectDetails{
+							ID:            "id",
+							EventDate:     testNow,
+							CreationDate:  testNow,
+							ResourceOwner: "ro",
+							Sequence:      20211109,
+						},
+						UserID:    "user-id",
+						ClientID:  "client-id",
+						AppID:     "app-id",
+						AppName:   "app-name",
+						ProjectID: "project-id",
+						Scope:     database.TextArray[string]{"openid", "profile"},
+					},
+				},
+			},
+		},
+		{
+			name:    "prepareOIDCConsentsQuery sql err",
+			prepare: prepareOIDCConsentsQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(prepareOIDCConsentsStmt),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*OIDCConsents)(nil),
+		},
+		{
+			name:    "prepareOIDCConsentQuery no result",
+			prepare: prepareOIDCConsentQuery,
+			want: want{
+				sqlExpectations: mockQueriesScanErr(
+					regexp.QuoteMeta(prepareOIDCConsentStmt),
+					nil,
+					nil,
+				),
+				err: func(err error) (error, bool) {
+					if !zerrors.IsNotFound(err) {
+						return fmt.Errorf("err should be zitadel.NotFoundError got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*OIDCConsent)(nil),
+		},
+		{
+			name:    "prepareOIDCConsentQuery found, app removed",
+			prepare: prepareOIDCConsentQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					regexp.QuoteMeta(prepareOIDCConsentStmt),
+					prepareOIDCConsentCols,
+					[]driver.Value{
+						"id",
+						testNow,
+						testNow,
+						"ro",
+						uint64(20211109),
+						"user-id",
+						"client-id",
+						"app-id",
+						nil,
+						"project-id",
+						database.TextArray[string]{"openid"},
+					},
+				),
+			},
+			object: &OIDCConsent{
+				ObjectDetails: domain.ObjectDetails{
+					ID:            "id",
+					EventDate:     testNow,
+					CreationDate:  testNow,
+					ResourceOwner: "ro",
+					Sequence:      20211109,
+				},
+				UserID:    "user-id",
+				ClientID:  "client-id",
+				AppID:     "app-id",
+				ProjectID: "project-id",
+				Scope:     database.TextArray[string]{"openid"},
+			},
+		},
+		{
+			name:    "prepareOIDCConsentQuery sql err",
+			prepare: prepareOIDCConsentQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(prepareOIDCConsentStmt),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*OIDCConsent)(nil),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err)
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	oidcSessionTable = table{
+		name:          projection.OIDCSessionTable,
+		instanceIDCol: projection.OIDCSessionInstanceIDCol,
+	}
+	OIDCSessionColumnID = Column{
+		name:  projection.OIDCSessionIDCol,
+		table: oidcSessionTable,
+	}
+	OIDCSessionColumnInstanceID = Column{
+		name:  projection.OIDCSessionInstanceIDCol,
+		table: oidcSessionTable,
+	}
+	OIDCSessionColumnUserID = Column{
+		name:  projection.OIDCSessionUserIDCol,
+		table: oidcSessionTable,
+	}
+	OIDCSessionColumnClientID = Column{
+		name:  projection.OIDCSessionClientIDCol,
+		table: oidcSessionTable,
+	}
+)
+
+// OIDCSessionIDsByUserAndClientID returns the ids of all OIDC sessions the user created for the client,
+// it's used to revoke the (refresh) tokens of the client when the consent is revoked.
+func (q *Queries) OIDCSessionIDsByUserAndClientID(ctx context.Context, shouldTriggerBulk bool, userID, clientID string) (ids []string, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if shouldTriggerBulk {
+		_, traceSpan := tracing.NewNamedSpan(ctx, "TriggerOIDCSessionProjection")
+		ctx, err = projection.OIDCSessionProjection.Trigger(ctx, handler.WithAwaitRunning())
+		logging.OnError(err).Debug("trigger failed")
+		traceSpan.EndWithError(err)
+	}
+
+	query, scan := prepareOIDCSessionIDsQuery()
+	eq := sq.Eq{
+		OIDCSessionColumnUserID.identifier():     userID,
+		OIDCSessionColumnClientID.identifier():   clientID,
+		OIDCSessionColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+	}
+	stmt, args, err := query.Where(eq).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "QUERY-Ooph6", "Errors.Query.InvalidRequest")
+	}
+
+	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		ids, err = scan(rows)
+		return err
+	}, stmt, args...)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-ieV7a", "Errors.Internal")
+	}
+	return ids, nil
+}
+
+func prepareOIDCSessionIDsQuery() (sq.SelectBuilder, func(*sql.Rows) ([]string, error)) {
+	return sq.Select(
+			OIDCSessionColumnID.identifier(),
+		).From(oidcSessionTable.identifier()).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) ([]string, error) {
+			ids := make([]string, 0)
+			for rows.Next() {
+				var id string
+				if err := rows.Scan(&id); err != nil {
+					return nil, zerrors.ThrowInternal(err, "QUERY-Tho4u", "Errors.Internal")
+				}
+				ids = append(ids, id)
+			}
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-aiL0e", "Errors.Query.CloseRows")
+			}
+			return ids, nil
+		}
+}
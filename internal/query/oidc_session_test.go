@@ -0,0 +1,81 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+)
+
+var (
+	prepareOIDCSessionIDsStmt = `SELECT projections.oidc_sessions.id` +
+		` FROM projections.oidc_sessions`
+	prepareOIDCSessionIDsCols = []string{
+		"id",
+	}
+)
+
+func Test_OIDCSessionPrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareOIDCSessionIDsQuery no result",
+			prepare: prepareOIDCSessionIDsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareOIDCSessionIDsStmt),
+					nil,
+					nil,
+				),
+			},
+			object: []string{},
+		},
+		{
+			name:    "prepareOIDCSessionIDsQuery multiple results",
+			prepare: prepareOIDCSessionIDsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareOIDCSessionIDsStmt),
+					prepareOIDCSessionIDsCols,
+					[][]driver.Value{
+						{"session1"},
+						{"session2"},
+					},
+				),
+			},
+			object: []string{"session1", "session2"},
+		},
+		{
+			name:    "prepareOIDCSessionIDsQuery sql err",
+			prepare: prepareOIDCSessionIDsQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(prepareOIDCSessionIDsStmt),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: ([]string)(nil),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err)
+		})
+	}
+}
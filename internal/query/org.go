@@ -63,6 +63,14 @@ var (
 		name:  projection.OrgColumnDomain,
 		table: orgsTable,
 	}
+	OrgColumnParentOrgID = Column{
+		name:  projection.OrgColumnParentOrgID,
+		table: orgsTable,
+	}
+	OrgColumnDelegateAdministration = Column{
+		name:  projection.OrgColumnDelegateAdmin,
+		table: orgsTable,
+	}
 )
 
 type Orgs struct {
@@ -80,6 +88,9 @@ type Org struct {
 
 	Name   string
 	Domain string
+
+	ParentOrgID            string
+	DelegateAdministration bool
 }
 
 func orgsCheckPermission(ctx context.Context, orgs *Orgs, permissionCheck domain_pkg.PermissionCheck) {
@@ -161,6 +172,9 @@ func (q *Queries) OrgByID(ctx context.Context, shouldTriggerBulk bool, id string
 		Sequence:      uint64(foundOrg.Sequence),
 		Name:          foundOrg.Name,
 		Domain:        foundOrg.PrimaryDomain.Domain,
+
+		ParentOrgID:            foundOrg.ParentOrgID,
+		DelegateAdministration: foundOrg.DelegateAdministration,
 	}, nil
 }
 
@@ -384,6 +398,8 @@ func prepareOrgsQuery() (sq.SelectBuilder, func(*sql.Rows) (*Orgs, error)) {
 			OrgColumnSequence.identifier(),
 			OrgColumnName.identifier(),
 			OrgColumnDomain.identifier(),
+			OrgColumnParentOrgID.identifier(),
+			OrgColumnDelegateAdministration.identifier(),
 			countColumn.identifier()).
 			From(orgsTable.identifier()).
 			PlaceholderFormat(sq.Dollar),
@@ -392,6 +408,7 @@ func prepareOrgsQuery() (sq.SelectBuilder, func(*sql.Rows) (*Orgs, error)) {
 			var count uint64
 			for rows.Next() {
 				org := new(Org)
+				var parentOrgID sql.NullString
 				err := rows.Scan(
 					&org.ID,
 					&org.CreationDate,
@@ -401,11 +418,14 @@ func prepareOrgsQuery() (sq.SelectBuilder, func(*sql.Rows) (*Orgs, error)) {
 					&org.Sequence,
 					&org.Name,
 					&org.Domain,
+					&parentOrgID,
+					&org.DelegateAdministration,
 					&count,
 				)
 				if err != nil {
 					return nil, err
 				}
+				org.ParentOrgID = parentOrgID.String
 				orgs = append(orgs, org)
 			}
 
@@ -432,11 +452,14 @@ func prepareOrgQuery() (sq.SelectBuilder, func(*sql.Row) (*Org, error)) {
 			OrgColumnSequence.identifier(),
 			OrgColumnName.identifier(),
 			OrgColumnDomain.identifier(),
+			OrgColumnParentOrgID.identifier(),
+			OrgColumnDelegateAdministration.identifier(),
 		).
 			From(orgsTable.identifier()).
 			PlaceholderFormat(sq.Dollar),
 		func(row *sql.Row) (*Org, error) {
 			o := new(Org)
+			var parentOrgID sql.NullString
 			err := row.Scan(
 				&o.ID,
 				&o.CreationDate,
@@ -446,6 +469,8 @@ func prepareOrgQuery() (sq.SelectBuilder, func(*sql.Row) (*Org, error)) {
 				&o.Sequence,
 				&o.Name,
 				&o.Domain,
+				&parentOrgID,
+				&o.DelegateAdministration,
 			)
 			if err != nil {
 				if errors.Is(err, sql.ErrNoRows) {
@@ -453,6 +478,7 @@ func prepareOrgQuery() (sq.SelectBuilder, func(*sql.Row) (*Org, error)) {
 				}
 				return nil, zerrors.ThrowInternal(err, "QUERY-pWS5H", "Errors.Internal")
 			}
+			o.ParentOrgID = parentOrgID.String
 			return o, nil
 		}
 }
@@ -467,12 +493,15 @@ func prepareOrgWithDomainsQuery() (sq.SelectBuilder, func(*sql.Row) (*Org, error
 			OrgColumnSequence.identifier(),
 			OrgColumnName.identifier(),
 			OrgColumnDomain.identifier(),
+			OrgColumnParentOrgID.identifier(),
+			OrgColumnDelegateAdministration.identifier(),
 		).
 			From(orgsTable.identifier()).
 			LeftJoin(join(OrgDomainOrgIDCol, OrgColumnID)).
 			PlaceholderFormat(sq.Dollar),
 		func(row *sql.Row) (*Org, error) {
 			o := new(Org)
+			var parentOrgID sql.NullString
 			err := row.Scan(
 				&o.ID,
 				&o.CreationDate,
@@ -482,6 +511,8 @@ func prepareOrgWithDomainsQuery() (sq.SelectBuilder, func(*sql.Row) (*Org, error
 				&o.Sequence,
 				&o.Name,
 				&o.Domain,
+				&parentOrgID,
+				&o.DelegateAdministration,
 			)
 			if err != nil {
 				if errors.Is(err, sql.ErrNoRows) {
@@ -489,6 +520,7 @@ func prepareOrgWithDomainsQuery() (sq.SelectBuilder, func(*sql.Row) (*Org, error
 				}
 				return nil, zerrors.ThrowInternal(err, "QUERY-pWS5H", "Errors.Internal")
 			}
+			o.ParentOrgID = parentOrgID.String
 			return o, nil
 		}
 }
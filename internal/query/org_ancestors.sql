@@ -0,0 +1,15 @@
+WITH RECURSIVE ancestors (id, parent_org_id, delegate_administration, depth) AS (
+    SELECT o.id, o.parent_org_id, o.delegate_administration, 0
+    FROM projections.orgs1 o
+    WHERE o.instance_id = $1
+        AND o.id = $2
+    UNION ALL
+    SELECT p.id, p.parent_org_id, p.delegate_administration, a.depth + 1
+    FROM ancestors a
+    JOIN projections.orgs1 p
+        ON p.instance_id = $1
+        AND p.id = a.parent_org_id
+    WHERE a.depth < $3
+        AND (NOT $4 OR a.delegate_administration)
+)
+SELECT id FROM ancestors WHERE depth > 0 ORDER BY depth;
@@ -0,0 +1,86 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+//go:embed org_ancestors.sql
+var orgAncestorsQuery string
+
+// OrgAncestorIDs returns the ids of the ancestors of the organization, the parent first.
+// If delegatedOnly is set, the hierarchy is only followed as long as the parents
+// delegate their administration down to the organization.
+func (q *Queries) OrgAncestorIDs(ctx context.Context, orgID string, delegatedOnly bool) (ids []string, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if orgID == "" {
+		return nil, nil
+	}
+	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		for rows.Next() {
+			var id string
+			if err := rows.Scan(&id); err != nil {
+				return err
+			}
+			ids = append(ids, id)
+		}
+		return rows.Err()
+	},
+		orgAncestorsQuery,
+		authz.GetInstance(ctx).InstanceID(),
+		orgID,
+		domain.OrgHierarchyMaxDepth,
+		delegatedOnly,
+	)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Ahr8o", "Errors.Internal")
+	}
+	return ids, nil
+}
+
+// orgPolicyChain returns the organization followed by its ancestors,
+// in the order their policies take precedence over the ones of the instance.
+func (q *Queries) orgPolicyChain(ctx context.Context, orgID string) ([]string, error) {
+	ancestors, err := q.OrgAncestorIDs(ctx, orgID, false)
+	if err != nil {
+		return nil, err
+	}
+	return append([]string{orgID}, ancestors...), nil
+}
+
+// orgPolicyChainCondition selects the policies defined on the organization chain and the default of the instance.
+func orgPolicyChainCondition(ownerCol Column, chain []string, instanceID string) sq.Or {
+	if len(chain) == 1 {
+		return sq.Or{
+			sq.Eq{ownerCol.identifier(): chain[0]},
+			sq.Eq{ownerCol.identifier(): instanceID},
+		}
+	}
+	return sq.Or{
+		sq.Eq{ownerCol.identifier(): chain},
+		sq.Eq{ownerCol.identifier(): instanceID},
+	}
+}
+
+// orgPolicyChainOrder orders the policies selected by [orgPolicyChainCondition],
+// so the policy closest to the organization comes first and the default of the instance last.
+func orgPolicyChainOrder(ownerCol, isDefaultCol Column, chain []string) sq.Sqlizer {
+	if len(chain) == 1 {
+		return sq.Expr(isDefaultCol.identifier())
+	}
+	return sq.Expr(
+		isDefaultCol.identifier()+", array_position(?::TEXT[], "+ownerCol.identifier()+")",
+		database.TextArray[string](chain),
+	)
+}
@@ -0,0 +1,109 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestQueries_OrgAncestorIDs(t *testing.T) {
+	expQuery := regexp.QuoteMeta(orgAncestorsQuery)
+	cols := []string{"id"}
+
+	tests := []struct {
+		name          string
+		orgID         string
+		delegatedOnly bool
+		mock          sqlExpectation
+		want          []string
+		wantErr       error
+	}{
+		{
+			name:  "no org, no query",
+			orgID: "",
+			mock:  func(m sqlmock.Sqlmock) sqlmock.Sqlmock { return m },
+		},
+		{
+			name:    "internal error",
+			orgID:   "orgID",
+			mock:    mockQueryErr(expQuery, sql.ErrConnDone, "instanceID", "orgID", domain.OrgHierarchyMaxDepth, false),
+			wantErr: zerrors.ThrowInternal(sql.ErrConnDone, "QUERY-Ahr8o", "Errors.Internal"),
+		},
+		{
+			name:  "no parent",
+			orgID: "orgID",
+			mock:  mockQueries(expQuery, cols, nil, "instanceID", "orgID", domain.OrgHierarchyMaxDepth, false),
+		},
+		{
+			name:          "delegated ancestors",
+			orgID:         "orgID",
+			delegatedOnly: true,
+			mock: mockQueries(expQuery, cols,
+				[][]driver.Value{{"parentID"}, {"grandParentID"}},
+				"instanceID", "orgID", domain.OrgHierarchyMaxDepth, true,
+			),
+			want: []string{"parentID", "grandParentID"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			execMock(t, tt.mock, func(db *sql.DB) {
+				q := &Queries{
+					client: &database.DB{
+						DB: db,
+					},
+				}
+				ctx := authz.NewMockContext("instanceID", "orgID", "userID")
+				got, err := q.OrgAncestorIDs(ctx, tt.orgID, tt.delegatedOnly)
+				require.ErrorIs(t, err, tt.wantErr)
+				assert.Equal(t, tt.want, got)
+			})
+		})
+	}
+}
+
+func Test_orgPolicyChain(t *testing.T) {
+	tests := []struct {
+		name     string
+		chain    []string
+		wantStmt string
+		wantArgs []any
+	}{
+		{
+			name:     "organization without parent",
+			chain:    []string{"orgID"},
+			wantStmt: "SELECT projections.login_policies5.aggregate_id FROM projections.login_policies5 WHERE (projections.login_policies5.aggregate_id = $1 OR projections.login_policies5.aggregate_id = $2) ORDER BY projections.login_policies5.is_default",
+			wantArgs: []any{"orgID", "instanceID"},
+		},
+		{
+			name:     "organization with ancestors",
+			chain:    []string{"orgID", "parentID", "grandParentID"},
+			wantStmt: "SELECT projections.login_policies5.aggregate_id FROM projections.login_policies5 WHERE (projections.login_policies5.aggregate_id IN ($1,$2,$3) OR projections.login_policies5.aggregate_id = $4) ORDER BY projections.login_policies5.is_default, array_position($5::TEXT[], projections.login_policies5.aggregate_id)",
+			wantArgs: []any{"orgID", "parentID", "grandParentID", "instanceID", database.TextArray[string]{"orgID", "parentID", "grandParentID"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stmt, args, err := sq.Select(LoginPolicyColumnOrgID.identifier()).
+				From(loginPolicyTable.identifier()).
+				Where(orgPolicyChainCondition(LoginPolicyColumnOrgID, tt.chain, "instanceID")).
+				OrderByClause(orgPolicyChainOrder(LoginPolicyColumnOrgID, LoginPolicyColumnIsDefault, tt.chain)).
+				PlaceholderFormat(sq.Dollar).
+				ToSql()
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantStmt, stmt)
+			assert.Equal(t, tt.wantArgs, args)
+		})
+	}
+}
@@ -30,6 +30,8 @@ var (
 		` projections.orgs1.sequence,` +
 		` projections.orgs1.name,` +
 		` projections.orgs1.primary_domain,` +
+		` projections.orgs1.parent_org_id,` +
+		` projections.orgs1.delegate_administration,` +
 		` COUNT(*) OVER ()` +
 		` FROM projections.orgs1`
 	prepareOrgsQueryCols = []string{
@@ -41,6 +43,8 @@ var (
 		"sequence",
 		"name",
 		"primary_domain",
+		"parent_org_id",
+		"delegate_administration",
 		"count",
 	}
 
@@ -51,7 +55,9 @@ var (
 		` projections.orgs1.org_state,` +
 		` projections.orgs1.sequence,` +
 		` projections.orgs1.name,` +
-		` projections.orgs1.primary_domain` +
+		` projections.orgs1.primary_domain,` +
+		` projections.orgs1.parent_org_id,` +
+		` projections.orgs1.delegate_administration` +
 		` FROM projections.orgs1`
 	prepareOrgQueryCols = []string{
 		"id",
@@ -62,6 +68,8 @@ var (
 		"sequence",
 		"name",
 		"primary_domain",
+		"parent_org_id",
+		"delegate_administration",
 	}
 
 	prepareOrgUniqueStmt = `SELECT COUNT(*) = 0` +
@@ -112,6 +120,8 @@ func Test_OrgPrepares(t *testing.T) {
 							uint64(20211109),
 							"org-name",
 							"zitadel.ch",
+							nil,
+							false,
 						},
 					},
 				),
@@ -151,6 +161,8 @@ func Test_OrgPrepares(t *testing.T) {
 							uint64(20211108),
 							"org-name-1",
 							"zitadel.ch",
+							nil,
+							false,
 						},
 						{
 							"id-2",
@@ -161,6 +173,8 @@ func Test_OrgPrepares(t *testing.T) {
 							uint64(20211108),
 							"org-name-2",
 							"caos.ch",
+							"id-1",
+							true,
 						},
 					},
 				),
@@ -189,6 +203,9 @@ func Test_OrgPrepares(t *testing.T) {
 						Sequence:      20211108,
 						Name:          "org-name-2",
 						Domain:        "caos.ch",
+
+						ParentOrgID:            "id-1",
+						DelegateAdministration: true,
 					},
 				},
 			},
@@ -244,6 +261,8 @@ func Test_OrgPrepares(t *testing.T) {
 						uint64(20211108),
 						"org-name",
 						"zitadel.ch",
+						"parent-id",
+						false,
 					},
 				),
 			},
@@ -256,6 +275,7 @@ func Test_OrgPrepares(t *testing.T) {
 				Sequence:      20211108,
 				Name:          "org-name",
 				Domain:        "zitadel.ch",
+				ParentOrgID:   "parent-id",
 			},
 		},
 		{
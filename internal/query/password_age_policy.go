@@ -27,6 +27,8 @@ type PasswordAgePolicy struct {
 
 	ExpireWarnDays uint64
 	MaxAgeDays     uint64
+	HistoryCount   uint64
+	MinAgeDays     uint64
 
 	IsDefault bool
 }
@@ -68,6 +70,14 @@ var (
 		name:  projection.AgePolicyMaxAgeDaysCol,
 		table: passwordAgeTable,
 	}
+	PasswordAgeColHistoryCount = Column{
+		name:  projection.AgePolicyHistoryCountCol,
+		table: passwordAgeTable,
+	}
+	PasswordAgeColMinAge = Column{
+		name:  projection.AgePolicyMinAgeDaysCol,
+		table: passwordAgeTable,
+	}
 	PasswordAgeColIsDefault = Column{
 		name:  projection.AgePolicyIsDefaultCol,
 		table: passwordAgeTable,
@@ -86,6 +96,11 @@ func (q *Queries) PasswordAgePolicyByOrg(ctx context.Context, shouldTriggerBulk
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
+	chain, err := q.orgPolicyChain(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+
 	if shouldTriggerBulk {
 		_, traceSpan := tracing.NewNamedSpan(ctx, "TriggerPasswordAgeProjection")
 		ctx, err = projection.PasswordAgeProjection.Trigger(ctx, handler.WithAwaitRunning())
@@ -100,12 +115,9 @@ func (q *Queries) PasswordAgePolicyByOrg(ctx context.Context, shouldTriggerBulk
 	query, args, err := stmt.Where(
 		sq.And{
 			eq,
-			sq.Or{
-				sq.Eq{PasswordAgeColID.identifier(): orgID},
-				sq.Eq{PasswordAgeColID.identifier(): authz.GetInstance(ctx).InstanceID()},
-			},
+			orgPolicyChainCondition(PasswordAgeColID, chain, authz.GetInstance(ctx).InstanceID()),
 		}).
-		OrderBy(PasswordAgeColIsDefault.identifier()).
+		OrderByClause(orgPolicyChainOrder(PasswordAgeColID, PasswordAgeColIsDefault, chain)).
 		Limit(1).ToSql()
 	if err != nil {
 		return nil, zerrors.ThrowInternal(err, "QUERY-SKR6X", "Errors.Query.SQLStatement")
@@ -155,6 +167,8 @@ func preparePasswordAgePolicyQuery() (sq.SelectBuilder, func(*sql.Row) (*Passwor
 			PasswordAgeColResourceOwner.identifier(),
 			PasswordAgeColWarnDays.identifier(),
 			PasswordAgeColMaxAge.identifier(),
+			PasswordAgeColHistoryCount.identifier(),
+			PasswordAgeColMinAge.identifier(),
 			PasswordAgeColIsDefault.identifier(),
 			PasswordAgeColState.identifier(),
 		).
@@ -170,6 +184,8 @@ func preparePasswordAgePolicyQuery() (sq.SelectBuilder, func(*sql.Row) (*Passwor
 				&policy.ResourceOwner,
 				&policy.ExpireWarnDays,
 				&policy.MaxAgeDays,
+				&policy.HistoryCount,
+				&policy.MinAgeDays,
 				&policy.IsDefault,
 				&policy.State,
 			)
@@ -20,6 +20,8 @@ var (
 		` projections.password_age_policies2.resource_owner,` +
 		` projections.password_age_policies2.expire_warn_days,` +
 		` projections.password_age_policies2.max_age_days,` +
+		` projections.password_age_policies2.history_count,` +
+		` projections.password_age_policies2.min_age_days,` +
 		` projections.password_age_policies2.is_default,` +
 		` projections.password_age_policies2.state` +
 		` FROM projections.password_age_policies2`
@@ -31,6 +33,8 @@ var (
 		"resource_owner",
 		"expire_warn_days",
 		"max_age_days",
+		"history_count",
+		"min_age_days",
 		"is_default",
 		"state",
 	}
@@ -80,6 +84,8 @@ func Test_PasswordAgePolicyPrepares(t *testing.T) {
 						"ro",
 						10,
 						20,
+						5,
+						1,
 						true,
 						domain.PolicyStateActive,
 					},
@@ -94,6 +100,8 @@ func Test_PasswordAgePolicyPrepares(t *testing.T) {
 				State:          domain.PolicyStateActive,
 				ExpireWarnDays: 10,
 				MaxAgeDays:     20,
+				HistoryCount:   5,
+				MinAgeDays:     1,
 				IsDefault:      true,
 			},
 		},
@@ -0,0 +1,84 @@
+package query
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"strings"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+)
+
+// ProjectAuthorizationDetailType is an authorization details type (RFC 9396) clients can request for a project.
+type ProjectAuthorizationDetailType struct {
+	ProjectID string
+	Type      string
+	Schema    json.RawMessage
+}
+
+type projectAuthorizationDetailTypesReadModel struct {
+	eventstore.ReadModel
+
+	types map[string]json.RawMessage
+}
+
+func newProjectAuthorizationDetailTypesReadModel(projectID string) *projectAuthorizationDetailTypesReadModel {
+	return &projectAuthorizationDetailTypesReadModel{
+		ReadModel: eventstore.ReadModel{
+			AggregateID: projectID,
+		},
+		types: make(map[string]json.RawMessage),
+	}
+}
+
+func (rm *projectAuthorizationDetailTypesReadModel) Reduce() error {
+	for _, event := range rm.Events {
+		switch e := event.(type) {
+		case *project.AuthorizationDetailTypeSetEvent:
+			rm.types[e.DetailType] = e.Schema
+		case *project.AuthorizationDetailTypeRemovedEvent:
+			delete(rm.types, e.DetailType)
+		case *project.ProjectRemovedEvent:
+			clear(rm.types)
+		}
+	}
+	return rm.ReadModel.Reduce()
+}
+
+func (rm *projectAuthorizationDetailTypesReadModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(project.AggregateType).
+		AggregateIDs(rm.AggregateID).
+		EventTypes(
+			project.AuthorizationDetailTypeSetType,
+			project.AuthorizationDetailTypeRemovedType,
+			project.ProjectRemovedType,
+		).
+		Builder()
+}
+
+// ProjectAuthorizationDetailTypes returns the authorization details types of the project sorted by type.
+func (q *Queries) ProjectAuthorizationDetailTypes(ctx context.Context, projectID string) (_ []*ProjectAuthorizationDetailType, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	model := newProjectAuthorizationDetailTypesReadModel(projectID)
+	if err = q.eventstore.FilterToQueryReducer(ctx, model); err != nil {
+		return nil, err
+	}
+	types := make([]*ProjectAuthorizationDetailType, 0, len(model.types))
+	for detailType, schema := range model.types {
+		types = append(types, &ProjectAuthorizationDetailType{
+			ProjectID: projectID,
+			Type:      detailType,
+			Schema:    schema,
+		})
+	}
+	slices.SortFunc(types, func(a, b *ProjectAuthorizationDetailType) int {
+		return strings.Compare(a.Type, b.Type)
+	})
+	return types, nil
+}
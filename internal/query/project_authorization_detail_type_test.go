@@ -0,0 +1,104 @@
+package query
+
+import (
+	"encoding/json"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/project"
+)
+
+func TestQueries_ProjectAuthorizationDetailTypes(t *testing.T) {
+	ctx := authz.NewMockContextWithPermissions("instance1", "org1", "user1", nil)
+	agg := &project.NewAggregate("project1", "org1").Aggregate
+	tests := []struct {
+		name       string
+		eventstore func(*testing.T) *eventstore.Eventstore
+		want       []*ProjectAuthorizationDetailType
+		wantErr    error
+	}{
+		{
+			name: "filter error",
+			eventstore: expectEventstore(
+				expectFilterError(io.ErrClosedPipe),
+			),
+			wantErr: io.ErrClosedPipe,
+		},
+		{
+			name: "no types",
+			eventstore: expectEventstore(
+				expectFilter(),
+			),
+			want: []*ProjectAuthorizationDetailType{},
+		},
+		{
+			name: "types set, changed and removed",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(project.NewAuthorizationDetailTypeSetEvent(ctx, agg,
+						"payment_initiation",
+						json.RawMessage(`{"type":"object"}`),
+					)),
+					eventFromEventPusher(project.NewAuthorizationDetailTypeSetEvent(ctx, agg,
+						"account_information",
+						json.RawMessage(`{"type":"object"}`),
+					)),
+					eventFromEventPusher(project.NewAuthorizationDetailTypeSetEvent(ctx, agg,
+						"payment_initiation",
+						json.RawMessage(`{"type":"object","required":["instructedAmount"]}`),
+					)),
+					eventFromEventPusher(project.NewAuthorizationDetailTypeSetEvent(ctx, agg,
+						"customer_information",
+						json.RawMessage(`{"type":"object"}`),
+					)),
+					eventFromEventPusher(project.NewAuthorizationDetailTypeRemovedEvent(ctx, agg,
+						"customer_information",
+					)),
+				),
+			),
+			want: []*ProjectAuthorizationDetailType{
+				{
+					ProjectID: "project1",
+					Type:      "account_information",
+					Schema:    json.RawMessage(`{"type":"object"}`),
+				},
+				{
+					ProjectID: "project1",
+					Type:      "payment_initiation",
+					Schema:    json.RawMessage(`{"type":"object","required":["instructedAmount"]}`),
+				},
+			},
+		},
+		{
+			name: "project removed",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(project.NewAuthorizationDetailTypeSetEvent(ctx, agg,
+						"payment_initiation",
+						json.RawMessage(`{"type":"object"}`),
+					)),
+					eventFromEventPusher(project.NewProjectRemovedEvent(ctx, agg,
+						"projectname1",
+						nil,
+					)),
+				),
+			),
+			want: []*ProjectAuthorizationDetailType{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := &Queries{
+				eventstore: tt.eventstore(t),
+			}
+			got, err := q.ProjectAuthorizationDetailTypes(ctx, "project1")
+			require.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,246 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/accessrequest"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/repository/user"
+)
+
+const (
+	AccessRequestTable              = "projections.access_requests"
+	AccessRequestIDCol              = "id"
+	AccessRequestCreationDateCol    = "creation_date"
+	AccessRequestChangeDateCol      = "change_date"
+	AccessRequestResourceOwnerCol   = "resource_owner"
+	AccessRequestInstanceIDCol      = "instance_id"
+	AccessRequestSequenceCol        = "sequence"
+	AccessRequestStateCol           = "state"
+	AccessRequestUserIDCol          = "user_id"
+	AccessRequestProjectIDCol       = "project_id"
+	AccessRequestProjectGrantIDCol  = "grant_id"
+	AccessRequestRoleKeysCol        = "role_keys"
+	AccessRequestReasonCol          = "reason"
+	AccessRequestDeciderIDCol       = "decider_id"
+	AccessRequestDecisionCommentCol = "decision_comment"
+	AccessRequestUserGrantIDCol     = "user_grant_id"
+)
+
+type accessRequestProjection struct{}
+
+func newAccessRequestProjection(ctx context.Context, config handler.Config) *handler.Handler {
+	return handler.NewHandler(ctx, &config, new(accessRequestProjection))
+}
+
+func (*accessRequestProjection) Name() string {
+	return AccessRequestTable
+}
+
+func (*accessRequestProjection) Init() *old_handler.Check {
+	return handler.NewTableCheck(
+		handler.NewTable([]*handler.InitColumn{
+			handler.NewColumn(AccessRequestIDCol, handler.ColumnTypeText),
+			handler.NewColumn(AccessRequestCreationDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(AccessRequestChangeDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(AccessRequestResourceOwnerCol, handler.ColumnTypeText),
+			handler.NewColumn(AccessRequestInstanceIDCol, handler.ColumnTypeText),
+			handler.NewColumn(AccessRequestSequenceCol, handler.ColumnTypeInt64),
+			handler.NewColumn(AccessRequestStateCol, handler.ColumnTypeEnum),
+			handler.NewColumn(AccessRequestUserIDCol, handler.ColumnTypeText),
+			handler.NewColumn(AccessRequestProjectIDCol, handler.ColumnTypeText),
+			handler.NewColumn(AccessRequestProjectGrantIDCol, handler.ColumnTypeText, handler.Default("")),
+			handler.NewColumn(AccessRequestRoleKeysCol, handler.ColumnTypeTextArray),
+			handler.NewColumn(AccessRequestReasonCol, handler.ColumnTypeText, handler.Default("")),
+			handler.NewColumn(AccessRequestDeciderIDCol, handler.ColumnTypeText, handler.Default("")),
+			handler.NewColumn(AccessRequestDecisionCommentCol, handler.ColumnTypeText, handler.Default("")),
+			handler.NewColumn(AccessRequestUserGrantIDCol, handler.ColumnTypeText, handler.Default("")),
+		},
+			handler.NewPrimaryKey(AccessRequestInstanceIDCol, AccessRequestIDCol),
+			handler.WithIndex(handler.NewIndex("user_id", []string{AccessRequestInstanceIDCol, AccessRequestUserIDCol})),
+			handler.WithIndex(handler.NewIndex("project_id", []string{AccessRequestInstanceIDCol, AccessRequestProjectIDCol})),
+			handler.WithIndex(handler.NewIndex("resource_owner", []string{AccessRequestResourceOwnerCol})),
+		),
+	)
+}
+
+func (p *accessRequestProjection) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: accessrequest.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  accessrequest.AddedType,
+					Reduce: p.reduceAdded,
+				},
+				{
+					Event:  accessrequest.ApprovedType,
+					Reduce: p.reduceApproved,
+				},
+				{
+					Event:  accessrequest.DeniedType,
+					Reduce: p.reduceDenied,
+				},
+				{
+					Event:  accessrequest.WithdrawnType,
+					Reduce: p.reduceWithdrawn,
+				},
+			},
+		},
+		{
+			Aggregate: user.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  user.UserRemovedType,
+					Reduce: p.reduceUserRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: project.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  project.ProjectRemovedType,
+					Reduce: p.reduceProjectRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: org.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOwnerRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: reduceInstanceRemovedHelper(AccessRequestInstanceIDCol),
+				},
+			},
+		},
+	}
+}
+
+func (p *accessRequestProjection) reduceAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*accessrequest.AddedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewCreateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(AccessRequestInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCol(AccessRequestResourceOwnerCol, e.Aggregate().ResourceOwner),
+			handler.NewCol(AccessRequestIDCol, e.Aggregate().ID),
+			handler.NewCol(AccessRequestCreationDateCol, e.CreationDate()),
+			handler.NewCol(AccessRequestChangeDateCol, e.CreationDate()),
+			handler.NewCol(AccessRequestSequenceCol, e.Sequence()),
+			handler.NewCol(AccessRequestStateCol, domain.AccessRequestStatePending),
+			handler.NewCol(AccessRequestUserIDCol, e.UserID),
+			handler.NewCol(AccessRequestProjectIDCol, e.ProjectID),
+			handler.NewCol(AccessRequestProjectGrantIDCol, e.ProjectGrantID),
+			handler.NewCol(AccessRequestRoleKeysCol, database.TextArray[string](e.RoleKeys)),
+			handler.NewCol(AccessRequestReasonCol, e.Reason),
+		},
+	), nil
+}
+
+func (p *accessRequestProjection) reduceApproved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*accessrequest.ApprovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return p.updateState(e, domain.AccessRequestStateApproved,
+		handler.NewCol(AccessRequestDeciderIDCol, e.Creator()),
+		handler.NewCol(AccessRequestDecisionCommentCol, e.Comment),
+		handler.NewCol(AccessRequestUserGrantIDCol, e.UserGrantID),
+	), nil
+}
+
+func (p *accessRequestProjection) reduceDenied(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*accessrequest.DeniedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return p.updateState(e, domain.AccessRequestStateDenied,
+		handler.NewCol(AccessRequestDeciderIDCol, e.Creator()),
+		handler.NewCol(AccessRequestDecisionCommentCol, e.Comment),
+	), nil
+}
+
+func (p *accessRequestProjection) reduceWithdrawn(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*accessrequest.WithdrawnEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return p.updateState(e, domain.AccessRequestStateWithdrawn), nil
+}
+
+func (p *accessRequestProjection) updateState(event eventstore.Event, state domain.AccessRequestState, columns ...handler.Column) *handler.Statement {
+	return handler.NewUpdateStatement(
+		event,
+		append([]handler.Column{
+			handler.NewCol(AccessRequestChangeDateCol, event.CreatedAt()),
+			handler.NewCol(AccessRequestSequenceCol, event.Sequence()),
+			handler.NewCol(AccessRequestStateCol, state),
+		}, columns...),
+		[]handler.Condition{
+			handler.NewCond(AccessRequestInstanceIDCol, event.Aggregate().InstanceID),
+			handler.NewCond(AccessRequestIDCol, event.Aggregate().ID),
+		},
+	)
+}
+
+func (p *accessRequestProjection) reduceUserRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*user.UserRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(AccessRequestInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCond(AccessRequestUserIDCol, e.Aggregate().ID),
+		},
+	), nil
+}
+
+func (p *accessRequestProjection) reduceProjectRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*project.ProjectRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(AccessRequestInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCond(AccessRequestProjectIDCol, e.Aggregate().ID),
+		},
+	), nil
+}
+
+func (p *accessRequestProjection) reduceOwnerRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*org.OrgRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(AccessRequestInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCond(AccessRequestResourceOwnerCol, e.Aggregate().ID),
+		},
+	), nil
+}
@@ -0,0 +1,238 @@
+package projection
+
+import (
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/accessrequest"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestAccessRequestProjection_reduces(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "reduceAdded",
+			args: args{
+				event: getEvent(
+					testEvent(
+						accessrequest.AddedType,
+						accessrequest.AggregateType,
+						[]byte(`{"userId": "user-id", "projectId": "project-id", "roleKeys": ["role"], "reason": "reason"}`),
+					),
+					accessrequest.AddedEventMapper,
+				),
+			},
+			reduce: (&accessRequestProjection{}).reduceAdded,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("access_request"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.access_requests (instance_id, resource_owner, id, creation_date, change_date, sequence, state, user_id, project_id, grant_id, role_keys, reason) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"ro-id",
+								"agg-id",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								domain.AccessRequestStatePending,
+								"user-id",
+								"project-id",
+								"",
+								database.TextArray[string]{"role"},
+								"reason",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceApproved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						accessrequest.ApprovedType,
+						accessrequest.AggregateType,
+						[]byte(`{"userId": "user-id", "projectId": "project-id", "userGrantId": "user-grant-id", "comment": "comment"}`),
+					),
+					accessrequest.ApprovedEventMapper,
+				),
+			},
+			reduce: (&accessRequestProjection{}).reduceApproved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("access_request"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.access_requests SET (change_date, sequence, state, decider_id, decision_comment, user_grant_id) = ($1, $2, $3, $4, $5, $6) WHERE (instance_id = $7) AND (id = $8)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								domain.AccessRequestStateApproved,
+								"editor-user",
+								"comment",
+								"user-grant-id",
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceDenied",
+			args: args{
+				event: getEvent(
+					testEvent(
+						accessrequest.DeniedType,
+						accessrequest.AggregateType,
+						[]byte(`{"userId": "user-id", "projectId": "project-id", "comment": "comment"}`),
+					),
+					accessrequest.DeniedEventMapper,
+				),
+			},
+			reduce: (&accessRequestProjection{}).reduceDenied,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("access_request"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.access_requests SET (change_date, sequence, state, decider_id, decision_comment) = ($1, $2, $3, $4, $5) WHERE (instance_id = $6) AND (id = $7)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								domain.AccessRequestStateDenied,
+								"editor-user",
+								"comment",
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceWithdrawn",
+			args: args{
+				event: getEvent(
+					testEvent(
+						accessrequest.WithdrawnType,
+						accessrequest.AggregateType,
+						[]byte(`{"userId": "user-id", "projectId": "project-id"}`),
+					),
+					accessrequest.WithdrawnEventMapper,
+				),
+			},
+			reduce: (&accessRequestProjection{}).reduceWithdrawn,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("access_request"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.access_requests SET (change_date, sequence, state) = ($1, $2, $3) WHERE (instance_id = $4) AND (id = $5)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								domain.AccessRequestStateWithdrawn,
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceUserRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						user.UserRemovedType,
+						user.AggregateType,
+						nil,
+					),
+					user.UserRemovedEventMapper,
+				),
+			},
+			reduce: (&accessRequestProjection{}).reduceUserRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("user"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.access_requests WHERE (instance_id = $1) AND (user_id = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceOwnerRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						org.OrgRemovedEventType,
+						org.AggregateType,
+						nil,
+					),
+					org.OrgRemovedEventMapper,
+				),
+			},
+			reduce: (&accessRequestProjection{}).reduceOwnerRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("org"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.access_requests WHERE (instance_id = $1) AND (resource_owner = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if ok := zerrors.IsErrorInvalidArgument(err); !ok {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, AccessRequestTable, tt.want)
+		})
+	}
+}
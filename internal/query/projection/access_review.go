@@ -0,0 +1,264 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/accessreview"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+)
+
+const (
+	AccessReviewTable            = "projections.access_reviews"
+	AccessReviewIDCol            = "id"
+	AccessReviewCreationDateCol  = "creation_date"
+	AccessReviewChangeDateCol    = "change_date"
+	AccessReviewResourceOwnerCol = "resource_owner"
+	AccessReviewInstanceIDCol    = "instance_id"
+	AccessReviewSequenceCol      = "sequence"
+	AccessReviewStateCol         = "state"
+	AccessReviewNameCol          = "name"
+	AccessReviewProjectIDCol     = "project_id"
+	AccessReviewDeadlineCol      = "deadline"
+
+	AccessReviewItemSuffix         = "items"
+	AccessReviewItemInstanceIDCol  = "instance_id"
+	AccessReviewItemReviewIDCol    = "review_id"
+	AccessReviewItemIDCol          = "id"
+	AccessReviewItemChangeDateCol  = "change_date"
+	AccessReviewItemTypeCol        = "type"
+	AccessReviewItemUserIDCol      = "user_id"
+	AccessReviewItemProjectIDCol   = "project_id"
+	AccessReviewItemGrantIDCol     = "grant_id"
+	AccessReviewItemUserGrantIDCol = "user_grant_id"
+	AccessReviewItemRolesCol       = "roles"
+	AccessReviewItemReviewerIDCol  = "reviewer_id"
+	AccessReviewItemDecisionCol    = "decision"
+	AccessReviewItemDeciderIDCol   = "decider_id"
+	AccessReviewItemCommentCol     = "comment"
+)
+
+type accessReviewProjection struct{}
+
+func newAccessReviewProjection(ctx context.Context, config handler.Config) *handler.Handler {
+	return handler.NewHandler(ctx, &config, new(accessReviewProjection))
+}
+
+func (*accessReviewProjection) Name() string {
+	return AccessReviewTable
+}
+
+func (*accessReviewProjection) Init() *old_handler.Check {
+	return handler.NewMultiTableCheck(
+		handler.NewTable([]*handler.InitColumn{
+			handler.NewColumn(AccessReviewIDCol, handler.ColumnTypeText),
+			handler.NewColumn(AccessReviewCreationDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(AccessReviewChangeDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(AccessReviewResourceOwnerCol, handler.ColumnTypeText),
+			handler.NewColumn(AccessReviewInstanceIDCol, handler.ColumnTypeText),
+			handler.NewColumn(AccessReviewSequenceCol, handler.ColumnTypeInt64),
+			handler.NewColumn(AccessReviewStateCol, handler.ColumnTypeEnum),
+			handler.NewColumn(AccessReviewNameCol, handler.ColumnTypeText),
+			handler.NewColumn(AccessReviewProjectIDCol, handler.ColumnTypeText, handler.Default("")),
+			handler.NewColumn(AccessReviewDeadlineCol, handler.ColumnTypeTimestamp),
+		},
+			handler.NewPrimaryKey(AccessReviewInstanceIDCol, AccessReviewIDCol),
+			handler.WithIndex(handler.NewIndex("resource_owner", []string{AccessReviewResourceOwnerCol})),
+			handler.WithIndex(handler.NewIndex("deadline", []string{AccessReviewStateCol, AccessReviewDeadlineCol})),
+		),
+		handler.NewSuffixedTable([]*handler.InitColumn{
+			handler.NewColumn(AccessReviewItemInstanceIDCol, handler.ColumnTypeText),
+			handler.NewColumn(AccessReviewItemReviewIDCol, handler.ColumnTypeText),
+			handler.NewColumn(AccessReviewItemIDCol, handler.ColumnTypeText),
+			handler.NewColumn(AccessReviewItemChangeDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(AccessReviewItemTypeCol, handler.ColumnTypeEnum),
+			handler.NewColumn(AccessReviewItemUserIDCol, handler.ColumnTypeText),
+			handler.NewColumn(AccessReviewItemProjectIDCol, handler.ColumnTypeText, handler.Default("")),
+			handler.NewColumn(AccessReviewItemGrantIDCol, handler.ColumnTypeText, handler.Default("")),
+			handler.NewColumn(AccessReviewItemUserGrantIDCol, handler.ColumnTypeText, handler.Default("")),
+			handler.NewColumn(AccessReviewItemRolesCol, handler.ColumnTypeTextArray, handler.Nullable()),
+			handler.NewColumn(AccessReviewItemReviewerIDCol, handler.ColumnTypeText),
+			handler.NewColumn(AccessReviewItemDecisionCol, handler.ColumnTypeEnum),
+			handler.NewColumn(AccessReviewItemDeciderIDCol, handler.ColumnTypeText, handler.Default("")),
+			handler.NewColumn(AccessReviewItemCommentCol, handler.ColumnTypeText, handler.Default("")),
+		},
+			handler.NewPrimaryKey(AccessReviewItemInstanceIDCol, AccessReviewItemReviewIDCol, AccessReviewItemIDCol),
+			AccessReviewItemSuffix,
+			handler.WithForeignKey(handler.NewForeignKey("review", []string{AccessReviewItemInstanceIDCol, AccessReviewItemReviewIDCol}, []string{AccessReviewInstanceIDCol, AccessReviewIDCol})),
+			handler.WithIndex(handler.NewIndex("reviewer_id", []string{AccessReviewItemInstanceIDCol, AccessReviewItemReviewerIDCol})),
+		),
+	)
+}
+
+func (p *accessReviewProjection) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: accessreview.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  accessreview.StartedType,
+					Reduce: p.reduceStarted,
+				},
+				{
+					Event:  accessreview.ItemDecidedType,
+					Reduce: p.reduceItemDecided,
+				},
+				{
+					Event:  accessreview.ItemReassignedType,
+					Reduce: p.reduceItemReassigned,
+				},
+				{
+					Event:  accessreview.ClosedType,
+					Reduce: p.reduceClosed,
+				},
+			},
+		},
+		{
+			Aggregate: org.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOwnerRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: reduceInstanceRemovedHelper(AccessReviewInstanceIDCol),
+				},
+			},
+		},
+	}
+}
+
+func (p *accessReviewProjection) reduceStarted(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*accessreview.StartedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	stmts := make([]func(eventstore.Event) handler.Exec, 0, len(e.Items)+1)
+	stmts = append(stmts, handler.AddCreateStatement(
+		[]handler.Column{
+			handler.NewCol(AccessReviewInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCol(AccessReviewResourceOwnerCol, e.Aggregate().ResourceOwner),
+			handler.NewCol(AccessReviewIDCol, e.Aggregate().ID),
+			handler.NewCol(AccessReviewCreationDateCol, e.CreationDate()),
+			handler.NewCol(AccessReviewChangeDateCol, e.CreationDate()),
+			handler.NewCol(AccessReviewSequenceCol, e.Sequence()),
+			handler.NewCol(AccessReviewStateCol, domain.AccessReviewStateActive),
+			handler.NewCol(AccessReviewNameCol, e.Name),
+			handler.NewCol(AccessReviewProjectIDCol, e.ProjectID),
+			handler.NewCol(AccessReviewDeadlineCol, e.Deadline),
+		},
+	))
+	for _, item := range e.Items {
+		stmts = append(stmts, handler.AddCreateStatement(
+			[]handler.Column{
+				handler.NewCol(AccessReviewItemInstanceIDCol, e.Aggregate().InstanceID),
+				handler.NewCol(AccessReviewItemReviewIDCol, e.Aggregate().ID),
+				handler.NewCol(AccessReviewItemIDCol, item.ID),
+				handler.NewCol(AccessReviewItemChangeDateCol, e.CreationDate()),
+				handler.NewCol(AccessReviewItemTypeCol, item.Type),
+				handler.NewCol(AccessReviewItemUserIDCol, item.UserID),
+				handler.NewCol(AccessReviewItemProjectIDCol, item.ProjectID),
+				handler.NewCol(AccessReviewItemGrantIDCol, item.ProjectGrantID),
+				handler.NewCol(AccessReviewItemUserGrantIDCol, item.UserGrantID),
+				handler.NewCol(AccessReviewItemRolesCol, database.TextArray[string](item.Roles)),
+				handler.NewCol(AccessReviewItemReviewerIDCol, item.ReviewerID),
+				handler.NewCol(AccessReviewItemDecisionCol, domain.AccessReviewDecisionPending),
+			},
+			handler.WithTableSuffix(AccessReviewItemSuffix),
+		))
+	}
+	return handler.NewMultiStatement(e, stmts...), nil
+}
+
+func (p *accessReviewProjection) reduceItemDecided(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*accessreview.ItemDecidedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewMultiStatement(
+		e,
+		p.updateItem(e, e.ItemID,
+			handler.NewCol(AccessReviewItemDecisionCol, e.Decision),
+			handler.NewCol(AccessReviewItemDeciderIDCol, e.Creator()),
+			handler.NewCol(AccessReviewItemCommentCol, e.Comment),
+		),
+		p.updateReview(e),
+	), nil
+}
+
+func (p *accessReviewProjection) reduceItemReassigned(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*accessreview.ItemReassignedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewMultiStatement(
+		e,
+		p.updateItem(e, e.ItemID,
+			handler.NewCol(AccessReviewItemReviewerIDCol, e.ReviewerID),
+		),
+		p.updateReview(e),
+	), nil
+}
+
+func (p *accessReviewProjection) reduceClosed(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*accessreview.ClosedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewMultiStatement(
+		e,
+		p.updateReview(e, handler.NewCol(AccessReviewStateCol, domain.AccessReviewStateClosed)),
+	), nil
+}
+
+func (p *accessReviewProjection) updateItem(event eventstore.Event, itemID string, columns ...handler.Column) func(eventstore.Event) handler.Exec {
+	return handler.AddUpdateStatement(
+		append([]handler.Column{
+			handler.NewCol(AccessReviewItemChangeDateCol, event.CreatedAt()),
+		}, columns...),
+		[]handler.Condition{
+			handler.NewCond(AccessReviewItemInstanceIDCol, event.Aggregate().InstanceID),
+			handler.NewCond(AccessReviewItemReviewIDCol, event.Aggregate().ID),
+			handler.NewCond(AccessReviewItemIDCol, itemID),
+		},
+		handler.WithTableSuffix(AccessReviewItemSuffix),
+	)
+}
+
+func (p *accessReviewProjection) updateReview(event eventstore.Event, columns ...handler.Column) func(eventstore.Event) handler.Exec {
+	return handler.AddUpdateStatement(
+		append([]handler.Column{
+			handler.NewCol(AccessReviewChangeDateCol, event.CreatedAt()),
+			handler.NewCol(AccessReviewSequenceCol, event.Sequence()),
+		}, columns...),
+		[]handler.Condition{
+			handler.NewCond(AccessReviewInstanceIDCol, event.Aggregate().InstanceID),
+			handler.NewCond(AccessReviewIDCol, event.Aggregate().ID),
+		},
+	)
+}
+
+func (p *accessReviewProjection) reduceOwnerRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*org.OrgRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(AccessReviewInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCond(AccessReviewResourceOwnerCol, e.Aggregate().ID),
+		},
+	), nil
+}
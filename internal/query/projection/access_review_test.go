@@ -0,0 +1,238 @@
+package projection
+
+import (
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/accessreview"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestAccessReviewProjection_reduces(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "reduceStarted",
+			args: args{
+				event: getEvent(
+					testEvent(
+						accessreview.StartedType,
+						accessreview.AggregateType,
+						[]byte(`{"name": "Q1", "deadline": "2025-03-31T00:00:00Z", "items": [{"id": "item-id", "type": 1, "userId": "user-id", "projectId": "project-id", "userGrantId": "grant-id", "roles": ["role"], "reviewerId": "reviewer-id"}]}`),
+					),
+					accessreview.StartedEventMapper,
+				),
+			},
+			reduce: (&accessReviewProjection{}).reduceStarted,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("access_review"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.access_reviews (instance_id, resource_owner, id, creation_date, change_date, sequence, state, name, project_id, deadline) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"ro-id",
+								"agg-id",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								domain.AccessReviewStateActive,
+								"Q1",
+								"",
+								anyArg{},
+							},
+						},
+						{
+							expectedStmt: "INSERT INTO projections.access_reviews_items (instance_id, review_id, id, change_date, type, user_id, project_id, grant_id, user_grant_id, roles, reviewer_id, decision) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+								"item-id",
+								anyArg{},
+								domain.AccessReviewItemTypeUserGrant,
+								"user-id",
+								"project-id",
+								"",
+								"grant-id",
+								database.TextArray[string]{"role"},
+								"reviewer-id",
+								domain.AccessReviewDecisionPending,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceItemDecided",
+			args: args{
+				event: getEvent(
+					testEvent(
+						accessreview.ItemDecidedType,
+						accessreview.AggregateType,
+						[]byte(`{"itemId": "item-id", "decision": 2, "comment": "comment"}`),
+					),
+					accessreview.ItemDecidedEventMapper,
+				),
+			},
+			reduce: (&accessReviewProjection{}).reduceItemDecided,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("access_review"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.access_reviews_items SET (change_date, decision, decider_id, comment) = ($1, $2, $3, $4) WHERE (instance_id = $5) AND (review_id = $6) AND (id = $7)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								domain.AccessReviewDecisionRevoke,
+								"editor-user",
+								"comment",
+								"instance-id",
+								"agg-id",
+								"item-id",
+							},
+						},
+						{
+							expectedStmt: "UPDATE projections.access_reviews SET (change_date, sequence) = ($1, $2) WHERE (instance_id = $3) AND (id = $4)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceItemReassigned",
+			args: args{
+				event: getEvent(
+					testEvent(
+						accessreview.ItemReassignedType,
+						accessreview.AggregateType,
+						[]byte(`{"itemId": "item-id", "reviewerId": "reviewer-id"}`),
+					),
+					accessreview.ItemReassignedEventMapper,
+				),
+			},
+			reduce: (&accessReviewProjection{}).reduceItemReassigned,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("access_review"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.access_reviews_items SET (change_date, reviewer_id) = ($1, $2) WHERE (instance_id = $3) AND (review_id = $4) AND (id = $5)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								"reviewer-id",
+								"instance-id",
+								"agg-id",
+								"item-id",
+							},
+						},
+						{
+							expectedStmt: "UPDATE projections.access_reviews SET (change_date, sequence) = ($1, $2) WHERE (instance_id = $3) AND (id = $4)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceClosed",
+			args: args{
+				event: getEvent(
+					testEvent(
+						accessreview.ClosedType,
+						accessreview.AggregateType,
+						nil,
+					),
+					accessreview.ClosedEventMapper,
+				),
+			},
+			reduce: (&accessReviewProjection{}).reduceClosed,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("access_review"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.access_reviews SET (change_date, sequence, state) = ($1, $2, $3) WHERE (instance_id = $4) AND (id = $5)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								domain.AccessReviewStateClosed,
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceOwnerRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						org.OrgRemovedEventType,
+						org.AggregateType,
+						nil,
+					),
+					org.OrgRemovedEventMapper,
+				),
+			},
+			reduce: (&accessReviewProjection{}).reduceOwnerRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("org"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.access_reviews WHERE (instance_id = $1) AND (resource_owner = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if ok := zerrors.IsErrorInvalidArgument(err); !ok {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, AccessReviewTable, tt.want)
+		})
+	}
+}
@@ -38,29 +38,38 @@ const (
 	AppAPIConfigColumnClientSecret = "client_secret"
 	AppAPIConfigColumnAuthMethod   = "auth_method"
 
-	appOIDCTableSuffix                          = "oidc_configs"
-	AppOIDCConfigColumnAppID                    = "app_id"
-	AppOIDCConfigColumnInstanceID               = "instance_id"
-	AppOIDCConfigColumnVersion                  = "version"
-	AppOIDCConfigColumnClientID                 = "client_id"
-	AppOIDCConfigColumnClientSecret             = "client_secret"
-	AppOIDCConfigColumnRedirectUris             = "redirect_uris"
-	AppOIDCConfigColumnResponseTypes            = "response_types"
-	AppOIDCConfigColumnGrantTypes               = "grant_types"
-	AppOIDCConfigColumnApplicationType          = "application_type"
-	AppOIDCConfigColumnAuthMethodType           = "auth_method_type"
-	AppOIDCConfigColumnPostLogoutRedirectUris   = "post_logout_redirect_uris"
-	AppOIDCConfigColumnDevMode                  = "is_dev_mode"
-	AppOIDCConfigColumnAccessTokenType          = "access_token_type"
-	AppOIDCConfigColumnAccessTokenRoleAssertion = "access_token_role_assertion"
-	AppOIDCConfigColumnIDTokenRoleAssertion     = "id_token_role_assertion"
-	AppOIDCConfigColumnIDTokenUserinfoAssertion = "id_token_userinfo_assertion"
-	AppOIDCConfigColumnClockSkew                = "clock_skew"
-	AppOIDCConfigColumnAdditionalOrigins        = "additional_origins"
-	AppOIDCConfigColumnSkipNativeAppSuccessPage = "skip_native_app_success_page"
-	AppOIDCConfigColumnBackChannelLogoutURI     = "back_channel_logout_uri"
-	AppOIDCConfigColumnLoginVersion             = "login_version"
-	AppOIDCConfigColumnLoginBaseURI             = "login_base_uri"
+	appOIDCTableSuffix                                       = "oidc_configs"
+	AppOIDCConfigColumnAppID                                 = "app_id"
+	AppOIDCConfigColumnInstanceID                            = "instance_id"
+	AppOIDCConfigColumnVersion                               = "version"
+	AppOIDCConfigColumnClientID                              = "client_id"
+	AppOIDCConfigColumnClientSecret                          = "client_secret"
+	AppOIDCConfigColumnRedirectUris                          = "redirect_uris"
+	AppOIDCConfigColumnResponseTypes                         = "response_types"
+	AppOIDCConfigColumnGrantTypes                            = "grant_types"
+	AppOIDCConfigColumnApplicationType                       = "application_type"
+	AppOIDCConfigColumnAuthMethodType                        = "auth_method_type"
+	AppOIDCConfigColumnPostLogoutRedirectUris                = "post_logout_redirect_uris"
+	AppOIDCConfigColumnDevMode                               = "is_dev_mode"
+	AppOIDCConfigColumnAccessTokenType                       = "access_token_type"
+	AppOIDCConfigColumnAccessTokenRoleAssertion              = "access_token_role_assertion"
+	AppOIDCConfigColumnIDTokenRoleAssertion                  = "id_token_role_assertion"
+	AppOIDCConfigColumnIDTokenUserinfoAssertion              = "id_token_userinfo_assertion"
+	AppOIDCConfigColumnClockSkew                             = "clock_skew"
+	AppOIDCConfigColumnAdditionalOrigins                     = "additional_origins"
+	AppOIDCConfigColumnSkipNativeAppSuccessPage              = "skip_native_app_success_page"
+	AppOIDCConfigColumnBackChannelLogoutURI                  = "back_channel_logout_uri"
+	AppOIDCConfigColumnLoginVersion                          = "login_version"
+	AppOIDCConfigColumnLoginBaseURI                          = "login_base_uri"
+	AppOIDCConfigColumnDPoPBoundAccessTokens                 = "dpop_bound_access_tokens"
+	AppOIDCConfigColumnRequirePushedAuthRequests             = "require_pushed_auth_requests"
+	AppOIDCConfigColumnCIBANotificationURI                   = "ciba_notification_uri"
+	AppOIDCConfigColumnConsentRequired                       = "consent_required"
+	AppOIDCConfigColumnTLSClientAuthSubjectDN                = "tls_client_auth_subject_dn"
+	AppOIDCConfigColumnTLSClientCertificates                 = "tls_client_certificates"
+	AppOIDCConfigColumnTLSClientCertificateBoundAccessTokens = "tls_client_certificate_bound_access_tokens"
+	AppOIDCConfigColumnRefreshTokenRotation                  = "refresh_token_rotation"
+	AppOIDCConfigColumnEncryptAuthorizationResponse          = "encrypt_authorization_response"
 
 	appSAMLTableSuffix              = "saml_configs"
 	AppSAMLConfigColumnAppID        = "app_id"
@@ -133,6 +142,15 @@ func (*appProjection) Init() *old_handler.Check {
 			handler.NewColumn(AppOIDCConfigColumnBackChannelLogoutURI, handler.ColumnTypeText, handler.Nullable()),
 			handler.NewColumn(AppOIDCConfigColumnLoginVersion, handler.ColumnTypeEnum, handler.Nullable()),
 			handler.NewColumn(AppOIDCConfigColumnLoginBaseURI, handler.ColumnTypeText, handler.Nullable()),
+			handler.NewColumn(AppOIDCConfigColumnDPoPBoundAccessTokens, handler.ColumnTypeBool, handler.Default(false)),
+			handler.NewColumn(AppOIDCConfigColumnRequirePushedAuthRequests, handler.ColumnTypeBool, handler.Default(false)),
+			handler.NewColumn(AppOIDCConfigColumnCIBANotificationURI, handler.ColumnTypeText, handler.Nullable()),
+			handler.NewColumn(AppOIDCConfigColumnConsentRequired, handler.ColumnTypeBool, handler.Default(false)),
+			handler.NewColumn(AppOIDCConfigColumnTLSClientAuthSubjectDN, handler.ColumnTypeText, handler.Nullable()),
+			handler.NewColumn(AppOIDCConfigColumnTLSClientCertificates, handler.ColumnTypeTextArray, handler.Nullable()),
+			handler.NewColumn(AppOIDCConfigColumnTLSClientCertificateBoundAccessTokens, handler.ColumnTypeBool, handler.Default(false)),
+			handler.NewColumn(AppOIDCConfigColumnRefreshTokenRotation, handler.ColumnTypeEnum, handler.Default(0)),
+			handler.NewColumn(AppOIDCConfigColumnEncryptAuthorizationResponse, handler.ColumnTypeBool, handler.Default(false)),
 		},
 			handler.NewPrimaryKey(AppOIDCConfigColumnInstanceID, AppOIDCConfigColumnAppID),
 			appOIDCTableSuffix,
@@ -513,6 +531,15 @@ func (p *appProjection) reduceOIDCConfigAdded(event eventstore.Event) (*handler.
 				handler.NewCol(AppOIDCConfigColumnBackChannelLogoutURI, e.BackChannelLogoutURI),
 				handler.NewCol(AppOIDCConfigColumnLoginVersion, e.LoginVersion),
 				handler.NewCol(AppOIDCConfigColumnLoginBaseURI, e.LoginBaseURI),
+				handler.NewCol(AppOIDCConfigColumnDPoPBoundAccessTokens, e.DPoPBoundAccessTokens),
+				handler.NewCol(AppOIDCConfigColumnRequirePushedAuthRequests, e.RequirePushedAuthRequests),
+				handler.NewCol(AppOIDCConfigColumnCIBANotificationURI, e.CIBANotificationURI),
+				handler.NewCol(AppOIDCConfigColumnConsentRequired, e.ConsentRequired),
+				handler.NewCol(AppOIDCConfigColumnTLSClientAuthSubjectDN, e.TLSClientAuthSubjectDN),
+				handler.NewCol(AppOIDCConfigColumnTLSClientCertificates, database.TextArray[string](e.TLSClientCertificates)),
+				handler.NewCol(AppOIDCConfigColumnTLSClientCertificateBoundAccessTokens, e.TLSClientCertificateBoundAccessTokens),
+				handler.NewCol(AppOIDCConfigColumnRefreshTokenRotation, e.RefreshTokenRotation),
+				handler.NewCol(AppOIDCConfigColumnEncryptAuthorizationResponse, e.EncryptAuthorizationResponse),
 			},
 			handler.WithTableSuffix(appOIDCTableSuffix),
 		),
@@ -590,6 +617,33 @@ func (p *appProjection) reduceOIDCConfigChanged(event eventstore.Event) (*handle
 	if e.LoginBaseURI != nil {
 		cols = append(cols, handler.NewCol(AppOIDCConfigColumnLoginBaseURI, *e.LoginBaseURI))
 	}
+	if e.DPoPBoundAccessTokens != nil {
+		cols = append(cols, handler.NewCol(AppOIDCConfigColumnDPoPBoundAccessTokens, *e.DPoPBoundAccessTokens))
+	}
+	if e.RequirePushedAuthRequests != nil {
+		cols = append(cols, handler.NewCol(AppOIDCConfigColumnRequirePushedAuthRequests, *e.RequirePushedAuthRequests))
+	}
+	if e.CIBANotificationURI != nil {
+		cols = append(cols, handler.NewCol(AppOIDCConfigColumnCIBANotificationURI, *e.CIBANotificationURI))
+	}
+	if e.ConsentRequired != nil {
+		cols = append(cols, handler.NewCol(AppOIDCConfigColumnConsentRequired, *e.ConsentRequired))
+	}
+	if e.TLSClientAuthSubjectDN != nil {
+		cols = append(cols, handler.NewCol(AppOIDCConfigColumnTLSClientAuthSubjectDN, *e.TLSClientAuthSubjectDN))
+	}
+	if e.TLSClientCertificates != nil {
+		cols = append(cols, handler.NewCol(AppOIDCConfigColumnTLSClientCertificates, database.TextArray[string](*e.TLSClientCertificates)))
+	}
+	if e.TLSClientCertificateBoundAccessTokens != nil {
+		cols = append(cols, handler.NewCol(AppOIDCConfigColumnTLSClientCertificateBoundAccessTokens, *e.TLSClientCertificateBoundAccessTokens))
+	}
+	if e.RefreshTokenRotation != nil {
+		cols = append(cols, handler.NewCol(AppOIDCConfigColumnRefreshTokenRotation, *e.RefreshTokenRotation))
+	}
+	if e.EncryptAuthorizationResponse != nil {
+		cols = append(cols, handler.NewCol(AppOIDCConfigColumnEncryptAuthorizationResponse, *e.EncryptAuthorizationResponse))
+	}
 
 	if len(cols) == 0 {
 		return handler.NewNoOpStatement(e), nil
@@ -561,7 +561,16 @@ func TestAppProjection_reduces(t *testing.T) {
 						"skipNativeAppSuccessPage": true,
 						"backChannelLogoutURI": "back.channel.one.ch",
 						"loginVersion": 2,
-						"loginBaseURI": "https://login.ch/"
+						"loginBaseURI": "https://login.ch/",
+						"dpopBoundAccessTokens": true,
+						"requirePushedAuthRequests": true,
+						"cibaNotificationURI": "ciba.one.ch",
+						"consentRequired": true,
+						"tlsClientAuthSubjectDN": "CN=client",
+						"tlsClientCertificates": ["certificate"],
+						"tlsClientCertificateBoundAccessTokens": true,
+						"refreshTokenRotation": 1,
+						"encryptAuthorizationResponse": true
 		}`),
 					), project.OIDCConfigAddedEventMapper),
 			},
@@ -572,7 +581,7 @@ func TestAppProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "INSERT INTO projections.apps7_oidc_configs (app_id, instance_id, version, client_id, client_secret, redirect_uris, response_types, grant_types, application_type, auth_method_type, post_logout_redirect_uris, is_dev_mode, access_token_type, access_token_role_assertion, id_token_role_assertion, id_token_userinfo_assertion, clock_skew, additional_origins, skip_native_app_success_page, back_channel_logout_uri, login_version, login_base_uri) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22)",
+							expectedStmt: "INSERT INTO projections.apps7_oidc_configs (app_id, instance_id, version, client_id, client_secret, redirect_uris, response_types, grant_types, application_type, auth_method_type, post_logout_redirect_uris, is_dev_mode, access_token_type, access_token_role_assertion, id_token_role_assertion, id_token_userinfo_assertion, clock_skew, additional_origins, skip_native_app_success_page, back_channel_logout_uri, login_version, login_base_uri, dpop_bound_access_tokens, require_pushed_auth_requests, ciba_notification_uri, consent_required, tls_client_auth_subject_dn, tls_client_certificates, tls_client_certificate_bound_access_tokens, refresh_token_rotation, encrypt_authorization_response) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24, $25, $26, $27, $28, $29, $30, $31)",
 							expectedArgs: []interface{}{
 								"app-id",
 								"instance-id",
@@ -596,6 +605,15 @@ func TestAppProjection_reduces(t *testing.T) {
 								"back.channel.one.ch",
 								domain.LoginVersion2,
 								"https://login.ch/",
+								true,
+								true,
+								"ciba.one.ch",
+								true,
+								"CN=client",
+								database.TextArray[string]{"certificate"},
+								true,
+								domain.OIDCRefreshTokenRotationStrict,
+								true,
 							},
 						},
 						{
@@ -639,7 +657,16 @@ func TestAppProjection_reduces(t *testing.T) {
 						"skipNativeAppSuccessPage": true,
 						"backChannelLogoutURI": "back.channel.one.ch",
 						"loginVersion": 2,
-						"loginBaseURI": "https://login.ch/"
+						"loginBaseURI": "https://login.ch/",
+						"dpopBoundAccessTokens": true,
+						"requirePushedAuthRequests": true,
+						"cibaNotificationURI": "ciba.one.ch",
+						"consentRequired": true,
+						"tlsClientAuthSubjectDN": "CN=client",
+						"tlsClientCertificates": ["certificate"],
+						"tlsClientCertificateBoundAccessTokens": true,
+						"refreshTokenRotation": 1,
+						"encryptAuthorizationResponse": true
 		}`),
 					), project.OIDCConfigAddedEventMapper),
 			},
@@ -650,7 +677,7 @@ func TestAppProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "INSERT INTO projections.apps7_oidc_configs (app_id, instance_id, version, client_id, client_secret, redirect_uris, response_types, grant_types, application_type, auth_method_type, post_logout_redirect_uris, is_dev_mode, access_token_type, access_token_role_assertion, id_token_role_assertion, id_token_userinfo_assertion, clock_skew, additional_origins, skip_native_app_success_page, back_channel_logout_uri, login_version, login_base_uri) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22)",
+							expectedStmt: "INSERT INTO projections.apps7_oidc_configs (app_id, instance_id, version, client_id, client_secret, redirect_uris, response_types, grant_types, application_type, auth_method_type, post_logout_redirect_uris, is_dev_mode, access_token_type, access_token_role_assertion, id_token_role_assertion, id_token_userinfo_assertion, clock_skew, additional_origins, skip_native_app_success_page, back_channel_logout_uri, login_version, login_base_uri, dpop_bound_access_tokens, require_pushed_auth_requests, ciba_notification_uri, consent_required, tls_client_auth_subject_dn, tls_client_certificates, tls_client_certificate_bound_access_tokens, refresh_token_rotation, encrypt_authorization_response) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24, $25, $26, $27, $28, $29, $30, $31)",
 							expectedArgs: []interface{}{
 								"app-id",
 								"instance-id",
@@ -674,6 +701,15 @@ func TestAppProjection_reduces(t *testing.T) {
 								"back.channel.one.ch",
 								domain.LoginVersion2,
 								"https://login.ch/",
+								true,
+								true,
+								"ciba.one.ch",
+								true,
+								"CN=client",
+								database.TextArray[string]{"certificate"},
+								true,
+								domain.OIDCRefreshTokenRotationStrict,
+								true,
 							},
 						},
 						{
@@ -714,7 +750,16 @@ func TestAppProjection_reduces(t *testing.T) {
                         "additionalOrigins": ["origin.one.ch", "origin.two.ch"],
 						"skipNativeAppSuccessPage": true,
 						"backChannelLogoutURI": "back.channel.one.ch",
-						"loginVersion": 2
+						"loginVersion": 2,
+						"dpopBoundAccessTokens": true,
+						"requirePushedAuthRequests": true,
+						"cibaNotificationURI": "ciba.one.ch",
+						"consentRequired": true,
+						"tlsClientAuthSubjectDN": "CN=client",
+						"tlsClientCertificates": ["certificate"],
+						"tlsClientCertificateBoundAccessTokens": true,
+						"refreshTokenRotation": 1,
+						"encryptAuthorizationResponse": true
 		}`),
 					), project.OIDCConfigChangedEventMapper),
 			},
@@ -725,7 +770,7 @@ func TestAppProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "UPDATE projections.apps7_oidc_configs SET (version, redirect_uris, response_types, grant_types, application_type, auth_method_type, post_logout_redirect_uris, is_dev_mode, access_token_type, access_token_role_assertion, id_token_role_assertion, id_token_userinfo_assertion, clock_skew, additional_origins, skip_native_app_success_page, back_channel_logout_uri, login_version) = ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17) WHERE (app_id = $18) AND (instance_id = $19)",
+							expectedStmt: "UPDATE projections.apps7_oidc_configs SET (version, redirect_uris, response_types, grant_types, application_type, auth_method_type, post_logout_redirect_uris, is_dev_mode, access_token_type, access_token_role_assertion, id_token_role_assertion, id_token_userinfo_assertion, clock_skew, additional_origins, skip_native_app_success_page, back_channel_logout_uri, login_version, dpop_bound_access_tokens, require_pushed_auth_requests, ciba_notification_uri, consent_required, tls_client_auth_subject_dn, tls_client_certificates, tls_client_certificate_bound_access_tokens, refresh_token_rotation, encrypt_authorization_response) = ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24, $25, $26) WHERE (app_id = $27) AND (instance_id = $28)",
 							expectedArgs: []interface{}{
 								domain.OIDCVersionV1,
 								database.TextArray[string]{"redirect.one.ch", "redirect.two.ch"},
@@ -744,6 +789,15 @@ func TestAppProjection_reduces(t *testing.T) {
 								true,
 								"back.channel.one.ch",
 								domain.LoginVersion2,
+								true,
+								true,
+								"ciba.one.ch",
+								true,
+								"CN=client",
+								database.TextArray[string]{"certificate"},
+								true,
+								domain.OIDCRefreshTokenRotationStrict,
+								true,
 								"app-id",
 								"instance-id",
 							},
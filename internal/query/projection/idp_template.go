@@ -155,6 +155,7 @@ const (
 	LDAPPreferredLanguageAttributeCol = "preferred_language_attribute"
 	LDAPAvatarURLAttributeCol         = "avatar_url_attribute"
 	LDAPProfileAttributeCol           = "profile_attribute"
+	LDAPGroupSyncCol                  = "group_sync"
 
 	AppleIDCol         = "idp_id"
 	AppleInstanceIDCol = "instance_id"
@@ -350,6 +351,7 @@ func (*idpTemplateProjection) Init() *old_handler.Check {
 			handler.NewColumn(LDAPPreferredLanguageAttributeCol, handler.ColumnTypeText, handler.Nullable()),
 			handler.NewColumn(LDAPAvatarURLAttributeCol, handler.ColumnTypeText, handler.Nullable()),
 			handler.NewColumn(LDAPProfileAttributeCol, handler.ColumnTypeText, handler.Nullable()),
+			handler.NewColumn(LDAPGroupSyncCol, handler.ColumnTypeJSONB, handler.Nullable()),
 		},
 			handler.NewPrimaryKey(LDAPInstanceIDCol, LDAPIDCol),
 			IDPTemplateLDAPSuffix,
@@ -1921,6 +1923,7 @@ func (p *idpTemplateProjection) reduceLDAPIDPAdded(event eventstore.Event) (*han
 				handler.NewCol(LDAPPreferredLanguageAttributeCol, idpEvent.PreferredLanguageAttribute),
 				handler.NewCol(LDAPAvatarURLAttributeCol, idpEvent.AvatarURLAttribute),
 				handler.NewCol(LDAPProfileAttributeCol, idpEvent.ProfileAttribute),
+				ldapGroupSyncCol(idpEvent.GroupSync),
 			},
 			handler.WithTableSuffix(IDPTemplateLDAPSuffix),
 		),
@@ -2482,9 +2485,20 @@ func reduceLDAPIDPChangedColumns(idpEvent idp.LDAPIDPChangedEvent) []handler.Col
 	if idpEvent.ProfileAttribute != nil {
 		ldapCols = append(ldapCols, handler.NewCol(LDAPProfileAttributeCol, *idpEvent.ProfileAttribute))
 	}
+	if idpEvent.GroupSync != nil {
+		ldapCols = append(ldapCols, ldapGroupSyncCol(idpEvent.GroupSync))
+	}
 	return ldapCols
 }
 
+// ldapGroupSyncCol stores the group sync settings as JSON, or NULL if disabled.
+func ldapGroupSyncCol(groupSync *idp.LDAPGroupSync) handler.Column {
+	if groupSync.IsZero() {
+		return handler.NewCol(LDAPGroupSyncCol, nil)
+	}
+	return handler.NewJSONCol(LDAPGroupSyncCol, groupSync)
+}
+
 func reduceAppleIDPChangedColumns(idpEvent idp.AppleIDPChangedEvent) []handler.Column {
 	appleCols := make([]handler.Column, 0, 5)
 	if idpEvent.ClientID != nil {
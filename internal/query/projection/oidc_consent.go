@@ -0,0 +1,216 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/oidcconsent"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/repository/user"
+)
+
+const (
+	OIDCConsentTable            = "projections.oidc_consents"
+	OIDCConsentIDCol            = "id"
+	OIDCConsentCreationDateCol  = "creation_date"
+	OIDCConsentChangeDateCol    = "change_date"
+	OIDCConsentResourceOwnerCol = "resource_owner"
+	OIDCConsentInstanceIDCol    = "instance_id"
+	OIDCConsentSequenceCol      = "sequence"
+	OIDCConsentUserIDCol        = "user_id"
+	OIDCConsentClientIDCol      = "client_id"
+	OIDCConsentAppIDCol         = "app_id"
+	OIDCConsentProjectIDCol     = "project_id"
+	OIDCConsentScopeCol         = "scope"
+)
+
+type oidcConsentProjection struct{}
+
+func newOIDCConsentProjection(ctx context.Context, config handler.Config) *handler.Handler {
+	return handler.NewHandler(ctx, &config, new(oidcConsentProjection))
+}
+
+func (*oidcConsentProjection) Name() string {
+	return OIDCConsentTable
+}
+
+func (*oidcConsentProjection) Init() *old_handler.Check {
+	return handler.NewTableCheck(
+		handler.NewTable([]*handler.InitColumn{
+			handler.NewColumn(OIDCConsentIDCol, handler.ColumnTypeText),
+			handler.NewColumn(OIDCConsentCreationDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(OIDCConsentChangeDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(OIDCConsentResourceOwnerCol, handler.ColumnTypeText),
+			handler.NewColumn(OIDCConsentInstanceIDCol, handler.ColumnTypeText),
+			handler.NewColumn(OIDCConsentSequenceCol, handler.ColumnTypeInt64),
+			handler.NewColumn(OIDCConsentUserIDCol, handler.ColumnTypeText),
+			handler.NewColumn(OIDCConsentClientIDCol, handler.ColumnTypeText),
+			handler.NewColumn(OIDCConsentAppIDCol, handler.ColumnTypeText),
+			handler.NewColumn(OIDCConsentProjectIDCol, handler.ColumnTypeText),
+			handler.NewColumn(OIDCConsentScopeCol, handler.ColumnTypeTextArray, handler.Nullable()),
+		},
+			handler.NewPrimaryKey(OIDCConsentInstanceIDCol, OIDCConsentIDCol),
+			handler.WithIndex(handler.NewIndex("user_id", []string{OIDCConsentInstanceIDCol, OIDCConsentUserIDCol, OIDCConsentClientIDCol})),
+		),
+	)
+}
+
+func (p *oidcConsentProjection) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: oidcconsent.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  oidcconsent.GrantedType,
+					Reduce: p.reduceGranted,
+				},
+				{
+					Event:  oidcconsent.RevokedType,
+					Reduce: p.reduceRevoked,
+				},
+			},
+		},
+		{
+			Aggregate: user.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  user.UserRemovedType,
+					Reduce: p.reduceUserRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: project.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  project.ApplicationRemovedType,
+					Reduce: p.reduceApplicationRemoved,
+				},
+				{
+					Event:  project.ProjectRemovedType,
+					Reduce: p.reduceProjectRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: org.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOwnerRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: reduceInstanceRemovedHelper(OIDCConsentInstanceIDCol),
+				},
+			},
+		},
+	}
+}
+
+func (p *oidcConsentProjection) reduceGranted(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*oidcconsent.GrantedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewUpsertStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(OIDCConsentInstanceIDCol, nil),
+			handler.NewCol(OIDCConsentIDCol, nil),
+		},
+		[]handler.Column{
+			handler.NewCol(OIDCConsentInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCol(OIDCConsentIDCol, e.Aggregate().ID),
+			handler.NewCol(OIDCConsentResourceOwnerCol, e.Aggregate().ResourceOwner),
+			handler.NewCol(OIDCConsentCreationDateCol, handler.OnlySetValueOnInsert(OIDCConsentTable, e.CreationDate())),
+			handler.NewCol(OIDCConsentChangeDateCol, e.CreationDate()),
+			handler.NewCol(OIDCConsentSequenceCol, e.Sequence()),
+			handler.NewCol(OIDCConsentUserIDCol, e.UserID),
+			handler.NewCol(OIDCConsentClientIDCol, e.ClientID),
+			handler.NewCol(OIDCConsentAppIDCol, e.AppID),
+			handler.NewCol(OIDCConsentProjectIDCol, e.ProjectID),
+			handler.NewCol(OIDCConsentScopeCol, database.TextArray[string](e.Scope)),
+		},
+	), nil
+}
+
+func (p *oidcConsentProjection) reduceRevoked(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*oidcconsent.RevokedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(OIDCConsentInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCond(OIDCConsentIDCol, e.Aggregate().ID),
+		},
+	), nil
+}
+
+func (p *oidcConsentProjection) reduceUserRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*user.UserRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(OIDCConsentInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCond(OIDCConsentUserIDCol, e.Aggregate().ID),
+		},
+	), nil
+}
+
+func (p *oidcConsentProjection) reduceApplicationRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*project.ApplicationRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(OIDCConsentInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCond(OIDCConsentAppIDCol, e.AppID),
+		},
+	), nil
+}
+
+func (p *oidcConsentProjection) reduceProjectRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*project.ProjectRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(OIDCConsentInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCond(OIDCConsentProjectIDCol, e.Aggregate().ID),
+		},
+	), nil
+}
+
+func (p *oidcConsentProjection) reduceOwnerRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*org.OrgRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(OIDCConsentInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCond(OIDCConsentResourceOwnerCol, e.Aggregate().ID),
+		},
+	), nil
+}
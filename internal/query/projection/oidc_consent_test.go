@@ -0,0 +1,223 @@
+package projection
+
+import (
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/oidcconsent"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestOIDCConsentProjection_reduces(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "reduceGranted",
+			args: args{
+				event: getEvent(
+					testEvent(
+						oidcconsent.GrantedType,
+						oidcconsent.AggregateType,
+						[]byte(`{"userID": "user-id", "clientID": "client-id", "appID": "app-id", "projectID": "project-id", "scope": ["openid", "profile"]}`),
+					),
+					eventstore.GenericEventMapper[oidcconsent.GrantedEvent],
+				),
+			},
+			reduce: (&oidcConsentProjection{}).reduceGranted,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("oidc_consent"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.oidc_consents (instance_id, id, resource_owner, creation_date, change_date, sequence, user_id, client_id, app_id, project_id, scope) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11) ON CONFLICT (instance_id, id) DO UPDATE SET (resource_owner, creation_date, change_date, sequence, user_id, client_id, app_id, project_id, scope) = (EXCLUDED.resource_owner, projections.oidc_consents.creation_date, EXCLUDED.change_date, EXCLUDED.sequence, EXCLUDED.user_id, EXCLUDED.client_id, EXCLUDED.app_id, EXCLUDED.project_id, EXCLUDED.scope)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+								"ro-id",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								"user-id",
+								"client-id",
+								"app-id",
+								"project-id",
+								database.TextArray[string]{"openid", "profile"},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceRevoked",
+			args: args{
+				event: getEvent(
+					testEvent(
+						oidcconsent.RevokedType,
+						oidcconsent.AggregateType,
+						[]byte(`{"userID": "user-id", "clientID": "client-id"}`),
+					),
+					eventstore.GenericEventMapper[oidcconsent.RevokedEvent],
+				),
+			},
+			reduce: (&oidcConsentProjection{}).reduceRevoked,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("oidc_consent"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.oidc_consents WHERE (instance_id = $1) AND (id = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceUserRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						user.UserRemovedType,
+						user.AggregateType,
+						[]byte(`{}`),
+					),
+					user.UserRemovedEventMapper,
+				),
+			},
+			reduce: (&oidcConsentProjection{}).reduceUserRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("user"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.oidc_consents WHERE (instance_id = $1) AND (user_id = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceApplicationRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						project.ApplicationRemovedType,
+						project.AggregateType,
+						[]byte(`{"appId": "app-id"}`),
+					),
+					project.ApplicationRemovedEventMapper,
+				),
+			},
+			reduce: (&oidcConsentProjection{}).reduceApplicationRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("project"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.oidc_consents WHERE (instance_id = $1) AND (app_id = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"app-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceProjectRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						project.ProjectRemovedType,
+						project.AggregateType,
+						[]byte(`{}`),
+					),
+					project.ProjectRemovedEventMapper,
+				),
+			},
+			reduce: (&oidcConsentProjection{}).reduceProjectRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("project"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.oidc_consents WHERE (instance_id = $1) AND (project_id = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceOwnerRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						org.OrgRemovedEventType,
+						org.AggregateType,
+						nil,
+					),
+					org.OrgRemovedEventMapper,
+				),
+			},
+			reduce: (&oidcConsentProjection{}).reduceOwnerRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("org"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.oidc_consents WHERE (instance_id = $1) AND (resource_owner = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if ok := zerrors.IsErrorInvalidArgument(err); !ok {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, OIDCConsentTable, tt.want)
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/oidcsession"
+	"github.com/zitadel/zitadel/internal/repository/user"
+)
+
+const (
+	OIDCSessionTable            = "projections.oidc_sessions"
+	OIDCSessionIDCol            = "id"
+	OIDCSessionCreationDateCol  = "creation_date"
+	OIDCSessionChangeDateCol    = "change_date"
+	OIDCSessionResourceOwnerCol = "resource_owner"
+	OIDCSessionInstanceIDCol    = "instance_id"
+	OIDCSessionSequenceCol      = "sequence"
+	OIDCSessionUserIDCol        = "user_id"
+	OIDCSessionClientIDCol      = "client_id"
+)
+
+// oidcSessionProjection maps the OIDC sessions to the user and client they were created for,
+// so the sessions of a user for a client can be found without searching the event payloads.
+type oidcSessionProjection struct{}
+
+func newOIDCSessionProjection(ctx context.Context, config handler.Config) *handler.Handler {
+	return handler.NewHandler(ctx, &config, new(oidcSessionProjection))
+}
+
+func (*oidcSessionProjection) Name() string {
+	return OIDCSessionTable
+}
+
+func (*oidcSessionProjection) Init() *old_handler.Check {
+	return handler.NewTableCheck(
+		handler.NewTable([]*handler.InitColumn{
+			handler.NewColumn(OIDCSessionIDCol, handler.ColumnTypeText),
+			handler.NewColumn(OIDCSessionCreationDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(OIDCSessionChangeDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(OIDCSessionResourceOwnerCol, handler.ColumnTypeText),
+			handler.NewColumn(OIDCSessionInstanceIDCol, handler.ColumnTypeText),
+			handler.NewColumn(OIDCSessionSequenceCol, handler.ColumnTypeInt64),
+			handler.NewColumn(OIDCSessionUserIDCol, handler.ColumnTypeText),
+			handler.NewColumn(OIDCSessionClientIDCol, handler.ColumnTypeText),
+		},
+			handler.NewPrimaryKey(OIDCSessionInstanceIDCol, OIDCSessionIDCol),
+			handler.WithIndex(handler.NewIndex("user_id", []string{OIDCSessionInstanceIDCol, OIDCSessionUserIDCol, OIDCSessionClientIDCol})),
+		),
+	)
+}
+
+func (p *oidcSessionProjection) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: oidcsession.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  oidcsession.AddedType,
+					Reduce: p.reduceAdded,
+				},
+			},
+		},
+		{
+			Aggregate: user.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  user.UserRemovedType,
+					Reduce: p.reduceUserRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: reduceInstanceRemovedHelper(OIDCSessionInstanceIDCol),
+				},
+			},
+		},
+	}
+}
+
+func (p *oidcSessionProjection) reduceAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*oidcsession.AddedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewCreateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(OIDCSessionIDCol, e.Aggregate().ID),
+			handler.NewCol(OIDCSessionCreationDateCol, e.CreationDate()),
+			handler.NewCol(OIDCSessionChangeDateCol, e.CreationDate()),
+			handler.NewCol(OIDCSessionResourceOwnerCol, e.Aggregate().ResourceOwner),
+			handler.NewCol(OIDCSessionInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCol(OIDCSessionSequenceCol, e.Sequence()),
+			handler.NewCol(OIDCSessionUserIDCol, e.UserID),
+			handler.NewCol(OIDCSessionClientIDCol, e.ClientID),
+		},
+	), nil
+}
+
+func (p *oidcSessionProjection) reduceUserRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*user.UserRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(OIDCSessionInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCond(OIDCSessionUserIDCol, e.Aggregate().ID),
+		},
+	), nil
+}
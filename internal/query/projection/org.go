@@ -24,6 +24,8 @@ const (
 	OrgColumnSequence      = "sequence"
 	OrgColumnName          = "name"
 	OrgColumnDomain        = "primary_domain"
+	OrgColumnParentOrgID   = "parent_org_id"
+	OrgColumnDelegateAdmin = "delegate_administration"
 )
 
 type orgProjection struct{}
@@ -49,10 +51,13 @@ func (p *orgProjection) Init() *old_handler.Check {
 			handler.NewColumn(OrgColumnSequence, handler.ColumnTypeInt64),
 			handler.NewColumn(OrgColumnName, handler.ColumnTypeText),
 			handler.NewColumn(OrgColumnDomain, handler.ColumnTypeText, handler.Default("")),
+			handler.NewColumn(OrgColumnParentOrgID, handler.ColumnTypeText, handler.Nullable()),
+			handler.NewColumn(OrgColumnDelegateAdmin, handler.ColumnTypeBool, handler.Default(false)),
 		},
 			handler.NewPrimaryKey(OrgColumnInstanceID, OrgColumnID),
 			handler.WithIndex(handler.NewIndex("domain", []string{OrgColumnDomain})),
 			handler.WithIndex(handler.NewIndex("name", []string{OrgColumnName})),
+			handler.WithIndex(handler.NewIndex("parent", []string{OrgColumnParentOrgID})),
 		),
 	)
 }
@@ -86,6 +91,14 @@ func (p *orgProjection) Reducers() []handler.AggregateReducer {
 					Event:  org.OrgDomainPrimarySetEventType,
 					Reduce: p.reducePrimaryDomainSet,
 				},
+				{
+					Event:  org.OrgParentSetEventType,
+					Reduce: p.reduceParentSet,
+				},
+				{
+					Event:  org.OrgParentRemovedEventType,
+					Reduce: p.reduceParentRemoved,
+				},
 			},
 		},
 		{
@@ -199,6 +212,46 @@ func (p *orgProjection) reducePrimaryDomainSet(event eventstore.Event) (*handler
 	), nil
 }
 
+func (p *orgProjection) reduceParentSet(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*org.OrgParentSetEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Eiph8", "reduce.wrong.event.type %s", org.OrgParentSetEventType)
+	}
+	return handler.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(OrgColumnChangeDate, e.CreationDate()),
+			handler.NewCol(OrgColumnSequence, e.Sequence()),
+			handler.NewCol(OrgColumnParentOrgID, e.ParentOrgID),
+			handler.NewCol(OrgColumnDelegateAdmin, e.DelegateAdministration),
+		},
+		[]handler.Condition{
+			handler.NewCond(OrgColumnID, e.Aggregate().ID),
+			handler.NewCond(OrgColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *orgProjection) reduceParentRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*org.OrgParentRemovedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-oo2Ie", "reduce.wrong.event.type %s", org.OrgParentRemovedEventType)
+	}
+	return handler.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(OrgColumnChangeDate, e.CreationDate()),
+			handler.NewCol(OrgColumnSequence, e.Sequence()),
+			handler.NewCol(OrgColumnParentOrgID, nil),
+			handler.NewCol(OrgColumnDelegateAdmin, false),
+		},
+		[]handler.Condition{
+			handler.NewCond(OrgColumnID, e.Aggregate().ID),
+			handler.NewCond(OrgColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
 func (p *orgProjection) reduceOrgRemoved(event eventstore.Event) (*handler.Statement, error) {
 	e, ok := event.(*org.OrgRemovedEvent)
 	if !ok {
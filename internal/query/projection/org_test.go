@@ -51,6 +51,68 @@ func TestOrgProjection_reduces(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "reduceParentSet",
+			args: args{
+				event: getEvent(
+					testEvent(
+						org.OrgParentSetEventType,
+						org.AggregateType,
+						[]byte(`{"parentOrgId": "parent-id", "delegateAdministration": true}`),
+					), org.OrgParentSetEventMapper),
+			},
+			reduce: (&orgProjection{}).reduceParentSet,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("org"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.orgs1 SET (change_date, sequence, parent_org_id, delegate_administration) = ($1, $2, $3, $4) WHERE (id = $5) AND (instance_id = $6)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								"parent-id",
+								true,
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceParentRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						org.OrgParentRemovedEventType,
+						org.AggregateType,
+						[]byte(`{"parentOrgId": "parent-id"}`),
+					), org.OrgParentRemovedEventMapper),
+			},
+			reduce: (&orgProjection{}).reduceParentRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("org"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.orgs1 SET (change_date, sequence, parent_org_id, delegate_administration) = ($1, $2, $3, $4) WHERE (id = $5) AND (instance_id = $6)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								nil,
+								false,
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "reduceOrgReactivated",
 			args: args{
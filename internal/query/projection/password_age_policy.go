@@ -26,6 +26,8 @@ const (
 	AgePolicyInstanceIDCol     = "instance_id"
 	AgePolicyExpireWarnDaysCol = "expire_warn_days"
 	AgePolicyMaxAgeDaysCol     = "max_age_days"
+	AgePolicyHistoryCountCol   = "history_count"
+	AgePolicyMinAgeDaysCol     = "min_age_days"
 	AgePolicyOwnerRemovedCol   = "owner_removed"
 )
 
@@ -52,6 +54,8 @@ func (*passwordAgeProjection) Init() *old_handler.Check {
 			handler.NewColumn(AgePolicyInstanceIDCol, handler.ColumnTypeText),
 			handler.NewColumn(AgePolicyExpireWarnDaysCol, handler.ColumnTypeInt64),
 			handler.NewColumn(AgePolicyMaxAgeDaysCol, handler.ColumnTypeInt64),
+			handler.NewColumn(AgePolicyHistoryCountCol, handler.ColumnTypeInt64, handler.Default(0)),
+			handler.NewColumn(AgePolicyMinAgeDaysCol, handler.ColumnTypeInt64, handler.Default(0)),
 			handler.NewColumn(AgePolicyOwnerRemovedCol, handler.ColumnTypeBool, handler.Default(false)),
 		},
 			handler.NewPrimaryKey(AgePolicyInstanceIDCol, AgePolicyIDCol),
@@ -126,6 +130,8 @@ func (p *passwordAgeProjection) reduceAdded(event eventstore.Event) (*handler.St
 			handler.NewCol(AgePolicyStateCol, domain.PolicyStateActive),
 			handler.NewCol(AgePolicyExpireWarnDaysCol, policyEvent.ExpireWarnDays),
 			handler.NewCol(AgePolicyMaxAgeDaysCol, policyEvent.MaxAgeDays),
+			handler.NewCol(AgePolicyHistoryCountCol, policyEvent.HistoryCount),
+			handler.NewCol(AgePolicyMinAgeDaysCol, policyEvent.MinAgeDays),
 			handler.NewCol(AgePolicyIsDefaultCol, isDefault),
 			handler.NewCol(AgePolicyResourceOwnerCol, policyEvent.Aggregate().ResourceOwner),
 			handler.NewCol(AgePolicyInstanceIDCol, policyEvent.Aggregate().InstanceID),
@@ -152,6 +158,12 @@ func (p *passwordAgeProjection) reduceChanged(event eventstore.Event) (*handler.
 	if policyEvent.MaxAgeDays != nil {
 		cols = append(cols, handler.NewCol(AgePolicyMaxAgeDaysCol, *policyEvent.MaxAgeDays))
 	}
+	if policyEvent.HistoryCount != nil {
+		cols = append(cols, handler.NewCol(AgePolicyHistoryCountCol, *policyEvent.HistoryCount))
+	}
+	if policyEvent.MinAgeDays != nil {
+		cols = append(cols, handler.NewCol(AgePolicyMinAgeDaysCol, *policyEvent.MinAgeDays))
+	}
 	return handler.NewUpdateStatement(
 		&policyEvent,
 		cols,
@@ -83,6 +83,12 @@ var (
 	InstanceFeatureProjection           *handler.Handler
 	TargetProjection                    *handler.Handler
 	ExecutionProjection                 *handler.Handler
+	SCIMConnectorProjection             *handler.Handler
+	OIDCConsentProjection               *handler.Handler
+	OIDCSessionProjection               *handler.Handler
+	WorkloadIdentityTrustProjection     *handler.Handler
+	AccessRequestProjection             *handler.Handler
+	AccessReviewProjection              *handler.Handler
 	UserSchemaProjection                *handler.Handler
 	WebKeyProjection                    *handler.Handler
 	DebugEventsProjection               *handler.Handler
@@ -176,6 +182,12 @@ func Create(ctx context.Context, sqlClient *database.DB, es handler.EventStore,
 	InstanceFeatureProjection = newInstanceFeatureProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["instance_features"]))
 	TargetProjection = newTargetProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["targets"]))
 	ExecutionProjection = newExecutionProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["executions"]))
+	SCIMConnectorProjection = newSCIMConnectorProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["scim_connectors"]))
+	OIDCConsentProjection = newOIDCConsentProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["oidc_consents"]))
+	OIDCSessionProjection = newOIDCSessionProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["oidc_sessions"]))
+	WorkloadIdentityTrustProjection = newWorkloadIdentityTrustProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["workload_identity_trusts"]))
+	AccessRequestProjection = newAccessRequestProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["access_requests"]))
+	AccessReviewProjection = newAccessReviewProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["access_reviews"]))
 	UserSchemaProjection = newUserSchemaProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["user_schemas"]))
 	WebKeyProjection = newWebKeyProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["web_keys"]))
 	DebugEventsProjection = newDebugEventsProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["debug_events"]))
@@ -354,6 +366,12 @@ func newProjectionsList() {
 		InstanceFeatureProjection,
 		TargetProjection,
 		ExecutionProjection,
+		SCIMConnectorProjection,
+		OIDCConsentProjection,
+		OIDCSessionProjection,
+		WorkloadIdentityTrustProjection,
+		AccessRequestProjection,
+		AccessReviewProjection,
 		UserSchemaProjection,
 		WebKeyProjection,
 		DebugEventsProjection,
@@ -0,0 +1,241 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/repository/scimconnector"
+)
+
+const (
+	SCIMConnectorTable            = "projections.scim_connectors"
+	SCIMConnectorIDCol            = "id"
+	SCIMConnectorCreationDateCol  = "creation_date"
+	SCIMConnectorChangeDateCol    = "change_date"
+	SCIMConnectorResourceOwnerCol = "resource_owner"
+	SCIMConnectorInstanceIDCol    = "instance_id"
+	SCIMConnectorSequenceCol      = "sequence"
+	SCIMConnectorStateCol         = "state"
+	SCIMConnectorProjectIDCol     = "project_id"
+	SCIMConnectorNameCol          = "name"
+	SCIMConnectorEndpointCol      = "endpoint"
+	SCIMConnectorTokenCol         = "token"
+)
+
+type scimConnectorProjection struct{}
+
+func newSCIMConnectorProjection(ctx context.Context, config handler.Config) *handler.Handler {
+	return handler.NewHandler(ctx, &config, new(scimConnectorProjection))
+}
+
+func (*scimConnectorProjection) Name() string {
+	return SCIMConnectorTable
+}
+
+func (*scimConnectorProjection) Init() *old_handler.Check {
+	return handler.NewTableCheck(
+		handler.NewTable([]*handler.InitColumn{
+			handler.NewColumn(SCIMConnectorIDCol, handler.ColumnTypeText),
+			handler.NewColumn(SCIMConnectorCreationDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(SCIMConnectorChangeDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(SCIMConnectorResourceOwnerCol, handler.ColumnTypeText),
+			handler.NewColumn(SCIMConnectorInstanceIDCol, handler.ColumnTypeText),
+			handler.NewColumn(SCIMConnectorSequenceCol, handler.ColumnTypeInt64),
+			handler.NewColumn(SCIMConnectorStateCol, handler.ColumnTypeEnum),
+			handler.NewColumn(SCIMConnectorProjectIDCol, handler.ColumnTypeText),
+			handler.NewColumn(SCIMConnectorNameCol, handler.ColumnTypeText),
+			handler.NewColumn(SCIMConnectorEndpointCol, handler.ColumnTypeText),
+			handler.NewColumn(SCIMConnectorTokenCol, handler.ColumnTypeJSONB),
+		},
+			handler.NewPrimaryKey(SCIMConnectorInstanceIDCol, SCIMConnectorIDCol),
+			handler.WithIndex(handler.NewIndex("project_id", []string{SCIMConnectorInstanceIDCol, SCIMConnectorProjectIDCol})),
+		),
+	)
+}
+
+func (p *scimConnectorProjection) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: scimconnector.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  scimconnector.AddedEventType,
+					Reduce: p.reduceAdded,
+				},
+				{
+					Event:  scimconnector.ChangedEventType,
+					Reduce: p.reduceChanged,
+				},
+				{
+					Event:  scimconnector.DeactivatedEventType,
+					Reduce: p.reduceDeactivated,
+				},
+				{
+					Event:  scimconnector.ReactivatedEventType,
+					Reduce: p.reduceReactivated,
+				},
+				{
+					Event:  scimconnector.RemovedEventType,
+					Reduce: p.reduceRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: project.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  project.ProjectRemovedType,
+					Reduce: p.reduceProjectRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: org.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOwnerRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: reduceInstanceRemovedHelper(SCIMConnectorInstanceIDCol),
+				},
+			},
+		},
+	}
+}
+
+func (p *scimConnectorProjection) reduceAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*scimconnector.AddedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewCreateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(SCIMConnectorInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCol(SCIMConnectorResourceOwnerCol, e.Aggregate().ResourceOwner),
+			handler.NewCol(SCIMConnectorIDCol, e.Aggregate().ID),
+			handler.NewCol(SCIMConnectorCreationDateCol, e.CreationDate()),
+			handler.NewCol(SCIMConnectorChangeDateCol, e.CreationDate()),
+			handler.NewCol(SCIMConnectorSequenceCol, e.Sequence()),
+			handler.NewCol(SCIMConnectorStateCol, domain.SCIMConnectorStateActive),
+			handler.NewCol(SCIMConnectorProjectIDCol, e.ProjectID),
+			handler.NewCol(SCIMConnectorNameCol, e.Name),
+			handler.NewCol(SCIMConnectorEndpointCol, e.Endpoint),
+			handler.NewCol(SCIMConnectorTokenCol, e.Token),
+		},
+	), nil
+}
+
+func (p *scimConnectorProjection) reduceChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*scimconnector.ChangedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	values := []handler.Column{
+		handler.NewCol(SCIMConnectorChangeDateCol, e.CreationDate()),
+		handler.NewCol(SCIMConnectorSequenceCol, e.Sequence()),
+	}
+	if e.Name != nil {
+		values = append(values, handler.NewCol(SCIMConnectorNameCol, *e.Name))
+	}
+	if e.Endpoint != nil {
+		values = append(values, handler.NewCol(SCIMConnectorEndpointCol, *e.Endpoint))
+	}
+	if e.Token != nil {
+		values = append(values, handler.NewCol(SCIMConnectorTokenCol, e.Token))
+	}
+	return handler.NewUpdateStatement(
+		e,
+		values,
+		[]handler.Condition{
+			handler.NewCond(SCIMConnectorInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCond(SCIMConnectorIDCol, e.Aggregate().ID),
+		},
+	), nil
+}
+
+func (p *scimConnectorProjection) reduceDeactivated(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*scimconnector.DeactivatedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return p.stateStatement(e, domain.SCIMConnectorStateInactive), nil
+}
+
+func (p *scimConnectorProjection) reduceReactivated(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*scimconnector.ReactivatedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return p.stateStatement(e, domain.SCIMConnectorStateActive), nil
+}
+
+func (p *scimConnectorProjection) stateStatement(e eventstore.Event, state domain.SCIMConnectorState) *handler.Statement {
+	return handler.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(SCIMConnectorChangeDateCol, e.CreatedAt()),
+			handler.NewCol(SCIMConnectorSequenceCol, e.Sequence()),
+			handler.NewCol(SCIMConnectorStateCol, state),
+		},
+		[]handler.Condition{
+			handler.NewCond(SCIMConnectorInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCond(SCIMConnectorIDCol, e.Aggregate().ID),
+		},
+	)
+}
+
+func (p *scimConnectorProjection) reduceRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*scimconnector.RemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(SCIMConnectorInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCond(SCIMConnectorIDCol, e.Aggregate().ID),
+		},
+	), nil
+}
+
+func (p *scimConnectorProjection) reduceProjectRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*project.ProjectRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(SCIMConnectorInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCond(SCIMConnectorProjectIDCol, e.Aggregate().ID),
+		},
+	), nil
+}
+
+func (p *scimConnectorProjection) reduceOwnerRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*org.OrgRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(SCIMConnectorInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCond(SCIMConnectorResourceOwnerCol, e.Aggregate().ID),
+		},
+	), nil
+}
@@ -0,0 +1,261 @@
+package projection
+
+import (
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/repository/scimconnector"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestSCIMConnectorProjection_reduces(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "reduceAdded",
+			args: args{
+				event: getEvent(
+					testEvent(
+						scimconnector.AddedEventType,
+						scimconnector.AggregateType,
+						[]byte(`{"projectId": "project-id", "name": "name", "endpoint": "https://example.com/scim/v2", "token": {"cryptoType": 0, "algorithm": "enc", "keyId": "id", "crypted": "dG9rZW4="}}`),
+					),
+					eventstore.GenericEventMapper[scimconnector.AddedEvent],
+				),
+			},
+			reduce: (&scimConnectorProjection{}).reduceAdded,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("scim_connector"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.scim_connectors (instance_id, resource_owner, id, creation_date, change_date, sequence, state, project_id, name, endpoint, token) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"ro-id",
+								"agg-id",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								domain.SCIMConnectorStateActive,
+								"project-id",
+								"name",
+								"https://example.com/scim/v2",
+								anyArg{},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceChanged",
+			args: args{
+				event: getEvent(
+					testEvent(
+						scimconnector.ChangedEventType,
+						scimconnector.AggregateType,
+						[]byte(`{"name": "name2", "endpoint": "https://example.com/scim"}`),
+					),
+					eventstore.GenericEventMapper[scimconnector.ChangedEvent],
+				),
+			},
+			reduce: (&scimConnectorProjection{}).reduceChanged,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("scim_connector"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.scim_connectors SET (change_date, sequence, name, endpoint) = ($1, $2, $3, $4) WHERE (instance_id = $5) AND (id = $6)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								"name2",
+								"https://example.com/scim",
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceDeactivated",
+			args: args{
+				event: getEvent(
+					testEvent(
+						scimconnector.DeactivatedEventType,
+						scimconnector.AggregateType,
+						[]byte(`{}`),
+					),
+					eventstore.GenericEventMapper[scimconnector.DeactivatedEvent],
+				),
+			},
+			reduce: (&scimConnectorProjection{}).reduceDeactivated,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("scim_connector"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.scim_connectors SET (change_date, sequence, state) = ($1, $2, $3) WHERE (instance_id = $4) AND (id = $5)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								domain.SCIMConnectorStateInactive,
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceReactivated",
+			args: args{
+				event: getEvent(
+					testEvent(
+						scimconnector.ReactivatedEventType,
+						scimconnector.AggregateType,
+						[]byte(`{}`),
+					),
+					eventstore.GenericEventMapper[scimconnector.ReactivatedEvent],
+				),
+			},
+			reduce: (&scimConnectorProjection{}).reduceReactivated,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("scim_connector"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.scim_connectors SET (change_date, sequence, state) = ($1, $2, $3) WHERE (instance_id = $4) AND (id = $5)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								domain.SCIMConnectorStateActive,
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						scimconnector.RemovedEventType,
+						scimconnector.AggregateType,
+						[]byte(`{}`),
+					),
+					eventstore.GenericEventMapper[scimconnector.RemovedEvent],
+				),
+			},
+			reduce: (&scimConnectorProjection{}).reduceRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("scim_connector"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.scim_connectors WHERE (instance_id = $1) AND (id = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceProjectRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						project.ProjectRemovedType,
+						project.AggregateType,
+						[]byte(`{}`),
+					),
+					project.ProjectRemovedEventMapper,
+				),
+			},
+			reduce: (&scimConnectorProjection{}).reduceProjectRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("project"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.scim_connectors WHERE (instance_id = $1) AND (project_id = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceOwnerRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						org.OrgRemovedEventType,
+						org.AggregateType,
+						nil,
+					),
+					org.OrgRemovedEventMapper,
+				),
+			},
+			reduce: (&scimConnectorProjection{}).reduceOwnerRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("org"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.scim_connectors WHERE (instance_id = $1) AND (resource_owner = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if ok := zerrors.IsErrorInvalidArgument(err); !ok {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, SCIMConnectorTable, tt.want)
+		})
+	}
+}
@@ -24,6 +24,9 @@ const (
 	TargetTimeoutCol          = "timeout"
 	TargetInterruptOnErrorCol = "interrupt_on_error"
 	TargetSigningKey          = "signing_key"
+	TargetClientCertificate   = "client_certificate"
+	TargetClientKey           = "client_key"
+	TargetRootCA              = "root_ca"
 )
 
 type targetProjection struct{}
@@ -51,6 +54,9 @@ func (*targetProjection) Init() *old_handler.Check {
 			handler.NewColumn(TargetTimeoutCol, handler.ColumnTypeInt64),
 			handler.NewColumn(TargetInterruptOnErrorCol, handler.ColumnTypeBool),
 			handler.NewColumn(TargetSigningKey, handler.ColumnTypeJSONB, handler.Nullable()),
+			handler.NewColumn(TargetClientCertificate, handler.ColumnTypeBytes, handler.Nullable()),
+			handler.NewColumn(TargetClientKey, handler.ColumnTypeJSONB, handler.Nullable()),
+			handler.NewColumn(TargetRootCA, handler.ColumnTypeBytes, handler.Nullable()),
 		},
 			handler.NewPrimaryKey(TargetInstanceIDCol, TargetIDCol),
 		),
@@ -93,23 +99,24 @@ func (p *targetProjection) reduceTargetAdded(event eventstore.Event) (*handler.S
 	if err != nil {
 		return nil, err
 	}
-	return handler.NewCreateStatement(
-		e,
-		[]handler.Column{
-			handler.NewCol(TargetInstanceIDCol, e.Aggregate().InstanceID),
-			handler.NewCol(TargetResourceOwnerCol, e.Aggregate().ResourceOwner),
-			handler.NewCol(TargetIDCol, e.Aggregate().ID),
-			handler.NewCol(TargetCreationDateCol, handler.OnlySetValueOnInsert(TargetTable, e.CreationDate())),
-			handler.NewCol(TargetChangeDateCol, e.CreationDate()),
-			handler.NewCol(TargetSequenceCol, e.Sequence()),
-			handler.NewCol(TargetNameCol, e.Name),
-			handler.NewCol(TargetEndpointCol, e.Endpoint),
-			handler.NewCol(TargetTargetType, e.TargetType),
-			handler.NewCol(TargetTimeoutCol, e.Timeout),
-			handler.NewCol(TargetInterruptOnErrorCol, e.InterruptOnError),
-			handler.NewCol(TargetSigningKey, e.SigningKey),
-		},
-	), nil
+	values := []handler.Column{
+		handler.NewCol(TargetInstanceIDCol, e.Aggregate().InstanceID),
+		handler.NewCol(TargetResourceOwnerCol, e.Aggregate().ResourceOwner),
+		handler.NewCol(TargetIDCol, e.Aggregate().ID),
+		handler.NewCol(TargetCreationDateCol, handler.OnlySetValueOnInsert(TargetTable, e.CreationDate())),
+		handler.NewCol(TargetChangeDateCol, e.CreationDate()),
+		handler.NewCol(TargetSequenceCol, e.Sequence()),
+		handler.NewCol(TargetNameCol, e.Name),
+		handler.NewCol(TargetEndpointCol, e.Endpoint),
+		handler.NewCol(TargetTargetType, e.TargetType),
+		handler.NewCol(TargetTimeoutCol, e.Timeout),
+		handler.NewCol(TargetInterruptOnErrorCol, e.InterruptOnError),
+		handler.NewCol(TargetSigningKey, e.SigningKey),
+	}
+	if e.TLS != nil {
+		values = append(values, targetTLSCols(e.TLS)...)
+	}
+	return handler.NewCreateStatement(e, values), nil
 }
 
 func (p *targetProjection) reduceTargetChanged(event eventstore.Event) (*handler.Statement, error) {
@@ -140,6 +147,9 @@ func (p *targetProjection) reduceTargetChanged(event eventstore.Event) (*handler
 	if e.SigningKey != nil {
 		values = append(values, handler.NewCol(TargetSigningKey, e.SigningKey))
 	}
+	if e.TLS != nil {
+		values = append(values, targetTLSCols(e.TLS)...)
+	}
 	return handler.NewUpdateStatement(
 		e,
 		values,
@@ -150,6 +160,15 @@ func (p *targetProjection) reduceTargetChanged(event eventstore.Event) (*handler
 	), nil
 }
 
+// targetTLSCols always sets all TLS columns, as the TLS configuration is replaced as a whole.
+func targetTLSCols(tls *target.TLS) []handler.Column {
+	return []handler.Column{
+		handler.NewCol(TargetClientCertificate, tls.ClientCertificate),
+		handler.NewCol(TargetClientKey, tls.ClientKey),
+		handler.NewCol(TargetRootCA, tls.RootCA),
+	}
+}
+
 func (p *targetProjection) reduceTargetRemoved(event eventstore.Event) (*handler.Statement, error) {
 	e, err := assertEvent[*target.RemovedEvent](event)
 	if err != nil {
@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
@@ -61,6 +62,83 @@ func TestTargetProjection_reduces(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "reduceTargetAdded, tls",
+			args: args{
+				event: getEvent(
+					testEvent(
+						target.AddedEventType,
+						target.AggregateType,
+						[]byte(`{"name": "name", "targetType":3, "endpoint":"https://example.com", "timeout": 3000000000, "interruptOnError": true, "signingKey": { "cryptoType": 0, "algorithm": "RSA-265", "keyId": "key-id" }, "tls": {"clientCertificate": "Y2VydGlmaWNhdGU=", "clientKey": { "cryptoType": 0, "algorithm": "RSA-265", "keyId": "key-id" }, "rootCA": "cm9vdA=="}}`),
+					),
+					eventstore.GenericEventMapper[target.AddedEvent],
+				),
+			},
+			reduce: (&targetProjection{}).reduceTargetAdded,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("target"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.targets2 (instance_id, resource_owner, id, creation_date, change_date, sequence, name, endpoint, target_type, timeout, interrupt_on_error, signing_key, client_certificate, client_key, root_ca) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"ro-id",
+								"agg-id",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								"name",
+								"https://example.com",
+								domain.TargetTypeGRPC,
+								3 * time.Second,
+								true,
+								anyArg{},
+								[]byte("certificate"),
+								anyArg{},
+								[]byte("root"),
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceTargetChanged, tls removed",
+			args: args{
+				event: getEvent(
+					testEvent(
+						target.ChangedEventType,
+						target.AggregateType,
+						[]byte(`{"tls": {}}`),
+					),
+					eventstore.GenericEventMapper[target.ChangedEvent],
+				),
+			},
+			reduce: (&targetProjection{}).reduceTargetChanged,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("target"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.targets2 SET (change_date, sequence, resource_owner, client_certificate, client_key, root_ca) = ($1, $2, $3, $4, $5, $6) WHERE (instance_id = $7) AND (id = $8)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								"ro-id",
+								[]byte(nil),
+								(*crypto.CryptoValue)(nil),
+								[]byte(nil),
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "reduceTargetChanged",
 			args: args{
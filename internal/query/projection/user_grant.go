@@ -34,6 +34,9 @@ const (
 	UserGrantGrantID              = "grant_id"
 	UserGrantGrantedOrg           = "granted_org"
 	UserGrantRoles                = "roles"
+	UserGrantValidFrom            = "valid_from"
+	UserGrantValidUntil           = "valid_until"
+	UserGrantExpiryReminded       = "expiry_reminded"
 )
 
 type userGrantProjection struct {
@@ -65,10 +68,14 @@ func (*userGrantProjection) Init() *old_handler.Check {
 			handler.NewColumn(UserGrantGrantID, handler.ColumnTypeText),
 			handler.NewColumn(UserGrantGrantedOrg, handler.ColumnTypeText),
 			handler.NewColumn(UserGrantRoles, handler.ColumnTypeTextArray, handler.Nullable()),
+			handler.NewColumn(UserGrantValidFrom, handler.ColumnTypeTimestamp, handler.Nullable()),
+			handler.NewColumn(UserGrantValidUntil, handler.ColumnTypeTimestamp, handler.Nullable()),
+			handler.NewColumn(UserGrantExpiryReminded, handler.ColumnTypeBool, handler.Default(false)),
 		},
 			handler.NewPrimaryKey(UserGrantInstanceID, UserGrantID),
 			handler.WithIndex(handler.NewIndex("user_id", []string{UserGrantUserID})),
 			handler.WithIndex(handler.NewIndex("resource_owner", []string{UserGrantResourceOwner})),
+			handler.WithIndex(handler.NewIndex("valid_until", []string{UserGrantValidUntil})),
 		),
 	)
 }
@@ -106,6 +113,18 @@ func (p *userGrantProjection) Reducers() []handler.AggregateReducer {
 					Event:  usergrant.UserGrantReactivatedType,
 					Reduce: p.reduceReactivated,
 				},
+				{
+					Event:  usergrant.UserGrantValidityChangedType,
+					Reduce: p.reduceValidityChanged,
+				},
+				{
+					Event:  usergrant.UserGrantExpiredType,
+					Reduce: p.reduceExpired,
+				},
+				{
+					Event:  usergrant.UserGrantExpiryRemindedType,
+					Reduce: p.reduceExpiryReminded,
+				},
 			},
 		},
 		{
@@ -192,6 +211,8 @@ func (p *userGrantProjection) reduceAdded(event eventstore.Event) (*handler.Stat
 			handler.NewCol(UserGrantGrantedOrg, grantOwner),
 			handler.NewCol(UserGrantRoles, database.TextArray[string](e.RoleKeys)),
 			handler.NewCol(UserGrantState, domain.UserGrantStateActive),
+			handler.NewCol(UserGrantValidFrom, e.ValidFrom),
+			handler.NewCol(UserGrantValidUntil, e.ValidUntil),
 		},
 	), nil
 }
@@ -277,6 +298,65 @@ func (p *userGrantProjection) reduceReactivated(event eventstore.Event) (*handle
 	), nil
 }
 
+func (p *userGrantProjection) reduceValidityChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*usergrant.UserGrantValidityChangedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-Uo3ae", "reduce.wrong.event.type %s", usergrant.UserGrantValidityChangedType)
+	}
+
+	return handler.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(UserGrantChangeDate, e.CreatedAt()),
+			handler.NewCol(UserGrantValidFrom, e.ValidFrom),
+			handler.NewCol(UserGrantValidUntil, e.ValidUntil),
+			handler.NewCol(UserGrantExpiryReminded, false),
+			handler.NewCol(UserGrantSequence, e.Sequence()),
+		},
+		[]handler.Condition{
+			handler.NewCond(UserGrantID, e.Aggregate().ID),
+			handler.NewCond(UserGrantInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *userGrantProjection) reduceExpired(event eventstore.Event) (*handler.Statement, error) {
+	if _, ok := event.(*usergrant.UserGrantExpiredEvent); !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-ahl6O", "reduce.wrong.event.type %s", usergrant.UserGrantExpiredType)
+	}
+
+	return handler.NewUpdateStatement(
+		event,
+		[]handler.Column{
+			handler.NewCol(UserGrantChangeDate, event.CreatedAt()),
+			handler.NewCol(UserGrantState, domain.UserGrantStateInactive),
+			handler.NewCol(UserGrantSequence, event.Sequence()),
+		},
+		[]handler.Condition{
+			handler.NewCond(UserGrantID, event.Aggregate().ID),
+			handler.NewCond(UserGrantInstanceID, event.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *userGrantProjection) reduceExpiryReminded(event eventstore.Event) (*handler.Statement, error) {
+	if _, ok := event.(*usergrant.UserGrantExpiryRemindedEvent); !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-Eeth3", "reduce.wrong.event.type %s", usergrant.UserGrantExpiryRemindedType)
+	}
+
+	return handler.NewUpdateStatement(
+		event,
+		[]handler.Column{
+			handler.NewCol(UserGrantExpiryReminded, true),
+			handler.NewCol(UserGrantSequence, event.Sequence()),
+		},
+		[]handler.Condition{
+			handler.NewCond(UserGrantID, event.Aggregate().ID),
+			handler.NewCond(UserGrantInstanceID, event.Aggregate().InstanceID),
+		},
+	), nil
+}
+
 func (p *userGrantProjection) reduceUserRemoved(event eventstore.Event) (*handler.Statement, error) {
 	if _, ok := event.(*user.UserRemovedEvent); !ok {
 		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-Bner2a", "reduce.wrong.event.type %s", user.UserRemovedType)
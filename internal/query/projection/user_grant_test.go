@@ -3,7 +3,9 @@ package projection
 import (
 	"context"
 	"testing"
+	"time"
 
+	"github.com/muhlemmer/gu"
 	"github.com/stretchr/testify/require"
 	"golang.org/x/text/language"
 
@@ -85,7 +87,7 @@ func TestUserGrantProjection_reduces(t *testing.T) {
 			executer: &testExecuter{
 				executions: []execution{
 					{
-						expectedStmt: "INSERT INTO projections.user_grants5 (id, resource_owner, instance_id, creation_date, change_date, sequence, user_id, resource_owner_user, project_id, resource_owner_project, grant_id, granted_org, roles, state) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)",
+						expectedStmt: "INSERT INTO projections.user_grants5 (id, resource_owner, instance_id, creation_date, change_date, sequence, user_id, resource_owner_user, project_id, resource_owner_project, grant_id, granted_org, roles, state, valid_from, valid_until) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)",
 						expectedArgs: []interface{}{
 							"agg-id",
 							"ro-id",
@@ -101,6 +103,8 @@ func TestUserGrantProjection_reduces(t *testing.T) {
 							"",
 							database.TextArray[string]{"role"},
 							domain.UserGrantStateActive,
+							(*time.Time)(nil),
+							(*time.Time)(nil),
 						},
 					},
 				},
@@ -152,7 +156,7 @@ func TestUserGrantProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "INSERT INTO projections.user_grants5 (id, resource_owner, instance_id, creation_date, change_date, sequence, user_id, resource_owner_user, project_id, resource_owner_project, grant_id, granted_org, roles, state) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)",
+							expectedStmt: "INSERT INTO projections.user_grants5 (id, resource_owner, instance_id, creation_date, change_date, sequence, user_id, resource_owner_user, project_id, resource_owner_project, grant_id, granted_org, roles, state, valid_from, valid_until) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)",
 							expectedArgs: []interface{}{
 								"agg-id",
 								"ro-id",
@@ -168,6 +172,8 @@ func TestUserGrantProjection_reduces(t *testing.T) {
 								"",
 								database.TextArray[string]{"role"},
 								domain.UserGrantStateActive,
+								(*time.Time)(nil),
+								(*time.Time)(nil),
 							},
 						},
 					},
@@ -223,7 +229,7 @@ func TestUserGrantProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "INSERT INTO projections.user_grants5 (id, resource_owner, instance_id, creation_date, change_date, sequence, user_id, resource_owner_user, project_id, resource_owner_project, grant_id, granted_org, roles, state) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)",
+							expectedStmt: "INSERT INTO projections.user_grants5 (id, resource_owner, instance_id, creation_date, change_date, sequence, user_id, resource_owner_user, project_id, resource_owner_project, grant_id, granted_org, roles, state, valid_from, valid_until) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)",
 							expectedArgs: []interface{}{
 								"agg-id",
 								"ro-id",
@@ -239,6 +245,8 @@ func TestUserGrantProjection_reduces(t *testing.T) {
 								"org3",
 								database.TextArray[string]{"role"},
 								domain.UserGrantStateActive,
+								(*time.Time)(nil),
+								(*time.Time)(nil),
 							},
 						},
 					},
@@ -449,6 +457,105 @@ func TestUserGrantProjection_reduces(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "reduceValidityChanged",
+			args: args{
+				event: getEvent(
+					testEvent(
+						usergrant.UserGrantValidityChangedType,
+						usergrant.AggregateType,
+						[]byte(`{
+						"validUntil": "2026-01-01T00:00:00Z"
+					}`),
+					), usergrant.UserGrantValidityChangedEventMapper),
+			},
+			reduce: (&userGrantProjection{}).reduceValidityChanged,
+			want: wantReduce{
+				aggregateType: usergrant.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.user_grants5 SET (change_date, valid_from, valid_until, expiry_reminded, sequence) = ($1, $2, $3, $4, $5) WHERE (id = $6) AND (instance_id = $7)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								(*time.Time)(nil),
+								gu.Ptr(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)),
+								false,
+								uint64(15),
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceExpired",
+			args: args{
+				event: getEvent(
+					testEvent(
+						usergrant.UserGrantExpiredType,
+						usergrant.AggregateType,
+						[]byte(`{
+						"validUntil": "2026-01-01T00:00:00Z"
+					}`),
+					), usergrant.UserGrantExpiredEventMapper),
+			},
+			reduce: (&userGrantProjection{}).reduceExpired,
+			want: wantReduce{
+				aggregateType: usergrant.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.user_grants5 SET (change_date, state, sequence) = ($1, $2, $3) WHERE (id = $4) AND (instance_id = $5)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								domain.UserGrantStateInactive,
+								uint64(15),
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceExpiryReminded",
+			args: args{
+				event: getEvent(
+					testEvent(
+						usergrant.UserGrantExpiryRemindedType,
+						usergrant.AggregateType,
+						[]byte(`{
+						"userId": "user-id",
+						"projectName": "project",
+						"validUntil": "2026-01-01T00:00:00Z"
+					}`),
+					), usergrant.UserGrantExpiryRemindedEventMapper),
+			},
+			reduce: (&userGrantProjection{}).reduceExpiryReminded,
+			want: wantReduce{
+				aggregateType: usergrant.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.user_grants5 SET (expiry_reminded, sequence) = ($1, $2) WHERE (id = $3) AND (instance_id = $4)",
+							expectedArgs: []interface{}{
+								true,
+								uint64(15),
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "reduceUserRemoved",
 			args: args{
@@ -0,0 +1,191 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/workloadidentity"
+)
+
+const (
+	WorkloadIdentityTrustTable            = "projections.workload_identity_trusts"
+	WorkloadIdentityTrustIDCol            = "id"
+	WorkloadIdentityTrustCreationDateCol  = "creation_date"
+	WorkloadIdentityTrustChangeDateCol    = "change_date"
+	WorkloadIdentityTrustResourceOwnerCol = "resource_owner"
+	WorkloadIdentityTrustInstanceIDCol    = "instance_id"
+	WorkloadIdentityTrustSequenceCol      = "sequence"
+	WorkloadIdentityTrustNameCol          = "name"
+	WorkloadIdentityTrustIssuerCol        = "issuer"
+	WorkloadIdentityTrustJWKSEndpointCol  = "jwks_endpoint"
+	WorkloadIdentityTrustJWKSCol          = "jwks"
+	WorkloadIdentityTrustAudienceCol      = "audience"
+	WorkloadIdentityTrustRulesCol         = "rules"
+)
+
+type workloadIdentityTrustProjection struct{}
+
+func newWorkloadIdentityTrustProjection(ctx context.Context, config handler.Config) *handler.Handler {
+	return handler.NewHandler(ctx, &config, new(workloadIdentityTrustProjection))
+}
+
+func (*workloadIdentityTrustProjection) Name() string {
+	return WorkloadIdentityTrustTable
+}
+
+func (*workloadIdentityTrustProjection) Init() *old_handler.Check {
+	return handler.NewTableCheck(
+		handler.NewTable([]*handler.InitColumn{
+			handler.NewColumn(WorkloadIdentityTrustIDCol, handler.ColumnTypeText),
+			handler.NewColumn(WorkloadIdentityTrustCreationDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(WorkloadIdentityTrustChangeDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(WorkloadIdentityTrustResourceOwnerCol, handler.ColumnTypeText),
+			handler.NewColumn(WorkloadIdentityTrustInstanceIDCol, handler.ColumnTypeText),
+			handler.NewColumn(WorkloadIdentityTrustSequenceCol, handler.ColumnTypeInt64),
+			handler.NewColumn(WorkloadIdentityTrustNameCol, handler.ColumnTypeText),
+			handler.NewColumn(WorkloadIdentityTrustIssuerCol, handler.ColumnTypeText),
+			handler.NewColumn(WorkloadIdentityTrustJWKSEndpointCol, handler.ColumnTypeText, handler.Default("")),
+			handler.NewColumn(WorkloadIdentityTrustJWKSCol, handler.ColumnTypeBytes, handler.Nullable()),
+			handler.NewColumn(WorkloadIdentityTrustAudienceCol, handler.ColumnTypeTextArray),
+			handler.NewColumn(WorkloadIdentityTrustRulesCol, handler.ColumnTypeJSONB),
+		},
+			handler.NewPrimaryKey(WorkloadIdentityTrustInstanceIDCol, WorkloadIdentityTrustIDCol),
+			handler.WithIndex(handler.NewIndex("issuer", []string{WorkloadIdentityTrustInstanceIDCol, WorkloadIdentityTrustIssuerCol})),
+			handler.WithIndex(handler.NewIndex("resource_owner", []string{WorkloadIdentityTrustResourceOwnerCol})),
+		),
+	)
+}
+
+func (p *workloadIdentityTrustProjection) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: workloadidentity.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  workloadidentity.AddedEventType,
+					Reduce: p.reduceAdded,
+				},
+				{
+					Event:  workloadidentity.ChangedEventType,
+					Reduce: p.reduceChanged,
+				},
+				{
+					Event:  workloadidentity.RemovedEventType,
+					Reduce: p.reduceRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: org.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOwnerRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: reduceInstanceRemovedHelper(WorkloadIdentityTrustInstanceIDCol),
+				},
+			},
+		},
+	}
+}
+
+func (p *workloadIdentityTrustProjection) reduceAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*workloadidentity.AddedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewCreateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(WorkloadIdentityTrustInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCol(WorkloadIdentityTrustResourceOwnerCol, e.Aggregate().ResourceOwner),
+			handler.NewCol(WorkloadIdentityTrustIDCol, e.Aggregate().ID),
+			handler.NewCol(WorkloadIdentityTrustCreationDateCol, e.CreationDate()),
+			handler.NewCol(WorkloadIdentityTrustChangeDateCol, e.CreationDate()),
+			handler.NewCol(WorkloadIdentityTrustSequenceCol, e.Sequence()),
+			handler.NewCol(WorkloadIdentityTrustNameCol, e.Name),
+			handler.NewCol(WorkloadIdentityTrustIssuerCol, e.Issuer),
+			handler.NewCol(WorkloadIdentityTrustJWKSEndpointCol, e.JWKSEndpoint),
+			handler.NewCol(WorkloadIdentityTrustJWKSCol, e.JWKS),
+			handler.NewCol(WorkloadIdentityTrustAudienceCol, database.TextArray[string](e.Audience)),
+			handler.NewJSONCol(WorkloadIdentityTrustRulesCol, e.Rules),
+		},
+	), nil
+}
+
+func (p *workloadIdentityTrustProjection) reduceChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*workloadidentity.ChangedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	values := []handler.Column{
+		handler.NewCol(WorkloadIdentityTrustChangeDateCol, e.CreationDate()),
+		handler.NewCol(WorkloadIdentityTrustSequenceCol, e.Sequence()),
+	}
+	if e.Name != nil {
+		values = append(values, handler.NewCol(WorkloadIdentityTrustNameCol, *e.Name))
+	}
+	if e.Issuer != nil {
+		values = append(values, handler.NewCol(WorkloadIdentityTrustIssuerCol, *e.Issuer))
+	}
+	if e.JWKSEndpoint != nil {
+		values = append(values,
+			handler.NewCol(WorkloadIdentityTrustJWKSEndpointCol, *e.JWKSEndpoint),
+			handler.NewCol(WorkloadIdentityTrustJWKSCol, e.JWKS),
+		)
+	}
+	if e.Audience != nil {
+		values = append(values, handler.NewCol(WorkloadIdentityTrustAudienceCol, database.TextArray[string](e.Audience)))
+	}
+	if e.Rules != nil {
+		values = append(values, handler.NewJSONCol(WorkloadIdentityTrustRulesCol, e.Rules))
+	}
+	return handler.NewUpdateStatement(
+		e,
+		values,
+		[]handler.Condition{
+			handler.NewCond(WorkloadIdentityTrustInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCond(WorkloadIdentityTrustIDCol, e.Aggregate().ID),
+		},
+	), nil
+}
+
+func (p *workloadIdentityTrustProjection) reduceRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*workloadidentity.RemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(WorkloadIdentityTrustInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCond(WorkloadIdentityTrustIDCol, e.Aggregate().ID),
+		},
+	), nil
+}
+
+func (p *workloadIdentityTrustProjection) reduceOwnerRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*org.OrgRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(WorkloadIdentityTrustInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCond(WorkloadIdentityTrustResourceOwnerCol, e.Aggregate().ID),
+		},
+	), nil
+}
@@ -0,0 +1,169 @@
+package projection
+
+import (
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/workloadidentity"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestWorkloadIdentityTrustProjection_reduces(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "reduceAdded",
+			args: args{
+				event: getEvent(
+					testEvent(
+						workloadidentity.AddedEventType,
+						workloadidentity.AggregateType,
+						[]byte(`{"name": "name", "issuer": "https://kubernetes.default.svc", "jwksEndpoint": "https://kubernetes.example.com/openid/v1/jwks", "audience": ["zitadel"], "rules": [{"conditions": {"sub": "system:serviceaccount:ci:*"}, "userId": "machine1"}]}`),
+					),
+					eventstore.GenericEventMapper[workloadidentity.AddedEvent],
+				),
+			},
+			reduce: (&workloadIdentityTrustProjection{}).reduceAdded,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("workload_identity_trust"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.workload_identity_trusts (instance_id, resource_owner, id, creation_date, change_date, sequence, name, issuer, jwks_endpoint, jwks, audience, rules) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"ro-id",
+								"agg-id",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								"name",
+								"https://kubernetes.default.svc",
+								"https://kubernetes.example.com/openid/v1/jwks",
+								[]byte(nil),
+								database.TextArray[string]{"zitadel"},
+								[]byte(`[{"conditions":{"sub":"system:serviceaccount:ci:*"},"userId":"machine1"}]`),
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceChanged",
+			args: args{
+				event: getEvent(
+					testEvent(
+						workloadidentity.ChangedEventType,
+						workloadidentity.AggregateType,
+						[]byte(`{"name": "name2", "jwksEndpoint": "", "jwks": "e30="}`),
+					),
+					eventstore.GenericEventMapper[workloadidentity.ChangedEvent],
+				),
+			},
+			reduce: (&workloadIdentityTrustProjection{}).reduceChanged,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("workload_identity_trust"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.workload_identity_trusts SET (change_date, sequence, name, jwks_endpoint, jwks) = ($1, $2, $3, $4, $5) WHERE (instance_id = $6) AND (id = $7)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								"name2",
+								"",
+								[]byte("{}"),
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						workloadidentity.RemovedEventType,
+						workloadidentity.AggregateType,
+						nil,
+					),
+					eventstore.GenericEventMapper[workloadidentity.RemovedEvent],
+				),
+			},
+			reduce: (&workloadIdentityTrustProjection{}).reduceRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("workload_identity_trust"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.workload_identity_trusts WHERE (instance_id = $1) AND (id = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceOwnerRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						org.OrgRemovedEventType,
+						org.AggregateType,
+						nil,
+					),
+					org.OrgRemovedEventMapper,
+				),
+			},
+			reduce: (&workloadIdentityTrustProjection{}).reduceOwnerRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("org"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.workload_identity_trusts WHERE (instance_id = $1) AND (resource_owner = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if ok := zerrors.IsErrorInvalidArgument(err); !ok {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, WorkloadIdentityTrustTable, tt.want)
+		})
+	}
+}
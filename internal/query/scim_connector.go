@@ -0,0 +1,265 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	scimConnectorTable = table{
+		name:          projection.SCIMConnectorTable,
+		instanceIDCol: projection.SCIMConnectorInstanceIDCol,
+	}
+	SCIMConnectorColumnID = Column{
+		name:  projection.SCIMConnectorIDCol,
+		table: scimConnectorTable,
+	}
+	SCIMConnectorColumnCreationDate = Column{
+		name:  projection.SCIMConnectorCreationDateCol,
+		table: scimConnectorTable,
+	}
+	SCIMConnectorColumnChangeDate = Column{
+		name:  projection.SCIMConnectorChangeDateCol,
+		table: scimConnectorTable,
+	}
+	SCIMConnectorColumnResourceOwner = Column{
+		name:  projection.SCIMConnectorResourceOwnerCol,
+		table: scimConnectorTable,
+	}
+	SCIMConnectorColumnInstanceID = Column{
+		name:  projection.SCIMConnectorInstanceIDCol,
+		table: scimConnectorTable,
+	}
+	SCIMConnectorColumnSequence = Column{
+		name:  projection.SCIMConnectorSequenceCol,
+		table: scimConnectorTable,
+	}
+	SCIMConnectorColumnState = Column{
+		name:  projection.SCIMConnectorStateCol,
+		table: scimConnectorTable,
+	}
+	SCIMConnectorColumnProjectID = Column{
+		name:  projection.SCIMConnectorProjectIDCol,
+		table: scimConnectorTable,
+	}
+	SCIMConnectorColumnName = Column{
+		name:  projection.SCIMConnectorNameCol,
+		table: scimConnectorTable,
+	}
+	SCIMConnectorColumnEndpoint = Column{
+		name:  projection.SCIMConnectorEndpointCol,
+		table: scimConnectorTable,
+	}
+	SCIMConnectorColumnToken = Column{
+		name:  projection.SCIMConnectorTokenCol,
+		table: scimConnectorTable,
+	}
+)
+
+type SCIMConnectors struct {
+	SearchResponse
+	SCIMConnectors []*SCIMConnector
+}
+
+func (c *SCIMConnectors) SetState(s *State) {
+	c.State = s
+}
+
+type SCIMConnector struct {
+	domain.ObjectDetails
+
+	ProjectID string
+	Name      string
+	Endpoint  string
+	State     domain.SCIMConnectorState
+	token     *crypto.CryptoValue
+	// Token is only set by [Queries.GetSCIMConnectorWithTokenByID]
+	Token string
+}
+
+type SCIMConnectorSearchQueries struct {
+	SearchRequest
+	Queries []SearchQuery
+}
+
+func (q *SCIMConnectorSearchQueries) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	query = q.SearchRequest.toQuery(query)
+	for _, q := range q.Queries {
+		query = q.toQuery(query)
+	}
+	return query
+}
+
+func (q *Queries) SearchSCIMConnectors(ctx context.Context, queries *SCIMConnectorSearchQueries) (_ *SCIMConnectors, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	eq := sq.Eq{
+		SCIMConnectorColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+	}
+	query, scan := prepareSCIMConnectorsQuery()
+	return genericRowsQueryWithState(ctx, q.client, scimConnectorTable, combineToWhereStmt(query, queries.toQuery, eq), scan)
+}
+
+func (q *Queries) GetSCIMConnectorByID(ctx context.Context, projectID, id, resourceOwner string) (_ *SCIMConnector, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	eq := sq.Eq{
+		SCIMConnectorColumnID.identifier():            id,
+		SCIMConnectorColumnProjectID.identifier():     projectID,
+		SCIMConnectorColumnResourceOwner.identifier(): resourceOwner,
+		SCIMConnectorColumnInstanceID.identifier():    authz.GetInstance(ctx).InstanceID(),
+	}
+	query, scan := prepareSCIMConnectorQuery()
+	return genericRowQuery(ctx, q.client, query.Where(eq), scan)
+}
+
+// GetSCIMConnectorWithTokenByID returns the connector including the decrypted bearer token,
+// which is needed to call the downstream SCIM service provider.
+func (q *Queries) GetSCIMConnectorWithTokenByID(ctx context.Context, shouldTriggerBulk bool, id string) (_ *SCIMConnector, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if shouldTriggerBulk {
+		_, traceSpan := tracing.NewNamedSpan(ctx, "TriggerSCIMConnectorProjection")
+		ctx, err = projection.SCIMConnectorProjection.Trigger(ctx, handler.WithAwaitRunning())
+		logging.OnError(err).Debug("trigger failed")
+		traceSpan.EndWithError(err)
+	}
+
+	eq := sq.Eq{
+		SCIMConnectorColumnID.identifier():         id,
+		SCIMConnectorColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+	}
+	query, scan := prepareSCIMConnectorQuery()
+	connector, err := genericRowQuery(ctx, q.client, query.Where(eq), scan)
+	if err != nil {
+		return nil, err
+	}
+	connector.Token, err = crypto.DecryptString(connector.token, q.targetEncryptionAlgorithm)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-ahn8U", "Errors.Internal")
+	}
+	return connector, nil
+}
+
+func NewSCIMConnectorProjectIDSearchQuery(projectID string) (SearchQuery, error) {
+	return NewTextQuery(SCIMConnectorColumnProjectID, projectID, TextEquals)
+}
+
+func NewSCIMConnectorInProjectIDsSearchQuery(projectIDs []string) (SearchQuery, error) {
+	return NewInTextQuery(SCIMConnectorColumnProjectID, projectIDs)
+}
+
+func NewSCIMConnectorResourceOwnerSearchQuery(resourceOwner string) (SearchQuery, error) {
+	return NewTextQuery(SCIMConnectorColumnResourceOwner, resourceOwner, TextEquals)
+}
+
+func NewSCIMConnectorNameSearchQuery(method TextComparison, value string) (SearchQuery, error) {
+	return NewTextQuery(SCIMConnectorColumnName, value, method)
+}
+
+func NewSCIMConnectorStateSearchQuery(state domain.SCIMConnectorState) (SearchQuery, error) {
+	return NewNumberQuery(SCIMConnectorColumnState, state, NumberEquals)
+}
+
+func prepareSCIMConnectorsQuery() (sq.SelectBuilder, func(rows *sql.Rows) (*SCIMConnectors, error)) {
+	return sq.Select(
+			SCIMConnectorColumnID.identifier(),
+			SCIMConnectorColumnCreationDate.identifier(),
+			SCIMConnectorColumnChangeDate.identifier(),
+			SCIMConnectorColumnResourceOwner.identifier(),
+			SCIMConnectorColumnSequence.identifier(),
+			SCIMConnectorColumnState.identifier(),
+			SCIMConnectorColumnProjectID.identifier(),
+			SCIMConnectorColumnName.identifier(),
+			SCIMConnectorColumnEndpoint.identifier(),
+			countColumn.identifier(),
+		).From(scimConnectorTable.identifier()).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*SCIMConnectors, error) {
+			connectors := make([]*SCIMConnector, 0)
+			var count uint64
+			for rows.Next() {
+				connector := new(SCIMConnector)
+				err := rows.Scan(
+					&connector.ID,
+					&connector.CreationDate,
+					&connector.EventDate,
+					&connector.ResourceOwner,
+					&connector.Sequence,
+					&connector.State,
+					&connector.ProjectID,
+					&connector.Name,
+					&connector.Endpoint,
+					&count,
+				)
+				if err != nil {
+					return nil, err
+				}
+				connectors = append(connectors, connector)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-Ohk6i", "Errors.Query.CloseRows")
+			}
+
+			return &SCIMConnectors{
+				SCIMConnectors: connectors,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
+
+func prepareSCIMConnectorQuery() (sq.SelectBuilder, func(row *sql.Row) (*SCIMConnector, error)) {
+	return sq.Select(
+			SCIMConnectorColumnID.identifier(),
+			SCIMConnectorColumnCreationDate.identifier(),
+			SCIMConnectorColumnChangeDate.identifier(),
+			SCIMConnectorColumnResourceOwner.identifier(),
+			SCIMConnectorColumnSequence.identifier(),
+			SCIMConnectorColumnState.identifier(),
+			SCIMConnectorColumnProjectID.identifier(),
+			SCIMConnectorColumnName.identifier(),
+			SCIMConnectorColumnEndpoint.identifier(),
+			SCIMConnectorColumnToken.identifier(),
+		).From(scimConnectorTable.identifier()).
+			PlaceholderFormat(sq.Dollar),
+		func(row *sql.Row) (*SCIMConnector, error) {
+			connector := new(SCIMConnector)
+			err := row.Scan(
+				&connector.ID,
+				&connector.CreationDate,
+				&connector.EventDate,
+				&connector.ResourceOwner,
+				&connector.Sequence,
+				&connector.State,
+				&connector.ProjectID,
+				&connector.Name,
+				&connector.Endpoint,
+				&connector.token,
+			)
+			if err != nil {
+				if errors.Is(err, sql.ErrNoRows) {
+					return nil, zerrors.ThrowNotFound(err, "QUERY-Eej3a", "Errors.SCIMConnector.NotFound")
+				}
+				return nil, zerrors.ThrowInternal(err, "QUERY-Ohd1e", "Errors.Internal")
+			}
+			return connector, nil
+		}
+}
@@ -0,0 +1,236 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	prepareSCIMConnectorsStmt = `SELECT projections.scim_connectors.id,` +
+		` projections.scim_connectors.creation_date,` +
+		` projections.scim_connectors.change_date,` +
+		` projections.scim_connectors.resource_owner,` +
+		` projections.scim_connectors.sequence,` +
+		` projections.scim_connectors.state,` +
+		` projections.scim_connectors.project_id,` +
+		` projections.scim_connectors.name,` +
+		` projections.scim_connectors.endpoint,` +
+		` COUNT(*) OVER ()` +
+		` FROM projections.scim_connectors`
+	prepareSCIMConnectorsCols = []string{
+		"id",
+		"creation_date",
+		"change_date",
+		"resource_owner",
+		"sequence",
+		"state",
+		"project_id",
+		"name",
+		"endpoint",
+		"count",
+	}
+
+	prepareSCIMConnectorStmt = `SELECT projections.scim_connectors.id,` +
+		` projections.scim_connectors.creation_date,` +
+		` projections.scim_connectors.change_date,` +
+		` projections.scim_connectors.resource_owner,` +
+		` projections.scim_connectors.sequence,` +
+		` projections.scim_connectors.state,` +
+		` projections.scim_connectors.project_id,` +
+		` projections.scim_connectors.name,` +
+		` projections.scim_connectors.endpoint,` +
+		` projections.scim_connectors.token` +
+		` FROM projections.scim_connectors`
+	prepareSCIMConnectorCols = []string{
+		"id",
+		"creation_date",
+		"change_date",
+		"resource_owner",
+		"sequence",
+		"state",
+		"project_id",
+		"name",
+		"endpoint",
+		"token",
+	}
+)
+
+func Test_SCIMConnectorPrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareSCIMConnectorsQuery no result",
+			prepare: prepareSCIMConnectorsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareSCIMConnectorsStmt),
+					nil,
+					nil,
+				),
+			},
+			object: &SCIMConnectors{SCIMConnectors: []*SCIMConnector{}},
+		},
+		{
+			name:    "prepareSCIMConnectorsQuery one result",
+			prepare: prepareSCIMConnectorsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareSCIMConnectorsStmt),
+					prepareSCIMConnectorsCols,
+					[][]driver.Value{
+						{
+							"id",
+							testNow,
+							testNow,
+							"ro",
+							uint64(20211109),
+							domain.SCIMConnectorStateActive,
+							"project-id",
+							"connector-name",
+							"https://example.com/scim/v2",
+						},
+					},
+				),
+			},
+			object: &SCIMConnectors{
+				SearchResponse: SearchResponse{
+					Count: 1,
+				},
+				SCIMConnectors: []*SCIMConnector{
+					{
+						ObjectDetails: domain.ObjectDetails{
+							ID:            "id",
+							EventDate:     testNow,
+							CreationDate:  testNow,
+							ResourceOwner: "ro",
+							Sequence:      20211109,
+						},
+						State:     domain.SCIMConnectorStateActive,
+						ProjectID: "project-id",
+						Name:      "connector-name",
+						Endpoint:  "https://example.com/scim/v2",
+					},
+				},
+			},
+		},
+		{
+			name:    "prepareSCIMConnectorsQuery sql err",
+			prepare: prepareSCIMConnectorsQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(prepareSCIMConnectorsStmt),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*SCIMConnectors)(nil),
+		},
+		{
+			name:    "prepareSCIMConnectorQuery no result",
+			prepare: prepareSCIMConnectorQuery,
+			want: want{
+				sqlExpectations: mockQueriesScanErr(
+					regexp.QuoteMeta(prepareSCIMConnectorStmt),
+					nil,
+					nil,
+				),
+				err: func(err error) (error, bool) {
+					if !zerrors.IsNotFound(err) {
+						return fmt.Errorf("err should be zitadel.NotFoundError got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*SCIMConnector)(nil),
+		},
+		{
+			name:    "prepareSCIMConnectorQuery found",
+			prepare: prepareSCIMConnectorQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					regexp.QuoteMeta(prepareSCIMConnectorStmt),
+					prepareSCIMConnectorCols,
+					[]driver.Value{
+						"id",
+						testNow,
+						testNow,
+						"ro",
+						uint64(20211109),
+						domain.SCIMConnectorStateInactive,
+						"project-id",
+						"connector-name",
+						"https://example.com/scim/v2",
+						&crypto.CryptoValue{
+							CryptoType: crypto.TypeEncryption,
+							Algorithm:  "alg",
+							KeyID:      "encKey",
+							Crypted:    []byte("crypted"),
+						},
+					},
+				),
+			},
+			object: &SCIMConnector{
+				ObjectDetails: domain.ObjectDetails{
+					ID:            "id",
+					EventDate:     testNow,
+					CreationDate:  testNow,
+					ResourceOwner: "ro",
+					Sequence:      20211109,
+				},
+				State:     domain.SCIMConnectorStateInactive,
+				ProjectID: "project-id",
+				Name:      "connector-name",
+				Endpoint:  "https://example.com/scim/v2",
+				token: &crypto.CryptoValue{
+					CryptoType: crypto.TypeEncryption,
+					Algorithm:  "alg",
+					KeyID:      "encKey",
+					Crypted:    []byte("crypted"),
+				},
+			},
+		},
+		{
+			name:    "prepareSCIMConnectorQuery sql err",
+			prepare: prepareSCIMConnectorQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(prepareSCIMConnectorStmt),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*SCIMConnector)(nil),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err)
+		})
+	}
+}
@@ -299,6 +299,7 @@ func NewTextQuery(col Column, value string, compare TextComparison) (*textQuery,
 	case TextEquals,
 		TextListContains,
 		TextNotEquals,
+		TextGreater,
 		textCompareMax:
 		// do nothing
 	}
@@ -351,6 +352,8 @@ func (q *textQuery) comp() sq.Sqlizer {
 		return sq.ILike{q.Column.identifier(): "%" + q.Text + "%"}
 	case TextListContains:
 		return &listContains{col: q.Column, args: []interface{}{q.Text}}
+	case TextGreater:
+		return sq.Gt{q.Column.identifier(): q.Text}
 	case textCompareMax:
 		return nil
 	}
@@ -372,6 +375,8 @@ const (
 	TextListContains
 	TextNotEquals
 	TextNotEqualsIgnoreCase
+	// TextGreater compares the text by the sort order of the database, e.g. to page by the value.
+	TextGreater
 
 	textCompareMax
 )
@@ -1229,6 +1229,17 @@ func TestTextQuery_comp(t *testing.T) {
 				query: sq.NotILike{"test_table.test_col": "Hurst"},
 			},
 		},
+		{
+			name: "greater",
+			fields: fields{
+				Column:  testCol,
+				Text:    "Hurst",
+				Compare: TextGreater,
+			},
+			want: want{
+				query: sq.Gt{"test_table.test_col": "Hurst"},
+			},
+		},
 		{
 			name: "equals ignore case wildcard",
 			fields: fields{
@@ -64,6 +64,18 @@ var (
 		name:  projection.TargetSigningKey,
 		table: targetTable,
 	}
+	TargetColumnClientCertificate = Column{
+		name:  projection.TargetClientCertificate,
+		table: targetTable,
+	}
+	TargetColumnClientKey = Column{
+		name:  projection.TargetClientKey,
+		table: targetTable,
+	}
+	TargetColumnRootCA = Column{
+		name:  projection.TargetRootCA,
+		table: targetTable,
+	}
 )
 
 type Targets struct {
@@ -85,6 +97,10 @@ type Target struct {
 	InterruptOnError bool
 	signingKey       *crypto.CryptoValue
 	SigningKey       string
+	// ClientCertificate and RootCA are only set for gRPC targets,
+	// the client key is never returned.
+	ClientCertificate []byte
+	RootCA            []byte
 }
 
 func (t *Target) decryptSigningKey(alg crypto.EncryptionAlgorithm) error {
@@ -165,6 +181,8 @@ func prepareTargetsQuery() (sq.SelectBuilder, func(rows *sql.Rows) (*Targets, er
 			TargetColumnURL.identifier(),
 			TargetColumnInterruptOnError.identifier(),
 			TargetColumnSigningKey.identifier(),
+			TargetColumnClientCertificate.identifier(),
+			TargetColumnRootCA.identifier(),
 			countColumn.identifier(),
 		).From(targetTable.identifier()).
 			PlaceholderFormat(sq.Dollar),
@@ -184,6 +202,8 @@ func prepareTargetsQuery() (sq.SelectBuilder, func(rows *sql.Rows) (*Targets, er
 					&target.Endpoint,
 					&target.InterruptOnError,
 					&target.signingKey,
+					&target.ClientCertificate,
+					&target.RootCA,
 					&count,
 				)
 				if err != nil {
@@ -217,6 +237,8 @@ func prepareTargetQuery() (sq.SelectBuilder, func(row *sql.Row) (*Target, error)
 			TargetColumnURL.identifier(),
 			TargetColumnInterruptOnError.identifier(),
 			TargetColumnSigningKey.identifier(),
+			TargetColumnClientCertificate.identifier(),
+			TargetColumnRootCA.identifier(),
 		).From(targetTable.identifier()).
 			PlaceholderFormat(sq.Dollar),
 		func(row *sql.Row) (*Target, error) {
@@ -232,6 +254,8 @@ func prepareTargetQuery() (sq.SelectBuilder, func(row *sql.Row) (*Target, error)
 				&target.Endpoint,
 				&target.InterruptOnError,
 				&target.signingKey,
+				&target.ClientCertificate,
+				&target.RootCA,
 			)
 			if err != nil {
 				if errors.Is(err, sql.ErrNoRows) {
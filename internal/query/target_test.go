@@ -25,6 +25,8 @@ var (
 		` projections.targets2.endpoint,` +
 		` projections.targets2.interrupt_on_error,` +
 		` projections.targets2.signing_key,` +
+		` projections.targets2.client_certificate,` +
+		` projections.targets2.root_ca,` +
 		` COUNT(*) OVER ()` +
 		` FROM projections.targets2`
 	prepareTargetsCols = []string{
@@ -38,6 +40,8 @@ var (
 		"endpoint",
 		"interrupt_on_error",
 		"signing_key",
+		"client_certificate",
+		"root_ca",
 		"count",
 	}
 
@@ -50,7 +54,9 @@ var (
 		` projections.targets2.timeout,` +
 		` projections.targets2.endpoint,` +
 		` projections.targets2.interrupt_on_error,` +
-		` projections.targets2.signing_key` +
+		` projections.targets2.signing_key,` +
+		` projections.targets2.client_certificate,` +
+		` projections.targets2.root_ca` +
 		` FROM projections.targets2`
 	prepareTargetCols = []string{
 		"id",
@@ -63,6 +69,8 @@ var (
 		"endpoint",
 		"interrupt_on_error",
 		"signing_key",
+		"client_certificate",
+		"root_ca",
 	}
 )
 
@@ -113,6 +121,8 @@ func Test_TargetPrepares(t *testing.T) {
 								KeyID:      "encKey",
 								Crypted:    []byte("crypted"),
 							},
+							nil,
+							nil,
 						},
 					},
 				),
@@ -168,6 +178,8 @@ func Test_TargetPrepares(t *testing.T) {
 								KeyID:      "encKey",
 								Crypted:    []byte("crypted"),
 							},
+							nil,
+							nil,
 						},
 						{
 							"id-2",
@@ -185,6 +197,8 @@ func Test_TargetPrepares(t *testing.T) {
 								KeyID:      "encKey",
 								Crypted:    []byte("crypted"),
 							},
+							nil,
+							nil,
 						},
 						{
 							"id-3",
@@ -202,6 +216,8 @@ func Test_TargetPrepares(t *testing.T) {
 								KeyID:      "encKey",
 								Crypted:    []byte("crypted"),
 							},
+							nil,
+							nil,
 						},
 					},
 				),
@@ -329,6 +345,8 @@ func Test_TargetPrepares(t *testing.T) {
 							KeyID:      "encKey",
 							Crypted:    []byte("crypted"),
 						},
+						nil,
+						nil,
 					},
 				),
 			},
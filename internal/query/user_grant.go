@@ -28,6 +28,9 @@ type UserGrant struct {
 	// GrantID represents the project grant id
 	GrantID string                `json:"grant_id,omitempty"`
 	State   domain.UserGrantState `json:"state,omitempty"`
+	// ValidFrom and ValidUntil optionally restrict the time the roles are granted.
+	ValidFrom  time.Time `json:"valid_from,omitempty"`
+	ValidUntil time.Time `json:"valid_until,omitempty"`
 
 	UserID             string          `json:"user_id,omitempty"`
 	Username           string          `json:"username,omitempty"`
@@ -102,6 +105,12 @@ func NewUserGrantIDSearchQuery(id string) (SearchQuery, error) {
 	return NewTextQuery(UserGrantID, id, TextEquals)
 }
 
+// NewUserGrantIDGreaterSearchQuery returns the user grants with an ID greater than the id,
+// it's used to page through the user grants sorted by [UserGrantID].
+func NewUserGrantIDGreaterSearchQuery(id string) (SearchQuery, error) {
+	return NewTextQuery(UserGrantID, id, TextGreater)
+}
+
 func NewUserGrantUserTypeQuery(typ domain.UserType) (SearchQuery, error) {
 	return NewNumberQuery(UserTypeCol, typ, NumberEquals)
 }
@@ -146,6 +155,43 @@ func NewUserGrantStateQuery(value domain.UserGrantState) (SearchQuery, error) {
 	return NewNumberQuery(UserGrantState, value, NumberEquals)
 }
 
+// NewUserGrantValidAtQuery restricts the grants to the ones which are valid at the given time.
+func NewUserGrantValidAtQuery(at time.Time) (SearchQuery, error) {
+	validFromNull, err := NewIsNullQuery(UserGrantValidFrom)
+	if err != nil {
+		return nil, err
+	}
+	validFrom, err := NewTimestampQuery(UserGrantValidFrom, at, TimestampLessOrEquals)
+	if err != nil {
+		return nil, err
+	}
+	started, err := NewOrQuery(validFromNull, validFrom)
+	if err != nil {
+		return nil, err
+	}
+	validUntilNull, err := NewIsNullQuery(UserGrantValidUntil)
+	if err != nil {
+		return nil, err
+	}
+	validUntil, err := NewTimestampQuery(UserGrantValidUntil, at, TimestampGreater)
+	if err != nil {
+		return nil, err
+	}
+	notEnded, err := NewOrQuery(validUntilNull, validUntil)
+	if err != nil {
+		return nil, err
+	}
+	return NewAndQuery(started, notEnded)
+}
+
+func NewUserGrantValidUntilQuery(value time.Time, compare TimestampComparison) (SearchQuery, error) {
+	return NewTimestampQuery(UserGrantValidUntil, value, compare)
+}
+
+func NewUserGrantExpiryRemindedQuery(value bool) (SearchQuery, error) {
+	return NewBoolQuery(UserGrantExpiryReminded, value)
+}
+
 func NewUserGrantWithGrantedQuery(owner string) (SearchQuery, error) {
 	orgQuery, err := NewUserGrantResourceOwnerSearchQuery(owner)
 	if err != nil {
@@ -215,6 +261,18 @@ var (
 		name:  projection.UserGrantState,
 		table: userGrantTable,
 	}
+	UserGrantValidFrom = Column{
+		name:  projection.UserGrantValidFrom,
+		table: userGrantTable,
+	}
+	UserGrantValidUntil = Column{
+		name:  projection.UserGrantValidUntil,
+		table: userGrantTable,
+	}
+	UserGrantExpiryReminded = Column{
+		name:  projection.UserGrantExpiryReminded,
+		table: userGrantTable,
+	}
 	GrantedOrgsTable = table{
 		name:          projection.OrgProjectionTable,
 		alias:         "granted_orgs",
@@ -306,6 +364,8 @@ func prepareUserGrantQuery() (sq.SelectBuilder, func(*sql.Row) (*UserGrant, erro
 			UserGrantGrantID.identifier(),
 			UserGrantRoles.identifier(),
 			UserGrantState.identifier(),
+			UserGrantValidFrom.identifier(),
+			UserGrantValidUntil.identifier(),
 
 			UserGrantUserID.identifier(),
 			UserUsernameCol.identifier(),
@@ -343,6 +403,9 @@ func prepareUserGrantQuery() (sq.SelectBuilder, func(*sql.Row) (*UserGrant, erro
 			g := new(UserGrant)
 
 			var (
+				validFrom  sql.NullTime
+				validUntil sql.NullTime
+
 				username           sql.NullString
 				firstName          sql.NullString
 				userType           sql.NullInt32
@@ -371,6 +434,8 @@ func prepareUserGrantQuery() (sq.SelectBuilder, func(*sql.Row) (*UserGrant, erro
 				&g.GrantID,
 				&g.Roles,
 				&g.State,
+				&validFrom,
+				&validUntil,
 
 				&g.UserID,
 				&username,
@@ -401,6 +466,8 @@ func prepareUserGrantQuery() (sq.SelectBuilder, func(*sql.Row) (*UserGrant, erro
 				return nil, zerrors.ThrowInternal(err, "QUERY-oQPcP", "Errors.Internal")
 			}
 
+			g.ValidFrom = validFrom.Time
+			g.ValidUntil = validUntil.Time
 			g.Username = username.String
 			g.UserType = domain.UserType(userType.Int32)
 			g.UserResourceOwner = userOwner.String
@@ -429,6 +496,8 @@ func prepareUserGrantsQuery() (sq.SelectBuilder, func(*sql.Rows) (*UserGrants, e
 			UserGrantGrantID.identifier(),
 			UserGrantRoles.identifier(),
 			UserGrantState.identifier(),
+			UserGrantValidFrom.identifier(),
+			UserGrantValidUntil.identifier(),
 
 			UserGrantUserID.identifier(),
 			UserUsernameCol.identifier(),
@@ -471,6 +540,9 @@ func prepareUserGrantsQuery() (sq.SelectBuilder, func(*sql.Rows) (*UserGrants, e
 				g := new(UserGrant)
 
 				var (
+					validFrom  sql.NullTime
+					validUntil sql.NullTime
+
 					username           sql.NullString
 					userType           sql.NullInt32
 					userOwner          sql.NullString
@@ -499,6 +571,8 @@ func prepareUserGrantsQuery() (sq.SelectBuilder, func(*sql.Rows) (*UserGrants, e
 					&g.GrantID,
 					&g.Roles,
 					&g.State,
+					&validFrom,
+					&validUntil,
 
 					&g.UserID,
 					&username,
@@ -528,6 +602,8 @@ func prepareUserGrantsQuery() (sq.SelectBuilder, func(*sql.Rows) (*UserGrants, e
 					return nil, err
 				}
 
+				g.ValidFrom = validFrom.Time
+				g.ValidUntil = validUntil.Time
 				g.Username = username.String
 				g.UserType = domain.UserType(userType.Int32)
 				g.UserResourceOwner = userOwner.String
@@ -8,6 +8,10 @@ import (
 	"regexp"
 	"testing"
 
+	sq "github.com/Masterminds/squirrel"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
 	"github.com/zitadel/zitadel/internal/database"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/zerrors"
@@ -22,6 +26,8 @@ var (
 			", projections.user_grants5.grant_id" +
 			", projections.user_grants5.roles" +
 			", projections.user_grants5.state" +
+			", projections.user_grants5.valid_from" +
+			", projections.user_grants5.valid_until" +
 			", projections.user_grants5.user_id" +
 			", projections.users14.username" +
 			", projections.users14.type" +
@@ -56,6 +62,8 @@ var (
 		"grant_id",
 		"roles",
 		"state",
+		"valid_from",
+		"valid_until",
 		"user_id",
 		"username",
 		"type",
@@ -83,6 +91,8 @@ var (
 			", projections.user_grants5.grant_id" +
 			", projections.user_grants5.roles" +
 			", projections.user_grants5.state" +
+			", projections.user_grants5.valid_from" +
+			", projections.user_grants5.valid_until" +
 			", projections.user_grants5.user_id" +
 			", projections.users14.username" +
 			", projections.users14.type" +
@@ -160,6 +170,8 @@ func Test_UserGrantPrepares(t *testing.T) {
 						"grant-id",
 						database.TextArray[string]{"role-key"},
 						domain.UserGrantStateActive,
+						nil,
+						testNow,
 						"user-id",
 						"username",
 						domain.UserTypeHuman,
@@ -189,6 +201,7 @@ func Test_UserGrantPrepares(t *testing.T) {
 				Roles:              database.TextArray[string]{"role-key"},
 				GrantID:            "grant-id",
 				State:              domain.UserGrantStateActive,
+				ValidUntil:         testNow,
 				UserID:             "user-id",
 				Username:           "username",
 				UserType:           domain.UserTypeHuman,
@@ -224,6 +237,8 @@ func Test_UserGrantPrepares(t *testing.T) {
 						"grant-id",
 						database.TextArray[string]{"role-key"},
 						domain.UserGrantStateActive,
+						nil,
+						nil,
 						"user-id",
 						"username",
 						domain.UserTypeMachine,
@@ -288,6 +303,8 @@ func Test_UserGrantPrepares(t *testing.T) {
 						"grant-id",
 						database.TextArray[string]{"role-key"},
 						domain.UserGrantStateActive,
+						nil,
+						nil,
 						"user-id",
 						"username",
 						domain.UserTypeHuman,
@@ -352,6 +369,8 @@ func Test_UserGrantPrepares(t *testing.T) {
 						"grant-id",
 						database.TextArray[string]{"role-key"},
 						domain.UserGrantStateActive,
+						nil,
+						nil,
 						"user-id",
 						"username",
 						domain.UserTypeHuman,
@@ -416,6 +435,8 @@ func Test_UserGrantPrepares(t *testing.T) {
 						"grant-id",
 						database.TextArray[string]{"role-key"},
 						domain.UserGrantStateActive,
+						nil,
+						nil,
 						"user-id",
 						"username",
 						domain.UserTypeHuman,
@@ -510,6 +531,8 @@ func Test_UserGrantPrepares(t *testing.T) {
 							"grant-id",
 							database.TextArray[string]{"role-key"},
 							domain.UserGrantStateActive,
+							nil,
+							nil,
 							"user-id",
 							"username",
 							domain.UserTypeHuman,
@@ -583,6 +606,8 @@ func Test_UserGrantPrepares(t *testing.T) {
 							"grant-id",
 							database.TextArray[string]{"role-key"},
 							domain.UserGrantStateActive,
+							nil,
+							nil,
 							"user-id",
 							"username",
 							domain.UserTypeMachine,
@@ -656,6 +681,8 @@ func Test_UserGrantPrepares(t *testing.T) {
 							"grant-id",
 							database.TextArray[string]{"role-key"},
 							domain.UserGrantStateActive,
+							nil,
+							nil,
 							"user-id",
 							"username",
 							domain.UserTypeMachine,
@@ -729,6 +756,8 @@ func Test_UserGrantPrepares(t *testing.T) {
 							"grant-id",
 							database.TextArray[string]{"role-key"},
 							domain.UserGrantStateActive,
+							nil,
+							nil,
 							"user-id",
 							"username",
 							domain.UserTypeHuman,
@@ -802,6 +831,8 @@ func Test_UserGrantPrepares(t *testing.T) {
 							"grant-id",
 							database.TextArray[string]{"role-key"},
 							domain.UserGrantStateActive,
+							nil,
+							nil,
 							"user-id",
 							"username",
 							domain.UserTypeHuman,
@@ -875,6 +906,8 @@ func Test_UserGrantPrepares(t *testing.T) {
 							"grant-id",
 							database.TextArray[string]{"role-key"},
 							domain.UserGrantStateActive,
+							nil,
+							nil,
 							"user-id",
 							"username",
 							domain.UserTypeHuman,
@@ -902,6 +935,8 @@ func Test_UserGrantPrepares(t *testing.T) {
 							"grant-id",
 							database.TextArray[string]{"role-key"},
 							domain.UserGrantStateActive,
+							nil,
+							nil,
 							"user-id",
 							"username",
 							domain.UserTypeHuman,
@@ -1010,3 +1045,17 @@ func Test_UserGrantPrepares(t *testing.T) {
 		})
 	}
 }
+
+func TestNewUserGrantValidAtQuery(t *testing.T) {
+	query, err := NewUserGrantValidAtQuery(testNow)
+	require.NoError(t, err)
+	stmt, args, err := query.toQuery(sq.Select(UserGrantID.identifier()).From(userGrantTable.identifier())).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	require.NoError(t, err)
+	assert.Equal(t,
+		"SELECT projections.user_grants5.id FROM projections.user_grants5 WHERE ((projections.user_grants5.valid_from IS NULL OR projections.user_grants5.valid_from <= $1) AND (projections.user_grants5.valid_until IS NULL OR projections.user_grants5.valid_until > $2))",
+		stmt,
+	)
+	assert.Equal(t, []any{testNow, testNow}, args)
+}
@@ -70,6 +70,15 @@ func NewMembershipOrgIDQuery(value string) (SearchQuery, error) {
 	return NewTextQuery(OrgMemberOrgID, value, TextEquals)
 }
 
+// NewMembershipOrgIDsQuery restricts the memberships to the members of the organizations.
+func NewMembershipOrgIDsQuery(ids ...string) (SearchQuery, error) {
+	list := make([]interface{}, len(ids))
+	for i, value := range ids {
+		list[i] = value
+	}
+	return NewListQuery(OrgMemberOrgID, list, ListIn)
+}
+
 func NewMembershipResourceOwnersSearchQuery(ids ...string) (SearchQuery, error) {
 	list := make([]interface{}, len(ids))
 	for i, value := range ids {
@@ -33,12 +33,15 @@ metadata as (
 ),
 -- get all user grants, needed for the orgs query
 user_grants as (
-	select id, grant_id, state, creation_date, change_date, sequence, user_id, roles, resource_owner, project_id
+	select id, grant_id, state, creation_date, change_date, sequence, user_id, roles, resource_owner, project_id, valid_from, valid_until
 	from projections.user_grants5
 	where user_id = $1
 	and instance_id = $2
 	and project_id = any($3)
     and state = 1
+	-- only grants inside their validity
+	and (valid_from is null or valid_from <= now())
+	and (valid_until is null or valid_until > now())
 	{{ if . -}}
 	and resource_owner = any($4)
 	{{- end }}
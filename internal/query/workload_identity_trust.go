@@ -0,0 +1,272 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/repository/workloadidentity"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	workloadIdentityTrustTable = table{
+		name:          projection.WorkloadIdentityTrustTable,
+		instanceIDCol: projection.WorkloadIdentityTrustInstanceIDCol,
+	}
+	WorkloadIdentityTrustColumnID = Column{
+		name:  projection.WorkloadIdentityTrustIDCol,
+		table: workloadIdentityTrustTable,
+	}
+	WorkloadIdentityTrustColumnCreationDate = Column{
+		name:  projection.WorkloadIdentityTrustCreationDateCol,
+		table: workloadIdentityTrustTable,
+	}
+	WorkloadIdentityTrustColumnChangeDate = Column{
+		name:  projection.WorkloadIdentityTrustChangeDateCol,
+		table: workloadIdentityTrustTable,
+	}
+	WorkloadIdentityTrustColumnResourceOwner = Column{
+		name:  projection.WorkloadIdentityTrustResourceOwnerCol,
+		table: workloadIdentityTrustTable,
+	}
+	WorkloadIdentityTrustColumnInstanceID = Column{
+		name:  projection.WorkloadIdentityTrustInstanceIDCol,
+		table: workloadIdentityTrustTable,
+	}
+	WorkloadIdentityTrustColumnSequence = Column{
+		name:  projection.WorkloadIdentityTrustSequenceCol,
+		table: workloadIdentityTrustTable,
+	}
+	WorkloadIdentityTrustColumnName = Column{
+		name:  projection.WorkloadIdentityTrustNameCol,
+		table: workloadIdentityTrustTable,
+	}
+	WorkloadIdentityTrustColumnIssuer = Column{
+		name:  projection.WorkloadIdentityTrustIssuerCol,
+		table: workloadIdentityTrustTable,
+	}
+	WorkloadIdentityTrustColumnJWKSEndpoint = Column{
+		name:  projection.WorkloadIdentityTrustJWKSEndpointCol,
+		table: workloadIdentityTrustTable,
+	}
+	WorkloadIdentityTrustColumnJWKS = Column{
+		name:  projection.WorkloadIdentityTrustJWKSCol,
+		table: workloadIdentityTrustTable,
+	}
+	WorkloadIdentityTrustColumnAudience = Column{
+		name:  projection.WorkloadIdentityTrustAudienceCol,
+		table: workloadIdentityTrustTable,
+	}
+	WorkloadIdentityTrustColumnRules = Column{
+		name:  projection.WorkloadIdentityTrustRulesCol,
+		table: workloadIdentityTrustTable,
+	}
+)
+
+type WorkloadIdentityTrusts struct {
+	SearchResponse
+	WorkloadIdentityTrusts []*WorkloadIdentityTrust
+}
+
+func (t *WorkloadIdentityTrusts) SetState(s *State) {
+	t.State = s
+}
+
+type WorkloadIdentityTrust struct {
+	domain.ObjectDetails
+
+	Name         string
+	Issuer       string
+	JWKSEndpoint string
+	JWKS         []byte
+	Audience     database.TextArray[string]
+	Rules        []*workloadidentity.Rule
+}
+
+type WorkloadIdentityTrustSearchQueries struct {
+	SearchRequest
+	Queries []SearchQuery
+}
+
+func (q *WorkloadIdentityTrustSearchQueries) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	query = q.SearchRequest.toQuery(query)
+	for _, q := range q.Queries {
+		query = q.toQuery(query)
+	}
+	return query
+}
+
+func (q *Queries) SearchWorkloadIdentityTrusts(ctx context.Context, queries *WorkloadIdentityTrustSearchQueries) (_ *WorkloadIdentityTrusts, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	eq := sq.Eq{
+		WorkloadIdentityTrustColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+	}
+	query, scan := prepareWorkloadIdentityTrustsQuery()
+	return genericRowsQueryWithState(ctx, q.client, workloadIdentityTrustTable, combineToWhereStmt(query, queries.toQuery, eq), scan)
+}
+
+func (q *Queries) GetWorkloadIdentityTrustByID(ctx context.Context, id, resourceOwner string) (_ *WorkloadIdentityTrust, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	eq := sq.Eq{
+		WorkloadIdentityTrustColumnID.identifier():            id,
+		WorkloadIdentityTrustColumnResourceOwner.identifier(): resourceOwner,
+		WorkloadIdentityTrustColumnInstanceID.identifier():    authz.GetInstance(ctx).InstanceID(),
+	}
+	query, scan := prepareWorkloadIdentityTrustQuery()
+	return genericRowQuery(ctx, q.client, query.Where(eq), scan)
+}
+
+// WorkloadIdentityTrustsByIssuer returns the trusts of all organizations of the instance
+// accepting tokens of the issuer, used to verify external tokens in the token exchange.
+func (q *Queries) WorkloadIdentityTrustsByIssuer(ctx context.Context, issuer string) (_ []*WorkloadIdentityTrust, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	eq := sq.Eq{
+		WorkloadIdentityTrustColumnIssuer.identifier():     issuer,
+		WorkloadIdentityTrustColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+	}
+	query, scan := prepareWorkloadIdentityTrustsQuery()
+	trusts, err := genericRowsQuery(ctx, q.client, query.Where(eq), scan)
+	if err != nil {
+		return nil, err
+	}
+	return trusts.WorkloadIdentityTrusts, nil
+}
+
+func NewWorkloadIdentityTrustResourceOwnerSearchQuery(resourceOwner string) (SearchQuery, error) {
+	return NewTextQuery(WorkloadIdentityTrustColumnResourceOwner, resourceOwner, TextEquals)
+}
+
+func NewWorkloadIdentityTrustNameSearchQuery(method TextComparison, value string) (SearchQuery, error) {
+	return NewTextQuery(WorkloadIdentityTrustColumnName, value, method)
+}
+
+func NewWorkloadIdentityTrustIssuerSearchQuery(method TextComparison, value string) (SearchQuery, error) {
+	return NewTextQuery(WorkloadIdentityTrustColumnIssuer, value, method)
+}
+
+func prepareWorkloadIdentityTrustsQuery() (sq.SelectBuilder, func(rows *sql.Rows) (*WorkloadIdentityTrusts, error)) {
+	return sq.Select(
+			WorkloadIdentityTrustColumnID.identifier(),
+			WorkloadIdentityTrustColumnCreationDate.identifier(),
+			WorkloadIdentityTrustColumnChangeDate.identifier(),
+			WorkloadIdentityTrustColumnResourceOwner.identifier(),
+			WorkloadIdentityTrustColumnSequence.identifier(),
+			WorkloadIdentityTrustColumnName.identifier(),
+			WorkloadIdentityTrustColumnIssuer.identifier(),
+			WorkloadIdentityTrustColumnJWKSEndpoint.identifier(),
+			WorkloadIdentityTrustColumnJWKS.identifier(),
+			WorkloadIdentityTrustColumnAudience.identifier(),
+			WorkloadIdentityTrustColumnRules.identifier(),
+			countColumn.identifier(),
+		).From(workloadIdentityTrustTable.identifier()).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*WorkloadIdentityTrusts, error) {
+			trusts := make([]*WorkloadIdentityTrust, 0)
+			var count uint64
+			for rows.Next() {
+				trust := new(WorkloadIdentityTrust)
+				var rules []byte
+				err := rows.Scan(
+					&trust.ID,
+					&trust.CreationDate,
+					&trust.EventDate,
+					&trust.ResourceOwner,
+					&trust.Sequence,
+					&trust.Name,
+					&trust.Issuer,
+					&trust.JWKSEndpoint,
+					&trust.JWKS,
+					&trust.Audience,
+					&rules,
+					&count,
+				)
+				if err != nil {
+					return nil, err
+				}
+				if err := unmarshalWorkloadIdentityRules(rules, trust); err != nil {
+					return nil, err
+				}
+				trusts = append(trusts, trust)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-uGh5a", "Errors.Query.CloseRows")
+			}
+
+			return &WorkloadIdentityTrusts{
+				WorkloadIdentityTrusts: trusts,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
+
+func prepareWorkloadIdentityTrustQuery() (sq.SelectBuilder, func(row *sql.Row) (*WorkloadIdentityTrust, error)) {
+	return sq.Select(
+			WorkloadIdentityTrustColumnID.identifier(),
+			WorkloadIdentityTrustColumnCreationDate.identifier(),
+			WorkloadIdentityTrustColumnChangeDate.identifier(),
+			WorkloadIdentityTrustColumnResourceOwner.identifier(),
+			WorkloadIdentityTrustColumnSequence.identifier(),
+			WorkloadIdentityTrustColumnName.identifier(),
+			WorkloadIdentityTrustColumnIssuer.identifier(),
+			WorkloadIdentityTrustColumnJWKSEndpoint.identifier(),
+			WorkloadIdentityTrustColumnJWKS.identifier(),
+			WorkloadIdentityTrustColumnAudience.identifier(),
+			WorkloadIdentityTrustColumnRules.identifier(),
+		).From(workloadIdentityTrustTable.identifier()).
+			PlaceholderFormat(sq.Dollar),
+		func(row *sql.Row) (*WorkloadIdentityTrust, error) {
+			trust := new(WorkloadIdentityTrust)
+			var rules []byte
+			err := row.Scan(
+				&trust.ID,
+				&trust.CreationDate,
+				&trust.EventDate,
+				&trust.ResourceOwner,
+				&trust.Sequence,
+				&trust.Name,
+				&trust.Issuer,
+				&trust.JWKSEndpoint,
+				&trust.JWKS,
+				&trust.Audience,
+				&rules,
+			)
+			if err != nil {
+				if errors.Is(err, sql.ErrNoRows) {
+					return nil, zerrors.ThrowNotFound(err, "QUERY-ieN7a", "Errors.WorkloadIdentityTrust.NotFound")
+				}
+				return nil, zerrors.ThrowInternal(err, "QUERY-Zoo1e", "Errors.Internal")
+			}
+			if err := unmarshalWorkloadIdentityRules(rules, trust); err != nil {
+				return nil, err
+			}
+			return trust, nil
+		}
+}
+
+func unmarshalWorkloadIdentityRules(data []byte, trust *WorkloadIdentityTrust) error {
+	if len(data) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(data, &trust.Rules); err != nil {
+		return zerrors.ThrowInternal(err, "QUERY-ohN5i", "Errors.Internal")
+	}
+	return nil
+}
@@ -0,0 +1,235 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/workloadidentity"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	prepareWorkloadIdentityTrustsStmt = `SELECT projections.workload_identity_trusts.id,` +
+		` projections.workload_identity_trusts.creation_date,` +
+		` projections.workload_identity_trusts.change_date,` +
+		` projections.workload_identity_trusts.resource_owner,` +
+		` projections.workload_identity_trusts.sequence,` +
+		` projections.workload_identity_trusts.name,` +
+		` projections.workload_identity_trusts.issuer,` +
+		` projections.workload_identity_trusts.jwks_endpoint,` +
+		` projections.workload_identity_trusts.jwks,` +
+		` projections.workload_identity_trusts.audience,` +
+		` projections.workload_identity_trusts.rules,` +
+		` COUNT(*) OVER ()` +
+		` FROM projections.workload_identity_trusts`
+	prepareWorkloadIdentityTrustsCols = []string{
+		"id",
+		"creation_date",
+		"change_date",
+		"resource_owner",
+		"sequence",
+		"name",
+		"issuer",
+		"jwks_endpoint",
+		"jwks",
+		"audience",
+		"rules",
+		"count",
+	}
+
+	prepareWorkloadIdentityTrustStmt = `SELECT projections.workload_identity_trusts.id,` +
+		` projections.workload_identity_trusts.creation_date,` +
+		` projections.workload_identity_trusts.change_date,` +
+		` projections.workload_identity_trusts.resource_owner,` +
+		` projections.workload_identity_trusts.sequence,` +
+		` projections.workload_identity_trusts.name,` +
+		` projections.workload_identity_trusts.issuer,` +
+		` projections.workload_identity_trusts.jwks_endpoint,` +
+		` projections.workload_identity_trusts.jwks,` +
+		` projections.workload_identity_trusts.audience,` +
+		` projections.workload_identity_trusts.rules` +
+		` FROM projections.workload_identity_trusts`
+	prepareWorkloadIdentityTrustCols = prepareWorkloadIdentityTrustsCols[:len(prepareWorkloadIdentityTrustsCols)-1]
+)
+
+func Test_WorkloadIdentityTrustPrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareWorkloadIdentityTrustsQuery no result",
+			prepare: prepareWorkloadIdentityTrustsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareWorkloadIdentityTrustsStmt),
+					nil,
+					nil,
+				),
+			},
+			object: &WorkloadIdentityTrusts{WorkloadIdentityTrusts: []*WorkloadIdentityTrust{}},
+		},
+		{
+			name:    "prepareWorkloadIdentityTrustsQuery one result",
+			prepare: prepareWorkloadIdentityTrustsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareWorkloadIdentityTrustsStmt),
+					prepareWorkloadIdentityTrustsCols,
+					[][]driver.Value{
+						{
+							"id",
+							testNow,
+							testNow,
+							"ro",
+							uint64(20211109),
+							"trust-name",
+							"https://kubernetes.default.svc",
+							"https://kubernetes.example.com/openid/v1/jwks",
+							nil,
+							database.TextArray[string]{"zitadel"},
+							[]byte(`[{"conditions":{"sub":"system:serviceaccount:ci:*"},"userId":"machine1"}]`),
+						},
+					},
+				),
+			},
+			object: &WorkloadIdentityTrusts{
+				SearchResponse: SearchResponse{
+					Count: 1,
+				},
+				WorkloadIdentityTrusts: []*WorkloadIdentityTrust{
+					{
+						ObjectDetails: domain.ObjectDetails{
+							ID:            "id",
+							EventDate:     testNow,
+							CreationDate:  testNow,
+							ResourceOwner: "ro",
+							Sequence:      20211109,
+						},
+						Name:         "trust-name",
+						Issuer:       "https://kubernetes.default.svc",
+						JWKSEndpoint: "https://kubernetes.example.com/openid/v1/jwks",
+						Audience:     database.TextArray[string]{"zitadel"},
+						Rules: []*workloadidentity.Rule{
+							{
+								Conditions: map[string]string{"sub": "system:serviceaccount:ci:*"},
+								UserID:     "machine1",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "prepareWorkloadIdentityTrustsQuery sql err",
+			prepare: prepareWorkloadIdentityTrustsQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(prepareWorkloadIdentityTrustsStmt),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*WorkloadIdentityTrusts)(nil),
+		},
+		{
+			name:    "prepareWorkloadIdentityTrustQuery no result",
+			prepare: prepareWorkloadIdentityTrustQuery,
+			want: want{
+				sqlExpectations: mockQueriesScanErr(
+					regexp.QuoteMeta(prepareWorkloadIdentityTrustStmt),
+					nil,
+					nil,
+				),
+				err: func(err error) (error, bool) {
+					if !zerrors.IsNotFound(err) {
+						return fmt.Errorf("err should be zitadel.NotFoundError got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*WorkloadIdentityTrust)(nil),
+		},
+		{
+			name:    "prepareWorkloadIdentityTrustQuery found",
+			prepare: prepareWorkloadIdentityTrustQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					regexp.QuoteMeta(prepareWorkloadIdentityTrustStmt),
+					prepareWorkloadIdentityTrustCols,
+					[]driver.Value{
+						"id",
+						testNow,
+						testNow,
+						"ro",
+						uint64(20211109),
+						"trust-name",
+						"https://token.actions.githubusercontent.com",
+						"",
+						[]byte(`{"keys":[]}`),
+						database.TextArray[string]{"zitadel"},
+						[]byte(`[{"conditions":{"repository":"zitadel/zitadel"},"userId":"machine1"}]`),
+					},
+				),
+			},
+			object: &WorkloadIdentityTrust{
+				ObjectDetails: domain.ObjectDetails{
+					ID:            "id",
+					EventDate:     testNow,
+					CreationDate:  testNow,
+					ResourceOwner: "ro",
+					Sequence:      20211109,
+				},
+				Name:     "trust-name",
+				Issuer:   "https://token.actions.githubusercontent.com",
+				JWKS:     []byte(`{"keys":[]}`),
+				Audience: database.TextArray[string]{"zitadel"},
+				Rules: []*workloadidentity.Rule{
+					{
+						Conditions: map[string]string{"repository": "zitadel/zitadel"},
+						UserID:     "machine1",
+					},
+				},
+			},
+		},
+		{
+			name:    "prepareWorkloadIdentityTrustQuery sql err",
+			prepare: prepareWorkloadIdentityTrustQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(prepareWorkloadIdentityTrustStmt),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*WorkloadIdentityTrust)(nil),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err)
+		})
+	}
+}
@@ -0,0 +1,100 @@
+package instanceworker
+
+import (
+	"context"
+	"time"
+
+	"github.com/riverqueue/river"
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/queue"
+)
+
+type EventStore interface {
+	InstanceIDs(ctx context.Context, queryFactory *eventstore.SearchQueryBuilder) ([]string, error)
+}
+
+// InstancesQuery returns the query of the instances the job runs in.
+type InstancesQuery func() *eventstore.SearchQueryBuilder
+
+// InstanceFunc processes the job in a single instance,
+// the context contains the instance and the user of the worker, see [WithInstance].
+type InstanceFunc func(ctx context.Context) error
+
+// Worker runs a job in all instances returned by its query, one instance after the other.
+// The job is scheduled by [Register] when the queue is started and then in the configured interval.
+// Only the elected leader schedules it, so it runs once per interval across all replicas.
+// Failures of a single instance are logged and do not stop the processing of the others,
+// they will be retried with the next run.
+type Worker[T river.JobArgs] struct {
+	river.WorkerDefaults[T]
+
+	queueName      string
+	userID         string
+	timeout        time.Duration
+	eventstore     EventStore
+	instancesQuery InstancesQuery
+	instanceFunc   InstanceFunc
+}
+
+func NewWorker[T river.JobArgs](
+	queueName string,
+	userID string,
+	timeout time.Duration,
+	es EventStore,
+	instancesQuery InstancesQuery,
+	instanceFunc InstanceFunc,
+) *Worker[T] {
+	return &Worker[T]{
+		queueName:      queueName,
+		userID:         userID,
+		timeout:        timeout,
+		eventstore:     es,
+		instancesQuery: instancesQuery,
+		instanceFunc:   instanceFunc,
+	}
+}
+
+func (w *Worker[T]) Register(workers *river.Workers, queues map[string]river.QueueConfig) {
+	river.AddWorker[T](workers, w)
+	queues[w.queueName] = river.QueueConfig{
+		MaxWorkers: 1,
+	}
+}
+
+// Timeout implements the Timeout-function of [river.Worker].
+func (w *Worker[T]) Timeout(*river.Job[T]) time.Duration {
+	return w.timeout
+}
+
+// Work implements [river.Worker].
+func (w *Worker[T]) Work(ctx context.Context, _ *river.Job[T]) error {
+	instanceIDs, err := w.eventstore.InstanceIDs(ctx, w.instancesQuery())
+	if err != nil {
+		return err
+	}
+	for _, instanceID := range instanceIDs {
+		err = w.instanceFunc(WithInstance(ctx, instanceID, w.userID))
+		logging.WithFields("queue", w.queueName, "instance", instanceID).OnError(err).Warn("unable to process instance")
+	}
+	return nil
+}
+
+// WithInstance returns the context to process the job in the instance as the user.
+func WithInstance(ctx context.Context, instanceID, userID string) context.Context {
+	ctx = authz.WithInstanceID(ctx, instanceID)
+	return authz.SetCtxData(ctx, authz.CtxData{UserID: userID})
+}
+
+// Register schedules the worker to run in the interval,
+// nothing is registered if no interval is configured.
+func Register[T river.JobArgs](q *queue.Queue, interval time.Duration, worker *Worker[T], args T) {
+	if interval <= 0 {
+		return
+	}
+	q.ShouldStart()
+	q.AddWorkers(worker)
+	q.AddPeriodicJob(interval, args, queue.WithQueueName(worker.queueName), queue.WithMaxAttempts(1))
+}
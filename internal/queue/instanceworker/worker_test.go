@@ -0,0 +1,82 @@
+package instanceworker
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/riverqueue/river"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+type fakeEventStore struct {
+	instanceIDs []string
+	err         error
+}
+
+func (f *fakeEventStore) InstanceIDs(context.Context, *eventstore.SearchQueryBuilder) ([]string, error) {
+	return f.instanceIDs, f.err
+}
+
+type testRequest struct{}
+
+func (*testRequest) Kind() string {
+	return "test_request"
+}
+
+func TestWorker_Work(t *testing.T) {
+	tests := []struct {
+		name          string
+		eventstore    *fakeEventStore
+		failInstances []string
+		want          []string
+		wantErr       bool
+	}{
+		{
+			name:       "instances query fails",
+			eventstore: &fakeEventStore{err: errors.New("failed")},
+			wantErr:    true,
+		},
+		{
+			name:       "no instances",
+			eventstore: &fakeEventStore{},
+		},
+		{
+			name:          "all instances processed, failure of instance ignored",
+			eventstore:    &fakeEventStore{instanceIDs: []string{"instance1", "instance2", "instance3"}},
+			failInstances: []string{"instance2"},
+			want:          []string{"instance1/user", "instance2/user", "instance3/user"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			w := NewWorker[*testRequest]("test", "user", 0, tt.eventstore,
+				func() *eventstore.SearchQueryBuilder {
+					return eventstore.NewSearchQueryBuilder(eventstore.ColumnsInstanceIDs)
+				},
+				func(ctx context.Context) error {
+					instanceID := authz.GetInstance(ctx).InstanceID()
+					got = append(got, instanceID+"/"+authz.GetCtxData(ctx).UserID)
+					for _, failInstance := range tt.failInstances {
+						if instanceID == failInstance {
+							return errors.New("failed")
+						}
+					}
+					return nil
+				},
+			)
+			err := w.Work(context.Background(), &river.Job[*testRequest]{Args: new(testRequest)})
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
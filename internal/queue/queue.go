@@ -2,6 +2,7 @@ package queue
 
 import (
 	"context"
+	"time"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/riverqueue/river"
@@ -68,6 +69,25 @@ func (q *Queue) AddWorkers(w ...Worker) {
 	}
 }
 
+// AddPeriodicJob inserts a job with the args in the interval, starting when the queue is started.
+func (q *Queue) AddPeriodicJob(interval time.Duration, args river.JobArgs, opts ...InsertOpt) {
+	if q == nil {
+		logging.Info("skip adding periodic job because queue is not set")
+		return
+	}
+	q.config.PeriodicJobs = append(q.config.PeriodicJobs, river.NewPeriodicJob(
+		river.PeriodicInterval(interval),
+		func() (river.JobArgs, *river.InsertOpts) {
+			options := new(river.InsertOpts)
+			for _, opt := range opts {
+				opt(options)
+			}
+			return args, options
+		},
+		&river.PeriodicJobOpts{RunOnStart: true},
+	))
+}
+
 type InsertOpt func(*river.InsertOpts)
 
 func WithMaxAttempts(maxAttempts uint8) InsertOpt {
@@ -0,0 +1,284 @@
+package accessrequest
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/zitadel/zitadel/internal/api/http"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	UniquePendingAccessRequest = "pending_access_request"
+	eventTypePrefix            = "access_request."
+	AddedType                  = eventTypePrefix + "added"
+	ApprovedType               = eventTypePrefix + "approved"
+	DeniedType                 = eventTypePrefix + "denied"
+	WithdrawnType              = eventTypePrefix + "withdrawn"
+	ApproversNotifiedType      = eventTypePrefix + "approvers.notified"
+)
+
+// NewAddPendingUniqueConstraint prevents a user from requesting access to the same project (grant) twice,
+// as long as the previous request is not decided or withdrawn.
+func NewAddPendingUniqueConstraint(resourceOwner, userID, projectID, projectGrantID string) *eventstore.UniqueConstraint {
+	return eventstore.NewAddEventUniqueConstraint(
+		UniquePendingAccessRequest,
+		fmt.Sprintf("%s:%s:%s:%s", resourceOwner, userID, projectID, projectGrantID),
+		"Errors.AccessRequest.AlreadyExists")
+}
+
+func NewRemovePendingUniqueConstraint(resourceOwner, userID, projectID, projectGrantID string) *eventstore.UniqueConstraint {
+	return eventstore.NewRemoveUniqueConstraint(
+		UniquePendingAccessRequest,
+		fmt.Sprintf("%s:%s:%s:%s", resourceOwner, userID, projectID, projectGrantID))
+}
+
+// AddedEvent is pushed when a user requests roles of a project.
+// The resource owner is the organization the user grant will be created on if the request is approved.
+type AddedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	UserID            string   `json:"userId,omitempty"`
+	ProjectID         string   `json:"projectId,omitempty"`
+	ProjectGrantID    string   `json:"grantId,omitempty"`
+	RoleKeys          []string `json:"roleKeys,omitempty"`
+	Reason            string   `json:"reason,omitempty"`
+	TriggeredAtOrigin string   `json:"triggerOrigin,omitempty"`
+}
+
+func (e *AddedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *AddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return []*eventstore.UniqueConstraint{NewAddPendingUniqueConstraint(e.Aggregate().ResourceOwner, e.UserID, e.ProjectID, e.ProjectGrantID)}
+}
+
+func (e *AddedEvent) TriggerOrigin() string {
+	return e.TriggeredAtOrigin
+}
+
+func NewAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	userID,
+	projectID,
+	projectGrantID string,
+	roleKeys []string,
+	reason string,
+) *AddedEvent {
+	return &AddedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			AddedType,
+		),
+		UserID:            userID,
+		ProjectID:         projectID,
+		ProjectGrantID:    projectGrantID,
+		RoleKeys:          roleKeys,
+		Reason:            reason,
+		TriggeredAtOrigin: http.DomainContext(ctx).Origin(),
+	}
+}
+
+func AddedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e := &AddedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := event.Unmarshal(e)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "ACCREQ-Ahr3u", "unable to unmarshal access request")
+	}
+
+	return e, nil
+}
+
+// ApprovedEvent is pushed together with the user grant event granting the requested roles.
+// The approver is the creator of the event.
+type ApprovedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	UserID         string `json:"userId,omitempty"`
+	ProjectID      string `json:"projectId,omitempty"`
+	ProjectGrantID string `json:"grantId,omitempty"`
+	UserGrantID    string `json:"userGrantId,omitempty"`
+	Comment        string `json:"comment,omitempty"`
+}
+
+func (e *ApprovedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *ApprovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return []*eventstore.UniqueConstraint{NewRemovePendingUniqueConstraint(e.Aggregate().ResourceOwner, e.UserID, e.ProjectID, e.ProjectGrantID)}
+}
+
+func NewApprovedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	userID,
+	projectID,
+	projectGrantID,
+	userGrantID,
+	comment string,
+) *ApprovedEvent {
+	return &ApprovedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			ApprovedType,
+		),
+		UserID:         userID,
+		ProjectID:      projectID,
+		ProjectGrantID: projectGrantID,
+		UserGrantID:    userGrantID,
+		Comment:        comment,
+	}
+}
+
+func ApprovedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e := &ApprovedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := event.Unmarshal(e)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "ACCREQ-Ohb5i", "unable to unmarshal access request approval")
+	}
+
+	return e, nil
+}
+
+// DeniedEvent is pushed if the requested roles are not granted.
+// The approver is the creator of the event.
+type DeniedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	UserID         string `json:"userId,omitempty"`
+	ProjectID      string `json:"projectId,omitempty"`
+	ProjectGrantID string `json:"grantId,omitempty"`
+	Comment        string `json:"comment,omitempty"`
+}
+
+func (e *DeniedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *DeniedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return []*eventstore.UniqueConstraint{NewRemovePendingUniqueConstraint(e.Aggregate().ResourceOwner, e.UserID, e.ProjectID, e.ProjectGrantID)}
+}
+
+func NewDeniedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	userID,
+	projectID,
+	projectGrantID,
+	comment string,
+) *DeniedEvent {
+	return &DeniedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			DeniedType,
+		),
+		UserID:         userID,
+		ProjectID:      projectID,
+		ProjectGrantID: projectGrantID,
+		Comment:        comment,
+	}
+}
+
+func DeniedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e := &DeniedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := event.Unmarshal(e)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "ACCREQ-Xoo3e", "unable to unmarshal access request denial")
+	}
+
+	return e, nil
+}
+
+// WithdrawnEvent is pushed if the requesting user no longer needs the roles.
+type WithdrawnEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	UserID         string `json:"userId,omitempty"`
+	ProjectID      string `json:"projectId,omitempty"`
+	ProjectGrantID string `json:"grantId,omitempty"`
+}
+
+func (e *WithdrawnEvent) Payload() interface{} {
+	return e
+}
+
+func (e *WithdrawnEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return []*eventstore.UniqueConstraint{NewRemovePendingUniqueConstraint(e.Aggregate().ResourceOwner, e.UserID, e.ProjectID, e.ProjectGrantID)}
+}
+
+func NewWithdrawnEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	userID,
+	projectID,
+	projectGrantID string,
+) *WithdrawnEvent {
+	return &WithdrawnEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			WithdrawnType,
+		),
+		UserID:         userID,
+		ProjectID:      projectID,
+		ProjectGrantID: projectGrantID,
+	}
+}
+
+func WithdrawnEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e := &WithdrawnEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := event.Unmarshal(e)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "ACCREQ-Eich6", "unable to unmarshal access request withdrawal")
+	}
+
+	return e, nil
+}
+
+// ApproversNotifiedEvent is pushed as soon as the approvers were notified about the request.
+type ApproversNotifiedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func (e *ApproversNotifiedEvent) Payload() interface{} {
+	return nil
+}
+
+func (e *ApproversNotifiedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewApproversNotifiedEvent(ctx context.Context, aggregate *eventstore.Aggregate) *ApproversNotifiedEvent {
+	return &ApproversNotifiedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			ApproversNotifiedType,
+		),
+	}
+}
+
+func ApproversNotifiedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	return &ApproversNotifiedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}, nil
+}
@@ -0,0 +1,13 @@
+package accessrequest
+
+import (
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+func init() {
+	eventstore.RegisterFilterEventMapper(AggregateType, AddedType, AddedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, ApprovedType, ApprovedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, DeniedType, DeniedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, WithdrawnType, WithdrawnEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, ApproversNotifiedType, ApproversNotifiedEventMapper)
+}
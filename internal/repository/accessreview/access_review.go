@@ -0,0 +1,208 @@
+package accessreview
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	eventTypePrefix    = "access_review."
+	StartedType        = eventTypePrefix + "started"
+	ItemDecidedType    = eventTypePrefix + "item.decided"
+	ItemReassignedType = eventTypePrefix + "item.reassigned"
+	ClosedType         = eventTypePrefix + "closed"
+)
+
+// Item is the snapshot of a user grant or membership at the start of the review.
+type Item struct {
+	ID             string                      `json:"id"`
+	Type           domain.AccessReviewItemType `json:"type"`
+	UserID         string                      `json:"userId"`
+	ProjectID      string                      `json:"projectId,omitempty"`
+	ProjectGrantID string                      `json:"grantId,omitempty"`
+	UserGrantID    string                      `json:"userGrantId,omitempty"`
+	Roles          []string                    `json:"roles,omitempty"`
+	ReviewerID     string                      `json:"reviewerId"`
+}
+
+// StartedEvent is pushed when a campaign is started.
+// It contains the snapshot of all items to be reviewed until the deadline.
+type StartedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Name      string    `json:"name,omitempty"`
+	ProjectID string    `json:"projectId,omitempty"`
+	Deadline  time.Time `json:"deadline"`
+	Items     []*Item   `json:"items,omitempty"`
+}
+
+func (e *StartedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *StartedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewStartedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	name,
+	projectID string,
+	deadline time.Time,
+	items []*Item,
+) *StartedEvent {
+	return &StartedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			StartedType,
+		),
+		Name:      name,
+		ProjectID: projectID,
+		Deadline:  deadline,
+		Items:     items,
+	}
+}
+
+func StartedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e := &StartedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := event.Unmarshal(e)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "ACCREV-Ohn5a", "unable to unmarshal access review")
+	}
+
+	return e, nil
+}
+
+// ItemDecidedEvent is pushed when the reviewer keeps or revokes the access of an item,
+// or when the item was not reviewed until the deadline.
+// Revocations are pushed together with the events removing the user grant or membership.
+type ItemDecidedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ItemID   string                      `json:"itemId"`
+	Decision domain.AccessReviewDecision `json:"decision"`
+	Comment  string                      `json:"comment,omitempty"`
+}
+
+func (e *ItemDecidedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *ItemDecidedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewItemDecidedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	itemID string,
+	decision domain.AccessReviewDecision,
+	comment string,
+) *ItemDecidedEvent {
+	return &ItemDecidedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			ItemDecidedType,
+		),
+		ItemID:   itemID,
+		Decision: decision,
+		Comment:  comment,
+	}
+}
+
+func ItemDecidedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e := &ItemDecidedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := event.Unmarshal(e)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "ACCREV-ieP4u", "unable to unmarshal access review decision")
+	}
+
+	return e, nil
+}
+
+type ItemReassignedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ItemID     string `json:"itemId"`
+	ReviewerID string `json:"reviewerId"`
+}
+
+func (e *ItemReassignedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *ItemReassignedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewItemReassignedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	itemID,
+	reviewerID string,
+) *ItemReassignedEvent {
+	return &ItemReassignedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			ItemReassignedType,
+		),
+		ItemID:     itemID,
+		ReviewerID: reviewerID,
+	}
+}
+
+func ItemReassignedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e := &ItemReassignedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := event.Unmarshal(e)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "ACCREV-Xoo3e", "unable to unmarshal access review reassignment")
+	}
+
+	return e, nil
+}
+
+// ClosedEvent is pushed as soon as all items are decided.
+type ClosedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func (e *ClosedEvent) Payload() interface{} {
+	return nil
+}
+
+func (e *ClosedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewClosedEvent(ctx context.Context, aggregate *eventstore.Aggregate) *ClosedEvent {
+	return &ClosedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			ClosedType,
+		),
+	}
+}
+
+func ClosedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	return &ClosedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}, nil
+}
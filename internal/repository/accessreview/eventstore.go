@@ -0,0 +1,12 @@
+package accessreview
+
+import (
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+func init() {
+	eventstore.RegisterFilterEventMapper(AggregateType, StartedType, StartedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, ItemDecidedType, ItemDecidedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, ItemReassignedType, ItemReassignedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, ClosedType, ClosedEventMapper)
+}
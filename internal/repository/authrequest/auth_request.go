@@ -2,6 +2,7 @@ package authrequest
 
 import (
 	"context"
+	"net/url"
 	"time"
 
 	"github.com/zitadel/zitadel/internal/domain"
@@ -17,6 +18,8 @@ const (
 	SessionLinkedType      = authRequestEventPrefix + "session.linked"
 	CodeExchangedType      = authRequestEventPrefix + "code.exchanged"
 	SucceededType          = authRequestEventPrefix + "succeeded"
+	PushedType             = authRequestEventPrefix + "pushed"
+	RequestURIUsedType     = authRequestEventPrefix + "request_uri.used"
 )
 
 type AddedEvent struct {
@@ -39,6 +42,8 @@ type AddedEvent struct {
 	HintUserID       *string                   `json:"hint_user_id,omitempty"`
 	NeedRefreshToken bool                      `json:"need_refresh_token,omitempty"`
 	Issuer           string                    `json:"issuer,omitempty"`
+	// AuthorizationDetails are the fine-grained authorizations requested by the client (RFC 9396).
+	AuthorizationDetails domain.AuthorizationDetails `json:"authorization_details,omitempty"`
 }
 
 func (e *AddedEvent) Payload() interface{} {
@@ -68,6 +73,7 @@ func NewAddedEvent(ctx context.Context,
 	hintUserID *string,
 	needRefreshToken bool,
 	issuer string,
+	authorizationDetails domain.AuthorizationDetails,
 ) *AddedEvent {
 	return &AddedEvent{
 		BaseEvent: *eventstore.NewBaseEventForPush(
@@ -92,6 +98,8 @@ func NewAddedEvent(ctx context.Context,
 		HintUserID:       hintUserID,
 		NeedRefreshToken: needRefreshToken,
 		Issuer:           issuer,
+
+		AuthorizationDetails: authorizationDetails,
 	}
 }
 
@@ -292,3 +300,81 @@ func SucceededEventMapper(event eventstore.Event) (eventstore.Event, error) {
 		BaseEvent: *eventstore.BaseEventFromRepo(event),
 	}, nil
 }
+
+// PushedEvent stores the parameters of a pushed authorization request (RFC 9126).
+// They are replayed on the authorization endpoint when the client references the request by its request_uri.
+type PushedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ClientID   string     `json:"client_id"`
+	Parameters url.Values `json:"parameters"`
+	Expiration time.Time  `json:"expiration"`
+}
+
+func (e *PushedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *PushedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewPushedEvent(ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	clientID string,
+	parameters url.Values,
+	expiration time.Time,
+) *PushedEvent {
+	return &PushedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			PushedType,
+		),
+		ClientID:   clientID,
+		Parameters: parameters,
+		Expiration: expiration,
+	}
+}
+
+func PushedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	added := &PushedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := event.Unmarshal(added)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "AUTHR-Ooj4e", "unable to unmarshal auth request pushed")
+	}
+
+	return added, nil
+}
+
+type RequestURIUsedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func (e *RequestURIUsedEvent) Payload() interface{} {
+	return nil
+}
+
+func (e *RequestURIUsedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewRequestURIUsedEvent(ctx context.Context,
+	aggregate *eventstore.Aggregate,
+) *RequestURIUsedEvent {
+	return &RequestURIUsedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			RequestURIUsedType,
+		),
+	}
+}
+
+func RequestURIUsedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	return &RequestURIUsedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}, nil
+}
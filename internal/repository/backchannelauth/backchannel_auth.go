@@ -0,0 +1,208 @@
+package backchannelauth
+
+import (
+	"context"
+	"time"
+
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	eventTypePrefix         eventstore.EventType = "backchannel.authentication."
+	AddedEventType                               = eventTypePrefix + "added"
+	UserNotifiedEventType                        = eventTypePrefix + "user.notified"
+	ApprovedEventType                            = eventTypePrefix + "approved"
+	CanceledEventType                            = eventTypePrefix + "canceled"
+	ClientNotifiedEventType                      = eventTypePrefix + "client.notified"
+	DoneEventType                                = eventTypePrefix + "done"
+)
+
+type AddedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	ClientID         string
+	Scopes           []string
+	Audience         []string
+	UserID           string
+	UserOrgID        string
+	BindingMessage   string
+	Expires          time.Time
+	State            domain.DeviceAuthState
+	NeedRefreshToken bool
+	// NotificationURI is only set for clients using the ping mode.
+	NotificationURI   string              `json:",omitempty"`
+	NotificationToken *crypto.CryptoValue `json:",omitempty"`
+}
+
+func (e *AddedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (e *AddedEvent) Payload() any {
+	return e
+}
+
+func (e *AddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	clientID string,
+	scopes []string,
+	audience []string,
+	userID string,
+	userOrgID string,
+	bindingMessage string,
+	expires time.Time,
+	needRefreshToken bool,
+	notificationURI string,
+	notificationToken *crypto.CryptoValue,
+) *AddedEvent {
+	return &AddedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx, aggregate, AddedEventType,
+		),
+		ClientID:          clientID,
+		Scopes:            scopes,
+		Audience:          audience,
+		UserID:            userID,
+		UserOrgID:         userOrgID,
+		BindingMessage:    bindingMessage,
+		Expires:           expires,
+		State:             domain.DeviceAuthStateInitiated,
+		NeedRefreshToken:  needRefreshToken,
+		NotificationURI:   notificationURI,
+		NotificationToken: notificationToken,
+	}
+}
+
+type UserNotifiedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+}
+
+func (e *UserNotifiedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (e *UserNotifiedEvent) Payload() any {
+	return e
+}
+
+func (e *UserNotifiedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewUserNotifiedEvent(ctx context.Context, aggregate *eventstore.Aggregate) *UserNotifiedEvent {
+	return &UserNotifiedEvent{eventstore.NewBaseEventForPush(ctx, aggregate, UserNotifiedEventType)}
+}
+
+type ApprovedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	UserAuthMethods   []domain.UserAuthMethodType
+	AuthTime          time.Time
+	PreferredLanguage *language.Tag
+	UserAgent         *domain.UserAgent
+	SessionID         string
+}
+
+func (e *ApprovedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (e *ApprovedEvent) Payload() any {
+	return e
+}
+
+func (e *ApprovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewApprovedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	userAuthMethods []domain.UserAuthMethodType,
+	authTime time.Time,
+	preferredLanguage *language.Tag,
+	userAgent *domain.UserAgent,
+	sessionID string,
+) *ApprovedEvent {
+	return &ApprovedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx, aggregate, ApprovedEventType,
+		),
+		UserAuthMethods:   userAuthMethods,
+		AuthTime:          authTime,
+		PreferredLanguage: preferredLanguage,
+		UserAgent:         userAgent,
+		SessionID:         sessionID,
+	}
+}
+
+type CanceledEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	Reason domain.DeviceAuthCanceled
+}
+
+func (e *CanceledEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (e *CanceledEvent) Payload() any {
+	return e
+}
+
+func (e *CanceledEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewCanceledEvent(ctx context.Context, aggregate *eventstore.Aggregate, reason domain.DeviceAuthCanceled) *CanceledEvent {
+	return &CanceledEvent{eventstore.NewBaseEventForPush(ctx, aggregate, CanceledEventType), reason}
+}
+
+type ClientNotifiedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+}
+
+func (e *ClientNotifiedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (e *ClientNotifiedEvent) Payload() any {
+	return e
+}
+
+func (e *ClientNotifiedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewClientNotifiedEvent(ctx context.Context, aggregate *eventstore.Aggregate) *ClientNotifiedEvent {
+	return &ClientNotifiedEvent{eventstore.NewBaseEventForPush(ctx, aggregate, ClientNotifiedEventType)}
+}
+
+type DoneEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+}
+
+func (e *DoneEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (e *DoneEvent) Payload() any {
+	return e
+}
+
+func (e *DoneEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewDoneEvent(ctx context.Context, aggregate *eventstore.Aggregate) *DoneEvent {
+	return &DoneEvent{eventstore.NewBaseEventForPush(ctx, aggregate, DoneEventType)}
+}
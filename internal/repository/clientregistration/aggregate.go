@@ -0,0 +1,20 @@
+package clientregistration
+
+import "github.com/zitadel/zitadel/internal/eventstore"
+
+const (
+	AggregateType    = "client_registration"
+	AggregateVersion = "v1"
+)
+
+// NewAggregate returns the aggregate of the dynamic client registration settings,
+// which exist once per instance.
+func NewAggregate(instanceID string) *eventstore.Aggregate {
+	return &eventstore.Aggregate{
+		ID:            instanceID,
+		Type:          AggregateType,
+		ResourceOwner: instanceID,
+		InstanceID:    instanceID,
+		Version:       AggregateVersion,
+	}
+}
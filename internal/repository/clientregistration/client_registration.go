@@ -0,0 +1,152 @@
+package clientregistration
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	eventTypePrefix                    eventstore.EventType = "client_registration."
+	ConfigSetEventType                                      = eventTypePrefix + "config.set"
+	ConfigRemovedEventType                                  = eventTypePrefix + "config.removed"
+	InitialAccessTokenAddedEventType                        = eventTypePrefix + "initial_access_token.added"
+	InitialAccessTokenRemovedEventType                      = eventTypePrefix + "initial_access_token.removed"
+)
+
+// SoftwareStatementIssuer is trusted to sign software statements (RFC 7591, section 2.3)
+// with one of the keys of its JWKS.
+type SoftwareStatementIssuer struct {
+	Issuer string `json:"issuer"`
+	JWKS   []byte `json:"jwks"`
+}
+
+// ConfigSetEvent enables the dynamic client registration (RFC 7591) for the instance.
+// Clients register themselves as OIDC applications of the designated project.
+type ConfigSetEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ProjectID                string                     `json:"projectId"`
+	ProjectResourceOwner     string                     `json:"projectResourceOwner"`
+	SoftwareStatementIssuers []*SoftwareStatementIssuer `json:"softwareStatementIssuers,omitempty"`
+}
+
+func (e *ConfigSetEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *ConfigSetEvent) Payload() any {
+	return e
+}
+
+func (e *ConfigSetEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewConfigSetEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	projectID,
+	projectResourceOwner string,
+	softwareStatementIssuers []*SoftwareStatementIssuer,
+) *ConfigSetEvent {
+	return &ConfigSetEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx, aggregate, ConfigSetEventType,
+		),
+		ProjectID:                projectID,
+		ProjectResourceOwner:     projectResourceOwner,
+		SoftwareStatementIssuers: softwareStatementIssuers,
+	}
+}
+
+// ConfigRemovedEvent disables the dynamic client registration.
+// Already registered clients are not removed.
+type ConfigRemovedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func (e *ConfigRemovedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *ConfigRemovedEvent) Payload() any {
+	return e
+}
+
+func (e *ConfigRemovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewConfigRemovedEvent(ctx context.Context, aggregate *eventstore.Aggregate) *ConfigRemovedEvent {
+	return &ConfigRemovedEvent{*eventstore.NewBaseEventForPush(ctx, aggregate, ConfigRemovedEventType)}
+}
+
+// InitialAccessTokenAddedEvent allows clients presenting the token to register themselves until it expires.
+type InitialAccessTokenAddedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	TokenID        string    `json:"tokenId"`
+	HashedToken    string    `json:"hashedToken"`
+	Description    string    `json:"description,omitempty"`
+	ExpirationDate time.Time `json:"expirationDate,omitempty"`
+}
+
+func (e *InitialAccessTokenAddedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *InitialAccessTokenAddedEvent) Payload() any {
+	return e
+}
+
+func (e *InitialAccessTokenAddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewInitialAccessTokenAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	tokenID,
+	hashedToken,
+	description string,
+	expirationDate time.Time,
+) *InitialAccessTokenAddedEvent {
+	return &InitialAccessTokenAddedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx, aggregate, InitialAccessTokenAddedEventType,
+		),
+		TokenID:        tokenID,
+		HashedToken:    hashedToken,
+		Description:    description,
+		ExpirationDate: expirationDate,
+	}
+}
+
+type InitialAccessTokenRemovedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	TokenID string `json:"tokenId"`
+}
+
+func (e *InitialAccessTokenRemovedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *InitialAccessTokenRemovedEvent) Payload() any {
+	return e
+}
+
+func (e *InitialAccessTokenRemovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewInitialAccessTokenRemovedEvent(ctx context.Context, aggregate *eventstore.Aggregate, tokenID string) *InitialAccessTokenRemovedEvent {
+	return &InitialAccessTokenRemovedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx, aggregate, InitialAccessTokenRemovedEventType,
+		),
+		TokenID: tokenID,
+	}
+}
@@ -0,0 +1,10 @@
+package clientregistration
+
+import "github.com/zitadel/zitadel/internal/eventstore"
+
+func init() {
+	eventstore.RegisterFilterEventMapper(AggregateType, ConfigSetEventType, eventstore.GenericEventMapper[ConfigSetEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, ConfigRemovedEventType, eventstore.GenericEventMapper[ConfigRemovedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, InitialAccessTokenAddedEventType, eventstore.GenericEventMapper[InitialAccessTokenAddedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, InitialAccessTokenRemovedEventType, eventstore.GenericEventMapper[InitialAccessTokenRemovedEvent])
+}
@@ -23,6 +23,7 @@ type LDAPIDPAddedEvent struct {
 	UserFilters       []string            `json:"userFilters"`
 	Timeout           time.Duration       `json:"timeout"`
 	RootCA            []byte              `json:"rootCA"`
+	GroupSync         *LDAPGroupSync      `json:"groupSync,omitempty"`
 
 	LDAPAttributes
 	Options
@@ -130,6 +131,31 @@ func (o *LDAPAttributes) ReduceChanges(changes LDAPAttributeChanges) {
 	}
 }
 
+// LDAPGroupSync defines how the groups of a user are searched on the LDAP
+// and which project roles are granted to the members of the groups.
+type LDAPGroupSync struct {
+	// GroupBaseDN is used to search the groups, if empty the BaseDN of the provider is used.
+	GroupBaseDN          string              `json:"groupBaseDN,omitempty"`
+	GroupObjectClasses   []string            `json:"groupObjectClasses,omitempty"`
+	GroupMemberAttribute string              `json:"groupMemberAttribute,omitempty"`
+	GroupMappings        []*LDAPGroupMapping `json:"groupMappings,omitempty"`
+}
+
+// LDAPGroupMapping grants the roles of the project to the members of the group (distinguished name).
+type LDAPGroupMapping struct {
+	Group     string   `json:"group,omitempty"`
+	ProjectID string   `json:"projectId,omitempty"`
+	RoleKeys  []string `json:"roleKeys,omitempty"`
+}
+
+func (s *LDAPGroupSync) IsZero() bool {
+	return s == nil ||
+		s.GroupBaseDN == "" &&
+			len(s.GroupObjectClasses) == 0 &&
+			s.GroupMemberAttribute == "" &&
+			len(s.GroupMappings) == 0
+}
+
 func NewLDAPIDPAddedEvent(
 	base *eventstore.BaseEvent,
 	id string,
@@ -144,6 +170,7 @@ func NewLDAPIDPAddedEvent(
 	userFilters []string,
 	timeout time.Duration,
 	rootCA []byte,
+	groupSync *LDAPGroupSync,
 	attributes LDAPAttributes,
 	options Options,
 ) *LDAPIDPAddedEvent {
@@ -161,6 +188,7 @@ func NewLDAPIDPAddedEvent(
 		UserFilters:       userFilters,
 		Timeout:           timeout,
 		RootCA:            rootCA,
+		GroupSync:         groupSync,
 		LDAPAttributes:    attributes,
 		Options:           options,
 	}
@@ -202,6 +230,8 @@ type LDAPIDPChangedEvent struct {
 	UserFilters       []string            `json:"userFilters,omitempty"`
 	Timeout           *time.Duration      `json:"timeout,omitempty"`
 	RootCA            []byte              `json:"rootCA,omitempty"`
+	// GroupSync is set as a whole, an empty value disables the group synchronisation.
+	GroupSync *LDAPGroupSync `json:"groupSync,omitempty"`
 
 	LDAPAttributeChanges
 	OptionChanges
@@ -325,6 +355,15 @@ func ChangeLDAPRootCA(rootCA []byte) func(*LDAPIDPChangedEvent) {
 	}
 }
 
+func ChangeLDAPGroupSync(groupSync *LDAPGroupSync) func(*LDAPIDPChangedEvent) {
+	return func(e *LDAPIDPChangedEvent) {
+		if groupSync == nil {
+			groupSync = new(LDAPGroupSync)
+		}
+		e.GroupSync = groupSync
+	}
+}
+
 func ChangeLDAPAttributes(attributes LDAPAttributeChanges) func(*LDAPIDPChangedEvent) {
 	return func(e *LDAPIDPChangedEvent) {
 		e.LDAPAttributeChanges = attributes
@@ -856,6 +856,7 @@ func NewLDAPIDPAddedEvent(
 	userFilters []string,
 	timeout time.Duration,
 	rootCA []byte,
+	groupSync *idp.LDAPGroupSync,
 	attributes idp.LDAPAttributes,
 	options idp.Options,
 ) *LDAPIDPAddedEvent {
@@ -879,6 +880,7 @@ func NewLDAPIDPAddedEvent(
 			userFilters,
 			timeout,
 			rootCA,
+			groupSync,
 			attributes,
 			options,
 		),
@@ -0,0 +1,93 @@
+package oidcconsent
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	eventTypePrefix eventstore.EventType = "oidc_consent."
+	GrantedType                          = eventTypePrefix + "granted"
+	RevokedType                          = eventTypePrefix + "revoked"
+)
+
+// GrantedEvent records the scopes a user consented to for a client.
+// A subsequent event on the same aggregate always contains the complete set of scopes.
+type GrantedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	UserID    string   `json:"userID"`
+	ClientID  string   `json:"clientID"`
+	AppID     string   `json:"appID"`
+	ProjectID string   `json:"projectID"`
+	Scope     []string `json:"scope"`
+}
+
+func (e *GrantedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *GrantedEvent) Payload() any {
+	return e
+}
+
+func (e *GrantedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewGrantedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	userID,
+	clientID,
+	appID,
+	projectID string,
+	scope []string,
+) *GrantedEvent {
+	return &GrantedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx, aggregate, GrantedType,
+		),
+		UserID:    userID,
+		ClientID:  clientID,
+		AppID:     appID,
+		ProjectID: projectID,
+		Scope:     scope,
+	}
+}
+
+type RevokedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	// UserID and ClientID are repeated to allow filtering all events of a user and client
+	UserID   string `json:"userID"`
+	ClientID string `json:"clientID"`
+}
+
+func (e *RevokedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *RevokedEvent) Payload() any {
+	return e
+}
+
+func (e *RevokedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewRevokedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	userID,
+	clientID string,
+) *RevokedEvent {
+	return &RevokedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx, aggregate, RevokedType,
+		),
+		UserID:   userID,
+		ClientID: clientID,
+	}
+}
@@ -11,5 +11,7 @@ func init() {
 	eventstore.RegisterFilterEventMapper(AggregateType, RefreshTokenAddedType, eventstore.GenericEventMapper[RefreshTokenAddedEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, RefreshTokenRenewedType, eventstore.GenericEventMapper[RefreshTokenRenewedEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, RefreshTokenRevokedType, eventstore.GenericEventMapper[RefreshTokenRevokedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, RefreshTokenReusedType, eventstore.GenericEventMapper[RefreshTokenReusedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, RefreshTokenReuseNotificationSentType, eventstore.GenericEventMapper[RefreshTokenReuseNotificationSentEvent])
 
 }
@@ -6,18 +6,21 @@ import (
 
 	"golang.org/x/text/language"
 
+	"github.com/zitadel/zitadel/internal/api/http"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 )
 
 const (
-	oidcSessionEventPrefix  = "oidc_session."
-	AddedType               = oidcSessionEventPrefix + "added"
-	AccessTokenAddedType    = oidcSessionEventPrefix + "access_token.added"
-	AccessTokenRevokedType  = oidcSessionEventPrefix + "access_token.revoked"
-	RefreshTokenAddedType   = oidcSessionEventPrefix + "refresh_token.added"
-	RefreshTokenRenewedType = oidcSessionEventPrefix + "refresh_token.renewed"
-	RefreshTokenRevokedType = oidcSessionEventPrefix + "refresh_token.revoked"
+	oidcSessionEventPrefix                = "oidc_session."
+	AddedType                             = oidcSessionEventPrefix + "added"
+	AccessTokenAddedType                  = oidcSessionEventPrefix + "access_token.added"
+	AccessTokenRevokedType                = oidcSessionEventPrefix + "access_token.revoked"
+	RefreshTokenAddedType                 = oidcSessionEventPrefix + "refresh_token.added"
+	RefreshTokenRenewedType               = oidcSessionEventPrefix + "refresh_token.renewed"
+	RefreshTokenRevokedType               = oidcSessionEventPrefix + "refresh_token.revoked"
+	RefreshTokenReusedType                = oidcSessionEventPrefix + "refresh_token.reused"
+	RefreshTokenReuseNotificationSentType = oidcSessionEventPrefix + "refresh_token.reuse.notification.sent"
 )
 
 type AddedEvent struct {
@@ -34,6 +37,11 @@ type AddedEvent struct {
 	Nonce             string                      `json:"nonce,omitempty"`
 	PreferredLanguage *language.Tag               `json:"preferredLanguage,omitempty"`
 	UserAgent         *domain.UserAgent           `json:"userAgent,omitempty"`
+	DPoPJKT           string                      `json:"dpopJkt,omitempty"`
+	// CertificateThumbprint is the SHA-256 thumbprint of the client certificate the tokens are bound to (RFC 8705).
+	CertificateThumbprint string `json:"x5tS256,omitempty"`
+	// AuthorizationDetails are the fine-grained authorizations granted to the session (RFC 9396).
+	AuthorizationDetails domain.AuthorizationDetails `json:"authorizationDetails,omitempty"`
 }
 
 func (e *AddedEvent) Payload() interface{} {
@@ -61,6 +69,9 @@ func NewAddedEvent(ctx context.Context,
 	nonce string,
 	preferredLanguage *language.Tag,
 	userAgent *domain.UserAgent,
+	dpopJKT,
+	certificateThumbprint string,
+	authorizationDetails domain.AuthorizationDetails,
 ) *AddedEvent {
 	return &AddedEvent{
 		BaseEvent: *eventstore.NewBaseEventForPush(
@@ -68,17 +79,20 @@ func NewAddedEvent(ctx context.Context,
 			aggregate,
 			AddedType,
 		),
-		UserID:            userID,
-		UserResourceOwner: userResourceOwner,
-		SessionID:         sessionID,
-		ClientID:          clientID,
-		Audience:          audience,
-		Scope:             scope,
-		AuthMethods:       authMethods,
-		AuthTime:          authTime,
-		Nonce:             nonce,
-		PreferredLanguage: preferredLanguage,
-		UserAgent:         userAgent,
+		UserID:                userID,
+		UserResourceOwner:     userResourceOwner,
+		SessionID:             sessionID,
+		ClientID:              clientID,
+		Audience:              audience,
+		Scope:                 scope,
+		AuthMethods:           authMethods,
+		AuthTime:              authTime,
+		Nonce:                 nonce,
+		PreferredLanguage:     preferredLanguage,
+		UserAgent:             userAgent,
+		DPoPJKT:               dpopJKT,
+		CertificateThumbprint: certificateThumbprint,
+		AuthorizationDetails:  authorizationDetails,
 	}
 }
 
@@ -90,6 +104,9 @@ type AccessTokenAddedEvent struct {
 	Lifetime time.Duration      `json:"lifetime,omitempty"`
 	Reason   domain.TokenReason `json:"reason,omitempty"`
 	Actor    *domain.TokenActor `json:"actor,omitempty"`
+	// Audience restricts the audience of the access token to the requested resources (RFC 8707).
+	// If empty, the audience of the session applies.
+	Audience []string `json:"audience,omitempty"`
 }
 
 func (e *AccessTokenAddedEvent) Payload() interface{} {
@@ -112,6 +129,7 @@ func NewAccessTokenAddedEvent(
 	lifetime time.Duration,
 	reason domain.TokenReason,
 	actor *domain.TokenActor,
+	audience []string,
 ) *AccessTokenAddedEvent {
 	return &AccessTokenAddedEvent{
 		BaseEvent: *eventstore.NewBaseEventForPush(
@@ -124,6 +142,7 @@ func NewAccessTokenAddedEvent(
 		Lifetime: lifetime,
 		Reason:   reason,
 		Actor:    actor,
+		Audience: audience,
 	}
 }
 
@@ -259,3 +278,83 @@ func NewRefreshTokenRevokedEvent(
 		),
 	}
 }
+
+// RefreshTokenReusedEvent is pushed when an already rotated refresh token is presented again
+// for a client with strict refresh token rotation.
+// It is treated as a compromise of the token family and terminates the session.
+type RefreshTokenReusedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ID                string `json:"id"`
+	ClientID          string `json:"clientID"`
+	UserID            string `json:"userID"`
+	NotifyUser        bool   `json:"notifyUser,omitempty"`
+	TriggeredAtOrigin string `json:"triggerOrigin,omitempty"`
+}
+
+func (e *RefreshTokenReusedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *RefreshTokenReusedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *RefreshTokenReusedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = *event
+}
+
+func (e *RefreshTokenReusedEvent) TriggerOrigin() string {
+	return e.TriggeredAtOrigin
+}
+
+func NewRefreshTokenReusedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id,
+	clientID,
+	userID string,
+	notifyUser bool,
+) *RefreshTokenReusedEvent {
+	return &RefreshTokenReusedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			RefreshTokenReusedType,
+		),
+		ID:                id,
+		ClientID:          clientID,
+		UserID:            userID,
+		NotifyUser:        notifyUser,
+		TriggeredAtOrigin: http.DomainContext(ctx).Origin(),
+	}
+}
+
+type RefreshTokenReuseNotificationSentEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func (e *RefreshTokenReuseNotificationSentEvent) Payload() interface{} {
+	return e
+}
+
+func (e *RefreshTokenReuseNotificationSentEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *RefreshTokenReuseNotificationSentEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = *event
+}
+
+func NewRefreshTokenReuseNotificationSentEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+) *RefreshTokenReuseNotificationSentEvent {
+	return &RefreshTokenReuseNotificationSentEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			RefreshTokenReuseNotificationSentType,
+		),
+	}
+}
@@ -10,6 +10,8 @@ func init() {
 	eventstore.RegisterFilterEventMapper(AggregateType, OrgDeactivatedEventType, OrgDeactivatedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, OrgReactivatedEventType, OrgReactivatedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, OrgRemovedEventType, OrgRemovedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, OrgParentSetEventType, OrgParentSetEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, OrgParentRemovedEventType, OrgParentRemovedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, OrgDomainAddedEventType, DomainAddedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, OrgDomainVerificationAddedEventType, DomainVerificationAddedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, OrgDomainVerificationFailedEventType, DomainVerificationFailedEventMapper)
@@ -0,0 +1,93 @@
+package org
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	OrgParentSetEventType     = orgEventTypePrefix + "parent.set"
+	OrgParentRemovedEventType = orgEventTypePrefix + "parent.removed"
+)
+
+// OrgParentSetEvent places the organization below the parent organization.
+// Policies not defined on the organization are inherited from the parent,
+// if DelegateAdministration is set, the members of the parent also administrate the organization.
+type OrgParentSetEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ParentOrgID            string `json:"parentOrgId,omitempty"`
+	DelegateAdministration bool   `json:"delegateAdministration,omitempty"`
+}
+
+func (e *OrgParentSetEvent) Payload() interface{} {
+	return e
+}
+
+func (e *OrgParentSetEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewOrgParentSetEvent(ctx context.Context, aggregate *eventstore.Aggregate, parentOrgID string, delegateAdministration bool) *OrgParentSetEvent {
+	return &OrgParentSetEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			OrgParentSetEventType,
+		),
+		ParentOrgID:            parentOrgID,
+		DelegateAdministration: delegateAdministration,
+	}
+}
+
+func OrgParentSetEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	parentSet := &OrgParentSetEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := event.Unmarshal(parentSet)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "ORG-Quoh3", "unable to unmarshal org parent set")
+	}
+
+	return parentSet, nil
+}
+
+// OrgParentRemovedEvent makes the organization a top level organization of the instance again.
+type OrgParentRemovedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ParentOrgID string `json:"parentOrgId,omitempty"`
+}
+
+func (e *OrgParentRemovedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *OrgParentRemovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewOrgParentRemovedEvent(ctx context.Context, aggregate *eventstore.Aggregate, parentOrgID string) *OrgParentRemovedEvent {
+	return &OrgParentRemovedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			OrgParentRemovedEventType,
+		),
+		ParentOrgID: parentOrgID,
+	}
+}
+
+func OrgParentRemovedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	parentRemoved := &OrgParentRemovedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := event.Unmarshal(parentRemoved)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "ORG-ieT6e", "unable to unmarshal org parent removed")
+	}
+
+	return parentRemoved, nil
+}
@@ -16,6 +16,8 @@ type PasswordAgePolicyAddedEvent struct {
 
 	ExpireWarnDays uint64 `json:"expireWarnDays,omitempty"`
 	MaxAgeDays     uint64 `json:"maxAgeDays,omitempty"`
+	HistoryCount   uint64 `json:"historyCount,omitempty"`
+	MinAgeDays     uint64 `json:"minAgeDays,omitempty"`
 }
 
 func (e *PasswordAgePolicyAddedEvent) Payload() interface{} {
@@ -29,13 +31,17 @@ func (e *PasswordAgePolicyAddedEvent) UniqueConstraints() []*eventstore.UniqueCo
 func NewPasswordAgePolicyAddedEvent(
 	base *eventstore.BaseEvent,
 	expireWarnDays,
-	maxAgeDays uint64,
+	maxAgeDays,
+	historyCount,
+	minAgeDays uint64,
 ) *PasswordAgePolicyAddedEvent {
 
 	return &PasswordAgePolicyAddedEvent{
 		BaseEvent:      *base,
 		ExpireWarnDays: expireWarnDays,
 		MaxAgeDays:     maxAgeDays,
+		HistoryCount:   historyCount,
+		MinAgeDays:     minAgeDays,
 	}
 }
 
@@ -57,6 +63,8 @@ type PasswordAgePolicyChangedEvent struct {
 
 	ExpireWarnDays *uint64 `json:"expireWarnDays,omitempty"`
 	MaxAgeDays     *uint64 `json:"maxAgeDays,omitempty"`
+	HistoryCount   *uint64 `json:"historyCount,omitempty"`
+	MinAgeDays     *uint64 `json:"minAgeDays,omitempty"`
 }
 
 func (e *PasswordAgePolicyChangedEvent) Payload() interface{} {
@@ -97,6 +105,18 @@ func ChangeMaxAgeDays(maxAgeDays uint64) func(*PasswordAgePolicyChangedEvent) {
 	}
 }
 
+func ChangeHistoryCount(historyCount uint64) func(*PasswordAgePolicyChangedEvent) {
+	return func(e *PasswordAgePolicyChangedEvent) {
+		e.HistoryCount = &historyCount
+	}
+}
+
+func ChangeMinAgeDays(minAgeDays uint64) func(*PasswordAgePolicyChangedEvent) {
+	return func(e *PasswordAgePolicyChangedEvent) {
+		e.MinAgeDays = &minAgeDays
+	}
+}
+
 func PasswordAgePolicyChangedEventMapper(event eventstore.Event) (eventstore.Event, error) {
 	e := &PasswordAgePolicyChangedEvent{
 		BaseEvent: *eventstore.BaseEventFromRepo(event),
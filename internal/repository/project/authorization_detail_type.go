@@ -0,0 +1,85 @@
+package project
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	authorizationDetailTypeEventTypePrefix = projectEventTypePrefix + "authorization_detail_type."
+	AuthorizationDetailTypeSetType         = authorizationDetailTypeEventTypePrefix + "set"
+	AuthorizationDetailTypeRemovedType     = authorizationDetailTypeEventTypePrefix + "removed"
+)
+
+// AuthorizationDetailTypeSetEvent adds or replaces an authorization details type (RFC 9396) of the project.
+// The schema is a JSON schema, which every authorization detail of the type requested for the project must be valid against.
+type AuthorizationDetailTypeSetEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	DetailType string          `json:"type"`
+	Schema     json.RawMessage `json:"schema,omitempty"`
+}
+
+func (e *AuthorizationDetailTypeSetEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *AuthorizationDetailTypeSetEvent) Payload() interface{} {
+	return e
+}
+
+func (e *AuthorizationDetailTypeSetEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewAuthorizationDetailTypeSetEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	detailType string,
+	schema json.RawMessage,
+) *AuthorizationDetailTypeSetEvent {
+	return &AuthorizationDetailTypeSetEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			AuthorizationDetailTypeSetType,
+		),
+		DetailType: detailType,
+		Schema:     schema,
+	}
+}
+
+type AuthorizationDetailTypeRemovedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	DetailType string `json:"type"`
+}
+
+func (e *AuthorizationDetailTypeRemovedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *AuthorizationDetailTypeRemovedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *AuthorizationDetailTypeRemovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewAuthorizationDetailTypeRemovedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	detailType string,
+) *AuthorizationDetailTypeRemovedEvent {
+	return &AuthorizationDetailTypeRemovedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			AuthorizationDetailTypeRemovedType,
+		),
+		DetailType: detailType,
+	}
+}
@@ -2,6 +2,7 @@ package project
 
 import (
 	"context"
+	"slices"
 	"time"
 
 	"github.com/zitadel/zitadel/internal/crypto"
@@ -15,6 +16,8 @@ const (
 	OIDCConfigChangedType           = applicationEventTypePrefix + "config.oidc.changed"
 	OIDCConfigSecretChangedType     = applicationEventTypePrefix + "config.oidc.secret.changed"
 	OIDCConfigSecretHashUpdatedType = applicationEventTypePrefix + "config.oidc.secret.updated"
+
+	OIDCConfigRegistrationAccessTokenSetType = applicationEventTypePrefix + "config.oidc.registration_access_token.set"
 )
 
 type OIDCConfigAddedEvent struct {
@@ -29,23 +32,32 @@ type OIDCConfigAddedEvent struct {
 	ClientSecret *crypto.CryptoValue `json:"clientSecret,omitempty"`
 	HashedSecret string              `json:"hashedSecret,omitempty"`
 
-	RedirectUris             []string                   `json:"redirectUris,omitempty"`
-	ResponseTypes            []domain.OIDCResponseType  `json:"responseTypes,omitempty"`
-	GrantTypes               []domain.OIDCGrantType     `json:"grantTypes,omitempty"`
-	ApplicationType          domain.OIDCApplicationType `json:"applicationType,omitempty"`
-	AuthMethodType           domain.OIDCAuthMethodType  `json:"authMethodType,omitempty"`
-	PostLogoutRedirectUris   []string                   `json:"postLogoutRedirectUris,omitempty"`
-	DevMode                  bool                       `json:"devMode,omitempty"`
-	AccessTokenType          domain.OIDCTokenType       `json:"accessTokenType,omitempty"`
-	AccessTokenRoleAssertion bool                       `json:"accessTokenRoleAssertion,omitempty"`
-	IDTokenRoleAssertion     bool                       `json:"idTokenRoleAssertion,omitempty"`
-	IDTokenUserinfoAssertion bool                       `json:"idTokenUserinfoAssertion,omitempty"`
-	ClockSkew                time.Duration              `json:"clockSkew,omitempty"`
-	AdditionalOrigins        []string                   `json:"additionalOrigins,omitempty"`
-	SkipNativeAppSuccessPage bool                       `json:"skipNativeAppSuccessPage,omitempty"`
-	BackChannelLogoutURI     string                     `json:"backChannelLogoutURI,omitempty"`
-	LoginVersion             domain.LoginVersion        `json:"loginVersion,omitempty"`
-	LoginBaseURI             string                     `json:"loginBaseURI,omitempty"`
+	RedirectUris                          []string                        `json:"redirectUris,omitempty"`
+	ResponseTypes                         []domain.OIDCResponseType       `json:"responseTypes,omitempty"`
+	GrantTypes                            []domain.OIDCGrantType          `json:"grantTypes,omitempty"`
+	ApplicationType                       domain.OIDCApplicationType      `json:"applicationType,omitempty"`
+	AuthMethodType                        domain.OIDCAuthMethodType       `json:"authMethodType,omitempty"`
+	PostLogoutRedirectUris                []string                        `json:"postLogoutRedirectUris,omitempty"`
+	DevMode                               bool                            `json:"devMode,omitempty"`
+	AccessTokenType                       domain.OIDCTokenType            `json:"accessTokenType,omitempty"`
+	AccessTokenRoleAssertion              bool                            `json:"accessTokenRoleAssertion,omitempty"`
+	IDTokenRoleAssertion                  bool                            `json:"idTokenRoleAssertion,omitempty"`
+	IDTokenUserinfoAssertion              bool                            `json:"idTokenUserinfoAssertion,omitempty"`
+	ClockSkew                             time.Duration                   `json:"clockSkew,omitempty"`
+	AdditionalOrigins                     []string                        `json:"additionalOrigins,omitempty"`
+	SkipNativeAppSuccessPage              bool                            `json:"skipNativeAppSuccessPage,omitempty"`
+	BackChannelLogoutURI                  string                          `json:"backChannelLogoutURI,omitempty"`
+	LoginVersion                          domain.LoginVersion             `json:"loginVersion,omitempty"`
+	LoginBaseURI                          string                          `json:"loginBaseURI,omitempty"`
+	DPoPBoundAccessTokens                 bool                            `json:"dpopBoundAccessTokens,omitempty"`
+	RequirePushedAuthRequests             bool                            `json:"requirePushedAuthRequests,omitempty"`
+	CIBANotificationURI                   string                          `json:"cibaNotificationURI,omitempty"`
+	ConsentRequired                       bool                            `json:"consentRequired,omitempty"`
+	TLSClientAuthSubjectDN                string                          `json:"tlsClientAuthSubjectDN,omitempty"`
+	TLSClientCertificates                 []string                        `json:"tlsClientCertificates,omitempty"`
+	TLSClientCertificateBoundAccessTokens bool                            `json:"tlsClientCertificateBoundAccessTokens,omitempty"`
+	RefreshTokenRotation                  domain.OIDCRefreshTokenRotation `json:"refreshTokenRotation,omitempty"`
+	EncryptAuthorizationResponse          bool                            `json:"encryptAuthorizationResponse,omitempty"`
 }
 
 func (e *OIDCConfigAddedEvent) Payload() interface{} {
@@ -80,6 +92,15 @@ func NewOIDCConfigAddedEvent(
 	backChannelLogoutURI string,
 	loginVersion domain.LoginVersion,
 	loginBaseURI string,
+	dpopBoundAccessTokens,
+	requirePushedAuthRequests bool,
+	cibaNotificationURI string,
+	consentRequired bool,
+	tlsClientAuthSubjectDN string,
+	tlsClientCertificates []string,
+	tlsClientCertificateBoundAccessTokens bool,
+	refreshTokenRotation domain.OIDCRefreshTokenRotation,
+	encryptAuthorizationResponse bool,
 ) *OIDCConfigAddedEvent {
 	return &OIDCConfigAddedEvent{
 		BaseEvent: *eventstore.NewBaseEventForPush(
@@ -87,27 +108,36 @@ func NewOIDCConfigAddedEvent(
 			aggregate,
 			OIDCConfigAddedType,
 		),
-		Version:                  version,
-		AppID:                    appID,
-		ClientID:                 clientID,
-		HashedSecret:             hashedSecret,
-		RedirectUris:             redirectUris,
-		ResponseTypes:            responseTypes,
-		GrantTypes:               grantTypes,
-		ApplicationType:          applicationType,
-		AuthMethodType:           authMethodType,
-		PostLogoutRedirectUris:   postLogoutRedirectUris,
-		DevMode:                  devMode,
-		AccessTokenType:          accessTokenType,
-		AccessTokenRoleAssertion: accessTokenRoleAssertion,
-		IDTokenRoleAssertion:     idTokenRoleAssertion,
-		IDTokenUserinfoAssertion: idTokenUserinfoAssertion,
-		ClockSkew:                clockSkew,
-		AdditionalOrigins:        additionalOrigins,
-		SkipNativeAppSuccessPage: skipNativeAppSuccessPage,
-		BackChannelLogoutURI:     backChannelLogoutURI,
-		LoginVersion:             loginVersion,
-		LoginBaseURI:             loginBaseURI,
+		Version:                               version,
+		AppID:                                 appID,
+		ClientID:                              clientID,
+		HashedSecret:                          hashedSecret,
+		RedirectUris:                          redirectUris,
+		ResponseTypes:                         responseTypes,
+		GrantTypes:                            grantTypes,
+		ApplicationType:                       applicationType,
+		AuthMethodType:                        authMethodType,
+		PostLogoutRedirectUris:                postLogoutRedirectUris,
+		DevMode:                               devMode,
+		AccessTokenType:                       accessTokenType,
+		AccessTokenRoleAssertion:              accessTokenRoleAssertion,
+		IDTokenRoleAssertion:                  idTokenRoleAssertion,
+		IDTokenUserinfoAssertion:              idTokenUserinfoAssertion,
+		ClockSkew:                             clockSkew,
+		AdditionalOrigins:                     additionalOrigins,
+		SkipNativeAppSuccessPage:              skipNativeAppSuccessPage,
+		BackChannelLogoutURI:                  backChannelLogoutURI,
+		LoginVersion:                          loginVersion,
+		LoginBaseURI:                          loginBaseURI,
+		DPoPBoundAccessTokens:                 dpopBoundAccessTokens,
+		RequirePushedAuthRequests:             requirePushedAuthRequests,
+		CIBANotificationURI:                   cibaNotificationURI,
+		ConsentRequired:                       consentRequired,
+		TLSClientAuthSubjectDN:                tlsClientAuthSubjectDN,
+		TLSClientCertificates:                 tlsClientCertificates,
+		TLSClientCertificateBoundAccessTokens: tlsClientCertificateBoundAccessTokens,
+		RefreshTokenRotation:                  refreshTokenRotation,
+		EncryptAuthorizationResponse:          encryptAuthorizationResponse,
 	}
 }
 
@@ -202,7 +232,34 @@ func (e *OIDCConfigAddedEvent) Validate(cmd eventstore.Command) bool {
 	if e.LoginVersion != c.LoginVersion {
 		return false
 	}
-	return e.LoginBaseURI == c.LoginBaseURI
+	if e.LoginBaseURI != c.LoginBaseURI {
+		return false
+	}
+	if e.DPoPBoundAccessTokens != c.DPoPBoundAccessTokens {
+		return false
+	}
+	if e.RequirePushedAuthRequests != c.RequirePushedAuthRequests {
+		return false
+	}
+	if e.CIBANotificationURI != c.CIBANotificationURI {
+		return false
+	}
+	if e.ConsentRequired != c.ConsentRequired {
+		return false
+	}
+	if e.TLSClientAuthSubjectDN != c.TLSClientAuthSubjectDN {
+		return false
+	}
+	if !slices.Equal(e.TLSClientCertificates, c.TLSClientCertificates) {
+		return false
+	}
+	if e.TLSClientCertificateBoundAccessTokens != c.TLSClientCertificateBoundAccessTokens {
+		return false
+	}
+	if e.RefreshTokenRotation != c.RefreshTokenRotation {
+		return false
+	}
+	return e.EncryptAuthorizationResponse == c.EncryptAuthorizationResponse
 }
 
 func OIDCConfigAddedEventMapper(event eventstore.Event) (eventstore.Event, error) {
@@ -221,25 +278,34 @@ func OIDCConfigAddedEventMapper(event eventstore.Event) (eventstore.Event, error
 type OIDCConfigChangedEvent struct {
 	eventstore.BaseEvent `json:"-"`
 
-	Version                  *domain.OIDCVersion         `json:"oidcVersion,omitempty"`
-	AppID                    string                      `json:"appId"`
-	RedirectUris             *[]string                   `json:"redirectUris,omitempty"`
-	ResponseTypes            *[]domain.OIDCResponseType  `json:"responseTypes,omitempty"`
-	GrantTypes               *[]domain.OIDCGrantType     `json:"grantTypes,omitempty"`
-	ApplicationType          *domain.OIDCApplicationType `json:"applicationType,omitempty"`
-	AuthMethodType           *domain.OIDCAuthMethodType  `json:"authMethodType,omitempty"`
-	PostLogoutRedirectUris   *[]string                   `json:"postLogoutRedirectUris,omitempty"`
-	DevMode                  *bool                       `json:"devMode,omitempty"`
-	AccessTokenType          *domain.OIDCTokenType       `json:"accessTokenType,omitempty"`
-	AccessTokenRoleAssertion *bool                       `json:"accessTokenRoleAssertion,omitempty"`
-	IDTokenRoleAssertion     *bool                       `json:"idTokenRoleAssertion,omitempty"`
-	IDTokenUserinfoAssertion *bool                       `json:"idTokenUserinfoAssertion,omitempty"`
-	ClockSkew                *time.Duration              `json:"clockSkew,omitempty"`
-	AdditionalOrigins        *[]string                   `json:"additionalOrigins,omitempty"`
-	SkipNativeAppSuccessPage *bool                       `json:"skipNativeAppSuccessPage,omitempty"`
-	BackChannelLogoutURI     *string                     `json:"backChannelLogoutURI,omitempty"`
-	LoginVersion             *domain.LoginVersion        `json:"loginVersion,omitempty"`
-	LoginBaseURI             *string                     `json:"loginBaseURI,omitempty"`
+	Version                               *domain.OIDCVersion              `json:"oidcVersion,omitempty"`
+	AppID                                 string                           `json:"appId"`
+	RedirectUris                          *[]string                        `json:"redirectUris,omitempty"`
+	ResponseTypes                         *[]domain.OIDCResponseType       `json:"responseTypes,omitempty"`
+	GrantTypes                            *[]domain.OIDCGrantType          `json:"grantTypes,omitempty"`
+	ApplicationType                       *domain.OIDCApplicationType      `json:"applicationType,omitempty"`
+	AuthMethodType                        *domain.OIDCAuthMethodType       `json:"authMethodType,omitempty"`
+	PostLogoutRedirectUris                *[]string                        `json:"postLogoutRedirectUris,omitempty"`
+	DevMode                               *bool                            `json:"devMode,omitempty"`
+	AccessTokenType                       *domain.OIDCTokenType            `json:"accessTokenType,omitempty"`
+	AccessTokenRoleAssertion              *bool                            `json:"accessTokenRoleAssertion,omitempty"`
+	IDTokenRoleAssertion                  *bool                            `json:"idTokenRoleAssertion,omitempty"`
+	IDTokenUserinfoAssertion              *bool                            `json:"idTokenUserinfoAssertion,omitempty"`
+	ClockSkew                             *time.Duration                   `json:"clockSkew,omitempty"`
+	AdditionalOrigins                     *[]string                        `json:"additionalOrigins,omitempty"`
+	SkipNativeAppSuccessPage              *bool                            `json:"skipNativeAppSuccessPage,omitempty"`
+	BackChannelLogoutURI                  *string                          `json:"backChannelLogoutURI,omitempty"`
+	LoginVersion                          *domain.LoginVersion             `json:"loginVersion,omitempty"`
+	LoginBaseURI                          *string                          `json:"loginBaseURI,omitempty"`
+	DPoPBoundAccessTokens                 *bool                            `json:"dpopBoundAccessTokens,omitempty"`
+	RequirePushedAuthRequests             *bool                            `json:"requirePushedAuthRequests,omitempty"`
+	CIBANotificationURI                   *string                          `json:"cibaNotificationURI,omitempty"`
+	ConsentRequired                       *bool                            `json:"consentRequired,omitempty"`
+	TLSClientAuthSubjectDN                *string                          `json:"tlsClientAuthSubjectDN,omitempty"`
+	TLSClientCertificates                 *[]string                        `json:"tlsClientCertificates,omitempty"`
+	TLSClientCertificateBoundAccessTokens *bool                            `json:"tlsClientCertificateBoundAccessTokens,omitempty"`
+	RefreshTokenRotation                  *domain.OIDCRefreshTokenRotation `json:"refreshTokenRotation,omitempty"`
+	EncryptAuthorizationResponse          *bool                            `json:"encryptAuthorizationResponse,omitempty"`
 }
 
 func (e *OIDCConfigChangedEvent) Payload() interface{} {
@@ -396,6 +462,60 @@ func ChangeOIDCLoginBaseURI(loginBaseURI string) func(event *OIDCConfigChangedEv
 	}
 }
 
+func ChangeDPoPBoundAccessTokens(dpopBoundAccessTokens bool) func(event *OIDCConfigChangedEvent) {
+	return func(e *OIDCConfigChangedEvent) {
+		e.DPoPBoundAccessTokens = &dpopBoundAccessTokens
+	}
+}
+
+func ChangeRequirePushedAuthRequests(requirePushedAuthRequests bool) func(event *OIDCConfigChangedEvent) {
+	return func(e *OIDCConfigChangedEvent) {
+		e.RequirePushedAuthRequests = &requirePushedAuthRequests
+	}
+}
+
+func ChangeCIBANotificationURI(cibaNotificationURI string) func(event *OIDCConfigChangedEvent) {
+	return func(e *OIDCConfigChangedEvent) {
+		e.CIBANotificationURI = &cibaNotificationURI
+	}
+}
+
+func ChangeConsentRequired(consentRequired bool) func(event *OIDCConfigChangedEvent) {
+	return func(e *OIDCConfigChangedEvent) {
+		e.ConsentRequired = &consentRequired
+	}
+}
+
+func ChangeTLSClientAuthSubjectDN(subjectDN string) func(event *OIDCConfigChangedEvent) {
+	return func(e *OIDCConfigChangedEvent) {
+		e.TLSClientAuthSubjectDN = &subjectDN
+	}
+}
+
+func ChangeTLSClientCertificates(certificates []string) func(event *OIDCConfigChangedEvent) {
+	return func(e *OIDCConfigChangedEvent) {
+		e.TLSClientCertificates = &certificates
+	}
+}
+
+func ChangeTLSClientCertificateBoundAccessTokens(boundAccessTokens bool) func(event *OIDCConfigChangedEvent) {
+	return func(e *OIDCConfigChangedEvent) {
+		e.TLSClientCertificateBoundAccessTokens = &boundAccessTokens
+	}
+}
+
+func ChangeRefreshTokenRotation(rotation domain.OIDCRefreshTokenRotation) func(event *OIDCConfigChangedEvent) {
+	return func(e *OIDCConfigChangedEvent) {
+		e.RefreshTokenRotation = &rotation
+	}
+}
+
+func ChangeEncryptAuthorizationResponse(encrypt bool) func(event *OIDCConfigChangedEvent) {
+	return func(e *OIDCConfigChangedEvent) {
+		e.EncryptAuthorizationResponse = &encrypt
+	}
+}
+
 func OIDCConfigChangedEventMapper(event eventstore.Event) (eventstore.Event, error) {
 	e := &OIDCConfigChangedEvent{
 		BaseEvent: *eventstore.BaseEventFromRepo(event),
@@ -493,3 +613,41 @@ func (e *OIDCConfigSecretHashUpdatedEvent) Payload() interface{} {
 func (e *OIDCConfigSecretHashUpdatedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
 	return nil
 }
+
+// OIDCConfigRegistrationAccessTokenSetEvent marks the application as registered by the client itself
+// through the dynamic client registration (RFC 7591), which can then be managed with the token (RFC 7592).
+type OIDCConfigRegistrationAccessTokenSetEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	AppID       string `json:"appId"`
+	HashedToken string `json:"hashedToken"`
+}
+
+func NewOIDCConfigRegistrationAccessTokenSetEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	appID string,
+	hashedToken string,
+) *OIDCConfigRegistrationAccessTokenSetEvent {
+	return &OIDCConfigRegistrationAccessTokenSetEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			OIDCConfigRegistrationAccessTokenSetType,
+		),
+		AppID:       appID,
+		HashedToken: hashedToken,
+	}
+}
+
+func (e *OIDCConfigRegistrationAccessTokenSetEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (e *OIDCConfigRegistrationAccessTokenSetEvent) Payload() interface{} {
+	return e
+}
+
+func (e *OIDCConfigRegistrationAccessTokenSetEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
@@ -8,5 +8,5 @@ func init() {
 	eventstore.RegisterFilterEventMapper(AggregateType, AddedType, eventstore.GenericEventMapper[AddedEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, SAMLResponseAddedType, eventstore.GenericEventMapper[SAMLResponseAddedEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, SAMLResponseRevokedType, eventstore.GenericEventMapper[SAMLResponseRevokedEvent])
-
+	eventstore.RegisterFilterEventMapper(AggregateType, TerminateType, eventstore.GenericEventMapper[TerminateEvent])
 }
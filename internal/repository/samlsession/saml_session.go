@@ -15,6 +15,7 @@ const (
 	AddedType               = samlSessionEventPrefix + "added"
 	SAMLResponseAddedType   = samlSessionEventPrefix + "saml_response.added"
 	SAMLResponseRevokedType = samlSessionEventPrefix + "saml_response.revoked"
+	TerminateType           = samlSessionEventPrefix + "terminated"
 )
 
 type AddedEvent struct {
@@ -137,3 +138,32 @@ func NewSAMLResponseRevokedEvent(
 		),
 	}
 }
+
+type TerminateEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func (e *TerminateEvent) Payload() interface{} {
+	return e
+}
+
+func (e *TerminateEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *TerminateEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = *event
+}
+
+func NewTerminateEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+) *TerminateEvent {
+	return &TerminateEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			TerminateType,
+		),
+	}
+}
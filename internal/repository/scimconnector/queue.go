@@ -0,0 +1,22 @@
+package scimconnector
+
+import (
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	QueueName = "scim_provisioning"
+)
+
+// Request asks the provisioning worker to reconcile the state of a user
+// in the downstream SCIM service of a connector.
+type Request struct {
+	Aggregate   *eventstore.Aggregate `json:"aggregate"`
+	ConnectorID string                `json:"connectorID"`
+	UserID      string                `json:"userID"`
+	EventType   eventstore.EventType  `json:"eventType"`
+}
+
+func (r *Request) Kind() string {
+	return "scim_provisioning_request"
+}
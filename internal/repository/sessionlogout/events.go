@@ -11,6 +11,9 @@ const (
 	backChannelEventTypePrefix      = eventTypePrefix + "back_channel."
 	BackChannelLogoutRegisteredType = backChannelEventTypePrefix + "registered"
 	BackChannelLogoutSentType       = backChannelEventTypePrefix + "sent"
+	samlEventTypePrefix             = eventTypePrefix + "saml."
+	SAMLLogoutRegisteredType        = samlEventTypePrefix + "registered"
+	SAMLLogoutSentType              = samlEventTypePrefix + "sent"
 )
 
 type BackChannelLogoutRegisteredEvent struct {
@@ -77,3 +80,83 @@ func NewBackChannelLogoutSentEvent(ctx context.Context, aggregate *eventstore.Ag
 		OIDCSessionID: oidcSessionID,
 	}
 }
+
+type SAMLLogoutRegisteredEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	SAMLSessionID     string `json:"saml_session_id"`
+	UserID            string `json:"user_id"`
+	UserResourceOwner string `json:"user_resource_owner"`
+	EntityID          string `json:"entity_id"`
+	NameID            string `json:"name_id"`
+	NameIDFormat      string `json:"name_id_format,omitempty"`
+	SessionIndex      string `json:"session_index,omitempty"`
+}
+
+func (e *SAMLLogoutRegisteredEvent) Payload() interface{} {
+	return e
+}
+
+func (e *SAMLLogoutRegisteredEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *SAMLLogoutRegisteredEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = *event
+}
+
+func NewSAMLLogoutRegisteredEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	samlSessionID,
+	userID,
+	userResourceOwner,
+	entityID,
+	nameID,
+	nameIDFormat,
+	sessionIndex string,
+) *SAMLLogoutRegisteredEvent {
+	return &SAMLLogoutRegisteredEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			SAMLLogoutRegisteredType,
+		),
+		SAMLSessionID:     samlSessionID,
+		UserID:            userID,
+		UserResourceOwner: userResourceOwner,
+		EntityID:          entityID,
+		NameID:            nameID,
+		NameIDFormat:      nameIDFormat,
+		SessionIndex:      sessionIndex,
+	}
+}
+
+type SAMLLogoutSentEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	SAMLSessionID string `json:"saml_session_id"`
+}
+
+func (e *SAMLLogoutSentEvent) Payload() interface{} {
+	return e
+}
+
+func (e *SAMLLogoutSentEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *SAMLLogoutSentEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = *event
+}
+
+func NewSAMLLogoutSentEvent(ctx context.Context, aggregate *eventstore.Aggregate, samlSessionID string) *SAMLLogoutSentEvent {
+	return &SAMLLogoutSentEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			SAMLLogoutSentType,
+		),
+		SAMLSessionID: samlSessionID,
+	}
+}
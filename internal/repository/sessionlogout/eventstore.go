@@ -7,9 +7,13 @@ import (
 var (
 	BackChannelLogoutRegisteredEventMapper = eventstore.GenericEventMapper[BackChannelLogoutRegisteredEvent]
 	BackChannelLogoutSentEventMapper       = eventstore.GenericEventMapper[BackChannelLogoutSentEvent]
+	SAMLLogoutRegisteredEventMapper        = eventstore.GenericEventMapper[SAMLLogoutRegisteredEvent]
+	SAMLLogoutSentEventMapper              = eventstore.GenericEventMapper[SAMLLogoutSentEvent]
 )
 
 func init() {
 	eventstore.RegisterFilterEventMapper(AggregateType, BackChannelLogoutRegisteredType, BackChannelLogoutRegisteredEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, BackChannelLogoutSentType, BackChannelLogoutSentEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, SAMLLogoutRegisteredType, SAMLLogoutRegisteredEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, SAMLLogoutSentType, SAMLLogoutSentEventMapper)
 }
@@ -16,6 +16,16 @@ const (
 	RemovedEventType                      = eventTypePrefix + "removed"
 )
 
+// TLS configures the connection to targets of type [domain.TargetTypeGRPC].
+type TLS struct {
+	// ClientCertificate is the PEM encoded certificate (chain) presented to the target for mutual TLS.
+	ClientCertificate []byte `json:"clientCertificate,omitempty"`
+	// ClientKey is the encrypted PEM encoded private key of the client certificate.
+	ClientKey *crypto.CryptoValue `json:"clientKey,omitempty"`
+	// RootCA is the PEM encoded certificate pool used to verify the target instead of the system roots.
+	RootCA []byte `json:"rootCA,omitempty"`
+}
+
 type AddedEvent struct {
 	eventstore.BaseEvent `json:"-"`
 
@@ -25,6 +35,7 @@ type AddedEvent struct {
 	Timeout          time.Duration       `json:"timeout"`
 	InterruptOnError bool                `json:"interruptOnError"`
 	SigningKey       *crypto.CryptoValue `json:"signingKey"`
+	TLS              *TLS                `json:"tls,omitempty"`
 }
 
 func (e *AddedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
@@ -48,12 +59,13 @@ func NewAddedEvent(
 	timeout time.Duration,
 	interruptOnError bool,
 	signingKey *crypto.CryptoValue,
+	tls *TLS,
 ) *AddedEvent {
 	return &AddedEvent{
 		*eventstore.NewBaseEventForPush(
 			ctx, aggregate, AddedEventType,
 		),
-		name, targetType, endpoint, timeout, interruptOnError, signingKey}
+		name, targetType, endpoint, timeout, interruptOnError, signingKey, tls}
 }
 
 type ChangedEvent struct {
@@ -65,6 +77,8 @@ type ChangedEvent struct {
 	Timeout          *time.Duration      `json:"timeout,omitempty"`
 	InterruptOnError *bool               `json:"interruptOnError,omitempty"`
 	SigningKey       *crypto.CryptoValue `json:"signingKey,omitempty"`
+	// TLS replaces the whole configuration, an empty configuration removes it
+	TLS *TLS `json:"tls,omitempty"`
 
 	oldName string
 }
@@ -144,6 +158,12 @@ func ChangeSigningKey(signingKey *crypto.CryptoValue) func(event *ChangedEvent)
 	}
 }
 
+func ChangeTLS(tls *TLS) func(event *ChangedEvent) {
+	return func(e *ChangedEvent) {
+		e.TLS = tls
+	}
+}
+
 type RemovedEvent struct {
 	eventstore.BaseEvent `json:"-"`
 
@@ -12,4 +12,8 @@ func init() {
 	eventstore.RegisterFilterEventMapper(AggregateType, UserGrantCascadeRemovedType, UserGrantCascadeRemovedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, UserGrantDeactivatedType, UserGrantDeactivatedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, UserGrantReactivatedType, UserGrantReactivatedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, UserGrantValidityChangedType, UserGrantValidityChangedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, UserGrantExpiredType, UserGrantExpiredEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, UserGrantExpiryRemindedType, UserGrantExpiryRemindedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, UserGrantExpiryReminderSentType, UserGrantExpiryReminderSentEventMapper)
 }
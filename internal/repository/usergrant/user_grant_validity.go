@@ -0,0 +1,193 @@
+package usergrant
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/api/http"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	UserGrantValidityChangedType    = userGrantEventTypePrefix + "validity.changed"
+	UserGrantExpiredType            = userGrantEventTypePrefix + "expired"
+	UserGrantExpiryRemindedType     = userGrantEventTypePrefix + "expiry.reminded"
+	UserGrantExpiryReminderSentType = userGrantEventTypePrefix + "expiry.reminder.sent"
+)
+
+type UserGrantValidityChangedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ValidFrom  *time.Time `json:"validFrom,omitempty"`
+	ValidUntil *time.Time `json:"validUntil,omitempty"`
+}
+
+func (e *UserGrantValidityChangedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *UserGrantValidityChangedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewUserGrantValidityChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	validFrom,
+	validUntil time.Time,
+) *UserGrantValidityChangedEvent {
+	return &UserGrantValidityChangedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			UserGrantValidityChangedType,
+		),
+		ValidFrom:  timePtr(validFrom),
+		ValidUntil: timePtr(validUntil),
+	}
+}
+
+func UserGrantValidityChangedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e := &UserGrantValidityChangedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := event.Unmarshal(e)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "UGRANT-iePh4", "unable to unmarshal user grant validity")
+	}
+
+	return e, nil
+}
+
+// UserGrantExpiredEvent is pushed as soon as the end of the validity of the grant is reached.
+// The grant is deactivated.
+type UserGrantExpiredEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ValidUntil time.Time `json:"validUntil"`
+}
+
+func (e *UserGrantExpiredEvent) Payload() interface{} {
+	return e
+}
+
+func (e *UserGrantExpiredEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewUserGrantExpiredEvent(ctx context.Context, aggregate *eventstore.Aggregate, validUntil time.Time) *UserGrantExpiredEvent {
+	return &UserGrantExpiredEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			UserGrantExpiredType,
+		),
+		ValidUntil: validUntil,
+	}
+}
+
+func UserGrantExpiredEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e := &UserGrantExpiredEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := event.Unmarshal(e)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "UGRANT-Shoo1", "unable to unmarshal user grant expiry")
+	}
+
+	return e, nil
+}
+
+// UserGrantExpiryRemindedEvent is pushed once per validity when the end of the grant is near,
+// so the user can be notified about the upcoming expiry.
+type UserGrantExpiryRemindedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	UserID            string    `json:"userId,omitempty"`
+	ProjectName       string    `json:"projectName,omitempty"`
+	ValidUntil        time.Time `json:"validUntil"`
+	TriggeredAtOrigin string    `json:"triggerOrigin,omitempty"`
+}
+
+func (e *UserGrantExpiryRemindedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *UserGrantExpiryRemindedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *UserGrantExpiryRemindedEvent) TriggerOrigin() string {
+	return e.TriggeredAtOrigin
+}
+
+func NewUserGrantExpiryRemindedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	userID,
+	projectName string,
+	validUntil time.Time,
+) *UserGrantExpiryRemindedEvent {
+	return &UserGrantExpiryRemindedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			UserGrantExpiryRemindedType,
+		),
+		UserID:            userID,
+		ProjectName:       projectName,
+		ValidUntil:        validUntil,
+		TriggeredAtOrigin: http.DomainContext(ctx).Origin(),
+	}
+}
+
+func UserGrantExpiryRemindedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e := &UserGrantExpiryRemindedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := event.Unmarshal(e)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "UGRANT-Ooj4a", "unable to unmarshal user grant expiry reminder")
+	}
+
+	return e, nil
+}
+
+type UserGrantExpiryReminderSentEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func (e *UserGrantExpiryReminderSentEvent) Payload() interface{} {
+	return nil
+}
+
+func (e *UserGrantExpiryReminderSentEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewUserGrantExpiryReminderSentEvent(ctx context.Context, aggregate *eventstore.Aggregate) *UserGrantExpiryReminderSentEvent {
+	return &UserGrantExpiryReminderSentEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			UserGrantExpiryReminderSentType,
+		),
+	}
+}
+
+func UserGrantExpiryReminderSentEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	return &UserGrantExpiryReminderSentEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}, nil
+}
+
+func timePtr(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
@@ -0,0 +1,161 @@
+package workloadidentity
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	eventTypePrefix  eventstore.EventType = "workload_identity_trust."
+	AddedEventType                        = eventTypePrefix + "added"
+	ChangedEventType                      = eventTypePrefix + "changed"
+	RemovedEventType                      = eventTypePrefix + "removed"
+)
+
+// Rule maps the external tokens whose claims match all conditions to a machine user.
+// A condition value ending with * matches all claim values with the preceding prefix.
+type Rule struct {
+	Conditions map[string]string `json:"conditions,omitempty"`
+	UserID     string            `json:"userId,omitempty"`
+}
+
+type AddedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Name         string   `json:"name"`
+	Issuer       string   `json:"issuer"`
+	JWKSEndpoint string   `json:"jwksEndpoint,omitempty"`
+	JWKS         []byte   `json:"jwks,omitempty"`
+	Audience     []string `json:"audience,omitempty"`
+	Rules        []*Rule  `json:"rules,omitempty"`
+}
+
+func (e *AddedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *AddedEvent) Payload() any {
+	return e
+}
+
+func (e *AddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	name,
+	issuer,
+	jwksEndpoint string,
+	jwks []byte,
+	audience []string,
+	rules []*Rule,
+) *AddedEvent {
+	return &AddedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx, aggregate, AddedEventType,
+		),
+		Name:         name,
+		Issuer:       issuer,
+		JWKSEndpoint: jwksEndpoint,
+		JWKS:         jwks,
+		Audience:     audience,
+		Rules:        rules,
+	}
+}
+
+type ChangedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Name         *string  `json:"name,omitempty"`
+	Issuer       *string  `json:"issuer,omitempty"`
+	JWKSEndpoint *string  `json:"jwksEndpoint,omitempty"`
+	JWKS         []byte   `json:"jwks,omitempty"`
+	Audience     []string `json:"audience,omitempty"`
+	Rules        []*Rule  `json:"rules,omitempty"`
+}
+
+func (e *ChangedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *ChangedEvent) Payload() any {
+	return e
+}
+
+func (e *ChangedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	changes []Changes,
+) *ChangedEvent {
+	changeEvent := &ChangedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx, aggregate, ChangedEventType,
+		),
+	}
+	for _, change := range changes {
+		change(changeEvent)
+	}
+	return changeEvent
+}
+
+type Changes func(event *ChangedEvent)
+
+func ChangeName(name string) func(event *ChangedEvent) {
+	return func(e *ChangedEvent) {
+		e.Name = &name
+	}
+}
+
+func ChangeIssuer(issuer string) func(event *ChangedEvent) {
+	return func(e *ChangedEvent) {
+		e.Issuer = &issuer
+	}
+}
+
+// ChangeKeys always sets the JWKS endpoint, so the change of the static JWKS
+// can be detected even if it is removed.
+func ChangeKeys(jwksEndpoint string, jwks []byte) func(event *ChangedEvent) {
+	return func(e *ChangedEvent) {
+		e.JWKSEndpoint = &jwksEndpoint
+		e.JWKS = jwks
+	}
+}
+
+func ChangeAudience(audience []string) func(event *ChangedEvent) {
+	return func(e *ChangedEvent) {
+		e.Audience = audience
+	}
+}
+
+func ChangeRules(rules []*Rule) func(event *ChangedEvent) {
+	return func(e *ChangedEvent) {
+		e.Rules = rules
+	}
+}
+
+type RemovedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func (e *RemovedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *RemovedEvent) Payload() any {
+	return e
+}
+
+func (e *RemovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewRemovedEvent(ctx context.Context, aggregate *eventstore.Aggregate) *RemovedEvent {
+	return &RemovedEvent{*eventstore.NewBaseEventForPush(ctx, aggregate, RemovedEventType)}
+}
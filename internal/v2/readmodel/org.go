@@ -14,6 +14,9 @@ type Org struct {
 	PrimaryDomain *projection.OrgPrimaryDomain
 	State         *projection.OrgState
 
+	ParentOrgID            string
+	DelegateAdministration bool
+
 	Sequence     uint32
 	CreationDate time.Time
 	ChangeDate   time.Time
@@ -57,6 +60,16 @@ func (rm *Org) Reduce(events ...*eventstore.StorageEvent) error {
 				return err
 			}
 			rm.Name = changed.Payload.Name
+		case org.ParentSetType:
+			parentSet, err := org.ParentSetEventFromStorage(event)
+			if err != nil {
+				return err
+			}
+			rm.ParentOrgID = parentSet.Payload.ParentOrgID
+			rm.DelegateAdministration = parentSet.Payload.DelegateAdministration
+		case org.ParentRemovedType:
+			rm.ParentOrgID = ""
+			rm.DelegateAdministration = false
 		}
 		rm.Sequence = event.Sequence
 		rm.ChangeDate = event.CreatedAt
@@ -181,6 +181,82 @@ func (w *Config) FinishLogin(ctx context.Context, user *domain.Human, webAuthN *
 	return credential, nil
 }
 
+// BeginDiscoverableLogin creates a challenge without a user and therefore without allowed credentials,
+// so the browser can offer all discoverable credentials (passkeys) of the relying party, e.g. using conditional mediation.
+func (w *Config) BeginDiscoverableLogin(ctx context.Context, userVerification domain.UserVerificationRequirement, rpID string) (*domain.WebAuthNLogin, error) {
+	webAuthNServer, err := w.serverFromContext(ctx, rpID, "")
+	if err != nil {
+		return nil, err
+	}
+	assertion, sessionData, err := webAuthNServer.BeginDiscoverableLogin(webauthn.WithUserVerification(UserVerificationFromDomain(userVerification)))
+	if err != nil {
+		logging.WithFields("error", tryExtractProtocolErrMsg(err)).Debug("webauthn discoverable login could not be started")
+		return nil, zerrors.ThrowInternal(err, "WEBAU-Eeb4o", "Errors.User.WebAuthN.BeginLoginFailed")
+	}
+	cred, err := json.Marshal(assertion)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "WEBAU-ieH0a", "Errors.User.WebAuthN.MarshalError")
+	}
+	return &domain.WebAuthNLogin{
+		Challenge:               sessionData.Challenge,
+		CredentialAssertionData: cred,
+		UserVerification:        userVerification,
+		RPID:                    webAuthNServer.Config.RPID,
+	}, nil
+}
+
+// DiscoverableUserHandler resolves the user and its tokens from the credential ID and the user handle
+// returned by the authenticator.
+type DiscoverableUserHandler func(credentialID, userHandle []byte) (*domain.Human, []*domain.WebAuthNToken, error)
+
+// FinishDiscoverableLogin validates the assertion of a challenge created by [Config.BeginDiscoverableLogin].
+// The user is resolved by the handler and returned together with the validated credential.
+func (w *Config) FinishDiscoverableLogin(ctx context.Context, webAuthN *domain.WebAuthNLogin, credData []byte, handler DiscoverableUserHandler) (*domain.Human, *webauthn.Credential, error) {
+	assertionData, err := protocol.ParseCredentialRequestResponseBody(bytes.NewReader(credData))
+	if err != nil {
+		logging.WithFields("error", tryExtractProtocolErrMsg(err)).Debug("webauthn assertion could not be parsed")
+		return nil, nil, zerrors.ThrowInternal(err, "WEBAU-ahR4e", "Errors.User.WebAuthN.ValidateLoginFailed")
+	}
+	webAuthNServer, err := w.serverFromContext(ctx, webAuthN.RPID, assertionData.Response.CollectedClientData.Origin)
+	if err != nil {
+		return nil, nil, err
+	}
+	var (
+		human      *domain.Human
+		handlerErr error
+	)
+	credential, err := webAuthNServer.ValidateDiscoverableLogin(
+		func(rawID, userHandle []byte) (webauthn.User, error) {
+			var tokens []*domain.WebAuthNToken
+			human, tokens, handlerErr = handler(rawID, userHandle)
+			if handlerErr != nil {
+				return nil, handlerErr
+			}
+			return &webUser{
+				Human:       human,
+				credentials: WebAuthNsToCredentials(tokens, webAuthN.RPID),
+			}, nil
+		},
+		webauthn.SessionData{
+			Challenge:        webAuthN.Challenge,
+			UserVerification: UserVerificationFromDomain(webAuthN.UserVerification),
+		},
+		assertionData,
+	)
+	// return the error of the handler, e.g. a not found user, instead of the wrapped protocol error
+	if handlerErr != nil {
+		return nil, nil, handlerErr
+	}
+	if err != nil {
+		logging.WithFields("error", tryExtractProtocolErrMsg(err)).Debug("webauthn discoverable assertion failed")
+		return nil, nil, zerrors.ThrowInternal(err, "WEBAU-Ooth9", "Errors.User.WebAuthN.ValidateLoginFailed")
+	}
+	if credential.Authenticator.CloneWarning {
+		return human, credential, zerrors.ThrowInternal(nil, "WEBAU-ohV4a", "Errors.User.WebAuthN.CloneWarning")
+	}
+	return human, credential, nil
+}
+
 func (w *Config) serverFromContext(ctx context.Context, id, origin string) (*webauthn.WebAuthn, error) {
 	config := w.config(id, origin)
 	if id == "" {